@@ -9,6 +9,7 @@ package users
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -200,6 +201,62 @@ func (x *Uint64Msg) GetValue() uint64 {
 	return 0
 }
 
+// Money is an exact amount in minor units (kopecks, cents) with its ISO 4217 currency code.
+type Money struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount   int64  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Money) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type ListReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -212,7 +269,7 @@ type ListReq struct {
 func (x *ListReq) Reset() {
 	*x = ListReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_pb_products_proto_msgTypes[4]
+		mi := &file_api_pb_products_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -225,7 +282,7 @@ func (x *ListReq) String() string {
 func (*ListReq) ProtoMessage() {}
 
 func (x *ListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_pb_products_proto_msgTypes[4]
+	mi := &file_api_pb_products_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -238,7 +295,7 @@ func (x *ListReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListReq.ProtoReflect.Descriptor instead.
 func (*ListReq) Descriptor() ([]byte, []int) {
-	return file_api_pb_products_proto_rawDescGZIP(), []int{4}
+	return file_api_pb_products_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListReq) GetPage() uint64 {
@@ -268,7 +325,7 @@ type SearchReq struct {
 func (x *SearchReq) Reset() {
 	*x = SearchReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_pb_products_proto_msgTypes[5]
+		mi := &file_api_pb_products_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -281,7 +338,7 @@ func (x *SearchReq) String() string {
 func (*SearchReq) ProtoMessage() {}
 
 func (x *SearchReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_pb_products_proto_msgTypes[5]
+	mi := &file_api_pb_products_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -294,7 +351,7 @@ func (x *SearchReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchReq.ProtoReflect.Descriptor instead.
 func (*SearchReq) Descriptor() ([]byte, []int) {
-	return file_api_pb_products_proto_rawDescGZIP(), []int{5}
+	return file_api_pb_products_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SearchReq) GetQuery() string {
@@ -318,6 +375,8 @@ func (x *SearchReq) GetSize() uint64 {
 	return 0
 }
 
+// Items, categories, promotions and orders carry a version that is bumped on every update.
+// An update with a non zero version fails with ABORTED when the stored version differs.
 type CategoryMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -335,12 +394,13 @@ type CategoryMsg struct {
 	Filters            []*Filter              `protobuf:"bytes,10,rep,name=filters,proto3" json:"filters,omitempty"`
 	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Version            int32                  `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *CategoryMsg) Reset() {
 	*x = CategoryMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_pb_products_proto_msgTypes[6]
+		mi := &file_api_pb_products_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -353,7 +413,7 @@ func (x *CategoryMsg) String() string {
 func (*CategoryMsg) ProtoMessage() {}
 
 func (x *CategoryMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_api_pb_products_proto_msgTypes[6]
+	mi := &file_api_pb_products_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -366,7 +426,7 @@ func (x *CategoryMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategoryMsg.ProtoReflect.Descriptor instead.
 func (*CategoryMsg) Descriptor() ([]byte, []int) {
-	return file_api_pb_products_proto_rawDescGZIP(), []int{6}
+	return file_api_pb_products_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CategoryMsg) GetSlug() string {
@@ -453,6 +513,13 @@ func (x *CategoryMsg) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *CategoryMsg) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type CategoryWithSlug struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -460,12 +527,14 @@ type CategoryWithSlug struct {
 
 	Slug     string       `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
 	Category *CategoryMsg `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	// When set only the named fields are updated, e.g. "title" or "filters".
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 }
 
 func (x *CategoryWithSlug) Reset() {
 	*x = CategoryWithSlug{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_pb_products_proto_msgTypes[7]
+		mi := &file_api_pb_products_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -478,7 +547,7 @@ func (x *CategoryWithSlug) String() string {
 func (*CategoryWithSlug) ProtoMessage() {}
 
 func (x *CategoryWithSlug) ProtoReflect() protoreflect.Message {
-	mi := &file_api_pb_products_proto_msgTypes[7]
+	mi := &file_api_pb_products_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -491,7 +560,7 @@ func (x *CategoryWithSlug) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategoryWithSlug.ProtoReflect.Descriptor instead.
 func (*CategoryWithSlug) Descriptor() ([]byte, []int) {
-	return file_api_pb_products_proto_rawDescGZIP(), []int{7}
+	return file_api_pb_products_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CategoryWithSlug) GetSlug() string {
@@ -508,6 +577,13 @@ func (x *CategoryWithSlug) GetCategory() *CategoryMsg {
 	return nil
 }
 
+func (x *CategoryWithSlug) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
 type Filter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -527,7 +603,7 @@ type Filter struct {
 func (x *Filter) Reset() {
 	*x = Filter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_pb_products_proto_msgTypes[8]
+		mi := &file_api_pb_products_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -540,7 +616,7 @@ func (x *Filter) String() string {
 func (*Filter) ProtoMessage() {}
 
 func (x *Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_api_pb_products_proto_msgTypes[8]
+	mi := &file_api_pb_products_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -553,7 +629,7 @@ func (x *Filter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Filter.ProtoReflect.Descriptor instead.
 func (*Filter) Descriptor() ([]byte, []int) {
-	return file_api_pb_products_proto_rawDescGZIP(), []int{8}
+	return file_api_pb_products_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Filter) GetId() uint64 {
@@ -628,7 +704,7 @@ type ItemMsg struct {
 	Article         string                 `protobuf:"bytes,2,opt,name=article,proto3" json:"article,omitempty"`
 	Title           string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
 	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Price           float32                `protobuf:"fixed32,5,opt,name=price,proto3" json:"price,omitempty"`
+	Price           *Money                 `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
 	QuantityInStock uint32                 `protobuf:"varint,6,opt,name=quantity_in_stock,json=quantityInStock,proto3" json:"quantity_in_stock,omitempty"`
 	Src             string                 `protobuf:"bytes,7,opt,name=src,proto3" json:"src,omitempty"`
 	Alt             string                 `protobuf:"bytes,8,opt,name=alt,proto3" json:"alt,omitempty"`
@@ -643,12 +719,17 @@ type ItemMsg struct {
 	RelatedProducts []*RelatedProduct      `protobuf:"bytes,17,rep,name=related_products,json=relatedProducts,proto3" json:"related_products,omitempty"`
 	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	QuantityBreaks  []*QuantityBreakMsg    `protobuf:"bytes,20,rep,name=quantity_breaks,json=quantityBreaks,proto3" json:"quantity_breaks,omitempty"`
+	Labels          []string               `protobuf:"bytes,21,rep,name=labels,proto3" json:"labels,omitempty"`
+	Rating          float64                `protobuf:"fixed64,22,opt,name=rating,proto3" json:"rating,omitempty"`
+	ReviewCount     int32                  `protobuf:"varint,23,opt,name=review_count,json=reviewCount,proto3" json:"review_count,omitempty"`
+	Version         int32                  `protobuf:"varint,24,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *ItemMsg) Reset() {
 	*x = ItemMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_pb_products_proto_msgTypes[9]
+		mi := &file_api_pb_products_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -661,7 +742,7 @@ func (x *ItemMsg) String() string {
 func (*ItemMsg) ProtoMessage() {}
 
 func (x *ItemMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_api_pb_products_proto_msgTypes[9]
+	mi := &file_api_pb_products_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -674,7 +755,7 @@ func (x *ItemMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ItemMsg.ProtoReflect.Descriptor instead.
 func (*ItemMsg) Descriptor() ([]byte, []int) {
-	return file_api_pb_products_proto_rawDescGZIP(), []int{9}
+	return file_api_pb_products_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ItemMsg) GetId() string {
@@ -705,11 +786,11 @@ func (x *ItemMsg) GetDescription() string {
 	return ""
 }
 
-func (x *ItemMsg) GetPrice() float32 {
+func (x *ItemMsg) GetPrice() *Money {
 	if x != nil {
 		return x.Price
 	}
-	return 0
+	return nil
 }
 
 func (x *ItemMsg) GetQuantityInStock() uint32 {
@@ -810,23 +891,65 @@ func (x *ItemMsg) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ItemMsg) GetQuantityBreaks() []*QuantityBreakMsg {
+	if x != nil {
+		return x.QuantityBreaks
+	}
+	return nil
+}
+
+func (x *ItemMsg) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ItemMsg) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *ItemMsg) GetReviewCount() int32 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
+func (x *ItemMsg) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type ItemMedia struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Src       string                 `protobuf:"bytes,2,opt,name=src,proto3" json:"src,omitempty"`
-	Alt       string                 `protobuf:"bytes,3,opt,name=alt,proto3" json:"alt,omitempty"`
-	ItemId    string                 `protobuf:"bytes,4,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Src         string                 `protobuf:"bytes,2,opt,name=src,proto3" json:"src,omitempty"`
+	Alt         string                 `protobuf:"bytes,3,opt,name=alt,proto3" json:"alt,omitempty"`
+	ItemId      string                 `protobuf:"bytes,4,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ContentType string                 `protobuf:"bytes,7,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size        int64                  `protobuf:"varint,8,opt,name=size,proto3" json:"size,omitempty"`
+	Width       int32                  `protobuf:"varint,9,opt,name=width,proto3" json:"width,omitempty"`
+	Height      int32                  `protobuf:"varint,10,opt,name=height,proto3" json:"height,omitempty"`
+	Position    int32                  `protobuf:"varint,11,opt,name=position,proto3" json:"position,omitempty"`
+	IsPrimary   bool                   `protobuf:"varint,12,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	Variants    []*MediaVariantMsg     `protobuf:"bytes,13,rep,name=variants,proto3" json:"variants,omitempty"`
 }
 
 func (x *ItemMedia) Reset() {
 	*x = ItemMedia{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_pb_products_proto_msgTypes[10]
+		mi := &file_api_pb_products_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -839,7 +962,7 @@ func (x *ItemMedia) String() string {
 func (*ItemMedia) ProtoMessage() {}
 
 func (x *ItemMedia) ProtoReflect() protoreflect.Message {
-	mi := &file_api_pb_products_proto_msgTypes[10]
+	mi := &file_api_pb_products_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -852,7 +975,7 @@ func (x *ItemMedia) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ItemMedia.ProtoReflect.Descriptor instead.
 func (*ItemMedia) Descriptor() ([]byte, []int) {
-	return file_api_pb_products_proto_rawDescGZIP(), []int{10}
+	return file_api_pb_products_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ItemMedia) GetId() uint64 {
@@ -897,23 +1020,152 @@ func (x *ItemMedia) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ItemMedia) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ItemMedia) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ItemMedia) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ItemMedia) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ItemMedia) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ItemMedia) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+func (x *ItemMedia) GetVariants() []*MediaVariantMsg {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+type MediaVariantMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Src         string `protobuf:"bytes,2,opt,name=src,proto3" json:"src,omitempty"`
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Width       int32  `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
+	Height      int32  `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *MediaVariantMsg) Reset() {
+	*x = MediaVariantMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MediaVariantMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaVariantMsg) ProtoMessage() {}
+
+func (x *MediaVariantMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaVariantMsg.ProtoReflect.Descriptor instead.
+func (*MediaVariantMsg) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MediaVariantMsg) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MediaVariantMsg) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	return ""
+}
+
+func (x *MediaVariantMsg) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *MediaVariantMsg) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *MediaVariantMsg) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
 type ItemAttribute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Value     string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
-	ItemId    string                 `protobuf:"bytes,4,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Value       string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	ItemId      string                 `protobuf:"bytes,4,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	AttributeId uint64                 `protobuf:"varint,7,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
 }
 
 func (x *ItemAttribute) Reset() {
 	*x = ItemAttribute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_pb_products_proto_msgTypes[11]
+		mi := &file_api_pb_products_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -926,7 +1178,7 @@ func (x *ItemAttribute) String() string {
 func (*ItemAttribute) ProtoMessage() {}
 
 func (x *ItemAttribute) ProtoReflect() protoreflect.Message {
-	mi := &file_api_pb_products_proto_msgTypes[11]
+	mi := &file_api_pb_products_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -939,7 +1191,7 @@ func (x *ItemAttribute) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ItemAttribute.ProtoReflect.Descriptor instead.
 func (*ItemAttribute) Descriptor() ([]byte, []int) {
-	return file_api_pb_products_proto_rawDescGZIP(), []int{11}
+	return file_api_pb_products_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ItemAttribute) GetId() uint64 {
@@ -984,6 +1236,13 @@ func (x *ItemAttribute) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ItemAttribute) GetAttributeId() uint64 {
+	if x != nil {
+		return x.AttributeId
+	}
+	return 0
+}
+
 type RelatedProduct struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -995,12 +1254,16 @@ type RelatedProduct struct {
 	RelatedItem   *ItemMsg               `protobuf:"bytes,4,opt,name=related_item,json=relatedItem,proto3" json:"related_item,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Type          string                 `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`
+	IsSymmetric   bool                   `protobuf:"varint,8,opt,name=is_symmetric,json=isSymmetric,proto3" json:"is_symmetric,omitempty"`
+	Position      int32                  `protobuf:"varint,9,opt,name=position,proto3" json:"position,omitempty"`
+	IsRecommended bool                   `protobuf:"varint,10,opt,name=is_recommended,json=isRecommended,proto3" json:"is_recommended,omitempty"`
 }
 
 func (x *RelatedProduct) Reset() {
 	*x = RelatedProduct{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_pb_products_proto_msgTypes[12]
+		mi := &file_api_pb_products_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1013,7 +1276,7 @@ func (x *RelatedProduct) String() string {
 func (*RelatedProduct) ProtoMessage() {}
 
 func (x *RelatedProduct) ProtoReflect() protoreflect.Message {
-	mi := &file_api_pb_products_proto_msgTypes[12]
+	mi := &file_api_pb_products_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1026,7 +1289,7 @@ func (x *RelatedProduct) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RelatedProduct.ProtoReflect.Descriptor instead.
 func (*RelatedProduct) Descriptor() ([]byte, []int) {
-	return file_api_pb_products_proto_rawDescGZIP(), []int{12}
+	return file_api_pb_products_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *RelatedProduct) GetId() uint64 {
@@ -1071,97 +1334,61 @@ func (x *RelatedProduct) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type ListItemsByLabelReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *RelatedProduct) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
 
-	Page  uint32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Size  uint32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+func (x *RelatedProduct) GetIsSymmetric() bool {
+	if x != nil {
+		return x.IsSymmetric
+	}
+	return false
 }
 
-func (x *ListItemsByLabelReq) Reset() {
-	*x = ListItemsByLabelReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_pb_products_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ListItemsByLabelReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListItemsByLabelReq) ProtoMessage() {}
-
-func (x *ListItemsByLabelReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_pb_products_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListItemsByLabelReq.ProtoReflect.Descriptor instead.
-func (*ListItemsByLabelReq) Descriptor() ([]byte, []int) {
-	return file_api_pb_products_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *ListItemsByLabelReq) GetPage() uint32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *ListItemsByLabelReq) GetSize() uint32 {
+func (x *RelatedProduct) GetPosition() int32 {
 	if x != nil {
-		return x.Size
+		return x.Position
 	}
 	return 0
 }
 
-func (x *ListItemsByLabelReq) GetLabel() string {
+func (x *RelatedProduct) GetIsRecommended() bool {
 	if x != nil {
-		return x.Label
+		return x.IsRecommended
 	}
-	return ""
+	return false
 }
 
-type ListCategoryItemsReq struct {
+type ListItemRevisionsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page         uint32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Size         uint32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	Sort         string `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
-	CategorySlug string `protobuf:"bytes,4,opt,name=category_slug,json=categorySlug,proto3" json:"category_slug,omitempty"`
+	ItemId string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Page   uint64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Size   uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
 }
 
-func (x *ListCategoryItemsReq) Reset() {
-	*x = ListCategoryItemsReq{}
+func (x *ListItemRevisionsReq) Reset() {
+	*x = ListItemRevisionsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_pb_products_proto_msgTypes[14]
+		mi := &file_api_pb_products_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListCategoryItemsReq) String() string {
+func (x *ListItemRevisionsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCategoryItemsReq) ProtoMessage() {}
+func (*ListItemRevisionsReq) ProtoMessage() {}
 
-func (x *ListCategoryItemsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_pb_products_proto_msgTypes[14]
+func (x *ListItemRevisionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1172,64 +1399,58 @@ func (x *ListCategoryItemsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCategoryItemsReq.ProtoReflect.Descriptor instead.
-func (*ListCategoryItemsReq) Descriptor() ([]byte, []int) {
-	return file_api_pb_products_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ListItemRevisionsReq.ProtoReflect.Descriptor instead.
+func (*ListItemRevisionsReq) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ListCategoryItemsReq) GetPage() uint32 {
+func (x *ListItemRevisionsReq) GetItemId() string {
 	if x != nil {
-		return x.Page
+		return x.ItemId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListCategoryItemsReq) GetSize() uint32 {
+func (x *ListItemRevisionsReq) GetPage() uint64 {
 	if x != nil {
-		return x.Size
+		return x.Page
 	}
 	return 0
 }
 
-func (x *ListCategoryItemsReq) GetSort() string {
-	if x != nil {
-		return x.Sort
-	}
-	return ""
-}
-
-func (x *ListCategoryItemsReq) GetCategorySlug() string {
+func (x *ListItemRevisionsReq) GetSize() uint64 {
 	if x != nil {
-		return x.CategorySlug
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-type RelatedItemsList struct {
+type ItemRevisionReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Items []*RelatedProduct `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	ItemId string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Id     uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *RelatedItemsList) Reset() {
-	*x = RelatedItemsList{}
+func (x *ItemRevisionReq) Reset() {
+	*x = ItemRevisionReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_pb_products_proto_msgTypes[15]
+		mi := &file_api_pb_products_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RelatedItemsList) String() string {
+func (x *ItemRevisionReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RelatedItemsList) ProtoMessage() {}
+func (*ItemRevisionReq) ProtoMessage() {}
 
-func (x *RelatedItemsList) ProtoReflect() protoreflect.Message {
-	mi := &file_api_pb_products_proto_msgTypes[15]
+func (x *ItemRevisionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1240,44 +1461,52 @@ func (x *RelatedItemsList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RelatedItemsList.ProtoReflect.Descriptor instead.
-func (*RelatedItemsList) Descriptor() ([]byte, []int) {
-	return file_api_pb_products_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ItemRevisionReq.ProtoReflect.Descriptor instead.
+func (*ItemRevisionReq) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *RelatedItemsList) GetItems() []*RelatedProduct {
+func (x *ItemRevisionReq) GetItemId() string {
 	if x != nil {
-		return x.Items
+		return x.ItemId
 	}
-	return nil
+	return ""
 }
 
-type ItemWithUid struct {
+func (x *ItemRevisionReq) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DiffItemRevisionsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Item *ItemMsg `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
-	Uid  string   `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	ItemId string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	From   uint64 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To     uint64 `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
 }
 
-func (x *ItemWithUid) Reset() {
-	*x = ItemWithUid{}
+func (x *DiffItemRevisionsReq) Reset() {
+	*x = DiffItemRevisionsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_pb_products_proto_msgTypes[16]
+		mi := &file_api_pb_products_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ItemWithUid) String() string {
+func (x *DiffItemRevisionsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ItemWithUid) ProtoMessage() {}
+func (*DiffItemRevisionsReq) ProtoMessage() {}
 
-func (x *ItemWithUid) ProtoReflect() protoreflect.Message {
-	mi := &file_api_pb_products_proto_msgTypes[16]
+func (x *DiffItemRevisionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1288,54 +1517,62 @@ func (x *ItemWithUid) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ItemWithUid.ProtoReflect.Descriptor instead.
-func (*ItemWithUid) Descriptor() ([]byte, []int) {
-	return file_api_pb_products_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use DiffItemRevisionsReq.ProtoReflect.Descriptor instead.
+func (*DiffItemRevisionsReq) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ItemWithUid) GetItem() *ItemMsg {
+func (x *DiffItemRevisionsReq) GetItemId() string {
 	if x != nil {
-		return x.Item
+		return x.ItemId
 	}
-	return nil
+	return ""
 }
 
-func (x *ItemWithUid) GetUid() string {
+func (x *DiffItemRevisionsReq) GetFrom() uint64 {
 	if x != nil {
-		return x.Uid
+		return x.From
 	}
-	return ""
+	return 0
 }
 
-type PaginatedItemRes struct {
+func (x *DiffItemRevisionsReq) GetTo() uint64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+// The state the item had at version, captured when an update replaced it.
+type ItemRevisionMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data        []*ItemMsg `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	Count       int64      `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
-	TotalPages  int64      `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
-	CurrentPage int64      `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
-	HasNextPage bool       `protobuf:"varint,5,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ItemId    string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Version   int64                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Item      *ItemMsg               `protobuf:"bytes,4,opt,name=item,proto3" json:"item,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *PaginatedItemRes) Reset() {
-	*x = PaginatedItemRes{}
+func (x *ItemRevisionMsg) Reset() {
+	*x = ItemRevisionMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_pb_products_proto_msgTypes[17]
+		mi := &file_api_pb_products_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PaginatedItemRes) String() string {
+func (x *ItemRevisionMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaginatedItemRes) ProtoMessage() {}
+func (*ItemRevisionMsg) ProtoMessage() {}
 
-func (x *PaginatedItemRes) ProtoReflect() protoreflect.Message {
-	mi := &file_api_pb_products_proto_msgTypes[17]
+func (x *ItemRevisionMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1346,75 +1583,75 @@ func (x *PaginatedItemRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaginatedItemRes.ProtoReflect.Descriptor instead.
-func (*PaginatedItemRes) Descriptor() ([]byte, []int) {
-	return file_api_pb_products_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ItemRevisionMsg.ProtoReflect.Descriptor instead.
+func (*ItemRevisionMsg) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *PaginatedItemRes) GetData() []*ItemMsg {
+func (x *ItemRevisionMsg) GetId() uint64 {
 	if x != nil {
-		return x.Data
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *PaginatedItemRes) GetCount() int64 {
+func (x *ItemRevisionMsg) GetItemId() string {
 	if x != nil {
-		return x.Count
+		return x.ItemId
 	}
-	return 0
+	return ""
 }
 
-func (x *PaginatedItemRes) GetTotalPages() int64 {
+func (x *ItemRevisionMsg) GetVersion() int64 {
 	if x != nil {
-		return x.TotalPages
+		return x.Version
 	}
 	return 0
 }
 
-func (x *PaginatedItemRes) GetCurrentPage() int64 {
+func (x *ItemRevisionMsg) GetItem() *ItemMsg {
 	if x != nil {
-		return x.CurrentPage
+		return x.Item
 	}
-	return 0
+	return nil
 }
 
-func (x *PaginatedItemRes) GetHasNextPage() bool {
+func (x *ItemRevisionMsg) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.HasNextPage
+		return x.CreatedAt
 	}
-	return false
+	return nil
 }
 
-type PaginatedItemAttrsRes struct {
+type PaginatedItemRevisionsRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data        []*ItemAttribute `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	Count       int64            `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
-	TotalPages  int64            `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
-	CurrentPage int64            `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
-	HasNextPage bool             `protobuf:"varint,5,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	Data        []*ItemRevisionMsg `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Count       int64              `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	TotalPages  int64              `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	CurrentPage int64              `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	HasNextPage bool               `protobuf:"varint,5,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
 }
 
-func (x *PaginatedItemAttrsRes) Reset() {
-	*x = PaginatedItemAttrsRes{}
+func (x *PaginatedItemRevisionsRes) Reset() {
+	*x = PaginatedItemRevisionsRes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_pb_products_proto_msgTypes[18]
+		mi := &file_api_pb_products_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PaginatedItemAttrsRes) String() string {
+func (x *PaginatedItemRevisionsRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaginatedItemAttrsRes) ProtoMessage() {}
+func (*PaginatedItemRevisionsRes) ProtoMessage() {}
 
-func (x *PaginatedItemAttrsRes) ProtoReflect() protoreflect.Message {
-	mi := &file_api_pb_products_proto_msgTypes[18]
+func (x *PaginatedItemRevisionsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1425,75 +1662,74 @@ func (x *PaginatedItemAttrsRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaginatedItemAttrsRes.ProtoReflect.Descriptor instead.
-func (*PaginatedItemAttrsRes) Descriptor() ([]byte, []int) {
-	return file_api_pb_products_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use PaginatedItemRevisionsRes.ProtoReflect.Descriptor instead.
+func (*PaginatedItemRevisionsRes) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *PaginatedItemAttrsRes) GetData() []*ItemAttribute {
+func (x *PaginatedItemRevisionsRes) GetData() []*ItemRevisionMsg {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *PaginatedItemAttrsRes) GetCount() int64 {
+func (x *PaginatedItemRevisionsRes) GetCount() int64 {
 	if x != nil {
 		return x.Count
 	}
 	return 0
 }
 
-func (x *PaginatedItemAttrsRes) GetTotalPages() int64 {
+func (x *PaginatedItemRevisionsRes) GetTotalPages() int64 {
 	if x != nil {
 		return x.TotalPages
 	}
 	return 0
 }
 
-func (x *PaginatedItemAttrsRes) GetCurrentPage() int64 {
+func (x *PaginatedItemRevisionsRes) GetCurrentPage() int64 {
 	if x != nil {
 		return x.CurrentPage
 	}
 	return 0
 }
 
-func (x *PaginatedItemAttrsRes) GetHasNextPage() bool {
+func (x *PaginatedItemRevisionsRes) GetHasNextPage() bool {
 	if x != nil {
 		return x.HasNextPage
 	}
 	return false
 }
 
-type PaginatedCategoryRes struct {
+type ItemRevisionDiffMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data        []*CategoryMsg `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	Count       int64          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
-	TotalPages  int64          `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
-	CurrentPage int64          `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
-	HasNextPage bool           `protobuf:"varint,5,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	From uint64 `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   uint64 `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	// JSON object {"before": {...}, "after": {...}} with the fields that differ.
+	Diff string `protobuf:"bytes,3,opt,name=diff,proto3" json:"diff,omitempty"`
 }
 
-func (x *PaginatedCategoryRes) Reset() {
-	*x = PaginatedCategoryRes{}
+func (x *ItemRevisionDiffMsg) Reset() {
+	*x = ItemRevisionDiffMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_pb_products_proto_msgTypes[19]
+		mi := &file_api_pb_products_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PaginatedCategoryRes) String() string {
+func (x *ItemRevisionDiffMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaginatedCategoryRes) ProtoMessage() {}
+func (*ItemRevisionDiffMsg) ProtoMessage() {}
 
-func (x *PaginatedCategoryRes) ProtoReflect() protoreflect.Message {
-	mi := &file_api_pb_products_proto_msgTypes[19]
+func (x *ItemRevisionDiffMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1504,71 +1740,62 @@ func (x *PaginatedCategoryRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaginatedCategoryRes.ProtoReflect.Descriptor instead.
-func (*PaginatedCategoryRes) Descriptor() ([]byte, []int) {
-	return file_api_pb_products_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *PaginatedCategoryRes) GetData() []*CategoryMsg {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *PaginatedCategoryRes) GetCount() int64 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
+// Deprecated: Use ItemRevisionDiffMsg.ProtoReflect.Descriptor instead.
+func (*ItemRevisionDiffMsg) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *PaginatedCategoryRes) GetTotalPages() int64 {
+func (x *ItemRevisionDiffMsg) GetFrom() uint64 {
 	if x != nil {
-		return x.TotalPages
+		return x.From
 	}
 	return 0
 }
 
-func (x *PaginatedCategoryRes) GetCurrentPage() int64 {
+func (x *ItemRevisionDiffMsg) GetTo() uint64 {
 	if x != nil {
-		return x.CurrentPage
+		return x.To
 	}
 	return 0
 }
 
-func (x *PaginatedCategoryRes) GetHasNextPage() bool {
+func (x *ItemRevisionDiffMsg) GetDiff() string {
 	if x != nil {
-		return x.HasNextPage
+		return x.Diff
 	}
-	return false
+	return ""
 }
 
-type FilterListRes struct {
+type PriceHistoryMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []*Filter `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ItemId    string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	OldPrice  *Money                 `protobuf:"bytes,3,opt,name=old_price,json=oldPrice,proto3" json:"old_price,omitempty"`
+	NewPrice  *Money                 `protobuf:"bytes,4,opt,name=new_price,json=newPrice,proto3" json:"new_price,omitempty"`
+	Source    string                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *FilterListRes) Reset() {
-	*x = FilterListRes{}
+func (x *PriceHistoryMsg) Reset() {
+	*x = PriceHistoryMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_pb_products_proto_msgTypes[20]
+		mi := &file_api_pb_products_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FilterListRes) String() string {
+func (x *PriceHistoryMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FilterListRes) ProtoMessage() {}
+func (*PriceHistoryMsg) ProtoMessage() {}
 
-func (x *FilterListRes) ProtoReflect() protoreflect.Message {
-	mi := &file_api_pb_products_proto_msgTypes[20]
+func (x *PriceHistoryMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1579,112 +1806,68 @@ func (x *FilterListRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FilterListRes.ProtoReflect.Descriptor instead.
-func (*FilterListRes) Descriptor() ([]byte, []int) {
-	return file_api_pb_products_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use PriceHistoryMsg.ProtoReflect.Descriptor instead.
+func (*PriceHistoryMsg) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *FilterListRes) GetData() []*Filter {
+func (x *PriceHistoryMsg) GetId() uint64 {
 	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-type PaginatedFilterRes struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Data        []*Filter `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	Count       int64     `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
-	TotalPages  int64     `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
-	CurrentPage int64     `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
-	HasNextPage bool      `protobuf:"varint,5,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
-}
-
-func (x *PaginatedFilterRes) Reset() {
-	*x = PaginatedFilterRes{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_pb_products_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *PaginatedFilterRes) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PaginatedFilterRes) ProtoMessage() {}
-
-func (x *PaginatedFilterRes) ProtoReflect() protoreflect.Message {
-	mi := &file_api_pb_products_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Id
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PaginatedFilterRes.ProtoReflect.Descriptor instead.
-func (*PaginatedFilterRes) Descriptor() ([]byte, []int) {
-	return file_api_pb_products_proto_rawDescGZIP(), []int{21}
+	return 0
 }
 
-func (x *PaginatedFilterRes) GetData() []*Filter {
+func (x *PriceHistoryMsg) GetItemId() string {
 	if x != nil {
-		return x.Data
+		return x.ItemId
 	}
-	return nil
+	return ""
 }
 
-func (x *PaginatedFilterRes) GetCount() int64 {
+func (x *PriceHistoryMsg) GetOldPrice() *Money {
 	if x != nil {
-		return x.Count
+		return x.OldPrice
 	}
-	return 0
+	return nil
 }
 
-func (x *PaginatedFilterRes) GetTotalPages() int64 {
+func (x *PriceHistoryMsg) GetNewPrice() *Money {
 	if x != nil {
-		return x.TotalPages
+		return x.NewPrice
 	}
-	return 0
+	return nil
 }
 
-func (x *PaginatedFilterRes) GetCurrentPage() int64 {
+func (x *PriceHistoryMsg) GetSource() string {
 	if x != nil {
-		return x.CurrentPage
+		return x.Source
 	}
-	return 0
+	return ""
 }
 
-func (x *PaginatedFilterRes) GetHasNextPage() bool {
+func (x *PriceHistoryMsg) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.HasNextPage
+		return x.CreatedAt
 	}
-	return false
+	return nil
 }
 
-type FavoriteMsg struct {
+type ScheduledPriceMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId    string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ItemId    string                 `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	Item      *ItemMsg               `protobuf:"bytes,4,opt,name=item,proto3" json:"item,omitempty"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ItemId    string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Price     *Money                 `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	StartsAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	AppliedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=applied_at,json=appliedAt,proto3" json:"applied_at,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *FavoriteMsg) Reset() {
-	*x = FavoriteMsg{}
+func (x *ScheduledPriceMsg) Reset() {
+	*x = ScheduledPriceMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_pb_products_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1692,13 +1875,13 @@ func (x *FavoriteMsg) Reset() {
 	}
 }
 
-func (x *FavoriteMsg) String() string {
+func (x *ScheduledPriceMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FavoriteMsg) ProtoMessage() {}
+func (*ScheduledPriceMsg) ProtoMessage() {}
 
-func (x *FavoriteMsg) ProtoReflect() protoreflect.Message {
+func (x *ScheduledPriceMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_api_pb_products_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1710,63 +1893,67 @@ func (x *FavoriteMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FavoriteMsg.ProtoReflect.Descriptor instead.
-func (*FavoriteMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScheduledPriceMsg.ProtoReflect.Descriptor instead.
+func (*ScheduledPriceMsg) Descriptor() ([]byte, []int) {
 	return file_api_pb_products_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *FavoriteMsg) GetId() uint64 {
+func (x *ScheduledPriceMsg) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *FavoriteMsg) GetUserId() string {
+func (x *ScheduledPriceMsg) GetItemId() string {
 	if x != nil {
-		return x.UserId
+		return x.ItemId
 	}
 	return ""
 }
 
-func (x *FavoriteMsg) GetItemId() string {
+func (x *ScheduledPriceMsg) GetPrice() *Money {
 	if x != nil {
-		return x.ItemId
+		return x.Price
 	}
-	return ""
+	return nil
 }
 
-func (x *FavoriteMsg) GetItem() *ItemMsg {
+func (x *ScheduledPriceMsg) GetStartsAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Item
+		return x.StartsAt
 	}
 	return nil
 }
 
-func (x *FavoriteMsg) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ScheduledPriceMsg) GetAppliedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CreatedAt
+		return x.AppliedAt
 	}
 	return nil
 }
 
-func (x *FavoriteMsg) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *ScheduledPriceMsg) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdatedAt
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type FavoriteListMsg struct {
+type PriceTimelineMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []*FavoriteMsg `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	ItemId          string               `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	CurrentPrice    *Money               `protobuf:"bytes,2,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
+	LowestPrice_30D *Money               `protobuf:"bytes,3,opt,name=lowest_price_30d,json=lowestPrice30d,proto3" json:"lowest_price_30d,omitempty"`
+	History         []*PriceHistoryMsg   `protobuf:"bytes,4,rep,name=history,proto3" json:"history,omitempty"`
+	Scheduled       []*ScheduledPriceMsg `protobuf:"bytes,5,rep,name=scheduled,proto3" json:"scheduled,omitempty"`
 }
 
-func (x *FavoriteListMsg) Reset() {
-	*x = FavoriteListMsg{}
+func (x *PriceTimelineMsg) Reset() {
+	*x = PriceTimelineMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_pb_products_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1774,13 +1961,13 @@ func (x *FavoriteListMsg) Reset() {
 	}
 }
 
-func (x *FavoriteListMsg) String() string {
+func (x *PriceTimelineMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FavoriteListMsg) ProtoMessage() {}
+func (*PriceTimelineMsg) ProtoMessage() {}
 
-func (x *FavoriteListMsg) ProtoReflect() protoreflect.Message {
+func (x *PriceTimelineMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_api_pb_products_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1792,29 +1979,58 @@ func (x *FavoriteListMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FavoriteListMsg.ProtoReflect.Descriptor instead.
-func (*FavoriteListMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use PriceTimelineMsg.ProtoReflect.Descriptor instead.
+func (*PriceTimelineMsg) Descriptor() ([]byte, []int) {
 	return file_api_pb_products_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *FavoriteListMsg) GetData() []*FavoriteMsg {
+func (x *PriceTimelineMsg) GetItemId() string {
 	if x != nil {
-		return x.Data
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *PriceTimelineMsg) GetCurrentPrice() *Money {
+	if x != nil {
+		return x.CurrentPrice
 	}
 	return nil
 }
 
-type UserAndItemIds struct {
+func (x *PriceTimelineMsg) GetLowestPrice_30D() *Money {
+	if x != nil {
+		return x.LowestPrice_30D
+	}
+	return nil
+}
+
+func (x *PriceTimelineMsg) GetHistory() []*PriceHistoryMsg {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *PriceTimelineMsg) GetScheduled() []*ScheduledPriceMsg {
+	if x != nil {
+		return x.Scheduled
+	}
+	return nil
+}
+
+type ListItemsByLabelReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ItemId string `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Page  uint32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size  uint32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
 }
 
-func (x *UserAndItemIds) Reset() {
-	*x = UserAndItemIds{}
+func (x *ListItemsByLabelReq) Reset() {
+	*x = ListItemsByLabelReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_pb_products_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1822,13 +2038,13 @@ func (x *UserAndItemIds) Reset() {
 	}
 }
 
-func (x *UserAndItemIds) String() string {
+func (x *ListItemsByLabelReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserAndItemIds) ProtoMessage() {}
+func (*ListItemsByLabelReq) ProtoMessage() {}
 
-func (x *UserAndItemIds) ProtoReflect() protoreflect.Message {
+func (x *ListItemsByLabelReq) ProtoReflect() protoreflect.Message {
 	mi := &file_api_pb_products_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1840,42 +2056,45 @@ func (x *UserAndItemIds) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserAndItemIds.ProtoReflect.Descriptor instead.
-func (*UserAndItemIds) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListItemsByLabelReq.ProtoReflect.Descriptor instead.
+func (*ListItemsByLabelReq) Descriptor() ([]byte, []int) {
 	return file_api_pb_products_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *UserAndItemIds) GetUserId() string {
+func (x *ListItemsByLabelReq) GetPage() uint32 {
 	if x != nil {
-		return x.UserId
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *UserAndItemIds) GetItemId() string {
+func (x *ListItemsByLabelReq) GetSize() uint32 {
 	if x != nil {
-		return x.ItemId
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ListItemsByLabelReq) GetLabel() string {
+	if x != nil {
+		return x.Label
 	}
 	return ""
 }
 
-type PromoMsg struct {
+type ListCategoryItemsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Slug        string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
-	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Src         string                 `protobuf:"bytes,4,opt,name=src,proto3" json:"src,omitempty"`
-	Alt         string                 `protobuf:"bytes,5,opt,name=alt,proto3" json:"alt,omitempty"`
-	LastsTo     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=lasts_to,json=lastsTo,proto3" json:"lasts_to,omitempty"`
-	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Page         uint32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size         uint32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Sort         string `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	CategorySlug string `protobuf:"bytes,4,opt,name=category_slug,json=categorySlug,proto3" json:"category_slug,omitempty"`
 }
 
-func (x *PromoMsg) Reset() {
-	*x = PromoMsg{}
+func (x *ListCategoryItemsReq) Reset() {
+	*x = ListCategoryItemsReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_pb_products_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1883,13 +2102,13 @@ func (x *PromoMsg) Reset() {
 	}
 }
 
-func (x *PromoMsg) String() string {
+func (x *ListCategoryItemsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PromoMsg) ProtoMessage() {}
+func (*ListCategoryItemsReq) ProtoMessage() {}
 
-func (x *PromoMsg) ProtoReflect() protoreflect.Message {
+func (x *ListCategoryItemsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_api_pb_products_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1901,78 +2120,49 @@ func (x *PromoMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PromoMsg.ProtoReflect.Descriptor instead.
-func (*PromoMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListCategoryItemsReq.ProtoReflect.Descriptor instead.
+func (*ListCategoryItemsReq) Descriptor() ([]byte, []int) {
 	return file_api_pb_products_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *PromoMsg) GetSlug() string {
-	if x != nil {
-		return x.Slug
-	}
-	return ""
-}
-
-func (x *PromoMsg) GetTitle() string {
+func (x *ListCategoryItemsReq) GetPage() uint32 {
 	if x != nil {
-		return x.Title
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *PromoMsg) GetDescription() string {
+func (x *ListCategoryItemsReq) GetSize() uint32 {
 	if x != nil {
-		return x.Description
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-func (x *PromoMsg) GetSrc() string {
+func (x *ListCategoryItemsReq) GetSort() string {
 	if x != nil {
-		return x.Src
+		return x.Sort
 	}
 	return ""
 }
 
-func (x *PromoMsg) GetAlt() string {
+func (x *ListCategoryItemsReq) GetCategorySlug() string {
 	if x != nil {
-		return x.Alt
+		return x.CategorySlug
 	}
 	return ""
 }
 
-func (x *PromoMsg) GetLastsTo() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LastsTo
-	}
-	return nil
-}
-
-func (x *PromoMsg) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *PromoMsg) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
-}
-
-type PromoWithSlug struct {
+type RelatedItemsList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Slug string    `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
-	Data *PromoMsg `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Items []*RelatedProduct `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *PromoWithSlug) Reset() {
-	*x = PromoWithSlug{}
+func (x *RelatedItemsList) Reset() {
+	*x = RelatedItemsList{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_pb_products_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1980,13 +2170,13 @@ func (x *PromoWithSlug) Reset() {
 	}
 }
 
-func (x *PromoWithSlug) String() string {
+func (x *RelatedItemsList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PromoWithSlug) ProtoMessage() {}
+func (*RelatedItemsList) ProtoMessage() {}
 
-func (x *PromoWithSlug) ProtoReflect() protoreflect.Message {
+func (x *RelatedItemsList) ProtoReflect() protoreflect.Message {
 	mi := &file_api_pb_products_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1998,41 +2188,31 @@ func (x *PromoWithSlug) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PromoWithSlug.ProtoReflect.Descriptor instead.
-func (*PromoWithSlug) Descriptor() ([]byte, []int) {
+// Deprecated: Use RelatedItemsList.ProtoReflect.Descriptor instead.
+func (*RelatedItemsList) Descriptor() ([]byte, []int) {
 	return file_api_pb_products_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *PromoWithSlug) GetSlug() string {
-	if x != nil {
-		return x.Slug
-	}
-	return ""
-}
-
-func (x *PromoWithSlug) GetData() *PromoMsg {
+func (x *RelatedItemsList) GetItems() []*RelatedProduct {
 	if x != nil {
-		return x.Data
+		return x.Items
 	}
 	return nil
 }
 
-type PromoItem struct {
+type ItemWithUid struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Discount      uint32                 `protobuf:"varint,2,opt,name=discount,proto3" json:"discount,omitempty"`
-	PromotionSlug string                 `protobuf:"bytes,3,opt,name=promotion_slug,json=promotionSlug,proto3" json:"promotion_slug,omitempty"`
-	ItemId        string                 `protobuf:"bytes,4,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	Item          *ItemMsg               `protobuf:"bytes,5,opt,name=item,proto3" json:"item,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Item *ItemMsg `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Uid  string   `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	// When set only the named fields are updated, sub-collections such as "media" are kept unless named.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 }
 
-func (x *PromoItem) Reset() {
-	*x = PromoItem{}
+func (x *ItemWithUid) Reset() {
+	*x = ItemWithUid{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_pb_products_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2040,13 +2220,13 @@ func (x *PromoItem) Reset() {
 	}
 }
 
-func (x *PromoItem) String() string {
+func (x *ItemWithUid) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PromoItem) ProtoMessage() {}
+func (*ItemWithUid) ProtoMessage() {}
 
-func (x *PromoItem) ProtoReflect() protoreflect.Message {
+func (x *ItemWithUid) ProtoReflect() protoreflect.Message {
 	mi := &file_api_pb_products_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2058,74 +2238,46 @@ func (x *PromoItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PromoItem.ProtoReflect.Descriptor instead.
-func (*PromoItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use ItemWithUid.ProtoReflect.Descriptor instead.
+func (*ItemWithUid) Descriptor() ([]byte, []int) {
 	return file_api_pb_products_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *PromoItem) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *PromoItem) GetDiscount() uint32 {
-	if x != nil {
-		return x.Discount
-	}
-	return 0
-}
-
-func (x *PromoItem) GetPromotionSlug() string {
-	if x != nil {
-		return x.PromotionSlug
-	}
-	return ""
-}
-
-func (x *PromoItem) GetItemId() string {
-	if x != nil {
-		return x.ItemId
-	}
-	return ""
-}
-
-func (x *PromoItem) GetItem() *ItemMsg {
+func (x *ItemWithUid) GetItem() *ItemMsg {
 	if x != nil {
 		return x.Item
 	}
 	return nil
 }
 
-func (x *PromoItem) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ItemWithUid) GetUid() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Uid
 	}
-	return nil
+	return ""
 }
 
-func (x *PromoItem) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *ItemWithUid) GetUpdateMask() *fieldmaskpb.FieldMask {
 	if x != nil {
-		return x.UpdatedAt
+		return x.UpdateMask
 	}
 	return nil
 }
 
-type PaginatedPromoRes struct {
+type PaginatedItemRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data        []*PromoMsg `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	Count       int64       `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
-	TotalPages  int64       `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
-	CurrentPage int64       `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
-	HasNextPage bool        `protobuf:"varint,5,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	Data        []*ItemMsg `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Count       int64      `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	TotalPages  int64      `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	CurrentPage int64      `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	HasNextPage bool       `protobuf:"varint,5,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
 }
 
-func (x *PaginatedPromoRes) Reset() {
-	*x = PaginatedPromoRes{}
+func (x *PaginatedItemRes) Reset() {
+	*x = PaginatedItemRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_pb_products_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2133,13 +2285,13 @@ func (x *PaginatedPromoRes) Reset() {
 	}
 }
 
-func (x *PaginatedPromoRes) String() string {
+func (x *PaginatedItemRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaginatedPromoRes) ProtoMessage() {}
+func (*PaginatedItemRes) ProtoMessage() {}
 
-func (x *PaginatedPromoRes) ProtoReflect() protoreflect.Message {
+func (x *PaginatedItemRes) ProtoReflect() protoreflect.Message {
 	mi := &file_api_pb_products_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2151,60 +2303,60 @@ func (x *PaginatedPromoRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaginatedPromoRes.ProtoReflect.Descriptor instead.
-func (*PaginatedPromoRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use PaginatedItemRes.ProtoReflect.Descriptor instead.
+func (*PaginatedItemRes) Descriptor() ([]byte, []int) {
 	return file_api_pb_products_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *PaginatedPromoRes) GetData() []*PromoMsg {
+func (x *PaginatedItemRes) GetData() []*ItemMsg {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *PaginatedPromoRes) GetCount() int64 {
+func (x *PaginatedItemRes) GetCount() int64 {
 	if x != nil {
 		return x.Count
 	}
 	return 0
 }
 
-func (x *PaginatedPromoRes) GetTotalPages() int64 {
+func (x *PaginatedItemRes) GetTotalPages() int64 {
 	if x != nil {
 		return x.TotalPages
 	}
 	return 0
 }
 
-func (x *PaginatedPromoRes) GetCurrentPage() int64 {
+func (x *PaginatedItemRes) GetCurrentPage() int64 {
 	if x != nil {
 		return x.CurrentPage
 	}
 	return 0
 }
 
-func (x *PaginatedPromoRes) GetHasNextPage() bool {
+func (x *PaginatedItemRes) GetHasNextPage() bool {
 	if x != nil {
 		return x.HasNextPage
 	}
 	return false
 }
 
-type PaginatedPromoItemsRes struct {
+type PaginatedItemAttrsRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data        []*PromoItem `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	Count       int64        `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
-	TotalPages  int64        `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
-	CurrentPage int64        `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
-	HasNextPage bool         `protobuf:"varint,5,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	Data        []*ItemAttribute `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Count       int64            `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	TotalPages  int64            `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	CurrentPage int64            `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	HasNextPage bool             `protobuf:"varint,5,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
 }
 
-func (x *PaginatedPromoItemsRes) Reset() {
-	*x = PaginatedPromoItemsRes{}
+func (x *PaginatedItemAttrsRes) Reset() {
+	*x = PaginatedItemAttrsRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_pb_products_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2212,13 +2364,13 @@ func (x *PaginatedPromoItemsRes) Reset() {
 	}
 }
 
-func (x *PaginatedPromoItemsRes) String() string {
+func (x *PaginatedItemAttrsRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaginatedPromoItemsRes) ProtoMessage() {}
+func (*PaginatedItemAttrsRes) ProtoMessage() {}
 
-func (x *PaginatedPromoItemsRes) ProtoReflect() protoreflect.Message {
+func (x *PaginatedItemAttrsRes) ProtoReflect() protoreflect.Message {
 	mi := &file_api_pb_products_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2230,58 +2382,60 @@ func (x *PaginatedPromoItemsRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaginatedPromoItemsRes.ProtoReflect.Descriptor instead.
-func (*PaginatedPromoItemsRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use PaginatedItemAttrsRes.ProtoReflect.Descriptor instead.
+func (*PaginatedItemAttrsRes) Descriptor() ([]byte, []int) {
 	return file_api_pb_products_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *PaginatedPromoItemsRes) GetData() []*PromoItem {
+func (x *PaginatedItemAttrsRes) GetData() []*ItemAttribute {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *PaginatedPromoItemsRes) GetCount() int64 {
+func (x *PaginatedItemAttrsRes) GetCount() int64 {
 	if x != nil {
 		return x.Count
 	}
 	return 0
 }
 
-func (x *PaginatedPromoItemsRes) GetTotalPages() int64 {
+func (x *PaginatedItemAttrsRes) GetTotalPages() int64 {
 	if x != nil {
 		return x.TotalPages
 	}
 	return 0
 }
 
-func (x *PaginatedPromoItemsRes) GetCurrentPage() int64 {
+func (x *PaginatedItemAttrsRes) GetCurrentPage() int64 {
 	if x != nil {
 		return x.CurrentPage
 	}
 	return 0
 }
 
-func (x *PaginatedPromoItemsRes) GetHasNextPage() bool {
+func (x *PaginatedItemAttrsRes) GetHasNextPage() bool {
 	if x != nil {
 		return x.HasNextPage
 	}
 	return false
 }
 
-type ListPromotionItemsReq struct {
+type PaginatedCategoryRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Slug string `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
-	Page uint32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	Size uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Data        []*CategoryMsg `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Count       int64          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	TotalPages  int64          `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	CurrentPage int64          `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	HasNextPage bool           `protobuf:"varint,5,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
 }
 
-func (x *ListPromotionItemsReq) Reset() {
-	*x = ListPromotionItemsReq{}
+func (x *PaginatedCategoryRes) Reset() {
+	*x = PaginatedCategoryRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_pb_products_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2289,13 +2443,13 @@ func (x *ListPromotionItemsReq) Reset() {
 	}
 }
 
-func (x *ListPromotionItemsReq) String() string {
+func (x *PaginatedCategoryRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPromotionItemsReq) ProtoMessage() {}
+func (*PaginatedCategoryRes) ProtoMessage() {}
 
-func (x *ListPromotionItemsReq) ProtoReflect() protoreflect.Message {
+func (x *PaginatedCategoryRes) ProtoReflect() protoreflect.Message {
 	mi := &file_api_pb_products_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2307,54 +2461,56 @@ func (x *ListPromotionItemsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPromotionItemsReq.ProtoReflect.Descriptor instead.
-func (*ListPromotionItemsReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use PaginatedCategoryRes.ProtoReflect.Descriptor instead.
+func (*PaginatedCategoryRes) Descriptor() ([]byte, []int) {
 	return file_api_pb_products_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ListPromotionItemsReq) GetSlug() string {
+func (x *PaginatedCategoryRes) GetData() []*CategoryMsg {
 	if x != nil {
-		return x.Slug
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *ListPromotionItemsReq) GetPage() uint32 {
+func (x *PaginatedCategoryRes) GetCount() int64 {
 	if x != nil {
-		return x.Page
+		return x.Count
 	}
 	return 0
 }
 
-func (x *ListPromotionItemsReq) GetSize() uint32 {
+func (x *PaginatedCategoryRes) GetTotalPages() int64 {
 	if x != nil {
-		return x.Size
+		return x.TotalPages
 	}
 	return 0
 }
 
-type OrderMsg struct {
+func (x *PaginatedCategoryRes) GetCurrentPage() int64 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *PaginatedCategoryRes) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+type FilterListRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Total         float32                `protobuf:"fixed32,3,opt,name=total,proto3" json:"total,omitempty"`
-	Fio           string                 `protobuf:"bytes,4,opt,name=fio,proto3" json:"fio,omitempty"`
-	Tel           string                 `protobuf:"bytes,5,opt,name=tel,proto3" json:"tel,omitempty"`
-	Email         string                 `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
-	Address       string                 `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
-	Delivery      string                 `protobuf:"bytes,8,opt,name=delivery,proto3" json:"delivery,omitempty"`
-	PaymentMethod string                 `protobuf:"bytes,9,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
-	UserId        string                 `protobuf:"bytes,10,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Items         []*OrderItem           `protobuf:"bytes,11,rep,name=items,proto3" json:"items,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Data []*Filter `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *OrderMsg) Reset() {
-	*x = OrderMsg{}
+func (x *FilterListRes) Reset() {
+	*x = FilterListRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_pb_products_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2362,13 +2518,13 @@ func (x *OrderMsg) Reset() {
 	}
 }
 
-func (x *OrderMsg) String() string {
+func (x *FilterListRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderMsg) ProtoMessage() {}
+func (*FilterListRes) ProtoMessage() {}
 
-func (x *OrderMsg) ProtoReflect() protoreflect.Message {
+func (x *FilterListRes) ProtoReflect() protoreflect.Message {
 	mi := &file_api_pb_products_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2380,133 +2536,236 @@ func (x *OrderMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderMsg.ProtoReflect.Descriptor instead.
-func (*OrderMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use FilterListRes.ProtoReflect.Descriptor instead.
+func (*FilterListRes) Descriptor() ([]byte, []int) {
 	return file_api_pb_products_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *OrderMsg) GetId() uint64 {
+func (x *FilterListRes) GetData() []*Filter {
 	if x != nil {
-		return x.Id
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-func (x *OrderMsg) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
+type PaginatedFilterRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *OrderMsg) GetTotal() float32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
+	Data        []*Filter `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Count       int64     `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	TotalPages  int64     `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	CurrentPage int64     `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	HasNextPage bool      `protobuf:"varint,5,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
 }
 
-func (x *OrderMsg) GetFio() string {
+func (x *PaginatedFilterRes) Reset() {
+	*x = PaginatedFilterRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaginatedFilterRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaginatedFilterRes) ProtoMessage() {}
+
+func (x *PaginatedFilterRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaginatedFilterRes.ProtoReflect.Descriptor instead.
+func (*PaginatedFilterRes) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PaginatedFilterRes) GetData() []*Filter {
 	if x != nil {
-		return x.Fio
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *OrderMsg) GetTel() string {
+func (x *PaginatedFilterRes) GetCount() int64 {
 	if x != nil {
-		return x.Tel
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-func (x *OrderMsg) GetEmail() string {
+func (x *PaginatedFilterRes) GetTotalPages() int64 {
 	if x != nil {
-		return x.Email
+		return x.TotalPages
 	}
-	return ""
+	return 0
 }
 
-func (x *OrderMsg) GetAddress() string {
+func (x *PaginatedFilterRes) GetCurrentPage() int64 {
 	if x != nil {
-		return x.Address
+		return x.CurrentPage
 	}
-	return ""
+	return 0
 }
 
-func (x *OrderMsg) GetDelivery() string {
+func (x *PaginatedFilterRes) GetHasNextPage() bool {
 	if x != nil {
-		return x.Delivery
+		return x.HasNextPage
 	}
-	return ""
+	return false
 }
 
-func (x *OrderMsg) GetPaymentMethod() string {
+type FavoriteMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ItemId          string                 `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Item            *ItemMsg               `protobuf:"bytes,4,opt,name=item,proto3" json:"item,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	NotifyPriceDrop bool                   `protobuf:"varint,7,opt,name=notify_price_drop,json=notifyPriceDrop,proto3" json:"notify_price_drop,omitempty"`
+	NotifyInStock   bool                   `protobuf:"varint,8,opt,name=notify_in_stock,json=notifyInStock,proto3" json:"notify_in_stock,omitempty"`
+	EffectivePrice  *Money                 `protobuf:"bytes,9,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"`
+}
+
+func (x *FavoriteMsg) Reset() {
+	*x = FavoriteMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FavoriteMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoriteMsg) ProtoMessage() {}
+
+func (x *FavoriteMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoriteMsg.ProtoReflect.Descriptor instead.
+func (*FavoriteMsg) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *FavoriteMsg) GetId() uint64 {
 	if x != nil {
-		return x.PaymentMethod
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *OrderMsg) GetUserId() string {
+func (x *FavoriteMsg) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *OrderMsg) GetItems() []*OrderItem {
+func (x *FavoriteMsg) GetItemId() string {
 	if x != nil {
-		return x.Items
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *FavoriteMsg) GetItem() *ItemMsg {
+	if x != nil {
+		return x.Item
 	}
 	return nil
 }
 
-func (x *OrderMsg) GetCreatedAt() *timestamppb.Timestamp {
+func (x *FavoriteMsg) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *OrderMsg) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *FavoriteMsg) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return nil
 }
 
-type OrderItem struct {
+func (x *FavoriteMsg) GetNotifyPriceDrop() bool {
+	if x != nil {
+		return x.NotifyPriceDrop
+	}
+	return false
+}
+
+func (x *FavoriteMsg) GetNotifyInStock() bool {
+	if x != nil {
+		return x.NotifyInStock
+	}
+	return false
+}
+
+func (x *FavoriteMsg) GetEffectivePrice() *Money {
+	if x != nil {
+		return x.EffectivePrice
+	}
+	return nil
+}
+
+type ListFavoritesReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Quantity  uint32                 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	OrderId   uint64                 `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	ItemId    string                 `protobuf:"bytes,4,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	Item      *ItemMsg               `protobuf:"bytes,5,opt,name=item,proto3" json:"item,omitempty"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page   uint64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Size   uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Sort   string `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
 }
 
-func (x *OrderItem) Reset() {
-	*x = OrderItem{}
+func (x *ListFavoritesReq) Reset() {
+	*x = ListFavoritesReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_pb_products_proto_msgTypes[32]
+		mi := &file_api_pb_products_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OrderItem) String() string {
+func (x *ListFavoritesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderItem) ProtoMessage() {}
+func (*ListFavoritesReq) ProtoMessage() {}
 
-func (x *OrderItem) ProtoReflect() protoreflect.Message {
-	mi := &file_api_pb_products_proto_msgTypes[32]
+func (x *ListFavoritesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2517,828 +2776,7638 @@ func (x *OrderItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
-func (*OrderItem) Descriptor() ([]byte, []int) {
-	return file_api_pb_products_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use ListFavoritesReq.ProtoReflect.Descriptor instead.
+func (*ListFavoritesReq) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *OrderItem) GetId() uint64 {
+func (x *ListFavoritesReq) GetUserId() string {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
-	return 0
+	return ""
 }
 
-func (x *OrderItem) GetQuantity() uint32 {
+func (x *ListFavoritesReq) GetPage() uint64 {
 	if x != nil {
-		return x.Quantity
+		return x.Page
 	}
 	return 0
 }
 
-func (x *OrderItem) GetOrderId() uint64 {
+func (x *ListFavoritesReq) GetSize() uint64 {
 	if x != nil {
-		return x.OrderId
+		return x.Size
 	}
 	return 0
 }
 
-func (x *OrderItem) GetItemId() string {
+func (x *ListFavoritesReq) GetSort() string {
 	if x != nil {
-		return x.ItemId
+		return x.Sort
 	}
 	return ""
 }
 
-func (x *OrderItem) GetItem() *ItemMsg {
+type PaginatedFavoriteRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data        []*FavoriteMsg `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Count       int64          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	TotalPages  int64          `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	CurrentPage int64          `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	HasNextPage bool           `protobuf:"varint,5,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+}
+
+func (x *PaginatedFavoriteRes) Reset() {
+	*x = PaginatedFavoriteRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaginatedFavoriteRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaginatedFavoriteRes) ProtoMessage() {}
+
+func (x *PaginatedFavoriteRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaginatedFavoriteRes.ProtoReflect.Descriptor instead.
+func (*PaginatedFavoriteRes) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PaginatedFavoriteRes) GetData() []*FavoriteMsg {
 	if x != nil {
-		return x.Item
+		return x.Data
 	}
 	return nil
 }
 
-func (x *OrderItem) GetCreatedAt() *timestamppb.Timestamp {
+func (x *PaginatedFavoriteRes) GetCount() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-func (x *OrderItem) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *PaginatedFavoriteRes) GetTotalPages() int64 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.TotalPages
 	}
-	return nil
+	return 0
 }
 
-type PaginatedOrderRes struct {
+func (x *PaginatedFavoriteRes) GetCurrentPage() int64 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *PaginatedFavoriteRes) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+type UserAndItemIds struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data        []*OrderMsg `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	Count       int64       `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
-	TotalPages  int64       `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
-	CurrentPage int64       `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
-	HasNextPage bool        `protobuf:"varint,5,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ItemId string `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
 }
 
-func (x *PaginatedOrderRes) Reset() {
-	*x = PaginatedOrderRes{}
+func (x *UserAndItemIds) Reset() {
+	*x = UserAndItemIds{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_pb_products_proto_msgTypes[33]
+		mi := &file_api_pb_products_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PaginatedOrderRes) String() string {
+func (x *UserAndItemIds) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaginatedOrderRes) ProtoMessage() {}
+func (*UserAndItemIds) ProtoMessage() {}
 
-func (x *PaginatedOrderRes) ProtoReflect() protoreflect.Message {
-	mi := &file_api_pb_products_proto_msgTypes[33]
+func (x *UserAndItemIds) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PaginatedOrderRes.ProtoReflect.Descriptor instead.
-func (*PaginatedOrderRes) Descriptor() ([]byte, []int) {
-	return file_api_pb_products_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *PaginatedOrderRes) GetData() []*OrderMsg {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *PaginatedOrderRes) GetCount() int64 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-func (x *PaginatedOrderRes) GetTotalPages() int64 {
-	if x != nil {
-		return x.TotalPages
-	}
-	return 0
-}
-
-func (x *PaginatedOrderRes) GetCurrentPage() int64 {
-	if x != nil {
-		return x.CurrentPage
-	}
-	return 0
-}
-
-func (x *PaginatedOrderRes) GetHasNextPage() bool {
-	if x != nil {
-		return x.HasNextPage
-	}
-	return false
-}
-
-var File_api_pb_products_proto protoreflect.FileDescriptor
-
-var file_api_pb_products_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x62, 0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x75, 0x73, 0x65, 0x72, 0x1a, 0x1f, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x07,
-	0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1d, 0x0a, 0x07, 0x75, 0x75, 0x69, 0x64, 0x4d,
-	0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x1d, 0x0a, 0x07, 0x73, 0x6c, 0x75, 0x67, 0x4d, 0x73,
-	0x67, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x73, 0x6c, 0x75, 0x67, 0x22, 0x21, 0x0a, 0x09, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d,
-	0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x31, 0x0a, 0x07, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x49, 0x0a, 0x09, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xdb, 0x03, 0x0a, 0x0b, 0x43, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x79, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
-	0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x71, 0x75, 0x61, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x73,
-	0x72, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x10, 0x0a,
-	0x03, 0x61, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x6c, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x6c, 0x75, 0x67,
-	0x12, 0x40, 0x0a, 0x12, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x43, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x79, 0x4d, 0x73, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4d, 0x73, 0x67, 0x52,
-	0x11, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4d,
-	0x73, 0x67, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x08,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x79, 0x4d, 0x73, 0x67, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65,
-	0x6e, 0x12, 0x23, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x73, 0x67, 0x52,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x26, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x39,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x22, 0x55, 0x0a, 0x10, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
-	0x57, 0x69, 0x74, 0x68, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x2d, 0x0a, 0x08,
-	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4d, 0x73,
-	0x67, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0xba, 0x02, 0x0a, 0x06,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x73,
-	0x6c, 0x75, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x6d, 0x69, 0x6e,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a,
-	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xb1, 0x05, 0x0a, 0x07, 0x49, 0x74, 0x65,
-	0x6d, 0x4d, 0x73, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x11,
-	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x6f, 0x63,
-	0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x49, 0x6e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c,
-	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x6c, 0x74, 0x12, 0x19, 0x0a, 0x08,
-	0x69, 0x6e, 0x5f, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x69, 0x6e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x68, 0x69,
-	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x48, 0x69, 0x74, 0x12, 0x15,
-	0x0a, 0x06, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x69, 0x73, 0x52, 0x65, 0x63, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f,
-	0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70,
-	0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x0a, 0x63,
-	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4d,
-	0x73, 0x67, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x25,
-	0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x05,
-	0x6d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x33, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x2e, 0x49, 0x74, 0x65, 0x6d, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x0a,
-	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x08, 0x76, 0x61,
-	0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x73, 0x67, 0x52, 0x08, 0x76, 0x61, 0x72,
-	0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x10, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x0f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xce, 0x01, 0x0a,
-	0x09, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x72,
-	0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x10, 0x0a, 0x03,
-	0x61, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x6c, 0x74, 0x12, 0x17,
-	0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xd8, 0x01,
-	0x0a, 0x0d, 0x49, 0x74, 0x65, 0x6d, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65,
-	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d,
-	0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a,
-	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x89, 0x02, 0x0a, 0x0e, 0x52, 0x65, 0x6c,
-	0x61, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69,
-	0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74,
-	0x65, 0x6d, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72,
-	0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x0c,
-	0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x73,
-	0x67, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x39,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x22, 0x53, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d,
-	0x73, 0x42, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73,
-	0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x77, 0x0a, 0x14, 0x6c, 0x69, 0x73,
-	0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65,
-	0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x23, 0x0a,
-	0x0d, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x53, 0x6c,
-	0x75, 0x67, 0x22, 0x3e, 0x0a, 0x10, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x49, 0x74, 0x65,
-	0x6d, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6c,
-	0x61, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65,
-	0x6d, 0x73, 0x22, 0x42, 0x0a, 0x0b, 0x49, 0x74, 0x65, 0x6d, 0x57, 0x69, 0x74, 0x68, 0x55, 0x69,
-	0x64, 0x12, 0x21, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x73, 0x67, 0x52, 0x04,
-	0x69, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0xb3, 0x01, 0x0a, 0x10, 0x50, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14,
-	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61,
-	0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f,
-	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0b, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x22, 0xbe, 0x01, 0x0a,
-	0x15, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x41, 0x74,
-	0x74, 0x72, 0x73, 0x52, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d,
-	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
-	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70,
-	0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x68, 0x61, 0x73,
-	0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0b, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x22, 0xbb, 0x01,
-	0x0a, 0x14, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x79, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a,
-	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x67,
-	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50,
-	0x61, 0x67, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f,
-	0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x6e,
-	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
-	0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x22, 0x31, 0x0a, 0x0d, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x75, 0x73, 0x65,
-	0x72, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xb4,
-	0x01, 0x0a, 0x12, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x21,
-	0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67,
-	0x65, 0x12, 0x22, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
-	0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78,
-	0x74, 0x50, 0x61, 0x67, 0x65, 0x22, 0xe8, 0x01, 0x0a, 0x0b, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69,
-	0x74, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17,
-	0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x49, 0x74, 0x65,
-	0x6d, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x22, 0x38, 0x0a, 0x0f, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x4d, 0x73, 0x67, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
-	0x65, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x42, 0x0a, 0x0e, 0x55, 0x73,
-	0x65, 0x72, 0x41, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x73, 0x12, 0x17, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x22, 0xa7,
-	0x02, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x73,
-	0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x6c, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x6c,
-	0x61, 0x73, 0x74, 0x73, 0x5f, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x73,
-	0x54, 0x6f, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a,
-	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x47, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6d,
-	0x6f, 0x57, 0x69, 0x74, 0x68, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75,
-	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x22, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0x90, 0x02, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x49, 0x74, 0x65, 0x6d, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70,
-	0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6c,
-	0x75, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x04, 0x69,
-	0x74, 0x65, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x39,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x22, 0xb5, 0x01, 0x0a, 0x11, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x65, 0x64, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
-	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14,
-	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61,
-	0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f,
-	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0b, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x22, 0xbb, 0x01, 0x0a,
-	0x16, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x49,
-	0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f,
-	0x6d, 0x6f, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61,
-	0x67, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70,
-	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65,
-	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68,
-	0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x22, 0x53, 0x0a, 0x15, 0x4c, 0x69,
-	0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x73,
-	0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73,
-	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22,
-	0x95, 0x03, 0x0a, 0x08, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x02, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x69,
-	0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x66, 0x69, 0x6f, 0x12, 0x10, 0x0a, 0x03,
-	0x74, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x65, 0x6c, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a,
-	0x0a, 0x08, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x05, 0x69, 0x74,
-	0x65, 0x6d, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
-	0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x84, 0x02, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
-	0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69,
-	0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d,
-	0x73, 0x67, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xb5,
-	0x01, 0x0a, 0x11, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4d,
-	0x73, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f,
-	0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12,
-	0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61,
-	0x67, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
-	0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x4e, 0x65,
-	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x32, 0xa9, 0x04, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12,
-	0x35, 0x0a, 0x0a, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x0f, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x16,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x49,
-	0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0e, 0x49, 0x74, 0x65, 0x6d, 0x41, 0x74,
-	0x74, 0x72, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x41, 0x74,
-	0x74, 0x72, 0x73, 0x52, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74,
-	0x65, 0x6d, 0x73, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x1a, 0x16, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x0a, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
-	0x49, 0x74, 0x65, 0x6d, 0x4d, 0x73, 0x67, 0x1a, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x75,
-	0x75, 0x69, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x27, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65,
-	0x6d, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x4d, 0x73, 0x67,
-	0x1a, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x73, 0x67, 0x12,
-	0x2c, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x11, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x57, 0x69, 0x74, 0x68, 0x55, 0x69, 0x64,
-	0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x28, 0x0a,
-	0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0d, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x4d, 0x73, 0x67, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65,
-	0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x0d, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x75, 0x73, 0x65,
-	0x72, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x47, 0x0a, 0x11, 0x6c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x6c,
-	0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x73,
-	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x10, 0x4c,
-	0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x42, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12,
-	0x19, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73,
-	0x42, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x75, 0x73, 0x65,
-	0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52,
-	0x65, 0x73, 0x32, 0xcf, 0x03, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12,
-	0x3b, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
-	0x73, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x1a, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65,
-	0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x0e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x11,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4d, 0x73,
-	0x67, 0x1a, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x73, 0x6c, 0x75, 0x67, 0x4d, 0x73, 0x67,
-	0x12, 0x3d, 0x0a, 0x0e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x12, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x65, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x12,
-	0x42, 0x0a, 0x15, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x73, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x79, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x73, 0x6c, 0x75, 0x67, 0x4d, 0x73,
-	0x67, 0x1a, 0x11, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
-	0x79, 0x4d, 0x73, 0x67, 0x12, 0x35, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61,
-	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x43, 0x61,
-	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x57, 0x69, 0x74, 0x68, 0x53, 0x6c, 0x75, 0x67, 0x1a, 0x0b,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x0e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x0d, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x2e, 0x73, 0x6c, 0x75, 0x67, 0x4d, 0x73, 0x67, 0x1a, 0x0b, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x13, 0x4c, 0x69, 0x73,
-	0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
-	0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x73, 0x6c, 0x75, 0x67, 0x4d, 0x73, 0x67, 0x1a,
-	0x13, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x32, 0xb6, 0x01, 0x0a, 0x08, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
-	0x65, 0x12, 0x35, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
-	0x65, 0x73, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x4d, 0x73,
-	0x67, 0x1a, 0x15, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x39, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x54,
-	0x6f, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x75, 0x73, 0x65,
-	0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x73,
-	0x1a, 0x11, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
-	0x4d, 0x73, 0x67, 0x12, 0x38, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f,
-	0x6d, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x75, 0x73, 0x65,
-	0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x73,
-	0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0x98, 0x03,
-	0x0a, 0x09, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0e, 0x4c,
-	0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0d, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f,
-	0x6d, 0x6f, 0x52, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52,
-	0x65, 0x73, 0x12, 0x30, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d,
-	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f,
-	0x6d, 0x6f, 0x4d, 0x73, 0x67, 0x1a, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x73, 0x6c, 0x75,
-	0x67, 0x4d, 0x73, 0x67, 0x12, 0x2d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x73, 0x6c, 0x75, 0x67,
-	0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
-	0x4d, 0x73, 0x67, 0x12, 0x33, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
-	0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x72,
-	0x6f, 0x6d, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x53, 0x6c, 0x75, 0x67, 0x1a, 0x0b, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0d, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x2e, 0x73, 0x6c, 0x75, 0x67, 0x4d, 0x73, 0x67, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65,
-	0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4f, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50,
-	0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1b, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x75, 0x73, 0x65,
-	0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
-	0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x32, 0xad, 0x02, 0x0a, 0x05, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x12, 0x34, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73,
-	0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a,
-	0x17, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65,
-	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0f,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x73, 0x67, 0x1a,
-	0x0e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x12,
-	0x2e, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x1a, 0x0f,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x73, 0x67, 0x12,
-	0x2a, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x1a, 0x0b,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x0b, 0x43,
-	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0f, 0x2e, 0x75, 0x73, 0x65,
-	0x72, 0x2e, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x73, 0x67, 0x1a, 0x0b, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4a, 0x4d, 0x55, 0x52, 0x76, 0x2f, 0x70, 0x61, 0x72,
-	0x2d, 0x70, 0x72, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x62, 0x2f, 0x75, 0x73, 0x65, 0x72,
-	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_api_pb_products_proto_rawDescOnce sync.Once
-	file_api_pb_products_proto_rawDescData = file_api_pb_products_proto_rawDesc
-)
-
-func file_api_pb_products_proto_rawDescGZIP() []byte {
-	file_api_pb_products_proto_rawDescOnce.Do(func() {
-		file_api_pb_products_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_pb_products_proto_rawDescData)
-	})
-	return file_api_pb_products_proto_rawDescData
-}
-
-var file_api_pb_products_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
-var file_api_pb_products_proto_goTypes = []any{
-	(*Empty)(nil),                  // 0: user.Empty
-	(*UuidMsg)(nil),                // 1: user.uuidMsg
-	(*SlugMsg)(nil),                // 2: user.slugMsg
-	(*Uint64Msg)(nil),              // 3: user.uint64Msg
-	(*ListReq)(nil),                // 4: user.ListReq
-	(*SearchReq)(nil),              // 5: user.SearchReq
-	(*CategoryMsg)(nil),            // 6: user.CategoryMsg
-	(*CategoryWithSlug)(nil),       // 7: user.CategoryWithSlug
-	(*Filter)(nil),                 // 8: user.Filter
-	(*ItemMsg)(nil),                // 9: user.ItemMsg
-	(*ItemMedia)(nil),              // 10: user.ItemMedia
-	(*ItemAttribute)(nil),          // 11: user.ItemAttribute
-	(*RelatedProduct)(nil),         // 12: user.RelatedProduct
-	(*ListItemsByLabelReq)(nil),    // 13: user.ListItemsByLabelReq
-	(*ListCategoryItemsReq)(nil),   // 14: user.listCategoryItemsReq
-	(*RelatedItemsList)(nil),       // 15: user.RelatedItemsList
-	(*ItemWithUid)(nil),            // 16: user.ItemWithUid
-	(*PaginatedItemRes)(nil),       // 17: user.PaginatedItemRes
-	(*PaginatedItemAttrsRes)(nil),  // 18: user.PaginatedItemAttrsRes
-	(*PaginatedCategoryRes)(nil),   // 19: user.PaginatedCategoryRes
-	(*FilterListRes)(nil),          // 20: user.FilterListRes
-	(*PaginatedFilterRes)(nil),     // 21: user.PaginatedFilterRes
-	(*FavoriteMsg)(nil),            // 22: user.FavoriteMsg
-	(*FavoriteListMsg)(nil),        // 23: user.FavoriteListMsg
-	(*UserAndItemIds)(nil),         // 24: user.UserAndItemIds
-	(*PromoMsg)(nil),               // 25: user.PromoMsg
-	(*PromoWithSlug)(nil),          // 26: user.PromoWithSlug
-	(*PromoItem)(nil),              // 27: user.PromoItem
-	(*PaginatedPromoRes)(nil),      // 28: user.PaginatedPromoRes
-	(*PaginatedPromoItemsRes)(nil), // 29: user.PaginatedPromoItemsRes
-	(*ListPromotionItemsReq)(nil),  // 30: user.ListPromotionItemsReq
-	(*OrderMsg)(nil),               // 31: user.OrderMsg
-	(*OrderItem)(nil),              // 32: user.OrderItem
-	(*PaginatedOrderRes)(nil),      // 33: user.PaginatedOrderRes
-	(*timestamppb.Timestamp)(nil),  // 34: google.protobuf.Timestamp
-}
-var file_api_pb_products_proto_depIdxs = []int32{
-	6,  // 0: user.CategoryMsg.parent_CategoryMsg:type_name -> user.CategoryMsg
-	6,  // 1: user.CategoryMsg.children:type_name -> user.CategoryMsg
-	9,  // 2: user.CategoryMsg.items:type_name -> user.ItemMsg
-	8,  // 3: user.CategoryMsg.filters:type_name -> user.Filter
-	34, // 4: user.CategoryMsg.created_at:type_name -> google.protobuf.Timestamp
-	34, // 5: user.CategoryMsg.updated_at:type_name -> google.protobuf.Timestamp
-	6,  // 6: user.CategoryWithSlug.category:type_name -> user.CategoryMsg
-	34, // 7: user.Filter.created_at:type_name -> google.protobuf.Timestamp
-	34, // 8: user.Filter.updated_at:type_name -> google.protobuf.Timestamp
-	6,  // 9: user.ItemMsg.categories:type_name -> user.CategoryMsg
-	10, // 10: user.ItemMsg.media:type_name -> user.ItemMedia
-	11, // 11: user.ItemMsg.attributes:type_name -> user.ItemAttribute
-	9,  // 12: user.ItemMsg.variants:type_name -> user.ItemMsg
-	12, // 13: user.ItemMsg.related_products:type_name -> user.RelatedProduct
-	34, // 14: user.ItemMsg.created_at:type_name -> google.protobuf.Timestamp
-	34, // 15: user.ItemMsg.updated_at:type_name -> google.protobuf.Timestamp
-	34, // 16: user.ItemMedia.created_at:type_name -> google.protobuf.Timestamp
-	34, // 17: user.ItemMedia.updated_at:type_name -> google.protobuf.Timestamp
-	34, // 18: user.ItemAttribute.created_at:type_name -> google.protobuf.Timestamp
-	34, // 19: user.ItemAttribute.updated_at:type_name -> google.protobuf.Timestamp
-	9,  // 20: user.RelatedProduct.related_item:type_name -> user.ItemMsg
-	34, // 21: user.RelatedProduct.created_at:type_name -> google.protobuf.Timestamp
-	34, // 22: user.RelatedProduct.updated_at:type_name -> google.protobuf.Timestamp
-	12, // 23: user.RelatedItemsList.items:type_name -> user.RelatedProduct
-	9,  // 24: user.ItemWithUid.item:type_name -> user.ItemMsg
-	9,  // 25: user.PaginatedItemRes.data:type_name -> user.ItemMsg
-	11, // 26: user.PaginatedItemAttrsRes.data:type_name -> user.ItemAttribute
-	6,  // 27: user.PaginatedCategoryRes.data:type_name -> user.CategoryMsg
-	8,  // 28: user.FilterListRes.data:type_name -> user.Filter
-	8,  // 29: user.PaginatedFilterRes.data:type_name -> user.Filter
-	9,  // 30: user.FavoriteMsg.item:type_name -> user.ItemMsg
-	34, // 31: user.FavoriteMsg.created_at:type_name -> google.protobuf.Timestamp
-	34, // 32: user.FavoriteMsg.updated_at:type_name -> google.protobuf.Timestamp
-	22, // 33: user.FavoriteListMsg.data:type_name -> user.FavoriteMsg
-	34, // 34: user.PromoMsg.lasts_to:type_name -> google.protobuf.Timestamp
-	34, // 35: user.PromoMsg.created_at:type_name -> google.protobuf.Timestamp
-	34, // 36: user.PromoMsg.updated_at:type_name -> google.protobuf.Timestamp
-	25, // 37: user.PromoWithSlug.data:type_name -> user.PromoMsg
-	9,  // 38: user.PromoItem.item:type_name -> user.ItemMsg
-	34, // 39: user.PromoItem.created_at:type_name -> google.protobuf.Timestamp
-	34, // 40: user.PromoItem.updated_at:type_name -> google.protobuf.Timestamp
-	25, // 41: user.PaginatedPromoRes.data:type_name -> user.PromoMsg
-	27, // 42: user.PaginatedPromoItemsRes.data:type_name -> user.PromoItem
-	32, // 43: user.OrderMsg.items:type_name -> user.OrderItem
-	34, // 44: user.OrderMsg.created_at:type_name -> google.protobuf.Timestamp
-	34, // 45: user.OrderMsg.updated_at:type_name -> google.protobuf.Timestamp
-	9,  // 46: user.OrderItem.item:type_name -> user.ItemMsg
-	34, // 47: user.OrderItem.created_at:type_name -> google.protobuf.Timestamp
-	34, // 48: user.OrderItem.updated_at:type_name -> google.protobuf.Timestamp
-	31, // 49: user.PaginatedOrderRes.data:type_name -> user.OrderMsg
-	5,  // 50: user.Item.ItemSearch:input_type -> user.SearchReq
-	5,  // 51: user.Item.ItemAttrSearch:input_type -> user.SearchReq
-	4,  // 52: user.Item.ListItems:input_type -> user.ListReq
-	9,  // 53: user.Item.CreateItem:input_type -> user.ItemMsg
-	1,  // 54: user.Item.GetItem:input_type -> user.uuidMsg
-	16, // 55: user.Item.UpdateItem:input_type -> user.ItemWithUid
-	1,  // 56: user.Item.DeleteItem:input_type -> user.uuidMsg
-	1,  // 57: user.Item.ListRelatedItems:input_type -> user.uuidMsg
-	14, // 58: user.Item.listCategoryItems:input_type -> user.listCategoryItemsReq
-	13, // 59: user.Item.ListItemsByLabel:input_type -> user.ListItemsByLabelReq
-	4,  // 60: user.Category.ListCategories:input_type -> user.ListReq
-	6,  // 61: user.Category.CreateCategory:input_type -> user.CategoryMsg
-	5,  // 62: user.Category.CategorySearch:input_type -> user.SearchReq
-	5,  // 63: user.Category.CategoryFiltersSearch:input_type -> user.SearchReq
-	2,  // 64: user.Category.GetCategory:input_type -> user.slugMsg
-	7,  // 65: user.Category.UpdateCategory:input_type -> user.CategoryWithSlug
-	2,  // 66: user.Category.DeleteCategory:input_type -> user.slugMsg
-	2,  // 67: user.Category.ListCategoryFilters:input_type -> user.slugMsg
-	1,  // 68: user.Favorite.ListFavorites:input_type -> user.uuidMsg
-	24, // 69: user.Favorite.AddToFavorites:input_type -> user.UserAndItemIds
-	24, // 70: user.Favorite.RemoveFromFavorites:input_type -> user.UserAndItemIds
-	4,  // 71: user.Promotion.ListPromotions:input_type -> user.ListReq
-	5,  // 72: user.Promotion.PromotionSearch:input_type -> user.SearchReq
-	25, // 73: user.Promotion.CreatePromotion:input_type -> user.PromoMsg
-	2,  // 74: user.Promotion.GetPromotion:input_type -> user.slugMsg
-	26, // 75: user.Promotion.UpdatePromotion:input_type -> user.PromoWithSlug
-	2,  // 76: user.Promotion.DeletePromotion:input_type -> user.slugMsg
-	30, // 77: user.Promotion.ListPromotionItems:input_type -> user.ListPromotionItemsReq
-	4,  // 78: user.Order.ListOrders:input_type -> user.ListReq
-	4,  // 79: user.Order.ListUserOrders:input_type -> user.ListReq
-	3,  // 80: user.Order.GetOrder:input_type -> user.uint64Msg
-	31, // 81: user.Order.CreateOrder:input_type -> user.OrderMsg
-	31, // 82: user.Order.UpdateOrder:input_type -> user.OrderMsg
-	3,  // 83: user.Order.CancelOrder:input_type -> user.uint64Msg
-	17, // 84: user.Item.ItemSearch:output_type -> user.PaginatedItemRes
-	18, // 85: user.Item.ItemAttrSearch:output_type -> user.PaginatedItemAttrsRes
-	17, // 86: user.Item.ListItems:output_type -> user.PaginatedItemRes
-	1,  // 87: user.Item.CreateItem:output_type -> user.uuidMsg
-	9,  // 88: user.Item.GetItem:output_type -> user.ItemMsg
-	0,  // 89: user.Item.UpdateItem:output_type -> user.Empty
-	0,  // 90: user.Item.DeleteItem:output_type -> user.Empty
-	15, // 91: user.Item.ListRelatedItems:output_type -> user.RelatedItemsList
-	17, // 92: user.Item.listCategoryItems:output_type -> user.PaginatedItemRes
-	17, // 93: user.Item.ListItemsByLabel:output_type -> user.PaginatedItemRes
-	19, // 94: user.Category.ListCategories:output_type -> user.PaginatedCategoryRes
-	2,  // 95: user.Category.CreateCategory:output_type -> user.slugMsg
-	19, // 96: user.Category.CategorySearch:output_type -> user.PaginatedCategoryRes
-	21, // 97: user.Category.CategoryFiltersSearch:output_type -> user.PaginatedFilterRes
-	6,  // 98: user.Category.GetCategory:output_type -> user.CategoryMsg
-	0,  // 99: user.Category.UpdateCategory:output_type -> user.Empty
-	0,  // 100: user.Category.DeleteCategory:output_type -> user.Empty
-	20, // 101: user.Category.ListCategoryFilters:output_type -> user.FilterListRes
-	23, // 102: user.Favorite.ListFavorites:output_type -> user.FavoriteListMsg
-	22, // 103: user.Favorite.AddToFavorites:output_type -> user.FavoriteMsg
-	0,  // 104: user.Favorite.RemoveFromFavorites:output_type -> user.Empty
-	28, // 105: user.Promotion.ListPromotions:output_type -> user.PaginatedPromoRes
-	28, // 106: user.Promotion.PromotionSearch:output_type -> user.PaginatedPromoRes
-	2,  // 107: user.Promotion.CreatePromotion:output_type -> user.slugMsg
-	25, // 108: user.Promotion.GetPromotion:output_type -> user.PromoMsg
-	0,  // 109: user.Promotion.UpdatePromotion:output_type -> user.Empty
-	0,  // 110: user.Promotion.DeletePromotion:output_type -> user.Empty
-	29, // 111: user.Promotion.ListPromotionItems:output_type -> user.PaginatedPromoItemsRes
-	33, // 112: user.Order.ListOrders:output_type -> user.PaginatedOrderRes
-	33, // 113: user.Order.ListUserOrders:output_type -> user.PaginatedOrderRes
-	31, // 114: user.Order.GetOrder:output_type -> user.OrderMsg
-	3,  // 115: user.Order.CreateOrder:output_type -> user.uint64Msg
-	0,  // 116: user.Order.UpdateOrder:output_type -> user.Empty
-	0,  // 117: user.Order.CancelOrder:output_type -> user.Empty
-	84, // [84:118] is the sub-list for method output_type
-	50, // [50:84] is the sub-list for method input_type
-	50, // [50:50] is the sub-list for extension type_name
-	50, // [50:50] is the sub-list for extension extendee
-	0,  // [0:50] is the sub-list for field type_name
-}
-
-func init() { file_api_pb_products_proto_init() }
-func file_api_pb_products_proto_init() {
-	if File_api_pb_products_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_api_pb_products_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*Empty); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserAndItemIds.ProtoReflect.Descriptor instead.
+func (*UserAndItemIds) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UserAndItemIds) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserAndItemIds) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+type FavoriteNotificationsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId          string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ItemId          string `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	NotifyPriceDrop bool   `protobuf:"varint,3,opt,name=notify_price_drop,json=notifyPriceDrop,proto3" json:"notify_price_drop,omitempty"`
+	NotifyInStock   bool   `protobuf:"varint,4,opt,name=notify_in_stock,json=notifyInStock,proto3" json:"notify_in_stock,omitempty"`
+}
+
+func (x *FavoriteNotificationsReq) Reset() {
+	*x = FavoriteNotificationsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FavoriteNotificationsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoriteNotificationsReq) ProtoMessage() {}
+
+func (x *FavoriteNotificationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoriteNotificationsReq.ProtoReflect.Descriptor instead.
+func (*FavoriteNotificationsReq) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *FavoriteNotificationsReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FavoriteNotificationsReq) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *FavoriteNotificationsReq) GetNotifyPriceDrop() bool {
+	if x != nil {
+		return x.NotifyPriceDrop
+	}
+	return false
+}
+
+func (x *FavoriteNotificationsReq) GetNotifyInStock() bool {
+	if x != nil {
+		return x.NotifyInStock
+	}
+	return false
+}
+
+type FavoriteCollectionMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint64                       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId     string                       `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name       string                       `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ShareToken string                       `protobuf:"bytes,4,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
+	Items      []*FavoriteCollectionItemMsg `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	CreatedAt  *timestamppb.Timestamp       `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp       `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *FavoriteCollectionMsg) Reset() {
+	*x = FavoriteCollectionMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FavoriteCollectionMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoriteCollectionMsg) ProtoMessage() {}
+
+func (x *FavoriteCollectionMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoriteCollectionMsg.ProtoReflect.Descriptor instead.
+func (*FavoriteCollectionMsg) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *FavoriteCollectionMsg) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FavoriteCollectionMsg) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FavoriteCollectionMsg) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FavoriteCollectionMsg) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+func (x *FavoriteCollectionMsg) GetItems() []*FavoriteCollectionItemMsg {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *FavoriteCollectionMsg) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *FavoriteCollectionMsg) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type FavoriteCollectionItemMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CollectionId uint64                 `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	ItemId       string                 `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Note         string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	Item         *ItemMsg               `protobuf:"bytes,5,opt,name=item,proto3" json:"item,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *FavoriteCollectionItemMsg) Reset() {
+	*x = FavoriteCollectionItemMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FavoriteCollectionItemMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoriteCollectionItemMsg) ProtoMessage() {}
+
+func (x *FavoriteCollectionItemMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoriteCollectionItemMsg.ProtoReflect.Descriptor instead.
+func (*FavoriteCollectionItemMsg) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *FavoriteCollectionItemMsg) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FavoriteCollectionItemMsg) GetCollectionId() uint64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *FavoriteCollectionItemMsg) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *FavoriteCollectionItemMsg) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *FavoriteCollectionItemMsg) GetItem() *ItemMsg {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *FavoriteCollectionItemMsg) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *FavoriteCollectionItemMsg) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type FavoriteCollectionListMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*FavoriteCollectionMsg `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *FavoriteCollectionListMsg) Reset() {
+	*x = FavoriteCollectionListMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FavoriteCollectionListMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoriteCollectionListMsg) ProtoMessage() {}
+
+func (x *FavoriteCollectionListMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoriteCollectionListMsg.ProtoReflect.Descriptor instead.
+func (*FavoriteCollectionListMsg) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *FavoriteCollectionListMsg) GetData() []*FavoriteCollectionMsg {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type FavoriteCollectionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id     uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *FavoriteCollectionReq) Reset() {
+	*x = FavoriteCollectionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FavoriteCollectionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoriteCollectionReq) ProtoMessage() {}
+
+func (x *FavoriteCollectionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoriteCollectionReq.ProtoReflect.Descriptor instead.
+func (*FavoriteCollectionReq) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *FavoriteCollectionReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FavoriteCollectionReq) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ShareTokenMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *ShareTokenMsg) Reset() {
+	*x = ShareTokenMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareTokenMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareTokenMsg) ProtoMessage() {}
+
+func (x *ShareTokenMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareTokenMsg.ProtoReflect.Descriptor instead.
+func (*ShareTokenMsg) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ShareTokenMsg) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type PromoMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slug        string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Src         string                 `protobuf:"bytes,4,opt,name=src,proto3" json:"src,omitempty"`
+	Alt         string                 `protobuf:"bytes,5,opt,name=alt,proto3" json:"alt,omitempty"`
+	LastsTo     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=lasts_to,json=lastsTo,proto3" json:"lasts_to,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Version     int32                  `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *PromoMsg) Reset() {
+	*x = PromoMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoMsg) ProtoMessage() {}
+
+func (x *PromoMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoMsg.ProtoReflect.Descriptor instead.
+func (*PromoMsg) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PromoMsg) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *PromoMsg) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PromoMsg) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PromoMsg) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	return ""
+}
+
+func (x *PromoMsg) GetAlt() string {
+	if x != nil {
+		return x.Alt
+	}
+	return ""
+}
+
+func (x *PromoMsg) GetLastsTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastsTo
+	}
+	return nil
+}
+
+func (x *PromoMsg) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PromoMsg) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *PromoMsg) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type PromoWithSlug struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slug string    `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Data *PromoMsg `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// When set only the named fields are updated.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (x *PromoWithSlug) Reset() {
+	*x = PromoWithSlug{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoWithSlug) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoWithSlug) ProtoMessage() {}
+
+func (x *PromoWithSlug) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoWithSlug.ProtoReflect.Descriptor instead.
+func (*PromoWithSlug) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PromoWithSlug) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *PromoWithSlug) GetData() *PromoMsg {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PromoWithSlug) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type PromoItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Discount      uint32                 `protobuf:"varint,2,opt,name=discount,proto3" json:"discount,omitempty"`
+	PromotionSlug string                 `protobuf:"bytes,3,opt,name=promotion_slug,json=promotionSlug,proto3" json:"promotion_slug,omitempty"`
+	ItemId        string                 `protobuf:"bytes,4,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Item          *ItemMsg               `protobuf:"bytes,5,opt,name=item,proto3" json:"item,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *PromoItem) Reset() {
+	*x = PromoItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoItem) ProtoMessage() {}
+
+func (x *PromoItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoItem.ProtoReflect.Descriptor instead.
+func (*PromoItem) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PromoItem) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PromoItem) GetDiscount() uint32 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *PromoItem) GetPromotionSlug() string {
+	if x != nil {
+		return x.PromotionSlug
+	}
+	return ""
+}
+
+func (x *PromoItem) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *PromoItem) GetItem() *ItemMsg {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *PromoItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PromoItem) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type PaginatedPromoRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data        []*PromoMsg `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Count       int64       `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	TotalPages  int64       `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	CurrentPage int64       `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	HasNextPage bool        `protobuf:"varint,5,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+}
+
+func (x *PaginatedPromoRes) Reset() {
+	*x = PaginatedPromoRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaginatedPromoRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaginatedPromoRes) ProtoMessage() {}
+
+func (x *PaginatedPromoRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaginatedPromoRes.ProtoReflect.Descriptor instead.
+func (*PaginatedPromoRes) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PaginatedPromoRes) GetData() []*PromoMsg {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PaginatedPromoRes) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PaginatedPromoRes) GetTotalPages() int64 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *PaginatedPromoRes) GetCurrentPage() int64 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *PaginatedPromoRes) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+type PaginatedPromoItemsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data        []*PromoItem `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Count       int64        `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	TotalPages  int64        `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	CurrentPage int64        `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	HasNextPage bool         `protobuf:"varint,5,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+}
+
+func (x *PaginatedPromoItemsRes) Reset() {
+	*x = PaginatedPromoItemsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaginatedPromoItemsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaginatedPromoItemsRes) ProtoMessage() {}
+
+func (x *PaginatedPromoItemsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaginatedPromoItemsRes.ProtoReflect.Descriptor instead.
+func (*PaginatedPromoItemsRes) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PaginatedPromoItemsRes) GetData() []*PromoItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PaginatedPromoItemsRes) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PaginatedPromoItemsRes) GetTotalPages() int64 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *PaginatedPromoItemsRes) GetCurrentPage() int64 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *PaginatedPromoItemsRes) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+type ListPromotionItemsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slug string `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Page uint32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Size uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *ListPromotionItemsReq) Reset() {
+	*x = ListPromotionItemsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPromotionItemsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromotionItemsReq) ProtoMessage() {}
+
+func (x *ListPromotionItemsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromotionItemsReq.ProtoReflect.Descriptor instead.
+func (*ListPromotionItemsReq) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListPromotionItemsReq) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *ListPromotionItemsReq) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPromotionItemsReq) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type OrderMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Total         *Money                 `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	Fio           string                 `protobuf:"bytes,4,opt,name=fio,proto3" json:"fio,omitempty"`
+	Tel           string                 `protobuf:"bytes,5,opt,name=tel,proto3" json:"tel,omitempty"`
+	Email         string                 `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	Address       string                 `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	Delivery      string                 `protobuf:"bytes,8,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	PaymentMethod string                 `protobuf:"bytes,9,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	UserId        string                 `protobuf:"bytes,10,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Items         []*OrderItem           `protobuf:"bytes,11,rep,name=items,proto3" json:"items,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Version       int32                  `protobuf:"varint,14,opt,name=version,proto3" json:"version,omitempty"`
+	// Read by UpdateOrder only, when set only the named fields are updated.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,15,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (x *OrderMsg) Reset() {
+	*x = OrderMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderMsg) ProtoMessage() {}
+
+func (x *OrderMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderMsg.ProtoReflect.Descriptor instead.
+func (*OrderMsg) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *OrderMsg) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OrderMsg) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OrderMsg) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *OrderMsg) GetFio() string {
+	if x != nil {
+		return x.Fio
+	}
+	return ""
+}
+
+func (x *OrderMsg) GetTel() string {
+	if x != nil {
+		return x.Tel
+	}
+	return ""
+}
+
+func (x *OrderMsg) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *OrderMsg) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *OrderMsg) GetDelivery() string {
+	if x != nil {
+		return x.Delivery
+	}
+	return ""
+}
+
+func (x *OrderMsg) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *OrderMsg) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OrderMsg) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *OrderMsg) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OrderMsg) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *OrderMsg) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *OrderMsg) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type OrderItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Quantity  uint32                 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	OrderId   uint64                 `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ItemId    string                 `protobuf:"bytes,4,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Item      *ItemMsg               `protobuf:"bytes,5,opt,name=item,proto3" json:"item,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *OrderItem) Reset() {
+	*x = OrderItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItem) ProtoMessage() {}
+
+func (x *OrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
+func (*OrderItem) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *OrderItem) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OrderItem) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderItem) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *OrderItem) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *OrderItem) GetItem() *ItemMsg {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *OrderItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OrderItem) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type PaginatedOrderRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data        []*OrderMsg `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Count       int64       `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	TotalPages  int64       `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	CurrentPage int64       `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	HasNextPage bool        `protobuf:"varint,5,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+}
+
+func (x *PaginatedOrderRes) Reset() {
+	*x = PaginatedOrderRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaginatedOrderRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaginatedOrderRes) ProtoMessage() {}
+
+func (x *PaginatedOrderRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaginatedOrderRes.ProtoReflect.Descriptor instead.
+func (*PaginatedOrderRes) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PaginatedOrderRes) GetData() []*OrderMsg {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PaginatedOrderRes) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PaginatedOrderRes) GetTotalPages() int64 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *PaginatedOrderRes) GetCurrentPage() int64 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *PaginatedOrderRes) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+type PriceListMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Slug         string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Name         string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Currency     string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	BaseCurrency string                 `protobuf:"bytes,5,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	Rate         float64                `protobuf:"fixed64,6,opt,name=rate,proto3" json:"rate,omitempty"`
+	Rounding     string                 `protobuf:"bytes,7,opt,name=rounding,proto3" json:"rounding,omitempty"`
+	RoundingStep int64                  `protobuf:"varint,8,opt,name=rounding_step,json=roundingStep,proto3" json:"rounding_step,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *PriceListMsg) Reset() {
+	*x = PriceListMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceListMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceListMsg) ProtoMessage() {}
+
+func (x *PriceListMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceListMsg.ProtoReflect.Descriptor instead.
+func (*PriceListMsg) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PriceListMsg) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PriceListMsg) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *PriceListMsg) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PriceListMsg) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PriceListMsg) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *PriceListMsg) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *PriceListMsg) GetRounding() string {
+	if x != nil {
+		return x.Rounding
+	}
+	return ""
+}
+
+func (x *PriceListMsg) GetRoundingStep() int64 {
+	if x != nil {
+		return x.RoundingStep
+	}
+	return 0
+}
+
+func (x *PriceListMsg) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PriceListMsg) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type PriceListListRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*PriceListMsg `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *PriceListListRes) Reset() {
+	*x = PriceListListRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceListListRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceListListRes) ProtoMessage() {}
+
+func (x *PriceListListRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceListListRes.ProtoReflect.Descriptor instead.
+func (*PriceListListRes) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *PriceListListRes) GetData() []*PriceListMsg {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type PriceListItemMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PriceListId uint64 `protobuf:"varint,1,opt,name=price_list_id,json=priceListId,proto3" json:"price_list_id,omitempty"`
+	ItemId      string `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Price       *Money `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *PriceListItemMsg) Reset() {
+	*x = PriceListItemMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceListItemMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceListItemMsg) ProtoMessage() {}
+
+func (x *PriceListItemMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceListItemMsg.ProtoReflect.Descriptor instead.
+func (*PriceListItemMsg) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *PriceListItemMsg) GetPriceListId() uint64 {
+	if x != nil {
+		return x.PriceListId
+	}
+	return 0
+}
+
+func (x *PriceListItemMsg) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *PriceListItemMsg) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type ListPriceListItemsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slug string `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Page uint64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Size uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *ListPriceListItemsReq) Reset() {
+	*x = ListPriceListItemsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPriceListItemsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceListItemsReq) ProtoMessage() {}
+
+func (x *ListPriceListItemsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceListItemsReq.ProtoReflect.Descriptor instead.
+func (*ListPriceListItemsReq) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListPriceListItemsReq) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *ListPriceListItemsReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPriceListItemsReq) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type PaginatedPriceListItemsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data        []*PriceListItemMsg `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Count       int64               `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	TotalPages  int64               `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	CurrentPage int64               `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	HasNextPage bool                `protobuf:"varint,5,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+}
+
+func (x *PaginatedPriceListItemsRes) Reset() {
+	*x = PaginatedPriceListItemsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaginatedPriceListItemsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaginatedPriceListItemsRes) ProtoMessage() {}
+
+func (x *PaginatedPriceListItemsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaginatedPriceListItemsRes.ProtoReflect.Descriptor instead.
+func (*PaginatedPriceListItemsRes) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *PaginatedPriceListItemsRes) GetData() []*PriceListItemMsg {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PaginatedPriceListItemsRes) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PaginatedPriceListItemsRes) GetTotalPages() int64 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *PaginatedPriceListItemsRes) GetCurrentPage() int64 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *PaginatedPriceListItemsRes) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+type SetPriceListItemsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slug  string              `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Items []*PriceListItemMsg `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *SetPriceListItemsReq) Reset() {
+	*x = SetPriceListItemsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPriceListItemsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPriceListItemsReq) ProtoMessage() {}
+
+func (x *SetPriceListItemsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPriceListItemsReq.ProtoReflect.Descriptor instead.
+func (*SetPriceListItemsReq) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SetPriceListItemsReq) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *SetPriceListItemsReq) GetItems() []*PriceListItemMsg {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type PriceListItemReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slug   string `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	ItemId string `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+}
+
+func (x *PriceListItemReq) Reset() {
+	*x = PriceListItemReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceListItemReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceListItemReq) ProtoMessage() {}
+
+func (x *PriceListItemReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceListItemReq.ProtoReflect.Descriptor instead.
+func (*PriceListItemReq) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *PriceListItemReq) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *PriceListItemReq) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+type CustomerGroupMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	PriceListId   uint64                 `protobuf:"varint,4,opt,name=price_list_id,json=priceListId,proto3" json:"price_list_id,omitempty"`
+	PriceListSlug string                 `protobuf:"bytes,5,opt,name=price_list_slug,json=priceListSlug,proto3" json:"price_list_slug,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *CustomerGroupMsg) Reset() {
+	*x = CustomerGroupMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomerGroupMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerGroupMsg) ProtoMessage() {}
+
+func (x *CustomerGroupMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerGroupMsg.ProtoReflect.Descriptor instead.
+func (*CustomerGroupMsg) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CustomerGroupMsg) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CustomerGroupMsg) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *CustomerGroupMsg) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CustomerGroupMsg) GetPriceListId() uint64 {
+	if x != nil {
+		return x.PriceListId
+	}
+	return 0
+}
+
+func (x *CustomerGroupMsg) GetPriceListSlug() string {
+	if x != nil {
+		return x.PriceListSlug
+	}
+	return ""
+}
+
+func (x *CustomerGroupMsg) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CustomerGroupMsg) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CustomerGroupListRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*CustomerGroupMsg `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *CustomerGroupListRes) Reset() {
+	*x = CustomerGroupListRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomerGroupListRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerGroupListRes) ProtoMessage() {}
+
+func (x *CustomerGroupListRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerGroupListRes.ProtoReflect.Descriptor instead.
+func (*CustomerGroupListRes) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CustomerGroupListRes) GetData() []*CustomerGroupMsg {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type QuantityBreakMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MinQuantity     uint32 `protobuf:"varint,2,opt,name=min_quantity,json=minQuantity,proto3" json:"min_quantity,omitempty"`
+	Discount        uint32 `protobuf:"varint,3,opt,name=discount,proto3" json:"discount,omitempty"`
+	Price           *Money `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	ItemId          string `protobuf:"bytes,5,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	CustomerGroupId uint64 `protobuf:"varint,6,opt,name=customer_group_id,json=customerGroupId,proto3" json:"customer_group_id,omitempty"`
+}
+
+func (x *QuantityBreakMsg) Reset() {
+	*x = QuantityBreakMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuantityBreakMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuantityBreakMsg) ProtoMessage() {}
+
+func (x *QuantityBreakMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuantityBreakMsg.ProtoReflect.Descriptor instead.
+func (*QuantityBreakMsg) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *QuantityBreakMsg) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *QuantityBreakMsg) GetMinQuantity() uint32 {
+	if x != nil {
+		return x.MinQuantity
+	}
+	return 0
+}
+
+func (x *QuantityBreakMsg) GetDiscount() uint32 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *QuantityBreakMsg) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *QuantityBreakMsg) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *QuantityBreakMsg) GetCustomerGroupId() uint64 {
+	if x != nil {
+		return x.CustomerGroupId
+	}
+	return 0
+}
+
+type QuantityBreakListRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*QuantityBreakMsg `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *QuantityBreakListRes) Reset() {
+	*x = QuantityBreakListRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuantityBreakListRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuantityBreakListRes) ProtoMessage() {}
+
+func (x *QuantityBreakListRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuantityBreakListRes.ProtoReflect.Descriptor instead.
+func (*QuantityBreakListRes) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *QuantityBreakListRes) GetData() []*QuantityBreakMsg {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SetQuantityBreaksReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId string              `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Breaks []*QuantityBreakMsg `protobuf:"bytes,2,rep,name=breaks,proto3" json:"breaks,omitempty"`
+}
+
+func (x *SetQuantityBreaksReq) Reset() {
+	*x = SetQuantityBreaksReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetQuantityBreaksReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetQuantityBreaksReq) ProtoMessage() {}
+
+func (x *SetQuantityBreaksReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetQuantityBreaksReq.ProtoReflect.Descriptor instead.
+func (*SetQuantityBreaksReq) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SetQuantityBreaksReq) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *SetQuantityBreaksReq) GetBreaks() []*QuantityBreakMsg {
+	if x != nil {
+		return x.Breaks
+	}
+	return nil
+}
+
+type ItemMediaInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId    string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Alt       string `protobuf:"bytes,2,opt,name=alt,proto3" json:"alt,omitempty"`
+	IsPrimary bool   `protobuf:"varint,3,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+}
+
+func (x *ItemMediaInfo) Reset() {
+	*x = ItemMediaInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ItemMediaInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemMediaInfo) ProtoMessage() {}
+
+func (x *ItemMediaInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemMediaInfo.ProtoReflect.Descriptor instead.
+func (*ItemMediaInfo) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ItemMediaInfo) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *ItemMediaInfo) GetAlt() string {
+	if x != nil {
+		return x.Alt
+	}
+	return ""
+}
+
+func (x *ItemMediaInfo) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+type UploadItemMediaReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*UploadItemMediaReq_Info
+	//	*UploadItemMediaReq_Chunk
+	Data isUploadItemMediaReq_Data `protobuf_oneof:"data"`
+}
+
+func (x *UploadItemMediaReq) Reset() {
+	*x = UploadItemMediaReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadItemMediaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadItemMediaReq) ProtoMessage() {}
+
+func (x *UploadItemMediaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadItemMediaReq.ProtoReflect.Descriptor instead.
+func (*UploadItemMediaReq) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{65}
+}
+
+func (m *UploadItemMediaReq) GetData() isUploadItemMediaReq_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *UploadItemMediaReq) GetInfo() *ItemMediaInfo {
+	if x, ok := x.GetData().(*UploadItemMediaReq_Info); ok {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *UploadItemMediaReq) GetChunk() []byte {
+	if x, ok := x.GetData().(*UploadItemMediaReq_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+type isUploadItemMediaReq_Data interface {
+	isUploadItemMediaReq_Data()
+}
+
+type UploadItemMediaReq_Info struct {
+	Info *ItemMediaInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type UploadItemMediaReq_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadItemMediaReq_Info) isUploadItemMediaReq_Data() {}
+
+func (*UploadItemMediaReq_Chunk) isUploadItemMediaReq_Data() {}
+
+type ItemMediaList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*ItemMedia `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ItemMediaList) Reset() {
+	*x = ItemMediaList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ItemMediaList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemMediaList) ProtoMessage() {}
+
+func (x *ItemMediaList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemMediaList.ProtoReflect.Descriptor instead.
+func (*ItemMediaList) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ItemMediaList) GetData() []*ItemMedia {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ReorderItemMediaReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId string   `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Ids    []uint64 `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *ReorderItemMediaReq) Reset() {
+	*x = ReorderItemMediaReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReorderItemMediaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderItemMediaReq) ProtoMessage() {}
+
+func (x *ReorderItemMediaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderItemMediaReq.ProtoReflect.Descriptor instead.
+func (*ReorderItemMediaReq) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ReorderItemMediaReq) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *ReorderItemMediaReq) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type ItemMediaReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Id     uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ItemMediaReq) Reset() {
+	*x = ItemMediaReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ItemMediaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemMediaReq) ProtoMessage() {}
+
+func (x *ItemMediaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemMediaReq.ProtoReflect.Descriptor instead.
+func (*ItemMediaReq) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ItemMediaReq) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *ItemMediaReq) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AttributeMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Unit          string                 `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`
+	AllowedValues []string               `protobuf:"bytes,6,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *AttributeMsg) Reset() {
+	*x = AttributeMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttributeMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeMsg) ProtoMessage() {}
+
+func (x *AttributeMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeMsg.ProtoReflect.Descriptor instead.
+func (*AttributeMsg) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *AttributeMsg) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AttributeMsg) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *AttributeMsg) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AttributeMsg) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AttributeMsg) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *AttributeMsg) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
+func (x *AttributeMsg) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AttributeMsg) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type AttributeListRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*AttributeMsg `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *AttributeListRes) Reset() {
+	*x = AttributeListRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttributeListRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeListRes) ProtoMessage() {}
+
+func (x *AttributeListRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeListRes.ProtoReflect.Descriptor instead.
+func (*AttributeListRes) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *AttributeListRes) GetData() []*AttributeMsg {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CategoryAttributeMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CategorySlug string        `protobuf:"bytes,1,opt,name=category_slug,json=categorySlug,proto3" json:"category_slug,omitempty"`
+	AttributeId  uint64        `protobuf:"varint,2,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+	Attribute    *AttributeMsg `protobuf:"bytes,3,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	IsRequired   bool          `protobuf:"varint,4,opt,name=is_required,json=isRequired,proto3" json:"is_required,omitempty"`
+	IsFilterable bool          `protobuf:"varint,5,opt,name=is_filterable,json=isFilterable,proto3" json:"is_filterable,omitempty"`
+	Position     int32         `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (x *CategoryAttributeMsg) Reset() {
+	*x = CategoryAttributeMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CategoryAttributeMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryAttributeMsg) ProtoMessage() {}
+
+func (x *CategoryAttributeMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryAttributeMsg.ProtoReflect.Descriptor instead.
+func (*CategoryAttributeMsg) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CategoryAttributeMsg) GetCategorySlug() string {
+	if x != nil {
+		return x.CategorySlug
+	}
+	return ""
+}
+
+func (x *CategoryAttributeMsg) GetAttributeId() uint64 {
+	if x != nil {
+		return x.AttributeId
+	}
+	return 0
+}
+
+func (x *CategoryAttributeMsg) GetAttribute() *AttributeMsg {
+	if x != nil {
+		return x.Attribute
+	}
+	return nil
+}
+
+func (x *CategoryAttributeMsg) GetIsRequired() bool {
+	if x != nil {
+		return x.IsRequired
+	}
+	return false
+}
+
+func (x *CategoryAttributeMsg) GetIsFilterable() bool {
+	if x != nil {
+		return x.IsFilterable
+	}
+	return false
+}
+
+func (x *CategoryAttributeMsg) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type CategoryAttributeListRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*CategoryAttributeMsg `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *CategoryAttributeListRes) Reset() {
+	*x = CategoryAttributeListRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CategoryAttributeListRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryAttributeListRes) ProtoMessage() {}
+
+func (x *CategoryAttributeListRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryAttributeListRes.ProtoReflect.Descriptor instead.
+func (*CategoryAttributeListRes) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CategoryAttributeListRes) GetData() []*CategoryAttributeMsg {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SetCategoryAttributesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slug       string                  `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Attributes []*CategoryAttributeMsg `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *SetCategoryAttributesReq) Reset() {
+	*x = SetCategoryAttributesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCategoryAttributesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCategoryAttributesReq) ProtoMessage() {}
+
+func (x *SetCategoryAttributesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCategoryAttributesReq.ProtoReflect.Descriptor instead.
+func (*SetCategoryAttributesReq) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SetCategoryAttributesReq) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *SetCategoryAttributesReq) GetAttributes() []*CategoryAttributeMsg {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type LabelMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Title     string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Color     string                 `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`
+	Priority  int32                  `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	Rule      string                 `protobuf:"bytes,6,opt,name=rule,proto3" json:"rule,omitempty"`
+	RuleParam int32                  `protobuf:"varint,7,opt,name=rule_param,json=ruleParam,proto3" json:"rule_param,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *LabelMsg) Reset() {
+	*x = LabelMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LabelMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabelMsg) ProtoMessage() {}
+
+func (x *LabelMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabelMsg.ProtoReflect.Descriptor instead.
+func (*LabelMsg) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *LabelMsg) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LabelMsg) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LabelMsg) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LabelMsg) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *LabelMsg) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *LabelMsg) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *LabelMsg) GetRuleParam() int32 {
+	if x != nil {
+		return x.RuleParam
+	}
+	return 0
+}
+
+func (x *LabelMsg) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LabelMsg) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type LabelListRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*LabelMsg `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *LabelListRes) Reset() {
+	*x = LabelListRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LabelListRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabelListRes) ProtoMessage() {}
+
+func (x *LabelListRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabelListRes.ProtoReflect.Descriptor instead.
+func (*LabelListRes) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *LabelListRes) GetData() []*LabelMsg {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UpdateLabelReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Label *LabelMsg `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (x *UpdateLabelReq) Reset() {
+	*x = UpdateLabelReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateLabelReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLabelReq) ProtoMessage() {}
+
+func (x *UpdateLabelReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLabelReq.ProtoReflect.Descriptor instead.
+func (*UpdateLabelReq) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *UpdateLabelReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateLabelReq) GetLabel() *LabelMsg {
+	if x != nil {
+		return x.Label
+	}
+	return nil
+}
+
+type SetItemLabelsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid   string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Labels []string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty"`
+}
+
+func (x *SetItemLabelsReq) Reset() {
+	*x = SetItemLabelsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetItemLabelsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetItemLabelsReq) ProtoMessage() {}
+
+func (x *SetItemLabelsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetItemLabelsReq.ProtoReflect.Descriptor instead.
+func (*SetItemLabelsReq) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SetItemLabelsReq) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *SetItemLabelsReq) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type ReviewMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ItemId         string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrderId        uint64                 `protobuf:"varint,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Rating         int32                  `protobuf:"varint,5,opt,name=rating,proto3" json:"rating,omitempty"`
+	Text           string                 `protobuf:"bytes,6,opt,name=text,proto3" json:"text,omitempty"`
+	Pros           string                 `protobuf:"bytes,7,opt,name=pros,proto3" json:"pros,omitempty"`
+	Cons           string                 `protobuf:"bytes,8,opt,name=cons,proto3" json:"cons,omitempty"`
+	Media          []*ReviewMediaMsg      `protobuf:"bytes,9,rep,name=media,proto3" json:"media,omitempty"`
+	Status         string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	ModerationNote string                 `protobuf:"bytes,11,opt,name=moderation_note,json=moderationNote,proto3" json:"moderation_note,omitempty"`
+	ModeratedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=moderated_at,json=moderatedAt,proto3" json:"moderated_at,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *ReviewMsg) Reset() {
+	*x = ReviewMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewMsg) ProtoMessage() {}
+
+func (x *ReviewMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewMsg.ProtoReflect.Descriptor instead.
+func (*ReviewMsg) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ReviewMsg) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReviewMsg) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *ReviewMsg) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReviewMsg) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *ReviewMsg) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *ReviewMsg) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ReviewMsg) GetPros() string {
+	if x != nil {
+		return x.Pros
+	}
+	return ""
+}
+
+func (x *ReviewMsg) GetCons() string {
+	if x != nil {
+		return x.Cons
+	}
+	return ""
+}
+
+func (x *ReviewMsg) GetMedia() []*ReviewMediaMsg {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *ReviewMsg) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReviewMsg) GetModerationNote() string {
+	if x != nil {
+		return x.ModerationNote
+	}
+	return ""
+}
+
+func (x *ReviewMsg) GetModeratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ModeratedAt
+	}
+	return nil
+}
+
+func (x *ReviewMsg) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ReviewMsg) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ReviewMediaMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReviewId    uint64                 `protobuf:"varint,2,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Src         string                 `protobuf:"bytes,3,opt,name=src,proto3" json:"src,omitempty"`
+	ContentType string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size        int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	Width       int32                  `protobuf:"varint,6,opt,name=width,proto3" json:"width,omitempty"`
+	Height      int32                  `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	Position    int32                  `protobuf:"varint,8,opt,name=position,proto3" json:"position,omitempty"`
+	Variants    []*MediaVariantMsg     `protobuf:"bytes,9,rep,name=variants,proto3" json:"variants,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ReviewMediaMsg) Reset() {
+	*x = ReviewMediaMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewMediaMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewMediaMsg) ProtoMessage() {}
+
+func (x *ReviewMediaMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewMediaMsg.ProtoReflect.Descriptor instead.
+func (*ReviewMediaMsg) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ReviewMediaMsg) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReviewMediaMsg) GetReviewId() uint64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *ReviewMediaMsg) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	return ""
+}
+
+func (x *ReviewMediaMsg) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ReviewMediaMsg) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ReviewMediaMsg) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ReviewMediaMsg) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ReviewMediaMsg) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ReviewMediaMsg) GetVariants() []*MediaVariantMsg {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *ReviewMediaMsg) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListItemReviewsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Page uint64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Size uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *ListItemReviewsReq) Reset() {
+	*x = ListItemReviewsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListItemReviewsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItemReviewsReq) ProtoMessage() {}
+
+func (x *ListItemReviewsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItemReviewsReq.ProtoReflect.Descriptor instead.
+func (*ListItemReviewsReq) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListItemReviewsReq) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ListItemReviewsReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListItemReviewsReq) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type PaginatedReviewRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data        []*ReviewMsg `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Count       int64        `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	TotalPages  int64        `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	CurrentPage int64        `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	HasNextPage bool         `protobuf:"varint,5,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+}
+
+func (x *PaginatedReviewRes) Reset() {
+	*x = PaginatedReviewRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaginatedReviewRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaginatedReviewRes) ProtoMessage() {}
+
+func (x *PaginatedReviewRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaginatedReviewRes.ProtoReflect.Descriptor instead.
+func (*PaginatedReviewRes) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *PaginatedReviewRes) GetData() []*ReviewMsg {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PaginatedReviewRes) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PaginatedReviewRes) GetTotalPages() int64 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *PaginatedReviewRes) GetCurrentPage() int64 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *PaginatedReviewRes) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+type ModerateReviewReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Note   string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *ModerateReviewReq) Reset() {
+	*x = ModerateReviewReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModerateReviewReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateReviewReq) ProtoMessage() {}
+
+func (x *ModerateReviewReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateReviewReq.ProtoReflect.Descriptor instead.
+func (*ModerateReviewReq) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ModerateReviewReq) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ModerateReviewReq) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ModerateReviewReq) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type DeleteReviewReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *DeleteReviewReq) Reset() {
+	*x = DeleteReviewReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteReviewReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReviewReq) ProtoMessage() {}
+
+func (x *DeleteReviewReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReviewReq.ProtoReflect.Descriptor instead.
+func (*DeleteReviewReq) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *DeleteReviewReq) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteReviewReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ReviewMediaInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReviewId uint64 `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	UserId   string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *ReviewMediaInfo) Reset() {
+	*x = ReviewMediaInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewMediaInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewMediaInfo) ProtoMessage() {}
+
+func (x *ReviewMediaInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewMediaInfo.ProtoReflect.Descriptor instead.
+func (*ReviewMediaInfo) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ReviewMediaInfo) GetReviewId() uint64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *ReviewMediaInfo) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UploadReviewMediaReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*UploadReviewMediaReq_Info
+	//	*UploadReviewMediaReq_Chunk
+	Data isUploadReviewMediaReq_Data `protobuf_oneof:"data"`
+}
+
+func (x *UploadReviewMediaReq) Reset() {
+	*x = UploadReviewMediaReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadReviewMediaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadReviewMediaReq) ProtoMessage() {}
+
+func (x *UploadReviewMediaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadReviewMediaReq.ProtoReflect.Descriptor instead.
+func (*UploadReviewMediaReq) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{85}
+}
+
+func (m *UploadReviewMediaReq) GetData() isUploadReviewMediaReq_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *UploadReviewMediaReq) GetInfo() *ReviewMediaInfo {
+	if x, ok := x.GetData().(*UploadReviewMediaReq_Info); ok {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *UploadReviewMediaReq) GetChunk() []byte {
+	if x, ok := x.GetData().(*UploadReviewMediaReq_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+type isUploadReviewMediaReq_Data interface {
+	isUploadReviewMediaReq_Data()
+}
+
+type UploadReviewMediaReq_Info struct {
+	Info *ReviewMediaInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type UploadReviewMediaReq_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadReviewMediaReq_Info) isUploadReviewMediaReq_Data() {}
+
+func (*UploadReviewMediaReq_Chunk) isUploadReviewMediaReq_Data() {}
+
+type QuestionMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ItemId         string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Text           string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	Answers        []*AnswerMsg           `protobuf:"bytes,5,rep,name=answers,proto3" json:"answers,omitempty"`
+	Status         string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	ModerationNote string                 `protobuf:"bytes,7,opt,name=moderation_note,json=moderationNote,proto3" json:"moderation_note,omitempty"`
+	ModeratedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=moderated_at,json=moderatedAt,proto3" json:"moderated_at,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *QuestionMsg) Reset() {
+	*x = QuestionMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuestionMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionMsg) ProtoMessage() {}
+
+func (x *QuestionMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionMsg.ProtoReflect.Descriptor instead.
+func (*QuestionMsg) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *QuestionMsg) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *QuestionMsg) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *QuestionMsg) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *QuestionMsg) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *QuestionMsg) GetAnswers() []*AnswerMsg {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *QuestionMsg) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *QuestionMsg) GetModerationNote() string {
+	if x != nil {
+		return x.ModerationNote
+	}
+	return ""
+}
+
+func (x *QuestionMsg) GetModeratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ModeratedAt
+	}
+	return nil
+}
+
+func (x *QuestionMsg) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *QuestionMsg) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type AnswerMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	QuestionId     uint64                 `protobuf:"varint,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Text           string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	Official       bool                   `protobuf:"varint,5,opt,name=official,proto3" json:"official,omitempty"`
+	Status         string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	ModerationNote string                 `protobuf:"bytes,7,opt,name=moderation_note,json=moderationNote,proto3" json:"moderation_note,omitempty"`
+	ModeratedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=moderated_at,json=moderatedAt,proto3" json:"moderated_at,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *AnswerMsg) Reset() {
+	*x = AnswerMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnswerMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerMsg) ProtoMessage() {}
+
+func (x *AnswerMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerMsg.ProtoReflect.Descriptor instead.
+func (*AnswerMsg) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *AnswerMsg) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AnswerMsg) GetQuestionId() uint64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *AnswerMsg) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AnswerMsg) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *AnswerMsg) GetOfficial() bool {
+	if x != nil {
+		return x.Official
+	}
+	return false
+}
+
+func (x *AnswerMsg) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AnswerMsg) GetModerationNote() string {
+	if x != nil {
+		return x.ModerationNote
+	}
+	return ""
+}
+
+func (x *AnswerMsg) GetModeratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ModeratedAt
+	}
+	return nil
+}
+
+func (x *AnswerMsg) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AnswerMsg) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListItemQuestionsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Page uint64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Size uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *ListItemQuestionsReq) Reset() {
+	*x = ListItemQuestionsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListItemQuestionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItemQuestionsReq) ProtoMessage() {}
+
+func (x *ListItemQuestionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItemQuestionsReq.ProtoReflect.Descriptor instead.
+func (*ListItemQuestionsReq) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ListItemQuestionsReq) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ListItemQuestionsReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListItemQuestionsReq) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type PaginatedQuestionRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data        []*QuestionMsg `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Count       int64          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	TotalPages  int64          `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	CurrentPage int64          `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	HasNextPage bool           `protobuf:"varint,5,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+}
+
+func (x *PaginatedQuestionRes) Reset() {
+	*x = PaginatedQuestionRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaginatedQuestionRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaginatedQuestionRes) ProtoMessage() {}
+
+func (x *PaginatedQuestionRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaginatedQuestionRes.ProtoReflect.Descriptor instead.
+func (*PaginatedQuestionRes) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *PaginatedQuestionRes) GetData() []*QuestionMsg {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PaginatedQuestionRes) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PaginatedQuestionRes) GetTotalPages() int64 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *PaginatedQuestionRes) GetCurrentPage() int64 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *PaginatedQuestionRes) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+type PaginatedAnswerRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data        []*AnswerMsg `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Count       int64        `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	TotalPages  int64        `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	CurrentPage int64        `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	HasNextPage bool         `protobuf:"varint,5,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+}
+
+func (x *PaginatedAnswerRes) Reset() {
+	*x = PaginatedAnswerRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaginatedAnswerRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaginatedAnswerRes) ProtoMessage() {}
+
+func (x *PaginatedAnswerRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaginatedAnswerRes.ProtoReflect.Descriptor instead.
+func (*PaginatedAnswerRes) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *PaginatedAnswerRes) GetData() []*AnswerMsg {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PaginatedAnswerRes) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PaginatedAnswerRes) GetTotalPages() int64 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *PaginatedAnswerRes) GetCurrentPage() int64 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *PaginatedAnswerRes) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+// Used for both questions and answers.
+type ModerateQuestionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Note   string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *ModerateQuestionReq) Reset() {
+	*x = ModerateQuestionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModerateQuestionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateQuestionReq) ProtoMessage() {}
+
+func (x *ModerateQuestionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateQuestionReq.ProtoReflect.Descriptor instead.
+func (*ModerateQuestionReq) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ModerateQuestionReq) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ModerateQuestionReq) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ModerateQuestionReq) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type SetAnswerOfficialReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Official bool   `protobuf:"varint,2,opt,name=official,proto3" json:"official,omitempty"`
+}
+
+func (x *SetAnswerOfficialReq) Reset() {
+	*x = SetAnswerOfficialReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAnswerOfficialReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAnswerOfficialReq) ProtoMessage() {}
+
+func (x *SetAnswerOfficialReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAnswerOfficialReq.ProtoReflect.Descriptor instead.
+func (*SetAnswerOfficialReq) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *SetAnswerOfficialReq) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SetAnswerOfficialReq) GetOfficial() bool {
+	if x != nil {
+		return x.Official
+	}
+	return false
+}
+
+type ListTrashReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// One of item, category, promotion, order, empty lists the whole trash.
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Page uint64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Size uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *ListTrashReq) Reset() {
+	*x = ListTrashReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTrashReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashReq) ProtoMessage() {}
+
+func (x *ListTrashReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashReq.ProtoReflect.Descriptor instead.
+func (*ListTrashReq) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ListTrashReq) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ListTrashReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTrashReq) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type TrashEntryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Id   string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *TrashEntryReq) Reset() {
+	*x = TrashEntryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrashEntryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashEntryReq) ProtoMessage() {}
+
+func (x *TrashEntryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashEntryReq.ProtoReflect.Descriptor instead.
+func (*TrashEntryReq) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *TrashEntryReq) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *TrashEntryReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type TrashEntryMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind      string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Id        string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Title     string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+}
+
+func (x *TrashEntryMsg) Reset() {
+	*x = TrashEntryMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrashEntryMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashEntryMsg) ProtoMessage() {}
+
+func (x *TrashEntryMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashEntryMsg.ProtoReflect.Descriptor instead.
+func (*TrashEntryMsg) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *TrashEntryMsg) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *TrashEntryMsg) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TrashEntryMsg) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TrashEntryMsg) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type PaginatedTrashRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data        []*TrashEntryMsg `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Count       int64            `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	TotalPages  int64            `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	CurrentPage int64            `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	HasNextPage bool             `protobuf:"varint,5,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+}
+
+func (x *PaginatedTrashRes) Reset() {
+	*x = PaginatedTrashRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaginatedTrashRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaginatedTrashRes) ProtoMessage() {}
+
+func (x *PaginatedTrashRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaginatedTrashRes.ProtoReflect.Descriptor instead.
+func (*PaginatedTrashRes) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *PaginatedTrashRes) GetData() []*TrashEntryMsg {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PaginatedTrashRes) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PaginatedTrashRes) GetTotalPages() int64 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *PaginatedTrashRes) GetCurrentPage() int64 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *PaginatedTrashRes) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+type ListAuditLogReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Empty filters and unset times match everything.
+	Entity   string                 `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	EntityId string                 `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	ActorId  string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	From     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Page     uint64                 `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
+	Size     uint64                 `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *ListAuditLogReq) Reset() {
+	*x = ListAuditLogReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditLogReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogReq) ProtoMessage() {}
+
+func (x *ListAuditLogReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogReq.ProtoReflect.Descriptor instead.
+func (*ListAuditLogReq) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ListAuditLogReq) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *ListAuditLogReq) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *ListAuditLogReq) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ListAuditLogReq) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListAuditLogReq) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListAuditLogReq) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAuditLogReq) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type AuditEntryMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActorId  string `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Entity   string `protobuf:"bytes,3,opt,name=entity,proto3" json:"entity,omitempty"`
+	EntityId string `protobuf:"bytes,4,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Action   string `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	// JSON object {"before": {...}, "after": {...}} with the fields that changed.
+	Diff      string                 `protobuf:"bytes,6,opt,name=diff,proto3" json:"diff,omitempty"`
+	RequestId string                 `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AuditEntryMsg) Reset() {
+	*x = AuditEntryMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEntryMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntryMsg) ProtoMessage() {}
+
+func (x *AuditEntryMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntryMsg.ProtoReflect.Descriptor instead.
+func (*AuditEntryMsg) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *AuditEntryMsg) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditEntryMsg) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AuditEntryMsg) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *AuditEntryMsg) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *AuditEntryMsg) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEntryMsg) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+func (x *AuditEntryMsg) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditEntryMsg) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type PaginatedAuditRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data        []*AuditEntryMsg `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Count       int64            `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	TotalPages  int64            `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	CurrentPage int64            `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	HasNextPage bool             `protobuf:"varint,5,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+}
+
+func (x *PaginatedAuditRes) Reset() {
+	*x = PaginatedAuditRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_products_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaginatedAuditRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaginatedAuditRes) ProtoMessage() {}
+
+func (x *PaginatedAuditRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_products_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaginatedAuditRes.ProtoReflect.Descriptor instead.
+func (*PaginatedAuditRes) Descriptor() ([]byte, []int) {
+	return file_api_pb_products_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *PaginatedAuditRes) GetData() []*AuditEntryMsg {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PaginatedAuditRes) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PaginatedAuditRes) GetTotalPages() int64 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *PaginatedAuditRes) GetCurrentPage() int64 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *PaginatedAuditRes) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+var File_api_pb_products_proto protoreflect.FileDescriptor
+
+var file_api_pb_products_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x62, 0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x75, 0x73, 0x65, 0x72, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1d, 0x0a, 0x07, 0x75, 0x75, 0x69,
+	0x64, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x1d, 0x0a, 0x07, 0x73, 0x6c, 0x75, 0x67,
+	0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x22, 0x21, 0x0a, 0x09, 0x75, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3b, 0x0a, 0x05, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x31, 0x0a, 0x07, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x49, 0x0a, 0x09, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xf5, 0x03, 0x0a, 0x0b, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x72,
+	0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x10, 0x0a, 0x03,
+	0x61, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x6c, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x6c, 0x75, 0x67, 0x12,
+	0x40, 0x0a, 0x12, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x4d, 0x73, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4d, 0x73, 0x67, 0x52, 0x11,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4d, 0x73,
+	0x67, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x4d, 0x73, 0x67, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
+	0x12, 0x23, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x73, 0x67, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x26, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x92, 0x01,
+	0x0a, 0x10, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x57, 0x69, 0x74, 0x68, 0x53, 0x6c,
+	0x75, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4d, 0x73, 0x67, 0x52, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x6d, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x73, 0x6b, 0x22, 0xba, 0x02, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x1f,
+	0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x61, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x08, 0x6d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0xec, 0x06, 0x0a, 0x07, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x73, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x2a, 0x0a, 0x11, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x6e, 0x5f,
+	0x73, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x72, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x10,
+	0x0a, 0x03, 0x61, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x6c, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x15, 0x0a, 0x06, 0x69,
+	0x73, 0x5f, 0x68, 0x69, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x48,
+	0x69, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x52, 0x65, 0x63, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12,
+	0x31, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0d, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x4d, 0x73, 0x67, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x0e, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x64,
+	0x69, 0x61, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x33, 0x0a, 0x0a, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x29,
+	0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x73, 0x67, 0x52,
+	0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x10, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x18, 0x11, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x0f, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x3f, 0x0a, 0x0f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x62, 0x72, 0x65,
+	0x61, 0x6b, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x4d, 0x73,
+	0x67, 0x52, 0x0e, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x72, 0x65, 0x61, 0x6b,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x18, 0x16, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x18, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa1,
+	0x03, 0x0a, 0x09, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x72, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x10,
+	0x0a, 0x03, 0x61, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x6c, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x12,
+	0x31, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x74, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x0f, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x72,
+	0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xfb, 0x01,
+	0x0a, 0x0d, 0x49, 0x74, 0x65, 0x6d, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d,
+	0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x64, 0x22, 0x83, 0x03, 0x0a, 0x0e,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12,
+	0x30, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x49, 0x74, 0x65,
+	0x6d, 0x4d, 0x73, 0x67, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x49, 0x74, 0x65,
+	0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69,
+	0x73, 0x5f, 0x73, 0x79, 0x6d, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x69, 0x73, 0x53, 0x79, 0x6d, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x73,
+	0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65,
+	0x64, 0x22, 0x57, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x0a, 0x0f, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a,
+	0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x53, 0x0a, 0x14, 0x44, 0x69, 0x66, 0x66, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x17,
+	0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74,
+	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x74, 0x6f, 0x22, 0xb2, 0x01, 0x0a, 0x0f,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x73, 0x67, 0x52,
+	0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0xc4, 0x01, 0x0a, 0x19, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x12, 0x29,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x4d, 0x73, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x4e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x22, 0x4d, 0x0a, 0x13, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x4d, 0x73, 0x67, 0x12, 0x12,
+	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x22, 0xe1, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4d, 0x73, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74,
+	0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65,
+	0x6d, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x09, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x28, 0x0a,
+	0x09, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x08, 0x6e,
+	0x65, 0x77, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x8e, 0x02, 0x0a, 0x11, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4d, 0x73, 0x67,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xfc, 0x01, 0x0a, 0x10,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4d, 0x73, 0x67,
+	0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x0d, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0c, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x35, 0x0a, 0x10, 0x6c,
+	0x6f, 0x77, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x33, 0x30, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x0e, 0x6c, 0x6f, 0x77, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x33,
+	0x30, 0x64, 0x12, 0x2f, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4d, 0x73, 0x67, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x35, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4d, 0x73, 0x67, 0x52,
+	0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x22, 0x53, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x42, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22,
+	0x77, 0x0a, 0x14, 0x6c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49,
+	0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73,
+	0x6f, 0x72, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f,
+	0x73, 0x6c, 0x75, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x22, 0x3e, 0x0a, 0x10, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x7f, 0x0a, 0x0b, 0x49, 0x74, 0x65, 0x6d,
+	0x57, 0x69, 0x74, 0x68, 0x55, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x49, 0x74, 0x65,
+	0x6d, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x0b,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0xb3, 0x01, 0x0a, 0x10, 0x50, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x12, 0x21,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x68,
+	0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x22,
+	0xbe, 0x01, 0x0a, 0x15, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x49, 0x74, 0x65,
+	0x6d, 0x41, 0x74, 0x74, 0x72, 0x73, 0x52, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x49,
+	0x74, 0x65, 0x6d, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0d,
+	0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x22, 0xbb, 0x01, 0x0a, 0x14, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x68, 0x61,
+	0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x22, 0x31,
+	0x0a, 0x0d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x12,
+	0x20, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0xb4, 0x01, 0x0a, 0x12, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73,
+	0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x22, 0xf2, 0x02, 0x0a, 0x0b, 0x46, 0x61, 0x76,
+	0x6f, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x04, 0x69, 0x74,
+	0x65, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x49, 0x74, 0x65, 0x6d, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x44, 0x72, 0x6f, 0x70, 0x12,
+	0x26, 0x0a, 0x0f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x6f,
+	0x63, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79,
+	0x49, 0x6e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x34, 0x0a, 0x0f, 0x65, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0e, 0x65,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x67, 0x0a,
+	0x10, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x22, 0xbb, 0x01, 0x0a, 0x14, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x64, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x12,
+	0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x73, 0x67,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x12, 0x22, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x22, 0x42, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x49,
+	0x74, 0x65, 0x6d, 0x49, 0x64, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x22, 0xa0, 0x01, 0x0a, 0x18, 0x46, 0x61, 0x76,
+	0x6f, 0x72, 0x69, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x44,
+	0x72, 0x6f, 0x70, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x69, 0x6e,
+	0x5f, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6e, 0x6f,
+	0x74, 0x69, 0x66, 0x79, 0x49, 0x6e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x22, 0xa2, 0x02, 0x0a, 0x15,
+	0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x35, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69,
+	0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d,
+	0x4d, 0x73, 0x67, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x9f, 0x02, 0x0a, 0x19, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x73, 0x67, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69,
+	0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x69, 0x74, 0x65,
+	0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x49,
+	0x74, 0x65, 0x6d, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x4c, 0x0a, 0x19, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x12,
+	0x2f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x40, 0x0a, 0x15, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x25, 0x0a, 0x0d, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xc1, 0x02, 0x0a, 0x08, 0x50, 0x72,
+	0x6f, 0x6d, 0x6f, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x73, 0x72, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x61, 0x6c, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x73, 0x5f,
+	0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x73, 0x54, 0x6f, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x84, 0x01,
+	0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x53, 0x6c, 0x75, 0x67, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73,
+	0x6c, 0x75, 0x67, 0x12, 0x22, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x4d, 0x73,
+	0x67, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x73, 0x6b, 0x22, 0x90, 0x02, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x6c, 0x75, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x69, 0x74, 0x65,
+	0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xb5, 0x01, 0x0a, 0x11, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x12, 0x22, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x68,
+	0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x22,
+	0xbb, 0x01, 0x0a, 0x16, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x68, 0x61, 0x73,
+	0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x22, 0x53, 0x0a,
+	0x15, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x22, 0xf9, 0x03, 0x0a, 0x08, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x69,
+	0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x66, 0x69, 0x6f, 0x12, 0x10, 0x0a, 0x03,
+	0x74, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x65, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61,
+	0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x84,
+	0x02, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x04,
+	0x69, 0x74, 0x65, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xb5, 0x01, 0x0a, 0x11, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x68, 0x61, 0x73,
+	0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x22, 0xd2, 0x02,
+	0x0a, 0x0c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c,
+	0x75, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x65, 0x70, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x3a, 0x0a, 0x10, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x72,
+	0x0a, 0x10, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x4d,
+	0x73, 0x67, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12,
+	0x21, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x22, 0x53, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xc6, 0x01, 0x0a, 0x1a, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0d,
+	0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x22, 0x58, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x2c, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d,
+	0x4d, 0x73, 0x67, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3f, 0x0a, 0x10, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c,
+	0x75, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x22, 0x8c, 0x02, 0x0a, 0x10,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x73, 0x67,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x73, 0x6c, 0x75, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x73, 0x6c, 0x75, 0x67, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x6c, 0x75, 0x67, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x42, 0x0a, 0x14, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x12, 0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xc9,
+	0x01, 0x0a, 0x10, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x72, 0x65, 0x61, 0x6b,
+	0x4d, 0x73, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x51, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x21, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x2a,
+	0x0a, 0x11, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x14, 0x51, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x12, 0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x42, 0x72, 0x65, 0x61, 0x6b, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x5f,
+	0x0a, 0x14, 0x53, 0x65, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x72, 0x65,
+	0x61, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12,
+	0x2e, 0x0a, 0x06, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42,
+	0x72, 0x65, 0x61, 0x6b, 0x4d, 0x73, 0x67, 0x52, 0x06, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x73, 0x22,
+	0x59, 0x0a, 0x0d, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69,
+	0x73, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x69, 0x73, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x5f, 0x0a, 0x12, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71,
+	0x12, 0x29, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x49,
+	0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x05, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x34, 0x0a, 0x0d, 0x49,
+	0x74, 0x65, 0x6d, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x40, 0x0a, 0x13, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d,
+	0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x03,
+	0x69, 0x64, 0x73, 0x22, 0x37, 0x0a, 0x0c, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x64, 0x69, 0x61,
+	0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x8b, 0x02, 0x0a,
+	0x0c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75,
+	0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x3a, 0x0a, 0x10, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x12, 0x26,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4d, 0x73, 0x67,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xf2, 0x01, 0x0a, 0x14, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x73, 0x6c, 0x75, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x53, 0x6c, 0x75, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x09, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x09,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73,
+	0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x69, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4a, 0x0a, 0x18, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4d, 0x73,
+	0x67, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6a, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x3a, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x22, 0x9f, 0x02, 0x0a, 0x08, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4d, 0x73, 0x67,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x75, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x75, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x32, 0x0a, 0x0c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x4d, 0x73, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4a, 0x0a, 0x0e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x24, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4d, 0x73, 0x67, 0x52, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x3e, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0xde, 0x03, 0x0a, 0x09, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x4d, 0x73, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x72, 0x6f, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x72, 0x6f, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x6f, 0x64, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74,
+	0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xbe, 0x02, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x08, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x4d,
+	0x73, 0x67, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x50, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x12, 0x50, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73,
+	0x12, 0x23, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4d, 0x73, 0x67, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12,
+	0x22, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x22, 0x4f, 0x0a, 0x11, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x6f, 0x74, 0x65, 0x22, 0x3a, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x47, 0x0a, 0x0f, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x63, 0x0a, 0x14, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65,
+	0x71, 0x12, 0x2b, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4d, 0x65, 0x64,
+	0x69, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x16,
+	0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52,
+	0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x84,
+	0x03, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x6f, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x65,
+	0x12, 0x3d, 0x0a, 0x0c, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xfb, 0x02, 0x0a, 0x09, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x4d, 0x73, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x3d,
+	0x0a, 0x0c, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x52, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xbb, 0x01, 0x0a, 0x14, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73,
+	0x67, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x12, 0x22, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x12, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x68,
+	0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x22,
+	0x51, 0x0a, 0x13, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f,
+	0x74, 0x65, 0x22, 0x42, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x4f,
+	0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x66,
+	0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x66,
+	0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x22, 0x4a, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72,
+	0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x22, 0x33, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x73, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x84, 0x01, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x73,
+	0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xba,
+	0x01, 0x0a, 0x11, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x54, 0x72, 0x61, 0x73,
+	0x68, 0x52, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x72, 0x61, 0x73, 0x68, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x22, 0xe5, 0x01, 0x0a, 0x0f,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x12,
+	0x16, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12,
+	0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x22, 0xf5, 0x01, 0x0a, 0x0d, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x4d, 0x73, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x66,
+	0x66, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xba, 0x01, 0x0a, 0x11,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65,
+	0x73, 0x12, 0x27, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73,
+	0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x32, 0xad, 0x07, 0x0a, 0x04, 0x49, 0x74, 0x65,
+	0x6d, 0x12, 0x35, 0x0a, 0x0a, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12,
+	0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x1a, 0x16, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0e, 0x49, 0x74, 0x65, 0x6d,
+	0x41, 0x74, 0x74, 0x72, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x0f, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d,
+	0x41, 0x74, 0x74, 0x72, 0x73, 0x52, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x0a,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x73, 0x67, 0x1a, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x75, 0x75, 0x69, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x27, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x4d,
+	0x73, 0x67, 0x1a, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x73,
+	0x67, 0x12, 0x2c, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x11, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x57, 0x69, 0x74, 0x68, 0x55,
+	0x69, 0x64, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x28, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0d, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x4d, 0x73, 0x67, 0x1a, 0x0b, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x0d, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x11, 0x6c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x6c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x12, 0x45, 0x0a,
+	0x10, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x42, 0x79, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x12, 0x19, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x42, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x75, 0x75, 0x69, 0x64, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4d, 0x73, 0x67, 0x12,
+	0x3f, 0x0a, 0x13, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x17, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4d, 0x73, 0x67, 0x1a,
+	0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x73, 0x67,
+	0x12, 0x34, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x73, 0x67, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x50, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x11, 0x44, 0x69, 0x66, 0x66,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66,
+	0x66, 0x4d, 0x73, 0x67, 0x12, 0x32, 0x0a, 0x0c, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x49, 0x74, 0x65, 0x6d, 0x12, 0x15, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0b, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0x85, 0x04, 0x0a, 0x08, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x3b, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x12, 0x32, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x12, 0x11, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x4d, 0x73, 0x67, 0x1a, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x73,
+	0x6c, 0x75, 0x67, 0x4d, 0x73, 0x67, 0x12, 0x3d, 0x0a, 0x0e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x15, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x0f,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a,
+	0x18, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x73, 0x6c, 0x75, 0x67, 0x4d, 0x73, 0x67, 0x1a, 0x11, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4d, 0x73, 0x67, 0x12, 0x35, 0x0a, 0x0e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x57, 0x69, 0x74, 0x68,
+	0x53, 0x6c, 0x75, 0x67, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x2c, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x73, 0x6c, 0x75, 0x67, 0x4d,
+	0x73, 0x67, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x39, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x73, 0x6c,
+	0x75, 0x67, 0x4d, 0x73, 0x67, 0x1a, 0x13, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x52, 0x65,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x73, 0x6c, 0x75, 0x67,
+	0x4d, 0x73, 0x67, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x32, 0xff, 0x07, 0x0a, 0x08, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x12, 0x43, 0x0a,
+	0x0d, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x12, 0x16,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x12, 0x39, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x46, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x41, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x73, 0x1a, 0x11, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x38, 0x0a,
+	0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x41, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x73, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x47, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x46, 0x61,
+	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x49, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0d, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x4d, 0x73, 0x67, 0x1a, 0x1f, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x51, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x61, 0x76, 0x6f,
+	0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x1a, 0x1b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
+	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x4f,
+	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x46, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d,
+	0x73, 0x67, 0x1a, 0x1b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69,
+	0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12,
+	0x48, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
+	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x73, 0x67, 0x12, 0x44, 0x0a, 0x18, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x61, 0x76,
+	0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x73, 0x67, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x44, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
+	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4b, 0x0a, 0x17, 0x53, 0x68, 0x61, 0x72, 0x65, 0x46, 0x61,
+	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d,
+	0x73, 0x67, 0x12, 0x45, 0x0a, 0x19, 0x55, 0x6e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x46, 0x61, 0x76,
+	0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0b, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x49, 0x0a, 0x19, 0x53, 0x65, 0x74,
+	0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x61,
+	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x74, 0x65, 0x6d, 0x4d, 0x73, 0x67, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x4c, 0x0a, 0x1c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x61,
+	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x74, 0x65, 0x6d, 0x12, 0x1f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x61, 0x76, 0x6f,
+	0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74,
+	0x65, 0x6d, 0x4d, 0x73, 0x67, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x32, 0x98, 0x03, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x38, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x1a, 0x17, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x65, 0x64, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0f, 0x50, 0x72,
+	0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x0f, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x17,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x50,
+	0x72, 0x6f, 0x6d, 0x6f, 0x52, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x4d, 0x73, 0x67, 0x1a, 0x0d, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x73, 0x6c, 0x75, 0x67, 0x4d, 0x73, 0x67, 0x12, 0x2d, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x73, 0x6c, 0x75, 0x67, 0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x4d, 0x73, 0x67, 0x12, 0x33, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x53, 0x6c, 0x75, 0x67,
+	0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2d, 0x0a,
+	0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x73, 0x6c, 0x75, 0x67, 0x4d, 0x73, 0x67, 0x1a,
+	0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4f, 0x0a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x12, 0x1b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72,
+	0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x1c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x32, 0xda, 0x02,
+	0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x38, 0x0a,
+	0x0e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x17,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x75, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x4d, 0x73, 0x67, 0x12, 0x2e, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x0e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x4d, 0x73, 0x67, 0x1a, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x75, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x4d, 0x73, 0x67, 0x12, 0x2a, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x0e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x4d, 0x73, 0x67, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x2b, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x73, 0x67,
+	0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2b, 0x0a,
+	0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0f, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x73, 0x67, 0x1a, 0x0b, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xdd, 0x03, 0x0a, 0x09, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x0b, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x12,
+	0x31, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x73, 0x6c, 0x75, 0x67, 0x4d, 0x73, 0x67, 0x1a, 0x12,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x73, 0x67, 0x12, 0x34, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x1a, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x73, 0x6c, 0x75, 0x67, 0x4d, 0x73, 0x67, 0x12, 0x32, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x1a,
+	0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x0f,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x73, 0x6c, 0x75, 0x67, 0x4d, 0x73, 0x67, 0x1a, 0x0b,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x53, 0x0a, 0x12, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x1b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x20,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x12, 0x3c, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3a,
+	0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x16, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x0b, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xb5, 0x03, 0x0a, 0x0d, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x3d, 0x0a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x12, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x73, 0x6c, 0x75, 0x67, 0x4d, 0x73, 0x67, 0x1a, 0x16,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x4d, 0x73, 0x67, 0x12, 0x3c, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x16, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x4d, 0x73, 0x67, 0x1a, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x73, 0x6c, 0x75,
+	0x67, 0x4d, 0x73, 0x67, 0x12, 0x3a, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x16, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x4d, 0x73, 0x67, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x31, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x73,
+	0x6c, 0x75, 0x67, 0x4d, 0x73, 0x67, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x73, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x75, 0x75, 0x69, 0x64, 0x4d, 0x73, 0x67, 0x1a, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x73, 0x12, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x53, 0x65, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x72, 0x65, 0x61,
+	0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x32, 0x9d, 0x02, 0x0a, 0x05, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x33, 0x0a, 0x0d,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x0d, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x4d, 0x73, 0x67, 0x1a, 0x13, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x3e, 0x0a, 0x0f, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x4d,
+	0x65, 0x64, 0x69, 0x61, 0x12, 0x18, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x0f,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x28,
+	0x01, 0x12, 0x2f, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x4d,
+	0x65, 0x64, 0x69, 0x61, 0x12, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d,
+	0x4d, 0x65, 0x64, 0x69, 0x61, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x3a, 0x0a, 0x10, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65,
+	0x6d, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x19, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x65,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65,
+	0x71, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x32,
+	0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x64, 0x69,
+	0x61, 0x12, 0x12, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x64,
+	0x69, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x32, 0x9d, 0x03, 0x0a, 0x09, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x12, 0x35, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x12, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x16, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x73,
+	0x6c, 0x75, 0x67, 0x4d, 0x73, 0x67, 0x1a, 0x12, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x34, 0x0a, 0x0f, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x12, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4d, 0x73,
+	0x67, 0x1a, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x73, 0x6c, 0x75, 0x67, 0x4d, 0x73, 0x67,
+	0x12, 0x32, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x12, 0x12, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x73,
+	0x6c, 0x75, 0x67, 0x4d, 0x73, 0x67, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x47, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x0d, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x73, 0x6c, 0x75, 0x67, 0x4d, 0x73, 0x67, 0x1a, 0x1e, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x15,
+	0x53, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x32, 0xf9, 0x01, 0x0a, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x2d, 0x0a, 0x0a,
+	0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x0b, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x0b, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x0e, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4d, 0x73, 0x67, 0x1a, 0x0f, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x73, 0x67, 0x12, 0x30, 0x0a, 0x0b, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x14, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x29, 0x0a,
+	0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x0d, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x73, 0x6c, 0x75, 0x67, 0x4d, 0x73, 0x67, 0x1a, 0x0b, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x16, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xa3,
+	0x03, 0x0a, 0x06, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x45, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x18, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73,
+	0x12, 0x3d, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x12,
+	0x30, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12,
+	0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4d, 0x73, 0x67,
+	0x1a, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x73,
+	0x67, 0x12, 0x2c, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x12, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4d,
+	0x73, 0x67, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x36, 0x0a, 0x0e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x12, 0x17, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x15, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x0b,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x47, 0x0a, 0x11, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4d, 0x65, 0x64, 0x69, 0x61,
+	0x12, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x4d,
+	0x73, 0x67, 0x28, 0x01, 0x32, 0xd3, 0x04, 0x0a, 0x08, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x4b, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x65, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x41,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x12, 0x34, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x11, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x75, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x4d, 0x73, 0x67, 0x12, 0x3a, 0x0a, 0x10, 0x4d, 0x6f, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x75, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x4d, 0x73, 0x67, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x12, 0x30, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x12, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x4d, 0x73, 0x67, 0x1a, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x75, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x4d, 0x73, 0x67, 0x12, 0x38, 0x0a, 0x0e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4d, 0x6f,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3c,
+	0x0a, 0x11, 0x53, 0x65, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x4f, 0x66, 0x66, 0x69, 0x63,
+	0x69, 0x61, 0x6c, 0x12, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x1a,
+	0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x0c,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x0f, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x73, 0x67, 0x1a, 0x0b, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xdc, 0x01, 0x0a, 0x05, 0x54,
+	0x72, 0x61, 0x73, 0x68, 0x12, 0x38, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73,
+	0x68, 0x12, 0x12, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61,
+	0x73, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x12, 0x2f,
+	0x0a, 0x0b, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x54, 0x72, 0x61, 0x73, 0x68, 0x12, 0x13, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x72, 0x61, 0x73, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x34, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x72,
+	0x61, 0x73, 0x68, 0x12, 0x13, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x72, 0x61, 0x73, 0x68,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x0e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x46, 0x72,
+	0x6f, 0x6d, 0x54, 0x72, 0x61, 0x73, 0x68, 0x12, 0x13, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x54,
+	0x72, 0x61, 0x73, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x0b, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0x47, 0x0a, 0x05, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x12, 0x3e, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c,
+	0x6f, 0x67, 0x12, 0x15, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52,
+	0x65, 0x73, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x4a, 0x4d, 0x55, 0x52, 0x76, 0x2f, 0x70, 0x61, 0x72, 0x2d, 0x70, 0x72, 0x6f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x70, 0x62, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_pb_products_proto_rawDescOnce sync.Once
+	file_api_pb_products_proto_rawDescData = file_api_pb_products_proto_rawDesc
+)
+
+func file_api_pb_products_proto_rawDescGZIP() []byte {
+	file_api_pb_products_proto_rawDescOnce.Do(func() {
+		file_api_pb_products_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_pb_products_proto_rawDescData)
+	})
+	return file_api_pb_products_proto_rawDescData
+}
+
+var file_api_pb_products_proto_msgTypes = make([]protoimpl.MessageInfo, 100)
+var file_api_pb_products_proto_goTypes = []any{
+	(*Empty)(nil),                      // 0: user.Empty
+	(*UuidMsg)(nil),                    // 1: user.uuidMsg
+	(*SlugMsg)(nil),                    // 2: user.slugMsg
+	(*Uint64Msg)(nil),                  // 3: user.uint64Msg
+	(*Money)(nil),                      // 4: user.Money
+	(*ListReq)(nil),                    // 5: user.ListReq
+	(*SearchReq)(nil),                  // 6: user.SearchReq
+	(*CategoryMsg)(nil),                // 7: user.CategoryMsg
+	(*CategoryWithSlug)(nil),           // 8: user.CategoryWithSlug
+	(*Filter)(nil),                     // 9: user.Filter
+	(*ItemMsg)(nil),                    // 10: user.ItemMsg
+	(*ItemMedia)(nil),                  // 11: user.ItemMedia
+	(*MediaVariantMsg)(nil),            // 12: user.MediaVariantMsg
+	(*ItemAttribute)(nil),              // 13: user.ItemAttribute
+	(*RelatedProduct)(nil),             // 14: user.RelatedProduct
+	(*ListItemRevisionsReq)(nil),       // 15: user.ListItemRevisionsReq
+	(*ItemRevisionReq)(nil),            // 16: user.ItemRevisionReq
+	(*DiffItemRevisionsReq)(nil),       // 17: user.DiffItemRevisionsReq
+	(*ItemRevisionMsg)(nil),            // 18: user.ItemRevisionMsg
+	(*PaginatedItemRevisionsRes)(nil),  // 19: user.PaginatedItemRevisionsRes
+	(*ItemRevisionDiffMsg)(nil),        // 20: user.ItemRevisionDiffMsg
+	(*PriceHistoryMsg)(nil),            // 21: user.PriceHistoryMsg
+	(*ScheduledPriceMsg)(nil),          // 22: user.ScheduledPriceMsg
+	(*PriceTimelineMsg)(nil),           // 23: user.PriceTimelineMsg
+	(*ListItemsByLabelReq)(nil),        // 24: user.ListItemsByLabelReq
+	(*ListCategoryItemsReq)(nil),       // 25: user.listCategoryItemsReq
+	(*RelatedItemsList)(nil),           // 26: user.RelatedItemsList
+	(*ItemWithUid)(nil),                // 27: user.ItemWithUid
+	(*PaginatedItemRes)(nil),           // 28: user.PaginatedItemRes
+	(*PaginatedItemAttrsRes)(nil),      // 29: user.PaginatedItemAttrsRes
+	(*PaginatedCategoryRes)(nil),       // 30: user.PaginatedCategoryRes
+	(*FilterListRes)(nil),              // 31: user.FilterListRes
+	(*PaginatedFilterRes)(nil),         // 32: user.PaginatedFilterRes
+	(*FavoriteMsg)(nil),                // 33: user.FavoriteMsg
+	(*ListFavoritesReq)(nil),           // 34: user.ListFavoritesReq
+	(*PaginatedFavoriteRes)(nil),       // 35: user.PaginatedFavoriteRes
+	(*UserAndItemIds)(nil),             // 36: user.UserAndItemIds
+	(*FavoriteNotificationsReq)(nil),   // 37: user.FavoriteNotificationsReq
+	(*FavoriteCollectionMsg)(nil),      // 38: user.FavoriteCollectionMsg
+	(*FavoriteCollectionItemMsg)(nil),  // 39: user.FavoriteCollectionItemMsg
+	(*FavoriteCollectionListMsg)(nil),  // 40: user.FavoriteCollectionListMsg
+	(*FavoriteCollectionReq)(nil),      // 41: user.FavoriteCollectionReq
+	(*ShareTokenMsg)(nil),              // 42: user.ShareTokenMsg
+	(*PromoMsg)(nil),                   // 43: user.PromoMsg
+	(*PromoWithSlug)(nil),              // 44: user.PromoWithSlug
+	(*PromoItem)(nil),                  // 45: user.PromoItem
+	(*PaginatedPromoRes)(nil),          // 46: user.PaginatedPromoRes
+	(*PaginatedPromoItemsRes)(nil),     // 47: user.PaginatedPromoItemsRes
+	(*ListPromotionItemsReq)(nil),      // 48: user.ListPromotionItemsReq
+	(*OrderMsg)(nil),                   // 49: user.OrderMsg
+	(*OrderItem)(nil),                  // 50: user.OrderItem
+	(*PaginatedOrderRes)(nil),          // 51: user.PaginatedOrderRes
+	(*PriceListMsg)(nil),               // 52: user.PriceListMsg
+	(*PriceListListRes)(nil),           // 53: user.PriceListListRes
+	(*PriceListItemMsg)(nil),           // 54: user.PriceListItemMsg
+	(*ListPriceListItemsReq)(nil),      // 55: user.ListPriceListItemsReq
+	(*PaginatedPriceListItemsRes)(nil), // 56: user.PaginatedPriceListItemsRes
+	(*SetPriceListItemsReq)(nil),       // 57: user.SetPriceListItemsReq
+	(*PriceListItemReq)(nil),           // 58: user.PriceListItemReq
+	(*CustomerGroupMsg)(nil),           // 59: user.CustomerGroupMsg
+	(*CustomerGroupListRes)(nil),       // 60: user.CustomerGroupListRes
+	(*QuantityBreakMsg)(nil),           // 61: user.QuantityBreakMsg
+	(*QuantityBreakListRes)(nil),       // 62: user.QuantityBreakListRes
+	(*SetQuantityBreaksReq)(nil),       // 63: user.SetQuantityBreaksReq
+	(*ItemMediaInfo)(nil),              // 64: user.ItemMediaInfo
+	(*UploadItemMediaReq)(nil),         // 65: user.UploadItemMediaReq
+	(*ItemMediaList)(nil),              // 66: user.ItemMediaList
+	(*ReorderItemMediaReq)(nil),        // 67: user.ReorderItemMediaReq
+	(*ItemMediaReq)(nil),               // 68: user.ItemMediaReq
+	(*AttributeMsg)(nil),               // 69: user.AttributeMsg
+	(*AttributeListRes)(nil),           // 70: user.AttributeListRes
+	(*CategoryAttributeMsg)(nil),       // 71: user.CategoryAttributeMsg
+	(*CategoryAttributeListRes)(nil),   // 72: user.CategoryAttributeListRes
+	(*SetCategoryAttributesReq)(nil),   // 73: user.SetCategoryAttributesReq
+	(*LabelMsg)(nil),                   // 74: user.LabelMsg
+	(*LabelListRes)(nil),               // 75: user.LabelListRes
+	(*UpdateLabelReq)(nil),             // 76: user.UpdateLabelReq
+	(*SetItemLabelsReq)(nil),           // 77: user.SetItemLabelsReq
+	(*ReviewMsg)(nil),                  // 78: user.ReviewMsg
+	(*ReviewMediaMsg)(nil),             // 79: user.ReviewMediaMsg
+	(*ListItemReviewsReq)(nil),         // 80: user.ListItemReviewsReq
+	(*PaginatedReviewRes)(nil),         // 81: user.PaginatedReviewRes
+	(*ModerateReviewReq)(nil),          // 82: user.ModerateReviewReq
+	(*DeleteReviewReq)(nil),            // 83: user.DeleteReviewReq
+	(*ReviewMediaInfo)(nil),            // 84: user.ReviewMediaInfo
+	(*UploadReviewMediaReq)(nil),       // 85: user.UploadReviewMediaReq
+	(*QuestionMsg)(nil),                // 86: user.QuestionMsg
+	(*AnswerMsg)(nil),                  // 87: user.AnswerMsg
+	(*ListItemQuestionsReq)(nil),       // 88: user.ListItemQuestionsReq
+	(*PaginatedQuestionRes)(nil),       // 89: user.PaginatedQuestionRes
+	(*PaginatedAnswerRes)(nil),         // 90: user.PaginatedAnswerRes
+	(*ModerateQuestionReq)(nil),        // 91: user.ModerateQuestionReq
+	(*SetAnswerOfficialReq)(nil),       // 92: user.SetAnswerOfficialReq
+	(*ListTrashReq)(nil),               // 93: user.ListTrashReq
+	(*TrashEntryReq)(nil),              // 94: user.TrashEntryReq
+	(*TrashEntryMsg)(nil),              // 95: user.TrashEntryMsg
+	(*PaginatedTrashRes)(nil),          // 96: user.PaginatedTrashRes
+	(*ListAuditLogReq)(nil),            // 97: user.ListAuditLogReq
+	(*AuditEntryMsg)(nil),              // 98: user.AuditEntryMsg
+	(*PaginatedAuditRes)(nil),          // 99: user.PaginatedAuditRes
+	(*timestamppb.Timestamp)(nil),      // 100: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),      // 101: google.protobuf.FieldMask
+}
+var file_api_pb_products_proto_depIdxs = []int32{
+	7,   // 0: user.CategoryMsg.parent_CategoryMsg:type_name -> user.CategoryMsg
+	7,   // 1: user.CategoryMsg.children:type_name -> user.CategoryMsg
+	10,  // 2: user.CategoryMsg.items:type_name -> user.ItemMsg
+	9,   // 3: user.CategoryMsg.filters:type_name -> user.Filter
+	100, // 4: user.CategoryMsg.created_at:type_name -> google.protobuf.Timestamp
+	100, // 5: user.CategoryMsg.updated_at:type_name -> google.protobuf.Timestamp
+	7,   // 6: user.CategoryWithSlug.category:type_name -> user.CategoryMsg
+	101, // 7: user.CategoryWithSlug.update_mask:type_name -> google.protobuf.FieldMask
+	100, // 8: user.Filter.created_at:type_name -> google.protobuf.Timestamp
+	100, // 9: user.Filter.updated_at:type_name -> google.protobuf.Timestamp
+	4,   // 10: user.ItemMsg.price:type_name -> user.Money
+	7,   // 11: user.ItemMsg.categories:type_name -> user.CategoryMsg
+	11,  // 12: user.ItemMsg.media:type_name -> user.ItemMedia
+	13,  // 13: user.ItemMsg.attributes:type_name -> user.ItemAttribute
+	10,  // 14: user.ItemMsg.variants:type_name -> user.ItemMsg
+	14,  // 15: user.ItemMsg.related_products:type_name -> user.RelatedProduct
+	100, // 16: user.ItemMsg.created_at:type_name -> google.protobuf.Timestamp
+	100, // 17: user.ItemMsg.updated_at:type_name -> google.protobuf.Timestamp
+	61,  // 18: user.ItemMsg.quantity_breaks:type_name -> user.QuantityBreakMsg
+	100, // 19: user.ItemMedia.created_at:type_name -> google.protobuf.Timestamp
+	100, // 20: user.ItemMedia.updated_at:type_name -> google.protobuf.Timestamp
+	12,  // 21: user.ItemMedia.variants:type_name -> user.MediaVariantMsg
+	100, // 22: user.ItemAttribute.created_at:type_name -> google.protobuf.Timestamp
+	100, // 23: user.ItemAttribute.updated_at:type_name -> google.protobuf.Timestamp
+	10,  // 24: user.RelatedProduct.related_item:type_name -> user.ItemMsg
+	100, // 25: user.RelatedProduct.created_at:type_name -> google.protobuf.Timestamp
+	100, // 26: user.RelatedProduct.updated_at:type_name -> google.protobuf.Timestamp
+	10,  // 27: user.ItemRevisionMsg.item:type_name -> user.ItemMsg
+	100, // 28: user.ItemRevisionMsg.created_at:type_name -> google.protobuf.Timestamp
+	18,  // 29: user.PaginatedItemRevisionsRes.data:type_name -> user.ItemRevisionMsg
+	4,   // 30: user.PriceHistoryMsg.old_price:type_name -> user.Money
+	4,   // 31: user.PriceHistoryMsg.new_price:type_name -> user.Money
+	100, // 32: user.PriceHistoryMsg.created_at:type_name -> google.protobuf.Timestamp
+	4,   // 33: user.ScheduledPriceMsg.price:type_name -> user.Money
+	100, // 34: user.ScheduledPriceMsg.starts_at:type_name -> google.protobuf.Timestamp
+	100, // 35: user.ScheduledPriceMsg.applied_at:type_name -> google.protobuf.Timestamp
+	100, // 36: user.ScheduledPriceMsg.created_at:type_name -> google.protobuf.Timestamp
+	4,   // 37: user.PriceTimelineMsg.current_price:type_name -> user.Money
+	4,   // 38: user.PriceTimelineMsg.lowest_price_30d:type_name -> user.Money
+	21,  // 39: user.PriceTimelineMsg.history:type_name -> user.PriceHistoryMsg
+	22,  // 40: user.PriceTimelineMsg.scheduled:type_name -> user.ScheduledPriceMsg
+	14,  // 41: user.RelatedItemsList.items:type_name -> user.RelatedProduct
+	10,  // 42: user.ItemWithUid.item:type_name -> user.ItemMsg
+	101, // 43: user.ItemWithUid.update_mask:type_name -> google.protobuf.FieldMask
+	10,  // 44: user.PaginatedItemRes.data:type_name -> user.ItemMsg
+	13,  // 45: user.PaginatedItemAttrsRes.data:type_name -> user.ItemAttribute
+	7,   // 46: user.PaginatedCategoryRes.data:type_name -> user.CategoryMsg
+	9,   // 47: user.FilterListRes.data:type_name -> user.Filter
+	9,   // 48: user.PaginatedFilterRes.data:type_name -> user.Filter
+	10,  // 49: user.FavoriteMsg.item:type_name -> user.ItemMsg
+	100, // 50: user.FavoriteMsg.created_at:type_name -> google.protobuf.Timestamp
+	100, // 51: user.FavoriteMsg.updated_at:type_name -> google.protobuf.Timestamp
+	4,   // 52: user.FavoriteMsg.effective_price:type_name -> user.Money
+	33,  // 53: user.PaginatedFavoriteRes.data:type_name -> user.FavoriteMsg
+	39,  // 54: user.FavoriteCollectionMsg.items:type_name -> user.FavoriteCollectionItemMsg
+	100, // 55: user.FavoriteCollectionMsg.created_at:type_name -> google.protobuf.Timestamp
+	100, // 56: user.FavoriteCollectionMsg.updated_at:type_name -> google.protobuf.Timestamp
+	10,  // 57: user.FavoriteCollectionItemMsg.item:type_name -> user.ItemMsg
+	100, // 58: user.FavoriteCollectionItemMsg.created_at:type_name -> google.protobuf.Timestamp
+	100, // 59: user.FavoriteCollectionItemMsg.updated_at:type_name -> google.protobuf.Timestamp
+	38,  // 60: user.FavoriteCollectionListMsg.data:type_name -> user.FavoriteCollectionMsg
+	100, // 61: user.PromoMsg.lasts_to:type_name -> google.protobuf.Timestamp
+	100, // 62: user.PromoMsg.created_at:type_name -> google.protobuf.Timestamp
+	100, // 63: user.PromoMsg.updated_at:type_name -> google.protobuf.Timestamp
+	43,  // 64: user.PromoWithSlug.data:type_name -> user.PromoMsg
+	101, // 65: user.PromoWithSlug.update_mask:type_name -> google.protobuf.FieldMask
+	10,  // 66: user.PromoItem.item:type_name -> user.ItemMsg
+	100, // 67: user.PromoItem.created_at:type_name -> google.protobuf.Timestamp
+	100, // 68: user.PromoItem.updated_at:type_name -> google.protobuf.Timestamp
+	43,  // 69: user.PaginatedPromoRes.data:type_name -> user.PromoMsg
+	45,  // 70: user.PaginatedPromoItemsRes.data:type_name -> user.PromoItem
+	4,   // 71: user.OrderMsg.total:type_name -> user.Money
+	50,  // 72: user.OrderMsg.items:type_name -> user.OrderItem
+	100, // 73: user.OrderMsg.created_at:type_name -> google.protobuf.Timestamp
+	100, // 74: user.OrderMsg.updated_at:type_name -> google.protobuf.Timestamp
+	101, // 75: user.OrderMsg.update_mask:type_name -> google.protobuf.FieldMask
+	10,  // 76: user.OrderItem.item:type_name -> user.ItemMsg
+	100, // 77: user.OrderItem.created_at:type_name -> google.protobuf.Timestamp
+	100, // 78: user.OrderItem.updated_at:type_name -> google.protobuf.Timestamp
+	49,  // 79: user.PaginatedOrderRes.data:type_name -> user.OrderMsg
+	100, // 80: user.PriceListMsg.created_at:type_name -> google.protobuf.Timestamp
+	100, // 81: user.PriceListMsg.updated_at:type_name -> google.protobuf.Timestamp
+	52,  // 82: user.PriceListListRes.data:type_name -> user.PriceListMsg
+	4,   // 83: user.PriceListItemMsg.price:type_name -> user.Money
+	54,  // 84: user.PaginatedPriceListItemsRes.data:type_name -> user.PriceListItemMsg
+	54,  // 85: user.SetPriceListItemsReq.items:type_name -> user.PriceListItemMsg
+	100, // 86: user.CustomerGroupMsg.created_at:type_name -> google.protobuf.Timestamp
+	100, // 87: user.CustomerGroupMsg.updated_at:type_name -> google.protobuf.Timestamp
+	59,  // 88: user.CustomerGroupListRes.data:type_name -> user.CustomerGroupMsg
+	4,   // 89: user.QuantityBreakMsg.price:type_name -> user.Money
+	61,  // 90: user.QuantityBreakListRes.data:type_name -> user.QuantityBreakMsg
+	61,  // 91: user.SetQuantityBreaksReq.breaks:type_name -> user.QuantityBreakMsg
+	64,  // 92: user.UploadItemMediaReq.info:type_name -> user.ItemMediaInfo
+	11,  // 93: user.ItemMediaList.data:type_name -> user.ItemMedia
+	100, // 94: user.AttributeMsg.created_at:type_name -> google.protobuf.Timestamp
+	100, // 95: user.AttributeMsg.updated_at:type_name -> google.protobuf.Timestamp
+	69,  // 96: user.AttributeListRes.data:type_name -> user.AttributeMsg
+	69,  // 97: user.CategoryAttributeMsg.attribute:type_name -> user.AttributeMsg
+	71,  // 98: user.CategoryAttributeListRes.data:type_name -> user.CategoryAttributeMsg
+	71,  // 99: user.SetCategoryAttributesReq.attributes:type_name -> user.CategoryAttributeMsg
+	100, // 100: user.LabelMsg.created_at:type_name -> google.protobuf.Timestamp
+	100, // 101: user.LabelMsg.updated_at:type_name -> google.protobuf.Timestamp
+	74,  // 102: user.LabelListRes.data:type_name -> user.LabelMsg
+	74,  // 103: user.UpdateLabelReq.label:type_name -> user.LabelMsg
+	79,  // 104: user.ReviewMsg.media:type_name -> user.ReviewMediaMsg
+	100, // 105: user.ReviewMsg.moderated_at:type_name -> google.protobuf.Timestamp
+	100, // 106: user.ReviewMsg.created_at:type_name -> google.protobuf.Timestamp
+	100, // 107: user.ReviewMsg.updated_at:type_name -> google.protobuf.Timestamp
+	12,  // 108: user.ReviewMediaMsg.variants:type_name -> user.MediaVariantMsg
+	100, // 109: user.ReviewMediaMsg.created_at:type_name -> google.protobuf.Timestamp
+	78,  // 110: user.PaginatedReviewRes.data:type_name -> user.ReviewMsg
+	84,  // 111: user.UploadReviewMediaReq.info:type_name -> user.ReviewMediaInfo
+	87,  // 112: user.QuestionMsg.answers:type_name -> user.AnswerMsg
+	100, // 113: user.QuestionMsg.moderated_at:type_name -> google.protobuf.Timestamp
+	100, // 114: user.QuestionMsg.created_at:type_name -> google.protobuf.Timestamp
+	100, // 115: user.QuestionMsg.updated_at:type_name -> google.protobuf.Timestamp
+	100, // 116: user.AnswerMsg.moderated_at:type_name -> google.protobuf.Timestamp
+	100, // 117: user.AnswerMsg.created_at:type_name -> google.protobuf.Timestamp
+	100, // 118: user.AnswerMsg.updated_at:type_name -> google.protobuf.Timestamp
+	86,  // 119: user.PaginatedQuestionRes.data:type_name -> user.QuestionMsg
+	87,  // 120: user.PaginatedAnswerRes.data:type_name -> user.AnswerMsg
+	100, // 121: user.TrashEntryMsg.deleted_at:type_name -> google.protobuf.Timestamp
+	95,  // 122: user.PaginatedTrashRes.data:type_name -> user.TrashEntryMsg
+	100, // 123: user.ListAuditLogReq.from:type_name -> google.protobuf.Timestamp
+	100, // 124: user.ListAuditLogReq.to:type_name -> google.protobuf.Timestamp
+	100, // 125: user.AuditEntryMsg.created_at:type_name -> google.protobuf.Timestamp
+	98,  // 126: user.PaginatedAuditRes.data:type_name -> user.AuditEntryMsg
+	6,   // 127: user.Item.ItemSearch:input_type -> user.SearchReq
+	6,   // 128: user.Item.ItemAttrSearch:input_type -> user.SearchReq
+	5,   // 129: user.Item.ListItems:input_type -> user.ListReq
+	10,  // 130: user.Item.CreateItem:input_type -> user.ItemMsg
+	1,   // 131: user.Item.GetItem:input_type -> user.uuidMsg
+	27,  // 132: user.Item.UpdateItem:input_type -> user.ItemWithUid
+	1,   // 133: user.Item.DeleteItem:input_type -> user.uuidMsg
+	1,   // 134: user.Item.ListRelatedItems:input_type -> user.uuidMsg
+	25,  // 135: user.Item.listCategoryItems:input_type -> user.listCategoryItemsReq
+	24,  // 136: user.Item.ListItemsByLabel:input_type -> user.ListItemsByLabelReq
+	1,   // 137: user.Item.GetPriceTimeline:input_type -> user.uuidMsg
+	22,  // 138: user.Item.SchedulePriceChange:input_type -> user.ScheduledPriceMsg
+	3,   // 139: user.Item.CancelScheduledPrice:input_type -> user.uint64Msg
+	15,  // 140: user.Item.ListItemRevisions:input_type -> user.ListItemRevisionsReq
+	17,  // 141: user.Item.DiffItemRevisions:input_type -> user.DiffItemRevisionsReq
+	16,  // 142: user.Item.RollbackItem:input_type -> user.ItemRevisionReq
+	5,   // 143: user.Category.ListCategories:input_type -> user.ListReq
+	7,   // 144: user.Category.CreateCategory:input_type -> user.CategoryMsg
+	6,   // 145: user.Category.CategorySearch:input_type -> user.SearchReq
+	6,   // 146: user.Category.CategoryFiltersSearch:input_type -> user.SearchReq
+	2,   // 147: user.Category.GetCategory:input_type -> user.slugMsg
+	8,   // 148: user.Category.UpdateCategory:input_type -> user.CategoryWithSlug
+	2,   // 149: user.Category.DeleteCategory:input_type -> user.slugMsg
+	2,   // 150: user.Category.ListCategoryFilters:input_type -> user.slugMsg
+	2,   // 151: user.Category.RebuildCategoryFilters:input_type -> user.slugMsg
+	34,  // 152: user.Favorite.ListFavorites:input_type -> user.ListFavoritesReq
+	36,  // 153: user.Favorite.AddToFavorites:input_type -> user.UserAndItemIds
+	36,  // 154: user.Favorite.RemoveFromFavorites:input_type -> user.UserAndItemIds
+	37,  // 155: user.Favorite.SetFavoriteNotifications:input_type -> user.FavoriteNotificationsReq
+	1,   // 156: user.Favorite.ListFavoriteCollections:input_type -> user.uuidMsg
+	41,  // 157: user.Favorite.GetFavoriteCollection:input_type -> user.FavoriteCollectionReq
+	42,  // 158: user.Favorite.GetSharedFavoriteCollection:input_type -> user.ShareTokenMsg
+	38,  // 159: user.Favorite.CreateFavoriteCollection:input_type -> user.FavoriteCollectionMsg
+	38,  // 160: user.Favorite.UpdateFavoriteCollection:input_type -> user.FavoriteCollectionMsg
+	41,  // 161: user.Favorite.DeleteFavoriteCollection:input_type -> user.FavoriteCollectionReq
+	41,  // 162: user.Favorite.ShareFavoriteCollection:input_type -> user.FavoriteCollectionReq
+	41,  // 163: user.Favorite.UnshareFavoriteCollection:input_type -> user.FavoriteCollectionReq
+	39,  // 164: user.Favorite.SetFavoriteCollectionItem:input_type -> user.FavoriteCollectionItemMsg
+	39,  // 165: user.Favorite.RemoveFavoriteCollectionItem:input_type -> user.FavoriteCollectionItemMsg
+	5,   // 166: user.Promotion.ListPromotions:input_type -> user.ListReq
+	6,   // 167: user.Promotion.PromotionSearch:input_type -> user.SearchReq
+	43,  // 168: user.Promotion.CreatePromotion:input_type -> user.PromoMsg
+	2,   // 169: user.Promotion.GetPromotion:input_type -> user.slugMsg
+	44,  // 170: user.Promotion.UpdatePromotion:input_type -> user.PromoWithSlug
+	2,   // 171: user.Promotion.DeletePromotion:input_type -> user.slugMsg
+	48,  // 172: user.Promotion.ListPromotionItems:input_type -> user.ListPromotionItemsReq
+	5,   // 173: user.Order.ListOrders:input_type -> user.ListReq
+	5,   // 174: user.Order.ListUserOrders:input_type -> user.ListReq
+	3,   // 175: user.Order.GetOrder:input_type -> user.uint64Msg
+	49,  // 176: user.Order.CreateOrder:input_type -> user.OrderMsg
+	49,  // 177: user.Order.UpdateOrder:input_type -> user.OrderMsg
+	3,   // 178: user.Order.CancelOrder:input_type -> user.uint64Msg
+	3,   // 179: user.Order.DeleteOrder:input_type -> user.uint64Msg
+	0,   // 180: user.PriceList.ListPriceLists:input_type -> user.Empty
+	2,   // 181: user.PriceList.GetPriceList:input_type -> user.slugMsg
+	52,  // 182: user.PriceList.CreatePriceList:input_type -> user.PriceListMsg
+	52,  // 183: user.PriceList.UpdatePriceList:input_type -> user.PriceListMsg
+	2,   // 184: user.PriceList.DeletePriceList:input_type -> user.slugMsg
+	55,  // 185: user.PriceList.ListPriceListItems:input_type -> user.ListPriceListItemsReq
+	57,  // 186: user.PriceList.SetPriceListItems:input_type -> user.SetPriceListItemsReq
+	58,  // 187: user.PriceList.DeletePriceListItem:input_type -> user.PriceListItemReq
+	0,   // 188: user.CustomerGroup.ListCustomerGroups:input_type -> user.Empty
+	2,   // 189: user.CustomerGroup.GetCustomerGroup:input_type -> user.slugMsg
+	59,  // 190: user.CustomerGroup.CreateCustomerGroup:input_type -> user.CustomerGroupMsg
+	59,  // 191: user.CustomerGroup.UpdateCustomerGroup:input_type -> user.CustomerGroupMsg
+	2,   // 192: user.CustomerGroup.DeleteCustomerGroup:input_type -> user.slugMsg
+	1,   // 193: user.CustomerGroup.ListQuantityBreaks:input_type -> user.uuidMsg
+	63,  // 194: user.CustomerGroup.SetQuantityBreaks:input_type -> user.SetQuantityBreaksReq
+	1,   // 195: user.Media.ListItemMedia:input_type -> user.uuidMsg
+	65,  // 196: user.Media.UploadItemMedia:input_type -> user.UploadItemMediaReq
+	11,  // 197: user.Media.UpdateItemMedia:input_type -> user.ItemMedia
+	67,  // 198: user.Media.ReorderItemMedia:input_type -> user.ReorderItemMediaReq
+	68,  // 199: user.Media.DeleteItemMedia:input_type -> user.ItemMediaReq
+	0,   // 200: user.Attribute.ListAttributes:input_type -> user.Empty
+	2,   // 201: user.Attribute.GetAttribute:input_type -> user.slugMsg
+	69,  // 202: user.Attribute.CreateAttribute:input_type -> user.AttributeMsg
+	69,  // 203: user.Attribute.UpdateAttribute:input_type -> user.AttributeMsg
+	2,   // 204: user.Attribute.DeleteAttribute:input_type -> user.slugMsg
+	2,   // 205: user.Attribute.ListCategoryAttributes:input_type -> user.slugMsg
+	73,  // 206: user.Attribute.SetCategoryAttributes:input_type -> user.SetCategoryAttributesReq
+	0,   // 207: user.Label.ListLabels:input_type -> user.Empty
+	74,  // 208: user.Label.CreateLabel:input_type -> user.LabelMsg
+	76,  // 209: user.Label.UpdateLabel:input_type -> user.UpdateLabelReq
+	2,   // 210: user.Label.DeleteLabel:input_type -> user.slugMsg
+	77,  // 211: user.Label.SetItemLabels:input_type -> user.SetItemLabelsReq
+	80,  // 212: user.Review.ListItemReviews:input_type -> user.ListItemReviewsReq
+	5,   // 213: user.Review.ListPendingReviews:input_type -> user.ListReq
+	78,  // 214: user.Review.CreateReview:input_type -> user.ReviewMsg
+	78,  // 215: user.Review.UpdateReview:input_type -> user.ReviewMsg
+	82,  // 216: user.Review.ModerateReview:input_type -> user.ModerateReviewReq
+	83,  // 217: user.Review.DeleteReview:input_type -> user.DeleteReviewReq
+	85,  // 218: user.Review.UploadReviewMedia:input_type -> user.UploadReviewMediaReq
+	88,  // 219: user.Question.ListItemQuestions:input_type -> user.ListItemQuestionsReq
+	5,   // 220: user.Question.ListPendingQuestions:input_type -> user.ListReq
+	86,  // 221: user.Question.CreateQuestion:input_type -> user.QuestionMsg
+	91,  // 222: user.Question.ModerateQuestion:input_type -> user.ModerateQuestionReq
+	3,   // 223: user.Question.DeleteQuestion:input_type -> user.uint64Msg
+	5,   // 224: user.Question.ListPendingAnswers:input_type -> user.ListReq
+	87,  // 225: user.Question.CreateAnswer:input_type -> user.AnswerMsg
+	91,  // 226: user.Question.ModerateAnswer:input_type -> user.ModerateQuestionReq
+	92,  // 227: user.Question.SetAnswerOfficial:input_type -> user.SetAnswerOfficialReq
+	3,   // 228: user.Question.DeleteAnswer:input_type -> user.uint64Msg
+	93,  // 229: user.Trash.ListTrash:input_type -> user.ListTrashReq
+	94,  // 230: user.Trash.MoveToTrash:input_type -> user.TrashEntryReq
+	94,  // 231: user.Trash.RestoreFromTrash:input_type -> user.TrashEntryReq
+	94,  // 232: user.Trash.PurgeFromTrash:input_type -> user.TrashEntryReq
+	97,  // 233: user.Audit.ListAuditLog:input_type -> user.ListAuditLogReq
+	28,  // 234: user.Item.ItemSearch:output_type -> user.PaginatedItemRes
+	29,  // 235: user.Item.ItemAttrSearch:output_type -> user.PaginatedItemAttrsRes
+	28,  // 236: user.Item.ListItems:output_type -> user.PaginatedItemRes
+	1,   // 237: user.Item.CreateItem:output_type -> user.uuidMsg
+	10,  // 238: user.Item.GetItem:output_type -> user.ItemMsg
+	0,   // 239: user.Item.UpdateItem:output_type -> user.Empty
+	0,   // 240: user.Item.DeleteItem:output_type -> user.Empty
+	26,  // 241: user.Item.ListRelatedItems:output_type -> user.RelatedItemsList
+	28,  // 242: user.Item.listCategoryItems:output_type -> user.PaginatedItemRes
+	28,  // 243: user.Item.ListItemsByLabel:output_type -> user.PaginatedItemRes
+	23,  // 244: user.Item.GetPriceTimeline:output_type -> user.PriceTimelineMsg
+	3,   // 245: user.Item.SchedulePriceChange:output_type -> user.uint64Msg
+	0,   // 246: user.Item.CancelScheduledPrice:output_type -> user.Empty
+	19,  // 247: user.Item.ListItemRevisions:output_type -> user.PaginatedItemRevisionsRes
+	20,  // 248: user.Item.DiffItemRevisions:output_type -> user.ItemRevisionDiffMsg
+	0,   // 249: user.Item.RollbackItem:output_type -> user.Empty
+	30,  // 250: user.Category.ListCategories:output_type -> user.PaginatedCategoryRes
+	2,   // 251: user.Category.CreateCategory:output_type -> user.slugMsg
+	30,  // 252: user.Category.CategorySearch:output_type -> user.PaginatedCategoryRes
+	32,  // 253: user.Category.CategoryFiltersSearch:output_type -> user.PaginatedFilterRes
+	7,   // 254: user.Category.GetCategory:output_type -> user.CategoryMsg
+	0,   // 255: user.Category.UpdateCategory:output_type -> user.Empty
+	0,   // 256: user.Category.DeleteCategory:output_type -> user.Empty
+	31,  // 257: user.Category.ListCategoryFilters:output_type -> user.FilterListRes
+	0,   // 258: user.Category.RebuildCategoryFilters:output_type -> user.Empty
+	35,  // 259: user.Favorite.ListFavorites:output_type -> user.PaginatedFavoriteRes
+	33,  // 260: user.Favorite.AddToFavorites:output_type -> user.FavoriteMsg
+	0,   // 261: user.Favorite.RemoveFromFavorites:output_type -> user.Empty
+	0,   // 262: user.Favorite.SetFavoriteNotifications:output_type -> user.Empty
+	40,  // 263: user.Favorite.ListFavoriteCollections:output_type -> user.FavoriteCollectionListMsg
+	38,  // 264: user.Favorite.GetFavoriteCollection:output_type -> user.FavoriteCollectionMsg
+	38,  // 265: user.Favorite.GetSharedFavoriteCollection:output_type -> user.FavoriteCollectionMsg
+	3,   // 266: user.Favorite.CreateFavoriteCollection:output_type -> user.uint64Msg
+	0,   // 267: user.Favorite.UpdateFavoriteCollection:output_type -> user.Empty
+	0,   // 268: user.Favorite.DeleteFavoriteCollection:output_type -> user.Empty
+	42,  // 269: user.Favorite.ShareFavoriteCollection:output_type -> user.ShareTokenMsg
+	0,   // 270: user.Favorite.UnshareFavoriteCollection:output_type -> user.Empty
+	0,   // 271: user.Favorite.SetFavoriteCollectionItem:output_type -> user.Empty
+	0,   // 272: user.Favorite.RemoveFavoriteCollectionItem:output_type -> user.Empty
+	46,  // 273: user.Promotion.ListPromotions:output_type -> user.PaginatedPromoRes
+	46,  // 274: user.Promotion.PromotionSearch:output_type -> user.PaginatedPromoRes
+	2,   // 275: user.Promotion.CreatePromotion:output_type -> user.slugMsg
+	43,  // 276: user.Promotion.GetPromotion:output_type -> user.PromoMsg
+	0,   // 277: user.Promotion.UpdatePromotion:output_type -> user.Empty
+	0,   // 278: user.Promotion.DeletePromotion:output_type -> user.Empty
+	47,  // 279: user.Promotion.ListPromotionItems:output_type -> user.PaginatedPromoItemsRes
+	51,  // 280: user.Order.ListOrders:output_type -> user.PaginatedOrderRes
+	51,  // 281: user.Order.ListUserOrders:output_type -> user.PaginatedOrderRes
+	49,  // 282: user.Order.GetOrder:output_type -> user.OrderMsg
+	3,   // 283: user.Order.CreateOrder:output_type -> user.uint64Msg
+	0,   // 284: user.Order.UpdateOrder:output_type -> user.Empty
+	0,   // 285: user.Order.CancelOrder:output_type -> user.Empty
+	0,   // 286: user.Order.DeleteOrder:output_type -> user.Empty
+	53,  // 287: user.PriceList.ListPriceLists:output_type -> user.PriceListListRes
+	52,  // 288: user.PriceList.GetPriceList:output_type -> user.PriceListMsg
+	2,   // 289: user.PriceList.CreatePriceList:output_type -> user.slugMsg
+	0,   // 290: user.PriceList.UpdatePriceList:output_type -> user.Empty
+	0,   // 291: user.PriceList.DeletePriceList:output_type -> user.Empty
+	56,  // 292: user.PriceList.ListPriceListItems:output_type -> user.PaginatedPriceListItemsRes
+	0,   // 293: user.PriceList.SetPriceListItems:output_type -> user.Empty
+	0,   // 294: user.PriceList.DeletePriceListItem:output_type -> user.Empty
+	60,  // 295: user.CustomerGroup.ListCustomerGroups:output_type -> user.CustomerGroupListRes
+	59,  // 296: user.CustomerGroup.GetCustomerGroup:output_type -> user.CustomerGroupMsg
+	2,   // 297: user.CustomerGroup.CreateCustomerGroup:output_type -> user.slugMsg
+	0,   // 298: user.CustomerGroup.UpdateCustomerGroup:output_type -> user.Empty
+	0,   // 299: user.CustomerGroup.DeleteCustomerGroup:output_type -> user.Empty
+	62,  // 300: user.CustomerGroup.ListQuantityBreaks:output_type -> user.QuantityBreakListRes
+	0,   // 301: user.CustomerGroup.SetQuantityBreaks:output_type -> user.Empty
+	66,  // 302: user.Media.ListItemMedia:output_type -> user.ItemMediaList
+	11,  // 303: user.Media.UploadItemMedia:output_type -> user.ItemMedia
+	0,   // 304: user.Media.UpdateItemMedia:output_type -> user.Empty
+	0,   // 305: user.Media.ReorderItemMedia:output_type -> user.Empty
+	0,   // 306: user.Media.DeleteItemMedia:output_type -> user.Empty
+	70,  // 307: user.Attribute.ListAttributes:output_type -> user.AttributeListRes
+	69,  // 308: user.Attribute.GetAttribute:output_type -> user.AttributeMsg
+	2,   // 309: user.Attribute.CreateAttribute:output_type -> user.slugMsg
+	0,   // 310: user.Attribute.UpdateAttribute:output_type -> user.Empty
+	0,   // 311: user.Attribute.DeleteAttribute:output_type -> user.Empty
+	72,  // 312: user.Attribute.ListCategoryAttributes:output_type -> user.CategoryAttributeListRes
+	0,   // 313: user.Attribute.SetCategoryAttributes:output_type -> user.Empty
+	75,  // 314: user.Label.ListLabels:output_type -> user.LabelListRes
+	3,   // 315: user.Label.CreateLabel:output_type -> user.uint64Msg
+	0,   // 316: user.Label.UpdateLabel:output_type -> user.Empty
+	0,   // 317: user.Label.DeleteLabel:output_type -> user.Empty
+	0,   // 318: user.Label.SetItemLabels:output_type -> user.Empty
+	81,  // 319: user.Review.ListItemReviews:output_type -> user.PaginatedReviewRes
+	81,  // 320: user.Review.ListPendingReviews:output_type -> user.PaginatedReviewRes
+	3,   // 321: user.Review.CreateReview:output_type -> user.uint64Msg
+	0,   // 322: user.Review.UpdateReview:output_type -> user.Empty
+	0,   // 323: user.Review.ModerateReview:output_type -> user.Empty
+	0,   // 324: user.Review.DeleteReview:output_type -> user.Empty
+	79,  // 325: user.Review.UploadReviewMedia:output_type -> user.ReviewMediaMsg
+	89,  // 326: user.Question.ListItemQuestions:output_type -> user.PaginatedQuestionRes
+	89,  // 327: user.Question.ListPendingQuestions:output_type -> user.PaginatedQuestionRes
+	3,   // 328: user.Question.CreateQuestion:output_type -> user.uint64Msg
+	0,   // 329: user.Question.ModerateQuestion:output_type -> user.Empty
+	0,   // 330: user.Question.DeleteQuestion:output_type -> user.Empty
+	90,  // 331: user.Question.ListPendingAnswers:output_type -> user.PaginatedAnswerRes
+	3,   // 332: user.Question.CreateAnswer:output_type -> user.uint64Msg
+	0,   // 333: user.Question.ModerateAnswer:output_type -> user.Empty
+	0,   // 334: user.Question.SetAnswerOfficial:output_type -> user.Empty
+	0,   // 335: user.Question.DeleteAnswer:output_type -> user.Empty
+	96,  // 336: user.Trash.ListTrash:output_type -> user.PaginatedTrashRes
+	0,   // 337: user.Trash.MoveToTrash:output_type -> user.Empty
+	0,   // 338: user.Trash.RestoreFromTrash:output_type -> user.Empty
+	0,   // 339: user.Trash.PurgeFromTrash:output_type -> user.Empty
+	99,  // 340: user.Audit.ListAuditLog:output_type -> user.PaginatedAuditRes
+	234, // [234:341] is the sub-list for method output_type
+	127, // [127:234] is the sub-list for method input_type
+	127, // [127:127] is the sub-list for extension type_name
+	127, // [127:127] is the sub-list for extension extendee
+	0,   // [0:127] is the sub-list for field type_name
+}
+
+func init() { file_api_pb_products_proto_init() }
+func file_api_pb_products_proto_init() {
+	if File_api_pb_products_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_pb_products_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*UuidMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*SlugMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*Uint64Msg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*Money); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*ListReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*CategoryMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*CategoryWithSlug); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*Filter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*ItemMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*ItemMedia); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*MediaVariantMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*ItemAttribute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*RelatedProduct); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*ListItemRevisionsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*ItemRevisionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*DiffItemRevisionsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*ItemRevisionMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*PaginatedItemRevisionsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*ItemRevisionDiffMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*PriceHistoryMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*ScheduledPriceMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*PriceTimelineMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*ListItemsByLabelReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*ListCategoryItemsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*RelatedItemsList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*ItemWithUid); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*PaginatedItemRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*PaginatedItemAttrsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*PaginatedCategoryRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*FilterListRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*PaginatedFilterRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*FavoriteMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*ListFavoritesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*PaginatedFavoriteRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*UserAndItemIds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*FavoriteNotificationsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*FavoriteCollectionMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*FavoriteCollectionItemMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*FavoriteCollectionListMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*FavoriteCollectionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*ShareTokenMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*PromoMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*PromoWithSlug); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*PromoItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*PaginatedPromoRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*PaginatedPromoItemsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*ListPromotionItemsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*OrderMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*OrderItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*PaginatedOrderRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*PriceListMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*PriceListListRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*PriceListItemMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*ListPriceListItemsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*PaginatedPriceListItemsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*SetPriceListItemsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*PriceListItemReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*CustomerGroupMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*CustomerGroupListRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*QuantityBreakMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*QuantityBreakListRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*SetQuantityBreaksReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*ItemMediaInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3349,8 +10418,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*UuidMsg); i {
+		file_api_pb_products_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*UploadItemMediaReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3361,8 +10430,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*SlugMsg); i {
+		file_api_pb_products_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*ItemMediaList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3373,8 +10442,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*Uint64Msg); i {
+		file_api_pb_products_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*ReorderItemMediaReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3385,8 +10454,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*ListReq); i {
+		file_api_pb_products_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*ItemMediaReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3397,8 +10466,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchReq); i {
+		file_api_pb_products_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*AttributeMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3409,8 +10478,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*CategoryMsg); i {
+		file_api_pb_products_proto_msgTypes[70].Exporter = func(v any, i int) any {
+			switch v := v.(*AttributeListRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3421,8 +10490,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*CategoryWithSlug); i {
+		file_api_pb_products_proto_msgTypes[71].Exporter = func(v any, i int) any {
+			switch v := v.(*CategoryAttributeMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3433,8 +10502,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*Filter); i {
+		file_api_pb_products_proto_msgTypes[72].Exporter = func(v any, i int) any {
+			switch v := v.(*CategoryAttributeListRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3445,8 +10514,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*ItemMsg); i {
+		file_api_pb_products_proto_msgTypes[73].Exporter = func(v any, i int) any {
+			switch v := v.(*SetCategoryAttributesReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3457,8 +10526,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*ItemMedia); i {
+		file_api_pb_products_proto_msgTypes[74].Exporter = func(v any, i int) any {
+			switch v := v.(*LabelMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3469,8 +10538,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*ItemAttribute); i {
+		file_api_pb_products_proto_msgTypes[75].Exporter = func(v any, i int) any {
+			switch v := v.(*LabelListRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3481,8 +10550,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*RelatedProduct); i {
+		file_api_pb_products_proto_msgTypes[76].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateLabelReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3493,8 +10562,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*ListItemsByLabelReq); i {
+		file_api_pb_products_proto_msgTypes[77].Exporter = func(v any, i int) any {
+			switch v := v.(*SetItemLabelsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3505,8 +10574,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*ListCategoryItemsReq); i {
+		file_api_pb_products_proto_msgTypes[78].Exporter = func(v any, i int) any {
+			switch v := v.(*ReviewMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3517,8 +10586,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*RelatedItemsList); i {
+		file_api_pb_products_proto_msgTypes[79].Exporter = func(v any, i int) any {
+			switch v := v.(*ReviewMediaMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3529,8 +10598,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*ItemWithUid); i {
+		file_api_pb_products_proto_msgTypes[80].Exporter = func(v any, i int) any {
+			switch v := v.(*ListItemReviewsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3541,8 +10610,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*PaginatedItemRes); i {
+		file_api_pb_products_proto_msgTypes[81].Exporter = func(v any, i int) any {
+			switch v := v.(*PaginatedReviewRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3553,8 +10622,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*PaginatedItemAttrsRes); i {
+		file_api_pb_products_proto_msgTypes[82].Exporter = func(v any, i int) any {
+			switch v := v.(*ModerateReviewReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3565,8 +10634,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*PaginatedCategoryRes); i {
+		file_api_pb_products_proto_msgTypes[83].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteReviewReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3577,8 +10646,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*FilterListRes); i {
+		file_api_pb_products_proto_msgTypes[84].Exporter = func(v any, i int) any {
+			switch v := v.(*ReviewMediaInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3589,8 +10658,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*PaginatedFilterRes); i {
+		file_api_pb_products_proto_msgTypes[85].Exporter = func(v any, i int) any {
+			switch v := v.(*UploadReviewMediaReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3601,8 +10670,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*FavoriteMsg); i {
+		file_api_pb_products_proto_msgTypes[86].Exporter = func(v any, i int) any {
+			switch v := v.(*QuestionMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3613,8 +10682,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*FavoriteListMsg); i {
+		file_api_pb_products_proto_msgTypes[87].Exporter = func(v any, i int) any {
+			switch v := v.(*AnswerMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3625,8 +10694,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*UserAndItemIds); i {
+		file_api_pb_products_proto_msgTypes[88].Exporter = func(v any, i int) any {
+			switch v := v.(*ListItemQuestionsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3637,8 +10706,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[25].Exporter = func(v any, i int) any {
-			switch v := v.(*PromoMsg); i {
+		file_api_pb_products_proto_msgTypes[89].Exporter = func(v any, i int) any {
+			switch v := v.(*PaginatedQuestionRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3649,8 +10718,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[26].Exporter = func(v any, i int) any {
-			switch v := v.(*PromoWithSlug); i {
+		file_api_pb_products_proto_msgTypes[90].Exporter = func(v any, i int) any {
+			switch v := v.(*PaginatedAnswerRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3661,8 +10730,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[27].Exporter = func(v any, i int) any {
-			switch v := v.(*PromoItem); i {
+		file_api_pb_products_proto_msgTypes[91].Exporter = func(v any, i int) any {
+			switch v := v.(*ModerateQuestionReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3673,8 +10742,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[28].Exporter = func(v any, i int) any {
-			switch v := v.(*PaginatedPromoRes); i {
+		file_api_pb_products_proto_msgTypes[92].Exporter = func(v any, i int) any {
+			switch v := v.(*SetAnswerOfficialReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3685,8 +10754,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[29].Exporter = func(v any, i int) any {
-			switch v := v.(*PaginatedPromoItemsRes); i {
+		file_api_pb_products_proto_msgTypes[93].Exporter = func(v any, i int) any {
+			switch v := v.(*ListTrashReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3697,8 +10766,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[30].Exporter = func(v any, i int) any {
-			switch v := v.(*ListPromotionItemsReq); i {
+		file_api_pb_products_proto_msgTypes[94].Exporter = func(v any, i int) any {
+			switch v := v.(*TrashEntryReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3709,8 +10778,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[31].Exporter = func(v any, i int) any {
-			switch v := v.(*OrderMsg); i {
+		file_api_pb_products_proto_msgTypes[95].Exporter = func(v any, i int) any {
+			switch v := v.(*TrashEntryMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3721,8 +10790,8 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[32].Exporter = func(v any, i int) any {
-			switch v := v.(*OrderItem); i {
+		file_api_pb_products_proto_msgTypes[96].Exporter = func(v any, i int) any {
+			switch v := v.(*PaginatedTrashRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3733,8 +10802,32 @@ func file_api_pb_products_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_products_proto_msgTypes[33].Exporter = func(v any, i int) any {
-			switch v := v.(*PaginatedOrderRes); i {
+		file_api_pb_products_proto_msgTypes[97].Exporter = func(v any, i int) any {
+			switch v := v.(*ListAuditLogReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[98].Exporter = func(v any, i int) any {
+			switch v := v.(*AuditEntryMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_products_proto_msgTypes[99].Exporter = func(v any, i int) any {
+			switch v := v.(*PaginatedAuditRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3746,15 +10839,23 @@ func file_api_pb_products_proto_init() {
 			}
 		}
 	}
+	file_api_pb_products_proto_msgTypes[65].OneofWrappers = []any{
+		(*UploadItemMediaReq_Info)(nil),
+		(*UploadItemMediaReq_Chunk)(nil),
+	}
+	file_api_pb_products_proto_msgTypes[85].OneofWrappers = []any{
+		(*UploadReviewMediaReq_Info)(nil),
+		(*UploadReviewMediaReq_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_pb_products_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   34,
+			NumMessages:   100,
 			NumExtensions: 0,
-			NumServices:   5,
+			NumServices:   14,
 		},
 		GoTypes:           file_api_pb_products_proto_goTypes,
 		DependencyIndexes: file_api_pb_products_proto_depIdxs,
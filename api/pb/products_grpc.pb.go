@@ -19,16 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Item_ItemSearch_FullMethodName        = "/user.Item/ItemSearch"
-	Item_ItemAttrSearch_FullMethodName    = "/user.Item/ItemAttrSearch"
-	Item_ListItems_FullMethodName         = "/user.Item/ListItems"
-	Item_CreateItem_FullMethodName        = "/user.Item/CreateItem"
-	Item_GetItem_FullMethodName           = "/user.Item/GetItem"
-	Item_UpdateItem_FullMethodName        = "/user.Item/UpdateItem"
-	Item_DeleteItem_FullMethodName        = "/user.Item/DeleteItem"
-	Item_ListRelatedItems_FullMethodName  = "/user.Item/ListRelatedItems"
-	Item_ListCategoryItems_FullMethodName = "/user.Item/listCategoryItems"
-	Item_ListItemsByLabel_FullMethodName  = "/user.Item/ListItemsByLabel"
+	Item_ItemSearch_FullMethodName           = "/user.Item/ItemSearch"
+	Item_ItemAttrSearch_FullMethodName       = "/user.Item/ItemAttrSearch"
+	Item_ListItems_FullMethodName            = "/user.Item/ListItems"
+	Item_CreateItem_FullMethodName           = "/user.Item/CreateItem"
+	Item_GetItem_FullMethodName              = "/user.Item/GetItem"
+	Item_UpdateItem_FullMethodName           = "/user.Item/UpdateItem"
+	Item_DeleteItem_FullMethodName           = "/user.Item/DeleteItem"
+	Item_ListRelatedItems_FullMethodName     = "/user.Item/ListRelatedItems"
+	Item_ListCategoryItems_FullMethodName    = "/user.Item/listCategoryItems"
+	Item_ListItemsByLabel_FullMethodName     = "/user.Item/ListItemsByLabel"
+	Item_GetPriceTimeline_FullMethodName     = "/user.Item/GetPriceTimeline"
+	Item_SchedulePriceChange_FullMethodName  = "/user.Item/SchedulePriceChange"
+	Item_CancelScheduledPrice_FullMethodName = "/user.Item/CancelScheduledPrice"
+	Item_ListItemRevisions_FullMethodName    = "/user.Item/ListItemRevisions"
+	Item_DiffItemRevisions_FullMethodName    = "/user.Item/DiffItemRevisions"
+	Item_RollbackItem_FullMethodName         = "/user.Item/RollbackItem"
 )
 
 // ItemClient is the client API for Item service.
@@ -45,6 +51,12 @@ type ItemClient interface {
 	ListRelatedItems(ctx context.Context, in *UuidMsg, opts ...grpc.CallOption) (*RelatedItemsList, error)
 	ListCategoryItems(ctx context.Context, in *ListCategoryItemsReq, opts ...grpc.CallOption) (*PaginatedItemRes, error)
 	ListItemsByLabel(ctx context.Context, in *ListItemsByLabelReq, opts ...grpc.CallOption) (*PaginatedItemRes, error)
+	GetPriceTimeline(ctx context.Context, in *UuidMsg, opts ...grpc.CallOption) (*PriceTimelineMsg, error)
+	SchedulePriceChange(ctx context.Context, in *ScheduledPriceMsg, opts ...grpc.CallOption) (*Uint64Msg, error)
+	CancelScheduledPrice(ctx context.Context, in *Uint64Msg, opts ...grpc.CallOption) (*Empty, error)
+	ListItemRevisions(ctx context.Context, in *ListItemRevisionsReq, opts ...grpc.CallOption) (*PaginatedItemRevisionsRes, error)
+	DiffItemRevisions(ctx context.Context, in *DiffItemRevisionsReq, opts ...grpc.CallOption) (*ItemRevisionDiffMsg, error)
+	RollbackItem(ctx context.Context, in *ItemRevisionReq, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type itemClient struct {
@@ -155,6 +167,66 @@ func (c *itemClient) ListItemsByLabel(ctx context.Context, in *ListItemsByLabelR
 	return out, nil
 }
 
+func (c *itemClient) GetPriceTimeline(ctx context.Context, in *UuidMsg, opts ...grpc.CallOption) (*PriceTimelineMsg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceTimelineMsg)
+	err := c.cc.Invoke(ctx, Item_GetPriceTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemClient) SchedulePriceChange(ctx context.Context, in *ScheduledPriceMsg, opts ...grpc.CallOption) (*Uint64Msg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Uint64Msg)
+	err := c.cc.Invoke(ctx, Item_SchedulePriceChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemClient) CancelScheduledPrice(ctx context.Context, in *Uint64Msg, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Item_CancelScheduledPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemClient) ListItemRevisions(ctx context.Context, in *ListItemRevisionsReq, opts ...grpc.CallOption) (*PaginatedItemRevisionsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaginatedItemRevisionsRes)
+	err := c.cc.Invoke(ctx, Item_ListItemRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemClient) DiffItemRevisions(ctx context.Context, in *DiffItemRevisionsReq, opts ...grpc.CallOption) (*ItemRevisionDiffMsg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ItemRevisionDiffMsg)
+	err := c.cc.Invoke(ctx, Item_DiffItemRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemClient) RollbackItem(ctx context.Context, in *ItemRevisionReq, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Item_RollbackItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ItemServer is the server API for Item service.
 // All implementations must embed UnimplementedItemServer
 // for forward compatibility.
@@ -169,6 +241,12 @@ type ItemServer interface {
 	ListRelatedItems(context.Context, *UuidMsg) (*RelatedItemsList, error)
 	ListCategoryItems(context.Context, *ListCategoryItemsReq) (*PaginatedItemRes, error)
 	ListItemsByLabel(context.Context, *ListItemsByLabelReq) (*PaginatedItemRes, error)
+	GetPriceTimeline(context.Context, *UuidMsg) (*PriceTimelineMsg, error)
+	SchedulePriceChange(context.Context, *ScheduledPriceMsg) (*Uint64Msg, error)
+	CancelScheduledPrice(context.Context, *Uint64Msg) (*Empty, error)
+	ListItemRevisions(context.Context, *ListItemRevisionsReq) (*PaginatedItemRevisionsRes, error)
+	DiffItemRevisions(context.Context, *DiffItemRevisionsReq) (*ItemRevisionDiffMsg, error)
+	RollbackItem(context.Context, *ItemRevisionReq) (*Empty, error)
 	mustEmbedUnimplementedItemServer()
 }
 
@@ -209,6 +287,24 @@ func (UnimplementedItemServer) ListCategoryItems(context.Context, *ListCategoryI
 func (UnimplementedItemServer) ListItemsByLabel(context.Context, *ListItemsByLabelReq) (*PaginatedItemRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListItemsByLabel not implemented")
 }
+func (UnimplementedItemServer) GetPriceTimeline(context.Context, *UuidMsg) (*PriceTimelineMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceTimeline not implemented")
+}
+func (UnimplementedItemServer) SchedulePriceChange(context.Context, *ScheduledPriceMsg) (*Uint64Msg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulePriceChange not implemented")
+}
+func (UnimplementedItemServer) CancelScheduledPrice(context.Context, *Uint64Msg) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledPrice not implemented")
+}
+func (UnimplementedItemServer) ListItemRevisions(context.Context, *ListItemRevisionsReq) (*PaginatedItemRevisionsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListItemRevisions not implemented")
+}
+func (UnimplementedItemServer) DiffItemRevisions(context.Context, *DiffItemRevisionsReq) (*ItemRevisionDiffMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffItemRevisions not implemented")
+}
+func (UnimplementedItemServer) RollbackItem(context.Context, *ItemRevisionReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackItem not implemented")
+}
 func (UnimplementedItemServer) mustEmbedUnimplementedItemServer() {}
 func (UnimplementedItemServer) testEmbeddedByValue()              {}
 
@@ -410,6 +506,114 @@ func _Item_ListItemsByLabel_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Item_GetPriceTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UuidMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServer).GetPriceTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Item_GetPriceTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServer).GetPriceTimeline(ctx, req.(*UuidMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Item_SchedulePriceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduledPriceMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServer).SchedulePriceChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Item_SchedulePriceChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServer).SchedulePriceChange(ctx, req.(*ScheduledPriceMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Item_CancelScheduledPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Uint64Msg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServer).CancelScheduledPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Item_CancelScheduledPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServer).CancelScheduledPrice(ctx, req.(*Uint64Msg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Item_ListItemRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItemRevisionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServer).ListItemRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Item_ListItemRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServer).ListItemRevisions(ctx, req.(*ListItemRevisionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Item_DiffItemRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffItemRevisionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServer).DiffItemRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Item_DiffItemRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServer).DiffItemRevisions(ctx, req.(*DiffItemRevisionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Item_RollbackItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItemRevisionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServer).RollbackItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Item_RollbackItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServer).RollbackItem(ctx, req.(*ItemRevisionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Item_ServiceDesc is the grpc.ServiceDesc for Item service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -457,20 +661,45 @@ var Item_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListItemsByLabel",
 			Handler:    _Item_ListItemsByLabel_Handler,
 		},
+		{
+			MethodName: "GetPriceTimeline",
+			Handler:    _Item_GetPriceTimeline_Handler,
+		},
+		{
+			MethodName: "SchedulePriceChange",
+			Handler:    _Item_SchedulePriceChange_Handler,
+		},
+		{
+			MethodName: "CancelScheduledPrice",
+			Handler:    _Item_CancelScheduledPrice_Handler,
+		},
+		{
+			MethodName: "ListItemRevisions",
+			Handler:    _Item_ListItemRevisions_Handler,
+		},
+		{
+			MethodName: "DiffItemRevisions",
+			Handler:    _Item_DiffItemRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackItem",
+			Handler:    _Item_RollbackItem_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/pb/products.proto",
 }
 
 const (
-	Category_ListCategories_FullMethodName        = "/user.Category/ListCategories"
-	Category_CreateCategory_FullMethodName        = "/user.Category/CreateCategory"
-	Category_CategorySearch_FullMethodName        = "/user.Category/CategorySearch"
-	Category_CategoryFiltersSearch_FullMethodName = "/user.Category/CategoryFiltersSearch"
-	Category_GetCategory_FullMethodName           = "/user.Category/GetCategory"
-	Category_UpdateCategory_FullMethodName        = "/user.Category/UpdateCategory"
-	Category_DeleteCategory_FullMethodName        = "/user.Category/DeleteCategory"
-	Category_ListCategoryFilters_FullMethodName   = "/user.Category/ListCategoryFilters"
+	Category_ListCategories_FullMethodName         = "/user.Category/ListCategories"
+	Category_CreateCategory_FullMethodName         = "/user.Category/CreateCategory"
+	Category_CategorySearch_FullMethodName         = "/user.Category/CategorySearch"
+	Category_CategoryFiltersSearch_FullMethodName  = "/user.Category/CategoryFiltersSearch"
+	Category_GetCategory_FullMethodName            = "/user.Category/GetCategory"
+	Category_UpdateCategory_FullMethodName         = "/user.Category/UpdateCategory"
+	Category_DeleteCategory_FullMethodName         = "/user.Category/DeleteCategory"
+	Category_ListCategoryFilters_FullMethodName    = "/user.Category/ListCategoryFilters"
+	Category_RebuildCategoryFilters_FullMethodName = "/user.Category/RebuildCategoryFilters"
 )
 
 // CategoryClient is the client API for Category service.
@@ -485,6 +714,7 @@ type CategoryClient interface {
 	UpdateCategory(ctx context.Context, in *CategoryWithSlug, opts ...grpc.CallOption) (*Empty, error)
 	DeleteCategory(ctx context.Context, in *SlugMsg, opts ...grpc.CallOption) (*Empty, error)
 	ListCategoryFilters(ctx context.Context, in *SlugMsg, opts ...grpc.CallOption) (*FilterListRes, error)
+	RebuildCategoryFilters(ctx context.Context, in *SlugMsg, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type categoryClient struct {
@@ -575,6 +805,16 @@ func (c *categoryClient) ListCategoryFilters(ctx context.Context, in *SlugMsg, o
 	return out, nil
 }
 
+func (c *categoryClient) RebuildCategoryFilters(ctx context.Context, in *SlugMsg, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Category_RebuildCategoryFilters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CategoryServer is the server API for Category service.
 // All implementations must embed UnimplementedCategoryServer
 // for forward compatibility.
@@ -587,6 +827,7 @@ type CategoryServer interface {
 	UpdateCategory(context.Context, *CategoryWithSlug) (*Empty, error)
 	DeleteCategory(context.Context, *SlugMsg) (*Empty, error)
 	ListCategoryFilters(context.Context, *SlugMsg) (*FilterListRes, error)
+	RebuildCategoryFilters(context.Context, *SlugMsg) (*Empty, error)
 	mustEmbedUnimplementedCategoryServer()
 }
 
@@ -621,6 +862,9 @@ func (UnimplementedCategoryServer) DeleteCategory(context.Context, *SlugMsg) (*E
 func (UnimplementedCategoryServer) ListCategoryFilters(context.Context, *SlugMsg) (*FilterListRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCategoryFilters not implemented")
 }
+func (UnimplementedCategoryServer) RebuildCategoryFilters(context.Context, *SlugMsg) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildCategoryFilters not implemented")
+}
 func (UnimplementedCategoryServer) mustEmbedUnimplementedCategoryServer() {}
 func (UnimplementedCategoryServer) testEmbeddedByValue()                  {}
 
@@ -786,6 +1030,24 @@ func _Category_ListCategoryFilters_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Category_RebuildCategoryFilters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlugMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServer).RebuildCategoryFilters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Category_RebuildCategoryFilters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServer).RebuildCategoryFilters(ctx, req.(*SlugMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Category_ServiceDesc is the grpc.ServiceDesc for Category service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -825,24 +1087,50 @@ var Category_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListCategoryFilters",
 			Handler:    _Category_ListCategoryFilters_Handler,
 		},
+		{
+			MethodName: "RebuildCategoryFilters",
+			Handler:    _Category_RebuildCategoryFilters_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/pb/products.proto",
 }
 
 const (
-	Favorite_ListFavorites_FullMethodName       = "/user.Favorite/ListFavorites"
-	Favorite_AddToFavorites_FullMethodName      = "/user.Favorite/AddToFavorites"
-	Favorite_RemoveFromFavorites_FullMethodName = "/user.Favorite/RemoveFromFavorites"
+	Favorite_ListFavorites_FullMethodName                = "/user.Favorite/ListFavorites"
+	Favorite_AddToFavorites_FullMethodName               = "/user.Favorite/AddToFavorites"
+	Favorite_RemoveFromFavorites_FullMethodName          = "/user.Favorite/RemoveFromFavorites"
+	Favorite_SetFavoriteNotifications_FullMethodName     = "/user.Favorite/SetFavoriteNotifications"
+	Favorite_ListFavoriteCollections_FullMethodName      = "/user.Favorite/ListFavoriteCollections"
+	Favorite_GetFavoriteCollection_FullMethodName        = "/user.Favorite/GetFavoriteCollection"
+	Favorite_GetSharedFavoriteCollection_FullMethodName  = "/user.Favorite/GetSharedFavoriteCollection"
+	Favorite_CreateFavoriteCollection_FullMethodName     = "/user.Favorite/CreateFavoriteCollection"
+	Favorite_UpdateFavoriteCollection_FullMethodName     = "/user.Favorite/UpdateFavoriteCollection"
+	Favorite_DeleteFavoriteCollection_FullMethodName     = "/user.Favorite/DeleteFavoriteCollection"
+	Favorite_ShareFavoriteCollection_FullMethodName      = "/user.Favorite/ShareFavoriteCollection"
+	Favorite_UnshareFavoriteCollection_FullMethodName    = "/user.Favorite/UnshareFavoriteCollection"
+	Favorite_SetFavoriteCollectionItem_FullMethodName    = "/user.Favorite/SetFavoriteCollectionItem"
+	Favorite_RemoveFavoriteCollectionItem_FullMethodName = "/user.Favorite/RemoveFavoriteCollectionItem"
 )
 
 // FavoriteClient is the client API for Favorite service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type FavoriteClient interface {
-	ListFavorites(ctx context.Context, in *UuidMsg, opts ...grpc.CallOption) (*FavoriteListMsg, error)
+	ListFavorites(ctx context.Context, in *ListFavoritesReq, opts ...grpc.CallOption) (*PaginatedFavoriteRes, error)
 	AddToFavorites(ctx context.Context, in *UserAndItemIds, opts ...grpc.CallOption) (*FavoriteMsg, error)
 	RemoveFromFavorites(ctx context.Context, in *UserAndItemIds, opts ...grpc.CallOption) (*Empty, error)
+	SetFavoriteNotifications(ctx context.Context, in *FavoriteNotificationsReq, opts ...grpc.CallOption) (*Empty, error)
+	ListFavoriteCollections(ctx context.Context, in *UuidMsg, opts ...grpc.CallOption) (*FavoriteCollectionListMsg, error)
+	GetFavoriteCollection(ctx context.Context, in *FavoriteCollectionReq, opts ...grpc.CallOption) (*FavoriteCollectionMsg, error)
+	GetSharedFavoriteCollection(ctx context.Context, in *ShareTokenMsg, opts ...grpc.CallOption) (*FavoriteCollectionMsg, error)
+	CreateFavoriteCollection(ctx context.Context, in *FavoriteCollectionMsg, opts ...grpc.CallOption) (*Uint64Msg, error)
+	UpdateFavoriteCollection(ctx context.Context, in *FavoriteCollectionMsg, opts ...grpc.CallOption) (*Empty, error)
+	DeleteFavoriteCollection(ctx context.Context, in *FavoriteCollectionReq, opts ...grpc.CallOption) (*Empty, error)
+	ShareFavoriteCollection(ctx context.Context, in *FavoriteCollectionReq, opts ...grpc.CallOption) (*ShareTokenMsg, error)
+	UnshareFavoriteCollection(ctx context.Context, in *FavoriteCollectionReq, opts ...grpc.CallOption) (*Empty, error)
+	SetFavoriteCollectionItem(ctx context.Context, in *FavoriteCollectionItemMsg, opts ...grpc.CallOption) (*Empty, error)
+	RemoveFavoriteCollectionItem(ctx context.Context, in *FavoriteCollectionItemMsg, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type favoriteClient struct {
@@ -853,9 +1141,9 @@ func NewFavoriteClient(cc grpc.ClientConnInterface) FavoriteClient {
 	return &favoriteClient{cc}
 }
 
-func (c *favoriteClient) ListFavorites(ctx context.Context, in *UuidMsg, opts ...grpc.CallOption) (*FavoriteListMsg, error) {
+func (c *favoriteClient) ListFavorites(ctx context.Context, in *ListFavoritesReq, opts ...grpc.CallOption) (*PaginatedFavoriteRes, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(FavoriteListMsg)
+	out := new(PaginatedFavoriteRes)
 	err := c.cc.Invoke(ctx, Favorite_ListFavorites_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -883,13 +1171,134 @@ func (c *favoriteClient) RemoveFromFavorites(ctx context.Context, in *UserAndIte
 	return out, nil
 }
 
+func (c *favoriteClient) SetFavoriteNotifications(ctx context.Context, in *FavoriteNotificationsReq, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Favorite_SetFavoriteNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) ListFavoriteCollections(ctx context.Context, in *UuidMsg, opts ...grpc.CallOption) (*FavoriteCollectionListMsg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FavoriteCollectionListMsg)
+	err := c.cc.Invoke(ctx, Favorite_ListFavoriteCollections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) GetFavoriteCollection(ctx context.Context, in *FavoriteCollectionReq, opts ...grpc.CallOption) (*FavoriteCollectionMsg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FavoriteCollectionMsg)
+	err := c.cc.Invoke(ctx, Favorite_GetFavoriteCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) GetSharedFavoriteCollection(ctx context.Context, in *ShareTokenMsg, opts ...grpc.CallOption) (*FavoriteCollectionMsg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FavoriteCollectionMsg)
+	err := c.cc.Invoke(ctx, Favorite_GetSharedFavoriteCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) CreateFavoriteCollection(ctx context.Context, in *FavoriteCollectionMsg, opts ...grpc.CallOption) (*Uint64Msg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Uint64Msg)
+	err := c.cc.Invoke(ctx, Favorite_CreateFavoriteCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) UpdateFavoriteCollection(ctx context.Context, in *FavoriteCollectionMsg, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Favorite_UpdateFavoriteCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) DeleteFavoriteCollection(ctx context.Context, in *FavoriteCollectionReq, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Favorite_DeleteFavoriteCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) ShareFavoriteCollection(ctx context.Context, in *FavoriteCollectionReq, opts ...grpc.CallOption) (*ShareTokenMsg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareTokenMsg)
+	err := c.cc.Invoke(ctx, Favorite_ShareFavoriteCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) UnshareFavoriteCollection(ctx context.Context, in *FavoriteCollectionReq, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Favorite_UnshareFavoriteCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) SetFavoriteCollectionItem(ctx context.Context, in *FavoriteCollectionItemMsg, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Favorite_SetFavoriteCollectionItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) RemoveFavoriteCollectionItem(ctx context.Context, in *FavoriteCollectionItemMsg, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Favorite_RemoveFavoriteCollectionItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FavoriteServer is the server API for Favorite service.
 // All implementations must embed UnimplementedFavoriteServer
 // for forward compatibility.
 type FavoriteServer interface {
-	ListFavorites(context.Context, *UuidMsg) (*FavoriteListMsg, error)
+	ListFavorites(context.Context, *ListFavoritesReq) (*PaginatedFavoriteRes, error)
 	AddToFavorites(context.Context, *UserAndItemIds) (*FavoriteMsg, error)
 	RemoveFromFavorites(context.Context, *UserAndItemIds) (*Empty, error)
+	SetFavoriteNotifications(context.Context, *FavoriteNotificationsReq) (*Empty, error)
+	ListFavoriteCollections(context.Context, *UuidMsg) (*FavoriteCollectionListMsg, error)
+	GetFavoriteCollection(context.Context, *FavoriteCollectionReq) (*FavoriteCollectionMsg, error)
+	GetSharedFavoriteCollection(context.Context, *ShareTokenMsg) (*FavoriteCollectionMsg, error)
+	CreateFavoriteCollection(context.Context, *FavoriteCollectionMsg) (*Uint64Msg, error)
+	UpdateFavoriteCollection(context.Context, *FavoriteCollectionMsg) (*Empty, error)
+	DeleteFavoriteCollection(context.Context, *FavoriteCollectionReq) (*Empty, error)
+	ShareFavoriteCollection(context.Context, *FavoriteCollectionReq) (*ShareTokenMsg, error)
+	UnshareFavoriteCollection(context.Context, *FavoriteCollectionReq) (*Empty, error)
+	SetFavoriteCollectionItem(context.Context, *FavoriteCollectionItemMsg) (*Empty, error)
+	RemoveFavoriteCollectionItem(context.Context, *FavoriteCollectionItemMsg) (*Empty, error)
 	mustEmbedUnimplementedFavoriteServer()
 }
 
@@ -900,7 +1309,7 @@ type FavoriteServer interface {
 // pointer dereference when methods are called.
 type UnimplementedFavoriteServer struct{}
 
-func (UnimplementedFavoriteServer) ListFavorites(context.Context, *UuidMsg) (*FavoriteListMsg, error) {
+func (UnimplementedFavoriteServer) ListFavorites(context.Context, *ListFavoritesReq) (*PaginatedFavoriteRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFavorites not implemented")
 }
 func (UnimplementedFavoriteServer) AddToFavorites(context.Context, *UserAndItemIds) (*FavoriteMsg, error) {
@@ -909,10 +1318,43 @@ func (UnimplementedFavoriteServer) AddToFavorites(context.Context, *UserAndItemI
 func (UnimplementedFavoriteServer) RemoveFromFavorites(context.Context, *UserAndItemIds) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromFavorites not implemented")
 }
-func (UnimplementedFavoriteServer) mustEmbedUnimplementedFavoriteServer() {}
-func (UnimplementedFavoriteServer) testEmbeddedByValue()                  {}
-
-// UnsafeFavoriteServer may be embedded to opt out of forward compatibility for this service.
+func (UnimplementedFavoriteServer) SetFavoriteNotifications(context.Context, *FavoriteNotificationsReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFavoriteNotifications not implemented")
+}
+func (UnimplementedFavoriteServer) ListFavoriteCollections(context.Context, *UuidMsg) (*FavoriteCollectionListMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFavoriteCollections not implemented")
+}
+func (UnimplementedFavoriteServer) GetFavoriteCollection(context.Context, *FavoriteCollectionReq) (*FavoriteCollectionMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFavoriteCollection not implemented")
+}
+func (UnimplementedFavoriteServer) GetSharedFavoriteCollection(context.Context, *ShareTokenMsg) (*FavoriteCollectionMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedFavoriteCollection not implemented")
+}
+func (UnimplementedFavoriteServer) CreateFavoriteCollection(context.Context, *FavoriteCollectionMsg) (*Uint64Msg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFavoriteCollection not implemented")
+}
+func (UnimplementedFavoriteServer) UpdateFavoriteCollection(context.Context, *FavoriteCollectionMsg) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFavoriteCollection not implemented")
+}
+func (UnimplementedFavoriteServer) DeleteFavoriteCollection(context.Context, *FavoriteCollectionReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFavoriteCollection not implemented")
+}
+func (UnimplementedFavoriteServer) ShareFavoriteCollection(context.Context, *FavoriteCollectionReq) (*ShareTokenMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareFavoriteCollection not implemented")
+}
+func (UnimplementedFavoriteServer) UnshareFavoriteCollection(context.Context, *FavoriteCollectionReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnshareFavoriteCollection not implemented")
+}
+func (UnimplementedFavoriteServer) SetFavoriteCollectionItem(context.Context, *FavoriteCollectionItemMsg) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFavoriteCollectionItem not implemented")
+}
+func (UnimplementedFavoriteServer) RemoveFavoriteCollectionItem(context.Context, *FavoriteCollectionItemMsg) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFavoriteCollectionItem not implemented")
+}
+func (UnimplementedFavoriteServer) mustEmbedUnimplementedFavoriteServer() {}
+func (UnimplementedFavoriteServer) testEmbeddedByValue()                  {}
+
+// UnsafeFavoriteServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to FavoriteServer will
 // result in compilation errors.
 type UnsafeFavoriteServer interface {
@@ -931,7 +1373,7 @@ func RegisterFavoriteServer(s grpc.ServiceRegistrar, srv FavoriteServer) {
 }
 
 func _Favorite_ListFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UuidMsg)
+	in := new(ListFavoritesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -943,7 +1385,7 @@ func _Favorite_ListFavorites_Handler(srv interface{}, ctx context.Context, dec f
 		FullMethod: Favorite_ListFavorites_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FavoriteServer).ListFavorites(ctx, req.(*UuidMsg))
+		return srv.(FavoriteServer).ListFavorites(ctx, req.(*ListFavoritesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -984,6 +1426,204 @@ func _Favorite_RemoveFromFavorites_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Favorite_SetFavoriteNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoriteNotificationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).SetFavoriteNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Favorite_SetFavoriteNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).SetFavoriteNotifications(ctx, req.(*FavoriteNotificationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_ListFavoriteCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UuidMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).ListFavoriteCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Favorite_ListFavoriteCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).ListFavoriteCollections(ctx, req.(*UuidMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_GetFavoriteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoriteCollectionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).GetFavoriteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Favorite_GetFavoriteCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).GetFavoriteCollection(ctx, req.(*FavoriteCollectionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_GetSharedFavoriteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareTokenMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).GetSharedFavoriteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Favorite_GetSharedFavoriteCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).GetSharedFavoriteCollection(ctx, req.(*ShareTokenMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_CreateFavoriteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoriteCollectionMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).CreateFavoriteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Favorite_CreateFavoriteCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).CreateFavoriteCollection(ctx, req.(*FavoriteCollectionMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_UpdateFavoriteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoriteCollectionMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).UpdateFavoriteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Favorite_UpdateFavoriteCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).UpdateFavoriteCollection(ctx, req.(*FavoriteCollectionMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_DeleteFavoriteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoriteCollectionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).DeleteFavoriteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Favorite_DeleteFavoriteCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).DeleteFavoriteCollection(ctx, req.(*FavoriteCollectionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_ShareFavoriteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoriteCollectionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).ShareFavoriteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Favorite_ShareFavoriteCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).ShareFavoriteCollection(ctx, req.(*FavoriteCollectionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_UnshareFavoriteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoriteCollectionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).UnshareFavoriteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Favorite_UnshareFavoriteCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).UnshareFavoriteCollection(ctx, req.(*FavoriteCollectionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_SetFavoriteCollectionItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoriteCollectionItemMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).SetFavoriteCollectionItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Favorite_SetFavoriteCollectionItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).SetFavoriteCollectionItem(ctx, req.(*FavoriteCollectionItemMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_RemoveFavoriteCollectionItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoriteCollectionItemMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).RemoveFavoriteCollectionItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Favorite_RemoveFavoriteCollectionItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).RemoveFavoriteCollectionItem(ctx, req.(*FavoriteCollectionItemMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Favorite_ServiceDesc is the grpc.ServiceDesc for Favorite service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1003,6 +1643,50 @@ var Favorite_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveFromFavorites",
 			Handler:    _Favorite_RemoveFromFavorites_Handler,
 		},
+		{
+			MethodName: "SetFavoriteNotifications",
+			Handler:    _Favorite_SetFavoriteNotifications_Handler,
+		},
+		{
+			MethodName: "ListFavoriteCollections",
+			Handler:    _Favorite_ListFavoriteCollections_Handler,
+		},
+		{
+			MethodName: "GetFavoriteCollection",
+			Handler:    _Favorite_GetFavoriteCollection_Handler,
+		},
+		{
+			MethodName: "GetSharedFavoriteCollection",
+			Handler:    _Favorite_GetSharedFavoriteCollection_Handler,
+		},
+		{
+			MethodName: "CreateFavoriteCollection",
+			Handler:    _Favorite_CreateFavoriteCollection_Handler,
+		},
+		{
+			MethodName: "UpdateFavoriteCollection",
+			Handler:    _Favorite_UpdateFavoriteCollection_Handler,
+		},
+		{
+			MethodName: "DeleteFavoriteCollection",
+			Handler:    _Favorite_DeleteFavoriteCollection_Handler,
+		},
+		{
+			MethodName: "ShareFavoriteCollection",
+			Handler:    _Favorite_ShareFavoriteCollection_Handler,
+		},
+		{
+			MethodName: "UnshareFavoriteCollection",
+			Handler:    _Favorite_UnshareFavoriteCollection_Handler,
+		},
+		{
+			MethodName: "SetFavoriteCollectionItem",
+			Handler:    _Favorite_SetFavoriteCollectionItem_Handler,
+		},
+		{
+			MethodName: "RemoveFavoriteCollectionItem",
+			Handler:    _Favorite_RemoveFavoriteCollectionItem_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/pb/products.proto",
@@ -1345,6 +2029,7 @@ const (
 	Order_CreateOrder_FullMethodName    = "/user.Order/CreateOrder"
 	Order_UpdateOrder_FullMethodName    = "/user.Order/UpdateOrder"
 	Order_CancelOrder_FullMethodName    = "/user.Order/CancelOrder"
+	Order_DeleteOrder_FullMethodName    = "/user.Order/DeleteOrder"
 )
 
 // OrderClient is the client API for Order service.
@@ -1357,6 +2042,8 @@ type OrderClient interface {
 	CreateOrder(ctx context.Context, in *OrderMsg, opts ...grpc.CallOption) (*Uint64Msg, error)
 	UpdateOrder(ctx context.Context, in *OrderMsg, opts ...grpc.CallOption) (*Empty, error)
 	CancelOrder(ctx context.Context, in *Uint64Msg, opts ...grpc.CallOption) (*Empty, error)
+	// Moves the order to the trash, see the Trash service.
+	DeleteOrder(ctx context.Context, in *Uint64Msg, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type orderClient struct {
@@ -1427,6 +2114,16 @@ func (c *orderClient) CancelOrder(ctx context.Context, in *Uint64Msg, opts ...gr
 	return out, nil
 }
 
+func (c *orderClient) DeleteOrder(ctx context.Context, in *Uint64Msg, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Order_DeleteOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServer is the server API for Order service.
 // All implementations must embed UnimplementedOrderServer
 // for forward compatibility.
@@ -1437,6 +2134,8 @@ type OrderServer interface {
 	CreateOrder(context.Context, *OrderMsg) (*Uint64Msg, error)
 	UpdateOrder(context.Context, *OrderMsg) (*Empty, error)
 	CancelOrder(context.Context, *Uint64Msg) (*Empty, error)
+	// Moves the order to the trash, see the Trash service.
+	DeleteOrder(context.Context, *Uint64Msg) (*Empty, error)
 	mustEmbedUnimplementedOrderServer()
 }
 
@@ -1465,6 +2164,9 @@ func (UnimplementedOrderServer) UpdateOrder(context.Context, *OrderMsg) (*Empty,
 func (UnimplementedOrderServer) CancelOrder(context.Context, *Uint64Msg) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
 }
+func (UnimplementedOrderServer) DeleteOrder(context.Context, *Uint64Msg) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrder not implemented")
+}
 func (UnimplementedOrderServer) mustEmbedUnimplementedOrderServer() {}
 func (UnimplementedOrderServer) testEmbeddedByValue()               {}
 
@@ -1594,6 +2296,24 @@ func _Order_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Order_DeleteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Uint64Msg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).DeleteOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_DeleteOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).DeleteOrder(ctx, req.(*Uint64Msg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Order_ServiceDesc is the grpc.ServiceDesc for Order service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1625,6 +2345,2640 @@ var Order_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelOrder",
 			Handler:    _Order_CancelOrder_Handler,
 		},
+		{
+			MethodName: "DeleteOrder",
+			Handler:    _Order_DeleteOrder_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/pb/products.proto",
+}
+
+const (
+	PriceList_ListPriceLists_FullMethodName      = "/user.PriceList/ListPriceLists"
+	PriceList_GetPriceList_FullMethodName        = "/user.PriceList/GetPriceList"
+	PriceList_CreatePriceList_FullMethodName     = "/user.PriceList/CreatePriceList"
+	PriceList_UpdatePriceList_FullMethodName     = "/user.PriceList/UpdatePriceList"
+	PriceList_DeletePriceList_FullMethodName     = "/user.PriceList/DeletePriceList"
+	PriceList_ListPriceListItems_FullMethodName  = "/user.PriceList/ListPriceListItems"
+	PriceList_SetPriceListItems_FullMethodName   = "/user.PriceList/SetPriceListItems"
+	PriceList_DeletePriceListItem_FullMethodName = "/user.PriceList/DeletePriceListItem"
+)
+
+// PriceListClient is the client API for PriceList service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Callers select a price list per request with the x-price-list metadata key.
+type PriceListClient interface {
+	ListPriceLists(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PriceListListRes, error)
+	GetPriceList(ctx context.Context, in *SlugMsg, opts ...grpc.CallOption) (*PriceListMsg, error)
+	CreatePriceList(ctx context.Context, in *PriceListMsg, opts ...grpc.CallOption) (*SlugMsg, error)
+	UpdatePriceList(ctx context.Context, in *PriceListMsg, opts ...grpc.CallOption) (*Empty, error)
+	DeletePriceList(ctx context.Context, in *SlugMsg, opts ...grpc.CallOption) (*Empty, error)
+	ListPriceListItems(ctx context.Context, in *ListPriceListItemsReq, opts ...grpc.CallOption) (*PaginatedPriceListItemsRes, error)
+	SetPriceListItems(ctx context.Context, in *SetPriceListItemsReq, opts ...grpc.CallOption) (*Empty, error)
+	DeletePriceListItem(ctx context.Context, in *PriceListItemReq, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type priceListClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPriceListClient(cc grpc.ClientConnInterface) PriceListClient {
+	return &priceListClient{cc}
+}
+
+func (c *priceListClient) ListPriceLists(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PriceListListRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceListListRes)
+	err := c.cc.Invoke(ctx, PriceList_ListPriceLists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceListClient) GetPriceList(ctx context.Context, in *SlugMsg, opts ...grpc.CallOption) (*PriceListMsg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceListMsg)
+	err := c.cc.Invoke(ctx, PriceList_GetPriceList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceListClient) CreatePriceList(ctx context.Context, in *PriceListMsg, opts ...grpc.CallOption) (*SlugMsg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SlugMsg)
+	err := c.cc.Invoke(ctx, PriceList_CreatePriceList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceListClient) UpdatePriceList(ctx context.Context, in *PriceListMsg, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, PriceList_UpdatePriceList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceListClient) DeletePriceList(ctx context.Context, in *SlugMsg, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, PriceList_DeletePriceList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceListClient) ListPriceListItems(ctx context.Context, in *ListPriceListItemsReq, opts ...grpc.CallOption) (*PaginatedPriceListItemsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaginatedPriceListItemsRes)
+	err := c.cc.Invoke(ctx, PriceList_ListPriceListItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceListClient) SetPriceListItems(ctx context.Context, in *SetPriceListItemsReq, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, PriceList_SetPriceListItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceListClient) DeletePriceListItem(ctx context.Context, in *PriceListItemReq, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, PriceList_DeletePriceListItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PriceListServer is the server API for PriceList service.
+// All implementations must embed UnimplementedPriceListServer
+// for forward compatibility.
+//
+// Callers select a price list per request with the x-price-list metadata key.
+type PriceListServer interface {
+	ListPriceLists(context.Context, *Empty) (*PriceListListRes, error)
+	GetPriceList(context.Context, *SlugMsg) (*PriceListMsg, error)
+	CreatePriceList(context.Context, *PriceListMsg) (*SlugMsg, error)
+	UpdatePriceList(context.Context, *PriceListMsg) (*Empty, error)
+	DeletePriceList(context.Context, *SlugMsg) (*Empty, error)
+	ListPriceListItems(context.Context, *ListPriceListItemsReq) (*PaginatedPriceListItemsRes, error)
+	SetPriceListItems(context.Context, *SetPriceListItemsReq) (*Empty, error)
+	DeletePriceListItem(context.Context, *PriceListItemReq) (*Empty, error)
+	mustEmbedUnimplementedPriceListServer()
+}
+
+// UnimplementedPriceListServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPriceListServer struct{}
+
+func (UnimplementedPriceListServer) ListPriceLists(context.Context, *Empty) (*PriceListListRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPriceLists not implemented")
+}
+func (UnimplementedPriceListServer) GetPriceList(context.Context, *SlugMsg) (*PriceListMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceList not implemented")
+}
+func (UnimplementedPriceListServer) CreatePriceList(context.Context, *PriceListMsg) (*SlugMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePriceList not implemented")
+}
+func (UnimplementedPriceListServer) UpdatePriceList(context.Context, *PriceListMsg) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePriceList not implemented")
+}
+func (UnimplementedPriceListServer) DeletePriceList(context.Context, *SlugMsg) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePriceList not implemented")
+}
+func (UnimplementedPriceListServer) ListPriceListItems(context.Context, *ListPriceListItemsReq) (*PaginatedPriceListItemsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPriceListItems not implemented")
+}
+func (UnimplementedPriceListServer) SetPriceListItems(context.Context, *SetPriceListItemsReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPriceListItems not implemented")
+}
+func (UnimplementedPriceListServer) DeletePriceListItem(context.Context, *PriceListItemReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePriceListItem not implemented")
+}
+func (UnimplementedPriceListServer) mustEmbedUnimplementedPriceListServer() {}
+func (UnimplementedPriceListServer) testEmbeddedByValue()                   {}
+
+// UnsafePriceListServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PriceListServer will
+// result in compilation errors.
+type UnsafePriceListServer interface {
+	mustEmbedUnimplementedPriceListServer()
+}
+
+func RegisterPriceListServer(s grpc.ServiceRegistrar, srv PriceListServer) {
+	// If the following call pancis, it indicates UnimplementedPriceListServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PriceList_ServiceDesc, srv)
+}
+
+func _PriceList_ListPriceLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceListServer).ListPriceLists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PriceList_ListPriceLists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceListServer).ListPriceLists(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceList_GetPriceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlugMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceListServer).GetPriceList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PriceList_GetPriceList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceListServer).GetPriceList(ctx, req.(*SlugMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceList_CreatePriceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceListMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceListServer).CreatePriceList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PriceList_CreatePriceList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceListServer).CreatePriceList(ctx, req.(*PriceListMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceList_UpdatePriceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceListMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceListServer).UpdatePriceList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PriceList_UpdatePriceList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceListServer).UpdatePriceList(ctx, req.(*PriceListMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceList_DeletePriceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlugMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceListServer).DeletePriceList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PriceList_DeletePriceList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceListServer).DeletePriceList(ctx, req.(*SlugMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceList_ListPriceListItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPriceListItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceListServer).ListPriceListItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PriceList_ListPriceListItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceListServer).ListPriceListItems(ctx, req.(*ListPriceListItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceList_SetPriceListItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPriceListItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceListServer).SetPriceListItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PriceList_SetPriceListItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceListServer).SetPriceListItems(ctx, req.(*SetPriceListItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceList_DeletePriceListItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceListItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceListServer).DeletePriceListItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PriceList_DeletePriceListItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceListServer).DeletePriceListItem(ctx, req.(*PriceListItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PriceList_ServiceDesc is the grpc.ServiceDesc for PriceList service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PriceList_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.PriceList",
+	HandlerType: (*PriceListServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListPriceLists",
+			Handler:    _PriceList_ListPriceLists_Handler,
+		},
+		{
+			MethodName: "GetPriceList",
+			Handler:    _PriceList_GetPriceList_Handler,
+		},
+		{
+			MethodName: "CreatePriceList",
+			Handler:    _PriceList_CreatePriceList_Handler,
+		},
+		{
+			MethodName: "UpdatePriceList",
+			Handler:    _PriceList_UpdatePriceList_Handler,
+		},
+		{
+			MethodName: "DeletePriceList",
+			Handler:    _PriceList_DeletePriceList_Handler,
+		},
+		{
+			MethodName: "ListPriceListItems",
+			Handler:    _PriceList_ListPriceListItems_Handler,
+		},
+		{
+			MethodName: "SetPriceListItems",
+			Handler:    _PriceList_SetPriceListItems_Handler,
+		},
+		{
+			MethodName: "DeletePriceListItem",
+			Handler:    _PriceList_DeletePriceListItem_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/pb/products.proto",
+}
+
+const (
+	CustomerGroup_ListCustomerGroups_FullMethodName  = "/user.CustomerGroup/ListCustomerGroups"
+	CustomerGroup_GetCustomerGroup_FullMethodName    = "/user.CustomerGroup/GetCustomerGroup"
+	CustomerGroup_CreateCustomerGroup_FullMethodName = "/user.CustomerGroup/CreateCustomerGroup"
+	CustomerGroup_UpdateCustomerGroup_FullMethodName = "/user.CustomerGroup/UpdateCustomerGroup"
+	CustomerGroup_DeleteCustomerGroup_FullMethodName = "/user.CustomerGroup/DeleteCustomerGroup"
+	CustomerGroup_ListQuantityBreaks_FullMethodName  = "/user.CustomerGroup/ListQuantityBreaks"
+	CustomerGroup_SetQuantityBreaks_FullMethodName   = "/user.CustomerGroup/SetQuantityBreaks"
+)
+
+// CustomerGroupClient is the client API for CustomerGroup service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CustomerGroupClient interface {
+	ListCustomerGroups(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CustomerGroupListRes, error)
+	GetCustomerGroup(ctx context.Context, in *SlugMsg, opts ...grpc.CallOption) (*CustomerGroupMsg, error)
+	CreateCustomerGroup(ctx context.Context, in *CustomerGroupMsg, opts ...grpc.CallOption) (*SlugMsg, error)
+	UpdateCustomerGroup(ctx context.Context, in *CustomerGroupMsg, opts ...grpc.CallOption) (*Empty, error)
+	DeleteCustomerGroup(ctx context.Context, in *SlugMsg, opts ...grpc.CallOption) (*Empty, error)
+	ListQuantityBreaks(ctx context.Context, in *UuidMsg, opts ...grpc.CallOption) (*QuantityBreakListRes, error)
+	SetQuantityBreaks(ctx context.Context, in *SetQuantityBreaksReq, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type customerGroupClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCustomerGroupClient(cc grpc.ClientConnInterface) CustomerGroupClient {
+	return &customerGroupClient{cc}
+}
+
+func (c *customerGroupClient) ListCustomerGroups(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CustomerGroupListRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CustomerGroupListRes)
+	err := c.cc.Invoke(ctx, CustomerGroup_ListCustomerGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerGroupClient) GetCustomerGroup(ctx context.Context, in *SlugMsg, opts ...grpc.CallOption) (*CustomerGroupMsg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CustomerGroupMsg)
+	err := c.cc.Invoke(ctx, CustomerGroup_GetCustomerGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerGroupClient) CreateCustomerGroup(ctx context.Context, in *CustomerGroupMsg, opts ...grpc.CallOption) (*SlugMsg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SlugMsg)
+	err := c.cc.Invoke(ctx, CustomerGroup_CreateCustomerGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerGroupClient) UpdateCustomerGroup(ctx context.Context, in *CustomerGroupMsg, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, CustomerGroup_UpdateCustomerGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerGroupClient) DeleteCustomerGroup(ctx context.Context, in *SlugMsg, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, CustomerGroup_DeleteCustomerGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerGroupClient) ListQuantityBreaks(ctx context.Context, in *UuidMsg, opts ...grpc.CallOption) (*QuantityBreakListRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuantityBreakListRes)
+	err := c.cc.Invoke(ctx, CustomerGroup_ListQuantityBreaks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerGroupClient) SetQuantityBreaks(ctx context.Context, in *SetQuantityBreaksReq, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, CustomerGroup_SetQuantityBreaks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CustomerGroupServer is the server API for CustomerGroup service.
+// All implementations must embed UnimplementedCustomerGroupServer
+// for forward compatibility.
+type CustomerGroupServer interface {
+	ListCustomerGroups(context.Context, *Empty) (*CustomerGroupListRes, error)
+	GetCustomerGroup(context.Context, *SlugMsg) (*CustomerGroupMsg, error)
+	CreateCustomerGroup(context.Context, *CustomerGroupMsg) (*SlugMsg, error)
+	UpdateCustomerGroup(context.Context, *CustomerGroupMsg) (*Empty, error)
+	DeleteCustomerGroup(context.Context, *SlugMsg) (*Empty, error)
+	ListQuantityBreaks(context.Context, *UuidMsg) (*QuantityBreakListRes, error)
+	SetQuantityBreaks(context.Context, *SetQuantityBreaksReq) (*Empty, error)
+	mustEmbedUnimplementedCustomerGroupServer()
+}
+
+// UnimplementedCustomerGroupServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCustomerGroupServer struct{}
+
+func (UnimplementedCustomerGroupServer) ListCustomerGroups(context.Context, *Empty) (*CustomerGroupListRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCustomerGroups not implemented")
+}
+func (UnimplementedCustomerGroupServer) GetCustomerGroup(context.Context, *SlugMsg) (*CustomerGroupMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomerGroup not implemented")
+}
+func (UnimplementedCustomerGroupServer) CreateCustomerGroup(context.Context, *CustomerGroupMsg) (*SlugMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCustomerGroup not implemented")
+}
+func (UnimplementedCustomerGroupServer) UpdateCustomerGroup(context.Context, *CustomerGroupMsg) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCustomerGroup not implemented")
+}
+func (UnimplementedCustomerGroupServer) DeleteCustomerGroup(context.Context, *SlugMsg) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCustomerGroup not implemented")
+}
+func (UnimplementedCustomerGroupServer) ListQuantityBreaks(context.Context, *UuidMsg) (*QuantityBreakListRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuantityBreaks not implemented")
+}
+func (UnimplementedCustomerGroupServer) SetQuantityBreaks(context.Context, *SetQuantityBreaksReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQuantityBreaks not implemented")
+}
+func (UnimplementedCustomerGroupServer) mustEmbedUnimplementedCustomerGroupServer() {}
+func (UnimplementedCustomerGroupServer) testEmbeddedByValue()                       {}
+
+// UnsafeCustomerGroupServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CustomerGroupServer will
+// result in compilation errors.
+type UnsafeCustomerGroupServer interface {
+	mustEmbedUnimplementedCustomerGroupServer()
+}
+
+func RegisterCustomerGroupServer(s grpc.ServiceRegistrar, srv CustomerGroupServer) {
+	// If the following call pancis, it indicates UnimplementedCustomerGroupServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CustomerGroup_ServiceDesc, srv)
+}
+
+func _CustomerGroup_ListCustomerGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerGroupServer).ListCustomerGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerGroup_ListCustomerGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerGroupServer).ListCustomerGroups(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerGroup_GetCustomerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlugMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerGroupServer).GetCustomerGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerGroup_GetCustomerGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerGroupServer).GetCustomerGroup(ctx, req.(*SlugMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerGroup_CreateCustomerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CustomerGroupMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerGroupServer).CreateCustomerGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerGroup_CreateCustomerGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerGroupServer).CreateCustomerGroup(ctx, req.(*CustomerGroupMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerGroup_UpdateCustomerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CustomerGroupMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerGroupServer).UpdateCustomerGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerGroup_UpdateCustomerGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerGroupServer).UpdateCustomerGroup(ctx, req.(*CustomerGroupMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerGroup_DeleteCustomerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlugMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerGroupServer).DeleteCustomerGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerGroup_DeleteCustomerGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerGroupServer).DeleteCustomerGroup(ctx, req.(*SlugMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerGroup_ListQuantityBreaks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UuidMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerGroupServer).ListQuantityBreaks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerGroup_ListQuantityBreaks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerGroupServer).ListQuantityBreaks(ctx, req.(*UuidMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerGroup_SetQuantityBreaks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetQuantityBreaksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerGroupServer).SetQuantityBreaks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerGroup_SetQuantityBreaks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerGroupServer).SetQuantityBreaks(ctx, req.(*SetQuantityBreaksReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CustomerGroup_ServiceDesc is the grpc.ServiceDesc for CustomerGroup service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CustomerGroup_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.CustomerGroup",
+	HandlerType: (*CustomerGroupServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListCustomerGroups",
+			Handler:    _CustomerGroup_ListCustomerGroups_Handler,
+		},
+		{
+			MethodName: "GetCustomerGroup",
+			Handler:    _CustomerGroup_GetCustomerGroup_Handler,
+		},
+		{
+			MethodName: "CreateCustomerGroup",
+			Handler:    _CustomerGroup_CreateCustomerGroup_Handler,
+		},
+		{
+			MethodName: "UpdateCustomerGroup",
+			Handler:    _CustomerGroup_UpdateCustomerGroup_Handler,
+		},
+		{
+			MethodName: "DeleteCustomerGroup",
+			Handler:    _CustomerGroup_DeleteCustomerGroup_Handler,
+		},
+		{
+			MethodName: "ListQuantityBreaks",
+			Handler:    _CustomerGroup_ListQuantityBreaks_Handler,
+		},
+		{
+			MethodName: "SetQuantityBreaks",
+			Handler:    _CustomerGroup_SetQuantityBreaks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/pb/products.proto",
+}
+
+const (
+	Media_ListItemMedia_FullMethodName    = "/user.Media/ListItemMedia"
+	Media_UploadItemMedia_FullMethodName  = "/user.Media/UploadItemMedia"
+	Media_UpdateItemMedia_FullMethodName  = "/user.Media/UpdateItemMedia"
+	Media_ReorderItemMedia_FullMethodName = "/user.Media/ReorderItemMedia"
+	Media_DeleteItemMedia_FullMethodName  = "/user.Media/DeleteItemMedia"
+)
+
+// MediaClient is the client API for Media service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MediaClient interface {
+	ListItemMedia(ctx context.Context, in *UuidMsg, opts ...grpc.CallOption) (*ItemMediaList, error)
+	UploadItemMedia(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadItemMediaReq, ItemMedia], error)
+	UpdateItemMedia(ctx context.Context, in *ItemMedia, opts ...grpc.CallOption) (*Empty, error)
+	ReorderItemMedia(ctx context.Context, in *ReorderItemMediaReq, opts ...grpc.CallOption) (*Empty, error)
+	DeleteItemMedia(ctx context.Context, in *ItemMediaReq, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type mediaClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMediaClient(cc grpc.ClientConnInterface) MediaClient {
+	return &mediaClient{cc}
+}
+
+func (c *mediaClient) ListItemMedia(ctx context.Context, in *UuidMsg, opts ...grpc.CallOption) (*ItemMediaList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ItemMediaList)
+	err := c.cc.Invoke(ctx, Media_ListItemMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediaClient) UploadItemMedia(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadItemMediaReq, ItemMedia], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Media_ServiceDesc.Streams[0], Media_UploadItemMedia_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadItemMediaReq, ItemMedia]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Media_UploadItemMediaClient = grpc.ClientStreamingClient[UploadItemMediaReq, ItemMedia]
+
+func (c *mediaClient) UpdateItemMedia(ctx context.Context, in *ItemMedia, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Media_UpdateItemMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediaClient) ReorderItemMedia(ctx context.Context, in *ReorderItemMediaReq, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Media_ReorderItemMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediaClient) DeleteItemMedia(ctx context.Context, in *ItemMediaReq, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Media_DeleteItemMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MediaServer is the server API for Media service.
+// All implementations must embed UnimplementedMediaServer
+// for forward compatibility.
+type MediaServer interface {
+	ListItemMedia(context.Context, *UuidMsg) (*ItemMediaList, error)
+	UploadItemMedia(grpc.ClientStreamingServer[UploadItemMediaReq, ItemMedia]) error
+	UpdateItemMedia(context.Context, *ItemMedia) (*Empty, error)
+	ReorderItemMedia(context.Context, *ReorderItemMediaReq) (*Empty, error)
+	DeleteItemMedia(context.Context, *ItemMediaReq) (*Empty, error)
+	mustEmbedUnimplementedMediaServer()
+}
+
+// UnimplementedMediaServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMediaServer struct{}
+
+func (UnimplementedMediaServer) ListItemMedia(context.Context, *UuidMsg) (*ItemMediaList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListItemMedia not implemented")
+}
+func (UnimplementedMediaServer) UploadItemMedia(grpc.ClientStreamingServer[UploadItemMediaReq, ItemMedia]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadItemMedia not implemented")
+}
+func (UnimplementedMediaServer) UpdateItemMedia(context.Context, *ItemMedia) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateItemMedia not implemented")
+}
+func (UnimplementedMediaServer) ReorderItemMedia(context.Context, *ReorderItemMediaReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderItemMedia not implemented")
+}
+func (UnimplementedMediaServer) DeleteItemMedia(context.Context, *ItemMediaReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteItemMedia not implemented")
+}
+func (UnimplementedMediaServer) mustEmbedUnimplementedMediaServer() {}
+func (UnimplementedMediaServer) testEmbeddedByValue()               {}
+
+// UnsafeMediaServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MediaServer will
+// result in compilation errors.
+type UnsafeMediaServer interface {
+	mustEmbedUnimplementedMediaServer()
+}
+
+func RegisterMediaServer(s grpc.ServiceRegistrar, srv MediaServer) {
+	// If the following call pancis, it indicates UnimplementedMediaServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Media_ServiceDesc, srv)
+}
+
+func _Media_ListItemMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UuidMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediaServer).ListItemMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Media_ListItemMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediaServer).ListItemMedia(ctx, req.(*UuidMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Media_UploadItemMedia_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MediaServer).UploadItemMedia(&grpc.GenericServerStream[UploadItemMediaReq, ItemMedia]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Media_UploadItemMediaServer = grpc.ClientStreamingServer[UploadItemMediaReq, ItemMedia]
+
+func _Media_UpdateItemMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItemMedia)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediaServer).UpdateItemMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Media_UpdateItemMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediaServer).UpdateItemMedia(ctx, req.(*ItemMedia))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Media_ReorderItemMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderItemMediaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediaServer).ReorderItemMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Media_ReorderItemMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediaServer).ReorderItemMedia(ctx, req.(*ReorderItemMediaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Media_DeleteItemMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItemMediaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediaServer).DeleteItemMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Media_DeleteItemMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediaServer).DeleteItemMedia(ctx, req.(*ItemMediaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Media_ServiceDesc is the grpc.ServiceDesc for Media service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Media_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.Media",
+	HandlerType: (*MediaServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListItemMedia",
+			Handler:    _Media_ListItemMedia_Handler,
+		},
+		{
+			MethodName: "UpdateItemMedia",
+			Handler:    _Media_UpdateItemMedia_Handler,
+		},
+		{
+			MethodName: "ReorderItemMedia",
+			Handler:    _Media_ReorderItemMedia_Handler,
+		},
+		{
+			MethodName: "DeleteItemMedia",
+			Handler:    _Media_DeleteItemMedia_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadItemMedia",
+			Handler:       _Media_UploadItemMedia_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "api/pb/products.proto",
+}
+
+const (
+	Attribute_ListAttributes_FullMethodName         = "/user.Attribute/ListAttributes"
+	Attribute_GetAttribute_FullMethodName           = "/user.Attribute/GetAttribute"
+	Attribute_CreateAttribute_FullMethodName        = "/user.Attribute/CreateAttribute"
+	Attribute_UpdateAttribute_FullMethodName        = "/user.Attribute/UpdateAttribute"
+	Attribute_DeleteAttribute_FullMethodName        = "/user.Attribute/DeleteAttribute"
+	Attribute_ListCategoryAttributes_FullMethodName = "/user.Attribute/ListCategoryAttributes"
+	Attribute_SetCategoryAttributes_FullMethodName  = "/user.Attribute/SetCategoryAttributes"
+)
+
+// AttributeClient is the client API for Attribute service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AttributeClient interface {
+	ListAttributes(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AttributeListRes, error)
+	GetAttribute(ctx context.Context, in *SlugMsg, opts ...grpc.CallOption) (*AttributeMsg, error)
+	CreateAttribute(ctx context.Context, in *AttributeMsg, opts ...grpc.CallOption) (*SlugMsg, error)
+	UpdateAttribute(ctx context.Context, in *AttributeMsg, opts ...grpc.CallOption) (*Empty, error)
+	DeleteAttribute(ctx context.Context, in *SlugMsg, opts ...grpc.CallOption) (*Empty, error)
+	ListCategoryAttributes(ctx context.Context, in *SlugMsg, opts ...grpc.CallOption) (*CategoryAttributeListRes, error)
+	SetCategoryAttributes(ctx context.Context, in *SetCategoryAttributesReq, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type attributeClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAttributeClient(cc grpc.ClientConnInterface) AttributeClient {
+	return &attributeClient{cc}
+}
+
+func (c *attributeClient) ListAttributes(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AttributeListRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttributeListRes)
+	err := c.cc.Invoke(ctx, Attribute_ListAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attributeClient) GetAttribute(ctx context.Context, in *SlugMsg, opts ...grpc.CallOption) (*AttributeMsg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttributeMsg)
+	err := c.cc.Invoke(ctx, Attribute_GetAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attributeClient) CreateAttribute(ctx context.Context, in *AttributeMsg, opts ...grpc.CallOption) (*SlugMsg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SlugMsg)
+	err := c.cc.Invoke(ctx, Attribute_CreateAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attributeClient) UpdateAttribute(ctx context.Context, in *AttributeMsg, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Attribute_UpdateAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attributeClient) DeleteAttribute(ctx context.Context, in *SlugMsg, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Attribute_DeleteAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attributeClient) ListCategoryAttributes(ctx context.Context, in *SlugMsg, opts ...grpc.CallOption) (*CategoryAttributeListRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryAttributeListRes)
+	err := c.cc.Invoke(ctx, Attribute_ListCategoryAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attributeClient) SetCategoryAttributes(ctx context.Context, in *SetCategoryAttributesReq, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Attribute_SetCategoryAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AttributeServer is the server API for Attribute service.
+// All implementations must embed UnimplementedAttributeServer
+// for forward compatibility.
+type AttributeServer interface {
+	ListAttributes(context.Context, *Empty) (*AttributeListRes, error)
+	GetAttribute(context.Context, *SlugMsg) (*AttributeMsg, error)
+	CreateAttribute(context.Context, *AttributeMsg) (*SlugMsg, error)
+	UpdateAttribute(context.Context, *AttributeMsg) (*Empty, error)
+	DeleteAttribute(context.Context, *SlugMsg) (*Empty, error)
+	ListCategoryAttributes(context.Context, *SlugMsg) (*CategoryAttributeListRes, error)
+	SetCategoryAttributes(context.Context, *SetCategoryAttributesReq) (*Empty, error)
+	mustEmbedUnimplementedAttributeServer()
+}
+
+// UnimplementedAttributeServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAttributeServer struct{}
+
+func (UnimplementedAttributeServer) ListAttributes(context.Context, *Empty) (*AttributeListRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttributes not implemented")
+}
+func (UnimplementedAttributeServer) GetAttribute(context.Context, *SlugMsg) (*AttributeMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttribute not implemented")
+}
+func (UnimplementedAttributeServer) CreateAttribute(context.Context, *AttributeMsg) (*SlugMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAttribute not implemented")
+}
+func (UnimplementedAttributeServer) UpdateAttribute(context.Context, *AttributeMsg) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAttribute not implemented")
+}
+func (UnimplementedAttributeServer) DeleteAttribute(context.Context, *SlugMsg) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttribute not implemented")
+}
+func (UnimplementedAttributeServer) ListCategoryAttributes(context.Context, *SlugMsg) (*CategoryAttributeListRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategoryAttributes not implemented")
+}
+func (UnimplementedAttributeServer) SetCategoryAttributes(context.Context, *SetCategoryAttributesReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCategoryAttributes not implemented")
+}
+func (UnimplementedAttributeServer) mustEmbedUnimplementedAttributeServer() {}
+func (UnimplementedAttributeServer) testEmbeddedByValue()                   {}
+
+// UnsafeAttributeServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttributeServer will
+// result in compilation errors.
+type UnsafeAttributeServer interface {
+	mustEmbedUnimplementedAttributeServer()
+}
+
+func RegisterAttributeServer(s grpc.ServiceRegistrar, srv AttributeServer) {
+	// If the following call pancis, it indicates UnimplementedAttributeServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Attribute_ServiceDesc, srv)
+}
+
+func _Attribute_ListAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttributeServer).ListAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Attribute_ListAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttributeServer).ListAttributes(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Attribute_GetAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlugMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttributeServer).GetAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Attribute_GetAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttributeServer).GetAttribute(ctx, req.(*SlugMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Attribute_CreateAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttributeMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttributeServer).CreateAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Attribute_CreateAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttributeServer).CreateAttribute(ctx, req.(*AttributeMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Attribute_UpdateAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttributeMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttributeServer).UpdateAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Attribute_UpdateAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttributeServer).UpdateAttribute(ctx, req.(*AttributeMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Attribute_DeleteAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlugMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttributeServer).DeleteAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Attribute_DeleteAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttributeServer).DeleteAttribute(ctx, req.(*SlugMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Attribute_ListCategoryAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlugMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttributeServer).ListCategoryAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Attribute_ListCategoryAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttributeServer).ListCategoryAttributes(ctx, req.(*SlugMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Attribute_SetCategoryAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCategoryAttributesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttributeServer).SetCategoryAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Attribute_SetCategoryAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttributeServer).SetCategoryAttributes(ctx, req.(*SetCategoryAttributesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Attribute_ServiceDesc is the grpc.ServiceDesc for Attribute service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Attribute_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.Attribute",
+	HandlerType: (*AttributeServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAttributes",
+			Handler:    _Attribute_ListAttributes_Handler,
+		},
+		{
+			MethodName: "GetAttribute",
+			Handler:    _Attribute_GetAttribute_Handler,
+		},
+		{
+			MethodName: "CreateAttribute",
+			Handler:    _Attribute_CreateAttribute_Handler,
+		},
+		{
+			MethodName: "UpdateAttribute",
+			Handler:    _Attribute_UpdateAttribute_Handler,
+		},
+		{
+			MethodName: "DeleteAttribute",
+			Handler:    _Attribute_DeleteAttribute_Handler,
+		},
+		{
+			MethodName: "ListCategoryAttributes",
+			Handler:    _Attribute_ListCategoryAttributes_Handler,
+		},
+		{
+			MethodName: "SetCategoryAttributes",
+			Handler:    _Attribute_SetCategoryAttributes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/pb/products.proto",
+}
+
+const (
+	Label_ListLabels_FullMethodName    = "/user.Label/ListLabels"
+	Label_CreateLabel_FullMethodName   = "/user.Label/CreateLabel"
+	Label_UpdateLabel_FullMethodName   = "/user.Label/UpdateLabel"
+	Label_DeleteLabel_FullMethodName   = "/user.Label/DeleteLabel"
+	Label_SetItemLabels_FullMethodName = "/user.Label/SetItemLabels"
+)
+
+// LabelClient is the client API for Label service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LabelClient interface {
+	ListLabels(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*LabelListRes, error)
+	CreateLabel(ctx context.Context, in *LabelMsg, opts ...grpc.CallOption) (*Uint64Msg, error)
+	UpdateLabel(ctx context.Context, in *UpdateLabelReq, opts ...grpc.CallOption) (*Empty, error)
+	DeleteLabel(ctx context.Context, in *SlugMsg, opts ...grpc.CallOption) (*Empty, error)
+	SetItemLabels(ctx context.Context, in *SetItemLabelsReq, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type labelClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLabelClient(cc grpc.ClientConnInterface) LabelClient {
+	return &labelClient{cc}
+}
+
+func (c *labelClient) ListLabels(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*LabelListRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LabelListRes)
+	err := c.cc.Invoke(ctx, Label_ListLabels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *labelClient) CreateLabel(ctx context.Context, in *LabelMsg, opts ...grpc.CallOption) (*Uint64Msg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Uint64Msg)
+	err := c.cc.Invoke(ctx, Label_CreateLabel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *labelClient) UpdateLabel(ctx context.Context, in *UpdateLabelReq, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Label_UpdateLabel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *labelClient) DeleteLabel(ctx context.Context, in *SlugMsg, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Label_DeleteLabel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *labelClient) SetItemLabels(ctx context.Context, in *SetItemLabelsReq, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Label_SetItemLabels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LabelServer is the server API for Label service.
+// All implementations must embed UnimplementedLabelServer
+// for forward compatibility.
+type LabelServer interface {
+	ListLabels(context.Context, *Empty) (*LabelListRes, error)
+	CreateLabel(context.Context, *LabelMsg) (*Uint64Msg, error)
+	UpdateLabel(context.Context, *UpdateLabelReq) (*Empty, error)
+	DeleteLabel(context.Context, *SlugMsg) (*Empty, error)
+	SetItemLabels(context.Context, *SetItemLabelsReq) (*Empty, error)
+	mustEmbedUnimplementedLabelServer()
+}
+
+// UnimplementedLabelServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLabelServer struct{}
+
+func (UnimplementedLabelServer) ListLabels(context.Context, *Empty) (*LabelListRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLabels not implemented")
+}
+func (UnimplementedLabelServer) CreateLabel(context.Context, *LabelMsg) (*Uint64Msg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLabel not implemented")
+}
+func (UnimplementedLabelServer) UpdateLabel(context.Context, *UpdateLabelReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLabel not implemented")
+}
+func (UnimplementedLabelServer) DeleteLabel(context.Context, *SlugMsg) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLabel not implemented")
+}
+func (UnimplementedLabelServer) SetItemLabels(context.Context, *SetItemLabelsReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetItemLabels not implemented")
+}
+func (UnimplementedLabelServer) mustEmbedUnimplementedLabelServer() {}
+func (UnimplementedLabelServer) testEmbeddedByValue()               {}
+
+// UnsafeLabelServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LabelServer will
+// result in compilation errors.
+type UnsafeLabelServer interface {
+	mustEmbedUnimplementedLabelServer()
+}
+
+func RegisterLabelServer(s grpc.ServiceRegistrar, srv LabelServer) {
+	// If the following call pancis, it indicates UnimplementedLabelServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Label_ServiceDesc, srv)
+}
+
+func _Label_ListLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LabelServer).ListLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Label_ListLabels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LabelServer).ListLabels(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Label_CreateLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabelMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LabelServer).CreateLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Label_CreateLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LabelServer).CreateLabel(ctx, req.(*LabelMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Label_UpdateLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLabelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LabelServer).UpdateLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Label_UpdateLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LabelServer).UpdateLabel(ctx, req.(*UpdateLabelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Label_DeleteLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlugMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LabelServer).DeleteLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Label_DeleteLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LabelServer).DeleteLabel(ctx, req.(*SlugMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Label_SetItemLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetItemLabelsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LabelServer).SetItemLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Label_SetItemLabels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LabelServer).SetItemLabels(ctx, req.(*SetItemLabelsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Label_ServiceDesc is the grpc.ServiceDesc for Label service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Label_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.Label",
+	HandlerType: (*LabelServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListLabels",
+			Handler:    _Label_ListLabels_Handler,
+		},
+		{
+			MethodName: "CreateLabel",
+			Handler:    _Label_CreateLabel_Handler,
+		},
+		{
+			MethodName: "UpdateLabel",
+			Handler:    _Label_UpdateLabel_Handler,
+		},
+		{
+			MethodName: "DeleteLabel",
+			Handler:    _Label_DeleteLabel_Handler,
+		},
+		{
+			MethodName: "SetItemLabels",
+			Handler:    _Label_SetItemLabels_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/pb/products.proto",
+}
+
+const (
+	Review_ListItemReviews_FullMethodName    = "/user.Review/ListItemReviews"
+	Review_ListPendingReviews_FullMethodName = "/user.Review/ListPendingReviews"
+	Review_CreateReview_FullMethodName       = "/user.Review/CreateReview"
+	Review_UpdateReview_FullMethodName       = "/user.Review/UpdateReview"
+	Review_ModerateReview_FullMethodName     = "/user.Review/ModerateReview"
+	Review_DeleteReview_FullMethodName       = "/user.Review/DeleteReview"
+	Review_UploadReviewMedia_FullMethodName  = "/user.Review/UploadReviewMedia"
+)
+
+// ReviewClient is the client API for Review service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReviewClient interface {
+	ListItemReviews(ctx context.Context, in *ListItemReviewsReq, opts ...grpc.CallOption) (*PaginatedReviewRes, error)
+	ListPendingReviews(ctx context.Context, in *ListReq, opts ...grpc.CallOption) (*PaginatedReviewRes, error)
+	CreateReview(ctx context.Context, in *ReviewMsg, opts ...grpc.CallOption) (*Uint64Msg, error)
+	UpdateReview(ctx context.Context, in *ReviewMsg, opts ...grpc.CallOption) (*Empty, error)
+	ModerateReview(ctx context.Context, in *ModerateReviewReq, opts ...grpc.CallOption) (*Empty, error)
+	DeleteReview(ctx context.Context, in *DeleteReviewReq, opts ...grpc.CallOption) (*Empty, error)
+	UploadReviewMedia(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadReviewMediaReq, ReviewMediaMsg], error)
+}
+
+type reviewClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReviewClient(cc grpc.ClientConnInterface) ReviewClient {
+	return &reviewClient{cc}
+}
+
+func (c *reviewClient) ListItemReviews(ctx context.Context, in *ListItemReviewsReq, opts ...grpc.CallOption) (*PaginatedReviewRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaginatedReviewRes)
+	err := c.cc.Invoke(ctx, Review_ListItemReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) ListPendingReviews(ctx context.Context, in *ListReq, opts ...grpc.CallOption) (*PaginatedReviewRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaginatedReviewRes)
+	err := c.cc.Invoke(ctx, Review_ListPendingReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) CreateReview(ctx context.Context, in *ReviewMsg, opts ...grpc.CallOption) (*Uint64Msg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Uint64Msg)
+	err := c.cc.Invoke(ctx, Review_CreateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) UpdateReview(ctx context.Context, in *ReviewMsg, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Review_UpdateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) ModerateReview(ctx context.Context, in *ModerateReviewReq, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Review_ModerateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) DeleteReview(ctx context.Context, in *DeleteReviewReq, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Review_DeleteReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) UploadReviewMedia(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadReviewMediaReq, ReviewMediaMsg], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Review_ServiceDesc.Streams[0], Review_UploadReviewMedia_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadReviewMediaReq, ReviewMediaMsg]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Review_UploadReviewMediaClient = grpc.ClientStreamingClient[UploadReviewMediaReq, ReviewMediaMsg]
+
+// ReviewServer is the server API for Review service.
+// All implementations must embed UnimplementedReviewServer
+// for forward compatibility.
+type ReviewServer interface {
+	ListItemReviews(context.Context, *ListItemReviewsReq) (*PaginatedReviewRes, error)
+	ListPendingReviews(context.Context, *ListReq) (*PaginatedReviewRes, error)
+	CreateReview(context.Context, *ReviewMsg) (*Uint64Msg, error)
+	UpdateReview(context.Context, *ReviewMsg) (*Empty, error)
+	ModerateReview(context.Context, *ModerateReviewReq) (*Empty, error)
+	DeleteReview(context.Context, *DeleteReviewReq) (*Empty, error)
+	UploadReviewMedia(grpc.ClientStreamingServer[UploadReviewMediaReq, ReviewMediaMsg]) error
+	mustEmbedUnimplementedReviewServer()
+}
+
+// UnimplementedReviewServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReviewServer struct{}
+
+func (UnimplementedReviewServer) ListItemReviews(context.Context, *ListItemReviewsReq) (*PaginatedReviewRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListItemReviews not implemented")
+}
+func (UnimplementedReviewServer) ListPendingReviews(context.Context, *ListReq) (*PaginatedReviewRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingReviews not implemented")
+}
+func (UnimplementedReviewServer) CreateReview(context.Context, *ReviewMsg) (*Uint64Msg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+}
+func (UnimplementedReviewServer) UpdateReview(context.Context, *ReviewMsg) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReview not implemented")
+}
+func (UnimplementedReviewServer) ModerateReview(context.Context, *ModerateReviewReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateReview not implemented")
+}
+func (UnimplementedReviewServer) DeleteReview(context.Context, *DeleteReviewReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReview not implemented")
+}
+func (UnimplementedReviewServer) UploadReviewMedia(grpc.ClientStreamingServer[UploadReviewMediaReq, ReviewMediaMsg]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadReviewMedia not implemented")
+}
+func (UnimplementedReviewServer) mustEmbedUnimplementedReviewServer() {}
+func (UnimplementedReviewServer) testEmbeddedByValue()                {}
+
+// UnsafeReviewServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReviewServer will
+// result in compilation errors.
+type UnsafeReviewServer interface {
+	mustEmbedUnimplementedReviewServer()
+}
+
+func RegisterReviewServer(s grpc.ServiceRegistrar, srv ReviewServer) {
+	// If the following call pancis, it indicates UnimplementedReviewServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Review_ServiceDesc, srv)
+}
+
+func _Review_ListItemReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItemReviewsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).ListItemReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_ListItemReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).ListItemReviews(ctx, req.(*ListItemReviewsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_ListPendingReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).ListPendingReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_ListPendingReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).ListPendingReviews(ctx, req.(*ListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).CreateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_CreateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).CreateReview(ctx, req.(*ReviewMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_UpdateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).UpdateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_UpdateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).UpdateReview(ctx, req.(*ReviewMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_ModerateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateReviewReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).ModerateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_ModerateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).ModerateReview(ctx, req.(*ModerateReviewReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_DeleteReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReviewReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).DeleteReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_DeleteReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).DeleteReview(ctx, req.(*DeleteReviewReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_UploadReviewMedia_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ReviewServer).UploadReviewMedia(&grpc.GenericServerStream[UploadReviewMediaReq, ReviewMediaMsg]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Review_UploadReviewMediaServer = grpc.ClientStreamingServer[UploadReviewMediaReq, ReviewMediaMsg]
+
+// Review_ServiceDesc is the grpc.ServiceDesc for Review service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Review_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.Review",
+	HandlerType: (*ReviewServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListItemReviews",
+			Handler:    _Review_ListItemReviews_Handler,
+		},
+		{
+			MethodName: "ListPendingReviews",
+			Handler:    _Review_ListPendingReviews_Handler,
+		},
+		{
+			MethodName: "CreateReview",
+			Handler:    _Review_CreateReview_Handler,
+		},
+		{
+			MethodName: "UpdateReview",
+			Handler:    _Review_UpdateReview_Handler,
+		},
+		{
+			MethodName: "ModerateReview",
+			Handler:    _Review_ModerateReview_Handler,
+		},
+		{
+			MethodName: "DeleteReview",
+			Handler:    _Review_DeleteReview_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadReviewMedia",
+			Handler:       _Review_UploadReviewMedia_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "api/pb/products.proto",
+}
+
+const (
+	Question_ListItemQuestions_FullMethodName    = "/user.Question/ListItemQuestions"
+	Question_ListPendingQuestions_FullMethodName = "/user.Question/ListPendingQuestions"
+	Question_CreateQuestion_FullMethodName       = "/user.Question/CreateQuestion"
+	Question_ModerateQuestion_FullMethodName     = "/user.Question/ModerateQuestion"
+	Question_DeleteQuestion_FullMethodName       = "/user.Question/DeleteQuestion"
+	Question_ListPendingAnswers_FullMethodName   = "/user.Question/ListPendingAnswers"
+	Question_CreateAnswer_FullMethodName         = "/user.Question/CreateAnswer"
+	Question_ModerateAnswer_FullMethodName       = "/user.Question/ModerateAnswer"
+	Question_SetAnswerOfficial_FullMethodName    = "/user.Question/SetAnswerOfficial"
+	Question_DeleteAnswer_FullMethodName         = "/user.Question/DeleteAnswer"
+)
+
+// QuestionClient is the client API for Question service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// The author of questions and answers is the signed-in SSO user, user_id in the messages is ignored on input.
+type QuestionClient interface {
+	ListItemQuestions(ctx context.Context, in *ListItemQuestionsReq, opts ...grpc.CallOption) (*PaginatedQuestionRes, error)
+	ListPendingQuestions(ctx context.Context, in *ListReq, opts ...grpc.CallOption) (*PaginatedQuestionRes, error)
+	CreateQuestion(ctx context.Context, in *QuestionMsg, opts ...grpc.CallOption) (*Uint64Msg, error)
+	ModerateQuestion(ctx context.Context, in *ModerateQuestionReq, opts ...grpc.CallOption) (*Empty, error)
+	DeleteQuestion(ctx context.Context, in *Uint64Msg, opts ...grpc.CallOption) (*Empty, error)
+	ListPendingAnswers(ctx context.Context, in *ListReq, opts ...grpc.CallOption) (*PaginatedAnswerRes, error)
+	CreateAnswer(ctx context.Context, in *AnswerMsg, opts ...grpc.CallOption) (*Uint64Msg, error)
+	ModerateAnswer(ctx context.Context, in *ModerateQuestionReq, opts ...grpc.CallOption) (*Empty, error)
+	SetAnswerOfficial(ctx context.Context, in *SetAnswerOfficialReq, opts ...grpc.CallOption) (*Empty, error)
+	DeleteAnswer(ctx context.Context, in *Uint64Msg, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type questionClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQuestionClient(cc grpc.ClientConnInterface) QuestionClient {
+	return &questionClient{cc}
+}
+
+func (c *questionClient) ListItemQuestions(ctx context.Context, in *ListItemQuestionsReq, opts ...grpc.CallOption) (*PaginatedQuestionRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaginatedQuestionRes)
+	err := c.cc.Invoke(ctx, Question_ListItemQuestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionClient) ListPendingQuestions(ctx context.Context, in *ListReq, opts ...grpc.CallOption) (*PaginatedQuestionRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaginatedQuestionRes)
+	err := c.cc.Invoke(ctx, Question_ListPendingQuestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionClient) CreateQuestion(ctx context.Context, in *QuestionMsg, opts ...grpc.CallOption) (*Uint64Msg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Uint64Msg)
+	err := c.cc.Invoke(ctx, Question_CreateQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionClient) ModerateQuestion(ctx context.Context, in *ModerateQuestionReq, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Question_ModerateQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionClient) DeleteQuestion(ctx context.Context, in *Uint64Msg, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Question_DeleteQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionClient) ListPendingAnswers(ctx context.Context, in *ListReq, opts ...grpc.CallOption) (*PaginatedAnswerRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaginatedAnswerRes)
+	err := c.cc.Invoke(ctx, Question_ListPendingAnswers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionClient) CreateAnswer(ctx context.Context, in *AnswerMsg, opts ...grpc.CallOption) (*Uint64Msg, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Uint64Msg)
+	err := c.cc.Invoke(ctx, Question_CreateAnswer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionClient) ModerateAnswer(ctx context.Context, in *ModerateQuestionReq, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Question_ModerateAnswer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionClient) SetAnswerOfficial(ctx context.Context, in *SetAnswerOfficialReq, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Question_SetAnswerOfficial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionClient) DeleteAnswer(ctx context.Context, in *Uint64Msg, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Question_DeleteAnswer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuestionServer is the server API for Question service.
+// All implementations must embed UnimplementedQuestionServer
+// for forward compatibility.
+//
+// The author of questions and answers is the signed-in SSO user, user_id in the messages is ignored on input.
+type QuestionServer interface {
+	ListItemQuestions(context.Context, *ListItemQuestionsReq) (*PaginatedQuestionRes, error)
+	ListPendingQuestions(context.Context, *ListReq) (*PaginatedQuestionRes, error)
+	CreateQuestion(context.Context, *QuestionMsg) (*Uint64Msg, error)
+	ModerateQuestion(context.Context, *ModerateQuestionReq) (*Empty, error)
+	DeleteQuestion(context.Context, *Uint64Msg) (*Empty, error)
+	ListPendingAnswers(context.Context, *ListReq) (*PaginatedAnswerRes, error)
+	CreateAnswer(context.Context, *AnswerMsg) (*Uint64Msg, error)
+	ModerateAnswer(context.Context, *ModerateQuestionReq) (*Empty, error)
+	SetAnswerOfficial(context.Context, *SetAnswerOfficialReq) (*Empty, error)
+	DeleteAnswer(context.Context, *Uint64Msg) (*Empty, error)
+	mustEmbedUnimplementedQuestionServer()
+}
+
+// UnimplementedQuestionServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQuestionServer struct{}
+
+func (UnimplementedQuestionServer) ListItemQuestions(context.Context, *ListItemQuestionsReq) (*PaginatedQuestionRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListItemQuestions not implemented")
+}
+func (UnimplementedQuestionServer) ListPendingQuestions(context.Context, *ListReq) (*PaginatedQuestionRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingQuestions not implemented")
+}
+func (UnimplementedQuestionServer) CreateQuestion(context.Context, *QuestionMsg) (*Uint64Msg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQuestion not implemented")
+}
+func (UnimplementedQuestionServer) ModerateQuestion(context.Context, *ModerateQuestionReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateQuestion not implemented")
+}
+func (UnimplementedQuestionServer) DeleteQuestion(context.Context, *Uint64Msg) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuestion not implemented")
+}
+func (UnimplementedQuestionServer) ListPendingAnswers(context.Context, *ListReq) (*PaginatedAnswerRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingAnswers not implemented")
+}
+func (UnimplementedQuestionServer) CreateAnswer(context.Context, *AnswerMsg) (*Uint64Msg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAnswer not implemented")
+}
+func (UnimplementedQuestionServer) ModerateAnswer(context.Context, *ModerateQuestionReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateAnswer not implemented")
+}
+func (UnimplementedQuestionServer) SetAnswerOfficial(context.Context, *SetAnswerOfficialReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAnswerOfficial not implemented")
+}
+func (UnimplementedQuestionServer) DeleteAnswer(context.Context, *Uint64Msg) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAnswer not implemented")
+}
+func (UnimplementedQuestionServer) mustEmbedUnimplementedQuestionServer() {}
+func (UnimplementedQuestionServer) testEmbeddedByValue()                  {}
+
+// UnsafeQuestionServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QuestionServer will
+// result in compilation errors.
+type UnsafeQuestionServer interface {
+	mustEmbedUnimplementedQuestionServer()
+}
+
+func RegisterQuestionServer(s grpc.ServiceRegistrar, srv QuestionServer) {
+	// If the following call pancis, it indicates UnimplementedQuestionServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Question_ServiceDesc, srv)
+}
+
+func _Question_ListItemQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItemQuestionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServer).ListItemQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Question_ListItemQuestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServer).ListItemQuestions(ctx, req.(*ListItemQuestionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Question_ListPendingQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServer).ListPendingQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Question_ListPendingQuestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServer).ListPendingQuestions(ctx, req.(*ListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Question_CreateQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuestionMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServer).CreateQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Question_CreateQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServer).CreateQuestion(ctx, req.(*QuestionMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Question_ModerateQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateQuestionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServer).ModerateQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Question_ModerateQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServer).ModerateQuestion(ctx, req.(*ModerateQuestionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Question_DeleteQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Uint64Msg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServer).DeleteQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Question_DeleteQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServer).DeleteQuestion(ctx, req.(*Uint64Msg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Question_ListPendingAnswers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServer).ListPendingAnswers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Question_ListPendingAnswers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServer).ListPendingAnswers(ctx, req.(*ListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Question_CreateAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnswerMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServer).CreateAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Question_CreateAnswer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServer).CreateAnswer(ctx, req.(*AnswerMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Question_ModerateAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateQuestionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServer).ModerateAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Question_ModerateAnswer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServer).ModerateAnswer(ctx, req.(*ModerateQuestionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Question_SetAnswerOfficial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAnswerOfficialReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServer).SetAnswerOfficial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Question_SetAnswerOfficial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServer).SetAnswerOfficial(ctx, req.(*SetAnswerOfficialReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Question_DeleteAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Uint64Msg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServer).DeleteAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Question_DeleteAnswer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServer).DeleteAnswer(ctx, req.(*Uint64Msg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Question_ServiceDesc is the grpc.ServiceDesc for Question service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Question_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.Question",
+	HandlerType: (*QuestionServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListItemQuestions",
+			Handler:    _Question_ListItemQuestions_Handler,
+		},
+		{
+			MethodName: "ListPendingQuestions",
+			Handler:    _Question_ListPendingQuestions_Handler,
+		},
+		{
+			MethodName: "CreateQuestion",
+			Handler:    _Question_CreateQuestion_Handler,
+		},
+		{
+			MethodName: "ModerateQuestion",
+			Handler:    _Question_ModerateQuestion_Handler,
+		},
+		{
+			MethodName: "DeleteQuestion",
+			Handler:    _Question_DeleteQuestion_Handler,
+		},
+		{
+			MethodName: "ListPendingAnswers",
+			Handler:    _Question_ListPendingAnswers_Handler,
+		},
+		{
+			MethodName: "CreateAnswer",
+			Handler:    _Question_CreateAnswer_Handler,
+		},
+		{
+			MethodName: "ModerateAnswer",
+			Handler:    _Question_ModerateAnswer_Handler,
+		},
+		{
+			MethodName: "SetAnswerOfficial",
+			Handler:    _Question_SetAnswerOfficial_Handler,
+		},
+		{
+			MethodName: "DeleteAnswer",
+			Handler:    _Question_DeleteAnswer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/pb/products.proto",
+}
+
+const (
+	Trash_ListTrash_FullMethodName        = "/user.Trash/ListTrash"
+	Trash_MoveToTrash_FullMethodName      = "/user.Trash/MoveToTrash"
+	Trash_RestoreFromTrash_FullMethodName = "/user.Trash/RestoreFromTrash"
+	Trash_PurgeFromTrash_FullMethodName   = "/user.Trash/PurgeFromTrash"
+)
+
+// TrashClient is the client API for Trash service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Deleted items, categories, promotions and orders stay in the trash until restored or purged.
+// The id of an entry is the item uuid, the category or promotion slug or the order number.
+type TrashClient interface {
+	ListTrash(ctx context.Context, in *ListTrashReq, opts ...grpc.CallOption) (*PaginatedTrashRes, error)
+	MoveToTrash(ctx context.Context, in *TrashEntryReq, opts ...grpc.CallOption) (*Empty, error)
+	RestoreFromTrash(ctx context.Context, in *TrashEntryReq, opts ...grpc.CallOption) (*Empty, error)
+	PurgeFromTrash(ctx context.Context, in *TrashEntryReq, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type trashClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTrashClient(cc grpc.ClientConnInterface) TrashClient {
+	return &trashClient{cc}
+}
+
+func (c *trashClient) ListTrash(ctx context.Context, in *ListTrashReq, opts ...grpc.CallOption) (*PaginatedTrashRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaginatedTrashRes)
+	err := c.cc.Invoke(ctx, Trash_ListTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trashClient) MoveToTrash(ctx context.Context, in *TrashEntryReq, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Trash_MoveToTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trashClient) RestoreFromTrash(ctx context.Context, in *TrashEntryReq, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Trash_RestoreFromTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trashClient) PurgeFromTrash(ctx context.Context, in *TrashEntryReq, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Trash_PurgeFromTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TrashServer is the server API for Trash service.
+// All implementations must embed UnimplementedTrashServer
+// for forward compatibility.
+//
+// Deleted items, categories, promotions and orders stay in the trash until restored or purged.
+// The id of an entry is the item uuid, the category or promotion slug or the order number.
+type TrashServer interface {
+	ListTrash(context.Context, *ListTrashReq) (*PaginatedTrashRes, error)
+	MoveToTrash(context.Context, *TrashEntryReq) (*Empty, error)
+	RestoreFromTrash(context.Context, *TrashEntryReq) (*Empty, error)
+	PurgeFromTrash(context.Context, *TrashEntryReq) (*Empty, error)
+	mustEmbedUnimplementedTrashServer()
+}
+
+// UnimplementedTrashServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTrashServer struct{}
+
+func (UnimplementedTrashServer) ListTrash(context.Context, *ListTrashReq) (*PaginatedTrashRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedTrashServer) MoveToTrash(context.Context, *TrashEntryReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveToTrash not implemented")
+}
+func (UnimplementedTrashServer) RestoreFromTrash(context.Context, *TrashEntryReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreFromTrash not implemented")
+}
+func (UnimplementedTrashServer) PurgeFromTrash(context.Context, *TrashEntryReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeFromTrash not implemented")
+}
+func (UnimplementedTrashServer) mustEmbedUnimplementedTrashServer() {}
+func (UnimplementedTrashServer) testEmbeddedByValue()               {}
+
+// UnsafeTrashServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TrashServer will
+// result in compilation errors.
+type UnsafeTrashServer interface {
+	mustEmbedUnimplementedTrashServer()
+}
+
+func RegisterTrashServer(s grpc.ServiceRegistrar, srv TrashServer) {
+	// If the following call pancis, it indicates UnimplementedTrashServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Trash_ServiceDesc, srv)
+}
+
+func _Trash_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrashServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Trash_ListTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrashServer).ListTrash(ctx, req.(*ListTrashReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trash_MoveToTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrashEntryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrashServer).MoveToTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Trash_MoveToTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrashServer).MoveToTrash(ctx, req.(*TrashEntryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trash_RestoreFromTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrashEntryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrashServer).RestoreFromTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Trash_RestoreFromTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrashServer).RestoreFromTrash(ctx, req.(*TrashEntryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trash_PurgeFromTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrashEntryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrashServer).PurgeFromTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Trash_PurgeFromTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrashServer).PurgeFromTrash(ctx, req.(*TrashEntryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Trash_ServiceDesc is the grpc.ServiceDesc for Trash service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Trash_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.Trash",
+	HandlerType: (*TrashServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTrash",
+			Handler:    _Trash_ListTrash_Handler,
+		},
+		{
+			MethodName: "MoveToTrash",
+			Handler:    _Trash_MoveToTrash_Handler,
+		},
+		{
+			MethodName: "RestoreFromTrash",
+			Handler:    _Trash_RestoreFromTrash_Handler,
+		},
+		{
+			MethodName: "PurgeFromTrash",
+			Handler:    _Trash_PurgeFromTrash_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/pb/products.proto",
+}
+
+const (
+	Audit_ListAuditLog_FullMethodName = "/user.Audit/ListAuditLog"
+)
+
+// AuditClient is the client API for Audit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuditClient interface {
+	ListAuditLog(ctx context.Context, in *ListAuditLogReq, opts ...grpc.CallOption) (*PaginatedAuditRes, error)
+}
+
+type auditClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuditClient(cc grpc.ClientConnInterface) AuditClient {
+	return &auditClient{cc}
+}
+
+func (c *auditClient) ListAuditLog(ctx context.Context, in *ListAuditLogReq, opts ...grpc.CallOption) (*PaginatedAuditRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaginatedAuditRes)
+	err := c.cc.Invoke(ctx, Audit_ListAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditServer is the server API for Audit service.
+// All implementations must embed UnimplementedAuditServer
+// for forward compatibility.
+type AuditServer interface {
+	ListAuditLog(context.Context, *ListAuditLogReq) (*PaginatedAuditRes, error)
+	mustEmbedUnimplementedAuditServer()
+}
+
+// UnimplementedAuditServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuditServer struct{}
+
+func (UnimplementedAuditServer) ListAuditLog(context.Context, *ListAuditLogReq) (*PaginatedAuditRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditLog not implemented")
+}
+func (UnimplementedAuditServer) mustEmbedUnimplementedAuditServer() {}
+func (UnimplementedAuditServer) testEmbeddedByValue()               {}
+
+// UnsafeAuditServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuditServer will
+// result in compilation errors.
+type UnsafeAuditServer interface {
+	mustEmbedUnimplementedAuditServer()
+}
+
+func RegisterAuditServer(s grpc.ServiceRegistrar, srv AuditServer) {
+	// If the following call pancis, it indicates UnimplementedAuditServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Audit_ServiceDesc, srv)
+}
+
+func _Audit_ListAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServer).ListAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Audit_ListAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServer).ListAuditLog(ctx, req.(*ListAuditLogReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Audit_ServiceDesc is the grpc.ServiceDesc for Audit service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Audit_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.Audit",
+	HandlerType: (*AuditServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAuditLog",
+			Handler:    _Audit_ListAuditLog_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/pb/products.proto",
@@ -4,13 +4,17 @@ import (
 	"context"
 	"fmt"
 	"github.com/JMURv/par-pro/products/internal/cache/redis"
+	"github.com/JMURv/par-pro/products/internal/client"
 	ctrl "github.com/JMURv/par-pro/products/internal/ctrl"
 	sso_ctrl_grpc "github.com/JMURv/par-pro/products/internal/ctrl/sso"
 	discovery "github.com/JMURv/par-pro/products/internal/discovery/JMURv/grpc"
 	handler "github.com/JMURv/par-pro/products/internal/hdl/grpc"
 	tracing "github.com/JMURv/par-pro/products/internal/metrics/jaeger"
 	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
+	"github.com/JMURv/par-pro/products/internal/notifier"
 	db "github.com/JMURv/par-pro/products/internal/repo/db"
+	"github.com/JMURv/par-pro/products/internal/storage"
+	"github.com/JMURv/par-pro/products/internal/worker"
 	cfg "github.com/JMURv/par-pro/products/pkg/config"
 	"go.uber.org/zap"
 	"os"
@@ -52,10 +56,27 @@ func main() {
 	cache := redis.New(conf.Redis)
 	repo := db.New(conf.DB)
 
-	ssoCtrl := sso_ctrl_grpc.New(dsc)
-	svc := ctrl.New(repo, cache)
+	verifier, err := sso_ctrl_grpc.NewVerifier(conf.Auth)
+	if err != nil {
+		zap.L().Fatal("Error loading token keys", zap.Error(err))
+	}
+
+	clients := client.New(dsc, conf.Client)
+	ssoCtrl := sso_ctrl_grpc.New(clients, verifier)
+	svc := ctrl.New(repo, cache, notifier.New(conf.Notifier), storage.New(conf.Storage))
 	h := handler.New(svc, ssoCtrl)
 
+	go worker.New("scheduled-prices", conf.Jobs.ScheduledPrices, svc.ApplyScheduledPrices).Start(ctx)
+	go worker.New("media-cleanup", conf.Jobs.MediaCleanup, svc.CleanupOrphanMedia).Start(ctx)
+	go worker.New("filter-rebuild", conf.Jobs.FilterRebuild, svc.RebuildStaleFilters).Start(ctx)
+	go worker.New("label-recompute", conf.Jobs.LabelRecompute, svc.RecomputeLabels).Start(ctx)
+	go worker.New("idempotency-cleanup", conf.Jobs.IdempotencyCleanup, svc.CleanupIdempotencyKeys).Start(ctx)
+	go worker.New(
+		"trash-purge", conf.Jobs.TrashPurge, func(ctx context.Context) error {
+			return svc.PurgeExpiredTrash(ctx, conf.Jobs.TrashRetention)
+		},
+	).Start(ctx)
+
 	go func() {
 		c := make(chan os.Signal, 1)
 		signal.Notify(c, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
@@ -67,6 +88,9 @@ func main() {
 		}
 
 		cache.Close()
+		if err := clients.Close(); err != nil {
+			zap.L().Debug("Error closing clients", zap.Error(err))
+		}
 		if err := h.Close(); err != nil {
 			zap.L().Debug("Error closing handler", zap.Error(err))
 		}
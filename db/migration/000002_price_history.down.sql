@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS item_price_history ON item;
+DROP FUNCTION IF EXISTS log_item_price_change;
+DROP TABLE IF EXISTS scheduled_price;
+DROP TABLE IF EXISTS price_history;
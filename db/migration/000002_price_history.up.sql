@@ -0,0 +1,55 @@
+-- price history
+CREATE TABLE IF NOT EXISTS "price_history" (
+    id         SERIAL PRIMARY KEY,
+    old_price  NUMERIC(12, 2),                  -- NULL for the initial price
+    new_price  NUMERIC(12, 2) NOT NULL,
+    source     VARCHAR(50)    NOT NULL DEFAULT 'manual', -- "manual", "scheduled"
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    item_id    UUID           NOT NULL,
+    CONSTRAINT fk_item FOREIGN KEY (item_id) REFERENCES item (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_price_history_item ON price_history (item_id, created_at);
+
+CREATE TABLE IF NOT EXISTS "scheduled_price" (
+    id         SERIAL PRIMARY KEY,
+    price      NUMERIC(12, 2) NOT NULL,
+    starts_at  TIMESTAMP      NOT NULL,
+    applied_at TIMESTAMP,                       -- NULL until the worker applies it
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    item_id    UUID           NOT NULL,
+    CONSTRAINT fk_item FOREIGN KEY (item_id) REFERENCES item (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_scheduled_price_pending ON scheduled_price (starts_at) WHERE applied_at IS NULL;
+
+-- Every write to item.price lands in price_history, whichever code path made it.
+-- Writers may tag the change with SET LOCAL app.price_source = '...'.
+CREATE OR REPLACE FUNCTION log_item_price_change() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF NEW.price IS NULL THEN
+        RETURN NEW;
+    END IF;
+
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO price_history (item_id, old_price, new_price, source)
+        VALUES (NEW.id, NULL, NEW.price::NUMERIC,
+                COALESCE(NULLIF(current_setting('app.price_source', TRUE), ''), 'manual'));
+    ELSIF OLD.price IS DISTINCT FROM NEW.price THEN
+        INSERT INTO price_history (item_id, old_price, new_price, source)
+        VALUES (NEW.id, OLD.price::NUMERIC, NEW.price::NUMERIC,
+                COALESCE(NULLIF(current_setting('app.price_source', TRUE), ''), 'manual'));
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER item_price_history
+    AFTER INSERT OR UPDATE OF price
+    ON item
+    FOR EACH ROW
+EXECUTE FUNCTION log_item_price_change();
@@ -0,0 +1,49 @@
+DROP TRIGGER IF EXISTS item_price_history ON item;
+
+ALTER TABLE scheduled_price
+    DROP COLUMN IF EXISTS currency,
+    ALTER COLUMN price TYPE NUMERIC(12, 2) USING price / 100.0;
+
+ALTER TABLE price_history
+    DROP COLUMN IF EXISTS currency,
+    ALTER COLUMN old_price TYPE NUMERIC(12, 2) USING old_price / 100.0,
+    ALTER COLUMN new_price TYPE NUMERIC(12, 2) USING new_price / 100.0;
+
+ALTER TABLE "order"
+    DROP COLUMN IF EXISTS currency,
+    ALTER COLUMN total_amount DROP NOT NULL,
+    ALTER COLUMN total_amount DROP DEFAULT,
+    ALTER COLUMN total_amount TYPE DECIMAL(10, 2) USING total_amount / 100.0;
+
+ALTER TABLE item
+    DROP COLUMN IF EXISTS currency,
+    ALTER COLUMN price DROP NOT NULL,
+    ALTER COLUMN price DROP DEFAULT,
+    ALTER COLUMN price TYPE MONEY USING (price / 100.0)::NUMERIC::MONEY;
+
+CREATE OR REPLACE FUNCTION log_item_price_change() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF NEW.price IS NULL THEN
+        RETURN NEW;
+    END IF;
+
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO price_history (item_id, old_price, new_price, source)
+        VALUES (NEW.id, NULL, NEW.price::NUMERIC,
+                COALESCE(NULLIF(current_setting('app.price_source', TRUE), ''), 'manual'));
+    ELSIF OLD.price IS DISTINCT FROM NEW.price THEN
+        INSERT INTO price_history (item_id, old_price, new_price, source)
+        VALUES (NEW.id, OLD.price::NUMERIC, NEW.price::NUMERIC,
+                COALESCE(NULLIF(current_setting('app.price_source', TRUE), ''), 'manual'));
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER item_price_history
+    AFTER INSERT OR UPDATE OF price
+    ON item
+    FOR EACH ROW
+EXECUTE FUNCTION log_item_price_change();
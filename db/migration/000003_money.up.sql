@@ -0,0 +1,49 @@
+-- Prices are stored as integer minor units (kopecks, cents) next to an ISO 4217 currency code.
+-- The price history trigger depends on item.price, so it is recreated around the type change.
+DROP TRIGGER IF EXISTS item_price_history ON item;
+
+UPDATE item SET price = 0 WHERE price IS NULL;
+ALTER TABLE item
+    ALTER COLUMN price TYPE BIGINT USING ROUND(price::NUMERIC * 100)::BIGINT,
+    ALTER COLUMN price SET DEFAULT 0,
+    ALTER COLUMN price SET NOT NULL,
+    ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'RUB';
+
+UPDATE "order" SET total_amount = 0 WHERE total_amount IS NULL;
+ALTER TABLE "order"
+    ALTER COLUMN total_amount TYPE BIGINT USING ROUND(total_amount * 100)::BIGINT,
+    ALTER COLUMN total_amount SET DEFAULT 0,
+    ALTER COLUMN total_amount SET NOT NULL,
+    ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'RUB';
+
+ALTER TABLE price_history
+    ALTER COLUMN old_price TYPE BIGINT USING ROUND(old_price * 100)::BIGINT,
+    ALTER COLUMN new_price TYPE BIGINT USING ROUND(new_price * 100)::BIGINT,
+    ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'RUB';
+
+ALTER TABLE scheduled_price
+    ALTER COLUMN price TYPE BIGINT USING ROUND(price * 100)::BIGINT,
+    ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'RUB';
+
+CREATE OR REPLACE FUNCTION log_item_price_change() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO price_history (item_id, old_price, new_price, currency, source)
+        VALUES (NEW.id, NULL, NEW.price, NEW.currency,
+                COALESCE(NULLIF(current_setting('app.price_source', TRUE), ''), 'manual'));
+    ELSIF OLD.price IS DISTINCT FROM NEW.price OR OLD.currency IS DISTINCT FROM NEW.currency THEN
+        INSERT INTO price_history (item_id, old_price, new_price, currency, source)
+        VALUES (NEW.id, CASE WHEN OLD.currency = NEW.currency THEN OLD.price END, NEW.price, NEW.currency,
+                COALESCE(NULLIF(current_setting('app.price_source', TRUE), ''), 'manual'));
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER item_price_history
+    AFTER INSERT OR UPDATE OF price, currency
+    ON item
+    FOR EACH ROW
+EXECUTE FUNCTION log_item_price_change();
@@ -0,0 +1,5 @@
+ALTER TABLE "order" DROP CONSTRAINT IF EXISTS fk_price_list;
+ALTER TABLE "order" DROP COLUMN IF EXISTS price_list_id;
+
+DROP TABLE IF EXISTS "price_list_item";
+DROP TABLE IF EXISTS "price_list";
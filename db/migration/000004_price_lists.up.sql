@@ -0,0 +1,28 @@
+-- Named price lists. An item is priced in a list either explicitly (price_list_item)
+-- or by converting its base price with the list rate and rounding rules.
+CREATE TABLE IF NOT EXISTS "price_list" (
+    id            SERIAL PRIMARY KEY,
+    slug          VARCHAR(255) NOT NULL UNIQUE,
+    name          VARCHAR(255) NOT NULL,
+    currency      CHAR(3)      NOT NULL,
+    base_currency CHAR(3)      NOT NULL DEFAULT 'RUB',
+    rate          NUMERIC(18, 6),                       -- list units per base unit, NULL for explicit prices only
+    rounding      VARCHAR(16)  NOT NULL DEFAULT 'half_up', -- "half_up", "up", "down"
+    rounding_step BIGINT       NOT NULL DEFAULT 1,     -- in minor units of the list currency
+    created_at    TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at    TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS "price_list_item" (
+    price         BIGINT  NOT NULL,
+
+    price_list_id INTEGER NOT NULL,
+    item_id       UUID    NOT NULL,
+    PRIMARY KEY (price_list_id, item_id),
+    CONSTRAINT fk_price_list FOREIGN KEY (price_list_id) REFERENCES price_list (id) ON DELETE CASCADE,
+    CONSTRAINT fk_item FOREIGN KEY (item_id) REFERENCES item (id) ON DELETE CASCADE
+);
+
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS price_list_id INTEGER;
+ALTER TABLE "order"
+    ADD CONSTRAINT fk_price_list FOREIGN KEY (price_list_id) REFERENCES price_list (id) ON DELETE SET NULL;
@@ -0,0 +1,6 @@
+ALTER TABLE "order" DROP CONSTRAINT IF EXISTS fk_customer_group;
+ALTER TABLE "order" DROP COLUMN IF EXISTS customer_group_id;
+
+DROP INDEX IF EXISTS idx_quantity_break_item;
+DROP TABLE IF EXISTS "quantity_break";
+DROP TABLE IF EXISTS "customer_group";
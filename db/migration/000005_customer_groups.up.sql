@@ -0,0 +1,32 @@
+-- Customer groups are assigned in SSO through "customer_group:<slug>" permissions.
+-- A group may own a price list, which is then reserved for its members.
+CREATE TABLE IF NOT EXISTS "customer_group" (
+    id            SERIAL PRIMARY KEY,
+    slug          VARCHAR(255) NOT NULL UNIQUE,
+    name          VARCHAR(255) NOT NULL,
+    price_list_id INTEGER,
+    created_at    TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at    TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_price_list FOREIGN KEY (price_list_id) REFERENCES price_list (id) ON DELETE SET NULL
+);
+
+-- Quantity breaks take a percentage off the unit price from min_quantity units on.
+-- Breaks without a group apply to every customer.
+CREATE TABLE IF NOT EXISTS "quantity_break" (
+    id                SERIAL PRIMARY KEY,
+    min_quantity      INTEGER NOT NULL CHECK (min_quantity > 1),
+    discount          INTEGER NOT NULL CHECK (discount > 0 AND discount < 100),
+
+    item_id           UUID    NOT NULL,
+    customer_group_id INTEGER,
+    created_at        TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at        TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_item FOREIGN KEY (item_id) REFERENCES item (id) ON DELETE CASCADE,
+    CONSTRAINT fk_customer_group FOREIGN KEY (customer_group_id) REFERENCES customer_group (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_quantity_break_item ON quantity_break (item_id);
+
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS customer_group_id INTEGER;
+ALTER TABLE "order"
+    ADD CONSTRAINT fk_customer_group FOREIGN KEY (customer_group_id) REFERENCES customer_group (id) ON DELETE SET NULL;
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS "favorite_collection_item";
+DROP TABLE IF EXISTS "favorite_collection";
+
+ALTER TABLE "favorites" DROP COLUMN IF EXISTS created_at;
+ALTER TABLE "favorites" DROP COLUMN IF EXISTS notify_in_stock;
+ALTER TABLE "favorites" DROP COLUMN IF EXISTS notify_price_drop;
@@ -0,0 +1,28 @@
+-- Opt-in notifications for favorited items
+ALTER TABLE "favorites" ADD COLUMN IF NOT EXISTS notify_price_drop BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE "favorites" ADD COLUMN IF NOT EXISTS notify_in_stock BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE "favorites" ADD COLUMN IF NOT EXISTS created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP;
+
+-- Named wishlists, a collection becomes public once it has a share token
+CREATE TABLE IF NOT EXISTS "favorite_collection" (
+    id          SERIAL PRIMARY KEY,
+    user_id     UUID         NOT NULL,
+    name        VARCHAR(255) NOT NULL,
+    share_token VARCHAR(64) UNIQUE,
+    created_at  TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at  TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE (user_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS "favorite_collection_item" (
+    collection_id INTEGER NOT NULL,
+    item_id       UUID    NOT NULL,
+    note          TEXT    NOT NULL DEFAULT '',
+    created_at    TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at    TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (collection_id, item_id),
+    CONSTRAINT fk_collection FOREIGN KEY (collection_id) REFERENCES favorite_collection (id) ON DELETE CASCADE,
+    CONSTRAINT fk_item FOREIGN KEY (item_id) REFERENCES item (id) ON DELETE CASCADE
+);
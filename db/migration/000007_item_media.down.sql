@@ -0,0 +1,18 @@
+DROP TRIGGER IF EXISTS item_media_variant_orphan ON item_media_variant;
+DROP TRIGGER IF EXISTS item_media_orphan ON item_media;
+DROP FUNCTION IF EXISTS queue_media_orphan();
+
+DROP TABLE IF EXISTS "media_orphan";
+DROP TABLE IF EXISTS "item_media_variant";
+
+DROP INDEX IF EXISTS idx_item_media_primary;
+DROP INDEX IF EXISTS idx_item_media_item;
+
+ALTER TABLE "item_media" DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE "item_media" DROP COLUMN IF EXISTS is_primary;
+ALTER TABLE "item_media" DROP COLUMN IF EXISTS position;
+ALTER TABLE "item_media" DROP COLUMN IF EXISTS height;
+ALTER TABLE "item_media" DROP COLUMN IF EXISTS width;
+ALTER TABLE "item_media" DROP COLUMN IF EXISTS size;
+ALTER TABLE "item_media" DROP COLUMN IF EXISTS content_type;
+ALTER TABLE "item_media" DROP COLUMN IF EXISTS storage_key;
@@ -0,0 +1,51 @@
+-- Uploaded media keeps its storage key so the file can be removed with the row.
+-- Rows added by hand before uploads existed have an empty key and no variants.
+ALTER TABLE "item_media" ADD COLUMN IF NOT EXISTS storage_key  VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE "item_media" ADD COLUMN IF NOT EXISTS content_type VARCHAR(64)  NOT NULL DEFAULT '';
+ALTER TABLE "item_media" ADD COLUMN IF NOT EXISTS size         BIGINT       NOT NULL DEFAULT 0;
+ALTER TABLE "item_media" ADD COLUMN IF NOT EXISTS width        INTEGER      NOT NULL DEFAULT 0;
+ALTER TABLE "item_media" ADD COLUMN IF NOT EXISTS height       INTEGER      NOT NULL DEFAULT 0;
+ALTER TABLE "item_media" ADD COLUMN IF NOT EXISTS position     INTEGER      NOT NULL DEFAULT 0;
+ALTER TABLE "item_media" ADD COLUMN IF NOT EXISTS is_primary   BOOLEAN      NOT NULL DEFAULT FALSE;
+ALTER TABLE "item_media" ADD COLUMN IF NOT EXISTS updated_at   TIMESTAMP DEFAULT CURRENT_TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_item_media_item ON item_media (item_id, position);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_item_media_primary ON item_media (item_id) WHERE is_primary;
+
+-- Thumbnails and WebP copies generated on upload.
+CREATE TABLE IF NOT EXISTS "item_media_variant" (
+    media_id     INTEGER      NOT NULL,
+    name         VARCHAR(32)  NOT NULL,
+    src          VARCHAR(255) NOT NULL,
+    storage_key  VARCHAR(255) NOT NULL,
+    content_type VARCHAR(64)  NOT NULL,
+    width        INTEGER      NOT NULL,
+    height       INTEGER      NOT NULL,
+
+    PRIMARY KEY (media_id, name),
+    CONSTRAINT fk_media FOREIGN KEY (media_id) REFERENCES item_media (id) ON DELETE CASCADE
+);
+
+-- Files whose rows are gone, including rows removed by cascades from item.
+-- The media cleanup job deletes them from storage.
+CREATE TABLE IF NOT EXISTS "media_orphan" (
+    storage_key VARCHAR(255) PRIMARY KEY,
+    created_at  TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE OR REPLACE FUNCTION queue_media_orphan() RETURNS TRIGGER AS $$
+BEGIN
+    IF OLD.storage_key <> '' THEN
+        INSERT INTO media_orphan (storage_key) VALUES (OLD.storage_key) ON CONFLICT DO NOTHING;
+    END IF;
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER item_media_orphan
+    AFTER DELETE ON item_media
+    FOR EACH ROW EXECUTE FUNCTION queue_media_orphan();
+
+CREATE TRIGGER item_media_variant_orphan
+    AFTER DELETE ON item_media_variant
+    FOR EACH ROW EXECUTE FUNCTION queue_media_orphan();
@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_filter_category_attribute;
+ALTER TABLE "filter" DROP CONSTRAINT IF EXISTS fk_attribute;
+ALTER TABLE "filter" DROP COLUMN IF EXISTS attribute_id;
+
+DROP INDEX IF EXISTS idx_item_attr_attribute;
+ALTER TABLE "item_attr" DROP CONSTRAINT IF EXISTS fk_attribute;
+ALTER TABLE "item_attr" DROP COLUMN IF EXISTS attribute_id;
+
+DROP TABLE IF EXISTS "category_attribute";
+DROP TABLE IF EXISTS "attribute";
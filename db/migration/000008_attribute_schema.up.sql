@@ -0,0 +1,36 @@
+-- Attribute definitions give item_attr names a type, so the same property is spelled and filtered the same way.
+-- allowed_values is only used by enum attributes.
+CREATE TABLE IF NOT EXISTS "attribute" (
+    id             SERIAL PRIMARY KEY,
+    slug           VARCHAR(255) NOT NULL UNIQUE,
+    name           VARCHAR(255) NOT NULL UNIQUE,
+    type           VARCHAR(20)  NOT NULL CHECK (type IN ('string', 'number', 'boolean', 'enum')),
+    unit           VARCHAR(50)  NOT NULL DEFAULT '',
+    allowed_values VARCHAR(255)[] NOT NULL DEFAULT '{}',
+    created_at     TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at     TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- The attributes items of a category are described with.
+CREATE TABLE IF NOT EXISTS "category_attribute" (
+    category_slug VARCHAR(255) NOT NULL,
+    attribute_id  INTEGER      NOT NULL,
+    is_required   BOOLEAN      NOT NULL DEFAULT FALSE,
+    is_filterable BOOLEAN      NOT NULL DEFAULT TRUE,
+    position      INTEGER      NOT NULL DEFAULT 0,
+
+    PRIMARY KEY (category_slug, attribute_id),
+    CONSTRAINT fk_category FOREIGN KEY (category_slug) REFERENCES category (slug) ON DELETE CASCADE,
+    CONSTRAINT fk_attribute FOREIGN KEY (attribute_id) REFERENCES attribute (id) ON DELETE CASCADE
+);
+
+ALTER TABLE "item_attr" ADD COLUMN IF NOT EXISTS attribute_id INTEGER;
+ALTER TABLE "item_attr"
+    ADD CONSTRAINT fk_attribute FOREIGN KEY (attribute_id) REFERENCES attribute (id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_item_attr_attribute ON item_attr (attribute_id);
+
+-- Filters bound to an attribute are derived from the category schema, the rest stay hand made.
+ALTER TABLE "filter" ADD COLUMN IF NOT EXISTS attribute_id INTEGER;
+ALTER TABLE "filter"
+    ADD CONSTRAINT fk_attribute FOREIGN KEY (attribute_id) REFERENCES attribute (id) ON DELETE CASCADE;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_filter_category_attribute ON filter (category_slug, attribute_id) WHERE attribute_id IS NOT NULL;
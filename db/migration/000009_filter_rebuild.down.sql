@@ -0,0 +1,7 @@
+DROP TRIGGER IF EXISTS item_filters_stale ON item;
+DROP TRIGGER IF EXISTS item_attr_filters_stale ON item_attr;
+DROP TRIGGER IF EXISTS item_category_filters_stale ON item_category;
+DROP FUNCTION IF EXISTS mark_category_filters_stale;
+
+DROP INDEX IF EXISTS idx_category_filters_stale;
+ALTER TABLE "category" DROP COLUMN IF EXISTS filters_stale_at;
@@ -0,0 +1,54 @@
+-- filters_stale_at is the time of the last item change in the category since its filters were rebuilt.
+-- The filter worker waits for it to settle before rebuilding, so bulk edits cost a single rebuild.
+ALTER TABLE "category" ADD COLUMN IF NOT EXISTS filters_stale_at TIMESTAMP;
+CREATE INDEX IF NOT EXISTS idx_category_filters_stale ON category (filters_stale_at) WHERE filters_stale_at IS NOT NULL;
+
+CREATE OR REPLACE FUNCTION mark_category_filters_stale() RETURNS TRIGGER AS
+$$
+DECLARE
+    uid UUID;
+BEGIN
+    IF TG_TABLE_NAME = 'item_category' THEN
+        IF TG_OP = 'DELETE' THEN
+            UPDATE category SET filters_stale_at = NOW() WHERE slug = OLD.category_slug;
+        ELSE
+            UPDATE category SET filters_stale_at = NOW() WHERE slug = NEW.category_slug;
+        END IF;
+        RETURN NULL;
+    END IF;
+
+    IF TG_TABLE_NAME = 'item' THEN
+        uid := NEW.id;
+    ELSIF TG_OP = 'DELETE' THEN
+        uid := OLD.item_id;
+    ELSE
+        uid := NEW.item_id;
+    END IF;
+
+    UPDATE category
+    SET filters_stale_at = NOW()
+    WHERE slug IN (SELECT category_slug FROM item_category WHERE item_id = uid)
+      AND filters_stale_at IS DISTINCT FROM NOW();
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER item_category_filters_stale
+    AFTER INSERT OR DELETE
+    ON item_category
+    FOR EACH ROW
+EXECUTE FUNCTION mark_category_filters_stale();
+
+CREATE TRIGGER item_attr_filters_stale
+    AFTER INSERT OR UPDATE OR DELETE
+    ON item_attr
+    FOR EACH ROW
+EXECUTE FUNCTION mark_category_filters_stale();
+
+CREATE TRIGGER item_filters_stale
+    AFTER UPDATE OF price
+    ON item
+    FOR EACH ROW
+    WHEN (OLD.price IS DISTINCT FROM NEW.price)
+EXECUTE FUNCTION mark_category_filters_stale();
@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_order_item_item;
+DROP INDEX IF EXISTS idx_related_product_symmetric;
+
+ALTER TABLE "related_product"
+    DROP CONSTRAINT IF EXISTS chk_related_product_self,
+    DROP COLUMN IF EXISTS updated_at,
+    DROP COLUMN IF EXISTS created_at,
+    DROP COLUMN IF EXISTS position,
+    DROP COLUMN IF EXISTS is_symmetric,
+    DROP COLUMN IF EXISTS type;
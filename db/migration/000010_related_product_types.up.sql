@@ -0,0 +1,17 @@
+-- Self references were never meaningful, they are dropped so the check can be added.
+DELETE FROM related_product WHERE item_id = related_item_id;
+
+-- A symmetric relation is listed from both of its items, a single row serves both directions.
+ALTER TABLE "related_product"
+    ADD COLUMN IF NOT EXISTS type         VARCHAR(20) NOT NULL DEFAULT 'cross_sell'
+        CHECK (type IN ('accessory', 'analogue', 'upsell', 'cross_sell')),
+    ADD COLUMN IF NOT EXISTS is_symmetric BOOLEAN     NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS position     INTEGER     NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS created_at   TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS updated_at   TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    ADD CONSTRAINT chk_related_product_self CHECK (item_id <> related_item_id);
+
+CREATE INDEX IF NOT EXISTS idx_related_product_symmetric ON related_product (related_item_id) WHERE is_symmetric;
+
+-- The recommender looks up orders by item.
+CREATE INDEX IF NOT EXISTS idx_order_item_item ON order_item (item_id);
@@ -0,0 +1,12 @@
+DELETE FROM label WHERE name = 'sale';
+ALTER TABLE "item_label" DROP COLUMN IF EXISTS is_auto;
+
+ALTER TABLE "label"
+    DROP CONSTRAINT IF EXISTS uq_label_name,
+    DROP COLUMN IF EXISTS updated_at,
+    DROP COLUMN IF EXISTS created_at,
+    DROP COLUMN IF EXISTS rule_param,
+    DROP COLUMN IF EXISTS rule,
+    DROP COLUMN IF EXISTS priority,
+    DROP COLUMN IF EXISTS color,
+    DROP COLUMN IF EXISTS title;
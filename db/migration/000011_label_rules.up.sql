@@ -0,0 +1,38 @@
+-- Names become unique: the assignments of a duplicate move to the oldest label of that name.
+INSERT INTO item_label (item_id, label_id)
+SELECT il.item_id, k.id
+FROM item_label il
+    JOIN label l ON l.id = il.label_id
+    JOIN (SELECT name, MIN(id) AS id FROM label GROUP BY name) k ON k.name = l.name AND k.id <> l.id
+ON CONFLICT DO NOTHING;
+DELETE FROM label l
+USING label k
+WHERE k.name = l.name AND k.id < l.id;
+
+-- Labels carry display metadata and an optional rule the label worker assigns them by:
+-- "new" for items created in the last rule_param days, "hit" for the rule_param best selling items,
+-- "sale" for items in a running promotion. "manual" labels are only assigned by hand.
+ALTER TABLE "label"
+    ADD COLUMN IF NOT EXISTS title      VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS color      VARCHAR(7)   NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS priority   INTEGER      NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS rule       VARCHAR(20)  NOT NULL DEFAULT 'manual'
+        CHECK (rule IN ('manual', 'new', 'hit', 'sale')),
+    ADD COLUMN IF NOT EXISTS rule_param INTEGER      NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    ADD CONSTRAINT uq_label_name UNIQUE (name);
+
+-- Rule assignments are replaced on every recomputation, manual ones are kept.
+ALTER TABLE "item_label" ADD COLUMN IF NOT EXISTS is_auto BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE label SET title = 'Hit', priority = 30, rule = 'hit', rule_param = 20 WHERE name = 'hit';
+UPDATE label SET title = 'New', priority = 20, rule = 'new', rule_param = 30 WHERE name = 'new';
+UPDATE label SET title = 'Recommended', priority = 10 WHERE name = 'rec';
+INSERT INTO label (name, title, priority, rule)
+VALUES ('sale', 'Sale', 40, 'sale')
+ON CONFLICT (name) DO NOTHING;
+
+-- Existing assignments of rule labels were made by the rules, the worker may replace them.
+UPDATE item_label SET is_auto = TRUE
+WHERE label_id IN (SELECT id FROM label WHERE rule <> 'manual');
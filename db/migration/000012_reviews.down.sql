@@ -0,0 +1,12 @@
+DROP TRIGGER IF EXISTS review_item_rating ON review;
+DROP FUNCTION IF EXISTS refresh_item_rating();
+
+DROP TABLE IF EXISTS "review_media_variant";
+DROP TABLE IF EXISTS "review_media";
+DROP TABLE IF EXISTS "review";
+
+DROP INDEX IF EXISTS idx_item_rating;
+
+ALTER TABLE "item"
+    DROP COLUMN IF EXISTS review_count,
+    DROP COLUMN IF EXISTS rating;
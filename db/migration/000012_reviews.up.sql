@@ -0,0 +1,94 @@
+-- Aggregates over approved reviews, kept up to date by the trigger below.
+ALTER TABLE "item" ADD COLUMN IF NOT EXISTS rating       NUMERIC(3, 2) NOT NULL DEFAULT 0;
+ALTER TABLE "item" ADD COLUMN IF NOT EXISTS review_count INTEGER       NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_item_rating ON item (rating DESC, review_count DESC);
+
+-- A user reviews an item once, the delivered order it was bought in is kept for reference.
+CREATE TABLE IF NOT EXISTS "review" (
+    id              SERIAL PRIMARY KEY,
+    item_id         UUID         NOT NULL,
+    user_id         UUID         NOT NULL,
+    order_id        INTEGER,
+    rating          SMALLINT     NOT NULL,
+    text            TEXT         NOT NULL DEFAULT '',
+    pros            TEXT         NOT NULL DEFAULT '',
+    cons            TEXT         NOT NULL DEFAULT '',
+    status          VARCHAR(16)  NOT NULL DEFAULT 'pending',
+    moderation_note VARCHAR(255) NOT NULL DEFAULT '',
+    moderated_at    TIMESTAMP,
+
+    created_at      TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at      TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT uq_review_item_user UNIQUE (item_id, user_id),
+    CONSTRAINT chk_review_rating CHECK (rating BETWEEN 1 AND 5),
+    CONSTRAINT chk_review_status CHECK (status IN ('pending', 'approved', 'rejected')),
+    CONSTRAINT fk_item FOREIGN KEY (item_id) REFERENCES item (id) ON DELETE CASCADE,
+    CONSTRAINT fk_order FOREIGN KEY (order_id) REFERENCES "order" (id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_review_item_status ON review (item_id, status, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_review_status ON review (status, created_at);
+
+CREATE TABLE IF NOT EXISTS "review_media" (
+    id           SERIAL PRIMARY KEY,
+    review_id    INTEGER      NOT NULL,
+    src          VARCHAR(255) NOT NULL,
+    storage_key  VARCHAR(255) NOT NULL,
+    content_type VARCHAR(64)  NOT NULL,
+    size         BIGINT       NOT NULL,
+    width        INTEGER      NOT NULL,
+    height       INTEGER      NOT NULL,
+    position     INTEGER      NOT NULL DEFAULT 0,
+
+    created_at   TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_review FOREIGN KEY (review_id) REFERENCES review (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_review_media_review ON review_media (review_id, position);
+
+CREATE TABLE IF NOT EXISTS "review_media_variant" (
+    media_id     INTEGER      NOT NULL,
+    name         VARCHAR(32)  NOT NULL,
+    src          VARCHAR(255) NOT NULL,
+    storage_key  VARCHAR(255) NOT NULL,
+    content_type VARCHAR(64)  NOT NULL,
+    width        INTEGER      NOT NULL,
+    height       INTEGER      NOT NULL,
+
+    PRIMARY KEY (media_id, name),
+    CONSTRAINT fk_media FOREIGN KEY (media_id) REFERENCES review_media (id) ON DELETE CASCADE
+);
+
+CREATE TRIGGER review_media_orphan
+    AFTER DELETE ON review_media
+    FOR EACH ROW EXECUTE FUNCTION queue_media_orphan();
+
+CREATE TRIGGER review_media_variant_orphan
+    AFTER DELETE ON review_media_variant
+    FOR EACH ROW EXECUTE FUNCTION queue_media_orphan();
+
+CREATE OR REPLACE FUNCTION refresh_item_rating() RETURNS TRIGGER AS $$
+DECLARE
+    uid UUID;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        uid := OLD.item_id;
+    ELSE
+        uid := NEW.item_id;
+    END IF;
+
+    UPDATE item SET
+        rating = COALESCE((SELECT ROUND(AVG(rating), 2) FROM review WHERE item_id = uid AND status = 'approved'), 0),
+        review_count = (SELECT COUNT(*) FROM review WHERE item_id = uid AND status = 'approved')
+    WHERE id = uid;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER review_item_rating
+    AFTER INSERT OR DELETE OR UPDATE OF rating, status ON review
+    FOR EACH ROW EXECUTE FUNCTION refresh_item_rating();
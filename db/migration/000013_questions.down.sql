@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "answer";
+DROP TABLE IF EXISTS "question";
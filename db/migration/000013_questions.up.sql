@@ -0,0 +1,40 @@
+-- Questions and answers go through moderation like reviews, only approved ones are shown on the item page.
+CREATE TABLE IF NOT EXISTS "question" (
+    id              SERIAL PRIMARY KEY,
+    item_id         UUID         NOT NULL,
+    user_id         UUID         NOT NULL,
+    text            TEXT         NOT NULL,
+    status          VARCHAR(16)  NOT NULL DEFAULT 'pending',
+    moderation_note VARCHAR(255) NOT NULL DEFAULT '',
+    moderated_at    TIMESTAMP,
+
+    created_at      TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at      TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT chk_question_status CHECK (status IN ('pending', 'approved', 'rejected')),
+    CONSTRAINT fk_item FOREIGN KEY (item_id) REFERENCES item (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_question_item_status ON question (item_id, status, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_question_status ON question (status, created_at);
+
+-- Official answers are flagged by the staff and listed first.
+CREATE TABLE IF NOT EXISTS "answer" (
+    id              SERIAL PRIMARY KEY,
+    question_id     INTEGER      NOT NULL,
+    user_id         UUID         NOT NULL,
+    text            TEXT         NOT NULL,
+    official        BOOLEAN      NOT NULL DEFAULT FALSE,
+    status          VARCHAR(16)  NOT NULL DEFAULT 'pending',
+    moderation_note VARCHAR(255) NOT NULL DEFAULT '',
+    moderated_at    TIMESTAMP,
+
+    created_at      TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at      TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT chk_answer_status CHECK (status IN ('pending', 'approved', 'rejected')),
+    CONSTRAINT fk_question FOREIGN KEY (question_id) REFERENCES question (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_answer_question_status ON answer (question_id, status, official DESC, created_at);
+CREATE INDEX IF NOT EXISTS idx_answer_status ON answer (status, created_at);
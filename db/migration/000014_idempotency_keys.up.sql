@@ -0,0 +1,12 @@
+-- Outcome of the first request sent with an idempotency key, keys are hashed together with the endpoint and caller.
+-- A zero status marks a request still in flight.
+CREATE TABLE IF NOT EXISTS "idempotency_key" (
+    key          VARCHAR(64) PRIMARY KEY,
+    request_hash VARCHAR(64) NOT NULL,
+    status       INT         NOT NULL DEFAULT 0,
+    response     BYTEA,
+
+    created_at   TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_key_created ON idempotency_key (created_at);
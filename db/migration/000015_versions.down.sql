@@ -0,0 +1,4 @@
+ALTER TABLE "order" DROP COLUMN IF EXISTS version;
+ALTER TABLE "promotion" DROP COLUMN IF EXISTS version;
+ALTER TABLE "category" DROP COLUMN IF EXISTS version;
+ALTER TABLE "item" DROP COLUMN IF EXISTS version;
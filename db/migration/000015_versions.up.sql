@@ -0,0 +1,5 @@
+-- Bumped on every update, updates that carry an older version are rejected.
+ALTER TABLE "item" ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 1;
+ALTER TABLE "category" ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 1;
+ALTER TABLE "promotion" ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 1;
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 1;
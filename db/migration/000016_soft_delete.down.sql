@@ -0,0 +1,16 @@
+DROP TRIGGER IF EXISTS item_filters_stale ON item;
+CREATE TRIGGER item_filters_stale
+    AFTER UPDATE OF price
+    ON item
+    FOR EACH ROW
+    WHEN (OLD.price IS DISTINCT FROM NEW.price)
+EXECUTE FUNCTION mark_category_filters_stale();
+
+DROP INDEX IF EXISTS idx_order_deleted_at;
+DROP INDEX IF EXISTS idx_promotion_deleted_at;
+DROP INDEX IF EXISTS idx_category_deleted_at;
+DROP INDEX IF EXISTS idx_item_deleted_at;
+
+ALTER TABLE "promotion" DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE "category" DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE "item" DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,18 @@
+-- Deleted rows are kept in the trash until restored or purged, "order" already has the column.
+ALTER TABLE "item" ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE "category" ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE "promotion" ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS idx_item_deleted_at ON "item" (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_category_deleted_at ON "category" (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_promotion_deleted_at ON "promotion" (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_order_deleted_at ON "order" (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- Moving an item to the trash and back changes the filters of its categories as its price does.
+DROP TRIGGER IF EXISTS item_filters_stale ON item;
+CREATE TRIGGER item_filters_stale
+    AFTER UPDATE OF price, deleted_at
+    ON item
+    FOR EACH ROW
+    WHEN (OLD.price IS DISTINCT FROM NEW.price OR OLD.deleted_at IS DISTINCT FROM NEW.deleted_at)
+EXECUTE FUNCTION mark_category_filters_stale();
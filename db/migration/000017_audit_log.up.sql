@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS audit_log (
+    id         BIGSERIAL PRIMARY KEY,
+    actor_id   VARCHAR(255) NOT NULL DEFAULT '',
+    entity     VARCHAR(64)  NOT NULL,
+    entity_id  VARCHAR(255) NOT NULL,
+    action     VARCHAR(32)  NOT NULL,
+    diff       JSONB        NOT NULL DEFAULT '{}',
+    request_id VARCHAR(255) NOT NULL DEFAULT '',
+
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_log_entity ON audit_log (entity, entity_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_log_actor ON audit_log (actor_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_log_created_at ON audit_log (created_at DESC);
@@ -0,0 +1,12 @@
+-- The state an item had at a version, captured by every update before it changes the item.
+CREATE TABLE IF NOT EXISTS "item_revision" (
+    id         BIGSERIAL PRIMARY KEY,
+    item_id    UUID  NOT NULL,
+    version    INT   NOT NULL,
+    snapshot   JSONB NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CONSTRAINT fk_item FOREIGN KEY (item_id) REFERENCES item (id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_item_revision_version ON item_revision (item_id, version);
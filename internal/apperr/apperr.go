@@ -0,0 +1,64 @@
+// Package apperr classifies the errors of the service, so the transports translate them in one place.
+package apperr
+
+import "errors"
+
+type Kind uint8
+
+const (
+	Internal Kind = iota
+	Validation
+	Unauthenticated
+	Forbidden
+	NotFound
+	AlreadyExists
+	Conflict
+	PreconditionFailed
+	Unavailable
+)
+
+var kindNames = [...]string{
+	Internal:           "internal",
+	Validation:         "validation",
+	Unauthenticated:    "unauthenticated",
+	Forbidden:          "forbidden",
+	NotFound:           "not_found",
+	AlreadyExists:      "already_exists",
+	Conflict:           "conflict",
+	PreconditionFailed: "precondition_failed",
+	Unavailable:        "unavailable",
+}
+
+func (k Kind) String() string {
+	if int(k) < len(kindNames) {
+		return kindNames[k]
+	}
+	return kindNames[Internal]
+}
+
+// Error is an error of a known kind, sentinels are declared with New and compared with errors.Is as usual.
+type Error struct {
+	kind Kind
+	msg  string
+}
+
+func New(kind Kind, msg string) error {
+	return &Error{kind: kind, msg: msg}
+}
+
+func (e *Error) Error() string {
+	return e.msg
+}
+
+func (e *Error) Kind() Kind {
+	return e.kind
+}
+
+// KindOf returns the kind of the first error in the chain of err that has one, Internal otherwise.
+func KindOf(err error) Kind {
+	var k interface{ Kind() Kind }
+	if errors.As(err, &k) {
+		return k.Kind()
+	}
+	return Internal
+}
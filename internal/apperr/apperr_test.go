@@ -0,0 +1,18 @@
+package apperr
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestKindOf(t *testing.T) {
+	errNotFound := New(NotFound, "not found")
+
+	assert.Equal(t, NotFound, KindOf(errNotFound))
+	assert.Equal(t, NotFound, KindOf(fmt.Errorf("%w: item", errNotFound)))
+	assert.Equal(t, Internal, KindOf(errors.New("connection reset")))
+	assert.Equal(t, Internal, KindOf(nil))
+	assert.Equal(t, "not_found", KindOf(errNotFound).String())
+}
@@ -94,6 +94,21 @@ func (c *Cache) Set(ctx context.Context, t time.Duration, key string, val any) e
 	return nil
 }
 
+func (c *Cache) SetNX(ctx context.Context, t time.Duration, key string, val any) (bool, error) {
+	const op = "SetNXToCache"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	ok, err := c.cli.SetNX(ctx, key, val, t).Result()
+	if err != nil {
+		zap.L().Debug("[CACHE] ERROR", zap.String("key", key), zap.Error(err))
+		return false, err
+	}
+
+	zap.L().Debug("[CACHE] SETNX", zap.String("key", key), zap.Bool("set", ok))
+	return ok, nil
+}
+
 func (c *Cache) Delete(ctx context.Context, key string) error {
 	const op = "DeleteFromCache"
 	span, _ := opentracing.StartSpanFromContext(ctx, op)
@@ -0,0 +1,285 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"github.com/JMURv/par-pro/products/internal/discovery"
+	cfg "github.com/JMURv/par-pro/products/pkg/config"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"math/rand/v2"
+	"strings"
+	"sync"
+	"time"
+)
+
+var ErrNotFoundSvc = errors.New("service not found")
+var ErrCreateClient = errors.New("failed to create client")
+var ErrCircuitOpen = errors.New("service is unavailable")
+
+// Call makes the RPCs of a single attempt over cc, ctx carries the attempt deadline and tracing metadata.
+type Call func(ctx context.Context, cc grpc.ClientConnInterface) error
+
+// Manager keeps one connection per downstream service, resolved through discovery.
+// A connection is dropped and resolved again when the service turns unavailable, it is closed once the calls
+// still running on it are done.
+type Manager struct {
+	discovery discovery.ServiceDiscovery
+	timeout   time.Duration
+	retries   int
+	backoff   time.Duration
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	conns    map[string]*pooledConn
+	breakers map[string]*breaker
+}
+
+// pooledConn counts the calls running on a connection, so a dropped connection isn't closed under them.
+type pooledConn struct {
+	cc    *grpc.ClientConn
+	calls sync.WaitGroup
+}
+
+func New(discovery discovery.ServiceDiscovery, conf *cfg.ClientConfig) *Manager {
+	m := &Manager{
+		discovery: discovery,
+		timeout:   conf.Timeout,
+		retries:   conf.Retries,
+		backoff:   conf.Backoff,
+		threshold: conf.BreakerThreshold,
+		cooldown:  conf.BreakerCooldown,
+		conns:     make(map[string]*pooledConn),
+		breakers:  make(map[string]*breaker),
+	}
+
+	if m.timeout <= 0 {
+		m.timeout = consts.DefaultClientTimeout
+	}
+	if m.retries < 0 {
+		m.retries = 0
+	}
+	if m.backoff <= 0 {
+		m.backoff = consts.DefaultClientBackoff
+	}
+	if m.threshold <= 0 {
+		m.threshold = consts.DefaultBreakerThreshold
+	}
+	if m.cooldown <= 0 {
+		m.cooldown = consts.DefaultBreakerCooldown
+	}
+	return m
+}
+
+// Invoke runs call against service once, so it is the one to use for calls that must not be repeated,
+// like creating a user. Once the service keeps failing its breaker opens and calls fail fast with ErrCircuitOpen.
+func (m *Manager) Invoke(ctx context.Context, service string, call Call) error {
+	return m.invoke(ctx, service, call, 0)
+}
+
+// InvokeIdempotent is Invoke for calls that are safe to repeat, attempts failing with Unavailable or
+// ResourceExhausted are retried with exponential backoff.
+func (m *Manager) InvokeIdempotent(ctx context.Context, service string, call Call) error {
+	return m.invoke(ctx, service, call, m.retries)
+}
+
+// invoke runs call against service with up to retries extra attempts.
+func (m *Manager) invoke(ctx context.Context, service string, call Call, retries int) error {
+	b := m.breaker(service)
+	if !b.allow() {
+		return ErrCircuitOpen
+	}
+
+	var err error
+	for attempt := 0; attempt <= retries; attempt++ {
+		if attempt > 0 {
+			if err = m.wait(ctx, attempt); err != nil {
+				break
+			}
+		}
+
+		var pc *pooledConn
+		if pc, err = m.attempt(ctx, service, call); !retryable(err) {
+			break
+		}
+
+		zap.L().Debug(
+			"call failed", zap.String("service", service), zap.Int("attempt", attempt+1), zap.Error(err),
+		)
+		m.drop(service, pc)
+	}
+
+	if failed(err) {
+		b.failure()
+	} else {
+		b.success()
+	}
+	return err
+}
+
+// attempt runs call once and returns the connection it used, nil when there was none to use.
+func (m *Manager) attempt(ctx context.Context, service string, call Call) (*pooledConn, error) {
+	pc, err := m.conn(ctx, service)
+	if err != nil {
+		return nil, err
+	}
+	defer pc.calls.Done()
+
+	ctx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+
+	return pc, call(injectSpan(ctx), pc.cc)
+}
+
+// wait sleeps for the backoff of attempt, a random jitter keeps callers from retrying in lockstep.
+func (m *Manager) wait(ctx context.Context, attempt int) error {
+	d := m.backoff << (attempt - 1)
+	d += rand.N(d/2 + 1)
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// conn returns the connection of service with the call counted, the caller marks it done.
+func (m *Manager) conn(ctx context.Context, service string) (*pooledConn, error) {
+	m.mu.Lock()
+	pc, ok := m.conns[service]
+	if ok {
+		pc.calls.Add(1)
+	}
+	m.mu.Unlock()
+	if ok {
+		return pc, nil
+	}
+
+	addr, err := m.discovery.FindServiceByName(ctx, service)
+	if err != nil {
+		zap.L().Debug("failed to find svc", zap.String("service", service), zap.Error(err))
+		return nil, status.Errorf(codes.Unavailable, ErrNotFoundSvc.Error())
+	}
+
+	cc, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		zap.L().Debug("failed to create client", zap.String("service", service), zap.Error(err))
+		return nil, ErrCreateClient
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Another call may have connected meanwhile, its connection wins.
+	pc, ok = m.conns[service]
+	if ok {
+		_ = cc.Close()
+	} else {
+		pc = &pooledConn{cc: cc}
+		m.conns[service] = pc
+	}
+	pc.calls.Add(1)
+	return pc, nil
+}
+
+// drop forgets the connection pc of service so that the next attempt resolves the service again.
+// A connection another call has already replaced is left alone, pc is closed after its running calls.
+func (m *Manager) drop(service string, pc *pooledConn) {
+	if pc == nil {
+		return
+	}
+
+	m.mu.Lock()
+	current := m.conns[service] == pc
+	if current {
+		delete(m.conns, service)
+	}
+	m.mu.Unlock()
+
+	if !current {
+		return
+	}
+
+	go func() {
+		pc.calls.Wait()
+		if err := pc.cc.Close(); err != nil {
+			zap.L().Debug("failed to close client", zap.String("service", service), zap.Error(err))
+		}
+	}()
+}
+
+func (m *Manager) breaker(service string) *breaker {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	b, ok := m.breakers[service]
+	if !ok {
+		b = newBreaker(m.threshold, m.cooldown)
+		m.breakers[service] = b
+	}
+	return b
+}
+
+func (m *Manager) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var errs []error
+	for k, pc := range m.conns {
+		errs = append(errs, pc.cc.Close())
+		delete(m.conns, k)
+	}
+	return errors.Join(errs...)
+}
+
+// retryable reports whether an attempt may succeed when repeated, the request wasn't served in that case.
+func retryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
+
+// failed reports whether err counts against the breaker, errors answered by the service itself don't.
+func failed(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
+
+// injectSpan passes the current span on to the service in the outgoing metadata.
+func injectSpan(ctx context.Context) context.Context {
+	span := opentracing.SpanFromContext(ctx)
+	if span == nil {
+		return ctx
+	}
+
+	carrier := opentracing.TextMapCarrier{}
+	if err := opentracing.GlobalTracer().Inject(span.Context(), opentracing.TextMap, carrier); err != nil {
+		zap.L().Debug("failed to inject span", zap.Error(err))
+		return ctx
+	}
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	for k, v := range carrier {
+		md.Set(strings.ToLower(k), v)
+	}
+	return metadata.NewOutgoingContext(ctx, md)
+}
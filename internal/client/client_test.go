@@ -0,0 +1,201 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"github.com/JMURv/par-pro/products/mocks"
+	cfg "github.com/JMURv/par-pro/products/pkg/config"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"net"
+	"testing"
+	"time"
+)
+
+func startHealthServer(t *testing.T) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	srv := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(srv, health.NewServer())
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	t.Cleanup(srv.Stop)
+
+	return lis.Addr().String()
+}
+
+func checkHealth(ctx context.Context, cc grpc.ClientConnInterface) error {
+	_, err := grpc_health_v1.NewHealthClient(cc).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	return err
+}
+
+func TestManager_Invoke(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	dsc := mocks.NewMockServiceDiscovery(mock)
+	m := New(dsc, &cfg.ClientConfig{Retries: 2, Backoff: time.Millisecond, BreakerThreshold: 2})
+	defer m.Close()
+
+	ctx := context.Background()
+	addr := startHealthServer(t)
+
+	t.Run(
+		"ReusesConnection", func(t *testing.T) {
+			dsc.EXPECT().FindServiceByName(gomock.Any(), "health").Return(addr, nil).Times(1)
+
+			for range 3 {
+				assert.NoError(t, m.InvokeIdempotent(ctx, "health", checkHealth))
+			}
+		},
+	)
+
+	t.Run(
+		"ResolvesAgainWhenUnavailable", func(t *testing.T) {
+			dsc.EXPECT().FindServiceByName(gomock.Any(), "health").Return(addr, nil).Times(1)
+
+			calls := 0
+			err := m.InvokeIdempotent(
+				ctx, "health", func(ctx context.Context, cc grpc.ClientConnInterface) error {
+					if calls++; calls == 1 {
+						return status.Error(codes.Unavailable, "connection refused")
+					}
+					return checkHealth(ctx, cc)
+				},
+			)
+			assert.NoError(t, err)
+			assert.Equal(t, 2, calls)
+		},
+	)
+
+	t.Run(
+		"KeepsConnectionOfRunningCalls", func(t *testing.T) {
+			dsc.EXPECT().FindServiceByName(gomock.Any(), "health").Return(addr, nil).Times(1)
+
+			started, release := make(chan struct{}), make(chan struct{})
+			done := make(chan error, 1)
+			go func() {
+				done <- m.InvokeIdempotent(
+					ctx, "health", func(ctx context.Context, cc grpc.ClientConnInterface) error {
+						close(started)
+						<-release
+						return checkHealth(ctx, cc)
+					},
+				)
+			}()
+			<-started
+
+			// The failed call drops the shared connection while the other one is still running on it.
+			calls := 0
+			err := m.InvokeIdempotent(
+				ctx, "health", func(ctx context.Context, cc grpc.ClientConnInterface) error {
+					if calls++; calls == 1 {
+						return status.Error(codes.Unavailable, "connection refused")
+					}
+					return checkHealth(ctx, cc)
+				},
+			)
+			assert.NoError(t, err)
+
+			close(release)
+			assert.NoError(t, <-done)
+		},
+	)
+
+	t.Run(
+		"DoesNotRetryServiceErrors", func(t *testing.T) {
+			calls := 0
+			err := m.InvokeIdempotent(
+				ctx, "health", func(ctx context.Context, cc grpc.ClientConnInterface) error {
+					calls++
+					return status.Error(codes.NotFound, "not found")
+				},
+			)
+			assert.Equal(t, codes.NotFound, status.Code(err))
+			assert.Equal(t, 1, calls)
+		},
+	)
+
+	t.Run(
+		"DoesNotRetryMutations", func(t *testing.T) {
+			calls := 0
+			err := m.Invoke(
+				ctx, "health", func(ctx context.Context, cc grpc.ClientConnInterface) error {
+					calls++
+					return status.Error(codes.Unavailable, "connection refused")
+				},
+			)
+			assert.Equal(t, codes.Unavailable, status.Code(err))
+			assert.Equal(t, 1, calls)
+		},
+	)
+
+	t.Run(
+		"OpensBreaker", func(t *testing.T) {
+			dsc.EXPECT().FindServiceByName(gomock.Any(), "down").Return("", errors.New("not found")).Times(6)
+
+			for range 2 {
+				err := m.InvokeIdempotent(
+					ctx, "down", func(ctx context.Context, cc grpc.ClientConnInterface) error {
+						return nil
+					},
+				)
+				assert.Equal(t, codes.Unavailable, status.Code(err))
+			}
+
+			err := m.InvokeIdempotent(
+				ctx, "down", func(ctx context.Context, cc grpc.ClientConnInterface) error {
+					return nil
+				},
+			)
+			assert.ErrorIs(t, err, ErrCircuitOpen)
+		},
+	)
+}
+
+func TestManager_Deadline(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	dsc := mocks.NewMockServiceDiscovery(mock)
+	dsc.EXPECT().FindServiceByName(gomock.Any(), "health").Return(startHealthServer(t), nil).Times(1)
+
+	m := New(dsc, &cfg.ClientConfig{Timeout: 10 * time.Millisecond})
+	defer m.Close()
+
+	err := m.Invoke(
+		context.Background(), "health", func(ctx context.Context, cc grpc.ClientConnInterface) error {
+			deadline, ok := ctx.Deadline()
+			assert.True(t, ok)
+			assert.WithinDuration(t, time.Now().Add(10*time.Millisecond), deadline, 10*time.Millisecond)
+			return nil
+		},
+	)
+	assert.NoError(t, err)
+}
+
+func TestInjectSpan(t *testing.T) {
+	tracer := mocktracer.New()
+	prev := opentracing.GlobalTracer()
+	opentracing.SetGlobalTracer(tracer)
+	defer opentracing.SetGlobalTracer(prev)
+
+	span := tracer.StartSpan("op")
+	defer span.Finish()
+
+	ctx := injectSpan(opentracing.ContextWithSpan(context.Background(), span))
+	md, ok := metadata.FromOutgoingContext(ctx)
+	require.True(t, ok)
+	assert.NotEmpty(t, md.Get("mockpfx-ids-traceid"))
+}
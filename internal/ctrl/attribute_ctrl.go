@@ -0,0 +1,236 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/JMURv/par-pro/products/pkg/utils/slugify"
+	"github.com/goccy/go-json"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+const attributeCacheKey = "attribute:%v"
+const attributesCacheKey = "attributes"
+const categoryAttributesCacheKey = "attributes-category:%v"
+const invalidateAttributeCachePattern = "attribute*"
+
+type attributeRepo interface {
+	ListAttributes(ctx context.Context) ([]*model.Attribute, error)
+	GetAttribute(ctx context.Context, slug string) (*model.Attribute, error)
+	CreateAttribute(ctx context.Context, a *model.Attribute) (string, error)
+	UpdateAttribute(ctx context.Context, slug string, a *model.Attribute) error
+	DeleteAttribute(ctx context.Context, slug string) error
+
+	ListCategoryAttributes(ctx context.Context, slugs []string) ([]*model.CategoryAttribute, error)
+	SetCategoryAttributes(ctx context.Context, slug string, attrs []*model.CategoryAttribute) error
+}
+
+func (c *Controller) ListAttributes(ctx context.Context) ([]*model.Attribute, error) {
+	const op = "attributes.ListAttributes.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	cached := make([]*model.Attribute, 0, 20)
+	if err := c.cache.GetToStruct(ctx, attributesCacheKey, &cached); err == nil {
+		return cached, nil
+	}
+
+	res, err := c.repo.ListAttributes(ctx)
+	if err != nil {
+		zap.L().Debug("failed to list attributes", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	if bytes, err := json.Marshal(res); err == nil {
+		if err = c.cache.Set(ctx, consts.DefaultCacheTime, attributesCacheKey, bytes); err != nil {
+			zap.L().Debug("failed to set to cache", zap.Error(err), zap.String("op", op))
+		}
+	}
+
+	return res, nil
+}
+
+func (c *Controller) GetAttribute(ctx context.Context, slug string) (*model.Attribute, error) {
+	const op = "attributes.GetAttribute.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	cached := &model.Attribute{}
+	cacheKey := fmt.Sprintf(attributeCacheKey, slug)
+	if err := c.cache.GetToStruct(ctx, cacheKey, cached); err == nil {
+		return cached, nil
+	}
+
+	res, err := c.repo.GetAttribute(ctx, slug)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find attribute", zap.Error(err), zap.String("op", op))
+		return nil, ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to get attribute", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	if bytes, err := json.Marshal(res); err == nil {
+		if err = c.cache.Set(ctx, consts.DefaultCacheTime, cacheKey, bytes); err != nil {
+			zap.L().Debug("failed to set to cache", zap.Error(err), zap.String("op", op))
+		}
+	}
+
+	return res, nil
+}
+
+func (c *Controller) CreateAttribute(ctx context.Context, a *model.Attribute) (string, error) {
+	const op = "attributes.CreateAttribute.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	if a.Slug == "" {
+		a.Slug = slugify.Slugify(a.Name)
+	}
+
+	slug, err := c.repo.CreateAttribute(ctx, a)
+	if err != nil && errors.Is(err, repo.ErrAlreadyExists) {
+		zap.L().Debug("attribute already exists", zap.Error(err), zap.String("op", op))
+		return "", ErrAlreadyExists
+	} else if err != nil {
+		zap.L().Debug("failed to create attribute", zap.Error(err), zap.String("op", op))
+		return "", err
+	}
+
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateAttributeCachePattern)
+	c.audit(ctx, model.AuditAttribute, model.AuditCreate, slug, nil, a)
+	return slug, nil
+}
+
+func (c *Controller) UpdateAttribute(ctx context.Context, slug string, a *model.Attribute) error {
+	const op = "attributes.UpdateAttribute.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	prev := snapshot(ctx, c.repo.GetAttribute, slug)
+	err := c.repo.UpdateAttribute(ctx, slug, a)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find attribute", zap.Error(err), zap.String("op", op))
+		return ErrNotFound
+	} else if err != nil && errors.Is(err, repo.ErrAlreadyExists) {
+		zap.L().Debug("attribute already exists", zap.Error(err), zap.String("op", op))
+		return ErrAlreadyExists
+	} else if err != nil {
+		zap.L().Debug("failed to update attribute", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	c.invalidateAttributes(ctx, slug)
+	c.audit(ctx, model.AuditAttribute, model.AuditUpdate, slug, prev, snapshot(ctx, c.repo.GetAttribute, slug))
+	return nil
+}
+
+func (c *Controller) DeleteAttribute(ctx context.Context, slug string) error {
+	const op = "attributes.DeleteAttribute.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	prev := snapshot(ctx, c.repo.GetAttribute, slug)
+	err := c.repo.DeleteAttribute(ctx, slug)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find attribute", zap.Error(err), zap.String("op", op))
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to delete attribute", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	c.invalidateAttributes(ctx, slug)
+	c.audit(ctx, model.AuditAttribute, model.AuditDelete, slug, prev, nil)
+	return nil
+}
+
+func (c *Controller) ListCategoryAttributes(ctx context.Context, slug string) ([]*model.CategoryAttribute, error) {
+	const op = "attributes.ListCategoryAttributes.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	cached := make([]*model.CategoryAttribute, 0, 20)
+	cacheKey := fmt.Sprintf(categoryAttributesCacheKey, slug)
+	if err := c.cache.GetToStruct(ctx, cacheKey, &cached); err == nil {
+		return cached, nil
+	}
+
+	res, err := c.repo.ListCategoryAttributes(ctx, []string{slug})
+	if err != nil {
+		zap.L().Debug("failed to list category attributes", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	if bytes, err := json.Marshal(res); err == nil {
+		if err = c.cache.Set(ctx, consts.DefaultCacheTime, cacheKey, bytes); err != nil {
+			zap.L().Debug("failed to set to cache", zap.Error(err), zap.String("op", op))
+		}
+	}
+
+	return res, nil
+}
+
+// SetCategoryAttributes replaces the schema of the category, its attribute filters are derived again.
+func (c *Controller) SetCategoryAttributes(ctx context.Context, slug string, attrs []*model.CategoryAttribute) error {
+	const op = "attributes.SetCategoryAttributes.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	err := c.repo.SetCategoryAttributes(ctx, slug, attrs)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find category or attribute", zap.Error(err), zap.String("op", op))
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to set category attributes", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	if err = c.cache.Delete(ctx, fmt.Sprintf(categoryAttributesCacheKey, slug)); err != nil {
+		zap.L().Debug("failed to delete from cache", zap.Error(err), zap.String("op", op))
+	}
+
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateCategoryRelatedCachePattern)
+	c.audit(ctx, model.AuditCategoryAttributes, model.AuditUpdate, slug, nil, attrs)
+	return nil
+}
+
+// GetAttributeSchema collects the attributes of every given category, items are validated against it.
+func (c *Controller) GetAttributeSchema(ctx context.Context, slugs []string) ([]*model.CategoryAttribute, error) {
+	const op = "attributes.GetAttributeSchema.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	res := make([]*model.CategoryAttribute, 0, 20)
+	for _, slug := range slugs {
+		attrs, err := c.ListCategoryAttributes(ctx, slug)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, attrs...)
+	}
+
+	return res, nil
+}
+
+// invalidateAttributes drops the definition and everything derived from it: category schemas and filters.
+func (c *Controller) invalidateAttributes(ctx context.Context, slug string) {
+	if err := c.cache.Delete(ctx, fmt.Sprintf(attributeCacheKey, slug)); err != nil {
+		zap.L().Debug("failed to delete from cache", zap.Error(err))
+	}
+
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateAttributeCachePattern)
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateCategoryRelatedCachePattern)
+}
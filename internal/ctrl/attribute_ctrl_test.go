@@ -0,0 +1,94 @@
+package ctrl
+
+import (
+	"context"
+	"fmt"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+func TestController_GetAttributeSchema(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+
+	weight := &model.CategoryAttribute{
+		CategorySlug: "phones",
+		AttributeID:  1,
+		Attribute:    &model.Attribute{ID: 1, Slug: "weight", Name: "Weight", Type: model.AttributeTypeNumber},
+	}
+
+	cc.EXPECT().GetToStruct(gomock.Any(), fmt.Sprintf(categoryAttributesCacheKey, "phones"), gomock.Any()).
+		Return(repo.ErrNotFound)
+	rr.EXPECT().ListCategoryAttributes(gomock.Any(), []string{"phones"}).
+		Return([]*model.CategoryAttribute{weight}, nil)
+	cc.EXPECT().Set(gomock.Any(), gomock.Any(), fmt.Sprintf(categoryAttributesCacheKey, "phones"), gomock.Any()).
+		Return(nil)
+
+	cc.EXPECT().GetToStruct(gomock.Any(), fmt.Sprintf(categoryAttributesCacheKey, "cases"), gomock.Any()).
+		Return(repo.ErrNotFound)
+	rr.EXPECT().ListCategoryAttributes(gomock.Any(), []string{"cases"}).
+		Return([]*model.CategoryAttribute{}, nil)
+	cc.EXPECT().Set(gomock.Any(), gomock.Any(), fmt.Sprintf(categoryAttributesCacheKey, "cases"), gomock.Any()).
+		Return(nil)
+
+	res, err := ctrl.GetAttributeSchema(context.Background(), []string{"phones", "cases"})
+	require.NoError(t, err)
+	assert.Equal(t, []*model.CategoryAttribute{weight}, res)
+}
+
+func TestController_SetCategoryAttributes(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+	expectAudit(rr)
+	attrs := []*model.CategoryAttribute{{AttributeID: 1, IsFilterable: true}}
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, error)
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				rr.EXPECT().SetCategoryAttributes(gomock.Any(), "phones", attrs).Return(nil)
+				cc.EXPECT().Delete(gomock.Any(), fmt.Sprintf(categoryAttributesCacheKey, "phones")).Return(nil)
+				cc.EXPECT().InvalidateKeysByPattern(gomock.Any(), invalidateCategoryRelatedCachePattern).AnyTimes()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "Unknown category or attribute",
+			mockExpect: func() {
+				rr.EXPECT().SetCategoryAttributes(gomock.Any(), "phones", attrs).Return(repo.ErrNotFound)
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.Equal(t, ErrNotFound, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				err := ctrl.SetCategoryAttributes(context.Background(), "phones", attrs)
+				tt.expectedResp(t, err)
+			},
+		)
+	}
+}
@@ -0,0 +1,133 @@
+package ctrl
+
+import (
+	"context"
+	"fmt"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/goccy/go-json"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"reflect"
+)
+
+type auditRepo interface {
+	CreateAuditEntry(ctx context.Context, e *model.AuditEntry) error
+	ListAuditEntries(ctx context.Context, f *model.AuditFilter, page, size int) (*model.PaginatedAuditData, error)
+}
+
+type requestIDCtxKey struct{}
+
+// WithRequestID sets the id the request is logged and audited under.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDCtxKey{}, id)
+}
+
+// RequestIDFromContext returns the id of the request, empty for background jobs.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDCtxKey{}).(string)
+	return id
+}
+
+func (c *Controller) ListAuditLog(ctx context.Context, f *model.AuditFilter, page, size int) (*model.PaginatedAuditData, error) {
+	const op = "audit.ListAuditLog.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	res, err := c.repo.ListAuditEntries(ctx, f, page, size)
+	if err != nil {
+		zap.L().Debug("failed to list audit log", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// audit records a successful mutation of the entity with the given id by the caller.
+// before and after are the states around the change, nil when the entity did not exist or could not be read.
+// The mutation is already done, so a failure to record it is logged rather than returned.
+func (c *Controller) audit(ctx context.Context, entity, action string, id any, before, after any) {
+	diff, err := auditDiff(before, after)
+	if err != nil {
+		zap.L().Debug("failed to diff audited entity", zap.Error(err), zap.String("entity", entity))
+		diff = []byte("{}")
+	}
+
+	e := &model.AuditEntry{
+		ActorID:   UserIDFromContext(ctx),
+		Entity:    entity,
+		EntityID:  fmt.Sprint(id),
+		Action:    action,
+		Diff:      diff,
+		RequestID: RequestIDFromContext(ctx),
+	}
+	if err = c.repo.CreateAuditEntry(ctx, e); err != nil {
+		zap.L().Debug(
+			"failed to record audit entry", zap.Error(err),
+			zap.String("entity", entity), zap.String("id", e.EntityID), zap.String("action", action),
+		)
+	}
+}
+
+// snapshot reads the stored state of an entity for the audit diff, nil when it can't be read.
+func snapshot[K, T any](ctx context.Context, get func(context.Context, K) (*T, error), key K) any {
+	res, err := get(ctx, key)
+	if err != nil {
+		return nil
+	}
+	return res
+}
+
+// auditDiff keeps the top level fields that differ between before and after as {"before": {...}, "after": {...}}.
+// Values that are not JSON objects are compared as a whole under "value".
+func auditDiff(before, after any) (json.RawMessage, error) {
+	b, err := auditFields(before)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := auditFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]map[string]any, 2)
+	changed := func(side string, from, to map[string]any) {
+		for k, v := range from {
+			if other, ok := to[k]; ok && reflect.DeepEqual(v, other) {
+				continue
+			}
+
+			if res[side] == nil {
+				res[side] = make(map[string]any)
+			}
+			res[side][k] = v
+		}
+	}
+	changed("before", b, a)
+	changed("after", a, b)
+
+	return json.Marshal(res)
+}
+
+func auditFields(v any) (map[string]any, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	bytes, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var res map[string]any
+	if err = json.Unmarshal(bytes, &res); err == nil {
+		return res, nil
+	}
+
+	var value any
+	if err = json.Unmarshal(bytes, &value); err != nil {
+		return nil, err
+	}
+	return map[string]any{"value": value}, nil
+}
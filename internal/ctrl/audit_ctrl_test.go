@@ -0,0 +1,113 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+// expectAudit lets a test of a mutation ignore the audit entry it records.
+func expectAudit(rr *mocks.MockAppRepo) {
+	rr.EXPECT().CreateAuditEntry(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+}
+
+func TestAuditDiff(t *testing.T) {
+	tests := []struct {
+		name   string
+		before any
+		after  any
+		expect string
+	}{
+		{
+			name:   "Created",
+			after:  map[string]any{"slug": "shoes", "title": "Shoes"},
+			expect: `{"after":{"slug":"shoes","title":"Shoes"}}`,
+		},
+		{
+			name:   "Deleted",
+			before: map[string]any{"slug": "shoes"},
+			expect: `{"before":{"slug":"shoes"}}`,
+		},
+		{
+			name:   "Only changed fields are kept",
+			before: map[string]any{"slug": "shoes", "title": "Shoes", "tags": []string{"a"}},
+			after:  map[string]any{"slug": "shoes", "title": "Boots", "tags": []string{"a"}},
+			expect: `{"before":{"title":"Shoes"},"after":{"title":"Boots"}}`,
+		},
+		{
+			name:   "Values that are not objects",
+			after:  []string{"new", "hit"},
+			expect: `{"after":{"value":["new","hit"]}}`,
+		},
+		{
+			name:   "Nothing known",
+			expect: `{}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				res, err := auditDiff(tt.before, tt.after)
+				require.NoError(t, err)
+				assert.JSONEq(t, tt.expect, string(res))
+			},
+		)
+	}
+}
+
+func TestController_Audit(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+
+	ctx := WithRequestID(WithUserID(context.Background(), "user-1"), "req-1")
+	rr.EXPECT().CreateAuditEntry(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, e *model.AuditEntry) error {
+			assert.Equal(t, "user-1", e.ActorID)
+			assert.Equal(t, model.AuditOrder, e.Entity)
+			assert.Equal(t, "12", e.EntityID)
+			assert.Equal(t, model.AuditCancel, e.Action)
+			assert.Equal(t, "req-1", e.RequestID)
+			assert.JSONEq(t, `{"before":{"status":"created"},"after":{"status":"cancelled"}}`, string(e.Diff))
+			return nil
+		},
+	)
+	ctrl.audit(
+		ctx, model.AuditOrder, model.AuditCancel, uint64(12),
+		map[string]string{"status": "created"}, map[string]string{"status": "cancelled"},
+	)
+
+	// The mutation is already done, a failure to record it must not surface
+	rr.EXPECT().CreateAuditEntry(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+	ctrl.audit(context.Background(), model.AuditLabel, model.AuditDelete, "new", nil, nil)
+}
+
+func TestController_ListAuditLog(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+
+	f := &model.AuditFilter{Entity: model.AuditItem}
+	dbErr := errors.New("db error")
+
+	rr.EXPECT().ListAuditEntries(gomock.Any(), f, 1, 10).Return(&model.PaginatedAuditData{Count: 1}, nil)
+	res, err := ctrl.ListAuditLog(context.Background(), f, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), res.Count)
+
+	rr.EXPECT().ListAuditEntries(gomock.Any(), f, 1, 10).Return(nil, dbErr)
+	_, err = ctrl.ListAuditLog(context.Background(), f, 1, 10)
+	assert.ErrorIs(t, err, dbErr)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/goccy/go-json"
 	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
+	"time"
 )
 
 const categoryCacheKey = "category:%v"
@@ -25,9 +26,12 @@ type categoryRepo interface {
 	GetCategoryBySlug(ctx context.Context, slug string) (*model.Category, error)
 	CreateCategory(ctx context.Context, c *model.Category) (string, error)
 	UpdateCategory(ctx context.Context, slug string, c *model.Category) error
+	PatchCategory(ctx context.Context, slug string, c *model.Category, fields []string) error
 	DeleteCategory(ctx context.Context, slug string) error
 
 	ListCategoryFilters(ctx context.Context, slug string) ([]*model.Filter, error)
+	RebuildCategoryFilters(ctx context.Context, slug string) error
+	ListStaleFilterCategories(ctx context.Context, before time.Time, limit int) ([]string, error)
 
 	CategorySearch(ctx context.Context, query string, page int, size int) (*model.PaginatedCategoryData, error)
 	CategoryFiltersSearch(ctx context.Context, query string, page int, size int) (res *model.PaginatedFilterData, err error)
@@ -132,6 +136,7 @@ func (c *Controller) CreateCategory(ctx context.Context, category *model.Categor
 	}
 
 	go c.cache.InvalidateKeysByPattern(ctx, invalidateCategoryRelatedCachePattern)
+	c.audit(ctx, model.AuditCategory, model.AuditCreate, slug, nil, category)
 	return slug, nil
 }
 
@@ -141,9 +146,12 @@ func (c *Controller) UpdateCategory(ctx context.Context, slug string, category *
 	ctx = opentracing.ContextWithSpan(ctx, span)
 	defer span.Finish()
 
+	prev := snapshot(ctx, c.repo.GetCategoryBySlug, slug)
 	err := c.repo.UpdateCategory(ctx, slug, category)
 	if err != nil && errors.Is(err, repo.ErrNotFound) {
 		return ErrNotFound
+	} else if err != nil && errors.Is(err, repo.ErrVersionConflict) {
+		return ErrVersionConflict
 	} else if err != nil {
 		zap.L().Debug("failed to update category", zap.Error(err), zap.String("op", op))
 		return err
@@ -154,6 +162,34 @@ func (c *Controller) UpdateCategory(ctx context.Context, slug string, category *
 	}
 
 	go c.cache.InvalidateKeysByPattern(ctx, invalidateCategoryRelatedCachePattern)
+	c.audit(ctx, model.AuditCategory, model.AuditUpdate, slug, prev, snapshot(ctx, c.repo.GetCategoryBySlug, slug))
+	return nil
+}
+
+// PatchCategory updates only the fields of category named in fields.
+func (c *Controller) PatchCategory(ctx context.Context, slug string, category *model.Category, fields []string) error {
+	const op = "category.PatchCategory.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	prev := snapshot(ctx, c.repo.GetCategoryBySlug, slug)
+	err := c.repo.PatchCategory(ctx, slug, category, fields)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return ErrNotFound
+	} else if err != nil && errors.Is(err, repo.ErrVersionConflict) {
+		return ErrVersionConflict
+	} else if err != nil {
+		zap.L().Debug("failed to patch category", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	if err = c.cache.Delete(ctx, fmt.Sprintf(categoryCacheKey, slug)); err != nil {
+		zap.L().Debug("failed to delete from cache", zap.Error(err))
+	}
+
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateCategoryRelatedCachePattern)
+	c.audit(ctx, model.AuditCategory, model.AuditUpdate, slug, prev, snapshot(ctx, c.repo.GetCategoryBySlug, slug))
 	return nil
 }
 
@@ -163,6 +199,7 @@ func (c *Controller) DeleteCategory(ctx context.Context, slug string) error {
 	ctx = opentracing.ContextWithSpan(ctx, span)
 	defer span.Finish()
 
+	prev := snapshot(ctx, c.repo.GetCategoryBySlug, slug)
 	err := c.repo.DeleteCategory(ctx, slug)
 	if err != nil && errors.Is(err, repo.ErrNotFound) {
 		return ErrNotFound
@@ -176,6 +213,7 @@ func (c *Controller) DeleteCategory(ctx context.Context, slug string) error {
 	}
 
 	go c.cache.InvalidateKeysByPattern(ctx, invalidateCategoryRelatedCachePattern)
+	c.audit(ctx, model.AuditCategory, model.AuditDelete, slug, prev, nil)
 	return nil
 }
 
@@ -230,3 +268,56 @@ func (c *Controller) ListCategoryFilters(ctx context.Context, slug string) ([]*m
 	}
 	return res, nil
 }
+
+func (c *Controller) RebuildCategoryFilters(ctx context.Context, slug string) error {
+	const op = "category.RebuildCategoryFilters.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	err := c.repo.RebuildCategoryFilters(ctx, slug)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to rebuild category filters", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	if err = c.cache.Delete(ctx, fmt.Sprintf(categoryCacheKey, slug)); err != nil {
+		zap.L().Debug("failed to delete from cache", zap.Error(err))
+	}
+
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateCategoryRelatedCachePattern)
+	return nil
+}
+
+// RebuildStaleFilters is run periodically by the filter worker.
+// Item changes mark their categories stale in the database, a category is rebuilt once it has been quiet for consts.FilterRebuildDelay.
+func (c *Controller) RebuildStaleFilters(ctx context.Context) error {
+	const op = "category.RebuildStaleFilters.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	slugs, err := c.repo.ListStaleFilterCategories(ctx, time.Now().Add(-consts.FilterRebuildDelay), consts.FilterRebuildBatch)
+	if err != nil {
+		zap.L().Debug("failed to list stale filter categories", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	for _, slug := range slugs {
+		if err = c.repo.RebuildCategoryFilters(ctx, slug); err != nil {
+			zap.L().Debug("failed to rebuild category filters", zap.Error(err), zap.String("slug", slug))
+			continue
+		}
+
+		if err = c.cache.Delete(ctx, fmt.Sprintf(categoryCacheKey, slug)); err != nil {
+			zap.L().Debug("failed to delete from cache", zap.Error(err))
+		}
+	}
+
+	if len(slugs) > 0 {
+		go c.cache.InvalidateKeysByPattern(ctx, invalidateCategoryRelatedCachePattern)
+	}
+	return nil
+}
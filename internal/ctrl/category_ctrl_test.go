@@ -20,7 +20,7 @@ func TestController_CategorySearch(t *testing.T) {
 
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
 
 	tests := []struct {
 		name         string
@@ -120,7 +120,7 @@ func TestController_ListCategories(t *testing.T) {
 
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
 
 	tests := []struct {
 		name         string
@@ -214,7 +214,7 @@ func TestController_GetCategoryBySlug(t *testing.T) {
 
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
 
 	tests := []struct {
 		name         string
@@ -322,7 +322,8 @@ func TestController_CreateCategory(t *testing.T) {
 
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
+	expectAudit(rr)
 
 	tests := []struct {
 		name         string
@@ -388,7 +389,9 @@ func TestController_UpdateCategory(t *testing.T) {
 
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
+	expectAudit(rr)
+	rr.EXPECT().GetCategoryBySlug(gomock.Any(), gomock.Any()).Return(nil, repo.ErrNotFound).AnyTimes()
 
 	tests := []struct {
 		name         string
@@ -446,6 +449,24 @@ func TestController_UpdateCategory(t *testing.T) {
 				assert.Equal(t, ErrNotFound, err)
 			},
 		},
+		{
+			name: "RepoVersionConflict",
+			slug: "test-slug",
+			category: &model.Category{
+				Title:   "Updated Category",
+				Version: 2,
+			},
+			mockExpect: func() {
+				rr.EXPECT().UpdateCategory(
+					gomock.Any(),
+					gomock.Any(),
+					gomock.Any(),
+				).Return(repo.ErrVersionConflict).Times(1)
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, ErrVersionConflict)
+			},
+		},
 		{
 			name: "RepoInternalError",
 			slug: "test-slug",
@@ -503,13 +524,82 @@ func TestController_UpdateCategory(t *testing.T) {
 	}
 }
 
+func TestController_PatchCategory(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+	expectAudit(rr)
+	rr.EXPECT().GetCategoryBySlug(gomock.Any(), gomock.Any()).Return(nil, repo.ErrNotFound).AnyTimes()
+
+	fields := []string{"title"}
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, error)
+	}{
+		{
+			name: "RepoSuccess",
+			mockExpect: func() {
+				rr.EXPECT().PatchCategory(gomock.Any(), "test-slug", gomock.Any(), fields).Return(nil).Times(1)
+				cc.EXPECT().Delete(gomock.Any(), fmt.Sprintf(categoryCacheKey, "test-slug")).Return(nil).Times(1)
+				cc.EXPECT().InvalidateKeysByPattern(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "RepoNotFound",
+			mockExpect: func() {
+				rr.EXPECT().PatchCategory(gomock.Any(), "test-slug", gomock.Any(), fields).Return(repo.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, ErrNotFound)
+			},
+		},
+		{
+			name: "RepoVersionConflict",
+			mockExpect: func() {
+				rr.EXPECT().PatchCategory(gomock.Any(), "test-slug", gomock.Any(), fields).Return(repo.ErrVersionConflict).Times(1)
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, ErrVersionConflict)
+			},
+		},
+		{
+			name: "RepoInternalError",
+			mockExpect: func() {
+				rr.EXPECT().PatchCategory(gomock.Any(), "test-slug", gomock.Any(), fields).Return(errors.New("internal error")).Times(1)
+			},
+			expectedResp: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				err := ctrl.PatchCategory(context.Background(), "test-slug", &model.Category{Title: "Patched"}, fields)
+				tt.expectedResp(t, err)
+			},
+		)
+	}
+}
+
 func TestController_DeleteCategory(t *testing.T) {
 	mock := gomock.NewController(t)
 	defer mock.Finish()
 
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
+	expectAudit(rr)
+	rr.EXPECT().GetCategoryBySlug(gomock.Any(), gomock.Any()).Return(nil, repo.ErrNotFound).AnyTimes()
 
 	tests := []struct {
 		name         string
@@ -612,7 +702,7 @@ func TestController_CategoryFiltersSearch(t *testing.T) {
 
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
 
 	tests := []struct {
 		name         string
@@ -712,7 +802,7 @@ func TestController_ListCategoryFilters(t *testing.T) {
 
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
 
 	tests := []struct {
 		name         string
@@ -793,3 +883,70 @@ func TestController_ListCategoryFilters(t *testing.T) {
 		)
 	}
 }
+
+func TestController_RebuildStaleFilters(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, error)
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				rr.EXPECT().ListStaleFilterCategories(gomock.Any(), gomock.Any(), consts.FilterRebuildBatch).
+					Return([]string{"first", "second"}, nil).Times(1)
+				rr.EXPECT().RebuildCategoryFilters(gomock.Any(), "first").Return(nil).Times(1)
+				rr.EXPECT().RebuildCategoryFilters(gomock.Any(), "second").Return(nil).Times(1)
+				cc.EXPECT().Delete(gomock.Any(), fmt.Sprintf(categoryCacheKey, "first")).Return(nil).Times(1)
+				cc.EXPECT().Delete(gomock.Any(), fmt.Sprintf(categoryCacheKey, "second")).Return(nil).Times(1)
+				cc.EXPECT().InvalidateKeysByPattern(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "FailedCategoryDoesNotStopTheRest",
+			mockExpect: func() {
+				rr.EXPECT().ListStaleFilterCategories(gomock.Any(), gomock.Any(), consts.FilterRebuildBatch).
+					Return([]string{"first", "second"}, nil).Times(1)
+				rr.EXPECT().RebuildCategoryFilters(gomock.Any(), "first").Return(errors.New("exec error")).Times(1)
+				rr.EXPECT().RebuildCategoryFilters(gomock.Any(), "second").Return(nil).Times(1)
+				cc.EXPECT().Delete(gomock.Any(), fmt.Sprintf(categoryCacheKey, "second")).Return(nil).Times(1)
+				cc.EXPECT().InvalidateKeysByPattern(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "ListError",
+			mockExpect: func() {
+				rr.EXPECT().ListStaleFilterCategories(gomock.Any(), gomock.Any(), consts.FilterRebuildBatch).
+					Return(nil, errors.New("query error")).Times(1)
+			},
+			expectedResp: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+
+				err := ctrl.RebuildStaleFilters(context.Background())
+
+				tt.expectedResp(t, err)
+			},
+		)
+	}
+}
@@ -2,6 +2,7 @@ package ctrl
 
 import (
 	"context"
+	"github.com/JMURv/par-pro/products/pkg/model"
 	"time"
 )
 
@@ -10,7 +11,20 @@ type AppRepo interface {
 	categoryRepo
 	promotionRepo
 	favoriteRepo
+	favoriteCollectionRepo
 	orderRepo
+	priceRepo
+	priceListRepo
+	customerGroupRepo
+	mediaRepo
+	attributeRepo
+	labelRepo
+	reviewRepo
+	questionRepo
+	idempotencyRepo
+	trashRepo
+	auditRepo
+	itemRevisionRepo
 }
 
 type Discovery interface {
@@ -23,19 +37,40 @@ type CacheService interface {
 	GetCode(ctx context.Context, key string) (int, error)
 	GetToStruct(ctx context.Context, key string, dest any) error
 	Set(ctx context.Context, t time.Duration, key string, val any) error
+	// SetNX sets key only when it does not exist yet and reports whether it did.
+	SetNX(ctx context.Context, t time.Duration, key string, val any) (bool, error)
 	Delete(ctx context.Context, key string) error
 	Close()
 	InvalidateKeysByPattern(ctx context.Context, pattern string) error
 }
 
+// Notifier delivers favorite and question events to the subscribed users.
+type Notifier interface {
+	Notify(ctx context.Context, e *model.FavoriteEvent) error
+	NotifyQuestion(ctx context.Context, e *model.QuestionEvent) error
+}
+
+// Storage keeps uploaded media files under slash separated keys.
+type Storage interface {
+	// Put stores data under key and returns the public URL of the file.
+	Put(ctx context.Context, key, contentType string, data []byte) (string, error)
+	// Delete removes the file, a missing file is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
 type Controller struct {
-	repo  AppRepo
-	cache CacheService
+	repo     AppRepo
+	cache    CacheService
+	notifier Notifier
+	storage  Storage
 }
 
-func New(repo AppRepo, cache CacheService) *Controller {
+// New creates a controller, a nil notifier disables notifications and a nil storage disables uploads.
+func New(repo AppRepo, cache CacheService, notifier Notifier, storage Storage) *Controller {
 	return &Controller{
-		repo:  repo,
-		cache: cache,
+		repo:     repo,
+		cache:    cache,
+		notifier: notifier,
+		storage:  storage,
 	}
 }
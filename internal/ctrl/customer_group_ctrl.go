@@ -0,0 +1,302 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/JMURv/par-pro/products/pkg/utils/slugify"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+const customerGroupCacheKey = "customer-group:%v"
+const customerGroupsCacheKey = "customer-groups"
+const invalidateCustomerGroupCachePattern = "customer-group*"
+
+type customerGroupRepo interface {
+	ListCustomerGroups(ctx context.Context) ([]*model.CustomerGroup, error)
+	GetCustomerGroup(ctx context.Context, slug string) (*model.CustomerGroup, error)
+	CreateCustomerGroup(ctx context.Context, g *model.CustomerGroup) (string, error)
+	UpdateCustomerGroup(ctx context.Context, slug string, g *model.CustomerGroup) error
+	DeleteCustomerGroup(ctx context.Context, slug string) error
+
+	ListQuantityBreaks(ctx context.Context, itemID uuid.UUID) ([]model.QuantityBreak, error)
+	SetQuantityBreaks(ctx context.Context, itemID uuid.UUID, breaks []model.QuantityBreak) error
+	GetApplicableQuantityBreaks(ctx context.Context, groupID uint64, items []uuid.UUID) (map[uuid.UUID][]model.QuantityBreak, error)
+}
+
+type customerGroupCtxKey struct{}
+
+// WithCustomerGroup sets the customer group the caller belongs to.
+func WithCustomerGroup(ctx context.Context, g *model.CustomerGroup) context.Context {
+	return context.WithValue(ctx, customerGroupCtxKey{}, g)
+}
+
+// CustomerGroupFromContext returns the customer group of the caller, nil for anonymous and retail customers.
+func CustomerGroupFromContext(ctx context.Context) *model.CustomerGroup {
+	g, _ := ctx.Value(customerGroupCtxKey{}).(*model.CustomerGroup)
+	return g
+}
+
+func (c *Controller) ListCustomerGroups(ctx context.Context) ([]*model.CustomerGroup, error) {
+	const op = "customerGroups.ListCustomerGroups.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	cached := make([]*model.CustomerGroup, 0, 5)
+	if err := c.cache.GetToStruct(ctx, customerGroupsCacheKey, &cached); err == nil {
+		return cached, nil
+	}
+
+	res, err := c.repo.ListCustomerGroups(ctx)
+	if err != nil {
+		zap.L().Debug("failed to list customer groups", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	if bytes, err := json.Marshal(res); err == nil {
+		if err = c.cache.Set(ctx, consts.DefaultCacheTime, customerGroupsCacheKey, bytes); err != nil {
+			zap.L().Debug("failed to set to cache", zap.Error(err), zap.String("op", op))
+		}
+	}
+
+	return res, nil
+}
+
+func (c *Controller) GetCustomerGroup(ctx context.Context, slug string) (*model.CustomerGroup, error) {
+	const op = "customerGroups.GetCustomerGroup.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	cached := &model.CustomerGroup{}
+	cacheKey := fmt.Sprintf(customerGroupCacheKey, slug)
+	if err := c.cache.GetToStruct(ctx, cacheKey, cached); err == nil {
+		return cached, nil
+	}
+
+	res, err := c.repo.GetCustomerGroup(ctx, slug)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find customer group", zap.Error(err), zap.String("op", op))
+		return nil, ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to get customer group", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	if bytes, err := json.Marshal(res); err == nil {
+		if err = c.cache.Set(ctx, consts.DefaultCacheTime, cacheKey, bytes); err != nil {
+			zap.L().Debug("failed to set to cache", zap.Error(err), zap.String("op", op))
+		}
+	}
+
+	return res, nil
+}
+
+func (c *Controller) CreateCustomerGroup(ctx context.Context, g *model.CustomerGroup) (string, error) {
+	const op = "customerGroups.CreateCustomerGroup.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	if g.Slug == "" {
+		g.Slug = slugify.Slugify(g.Name)
+	}
+
+	slug, err := c.repo.CreateCustomerGroup(ctx, g)
+	if err != nil && errors.Is(err, repo.ErrAlreadyExists) {
+		zap.L().Debug("customer group already exists", zap.Error(err), zap.String("op", op))
+		return "", ErrAlreadyExists
+	} else if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find price list", zap.Error(err), zap.String("op", op))
+		return "", ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to create customer group", zap.Error(err), zap.String("op", op))
+		return "", err
+	}
+
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateCustomerGroupCachePattern)
+	c.audit(ctx, model.AuditCustomerGroup, model.AuditCreate, slug, nil, g)
+	return slug, nil
+}
+
+func (c *Controller) UpdateCustomerGroup(ctx context.Context, slug string, g *model.CustomerGroup) error {
+	const op = "customerGroups.UpdateCustomerGroup.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	prev := snapshot(ctx, c.repo.GetCustomerGroup, slug)
+	err := c.repo.UpdateCustomerGroup(ctx, slug, g)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find customer group or price list", zap.Error(err), zap.String("op", op))
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to update customer group", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	if err = c.cache.Delete(ctx, fmt.Sprintf(customerGroupCacheKey, slug)); err != nil {
+		zap.L().Debug("failed to delete from cache", zap.Error(err), zap.String("op", op))
+	}
+
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateCustomerGroupCachePattern)
+	c.audit(ctx, model.AuditCustomerGroup, model.AuditUpdate, slug, prev, snapshot(ctx, c.repo.GetCustomerGroup, slug))
+	return nil
+}
+
+func (c *Controller) DeleteCustomerGroup(ctx context.Context, slug string) error {
+	const op = "customerGroups.DeleteCustomerGroup.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	prev := snapshot(ctx, c.repo.GetCustomerGroup, slug)
+	err := c.repo.DeleteCustomerGroup(ctx, slug)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find customer group", zap.Error(err), zap.String("op", op))
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to delete customer group", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	if err = c.cache.Delete(ctx, fmt.Sprintf(customerGroupCacheKey, slug)); err != nil {
+		zap.L().Debug("failed to delete from cache", zap.Error(err), zap.String("op", op))
+	}
+
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateCustomerGroupCachePattern)
+	c.audit(ctx, model.AuditCustomerGroup, model.AuditDelete, slug, prev, nil)
+	return nil
+}
+
+// ResolveCustomerGroup picks the first known group among the slugs the SSO user is assigned to.
+// It returns nil when the user is in none of them.
+func (c *Controller) ResolveCustomerGroup(ctx context.Context, slugs []string) (*model.CustomerGroup, error) {
+	const op = "customerGroups.ResolveCustomerGroup.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	if len(slugs) == 0 {
+		return nil, nil
+	}
+
+	groups, err := c.ListCustomerGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, slug := range slugs {
+		for _, g := range groups {
+			if g.Slug == slug {
+				return g, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// SelectPriceList returns the price list a request is priced in.
+// Without an explicit slug the caller's group list is used. Lists owned by groups are reserved for their members,
+// for everybody else they do not exist.
+func (c *Controller) SelectPriceList(ctx context.Context, slug string) (*model.PriceList, error) {
+	const op = "customerGroups.SelectPriceList.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	group := CustomerGroupFromContext(ctx)
+	if slug == "" {
+		if group == nil || group.PriceListSlug == "" {
+			return nil, nil
+		}
+		slug = group.PriceListSlug
+	} else {
+		groups, err := c.ListCustomerGroups(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		reserved, member := false, false
+		for _, g := range groups {
+			if g.PriceListSlug == slug {
+				reserved = true
+				member = member || (group != nil && group.ID == g.ID)
+			}
+		}
+
+		if reserved && !member {
+			zap.L().Debug("price list is reserved for a customer group", zap.String("slug", slug), zap.String("op", op))
+			return nil, ErrNotFound
+		}
+	}
+
+	return c.GetPriceList(ctx, slug)
+}
+
+func (c *Controller) ListQuantityBreaks(ctx context.Context, itemID uuid.UUID) ([]model.QuantityBreak, error) {
+	const op = "customerGroups.ListQuantityBreaks.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	res, err := c.repo.ListQuantityBreaks(ctx, itemID)
+	if err != nil {
+		zap.L().Debug("failed to list quantity breaks", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *Controller) SetQuantityBreaks(ctx context.Context, itemID uuid.UUID, breaks []model.QuantityBreak) error {
+	const op = "customerGroups.SetQuantityBreaks.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	err := c.repo.SetQuantityBreaks(ctx, itemID, breaks)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find item or customer group", zap.Error(err), zap.String("op", op))
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to set quantity breaks", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	c.audit(ctx, model.AuditQuantityBreaks, model.AuditUpdate, itemID, nil, breaks)
+	return nil
+}
+
+// applyQuantityBreaks attaches the breaks the caller is entitled to, priced from the already repriced unit price.
+func (c *Controller) applyQuantityBreaks(ctx context.Context, items []*model.Item) error {
+	ids := make([]uuid.UUID, 0, len(items))
+	for _, i := range items {
+		ids = append(ids, i.ID)
+	}
+
+	var groupID uint64
+	if g := CustomerGroupFromContext(ctx); g != nil {
+		groupID = g.ID
+	}
+
+	breaks, err := c.repo.GetApplicableQuantityBreaks(ctx, groupID, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range items {
+		i.QuantityBreaks = breaks[i.ID]
+		for idx := range i.QuantityBreaks {
+			i.QuantityBreaks[idx].Price = i.Price.Discount(i.QuantityBreaks[idx].Discount)
+		}
+	}
+	return nil
+}
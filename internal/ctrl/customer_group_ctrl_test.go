@@ -0,0 +1,248 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+func TestController_ResolveCustomerGroup(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+
+	ctx := context.Background()
+	groups := []*model.CustomerGroup{{ID: 1, Slug: "wholesale"}, {ID: 2, Slug: "dealers"}}
+
+	tests := []struct {
+		name         string
+		slugs        []string
+		mockExpect   func()
+		expectedResp func(*testing.T, *model.CustomerGroup, error)
+	}{
+		{
+			name:       "No groups",
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *model.CustomerGroup, err error) {
+				require.NoError(t, err)
+				assert.Nil(t, res)
+			},
+		},
+		{
+			name:  "Unknown group",
+			slugs: []string{"vip"},
+			mockExpect: func() {
+				cc.EXPECT().GetToStruct(gomock.Any(), customerGroupsCacheKey, gomock.Any()).Return(errors.New("cache miss")).Times(1)
+				rr.EXPECT().ListCustomerGroups(gomock.Any()).Return(groups, nil).Times(1)
+				cc.EXPECT().Set(gomock.Any(), gomock.Any(), customerGroupsCacheKey, gomock.Any()).Return(nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *model.CustomerGroup, err error) {
+				require.NoError(t, err)
+				assert.Nil(t, res)
+			},
+		},
+		{
+			name:  "Repo error",
+			slugs: []string{"dealers"},
+			mockExpect: func() {
+				cc.EXPECT().GetToStruct(gomock.Any(), customerGroupsCacheKey, gomock.Any()).Return(errors.New("cache miss")).Times(1)
+				rr.EXPECT().ListCustomerGroups(gomock.Any()).Return(nil, errors.New("query error")).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *model.CustomerGroup, err error) {
+				assert.Nil(t, res)
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:  "Success",
+			slugs: []string{"vip", "dealers"},
+			mockExpect: func() {
+				cc.EXPECT().GetToStruct(gomock.Any(), customerGroupsCacheKey, gomock.Any()).Return(errors.New("cache miss")).Times(1)
+				rr.EXPECT().ListCustomerGroups(gomock.Any()).Return(groups, nil).Times(1)
+				cc.EXPECT().Set(gomock.Any(), gomock.Any(), customerGroupsCacheKey, gomock.Any()).Return(nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *model.CustomerGroup, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, groups[1], res)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := ctrl.ResolveCustomerGroup(ctx, tt.slugs)
+				tt.expectedResp(t, res, err)
+			},
+		)
+	}
+}
+
+func TestController_SelectPriceList(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+
+	wholesale := &model.CustomerGroup{ID: 1, Slug: "wholesale", PriceListID: 2, PriceListSlug: "b2b"}
+	pl := &model.PriceList{ID: 2, Slug: "b2b", Currency: "RUB", BaseCurrency: "RUB", Rate: 1}
+	groups := []*model.CustomerGroup{wholesale}
+
+	cachedGroups := func() {
+		cc.EXPECT().GetToStruct(gomock.Any(), customerGroupsCacheKey, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, dest any) error {
+				bytes, err := json.Marshal(groups)
+				require.NoError(t, err)
+				return json.Unmarshal(bytes, dest)
+			},
+		).Times(1)
+	}
+	priceList := func() {
+		cc.EXPECT().GetToStruct(gomock.Any(), fmt.Sprintf(priceListCacheKey, "b2b"), gomock.Any()).Return(errors.New("cache miss")).Times(1)
+		rr.EXPECT().GetPriceList(gomock.Any(), "b2b").Return(pl, nil).Times(1)
+		cc.EXPECT().Set(gomock.Any(), gomock.Any(), fmt.Sprintf(priceListCacheKey, "b2b"), gomock.Any()).Return(nil).Times(1)
+	}
+
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		slug         string
+		mockExpect   func()
+		expectedResp func(*testing.T, *model.PriceList, error)
+	}{
+		{
+			name:       "Retail without header",
+			ctx:        context.Background(),
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *model.PriceList, err error) {
+				require.NoError(t, err)
+				assert.Nil(t, res)
+			},
+		},
+		{
+			name:       "Group default",
+			ctx:        WithCustomerGroup(context.Background(), wholesale),
+			mockExpect: priceList,
+			expectedResp: func(t *testing.T, res *model.PriceList, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, pl, res)
+			},
+		},
+		{
+			name:       "Reserved for group",
+			ctx:        context.Background(),
+			slug:       "b2b",
+			mockExpect: cachedGroups,
+			expectedResp: func(t *testing.T, res *model.PriceList, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, ErrNotFound, err)
+			},
+		},
+		{
+			name: "Member",
+			ctx:  WithCustomerGroup(context.Background(), wholesale),
+			slug: "b2b",
+			mockExpect: func() {
+				cachedGroups()
+				priceList()
+			},
+			expectedResp: func(t *testing.T, res *model.PriceList, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, pl, res)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := ctrl.SelectPriceList(tt.ctx, tt.slug)
+				tt.expectedResp(t, res, err)
+			},
+		)
+	}
+}
+
+func TestController_CreateCustomerGroup(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+	expectAudit(rr)
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		req          *model.CustomerGroup
+		mockExpect   func()
+		expectedResp func(*testing.T, string, error)
+	}{
+		{
+			name: "Success",
+			req:  &model.CustomerGroup{Name: "Wholesale"},
+			mockExpect: func() {
+				rr.EXPECT().CreateCustomerGroup(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, g *model.CustomerGroup) (string, error) {
+						assert.Equal(t, "wholesale", g.Slug)
+						return g.Slug, nil
+					},
+				).Times(1)
+				cc.EXPECT().InvalidateKeysByPattern(gomock.Any(), invalidateCustomerGroupCachePattern).AnyTimes()
+			},
+			expectedResp: func(t *testing.T, res string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "wholesale", res)
+			},
+		},
+		{
+			name: "Price list not found",
+			req:  &model.CustomerGroup{Slug: "wholesale", Name: "Wholesale", PriceListSlug: "b2b"},
+			mockExpect: func() {
+				rr.EXPECT().CreateCustomerGroup(gomock.Any(), gomock.Any()).Return("", repo.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, res string, err error) {
+				assert.Empty(t, res)
+				assert.Equal(t, ErrNotFound, err)
+			},
+		},
+		{
+			name: "Already exists",
+			req:  &model.CustomerGroup{Slug: "wholesale", Name: "Wholesale"},
+			mockExpect: func() {
+				rr.EXPECT().CreateCustomerGroup(gomock.Any(), gomock.Any()).Return("", repo.ErrAlreadyExists).Times(1)
+			},
+			expectedResp: func(t *testing.T, res string, err error) {
+				assert.Empty(t, res)
+				assert.Equal(t, ErrAlreadyExists, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := ctrl.CreateCustomerGroup(ctx, tt.req)
+				tt.expectedResp(t, res, err)
+			},
+		)
+	}
+}
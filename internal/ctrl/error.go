@@ -1,10 +1,22 @@
 package ctrl
 
-import "errors"
+import "github.com/JMURv/par-pro/products/internal/apperr"
 
-var ErrNotFound = errors.New("not found")
-var ErrAlreadyExists = errors.New("already exists")
-var ErrInternalError = errors.New("internal error")
-var ErrParseUUID = errors.New("failed to parse uuid")
-var ErrDecodeRequest = errors.New("failed to decode request")
-var ErrUnauthenticated = errors.New("unauthenticated")
+var ErrNotFound = apperr.New(apperr.NotFound, "not found")
+var ErrAlreadyExists = apperr.New(apperr.AlreadyExists, "already exists")
+var ErrInternalError = apperr.New(apperr.Internal, "internal error")
+var ErrParseUUID = apperr.New(apperr.Validation, "failed to parse uuid")
+var ErrDecodeRequest = apperr.New(apperr.Validation, "failed to decode request")
+var ErrUnauthenticated = apperr.New(apperr.Unauthenticated, "unauthenticated")
+var ErrPriceUnavailable = apperr.New(apperr.Validation, "item has no price in the selected price list")
+var ErrUnknownPriceList = apperr.New(apperr.Validation, "unknown price list")
+var ErrInvalidMedia = apperr.New(apperr.Validation, "file is not a supported image")
+var ErrImageTooLarge = apperr.New(apperr.Validation, "image must not have more than 40 megapixels")
+var ErrStorageDisabled = apperr.New(apperr.Unavailable, "media storage is not configured")
+var ErrNotPurchased = apperr.New(apperr.Forbidden, "only customers who received the item can review it")
+var ErrTooManyReviewMedia = apperr.New(apperr.Validation, "review must not have more than 5 files")
+var ErrForbidden = apperr.New(apperr.Forbidden, "permission denied")
+var ErrIdempotencyMismatch = apperr.New(apperr.Validation, "idempotency key was already used with a different request")
+var ErrIdempotencyInProgress = apperr.New(apperr.Conflict, "request with this idempotency key is still in progress")
+var ErrVersionConflict = apperr.New(apperr.PreconditionFailed, "resource was modified by another request")
+var ErrStillOrdered = apperr.New(apperr.Conflict, "item is still part of an order")
@@ -0,0 +1,257 @@
+package ctrl
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+const favoriteCollectionsCacheKey = "favorite-collections:%v"
+
+const shareTokenSize = 16
+
+type favoriteCollectionRepo interface {
+	ListFavoriteCollections(ctx context.Context, uid uuid.UUID) ([]*model.FavoriteCollection, error)
+	GetFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64) (*model.FavoriteCollection, error)
+	GetSharedFavoriteCollection(ctx context.Context, token string) (*model.FavoriteCollection, error)
+	CreateFavoriteCollection(ctx context.Context, uid uuid.UUID, req *model.FavoriteCollection) (uint64, error)
+	UpdateFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64, req *model.FavoriteCollection) error
+	DeleteFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64) error
+	ShareFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64, token string) (string, error)
+	UnshareFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64) error
+	SetFavoriteCollectionItem(ctx context.Context, uid uuid.UUID, id uint64, req *model.FavoriteCollectionItem) error
+	RemoveFavoriteCollectionItem(ctx context.Context, uid uuid.UUID, id uint64, itemID uuid.UUID) error
+}
+
+func (c *Controller) ListFavoriteCollections(ctx context.Context, uid uuid.UUID) ([]*model.FavoriteCollection, error) {
+	const op = "favorites.ListFavoriteCollections.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	cached := make([]*model.FavoriteCollection, 0, 5)
+	cacheKey := fmt.Sprintf(favoriteCollectionsCacheKey, uid)
+	if err := c.cache.GetToStruct(ctx, cacheKey, &cached); err == nil {
+		return cached, nil
+	}
+
+	res, err := c.repo.ListFavoriteCollections(ctx, uid)
+	if err != nil {
+		zap.L().Debug("failed to list favorite collections", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	if bytes, err := json.Marshal(res); err == nil {
+		if err = c.cache.Set(ctx, consts.DefaultCacheTime, cacheKey, bytes); err != nil {
+			zap.L().Debug("failed to set to cache", zap.Error(err), zap.String("op", op))
+		}
+	}
+
+	return res, nil
+}
+
+func (c *Controller) GetFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64) (*model.FavoriteCollection, error) {
+	const op = "favorites.GetFavoriteCollection.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	res, err := c.repo.GetFavoriteCollection(ctx, uid, id)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find favorite collection", zap.Error(err), zap.String("op", op))
+		return nil, ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to get favorite collection", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetSharedFavoriteCollection returns a collection by its public share token, anyone with the token may read it.
+func (c *Controller) GetSharedFavoriteCollection(ctx context.Context, token string) (*model.FavoriteCollection, error) {
+	const op = "favorites.GetSharedFavoriteCollection.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	res, err := c.repo.GetSharedFavoriteCollection(ctx, token)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find shared collection", zap.Error(err), zap.String("op", op))
+		return nil, ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to get shared collection", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	// Viewers of a shared collection do not get to know its owner
+	res.UserID = uuid.Nil
+	return res, nil
+}
+
+func (c *Controller) CreateFavoriteCollection(ctx context.Context, uid uuid.UUID, req *model.FavoriteCollection) (uint64, error) {
+	const op = "favorites.CreateFavoriteCollection.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	id, err := c.repo.CreateFavoriteCollection(ctx, uid, req)
+	if err != nil && errors.Is(err, repo.ErrAlreadyExists) {
+		zap.L().Debug("favorite collection already exists", zap.Error(err), zap.String("op", op))
+		return 0, ErrAlreadyExists
+	} else if err != nil {
+		zap.L().Debug("failed to create favorite collection", zap.Error(err), zap.String("op", op))
+		return 0, err
+	}
+
+	c.invalidateFavoriteCollections(ctx, uid)
+	c.audit(ctx, model.AuditFavoriteCollection, model.AuditCreate, id, nil, req)
+	return id, nil
+}
+
+func (c *Controller) UpdateFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64, req *model.FavoriteCollection) error {
+	const op = "favorites.UpdateFavoriteCollection.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	err := c.repo.UpdateFavoriteCollection(ctx, uid, id, req)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find favorite collection", zap.Error(err), zap.String("op", op))
+		return ErrNotFound
+	} else if err != nil && errors.Is(err, repo.ErrAlreadyExists) {
+		zap.L().Debug("favorite collection already exists", zap.Error(err), zap.String("op", op))
+		return ErrAlreadyExists
+	} else if err != nil {
+		zap.L().Debug("failed to update favorite collection", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	c.invalidateFavoriteCollections(ctx, uid)
+	c.audit(ctx, model.AuditFavoriteCollection, model.AuditUpdate, id, nil, req)
+	return nil
+}
+
+func (c *Controller) DeleteFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64) error {
+	const op = "favorites.DeleteFavoriteCollection.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	err := c.repo.DeleteFavoriteCollection(ctx, uid, id)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find favorite collection", zap.Error(err), zap.String("op", op))
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to delete favorite collection", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	c.invalidateFavoriteCollections(ctx, uid)
+	c.audit(ctx, model.AuditFavoriteCollection, model.AuditDelete, id, nil, nil)
+	return nil
+}
+
+// ShareFavoriteCollection makes the collection public and returns its share token.
+// Sharing an already shared collection returns the existing token.
+func (c *Controller) ShareFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64) (string, error) {
+	const op = "favorites.ShareFavoriteCollection.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	b := make([]byte, shareTokenSize)
+	if _, err := rand.Read(b); err != nil {
+		zap.L().Debug("failed to generate share token", zap.Error(err), zap.String("op", op))
+		return "", err
+	}
+
+	token, err := c.repo.ShareFavoriteCollection(ctx, uid, id, hex.EncodeToString(b))
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find favorite collection", zap.Error(err), zap.String("op", op))
+		return "", ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to share favorite collection", zap.Error(err), zap.String("op", op))
+		return "", err
+	}
+
+	c.invalidateFavoriteCollections(ctx, uid)
+	c.audit(ctx, model.AuditFavoriteCollection, model.AuditUpdate, id, nil, map[string]bool{"shared": true})
+	return token, nil
+}
+
+func (c *Controller) UnshareFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64) error {
+	const op = "favorites.UnshareFavoriteCollection.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	err := c.repo.UnshareFavoriteCollection(ctx, uid, id)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find favorite collection", zap.Error(err), zap.String("op", op))
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to unshare favorite collection", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	c.invalidateFavoriteCollections(ctx, uid)
+	c.audit(ctx, model.AuditFavoriteCollection, model.AuditUpdate, id, nil, map[string]bool{"shared": false})
+	return nil
+}
+
+// SetFavoriteCollectionItem adds the item to the collection or updates its note.
+func (c *Controller) SetFavoriteCollectionItem(ctx context.Context, uid uuid.UUID, id uint64, req *model.FavoriteCollectionItem) error {
+	const op = "favorites.SetFavoriteCollectionItem.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	err := c.repo.SetFavoriteCollectionItem(ctx, uid, id, req)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find favorite collection or item", zap.Error(err), zap.String("op", op))
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to set favorite collection item", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	c.invalidateFavoriteCollections(ctx, uid)
+	c.audit(ctx, model.AuditFavoriteCollection, model.AuditUpdate, id, nil, req)
+	return nil
+}
+
+func (c *Controller) RemoveFavoriteCollectionItem(ctx context.Context, uid uuid.UUID, id uint64, itemID uuid.UUID) error {
+	const op = "favorites.RemoveFavoriteCollectionItem.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	err := c.repo.RemoveFavoriteCollectionItem(ctx, uid, id, itemID)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find favorite collection item", zap.Error(err), zap.String("op", op))
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to remove favorite collection item", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	c.invalidateFavoriteCollections(ctx, uid)
+	c.audit(ctx, model.AuditFavoriteCollection, model.AuditUpdate, id, map[string]any{"item_id": itemID}, nil)
+	return nil
+}
+
+func (c *Controller) invalidateFavoriteCollections(ctx context.Context, uid uuid.UUID) {
+	if err := c.cache.Delete(ctx, fmt.Sprintf(favoriteCollectionsCacheKey, uid)); err != nil {
+		zap.L().Debug("failed to delete from cache", zap.Error(err))
+	}
+}
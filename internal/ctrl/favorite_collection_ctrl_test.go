@@ -0,0 +1,118 @@
+package ctrl
+
+import (
+	"context"
+	"fmt"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+func TestController_GetSharedFavoriteCollection(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, *model.FavoriteCollection, error)
+	}{
+		{
+			name: "HidesOwner",
+			mockExpect: func() {
+				rr.EXPECT().GetSharedFavoriteCollection(gomock.Any(), "token").
+					Return(&model.FavoriteCollection{ID: 1, UserID: uuid.New(), Name: "Birthday"}, nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *model.FavoriteCollection, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, uuid.Nil, res.UserID)
+				assert.Equal(t, "Birthday", res.Name)
+			},
+		},
+		{
+			name: "NotFound",
+			mockExpect: func() {
+				rr.EXPECT().GetSharedFavoriteCollection(gomock.Any(), "token").
+					Return(nil, repo.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *model.FavoriteCollection, err error) {
+				assert.Nil(t, res)
+				assert.ErrorIs(t, err, ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := ctrl.GetSharedFavoriteCollection(context.Background(), "token")
+				tt.expectedResp(t, res, err)
+			},
+		)
+	}
+}
+
+func TestController_ShareFavoriteCollection(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	uid := uuid.New()
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+	expectAudit(rr)
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, string, error)
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				rr.EXPECT().ShareFavoriteCollection(gomock.Any(), uid, uint64(1), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ uuid.UUID, _ uint64, token string) (string, error) {
+						assert.Len(t, token, shareTokenSize*2)
+						return token, nil
+					},
+				).Times(1)
+				cc.EXPECT().Delete(gomock.Any(), fmt.Sprintf(favoriteCollectionsCacheKey, uid)).Return(nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res string, err error) {
+				require.NoError(t, err)
+				assert.NotEmpty(t, res)
+			},
+		},
+		{
+			name: "NotFound",
+			mockExpect: func() {
+				rr.EXPECT().ShareFavoriteCollection(gomock.Any(), uid, uint64(1), gomock.Any()).
+					Return("", repo.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, res string, err error) {
+				assert.Empty(t, res)
+				assert.ErrorIs(t, err, ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := ctrl.ShareFavoriteCollection(context.Background(), uid, 1)
+				tt.expectedResp(t, res, err)
+			},
+		)
+	}
+}
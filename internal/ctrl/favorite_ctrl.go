@@ -11,34 +11,42 @@ import (
 	"github.com/google/uuid"
 	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
+	"time"
 )
 
-const favoriteCacheKey = "favorite:%v"
+const favoriteCacheKey = "favorite:%v:%v:%v:%v"
+const favoriteUserCachePattern = "favorite:%v:*"
+const invalidateFavoriteCachePattern = "favorite:*"
 
 type favoriteRepo interface {
-	GetFavorites(ctx context.Context, uid uuid.UUID) ([]*model.Favorite, error)
+	ListFavorites(ctx context.Context, uid uuid.UUID, page, size int, sort string) (*model.PaginatedFavoritesData, error)
 	AddToFavorites(ctx context.Context, uid uuid.UUID, itemID uuid.UUID) (*model.Favorite, error)
 	RemoveFromFavorites(ctx context.Context, uid uuid.UUID, itemID uuid.UUID) error
+	SetFavoriteNotifications(ctx context.Context, uid uuid.UUID, req *model.Favorite) error
+
+	ListFavoriteSubscribers(ctx context.Context, itemID uuid.UUID, t model.FavoriteEventType) ([]uuid.UUID, error)
+	GetItemStates(ctx context.Context, items []uuid.UUID) (map[uuid.UUID]model.ItemState, error)
 }
 
-func (c *Controller) ListFavorites(ctx context.Context, uid uuid.UUID) ([]*model.Favorite, error) {
+func (c *Controller) ListFavorites(ctx context.Context, uid uuid.UUID, page, size int, sort string) (*model.PaginatedFavoritesData, error) {
 	const op = "favorites.ListFavorites.ctrl"
 	span, _ := opentracing.StartSpanFromContext(ctx, op)
 	ctx = opentracing.ContextWithSpan(ctx, span)
 	defer span.Finish()
 
-	cached := make([]*model.Favorite, 0, 15)
-	cacheKey := fmt.Sprintf(favoriteCacheKey, uid)
-	if err := c.cache.GetToStruct(ctx, cacheKey, &cached); err == nil {
+	cached := &model.PaginatedFavoritesData{}
+	cacheKey := fmt.Sprintf(favoriteCacheKey, uid, page, size, sort)
+	if err := c.cache.GetToStruct(ctx, cacheKey, cached); err == nil {
+		if err = c.priceFavorites(ctx, cached.Data); err != nil {
+			zap.L().Debug("failed to apply pricing", zap.Error(err), zap.String("op", op))
+			return nil, err
+		}
 		return cached, nil
 	}
 
-	res, err := c.repo.GetFavorites(ctx, uid)
-	if err != nil && errors.Is(err, repo.ErrNotFound) {
-		zap.L().Debug("failed to find favorites", zap.Error(err), zap.String("op", op))
-		return nil, ErrNotFound
-	} else if err != nil {
-		zap.L().Debug("failed to get favorites", zap.Error(err), zap.String("op", op))
+	res, err := c.repo.ListFavorites(ctx, uid, page, size, sort)
+	if err != nil {
+		zap.L().Debug("failed to list favorites", zap.Error(err), zap.String("op", op))
 		return nil, err
 	}
 
@@ -48,9 +56,31 @@ func (c *Controller) ListFavorites(ctx context.Context, uid uuid.UUID) ([]*model
 		}
 	}
 
+	if err = c.priceFavorites(ctx, res.Data); err != nil {
+		zap.L().Debug("failed to apply pricing", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
 	return res, nil
 }
 
+// priceFavorites reprices the items for the caller like the item endpoints do,
+// the effective price is the promotion discount taken off the caller's price.
+func (c *Controller) priceFavorites(ctx context.Context, favs []*model.Favorite) error {
+	items := make([]*model.Item, 0, len(favs))
+	for _, f := range favs {
+		items = append(items, &f.Item)
+	}
+
+	if err := c.applyPricing(ctx, items...); err != nil {
+		return err
+	}
+
+	for _, f := range favs {
+		f.EffectivePrice = f.Item.Price.Discount(f.Discount)
+	}
+	return nil
+}
+
 func (c *Controller) AddToFavorites(ctx context.Context, uid uuid.UUID, itemID uuid.UUID) (*model.Favorite, error) {
 	const op = "favorites.AddToFavorites.ctrl"
 	span, _ := opentracing.StartSpanFromContext(ctx, op)
@@ -69,10 +99,9 @@ func (c *Controller) AddToFavorites(ctx context.Context, uid uuid.UUID, itemID u
 		return nil, err
 	}
 
-	if err := c.cache.Delete(ctx, fmt.Sprintf(favoriteCacheKey, uid)); err != nil {
-		zap.L().Debug("failed to delete from cache", zap.Error(err), zap.String("op", op))
-	}
+	go c.cache.InvalidateKeysByPattern(ctx, fmt.Sprintf(favoriteUserCachePattern, uid))
 
+	c.audit(ctx, model.AuditFavorite, model.AuditCreate, itemID, nil, res)
 	return res, nil
 }
 
@@ -91,9 +120,104 @@ func (c *Controller) RemoveFromFavorites(ctx context.Context, uid uuid.UUID, ite
 		return err
 	}
 
-	if err = c.cache.Delete(ctx, fmt.Sprintf(favoriteCacheKey, uid)); err != nil {
-		zap.L().Debug("failed to delete from cache", zap.Error(err), zap.String("op", op))
+	go c.cache.InvalidateKeysByPattern(ctx, fmt.Sprintf(favoriteUserCachePattern, uid))
+
+	c.audit(ctx, model.AuditFavorite, model.AuditDelete, itemID, nil, nil)
+	return nil
+}
+
+func (c *Controller) SetFavoriteNotifications(ctx context.Context, uid uuid.UUID, req *model.Favorite) error {
+	const op = "favorites.SetFavoriteNotifications.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	err := c.repo.SetFavoriteNotifications(ctx, uid, req)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find favorite", zap.Error(err), zap.String("op", op))
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to set favorite notifications", zap.Error(err), zap.String("op", op))
+		return err
 	}
 
+	go c.cache.InvalidateKeysByPattern(ctx, fmt.Sprintf(favoriteUserCachePattern, uid))
+
+	c.audit(ctx, model.AuditFavorite, model.AuditUpdate, req.ItemID, nil, req)
 	return nil
 }
+
+// itemStates snapshots the watched state of the items before a change, so notifyFavorites can tell what changed.
+// It returns nil when notifications are disabled.
+func (c *Controller) itemStates(ctx context.Context, items []uuid.UUID) map[uuid.UUID]model.ItemState {
+	if c.notifier == nil || len(items) == 0 {
+		return nil
+	}
+
+	res, err := c.repo.GetItemStates(ctx, items)
+	if err != nil {
+		zap.L().Debug("failed to get item states", zap.Error(err))
+		return nil
+	}
+	return res
+}
+
+// notifyFavorites compares the items with the snapshot taken before the change
+// and emits price drop and back in stock events to the users that opted in.
+// Failures are logged, the change itself has already been made.
+func (c *Controller) notifyFavorites(ctx context.Context, before map[uuid.UUID]model.ItemState) {
+	if len(before) == 0 {
+		return
+	}
+
+	ids := make([]uuid.UUID, 0, len(before))
+	for id := range before {
+		ids = append(ids, id)
+	}
+
+	after, err := c.repo.GetItemStates(ctx, ids)
+	if err != nil {
+		zap.L().Debug("failed to get item states", zap.Error(err))
+		return
+	}
+
+	now := time.Now()
+	for _, id := range ids {
+		cur, ok := after[id]
+		if !ok {
+			continue
+		}
+
+		old := before[id]
+		events := make([]*model.FavoriteEvent, 0, 2)
+		if cur.Price.Currency == old.Price.Currency && cur.Price.Less(old.Price) {
+			events = append(
+				events, &model.FavoriteEvent{
+					Type: model.FavoriteEventPriceDrop, ItemID: id, OldPrice: old.Price, NewPrice: cur.Price, CreatedAt: now,
+				},
+			)
+		}
+		if !old.InStock && cur.InStock {
+			events = append(
+				events, &model.FavoriteEvent{
+					Type: model.FavoriteEventBackInStock, ItemID: id, OldPrice: old.Price, NewPrice: cur.Price, CreatedAt: now,
+				},
+			)
+		}
+
+		for _, e := range events {
+			if e.UserIDs, err = c.repo.ListFavoriteSubscribers(ctx, id, e.Type); err != nil {
+				zap.L().Debug("failed to list favorite subscribers", zap.Error(err))
+				continue
+			}
+
+			if len(e.UserIDs) == 0 {
+				continue
+			}
+
+			if err = c.notifier.Notify(ctx, e); err != nil {
+				zap.L().Debug("failed to notify", zap.Error(err), zap.String("type", string(e.Type)))
+			}
+		}
+	}
+}
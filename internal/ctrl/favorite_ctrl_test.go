@@ -22,7 +22,7 @@ func TestController_ListFavorites(t *testing.T) {
 	uid := uuid.New()
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
 
 	tests := []struct {
 		name         string
@@ -36,7 +36,7 @@ func TestController_ListFavorites(t *testing.T) {
 			mockExpect: func() {
 				cc.EXPECT().GetToStruct(
 					gomock.Any(),
-					fmt.Sprintf(favoriteCacheKey, uid),
+					fmt.Sprintf(favoriteCacheKey, uid, 1, 40, model.FavoriteSortPrice),
 					gomock.Any(),
 				).Return(nil).Times(1)
 			},
@@ -45,43 +45,26 @@ func TestController_ListFavorites(t *testing.T) {
 				assert.NotNil(t, res)
 			},
 		},
-		{
-			name: "RepoNotFound",
-			uid:  uid,
-			mockExpect: func() {
-				cc.EXPECT().GetToStruct(
-					gomock.Any(),
-					fmt.Sprintf(favoriteCacheKey, uid),
-					gomock.Any(),
-				).Return(errors.New("cache miss")).Times(1)
-				rr.EXPECT().GetFavorites(
-					gomock.Any(),
-					gomock.Any(),
-				).Return(nil, repo.ErrNotFound).Times(1)
-			},
-			expectedResp: func(t *testing.T, res any, err error) {
-				require.Error(t, err)
-				assert.Equal(t, ErrNotFound, err)
-				assert.Nil(t, res)
-			},
-		},
 		{
 			name: "RepoSuccess",
 			uid:  uid,
 			mockExpect: func() {
 				cc.EXPECT().GetToStruct(
 					gomock.Any(),
-					fmt.Sprintf(favoriteCacheKey, uid),
+					fmt.Sprintf(favoriteCacheKey, uid, 1, 40, model.FavoriteSortPrice),
 					gomock.Any(),
 				).Return(errors.New("cache miss")).Times(1)
-				rr.EXPECT().GetFavorites(
+				rr.EXPECT().ListFavorites(
 					gomock.Any(),
-					gomock.Any(),
-				).Return([]*model.Favorite{}, nil).Times(1)
+					uid,
+					1,
+					40,
+					model.FavoriteSortPrice,
+				).Return(&model.PaginatedFavoritesData{}, nil).Times(1)
 				cc.EXPECT().Set(
 					gomock.Any(),
 					consts.DefaultCacheTime,
-					fmt.Sprintf(favoriteCacheKey, uid),
+					fmt.Sprintf(favoriteCacheKey, uid, 1, 40, model.FavoriteSortPrice),
 					gomock.Any(),
 				).Return(nil).Times(1)
 			},
@@ -96,12 +79,15 @@ func TestController_ListFavorites(t *testing.T) {
 			mockExpect: func() {
 				cc.EXPECT().GetToStruct(
 					gomock.Any(),
-					fmt.Sprintf(favoriteCacheKey, uid),
+					fmt.Sprintf(favoriteCacheKey, uid, 1, 40, model.FavoriteSortPrice),
 					gomock.Any(),
 				).Return(errors.New("cache miss")).Times(1)
-				rr.EXPECT().GetFavorites(
-					gomock.Any(),
+				rr.EXPECT().ListFavorites(
 					gomock.Any(),
+					uid,
+					1,
+					40,
+					model.FavoriteSortPrice,
 				).Return(nil, errors.New("internal error")).Times(1)
 			},
 			expectedResp: func(t *testing.T, res any, err error) {
@@ -116,7 +102,7 @@ func TestController_ListFavorites(t *testing.T) {
 			tt.name, func(t *testing.T) {
 				tt.mockExpect()
 
-				res, err := ctrl.ListFavorites(context.Background(), tt.uid)
+				res, err := ctrl.ListFavorites(context.Background(), tt.uid, 1, 40, model.FavoriteSortPrice)
 
 				tt.expectedResp(t, res, err)
 			},
@@ -124,6 +110,44 @@ func TestController_ListFavorites(t *testing.T) {
 	}
 }
 
+func TestController_ListFavoritesWithPriceList(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	uid, iid := uuid.New(), uuid.New()
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+
+	pl := &model.PriceList{ID: 1, Currency: "KZT", BaseCurrency: "RUB", Rate: 5.47}
+	ctx := WithPriceList(context.Background(), pl)
+
+	cc.EXPECT().GetToStruct(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("cache miss")).Times(1)
+	rr.EXPECT().ListFavorites(gomock.Any(), uid, 1, 40, model.FavoriteSortPrice).Return(
+		&model.PaginatedFavoritesData{
+			Data: []*model.Favorite{
+				{
+					ItemID:         iid,
+					Item:           model.Item{ID: iid, Price: model.NewMoney(10000, "RUB")},
+					Discount:       10,
+					EffectivePrice: model.NewMoney(9000, "RUB"),
+				},
+			},
+		}, nil,
+	).Times(1)
+	cc.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	rr.EXPECT().GetPriceListPrices(gomock.Any(), pl.ID, []uuid.UUID{iid}).
+		Return(map[uuid.UUID]int64{iid: 50000}, nil).Times(1)
+	rr.EXPECT().GetApplicableQuantityBreaks(gomock.Any(), uint64(0), []uuid.UUID{iid}).
+		Return(map[uuid.UUID][]model.QuantityBreak{}, nil).Times(1)
+
+	res, err := ctrl.ListFavorites(ctx, uid, 1, 40, model.FavoriteSortPrice)
+	require.NoError(t, err)
+	require.Len(t, res.Data, 1)
+	assert.Equal(t, model.NewMoney(50000, "KZT"), res.Data[0].Item.Price)
+	assert.Equal(t, model.NewMoney(45000, "KZT"), res.Data[0].EffectivePrice)
+}
+
 func TestController_AddToFavorites(t *testing.T) {
 	mock := gomock.NewController(t)
 	defer mock.Finish()
@@ -132,7 +156,8 @@ func TestController_AddToFavorites(t *testing.T) {
 	iID := uuid.New()
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
+	expectAudit(rr)
 
 	tests := []struct {
 		name         string
@@ -151,10 +176,10 @@ func TestController_AddToFavorites(t *testing.T) {
 					gomock.Any(),
 					gomock.Any(),
 				).Return(&model.Favorite{}, nil).Times(1)
-				cc.EXPECT().Delete(
+				cc.EXPECT().InvalidateKeysByPattern(
 					gomock.Any(),
-					fmt.Sprintf(favoriteCacheKey, uID),
-				).Return(nil).Times(1)
+					fmt.Sprintf(favoriteUserCachePattern, uID),
+				).AnyTimes()
 			},
 			expectedResp: func(t *testing.T, res *model.Favorite, err error) {
 				require.NoError(t, err)
@@ -171,7 +196,7 @@ func TestController_AddToFavorites(t *testing.T) {
 					gomock.Any(),
 					gomock.Any(),
 				).Return(nil, repo.ErrAlreadyExists).Times(1)
-				cc.EXPECT().Delete(
+				cc.EXPECT().InvalidateKeysByPattern(
 					gomock.Any(),
 					gomock.Any(),
 				).Times(0)
@@ -192,7 +217,7 @@ func TestController_AddToFavorites(t *testing.T) {
 					gomock.Any(),
 					gomock.Any(),
 				).Return(nil, repo.ErrNotFound).Times(1)
-				cc.EXPECT().Delete(
+				cc.EXPECT().InvalidateKeysByPattern(
 					gomock.Any(),
 					gomock.Any(),
 				).Times(0)
@@ -213,7 +238,7 @@ func TestController_AddToFavorites(t *testing.T) {
 					gomock.Any(),
 					gomock.Any(),
 				).Return(nil, errors.New("internal error")).Times(1)
-				cc.EXPECT().Delete(
+				cc.EXPECT().InvalidateKeysByPattern(
 					gomock.Any(),
 					gomock.Any(),
 				).Times(0)
@@ -246,7 +271,8 @@ func TestController_RemoveFromFavorites(t *testing.T) {
 	iID := uuid.New()
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
+	expectAudit(rr)
 
 	tests := []struct {
 		name         string
@@ -265,10 +291,10 @@ func TestController_RemoveFromFavorites(t *testing.T) {
 					gomock.Any(),
 					gomock.Any(),
 				).Return(nil).Times(1)
-				cc.EXPECT().Delete(
+				cc.EXPECT().InvalidateKeysByPattern(
 					gomock.Any(),
-					fmt.Sprintf(favoriteCacheKey, uID),
-				).Return(nil).Times(1)
+					fmt.Sprintf(favoriteUserCachePattern, uID),
+				).AnyTimes()
 			},
 			expectedResp: func(t *testing.T, err error) {
 				require.NoError(t, err)
@@ -284,7 +310,7 @@ func TestController_RemoveFromFavorites(t *testing.T) {
 					gomock.Any(),
 					gomock.Any(),
 				).Return(repo.ErrNotFound).Times(1)
-				cc.EXPECT().Delete(
+				cc.EXPECT().InvalidateKeysByPattern(
 					gomock.Any(),
 					gomock.Any(),
 				).Times(0)
@@ -304,7 +330,7 @@ func TestController_RemoveFromFavorites(t *testing.T) {
 					gomock.Any(),
 					gomock.Any(),
 				).Return(errors.New("internal error")).Times(1)
-				cc.EXPECT().Delete(
+				cc.EXPECT().InvalidateKeysByPattern(
 					gomock.Any(),
 					gomock.Any(),
 				).Times(0)
@@ -323,10 +349,10 @@ func TestController_RemoveFromFavorites(t *testing.T) {
 					gomock.Any(),
 					gomock.Any(),
 				).Return(nil).Times(1)
-				cc.EXPECT().Delete(
+				cc.EXPECT().InvalidateKeysByPattern(
 					gomock.Any(),
-					fmt.Sprintf(favoriteCacheKey, uID),
-				).Return(errors.New("cache delete error")).Times(1)
+					fmt.Sprintf(favoriteUserCachePattern, uID),
+				).Return(errors.New("cache delete error")).AnyTimes()
 			},
 			expectedResp: func(t *testing.T, err error) {
 				require.NoError(t, err)
@@ -346,3 +372,92 @@ func TestController_RemoveFromFavorites(t *testing.T) {
 		)
 	}
 }
+
+func TestController_NotifyFavorites(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	uid := uuid.New()
+	subscriber := uuid.New()
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	nn := mocks.NewMockNotifier(mock)
+	ctrl := New(rr, cc, nn, nil)
+	expectAudit(rr)
+	rr.EXPECT().GetItemByUUID(gomock.Any(), gomock.Any()).Return(nil, repo.ErrNotFound).AnyTimes()
+
+	tests := []struct {
+		name       string
+		before     model.ItemState
+		after      model.ItemState
+		mockExpect func()
+	}{
+		{
+			name:   "PriceDrop",
+			before: model.ItemState{Price: model.NewMoney(2000, "RUB"), InStock: true},
+			after:  model.ItemState{Price: model.NewMoney(1500, "RUB"), InStock: true},
+			mockExpect: func() {
+				rr.EXPECT().ListFavoriteSubscribers(gomock.Any(), uid, model.FavoriteEventPriceDrop).
+					Return([]uuid.UUID{subscriber}, nil).Times(1)
+				nn.EXPECT().Notify(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, e *model.FavoriteEvent) error {
+						assert.Equal(t, model.FavoriteEventPriceDrop, e.Type)
+						assert.Equal(t, []uuid.UUID{subscriber}, e.UserIDs)
+						assert.Equal(t, model.NewMoney(1500, "RUB"), e.NewPrice)
+						return nil
+					},
+				).Times(1)
+			},
+		},
+		{
+			name:   "BackInStock",
+			before: model.ItemState{Price: model.NewMoney(2000, "RUB")},
+			after:  model.ItemState{Price: model.NewMoney(2000, "RUB"), InStock: true},
+			mockExpect: func() {
+				rr.EXPECT().ListFavoriteSubscribers(gomock.Any(), uid, model.FavoriteEventBackInStock).
+					Return([]uuid.UUID{subscriber}, nil).Times(1)
+				nn.EXPECT().Notify(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, e *model.FavoriteEvent) error {
+						assert.Equal(t, model.FavoriteEventBackInStock, e.Type)
+						return nil
+					},
+				).Times(1)
+			},
+		},
+		{
+			name:   "NoSubscribers",
+			before: model.ItemState{Price: model.NewMoney(2000, "RUB")},
+			after:  model.ItemState{Price: model.NewMoney(1000, "RUB")},
+			mockExpect: func() {
+				rr.EXPECT().ListFavoriteSubscribers(gomock.Any(), uid, model.FavoriteEventPriceDrop).
+					Return(nil, nil).Times(1)
+			},
+		},
+		{
+			name:       "PriceIncrease",
+			before:     model.ItemState{Price: model.NewMoney(1000, "RUB")},
+			after:      model.ItemState{Price: model.NewMoney(2000, "RUB")},
+			mockExpect: func() {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				gomock.InOrder(
+					rr.EXPECT().GetItemStates(gomock.Any(), []uuid.UUID{uid}).
+						Return(map[uuid.UUID]model.ItemState{uid: tt.before}, nil).Times(1),
+					rr.EXPECT().UpdateItem(gomock.Any(), uid, gomock.Any()).Return(nil).Times(1),
+					rr.EXPECT().GetItemStates(gomock.Any(), []uuid.UUID{uid}).
+						Return(map[uuid.UUID]model.ItemState{uid: tt.after}, nil).Times(1),
+				)
+				cc.EXPECT().Delete(gomock.Any(), fmt.Sprintf(itemCacheKey, uid)).Return(nil).Times(1)
+				cc.EXPECT().InvalidateKeysByPattern(gomock.Any(), gomock.Any()).AnyTimes()
+				tt.mockExpect()
+
+				err := ctrl.UpdateItem(context.Background(), uid, &model.Item{})
+				require.NoError(t, err)
+			},
+		)
+	}
+}
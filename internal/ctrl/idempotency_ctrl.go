@@ -0,0 +1,182 @@
+package ctrl
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/JMURv/par-pro/products/internal/cache"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/goccy/go-json"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"strings"
+	"time"
+)
+
+const idempotencyCacheKey = "idempotency:%v"
+
+type idempotencyRepo interface {
+	ClaimIdempotencyKey(ctx context.Context, key, hash string, lock time.Duration) (bool, error)
+	GetIdempotencyRecord(ctx context.Context, key string) (*model.IdempotencyRecord, error)
+	SaveIdempotencyResponse(ctx context.Context, key string, status int, response []byte) error
+	ReleaseIdempotencyKey(ctx context.Context, key string) error
+	DeleteExpiredIdempotencyKeys(ctx context.Context, before time.Time) (int64, error)
+}
+
+// IdempotencyKey hashes the parts into a fixed size key, handlers use it to scope client keys and to hash request bodies.
+func IdempotencyKey(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// BeginIdempotent claims key for a request with the given hash.
+// It returns nil when the caller should process the request and the stored record when the request is a replay.
+// Keys are claimed in the cache with SETNX, Postgres only takes over while the cache is unavailable.
+// A pending claim expires after consts.IdempotencyLockTimeout so that an abandoned request can be retried.
+func (c *Controller) BeginIdempotent(ctx context.Context, key, hash string) (*model.IdempotencyRecord, error) {
+	const op = "idempotency.BeginIdempotent.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	cacheKey := fmt.Sprintf(idempotencyCacheKey, key)
+	pending, err := json.Marshal(&model.IdempotencyRecord{Key: key, RequestHash: hash, CreatedAt: time.Now()})
+	if err != nil {
+		return nil, err
+	}
+
+	claimed, err := c.cache.SetNX(ctx, consts.IdempotencyLockTimeout, cacheKey, pending)
+	if err != nil {
+		zap.L().Debug("failed to claim idempotency key in cache", zap.Error(err), zap.String("op", op))
+		return c.beginIdempotentDB(ctx, key, hash)
+	}
+
+	if claimed {
+		return nil, nil
+	}
+
+	res := &model.IdempotencyRecord{}
+	if err = c.cache.GetToStruct(ctx, cacheKey, res); err != nil && errors.Is(err, cache.ErrNotFoundInCache) {
+		// The other request failed and released the key in between
+		return nil, ErrIdempotencyInProgress
+	} else if err != nil {
+		zap.L().Debug("failed to get idempotency record from cache", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	return checkIdempotent(res, hash)
+}
+
+// beginIdempotentDB is the Postgres fallback of BeginIdempotent.
+func (c *Controller) beginIdempotentDB(ctx context.Context, key, hash string) (*model.IdempotencyRecord, error) {
+	const op = "idempotency.beginIdempotentDB.ctrl"
+
+	claimed, err := c.repo.ClaimIdempotencyKey(ctx, key, hash, consts.IdempotencyLockTimeout)
+	if err != nil {
+		zap.L().Debug("failed to claim idempotency key", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	if claimed {
+		return nil, nil
+	}
+
+	res, err := c.repo.GetIdempotencyRecord(ctx, key)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		// The other request failed and released the key in between
+		return nil, ErrIdempotencyInProgress
+	} else if err != nil {
+		zap.L().Debug("failed to get idempotency record", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	return checkIdempotent(res, hash)
+}
+
+func checkIdempotent(rec *model.IdempotencyRecord, hash string) (*model.IdempotencyRecord, error) {
+	if rec.RequestHash != hash {
+		return nil, ErrIdempotencyMismatch
+	}
+	if rec.Status == 0 {
+		return nil, ErrIdempotencyInProgress
+	}
+	return rec, nil
+}
+
+// CompleteIdempotent stores the response of a request claimed with BeginIdempotent so that replays return it.
+// The response goes to the cache, Postgres only keeps it when the cache is unavailable.
+func (c *Controller) CompleteIdempotent(ctx context.Context, key, hash string, status int, response []byte) error {
+	const op = "idempotency.CompleteIdempotent.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	bytes, err := json.Marshal(
+		&model.IdempotencyRecord{
+			Key:         key,
+			RequestHash: hash,
+			Status:      status,
+			Response:    response,
+			CreatedAt:   time.Now(),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if err = c.cache.Set(ctx, consts.IdempotencyTTL, fmt.Sprintf(idempotencyCacheKey, key), bytes); err == nil {
+		return nil
+	}
+	zap.L().Debug("failed to set to cache", zap.Error(err), zap.String("op", op))
+
+	if err = c.repo.SaveIdempotencyResponse(ctx, key, status, response); err != nil {
+		zap.L().Debug("failed to save idempotent response", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	return nil
+}
+
+// ReleaseIdempotent frees key after a failed request so that the client can retry it.
+func (c *Controller) ReleaseIdempotent(ctx context.Context, key string) error {
+	const op = "idempotency.ReleaseIdempotent.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	err := c.cache.Delete(ctx, fmt.Sprintf(idempotencyCacheKey, key))
+	if err == nil {
+		return nil
+	}
+	zap.L().Debug("failed to delete from cache", zap.Error(err), zap.String("op", op))
+
+	if err = c.repo.ReleaseIdempotencyKey(ctx, key); err != nil {
+		zap.L().Debug("failed to release idempotency key", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	return nil
+}
+
+// CleanupIdempotencyKeys removes keys older than consts.IdempotencyTTL, it runs as a background job.
+func (c *Controller) CleanupIdempotencyKeys(ctx context.Context) error {
+	const op = "idempotency.CleanupIdempotencyKeys.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	n, err := c.repo.DeleteExpiredIdempotencyKeys(ctx, time.Now().Add(-consts.IdempotencyTTL))
+	if err != nil {
+		zap.L().Debug("failed to delete expired idempotency keys", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	if n > 0 {
+		zap.L().Info("Removed expired idempotency keys", zap.Int64("count", n))
+	}
+	return nil
+}
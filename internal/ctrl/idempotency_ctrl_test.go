@@ -0,0 +1,209 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/JMURv/par-pro/products/internal/cache"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+func TestController_BeginIdempotent(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+
+	const key, hash = "key", "hash"
+	cacheKey := fmt.Sprintf(idempotencyCacheKey, key)
+	done := &model.IdempotencyRecord{Key: key, RequestHash: hash, Status: 201, Response: []byte(`{"id":1}`)}
+	dbErr := errors.New("db error")
+	cacheErr := errors.New("cache error")
+
+	claim := func(ok bool, err error) {
+		cc.EXPECT().SetNX(gomock.Any(), consts.IdempotencyLockTimeout, cacheKey, gomock.Any()).Return(ok, err)
+	}
+	cached := func(rec *model.IdempotencyRecord) {
+		cc.EXPECT().GetToStruct(gomock.Any(), cacheKey, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, dest any) error {
+				*dest.(*model.IdempotencyRecord) = *rec
+				return nil
+			},
+		)
+	}
+
+	tests := []struct {
+		name         string
+		hash         string
+		mockExpect   func()
+		expectedResp func(*testing.T, *model.IdempotencyRecord, error)
+	}{
+		{
+			name: "New key",
+			hash: hash,
+			mockExpect: func() {
+				claim(true, nil)
+			},
+			expectedResp: func(t *testing.T, rec *model.IdempotencyRecord, err error) {
+				require.NoError(t, err)
+				assert.Nil(t, rec)
+			},
+		},
+		{
+			name: "Replay from cache",
+			hash: hash,
+			mockExpect: func() {
+				claim(false, nil)
+				cached(done)
+			},
+			expectedResp: func(t *testing.T, rec *model.IdempotencyRecord, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, done, rec)
+			},
+		},
+		{
+			name: "Different request",
+			hash: "other",
+			mockExpect: func() {
+				claim(false, nil)
+				cached(done)
+			},
+			expectedResp: func(t *testing.T, rec *model.IdempotencyRecord, err error) {
+				assert.ErrorIs(t, err, ErrIdempotencyMismatch)
+				assert.Nil(t, rec)
+			},
+		},
+		{
+			name: "In progress",
+			hash: hash,
+			mockExpect: func() {
+				claim(false, nil)
+				cached(&model.IdempotencyRecord{Key: key, RequestHash: hash})
+			},
+			expectedResp: func(t *testing.T, rec *model.IdempotencyRecord, err error) {
+				assert.ErrorIs(t, err, ErrIdempotencyInProgress)
+			},
+		},
+		{
+			name: "Released in between",
+			hash: hash,
+			mockExpect: func() {
+				claim(false, nil)
+				cc.EXPECT().GetToStruct(gomock.Any(), cacheKey, gomock.Any()).Return(cache.ErrNotFoundInCache)
+			},
+			expectedResp: func(t *testing.T, rec *model.IdempotencyRecord, err error) {
+				assert.ErrorIs(t, err, ErrIdempotencyInProgress)
+			},
+		},
+		{
+			name: "Cache unavailable new key",
+			hash: hash,
+			mockExpect: func() {
+				claim(false, cacheErr)
+				rr.EXPECT().ClaimIdempotencyKey(gomock.Any(), key, hash, consts.IdempotencyLockTimeout).Return(true, nil)
+			},
+			expectedResp: func(t *testing.T, rec *model.IdempotencyRecord, err error) {
+				require.NoError(t, err)
+				assert.Nil(t, rec)
+			},
+		},
+		{
+			name: "Cache unavailable replay from database",
+			hash: hash,
+			mockExpect: func() {
+				claim(false, cacheErr)
+				rr.EXPECT().ClaimIdempotencyKey(gomock.Any(), key, hash, consts.IdempotencyLockTimeout).Return(false, nil)
+				rr.EXPECT().GetIdempotencyRecord(gomock.Any(), key).Return(done, nil)
+			},
+			expectedResp: func(t *testing.T, rec *model.IdempotencyRecord, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, done, rec)
+			},
+		},
+		{
+			name: "Cache unavailable released in between",
+			hash: hash,
+			mockExpect: func() {
+				claim(false, cacheErr)
+				rr.EXPECT().ClaimIdempotencyKey(gomock.Any(), key, hash, consts.IdempotencyLockTimeout).Return(false, nil)
+				rr.EXPECT().GetIdempotencyRecord(gomock.Any(), key).Return(nil, repo.ErrNotFound)
+			},
+			expectedResp: func(t *testing.T, rec *model.IdempotencyRecord, err error) {
+				assert.ErrorIs(t, err, ErrIdempotencyInProgress)
+			},
+		},
+		{
+			name: "ClaimIdempotencyKey error",
+			hash: hash,
+			mockExpect: func() {
+				claim(false, cacheErr)
+				rr.EXPECT().ClaimIdempotencyKey(gomock.Any(), key, hash, consts.IdempotencyLockTimeout).Return(false, dbErr)
+			},
+			expectedResp: func(t *testing.T, rec *model.IdempotencyRecord, err error) {
+				assert.ErrorIs(t, err, dbErr)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+			rec, err := ctrl.BeginIdempotent(context.Background(), key, tt.hash)
+			tt.expectedResp(t, rec, err)
+		})
+	}
+}
+
+func TestController_CompleteIdempotent(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+
+	body := []byte(`{"id":1}`)
+	cacheKey := fmt.Sprintf(idempotencyCacheKey, "key")
+
+	t.Run("Cache", func(t *testing.T) {
+		cc.EXPECT().Set(gomock.Any(), consts.IdempotencyTTL, cacheKey, gomock.Any()).Return(nil)
+		require.NoError(t, ctrl.CompleteIdempotent(context.Background(), "key", "hash", 201, body))
+	})
+
+	t.Run("Cache unavailable", func(t *testing.T) {
+		cc.EXPECT().Set(gomock.Any(), consts.IdempotencyTTL, cacheKey, gomock.Any()).Return(errors.New("cache error"))
+		rr.EXPECT().SaveIdempotencyResponse(gomock.Any(), "key", 201, body).Return(nil)
+		require.NoError(t, ctrl.CompleteIdempotent(context.Background(), "key", "hash", 201, body))
+	})
+}
+
+func TestController_ReleaseIdempotent(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+
+	cacheKey := fmt.Sprintf(idempotencyCacheKey, "key")
+
+	t.Run("Cache", func(t *testing.T) {
+		cc.EXPECT().Delete(gomock.Any(), cacheKey).Return(nil)
+		require.NoError(t, ctrl.ReleaseIdempotent(context.Background(), "key"))
+	})
+
+	t.Run("Cache unavailable", func(t *testing.T) {
+		cc.EXPECT().Delete(gomock.Any(), cacheKey).Return(errors.New("cache error"))
+		rr.EXPECT().ReleaseIdempotencyKey(gomock.Any(), "key").Return(nil)
+		require.NoError(t, ctrl.ReleaseIdempotent(context.Background(), "key"))
+	})
+}
@@ -31,9 +31,11 @@ type itemRepo interface {
 	GetItemByUUID(ctx context.Context, uid uuid.UUID) (*model.Item, error)
 	CreateItem(ctx context.Context, i *model.Item) (uuid.UUID, error)
 	UpdateItem(ctx context.Context, uid uuid.UUID, i *model.Item) error
+	PatchItem(ctx context.Context, uid uuid.UUID, i *model.Item, fields []string) error
 	DeleteItem(ctx context.Context, uid uuid.UUID) error
 
 	ListRelatedItems(ctx context.Context, uid uuid.UUID) ([]*model.RelatedProduct, error)
+	RecommendItems(ctx context.Context, uid uuid.UUID, exclude []uuid.UUID, limit int) ([]*model.RelatedProduct, error)
 	ListItemsByLabel(ctx context.Context, label string, page int, size int) (*model.PaginatedItemsData, error)
 }
 
@@ -45,6 +47,10 @@ func (c *Controller) ItemSearch(ctx context.Context, query string, page, size in
 
 	cached := &model.PaginatedItemsData{}
 	if err := c.cache.GetToStruct(ctx, fmt.Sprintf(itemSearchCacheKey, query, page, size), &cached); err == nil {
+		if err = c.applyPricing(ctx, cached.Data...); err != nil {
+			zap.L().Debug("failed to apply pricing", zap.Error(err), zap.String("op", op))
+			return nil, err
+		}
 		return cached, nil
 	}
 
@@ -65,6 +71,10 @@ func (c *Controller) ItemSearch(ctx context.Context, query string, page, size in
 		}
 	}
 
+	if err = c.applyPricing(ctx, res.Data...); err != nil {
+		zap.L().Debug("failed to apply pricing", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
 	return res, nil
 }
 
@@ -76,6 +86,10 @@ func (c *Controller) ListItems(ctx context.Context, page, size int) (*model.Pagi
 
 	cached := &model.PaginatedItemsData{}
 	if err := c.cache.GetToStruct(ctx, fmt.Sprintf(itemListCacheKey, page, size), cached); err == nil {
+		if err = c.applyPricing(ctx, cached.Data...); err != nil {
+			zap.L().Debug("failed to apply pricing", zap.Error(err), zap.String("op", op))
+			return nil, err
+		}
 		return cached, nil
 	}
 
@@ -96,6 +110,10 @@ func (c *Controller) ListItems(ctx context.Context, page, size int) (*model.Pagi
 		}
 	}
 
+	if err = c.applyPricing(ctx, res.Data...); err != nil {
+		zap.L().Debug("failed to apply pricing", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
 	return res, nil
 }
 
@@ -107,6 +125,10 @@ func (c *Controller) GetItemByUUID(ctx context.Context, uid uuid.UUID) (*model.I
 
 	cached := &model.Item{}
 	if err := c.cache.GetToStruct(ctx, fmt.Sprintf(itemCacheKey, uid), cached); err == nil {
+		if err = c.applyPricing(ctx, cached); err != nil {
+			zap.L().Debug("failed to apply pricing", zap.Error(err), zap.String("op", op))
+			return nil, err
+		}
 		return cached, nil
 	}
 
@@ -125,6 +147,10 @@ func (c *Controller) GetItemByUUID(ctx context.Context, uid uuid.UUID) (*model.I
 		}
 	}
 
+	if err = c.applyPricing(ctx, res); err != nil {
+		zap.L().Debug("failed to apply pricing", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
 	return res, nil
 }
 
@@ -134,6 +160,7 @@ func (c *Controller) CreateItem(ctx context.Context, i *model.Item) (uuid.UUID,
 	ctx = opentracing.ContextWithSpan(ctx, span)
 	defer span.Finish()
 
+	normalizeItemPrices(i)
 	uid, err := c.repo.CreateItem(ctx, i)
 	if err != nil {
 		zap.L().Debug("failed to create item", zap.Error(err), zap.String("op", op))
@@ -141,6 +168,7 @@ func (c *Controller) CreateItem(ctx context.Context, i *model.Item) (uuid.UUID,
 	}
 
 	go c.cache.InvalidateKeysByPattern(ctx, invalidateItemRelatedCachePattern)
+	c.audit(ctx, model.AuditItem, model.AuditCreate, uid, nil, i)
 	return uid, nil
 }
 
@@ -150,9 +178,14 @@ func (c *Controller) UpdateItem(ctx context.Context, uid uuid.UUID, i *model.Ite
 	ctx = opentracing.ContextWithSpan(ctx, span)
 	defer span.Finish()
 
+	normalizeItemPrices(i)
+	before := c.itemStates(ctx, []uuid.UUID{uid})
+	prev := snapshot(ctx, c.repo.GetItemByUUID, uid)
 	err := c.repo.UpdateItem(ctx, uid, i)
 	if err != nil && errors.Is(err, repo.ErrNotFound) {
 		return ErrNotFound
+	} else if err != nil && errors.Is(err, repo.ErrVersionConflict) {
+		return ErrVersionConflict
 	} else if err != nil {
 		zap.L().Debug("failed to update item", zap.Error(err), zap.String("op", op))
 		return err
@@ -163,6 +196,40 @@ func (c *Controller) UpdateItem(ctx context.Context, uid uuid.UUID, i *model.Ite
 	}
 
 	go c.cache.InvalidateKeysByPattern(ctx, invalidateItemRelatedCachePattern)
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateFavoriteCachePattern)
+	c.notifyFavorites(ctx, before)
+	c.audit(ctx, model.AuditItem, model.AuditUpdate, uid, prev, snapshot(ctx, c.repo.GetItemByUUID, uid))
+	return nil
+}
+
+// PatchItem updates only the fields of i named in fields.
+func (c *Controller) PatchItem(ctx context.Context, uid uuid.UUID, i *model.Item, fields []string) error {
+	const op = "items.PatchItem.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	normalizeItemPrices(i)
+	before := c.itemStates(ctx, []uuid.UUID{uid})
+	prev := snapshot(ctx, c.repo.GetItemByUUID, uid)
+	err := c.repo.PatchItem(ctx, uid, i, fields)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return ErrNotFound
+	} else if err != nil && errors.Is(err, repo.ErrVersionConflict) {
+		return ErrVersionConflict
+	} else if err != nil {
+		zap.L().Debug("failed to patch item", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	if err = c.cache.Delete(ctx, fmt.Sprintf(itemCacheKey, uid)); err != nil {
+		zap.L().Debug("failed to delete from cache", zap.Error(err), zap.String("op", op))
+	}
+
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateItemRelatedCachePattern)
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateFavoriteCachePattern)
+	c.notifyFavorites(ctx, before)
+	c.audit(ctx, model.AuditItem, model.AuditUpdate, uid, prev, snapshot(ctx, c.repo.GetItemByUUID, uid))
 	return nil
 }
 
@@ -172,6 +239,7 @@ func (c *Controller) DeleteItem(ctx context.Context, uid uuid.UUID) error {
 	ctx = opentracing.ContextWithSpan(ctx, span)
 	defer span.Finish()
 
+	prev := snapshot(ctx, c.repo.GetItemByUUID, uid)
 	err := c.repo.DeleteItem(ctx, uid)
 	if err != nil && errors.Is(err, repo.ErrNotFound) {
 		return ErrNotFound
@@ -185,6 +253,8 @@ func (c *Controller) DeleteItem(ctx context.Context, uid uuid.UUID) error {
 	}
 
 	go c.cache.InvalidateKeysByPattern(ctx, invalidateItemRelatedCachePattern)
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateFavoriteCachePattern)
+	c.audit(ctx, model.AuditItem, model.AuditDelete, uid, prev, nil)
 	return nil
 }
 
@@ -197,6 +267,10 @@ func (c *Controller) ListCategoryItems(ctx context.Context, slug string, page, s
 	cached := &model.PaginatedItemsData{}
 	cacheKey := fmt.Sprintf(itemCategoryCacheKey, slug, page, size, filters, sort)
 	if err := c.cache.GetToStruct(ctx, cacheKey, &cached); err == nil {
+		if err = c.applyPricing(ctx, cached.Data...); err != nil {
+			zap.L().Debug("failed to apply pricing", zap.Error(err), zap.String("op", op))
+			return nil, err
+		}
 		return cached, nil
 	}
 
@@ -215,6 +289,10 @@ func (c *Controller) ListCategoryItems(ctx context.Context, slug string, page, s
 		}
 	}
 
+	if err = c.applyPricing(ctx, res.Data...); err != nil {
+		zap.L().Debug("failed to apply pricing", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
 	return res, nil
 }
 
@@ -257,6 +335,10 @@ func (c *Controller) ListRelatedItems(ctx context.Context, uid uuid.UUID) ([]*mo
 
 	cached := make([]*model.RelatedProduct, 0, 15)
 	if err := c.cache.GetToStruct(ctx, fmt.Sprintf(relatedItemCacheKey, uid), &cached); err == nil {
+		if err = c.applyPricing(ctx, relatedItems(cached)...); err != nil {
+			zap.L().Debug("failed to apply pricing", zap.Error(err), zap.String("op", op))
+			return nil, err
+		}
 		return cached, nil
 	}
 
@@ -269,12 +351,20 @@ func (c *Controller) ListRelatedItems(ctx context.Context, uid uuid.UUID) ([]*mo
 		return nil, err
 	}
 
+	if len(res) < consts.RelatedItemsLimit {
+		res = append(res, c.recommendItems(ctx, uid, res)...)
+	}
+
 	if bytes, err := json.Marshal(res); err == nil {
 		if err = c.cache.Set(ctx, consts.DefaultCacheTime, fmt.Sprintf(relatedItemCacheKey, uid), bytes); err != nil {
 			zap.L().Debug("failed to set to cache", zap.Error(err), zap.String("op", op))
 		}
 	}
 
+	if err = c.applyPricing(ctx, relatedItems(res)...); err != nil {
+		zap.L().Debug("failed to apply pricing", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
 	return res, nil
 }
 
@@ -287,6 +377,10 @@ func (c *Controller) ListItemsByLabel(ctx context.Context, label string, page in
 	cacheKey := fmt.Sprintf(itemLabelCacheKey, label, page, size)
 	cached := &model.PaginatedItemsData{}
 	if err := c.cache.GetToStruct(ctx, cacheKey, &cached); err == nil {
+		if err = c.applyPricing(ctx, cached.Data...); err != nil {
+			zap.L().Debug("failed to apply pricing", zap.Error(err), zap.String("op", op))
+			return nil, err
+		}
 		return cached, nil
 	}
 
@@ -302,6 +396,39 @@ func (c *Controller) ListItemsByLabel(ctx context.Context, label string, page in
 		}
 	}
 
+	if err = c.applyPricing(ctx, res.Data...); err != nil {
+		zap.L().Debug("failed to apply pricing", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
 	return res, nil
+}
+
+func normalizeItemPrices(i *model.Item) {
+	i.Price.Normalize()
+	for idx := range i.Variants {
+		i.Variants[idx].Price.Normalize()
+	}
+}
+
+// recommendItems fills the related list up to consts.RelatedItemsLimit, recommendations are optional so errors are only logged.
+func (c *Controller) recommendItems(ctx context.Context, uid uuid.UUID, related []*model.RelatedProduct) []*model.RelatedProduct {
+	exclude := make([]uuid.UUID, 0, len(related))
+	for _, v := range related {
+		exclude = append(exclude, v.RelatedItemID)
+	}
+
+	res, err := c.repo.RecommendItems(ctx, uid, exclude, consts.RelatedItemsLimit-len(related))
+	if err != nil {
+		zap.L().Debug("failed to recommend items", zap.Error(err), zap.String("uid", uid.String()))
+		return nil
+	}
+	return res
+}
 
+func relatedItems(req []*model.RelatedProduct) []*model.Item {
+	res := make([]*model.Item, 0, len(req))
+	for _, v := range req {
+		res = append(res, &v.RelatedItem)
+	}
+	return res
 }
@@ -21,7 +21,7 @@ func TestController_ListCategoryItems(t *testing.T) {
 
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
 
 	tests := []struct {
 		name         string
@@ -158,7 +158,7 @@ func TestController_ItemAttrSearch(t *testing.T) {
 
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
 
 	tests := []struct {
 		name         string
@@ -258,7 +258,7 @@ func TestController_ItemSearch(t *testing.T) {
 
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
 
 	tests := []struct {
 		name         string
@@ -357,9 +357,10 @@ func TestController_ListRelatedItems(t *testing.T) {
 	defer mock.Finish()
 
 	uid := uuid.New()
+	relatedID := uuid.New()
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
 
 	tests := []struct {
 		name         string
@@ -395,6 +396,12 @@ func TestController_ListRelatedItems(t *testing.T) {
 					gomock.Any(),
 					uid,
 				).Return([]*model.RelatedProduct{}, nil).Times(1)
+				rr.EXPECT().RecommendItems(
+					gomock.Any(),
+					uid,
+					[]uuid.UUID{},
+					consts.RelatedItemsLimit,
+				).Return([]*model.RelatedProduct{}, nil).Times(1)
 				cc.EXPECT().Set(
 					gomock.Any(),
 					consts.DefaultCacheTime,
@@ -407,6 +414,73 @@ func TestController_ListRelatedItems(t *testing.T) {
 				assert.NotNil(t, res)
 			},
 		},
+		{
+			name: "RecommendationsFillTheGap",
+			uid:  uid,
+			mockExpect: func() {
+				manual := &model.RelatedProduct{ItemID: uid, RelatedItemID: relatedID, Type: model.RelatedTypeAccessory}
+				cc.EXPECT().GetToStruct(
+					gomock.Any(),
+					fmt.Sprintf(relatedItemCacheKey, uid),
+					gomock.Any(),
+				).Return(errors.New("cache miss")).Times(1)
+				rr.EXPECT().ListRelatedItems(
+					gomock.Any(),
+					uid,
+				).Return([]*model.RelatedProduct{manual}, nil).Times(1)
+				rr.EXPECT().RecommendItems(
+					gomock.Any(),
+					uid,
+					[]uuid.UUID{relatedID},
+					consts.RelatedItemsLimit-1,
+				).Return([]*model.RelatedProduct{{ItemID: uid, RelatedItemID: uuid.New(), IsRecommended: true}}, nil).Times(1)
+				cc.EXPECT().Set(
+					gomock.Any(),
+					consts.DefaultCacheTime,
+					fmt.Sprintf(relatedItemCacheKey, uid),
+					gomock.Any(),
+				).Return(nil).Times(1)
+				rr.EXPECT().GetApplicableQuantityBreaks(gomock.Any(), uint64(0), gomock.Any()).Return(nil, nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any, err error) {
+				require.NoError(t, err)
+				related := res.([]*model.RelatedProduct)
+				require.Len(t, related, 2)
+				assert.False(t, related[0].IsRecommended)
+				assert.True(t, related[1].IsRecommended)
+			},
+		},
+		{
+			name: "RecommenderErrorIsIgnored",
+			uid:  uid,
+			mockExpect: func() {
+				cc.EXPECT().GetToStruct(
+					gomock.Any(),
+					fmt.Sprintf(relatedItemCacheKey, uid),
+					gomock.Any(),
+				).Return(errors.New("cache miss")).Times(1)
+				rr.EXPECT().ListRelatedItems(
+					gomock.Any(),
+					uid,
+				).Return([]*model.RelatedProduct{}, nil).Times(1)
+				rr.EXPECT().RecommendItems(
+					gomock.Any(),
+					uid,
+					gomock.Any(),
+					consts.RelatedItemsLimit,
+				).Return(nil, errors.New("query error")).Times(1)
+				cc.EXPECT().Set(
+					gomock.Any(),
+					consts.DefaultCacheTime,
+					fmt.Sprintf(relatedItemCacheKey, uid),
+					gomock.Any(),
+				).Return(nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any, err error) {
+				require.NoError(t, err)
+				assert.Empty(t, res)
+			},
+		},
 		{
 			name: "RepoNotFound",
 			uid:  uid,
@@ -467,7 +541,7 @@ func TestController_ListItemsByLabel(t *testing.T) {
 
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
 
 	label := "test-label"
 	page := 1
@@ -560,7 +634,7 @@ func TestController_ListItems(t *testing.T) {
 
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
 
 	tests := []struct {
 		name         string
@@ -655,7 +729,7 @@ func TestController_GetItemByUUID(t *testing.T) {
 	itemID := uuid.New()
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
 
 	tests := []struct {
 		name         string
@@ -672,6 +746,7 @@ func TestController_GetItemByUUID(t *testing.T) {
 					fmt.Sprintf(itemCacheKey, itemID),
 					gomock.Any(),
 				).Return(nil).Times(1)
+				rr.EXPECT().GetApplicableQuantityBreaks(gomock.Any(), uint64(0), gomock.Any()).Return(nil, nil).Times(1)
 			},
 			expectedResp: func(t *testing.T, res any, err error) {
 				require.NoError(t, err)
@@ -717,6 +792,7 @@ func TestController_GetItemByUUID(t *testing.T) {
 					fmt.Sprintf(itemCacheKey, itemID),
 					gomock.Any(),
 				).Return(nil).Times(1)
+				rr.EXPECT().GetApplicableQuantityBreaks(gomock.Any(), uint64(0), gomock.Any()).Return(nil, nil).Times(1)
 			},
 			expectedResp: func(t *testing.T, res any, err error) {
 				require.NoError(t, err)
@@ -764,7 +840,8 @@ func TestController_CreateItem(t *testing.T) {
 	itemID := uuid.New()
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
+	expectAudit(rr)
 
 	tests := []struct {
 		name         string
@@ -825,7 +902,9 @@ func TestController_UpdateItem(t *testing.T) {
 
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
+	expectAudit(rr)
+	rr.EXPECT().GetItemByUUID(gomock.Any(), gomock.Any()).Return(nil, repo.ErrNotFound).AnyTimes()
 
 	tests := []struct {
 		name         string
@@ -927,7 +1006,9 @@ func TestController_DeleteItem(t *testing.T) {
 
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
+	expectAudit(rr)
+	rr.EXPECT().GetItemByUUID(gomock.Any(), gomock.Any()).Return(nil, repo.ErrNotFound).AnyTimes()
 
 	tests := []struct {
 		name         string
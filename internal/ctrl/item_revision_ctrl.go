@@ -0,0 +1,107 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+type itemRevisionRepo interface {
+	ListItemRevisions(ctx context.Context, uid uuid.UUID, page, size int) (*model.PaginatedItemRevisionsData, error)
+	GetItemRevision(ctx context.Context, uid uuid.UUID, id uint64) (*model.ItemRevision, error)
+	RollbackItem(ctx context.Context, uid uuid.UUID, id uint64) error
+}
+
+func (c *Controller) ListItemRevisions(ctx context.Context, uid uuid.UUID, page, size int) (*model.PaginatedItemRevisionsData, error) {
+	const op = "items.ListItemRevisions.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	res, err := c.repo.ListItemRevisions(ctx, uid, page, size)
+	if err != nil {
+		zap.L().Debug("failed to list item revisions", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// DiffItemRevisions compares the state of the item at revision from with the state at revision to.
+func (c *Controller) DiffItemRevisions(ctx context.Context, uid uuid.UUID, from, to uint64) (*model.ItemRevisionDiff, error) {
+	const op = "items.DiffItemRevisions.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	revs := make([]*model.ItemRevision, 0, 2)
+	for _, id := range []uint64{from, to} {
+		rev, err := c.repo.GetItemRevision(ctx, uid, id)
+		if err != nil && errors.Is(err, repo.ErrNotFound) {
+			return nil, ErrNotFound
+		} else if err != nil {
+			zap.L().Debug("failed to get item revision", zap.Error(err), zap.String("op", op))
+			return nil, err
+		}
+		revs = append(revs, rev)
+	}
+
+	diff, err := auditDiff(revs[0].Item, revs[1].Item)
+	if err != nil {
+		zap.L().Debug("failed to diff item revisions", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	return &model.ItemRevisionDiff{From: from, To: to, Diff: diff}, nil
+}
+
+func (c *Controller) RollbackItem(ctx context.Context, uid uuid.UUID, id uint64) error {
+	const op = "items.RollbackItem.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	rev, err := c.repo.GetItemRevision(ctx, uid, id)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to get item revision", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	// The snapshot was valid for the schema of its time, the categories may have changed it since.
+	schema, err := c.GetAttributeSchema(ctx, rev.Item.CategorySlugs())
+	if err != nil {
+		return err
+	}
+	if err = validation.ItemAttributesValidation(rev.Item.Attributes, schema); err != nil {
+		zap.L().Debug("failed to validate item revision", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	before := c.itemStates(ctx, []uuid.UUID{uid})
+	prev := snapshot(ctx, c.repo.GetItemByUUID, uid)
+	err = c.repo.RollbackItem(ctx, uid, id)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to roll back item", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	if err = c.cache.Delete(ctx, fmt.Sprintf(itemCacheKey, uid)); err != nil {
+		zap.L().Debug("failed to delete from cache", zap.Error(err), zap.String("op", op))
+	}
+
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateItemRelatedCachePattern)
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateFavoriteCachePattern)
+	c.notifyFavorites(ctx, before)
+	c.audit(ctx, model.AuditItem, model.AuditRollback, uid, prev, snapshot(ctx, c.repo.GetItemByUUID, uid))
+	return nil
+}
@@ -0,0 +1,148 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+func TestController_DiffItemRevisions(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+
+	uid := uuid.New()
+	older := &model.ItemRevision{ID: 1, Item: &model.Item{Title: "Shoes", Article: "A-1"}}
+	newer := &model.ItemRevision{ID: 2, Item: &model.Item{Title: "Boots", Article: "A-1"}}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			rr.EXPECT().GetItemRevision(gomock.Any(), uid, uint64(1)).Return(older, nil)
+			rr.EXPECT().GetItemRevision(gomock.Any(), uid, uint64(2)).Return(newer, nil)
+
+			res, err := ctrl.DiffItemRevisions(context.Background(), uid, 1, 2)
+			require.NoError(t, err)
+			assert.Equal(t, uint64(1), res.From)
+			assert.Equal(t, uint64(2), res.To)
+			assert.JSONEq(t, `{"before":{"title":"Shoes"},"after":{"title":"Boots"}}`, string(res.Diff))
+		},
+	)
+
+	t.Run(
+		"Unknown revision", func(t *testing.T) {
+			rr.EXPECT().GetItemRevision(gomock.Any(), uid, uint64(1)).Return(older, nil)
+			rr.EXPECT().GetItemRevision(gomock.Any(), uid, uint64(3)).Return(nil, repo.ErrNotFound)
+
+			_, err := ctrl.DiffItemRevisions(context.Background(), uid, 1, 3)
+			assert.ErrorIs(t, err, ErrNotFound)
+		},
+	)
+}
+
+func TestController_RollbackItem(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+	expectAudit(rr)
+	rr.EXPECT().GetItemByUUID(gomock.Any(), gomock.Any()).Return(nil, repo.ErrNotFound).AnyTimes()
+	cc.EXPECT().InvalidateKeysByPattern(gomock.Any(), gomock.Any()).AnyTimes()
+	cc.EXPECT().GetToStruct(gomock.Any(), fmt.Sprintf(categoryAttributesCacheKey, "tools"), gomock.Any()).Return(errors.New("miss")).AnyTimes()
+	cc.EXPECT().Set(gomock.Any(), gomock.Any(), fmt.Sprintf(categoryAttributesCacheKey, "tools"), gomock.Any()).Return(nil).AnyTimes()
+
+	uid := uuid.New()
+	dbErr := errors.New("db error")
+	schema := []*model.CategoryAttribute{
+		{
+			CategorySlug: "tools",
+			Attribute:    &model.Attribute{ID: 1, Slug: "power", Name: "Power", Type: "number"},
+			IsRequired:   true,
+		},
+	}
+	revision := func(value string) *model.ItemRevision {
+		return &model.ItemRevision{
+			ID:     4,
+			ItemID: uid,
+			Item: &model.Item{
+				Categories: []model.Category{{Slug: "tools"}},
+				Attributes: []model.ItemAttribute{{Name: "Power", Value: value}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		mockExpect func()
+		expectErr  error
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				rr.EXPECT().GetItemRevision(gomock.Any(), uid, uint64(4)).Return(revision("1500"), nil)
+				rr.EXPECT().ListCategoryAttributes(gomock.Any(), []string{"tools"}).Return(schema, nil)
+				rr.EXPECT().RollbackItem(gomock.Any(), uid, uint64(4)).Return(nil)
+				cc.EXPECT().Delete(gomock.Any(), fmt.Sprintf(itemCacheKey, uid)).Return(nil)
+			},
+		},
+		{
+			name: "Unknown revision",
+			mockExpect: func() {
+				rr.EXPECT().GetItemRevision(gomock.Any(), uid, uint64(4)).Return(nil, repo.ErrNotFound)
+			},
+			expectErr: ErrNotFound,
+		},
+		{
+			name: "Invalid for current schema",
+			mockExpect: func() {
+				rr.EXPECT().GetItemRevision(gomock.Any(), uid, uint64(4)).Return(revision("strong"), nil)
+				rr.EXPECT().ListCategoryAttributes(gomock.Any(), []string{"tools"}).Return(schema, nil)
+			},
+			expectErr: validation.ErrInvalidAttributeValue,
+		},
+		{
+			name: "Schema error",
+			mockExpect: func() {
+				rr.EXPECT().GetItemRevision(gomock.Any(), uid, uint64(4)).Return(revision("1500"), nil)
+				rr.EXPECT().ListCategoryAttributes(gomock.Any(), []string{"tools"}).Return(nil, dbErr)
+			},
+			expectErr: dbErr,
+		},
+		{
+			name: "Repo error",
+			mockExpect: func() {
+				rr.EXPECT().GetItemRevision(gomock.Any(), uid, uint64(4)).Return(revision("1500"), nil)
+				rr.EXPECT().ListCategoryAttributes(gomock.Any(), []string{"tools"}).Return(schema, nil)
+				rr.EXPECT().RollbackItem(gomock.Any(), uid, uint64(4)).Return(dbErr)
+			},
+			expectErr: dbErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				err := ctrl.RollbackItem(context.Background(), uid, 4)
+				if tt.expectErr != nil {
+					assert.ErrorIs(t, err, tt.expectErr)
+				} else {
+					require.NoError(t, err)
+				}
+			},
+		)
+	}
+}
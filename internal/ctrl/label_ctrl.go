@@ -0,0 +1,166 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+const labelsCacheKey = "labels"
+const invalidateItemLabelCachePattern = "items-label*"
+
+type labelRepo interface {
+	ListLabels(ctx context.Context) ([]*model.Label, error)
+	CreateLabel(ctx context.Context, l *model.Label) (uint64, error)
+	UpdateLabel(ctx context.Context, name string, l *model.Label) error
+	DeleteLabel(ctx context.Context, name string) error
+
+	SetItemLabels(ctx context.Context, uid uuid.UUID, names []string) error
+	RecomputeLabels(ctx context.Context) error
+}
+
+func (c *Controller) ListLabels(ctx context.Context) ([]*model.Label, error) {
+	const op = "labels.ListLabels.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	cached := make([]*model.Label, 0, 10)
+	if err := c.cache.GetToStruct(ctx, labelsCacheKey, &cached); err == nil {
+		return cached, nil
+	}
+
+	res, err := c.repo.ListLabels(ctx)
+	if err != nil {
+		zap.L().Debug("failed to list labels", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	if bytes, err := json.Marshal(res); err == nil {
+		if err = c.cache.Set(ctx, consts.DefaultCacheTime, labelsCacheKey, bytes); err != nil {
+			zap.L().Debug("failed to set to cache", zap.Error(err), zap.String("op", op))
+		}
+	}
+
+	return res, nil
+}
+
+func (c *Controller) CreateLabel(ctx context.Context, l *model.Label) (uint64, error) {
+	const op = "labels.CreateLabel.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	id, err := c.repo.CreateLabel(ctx, l)
+	if err != nil && errors.Is(err, repo.ErrAlreadyExists) {
+		zap.L().Debug("label already exists", zap.Error(err), zap.String("op", op))
+		return 0, ErrAlreadyExists
+	} else if err != nil {
+		zap.L().Debug("failed to create label", zap.Error(err), zap.String("op", op))
+		return 0, err
+	}
+
+	if err = c.cache.Delete(ctx, labelsCacheKey); err != nil {
+		zap.L().Debug("failed to delete from cache", zap.Error(err), zap.String("op", op))
+	}
+
+	c.audit(ctx, model.AuditLabel, model.AuditCreate, l.Name, nil, l)
+	return id, nil
+}
+
+func (c *Controller) UpdateLabel(ctx context.Context, name string, l *model.Label) error {
+	const op = "labels.UpdateLabel.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	err := c.repo.UpdateLabel(ctx, name, l)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find label", zap.Error(err), zap.String("op", op))
+		return ErrNotFound
+	} else if err != nil && errors.Is(err, repo.ErrAlreadyExists) {
+		zap.L().Debug("label already exists", zap.Error(err), zap.String("op", op))
+		return ErrAlreadyExists
+	} else if err != nil {
+		zap.L().Debug("failed to update label", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	c.invalidateLabels(ctx)
+	c.audit(ctx, model.AuditLabel, model.AuditUpdate, name, nil, l)
+	return nil
+}
+
+func (c *Controller) DeleteLabel(ctx context.Context, name string) error {
+	const op = "labels.DeleteLabel.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	err := c.repo.DeleteLabel(ctx, name)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find label", zap.Error(err), zap.String("op", op))
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to delete label", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	c.invalidateLabels(ctx)
+	c.audit(ctx, model.AuditLabel, model.AuditDelete, name, nil, nil)
+	return nil
+}
+
+// SetItemLabels replaces the labels assigned to the item by hand.
+func (c *Controller) SetItemLabels(ctx context.Context, uid uuid.UUID, names []string) error {
+	const op = "labels.SetItemLabels.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	err := c.repo.SetItemLabels(ctx, uid, names)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find item or label", zap.Error(err), zap.String("op", op))
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to set item labels", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateItemLabelCachePattern)
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateFavoriteCachePattern)
+	c.audit(ctx, model.AuditItemLabels, model.AuditUpdate, uid, nil, names)
+	return nil
+}
+
+// RecomputeLabels is run periodically by the label worker.
+func (c *Controller) RecomputeLabels(ctx context.Context) error {
+	const op = "labels.RecomputeLabels.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	if err := c.repo.RecomputeLabels(ctx); err != nil {
+		zap.L().Debug("failed to recompute labels", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateItemLabelCachePattern)
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateFavoriteCachePattern)
+	return nil
+}
+
+func (c *Controller) invalidateLabels(ctx context.Context) {
+	if err := c.cache.Delete(ctx, labelsCacheKey); err != nil {
+		zap.L().Debug("failed to delete from cache", zap.Error(err))
+	}
+
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateItemLabelCachePattern)
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateFavoriteCachePattern)
+}
@@ -0,0 +1,107 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+func TestController_SetItemLabels(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+	expectAudit(rr)
+	uid := uuid.New()
+	names := []string{"hit"}
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, error)
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				rr.EXPECT().SetItemLabels(gomock.Any(), uid, names).Return(nil)
+				cc.EXPECT().InvalidateKeysByPattern(gomock.Any(), invalidateItemLabelCachePattern).AnyTimes()
+				cc.EXPECT().InvalidateKeysByPattern(gomock.Any(), invalidateFavoriteCachePattern).AnyTimes()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "Unknown item or label",
+			mockExpect: func() {
+				rr.EXPECT().SetItemLabels(gomock.Any(), uid, names).Return(repo.ErrNotFound)
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.Equal(t, ErrNotFound, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				err := ctrl.SetItemLabels(context.Background(), uid, names)
+				tt.expectedResp(t, err)
+			},
+		)
+	}
+}
+
+func TestController_RecomputeLabels(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, error)
+	}{
+		{
+			name: "Cache is invalidated",
+			mockExpect: func() {
+				rr.EXPECT().RecomputeLabels(gomock.Any()).Return(nil)
+				cc.EXPECT().InvalidateKeysByPattern(gomock.Any(), invalidateItemLabelCachePattern).AnyTimes()
+				cc.EXPECT().InvalidateKeysByPattern(gomock.Any(), invalidateFavoriteCachePattern).AnyTimes()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "Repo error",
+			mockExpect: func() {
+				rr.EXPECT().RecomputeLabels(gomock.Any()).Return(errors.New("db error"))
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.Error(t, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				err := ctrl.RecomputeLabels(context.Background())
+				tt.expectedResp(t, err)
+			},
+		)
+	}
+}
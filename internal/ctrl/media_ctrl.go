@@ -0,0 +1,271 @@
+package ctrl
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/JMURv/par-pro/products/internal/media"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+const itemMediaCacheKey = "items-media:%v"
+const mediaKeySize = 16
+
+type mediaRepo interface {
+	ListItemMedia(ctx context.Context, itemID uuid.UUID) ([]*model.ItemMedia, error)
+	CreateItemMedia(ctx context.Context, itemID uuid.UUID, req *model.ItemMedia) (*model.ItemMedia, error)
+	UpdateItemMediaByID(ctx context.Context, itemID uuid.UUID, id uint64, req *model.ItemMedia) error
+	ReorderItemMedia(ctx context.Context, itemID uuid.UUID, ids []uint64) error
+	DeleteItemMedia(ctx context.Context, itemID uuid.UUID, id uint64) error
+
+	ClaimOrphanMedia(ctx context.Context, limit int) ([]string, error)
+	QueueOrphanMedia(ctx context.Context, keys []string) error
+}
+
+func (c *Controller) ListItemMedia(ctx context.Context, itemID uuid.UUID) ([]*model.ItemMedia, error) {
+	const op = "media.ListItemMedia.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	cached := make([]*model.ItemMedia, 0, 10)
+	cacheKey := fmt.Sprintf(itemMediaCacheKey, itemID)
+	if err := c.cache.GetToStruct(ctx, cacheKey, &cached); err == nil {
+		return cached, nil
+	}
+
+	res, err := c.repo.ListItemMedia(ctx, itemID)
+	if err != nil {
+		zap.L().Debug("failed to list item media", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	if bytes, err := json.Marshal(res); err == nil {
+		if err = c.cache.Set(ctx, consts.DefaultCacheTime, cacheKey, bytes); err != nil {
+			zap.L().Debug("failed to set to cache", zap.Error(err))
+		}
+	}
+
+	return res, nil
+}
+
+// UploadItemMedia stores the original file and its WebP variants, then records them on the item.
+// Stored files are removed again when any later step fails.
+func (c *Controller) UploadItemMedia(ctx context.Context, itemID uuid.UUID, req *model.MediaUpload) (*model.ItemMedia, error) {
+	const op = "media.UploadItemMedia.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	if c.storage == nil {
+		return nil, ErrStorageDisabled
+	}
+
+	m, stored, err := c.storeImage(ctx, fmt.Sprintf("items/%v", itemID), req.Data)
+	if err != nil {
+		zap.L().Debug("failed to store media", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+	m.Alt = req.Alt
+	m.IsPrimary = req.IsPrimary
+
+	res, err := c.repo.CreateItemMedia(ctx, itemID, m)
+	if err != nil {
+		c.removeStored(ctx, stored)
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		zap.L().Debug("failed to create item media", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	c.invalidateItemMedia(ctx, itemID)
+	c.audit(ctx, model.AuditItemMedia, model.AuditCreate, res.ID, nil, res)
+	return res, nil
+}
+
+func (c *Controller) UpdateItemMedia(ctx context.Context, itemID uuid.UUID, id uint64, req *model.ItemMedia) error {
+	const op = "media.UpdateItemMedia.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	err := c.repo.UpdateItemMediaByID(ctx, itemID, id, req)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to update item media", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	c.invalidateItemMedia(ctx, itemID)
+	c.audit(ctx, model.AuditItemMedia, model.AuditUpdate, id, nil, req)
+	return nil
+}
+
+func (c *Controller) ReorderItemMedia(ctx context.Context, itemID uuid.UUID, ids []uint64) error {
+	const op = "media.ReorderItemMedia.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	err := c.repo.ReorderItemMedia(ctx, itemID, ids)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to reorder item media", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	c.invalidateItemMedia(ctx, itemID)
+	c.audit(ctx, model.AuditItemMedia, model.AuditUpdate, itemID, nil, ids)
+	return nil
+}
+
+func (c *Controller) DeleteItemMedia(ctx context.Context, itemID uuid.UUID, id uint64) error {
+	const op = "media.DeleteItemMedia.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	err := c.repo.DeleteItemMedia(ctx, itemID, id)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to delete item media", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	c.invalidateItemMedia(ctx, itemID)
+	c.audit(ctx, model.AuditItemMedia, model.AuditDelete, id, nil, nil)
+	return nil
+}
+
+// CleanupOrphanMedia removes files of deleted media rows from the storage, it runs as a background job.
+// Keys are claimed before their files are removed, those that could not be removed are queued again.
+func (c *Controller) CleanupOrphanMedia(ctx context.Context) error {
+	const op = "media.CleanupOrphanMedia.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	if c.storage == nil {
+		return nil
+	}
+
+	keys, err := c.repo.ClaimOrphanMedia(ctx, consts.MediaCleanupBatch)
+	if err != nil {
+		zap.L().Debug("failed to claim orphan media", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	failed := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if err = c.storage.Delete(ctx, key); err != nil {
+			zap.L().Debug("failed to delete orphan media", zap.Error(err), zap.String("key", key))
+			failed = append(failed, key)
+		}
+	}
+
+	if len(failed) > 0 {
+		if err = c.repo.QueueOrphanMedia(ctx, failed); err != nil {
+			zap.L().Debug("failed to queue orphan media", zap.Error(err), zap.String("op", op))
+			return err
+		}
+	}
+
+	if len(keys) == len(failed) {
+		return nil
+	}
+
+	zap.L().Info("Removed orphan media", zap.Int("count", len(keys)-len(failed)))
+	return nil
+}
+
+// storeImage puts the original file and its WebP variants under dir, the result has the file fields set.
+// The keys of the stored files are returned so callers can remove them when a later step fails.
+func (c *Controller) storeImage(ctx context.Context, dir string, data []byte) (*model.ItemMedia, []string, error) {
+	contentType := media.DetectContentType(data)
+	ext, ok := media.Extensions[contentType]
+	if !ok {
+		return nil, nil, ErrInvalidMedia
+	}
+
+	img, err := media.Decode(data, consts.MaxImagePixels)
+	if err != nil && errors.Is(err, media.ErrTooManyPixels) {
+		return nil, nil, ErrImageTooLarge
+	} else if err != nil {
+		zap.L().Debug("failed to decode image", zap.Error(err))
+		return nil, nil, ErrInvalidMedia
+	}
+
+	variants, err := media.GenerateVariants(img)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b := make([]byte, mediaKeySize)
+	if _, err = rand.Read(b); err != nil {
+		return nil, nil, err
+	}
+	prefix := fmt.Sprintf("%v/%v", dir, hex.EncodeToString(b))
+
+	stored := make([]string, 0, len(variants)+1)
+	m := &model.ItemMedia{
+		StorageKey:  prefix + ext,
+		ContentType: contentType,
+		Size:        int64(len(data)),
+		Width:       img.Bounds().Dx(),
+		Height:      img.Bounds().Dy(),
+		Variants:    make([]model.MediaVariant, 0, len(variants)),
+	}
+
+	if m.Src, err = c.storage.Put(ctx, m.StorageKey, contentType, data); err != nil {
+		return nil, nil, err
+	}
+	stored = append(stored, m.StorageKey)
+
+	for _, v := range variants {
+		mv := model.MediaVariant{
+			Name:        v.Name,
+			StorageKey:  fmt.Sprintf("%v_%v.webp", prefix, v.Name),
+			ContentType: media.WebPType,
+			Width:       v.Width,
+			Height:      v.Height,
+		}
+
+		if mv.Src, err = c.storage.Put(ctx, mv.StorageKey, mv.ContentType, v.Data); err != nil {
+			c.removeStored(ctx, stored)
+			return nil, nil, err
+		}
+		stored = append(stored, mv.StorageKey)
+		m.Variants = append(m.Variants, mv)
+	}
+
+	return m, stored, nil
+}
+
+func (c *Controller) removeStored(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := c.storage.Delete(ctx, key); err != nil {
+			zap.L().Debug("failed to remove stored media", zap.Error(err), zap.String("key", key))
+		}
+	}
+}
+
+// invalidateItemMedia drops the media list and the item, whose src follows the primary media.
+func (c *Controller) invalidateItemMedia(ctx context.Context, itemID uuid.UUID) {
+	if err := c.cache.Delete(ctx, fmt.Sprintf(itemCacheKey, itemID)); err != nil {
+		zap.L().Debug("failed to delete from cache", zap.Error(err))
+	}
+
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateItemRelatedCachePattern)
+}
@@ -0,0 +1,204 @@
+package ctrl
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"hash/crc32"
+	"image"
+	"image/png"
+	"testing"
+)
+
+func testPNG(t *testing.T) []byte {
+	buf := &bytes.Buffer{}
+	require.NoError(t, png.Encode(buf, image.NewRGBA(image.Rect(0, 0, 4, 3))))
+	return buf.Bytes()
+}
+
+// hugePNG is a tiny PNG whose header declares 100000x100000 pixels.
+func hugePNG(t *testing.T) []byte {
+	data := testPNG(t)
+	// The IHDR chunk follows the 8 byte signature, its data starts after the length and type.
+	ihdr := data[16:29]
+	binary.BigEndian.PutUint32(ihdr[0:4], 100000)
+	binary.BigEndian.PutUint32(ihdr[4:8], 100000)
+	binary.BigEndian.PutUint32(data[29:33], crc32.ChecksumIEEE(data[12:29]))
+	return data
+}
+
+func TestController_UploadItemMedia(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	itemID := uuid.New()
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ss := mocks.NewMockStorage(mock)
+	ctrl := New(rr, cc, nil, ss)
+	expectAudit(rr)
+	data := testPNG(t)
+
+	tests := []struct {
+		name         string
+		req          *model.MediaUpload
+		mockExpect   func()
+		expectedResp func(*testing.T, *model.ItemMedia, error)
+	}{
+		{
+			name: "Success",
+			req:  &model.MediaUpload{Alt: "alt", Data: data},
+			mockExpect: func() {
+				ss.EXPECT().Put(gomock.Any(), gomock.Any(), "image/png", data).Return("/media/a.png", nil)
+				ss.EXPECT().Put(gomock.Any(), gomock.Any(), "image/webp", gomock.Any()).Return("/media/a.webp", nil).Times(3)
+				rr.EXPECT().CreateItemMedia(gomock.Any(), itemID, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ uuid.UUID, m *model.ItemMedia) (*model.ItemMedia, error) {
+						assert.Equal(t, "/media/a.png", m.Src)
+						assert.Equal(t, 4, m.Width)
+						assert.Equal(t, 3, m.Height)
+						assert.Len(t, m.Variants, 3)
+						m.ID = 1
+						return m, nil
+					},
+				)
+				cc.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil)
+				cc.EXPECT().InvalidateKeysByPattern(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectedResp: func(t *testing.T, res *model.ItemMedia, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, uint64(1), res.ID)
+			},
+		},
+		{
+			name: "Not an image",
+			req:  &model.MediaUpload{Data: []byte("plain text")},
+			mockExpect: func() {
+			},
+			expectedResp: func(t *testing.T, res *model.ItemMedia, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, ErrInvalidMedia, err)
+			},
+		},
+		{
+			name: "Too many pixels",
+			req:  &model.MediaUpload{Data: hugePNG(t)},
+			mockExpect: func() {
+			},
+			expectedResp: func(t *testing.T, res *model.ItemMedia, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, ErrImageTooLarge, err)
+			},
+		},
+		{
+			name: "Storage failure removes stored files",
+			req:  &model.MediaUpload{Data: data},
+			mockExpect: func() {
+				ss.EXPECT().Put(gomock.Any(), gomock.Any(), "image/png", data).Return("/media/a.png", nil)
+				ss.EXPECT().Put(gomock.Any(), gomock.Any(), "image/webp", gomock.Any()).Return("", errors.New("disk full"))
+				ss.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *model.ItemMedia, err error) {
+				assert.Nil(t, res)
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "Item not found",
+			req:  &model.MediaUpload{Data: data},
+			mockExpect: func() {
+				ss.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("/media/a", nil).Times(4)
+				rr.EXPECT().CreateItemMedia(gomock.Any(), itemID, gomock.Any()).Return(nil, repo.ErrNotFound)
+				ss.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil).Times(4)
+			},
+			expectedResp: func(t *testing.T, res *model.ItemMedia, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, ErrNotFound, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := ctrl.UploadItemMedia(context.Background(), itemID, tt.req)
+				tt.expectedResp(t, res, err)
+			},
+		)
+	}
+
+	t.Run(
+		"Storage disabled", func(t *testing.T) {
+			res, err := New(rr, cc, nil, nil).UploadItemMedia(context.Background(), itemID, &model.MediaUpload{Data: data})
+			assert.Nil(t, res)
+			assert.Equal(t, ErrStorageDisabled, err)
+		},
+	)
+}
+
+func TestController_CleanupOrphanMedia(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ss := mocks.NewMockStorage(mock)
+	ctrl := New(rr, cc, nil, ss)
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, error)
+	}{
+		{
+			name: "Files not removed are queued again",
+			mockExpect: func() {
+				rr.EXPECT().ClaimOrphanMedia(gomock.Any(), consts.MediaCleanupBatch).Return([]string{"a", "b"}, nil)
+				ss.EXPECT().Delete(gomock.Any(), "a").Return(nil)
+				ss.EXPECT().Delete(gomock.Any(), "b").Return(errors.New("timeout"))
+				rr.EXPECT().QueueOrphanMedia(gomock.Any(), []string{"b"}).Return(nil)
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "Nothing to clean",
+			mockExpect: func() {
+				rr.EXPECT().ClaimOrphanMedia(gomock.Any(), consts.MediaCleanupBatch).Return([]string{}, nil)
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "All removed",
+			mockExpect: func() {
+				rr.EXPECT().ClaimOrphanMedia(gomock.Any(), consts.MediaCleanupBatch).Return([]string{"a"}, nil)
+				ss.EXPECT().Delete(gomock.Any(), "a").Return(nil)
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				err := ctrl.CleanupOrphanMedia(context.Background())
+				tt.expectedResp(t, err)
+			},
+		)
+	}
+}
@@ -23,7 +23,9 @@ type orderRepo interface {
 	GetOrder(ctx context.Context, orderID uint64) (*model.Order, error)
 	CreateOrder(ctx context.Context, uid uuid.UUID, req *model.Order) (uint64, error)
 	UpdateOrder(ctx context.Context, orderID uint64, newData *model.Order) error
+	PatchOrder(ctx context.Context, orderID uint64, newData *model.Order, fields []string) error
 	CancelOrder(ctx context.Context, orderID uint64) error
+	DeleteOrder(ctx context.Context, orderID uint64) error
 }
 
 func (c *Controller) ListOrders(ctx context.Context, page, size int, filters map[string]any, sort string) (*model.PaginatedOrderData, error) {
@@ -104,16 +106,28 @@ func (c *Controller) CreateOrder(ctx context.Context, uid uuid.UUID, o *model.Or
 	ctx = opentracing.ContextWithSpan(ctx, span)
 	defer span.Finish()
 
+	o.PriceListID, o.CustomerGroupID = 0, 0
+	if pl := PriceListFromContext(ctx); pl != nil {
+		o.PriceListID = pl.ID
+	}
+	if g := CustomerGroupFromContext(ctx); g != nil {
+		o.CustomerGroupID = g.ID
+	}
+
 	res, err := c.repo.CreateOrder(ctx, uid, o)
 	if err != nil && errors.Is(err, repo.ErrNotFound) {
 		zap.L().Debug("Error create order", zap.Error(err), zap.String("op", op))
 		return 0, ErrNotFound
+	} else if err != nil && errors.Is(err, model.ErrCurrencyMismatch) {
+		zap.L().Debug("Error create order", zap.Error(err), zap.String("op", op))
+		return 0, ErrPriceUnavailable
 	} else if err != nil {
 		zap.L().Debug("Error create order", zap.Error(err), zap.String("op", op))
 		return 0, err
 	}
 
 	go c.cache.InvalidateKeysByPattern(ctx, invalidateOrderRelatedCachePattern)
+	c.audit(ctx, model.AuditOrder, model.AuditCreate, res, nil, o)
 	return res, nil
 }
 
@@ -123,9 +137,15 @@ func (c *Controller) UpdateOrder(ctx context.Context, orderID uint64, newData *m
 	ctx = opentracing.ContextWithSpan(ctx, span)
 	defer span.Finish()
 
+	prev := snapshot(ctx, c.repo.GetOrder, orderID)
 	err := c.repo.UpdateOrder(ctx, orderID, newData)
 	if err != nil && errors.Is(err, repo.ErrNotFound) {
 		return ErrNotFound
+	} else if err != nil && errors.Is(err, repo.ErrVersionConflict) {
+		return ErrVersionConflict
+	} else if err != nil && errors.Is(err, model.ErrCurrencyMismatch) {
+		zap.L().Debug("Error update order", zap.Error(err), zap.String("op", op))
+		return ErrPriceUnavailable
 	} else if err != nil {
 		zap.L().Debug("Error update order", zap.Error(err), zap.String("op", op))
 		return err
@@ -136,6 +156,37 @@ func (c *Controller) UpdateOrder(ctx context.Context, orderID uint64, newData *m
 	}
 
 	go c.cache.InvalidateKeysByPattern(ctx, invalidateOrderRelatedCachePattern)
+	c.audit(ctx, model.AuditOrder, model.AuditUpdate, orderID, prev, snapshot(ctx, c.repo.GetOrder, orderID))
+	return nil
+}
+
+// PatchOrder updates only the fields of newData named in fields.
+func (c *Controller) PatchOrder(ctx context.Context, orderID uint64, newData *model.Order, fields []string) error {
+	const op = "orders.PatchOrder.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	prev := snapshot(ctx, c.repo.GetOrder, orderID)
+	err := c.repo.PatchOrder(ctx, orderID, newData, fields)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return ErrNotFound
+	} else if err != nil && errors.Is(err, repo.ErrVersionConflict) {
+		return ErrVersionConflict
+	} else if err != nil && errors.Is(err, model.ErrCurrencyMismatch) {
+		zap.L().Debug("Error patch order", zap.Error(err), zap.String("op", op))
+		return ErrPriceUnavailable
+	} else if err != nil {
+		zap.L().Debug("Error patch order", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	if err = c.cache.Delete(ctx, fmt.Sprintf(orderCacheKey, orderID)); err != nil {
+		zap.L().Debug("failed to delete from cache", zap.Error(err), zap.String("op", op))
+	}
+
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateOrderRelatedCachePattern)
+	c.audit(ctx, model.AuditOrder, model.AuditUpdate, orderID, prev, snapshot(ctx, c.repo.GetOrder, orderID))
 	return nil
 }
 
@@ -145,6 +196,7 @@ func (c *Controller) CancelOrder(ctx context.Context, orderID uint64) error {
 	ctx = opentracing.ContextWithSpan(ctx, span)
 	defer span.Finish()
 
+	prev := snapshot(ctx, c.repo.GetOrder, orderID)
 	err := c.repo.CancelOrder(ctx, orderID)
 	if err != nil && errors.Is(err, repo.ErrNotFound) {
 		return ErrNotFound
@@ -158,5 +210,31 @@ func (c *Controller) CancelOrder(ctx context.Context, orderID uint64) error {
 	}
 
 	go c.cache.InvalidateKeysByPattern(ctx, invalidateOrderRelatedCachePattern)
+	c.audit(ctx, model.AuditOrder, model.AuditCancel, orderID, prev, snapshot(ctx, c.repo.GetOrder, orderID))
+	return nil
+}
+
+// DeleteOrder moves the order to the trash, it can be restored from there until purged.
+func (c *Controller) DeleteOrder(ctx context.Context, orderID uint64) error {
+	const op = "orders.DeleteOrder.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	prev := snapshot(ctx, c.repo.GetOrder, orderID)
+	err := c.repo.DeleteOrder(ctx, orderID)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("Error delete order", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	if err = c.cache.Delete(ctx, fmt.Sprintf(orderCacheKey, orderID)); err != nil {
+		zap.L().Debug("failed to delete from cache", zap.Error(err), zap.String("op", op))
+	}
+
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateOrderRelatedCachePattern)
+	c.audit(ctx, model.AuditOrder, model.AuditDelete, orderID, prev, nil)
 	return nil
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"testing"
+	"time"
 )
 
 func TestController_ListOrders(t *testing.T) {
@@ -20,7 +21,7 @@ func TestController_ListOrders(t *testing.T) {
 	defer mock.Finish()
 
 	rr := mocks.NewMockAppRepo(mock)
-	ctrl := New(rr, nil)
+	ctrl := New(rr, nil, nil, nil)
 
 	tests := []struct {
 		name         string
@@ -91,7 +92,7 @@ func TestController_ListUserOrders(t *testing.T) {
 
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
 
 	uid := uuid.New()
 	expRes := &model.PaginatedOrderData{
@@ -225,7 +226,7 @@ func TestController_GetOrder(t *testing.T) {
 
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
 
 	orderID := uint64(12345)
 	expRes := &model.Order{
@@ -339,10 +340,12 @@ func TestController_CreateOrder(t *testing.T) {
 
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
+	expectAudit(rr)
 
 	uid := uuid.New()
 	order := &model.Order{}
+	invalidated := make(chan struct{}, 1)
 
 	tests := []struct {
 		name         string
@@ -360,11 +363,21 @@ func TestController_CreateOrder(t *testing.T) {
 				cc.EXPECT().InvalidateKeysByPattern(
 					gomock.Any(),
 					invalidateOrderRelatedCachePattern,
-				).Return(nil).Times(1)
+				).DoAndReturn(
+					func(context.Context, string) error {
+						invalidated <- struct{}{}
+						return nil
+					},
+				).Times(1)
 			},
 			expectedResp: func(t *testing.T, res uint64, err error) {
 				require.NoError(t, err)
 				assert.Equal(t, uint64(12345), res)
+				select {
+				case <-invalidated:
+				case <-time.After(time.Second):
+					t.Error("cache was not invalidated")
+				}
 			},
 		},
 		{
@@ -416,7 +429,9 @@ func TestController_UpdateOrder(t *testing.T) {
 
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
+	expectAudit(rr)
+	rr.EXPECT().GetOrder(gomock.Any(), gomock.Any()).Return(nil, repo.ErrNotFound).AnyTimes()
 
 	orderID := uint64(12345)
 	ctx := context.Background()
@@ -459,6 +474,17 @@ func TestController_UpdateOrder(t *testing.T) {
 				assert.Equal(t, ErrNotFound, err)
 			},
 		},
+		{
+			name:    "Version Conflict",
+			orderID: orderID,
+			newData: newData,
+			mockExpect: func() {
+				rr.EXPECT().UpdateOrder(gomock.Any(), orderID, newData).Return(repo.ErrVersionConflict).Times(1)
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, ErrVersionConflict)
+			},
+		},
 		{
 			name:    "Repo Internal Error",
 			orderID: orderID,
@@ -510,7 +536,9 @@ func TestController_CancelOrder(t *testing.T) {
 
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
+	expectAudit(rr)
+	rr.EXPECT().GetOrder(gomock.Any(), gomock.Any()).Return(nil, repo.ErrNotFound).AnyTimes()
 
 	orderID := uint64(12345)
 	ctx := context.Background()
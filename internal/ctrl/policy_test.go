@@ -0,0 +1,50 @@
+package ctrl
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestResolvePermissions(t *testing.T) {
+	tests := []struct {
+		name     string
+		names    []string
+		allowed  []Permission
+		rejected []Permission
+	}{
+		{
+			name:     "Customer",
+			names:    nil,
+			rejected: []Permission{PermCatalogManage, PermOrdersRead},
+		},
+		{
+			name:     "ManagerRole",
+			names:    []string{"role:manager"},
+			allowed:  []Permission{PermCatalogManage, PermPricingManage, PermOrdersManage},
+			rejected: []Permission{PermContentModerate, PermTrashManage},
+		},
+		{
+			name:     "DirectPermission",
+			names:    []string{"content:moderate", "unknown:permission", "role:unknown"},
+			allowed:  []Permission{PermContentModerate},
+			rejected: []Permission{PermCatalogManage, Permission("unknown:permission")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				ctx := WithPermissions(context.Background(), ResolvePermissions(tt.names))
+				for _, p := range tt.allowed {
+					assert.Nil(t, Authorize(ctx, p))
+				}
+				for _, p := range tt.rejected {
+					assert.ErrorIs(t, Authorize(ctx, p), ErrForbidden)
+				}
+			},
+		)
+	}
+
+	assert.ErrorIs(t, Authorize(context.Background(), PermOrdersRead), ErrForbidden)
+}
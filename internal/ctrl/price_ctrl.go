@@ -0,0 +1,160 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"time"
+)
+
+const priceTimelineCacheKey = "items-prices:%v"
+const invalidatePriceTimelineCachePattern = "items-prices:*"
+
+type priceRepo interface {
+	GetPriceTimeline(ctx context.Context, uid uuid.UUID) (*model.PriceTimeline, error)
+	CreateScheduledPrice(ctx context.Context, sp *model.ScheduledPrice) (uint64, error)
+	DeleteScheduledPrice(ctx context.Context, id uint64) error
+	ListDueScheduledItems(ctx context.Context, now time.Time) ([]uuid.UUID, error)
+	ApplyScheduledPrices(ctx context.Context, now time.Time) ([]uuid.UUID, error)
+}
+
+func (c *Controller) GetPriceTimeline(ctx context.Context, uid uuid.UUID) (*model.PriceTimeline, error) {
+	const op = "prices.GetPriceTimeline.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	cached := &model.PriceTimeline{}
+	cacheKey := fmt.Sprintf(priceTimelineCacheKey, uid)
+	if err := c.cache.GetToStruct(ctx, cacheKey, cached); err == nil {
+		return cached, nil
+	}
+
+	res, err := c.repo.GetPriceTimeline(ctx, uid)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find item", zap.Error(err), zap.String("op", op))
+		return nil, ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to get price timeline", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+	res.LowestPrice30d = lowestPriceSince(res, time.Now().Add(-consts.LowestPriceWindow))
+
+	if bytes, err := json.Marshal(res); err == nil {
+		if err = c.cache.Set(ctx, consts.DefaultCacheTime, cacheKey, bytes); err != nil {
+			zap.L().Debug("failed to set to cache", zap.Error(err), zap.String("op", op))
+		}
+	}
+
+	return res, nil
+}
+
+func (c *Controller) SchedulePriceChange(ctx context.Context, sp *model.ScheduledPrice) (uint64, error) {
+	const op = "prices.SchedulePriceChange.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	sp.Price.Normalize()
+	res, err := c.repo.CreateScheduledPrice(ctx, sp)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find item", zap.Error(err), zap.String("op", op))
+		return 0, ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to schedule price change", zap.Error(err), zap.String("op", op))
+		return 0, err
+	}
+
+	if err = c.cache.Delete(ctx, fmt.Sprintf(priceTimelineCacheKey, sp.ItemID)); err != nil {
+		zap.L().Debug("failed to delete from cache", zap.Error(err), zap.String("op", op))
+	}
+
+	c.audit(ctx, model.AuditScheduledPrice, model.AuditCreate, res, nil, sp)
+	return res, nil
+}
+
+func (c *Controller) CancelScheduledPrice(ctx context.Context, id uint64) error {
+	const op = "prices.CancelScheduledPrice.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	err := c.repo.DeleteScheduledPrice(ctx, id)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find scheduled price", zap.Error(err), zap.String("op", op))
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to cancel scheduled price", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	go c.cache.InvalidateKeysByPattern(ctx, invalidatePriceTimelineCachePattern)
+	c.audit(ctx, model.AuditScheduledPrice, model.AuditCancel, id, nil, nil)
+	return nil
+}
+
+// ApplyScheduledPrices is run periodically by the price worker.
+func (c *Controller) ApplyScheduledPrices(ctx context.Context) error {
+	const op = "prices.ApplyScheduledPrices.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	now := time.Now()
+	due, err := c.repo.ListDueScheduledItems(ctx, now)
+	if err != nil {
+		zap.L().Debug("failed to list due scheduled prices", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	before := c.itemStates(ctx, due)
+	items, err := c.repo.ApplyScheduledPrices(ctx, now)
+	if err != nil {
+		zap.L().Debug("failed to apply scheduled prices", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	for _, uid := range items {
+		if err = c.cache.Delete(ctx, fmt.Sprintf(itemCacheKey, uid)); err != nil {
+			zap.L().Debug("failed to delete from cache", zap.Error(err), zap.String("op", op))
+		}
+		c.audit(ctx, model.AuditItem, model.AuditApply, uid, nil, nil)
+	}
+
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateItemRelatedCachePattern)
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateFavoriteCachePattern)
+	c.notifyFavorites(ctx, before)
+	return nil
+}
+
+// lowestPriceSince returns the lowest price the item had at any point after since.
+// History is expected newest first, as returned by the repo. Prices in another currency are not comparable and are skipped.
+func lowestPriceSince(t *model.PriceTimeline, since time.Time) model.Money {
+	lowest := t.CurrentPrice
+	for _, h := range t.History {
+		if h.NewPrice.Currency == lowest.Currency && h.NewPrice.Less(lowest) {
+			lowest = h.NewPrice
+		}
+
+		// The first change before the window is the price that was in effect when it opened
+		if h.CreatedAt.Before(since) {
+			break
+		}
+
+		if h.OldPrice.IsPositive() && h.OldPrice.Currency == lowest.Currency && h.OldPrice.Less(lowest) {
+			lowest = h.OldPrice
+		}
+	}
+	return lowest
+}
@@ -0,0 +1,381 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"time"
+)
+
+func TestController_GetPriceTimeline(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	uid := uuid.New()
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+
+	now := time.Now()
+	timeline := &model.PriceTimeline{
+		ItemID:       uid,
+		CurrentPrice: rub(8000),
+		History: []*model.PriceHistory{
+			{OldPrice: rub(10000), NewPrice: rub(8000), CreatedAt: now.Add(-time.Hour)},
+			{OldPrice: rub(6000), NewPrice: rub(10000), CreatedAt: now.Add(-10 * 24 * time.Hour)},
+			{OldPrice: rub(0), NewPrice: rub(6000), CreatedAt: now.Add(-60 * 24 * time.Hour)},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, *model.PriceTimeline, error)
+	}{
+		{
+			name: "CacheHit",
+			mockExpect: func() {
+				cc.EXPECT().GetToStruct(
+					gomock.Any(),
+					fmt.Sprintf(priceTimelineCacheKey, uid),
+					gomock.Any(),
+				).Return(nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *model.PriceTimeline, err error) {
+				require.NoError(t, err)
+				assert.NotNil(t, res)
+			},
+		},
+		{
+			name: "RepoNotFound",
+			mockExpect: func() {
+				cc.EXPECT().GetToStruct(
+					gomock.Any(),
+					fmt.Sprintf(priceTimelineCacheKey, uid),
+					gomock.Any(),
+				).Return(errors.New("cache miss")).Times(1)
+				rr.EXPECT().GetPriceTimeline(gomock.Any(), uid).Return(nil, repo.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *model.PriceTimeline, err error) {
+				assert.Equal(t, ErrNotFound, err)
+				assert.Nil(t, res)
+			},
+		},
+		{
+			name: "RepoInternalError",
+			mockExpect: func() {
+				cc.EXPECT().GetToStruct(
+					gomock.Any(),
+					fmt.Sprintf(priceTimelineCacheKey, uid),
+					gomock.Any(),
+				).Return(errors.New("cache miss")).Times(1)
+				rr.EXPECT().GetPriceTimeline(gomock.Any(), uid).Return(nil, errors.New("internal error")).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *model.PriceTimeline, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, res)
+			},
+		},
+		{
+			name: "RepoSuccess",
+			mockExpect: func() {
+				cc.EXPECT().GetToStruct(
+					gomock.Any(),
+					fmt.Sprintf(priceTimelineCacheKey, uid),
+					gomock.Any(),
+				).Return(errors.New("cache miss")).Times(1)
+				rr.EXPECT().GetPriceTimeline(gomock.Any(), uid).Return(timeline, nil).Times(1)
+				cc.EXPECT().Set(
+					gomock.Any(),
+					consts.DefaultCacheTime,
+					fmt.Sprintf(priceTimelineCacheKey, uid),
+					gomock.Any(),
+				).Return(nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *model.PriceTimeline, err error) {
+				require.NoError(t, err)
+				// 60 was in effect when the 30-day window opened
+				assert.Equal(t, rub(6000), res.LowestPrice30d)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := ctrl.GetPriceTimeline(context.Background(), uid)
+				tt.expectedResp(t, res, err)
+			},
+		)
+	}
+}
+
+func TestLowestPriceSince(t *testing.T) {
+	now := time.Now()
+	since := now.Add(-consts.LowestPriceWindow)
+
+	tests := []struct {
+		name     string
+		timeline *model.PriceTimeline
+		expected model.Money
+	}{
+		{
+			name:     "No history",
+			timeline: &model.PriceTimeline{CurrentPrice: rub(5000)},
+			expected: rub(5000),
+		},
+		{
+			name: "Drop inside window",
+			timeline: &model.PriceTimeline{
+				CurrentPrice: rub(10000),
+				History: []*model.PriceHistory{
+					{OldPrice: rub(7000), NewPrice: rub(10000), CreatedAt: now.Add(-time.Hour)},
+					{OldPrice: rub(10000), NewPrice: rub(7000), CreatedAt: now.Add(-5 * 24 * time.Hour)},
+					{NewPrice: rub(10000), CreatedAt: now.Add(-90 * 24 * time.Hour)},
+				},
+			},
+			expected: rub(7000),
+		},
+		{
+			name: "Older drops are ignored",
+			timeline: &model.PriceTimeline{
+				CurrentPrice: rub(9000),
+				History: []*model.PriceHistory{
+					{OldPrice: rub(10000), NewPrice: rub(9000), CreatedAt: now.Add(-time.Hour)},
+					{OldPrice: rub(1000), NewPrice: rub(10000), CreatedAt: now.Add(-40 * 24 * time.Hour)},
+					{NewPrice: rub(1000), CreatedAt: now.Add(-90 * 24 * time.Hour)},
+				},
+			},
+			expected: rub(9000),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.expected, lowestPriceSince(tt.timeline, since))
+			},
+		)
+	}
+}
+
+func TestController_SchedulePriceChange(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+	expectAudit(rr)
+
+	sp := &model.ScheduledPrice{
+		ItemID:   uuid.New(),
+		Price:    rub(9000),
+		StartsAt: time.Now().Add(time.Hour),
+	}
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, uint64, error)
+	}{
+		{
+			name: "RepoSuccess",
+			mockExpect: func() {
+				rr.EXPECT().CreateScheduledPrice(gomock.Any(), sp).Return(uint64(1), nil).Times(1)
+				cc.EXPECT().Delete(gomock.Any(), fmt.Sprintf(priceTimelineCacheKey, sp.ItemID)).Return(nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res uint64, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, uint64(1), res)
+			},
+		},
+		{
+			name: "RepoNotFound",
+			mockExpect: func() {
+				rr.EXPECT().CreateScheduledPrice(gomock.Any(), sp).Return(uint64(0), repo.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, res uint64, err error) {
+				assert.Equal(t, ErrNotFound, err)
+				assert.Zero(t, res)
+			},
+		},
+		{
+			name: "RepoInternalError",
+			mockExpect: func() {
+				rr.EXPECT().CreateScheduledPrice(gomock.Any(), sp).Return(uint64(0), errors.New("internal error")).Times(1)
+			},
+			expectedResp: func(t *testing.T, res uint64, err error) {
+				assert.Error(t, err)
+				assert.Zero(t, res)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := ctrl.SchedulePriceChange(context.Background(), sp)
+				tt.expectedResp(t, res, err)
+			},
+		)
+	}
+}
+
+func TestController_CancelScheduledPrice(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+	expectAudit(rr)
+
+	const id = uint64(1)
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, error)
+	}{
+		{
+			name: "RepoSuccess",
+			mockExpect: func() {
+				rr.EXPECT().DeleteScheduledPrice(gomock.Any(), id).Return(nil).Times(1)
+				cc.EXPECT().InvalidateKeysByPattern(
+					gomock.Any(),
+					invalidatePriceTimelineCachePattern,
+				).Return(nil).AnyTimes()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "RepoNotFound",
+			mockExpect: func() {
+				rr.EXPECT().DeleteScheduledPrice(gomock.Any(), id).Return(repo.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.Equal(t, ErrNotFound, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				tt.expectedResp(t, ctrl.CancelScheduledPrice(context.Background(), id))
+			},
+		)
+	}
+}
+
+func TestController_ApplyScheduledPrices(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	nn := mocks.NewMockNotifier(mock)
+	ctrl := New(rr, cc, nn, nil)
+	expectAudit(rr)
+
+	itemID := uuid.New()
+	subscriber := uuid.New()
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, error)
+	}{
+		{
+			name: "Applied",
+			mockExpect: func() {
+				gomock.InOrder(
+					rr.EXPECT().ListDueScheduledItems(gomock.Any(), gomock.Any()).Return([]uuid.UUID{itemID}, nil).Times(1),
+					rr.EXPECT().GetItemStates(gomock.Any(), []uuid.UUID{itemID}).
+						Return(map[uuid.UUID]model.ItemState{itemID: {Price: rub(2000), InStock: true}}, nil).Times(1),
+					rr.EXPECT().ApplyScheduledPrices(gomock.Any(), gomock.Any()).Return([]uuid.UUID{itemID}, nil).Times(1),
+					rr.EXPECT().GetItemStates(gomock.Any(), []uuid.UUID{itemID}).
+						Return(map[uuid.UUID]model.ItemState{itemID: {Price: rub(1500), InStock: true}}, nil).Times(1),
+				)
+				cc.EXPECT().Delete(gomock.Any(), fmt.Sprintf(itemCacheKey, itemID)).Return(nil).Times(1)
+				cc.EXPECT().InvalidateKeysByPattern(
+					gomock.Any(),
+					invalidateItemRelatedCachePattern,
+				).Return(nil).AnyTimes()
+				cc.EXPECT().InvalidateKeysByPattern(
+					gomock.Any(),
+					invalidateFavoriteCachePattern,
+				).Return(nil).AnyTimes()
+				rr.EXPECT().ListFavoriteSubscribers(gomock.Any(), itemID, model.FavoriteEventPriceDrop).
+					Return([]uuid.UUID{subscriber}, nil).Times(1)
+				nn.EXPECT().Notify(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, e *model.FavoriteEvent) error {
+						assert.Equal(t, model.FavoriteEventPriceDrop, e.Type)
+						assert.Equal(t, rub(1500), e.NewPrice)
+						return nil
+					},
+				).Times(1)
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "Nothing due",
+			mockExpect: func() {
+				rr.EXPECT().ListDueScheduledItems(gomock.Any(), gomock.Any()).Return([]uuid.UUID{}, nil).Times(1)
+				rr.EXPECT().ApplyScheduledPrices(gomock.Any(), gomock.Any()).Return([]uuid.UUID{}, nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "ListError",
+			mockExpect: func() {
+				rr.EXPECT().ListDueScheduledItems(gomock.Any(), gomock.Any()).Return(nil, errors.New("internal error")).Times(1)
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "RepoError",
+			mockExpect: func() {
+				rr.EXPECT().ListDueScheduledItems(gomock.Any(), gomock.Any()).Return([]uuid.UUID{}, nil).Times(1)
+				rr.EXPECT().ApplyScheduledPrices(gomock.Any(), gomock.Any()).Return(nil, errors.New("internal error")).Times(1)
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.Error(t, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				tt.expectedResp(t, ctrl.ApplyScheduledPrices(context.Background()))
+			},
+		)
+	}
+}
+
+func rub(amount int64) model.Money {
+	return model.NewMoney(amount, model.DefaultCurrency)
+}
@@ -0,0 +1,294 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/JMURv/par-pro/products/pkg/utils/slugify"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"strings"
+)
+
+const priceListCacheKey = "price-list:%v"
+const priceListsCacheKey = "price-lists"
+const invalidatePriceListCachePattern = "price-list*"
+
+type priceListRepo interface {
+	ListPriceLists(ctx context.Context) ([]*model.PriceList, error)
+	GetPriceList(ctx context.Context, slug string) (*model.PriceList, error)
+	CreatePriceList(ctx context.Context, pl *model.PriceList) (string, error)
+	UpdatePriceList(ctx context.Context, slug string, pl *model.PriceList) error
+	DeletePriceList(ctx context.Context, slug string) error
+
+	ListPriceListItems(ctx context.Context, slug string, page, size int) (*model.PaginatedPriceListItemsData, error)
+	SetPriceListItems(ctx context.Context, slug string, items []*model.PriceListItem) error
+	DeletePriceListItem(ctx context.Context, slug string, itemID uuid.UUID) error
+	GetPriceListPrices(ctx context.Context, listID uint64, items []uuid.UUID) (map[uuid.UUID]int64, error)
+}
+
+type priceListCtxKey struct{}
+
+// WithPriceList selects the price list used for every price the request returns or charges.
+func WithPriceList(ctx context.Context, pl *model.PriceList) context.Context {
+	return context.WithValue(ctx, priceListCtxKey{}, pl)
+}
+
+// PriceListFromContext returns the price list selected for the request, nil means base prices.
+func PriceListFromContext(ctx context.Context) *model.PriceList {
+	pl, _ := ctx.Value(priceListCtxKey{}).(*model.PriceList)
+	return pl
+}
+
+func (c *Controller) ListPriceLists(ctx context.Context) ([]*model.PriceList, error) {
+	const op = "priceLists.ListPriceLists.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	cached := make([]*model.PriceList, 0, 5)
+	if err := c.cache.GetToStruct(ctx, priceListsCacheKey, &cached); err == nil {
+		return cached, nil
+	}
+
+	res, err := c.repo.ListPriceLists(ctx)
+	if err != nil {
+		zap.L().Debug("failed to list price lists", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	if bytes, err := json.Marshal(res); err == nil {
+		if err = c.cache.Set(ctx, consts.DefaultCacheTime, priceListsCacheKey, bytes); err != nil {
+			zap.L().Debug("failed to set to cache", zap.Error(err), zap.String("op", op))
+		}
+	}
+
+	return res, nil
+}
+
+func (c *Controller) GetPriceList(ctx context.Context, slug string) (*model.PriceList, error) {
+	const op = "priceLists.GetPriceList.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	cached := &model.PriceList{}
+	cacheKey := fmt.Sprintf(priceListCacheKey, slug)
+	if err := c.cache.GetToStruct(ctx, cacheKey, cached); err == nil {
+		return cached, nil
+	}
+
+	res, err := c.repo.GetPriceList(ctx, slug)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find price list", zap.Error(err), zap.String("op", op))
+		return nil, ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to get price list", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	if bytes, err := json.Marshal(res); err == nil {
+		if err = c.cache.Set(ctx, consts.DefaultCacheTime, cacheKey, bytes); err != nil {
+			zap.L().Debug("failed to set to cache", zap.Error(err), zap.String("op", op))
+		}
+	}
+
+	return res, nil
+}
+
+func (c *Controller) CreatePriceList(ctx context.Context, pl *model.PriceList) (string, error) {
+	const op = "priceLists.CreatePriceList.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	if pl.Slug == "" {
+		pl.Slug = slugify.Slugify(pl.Name)
+	}
+	normalizePriceList(pl)
+
+	slug, err := c.repo.CreatePriceList(ctx, pl)
+	if err != nil && errors.Is(err, repo.ErrAlreadyExists) {
+		zap.L().Debug("price list already exists", zap.Error(err), zap.String("op", op))
+		return "", ErrAlreadyExists
+	} else if err != nil {
+		zap.L().Debug("failed to create price list", zap.Error(err), zap.String("op", op))
+		return "", err
+	}
+
+	go c.cache.InvalidateKeysByPattern(ctx, invalidatePriceListCachePattern)
+	c.audit(ctx, model.AuditPriceList, model.AuditCreate, slug, nil, pl)
+	return slug, nil
+}
+
+func (c *Controller) UpdatePriceList(ctx context.Context, slug string, pl *model.PriceList) error {
+	const op = "priceLists.UpdatePriceList.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	normalizePriceList(pl)
+	prev := snapshot(ctx, c.repo.GetPriceList, slug)
+	err := c.repo.UpdatePriceList(ctx, slug, pl)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find price list", zap.Error(err), zap.String("op", op))
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to update price list", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	if err = c.cache.Delete(ctx, fmt.Sprintf(priceListCacheKey, slug)); err != nil {
+		zap.L().Debug("failed to delete from cache", zap.Error(err), zap.String("op", op))
+	}
+
+	go c.cache.InvalidateKeysByPattern(ctx, invalidatePriceListCachePattern)
+	c.audit(ctx, model.AuditPriceList, model.AuditUpdate, slug, prev, snapshot(ctx, c.repo.GetPriceList, slug))
+	return nil
+}
+
+func (c *Controller) DeletePriceList(ctx context.Context, slug string) error {
+	const op = "priceLists.DeletePriceList.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	prev := snapshot(ctx, c.repo.GetPriceList, slug)
+	err := c.repo.DeletePriceList(ctx, slug)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find price list", zap.Error(err), zap.String("op", op))
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to delete price list", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	if err = c.cache.Delete(ctx, fmt.Sprintf(priceListCacheKey, slug)); err != nil {
+		zap.L().Debug("failed to delete from cache", zap.Error(err), zap.String("op", op))
+	}
+
+	go c.cache.InvalidateKeysByPattern(ctx, invalidatePriceListCachePattern)
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateCustomerGroupCachePattern)
+	c.audit(ctx, model.AuditPriceList, model.AuditDelete, slug, prev, nil)
+	return nil
+}
+
+func (c *Controller) ListPriceListItems(ctx context.Context, slug string, page, size int) (*model.PaginatedPriceListItemsData, error) {
+	const op = "priceLists.ListPriceListItems.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	res, err := c.repo.ListPriceListItems(ctx, slug, page, size)
+	if err != nil {
+		zap.L().Debug("failed to list price list items", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *Controller) SetPriceListItems(ctx context.Context, slug string, items []*model.PriceListItem) error {
+	const op = "priceLists.SetPriceListItems.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	for _, v := range items {
+		v.Price.Currency = strings.ToUpper(strings.TrimSpace(v.Price.Currency))
+	}
+
+	err := c.repo.SetPriceListItems(ctx, slug, items)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find price list or item", zap.Error(err), zap.String("op", op))
+		return ErrNotFound
+	} else if err != nil && errors.Is(err, model.ErrCurrencyMismatch) {
+		zap.L().Debug("price is not in the list currency", zap.Error(err), zap.String("op", op))
+		return ErrPriceUnavailable
+	} else if err != nil {
+		zap.L().Debug("failed to set price list items", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	c.audit(ctx, model.AuditPriceListItems, model.AuditUpdate, slug, nil, items)
+	return nil
+}
+
+func (c *Controller) DeletePriceListItem(ctx context.Context, slug string, itemID uuid.UUID) error {
+	const op = "priceLists.DeletePriceListItem.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	err := c.repo.DeletePriceListItem(ctx, slug, itemID)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find price list item", zap.Error(err), zap.String("op", op))
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to delete price list item", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	c.audit(ctx, model.AuditPriceListItems, model.AuditDelete, slug, map[string]any{"item_id": itemID}, nil)
+	return nil
+}
+
+// applyPricing reprices items, their variants included, for the caller: in the price list selected for the request
+// and with the quantity breaks of their customer group.
+// Cached data always holds base prices, so this runs after the cache has been read or filled.
+func (c *Controller) applyPricing(ctx context.Context, items ...*model.Item) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	all := make([]*model.Item, 0, len(items))
+	for _, i := range items {
+		all = append(all, i)
+		for idx := range i.Variants {
+			all = append(all, &i.Variants[idx])
+		}
+	}
+
+	if pl := PriceListFromContext(ctx); pl != nil {
+		ids := make([]uuid.UUID, 0, len(all))
+		for _, i := range all {
+			ids = append(ids, i.ID)
+		}
+
+		prices, err := c.repo.GetPriceListPrices(ctx, pl.ID, ids)
+		if err != nil {
+			return err
+		}
+
+		for _, i := range all {
+			var explicit *int64
+			if price, ok := prices[i.ID]; ok {
+				explicit = &price
+			}
+			i.Price = pl.Price(i.Price, explicit)
+		}
+	}
+
+	return c.applyQuantityBreaks(ctx, all)
+}
+
+func normalizePriceList(pl *model.PriceList) {
+	pl.Currency = strings.ToUpper(strings.TrimSpace(pl.Currency))
+	pl.BaseCurrency = strings.ToUpper(strings.TrimSpace(pl.BaseCurrency))
+	if pl.BaseCurrency == "" {
+		pl.BaseCurrency = model.DefaultCurrency
+	}
+
+	if pl.Rounding == "" {
+		pl.Rounding = model.RoundingHalfUp
+	}
+
+	if pl.RoundingStep <= 0 {
+		pl.RoundingStep = 1
+	}
+}
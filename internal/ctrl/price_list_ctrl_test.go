@@ -0,0 +1,286 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+func TestController_GetPriceList(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+
+	ctx := context.Background()
+	key := fmt.Sprintf(priceListCacheKey, "kz")
+	pl := &model.PriceList{ID: 1, Slug: "kz", Currency: "KZT", BaseCurrency: "RUB", Rate: 5.47}
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, *model.PriceList, error)
+	}{
+		{
+			name: "CacheHit",
+			mockExpect: func() {
+				cc.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).Return(nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *model.PriceList, err error) {
+				require.NoError(t, err)
+				assert.NotNil(t, res)
+			},
+		},
+		{
+			name: "RepoNotFound",
+			mockExpect: func() {
+				cc.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).Return(errors.New("cache miss")).Times(1)
+				rr.EXPECT().GetPriceList(gomock.Any(), "kz").Return(nil, repo.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *model.PriceList, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, ErrNotFound, err)
+			},
+		},
+		{
+			name: "RepoSuccess",
+			mockExpect: func() {
+				cc.EXPECT().GetToStruct(gomock.Any(), key, gomock.Any()).Return(errors.New("cache miss")).Times(1)
+				rr.EXPECT().GetPriceList(gomock.Any(), "kz").Return(pl, nil).Times(1)
+				cc.EXPECT().Set(gomock.Any(), gomock.Any(), key, gomock.Any()).Return(nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *model.PriceList, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, pl, res)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := ctrl.GetPriceList(ctx, "kz")
+				tt.expectedResp(t, res, err)
+			},
+		)
+	}
+}
+
+func TestController_CreatePriceList(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+	expectAudit(rr)
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		req          *model.PriceList
+		mockExpect   func()
+		expectedResp func(*testing.T, string, error)
+	}{
+		{
+			name: "Success with defaults",
+			req:  &model.PriceList{Name: "Kazakhstan", Currency: "kzt", Rate: 5.47},
+			mockExpect: func() {
+				rr.EXPECT().CreatePriceList(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, pl *model.PriceList) (string, error) {
+						assert.Equal(t, "kazakhstan", pl.Slug)
+						assert.Equal(t, "KZT", pl.Currency)
+						assert.Equal(t, model.DefaultCurrency, pl.BaseCurrency)
+						assert.Equal(t, model.RoundingHalfUp, pl.Rounding)
+						assert.Equal(t, int64(1), pl.RoundingStep)
+						return pl.Slug, nil
+					},
+				).Times(1)
+				cc.EXPECT().InvalidateKeysByPattern(gomock.Any(), invalidatePriceListCachePattern).AnyTimes()
+			},
+			expectedResp: func(t *testing.T, res string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "kazakhstan", res)
+			},
+		},
+		{
+			name: "Already exists",
+			req:  &model.PriceList{Slug: "kz", Name: "Kazakhstan", Currency: "KZT"},
+			mockExpect: func() {
+				rr.EXPECT().CreatePriceList(gomock.Any(), gomock.Any()).Return("", repo.ErrAlreadyExists).Times(1)
+			},
+			expectedResp: func(t *testing.T, res string, err error) {
+				assert.Empty(t, res)
+				assert.Equal(t, ErrAlreadyExists, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := ctrl.CreatePriceList(ctx, tt.req)
+				tt.expectedResp(t, res, err)
+			},
+		)
+	}
+}
+
+func TestController_SetPriceListItems(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+	expectAudit(rr)
+
+	ctx := context.Background()
+	items := []*model.PriceListItem{{ItemID: uuid.New(), Price: model.Money{Amount: 5000, Currency: "kzt"}}}
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, error)
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				rr.EXPECT().SetPriceListItems(gomock.Any(), "kz", items).Return(nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "KZT", items[0].Price.Currency)
+			},
+		},
+		{
+			name: "Not found",
+			mockExpect: func() {
+				rr.EXPECT().SetPriceListItems(gomock.Any(), "kz", items).Return(repo.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.Equal(t, ErrNotFound, err)
+			},
+		},
+		{
+			name: "Currency mismatch",
+			mockExpect: func() {
+				rr.EXPECT().SetPriceListItems(gomock.Any(), "kz", items).Return(model.ErrCurrencyMismatch).Times(1)
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.Equal(t, ErrPriceUnavailable, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				err := ctrl.SetPriceListItems(ctx, "kz", items)
+				tt.expectedResp(t, err)
+			},
+		)
+	}
+}
+
+func TestController_ListItemsWithPriceList(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+
+	pl := &model.PriceList{
+		ID:           1,
+		Currency:     "KZT",
+		BaseCurrency: "RUB",
+		Rate:         5.47,
+		Rounding:     model.RoundingUp,
+		RoundingStep: 100,
+	}
+	ctx := WithPriceList(context.Background(), pl)
+
+	priced, converted, variant := uuid.New(), uuid.New(), uuid.New()
+	newData := func() *model.PaginatedItemsData {
+		return &model.PaginatedItemsData{
+			Data: []*model.Item{
+				{ID: priced, Price: model.NewMoney(10000, "RUB")},
+				{
+					ID:       converted,
+					Price:    model.NewMoney(1050, "RUB"),
+					Variants: []model.Item{{ID: variant, Price: model.NewMoney(2000, "RUB")}},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, *model.PaginatedItemsData, error)
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				cc.EXPECT().GetToStruct(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("cache miss")).Times(1)
+				rr.EXPECT().ListItems(gomock.Any(), 1, 10).Return(newData(), nil).Times(1)
+				cc.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				rr.EXPECT().GetPriceListPrices(gomock.Any(), pl.ID, []uuid.UUID{priced, converted, variant}).
+					Return(map[uuid.UUID]int64{priced: 50000}, nil).Times(1)
+				rr.EXPECT().GetApplicableQuantityBreaks(gomock.Any(), uint64(0), []uuid.UUID{priced, converted, variant}).
+					Return(
+						map[uuid.UUID][]model.QuantityBreak{
+							converted: {{MinQuantity: 10, Discount: 5, ItemID: converted}},
+						}, nil,
+					).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *model.PaginatedItemsData, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, model.NewMoney(50000, "KZT"), res.Data[0].Price)
+				assert.Equal(t, model.NewMoney(5800, "KZT"), res.Data[1].Price)
+				assert.Equal(t, model.NewMoney(11000, "KZT"), res.Data[1].Variants[0].Price)
+				require.Len(t, res.Data[1].QuantityBreaks, 1)
+				assert.Equal(t, model.NewMoney(5510, "KZT"), res.Data[1].QuantityBreaks[0].Price)
+			},
+		},
+		{
+			name: "Prices error",
+			mockExpect: func() {
+				cc.EXPECT().GetToStruct(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("cache miss")).Times(1)
+				rr.EXPECT().ListItems(gomock.Any(), 1, 10).Return(newData(), nil).Times(1)
+				cc.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				rr.EXPECT().GetPriceListPrices(gomock.Any(), pl.ID, gomock.Any()).
+					Return(nil, errors.New("query error")).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *model.PaginatedItemsData, err error) {
+				assert.Nil(t, res)
+				assert.Error(t, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := ctrl.ListItems(ctx, 1, 10)
+				tt.expectedResp(t, res, err)
+			},
+		)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/JMURv/par-pro/products/pkg/model"
 	"github.com/JMURv/par-pro/products/pkg/utils/slugify"
 	"github.com/goccy/go-json"
+	"github.com/google/uuid"
 	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 )
@@ -25,6 +26,7 @@ type promotionRepo interface {
 	GetPromotion(ctx context.Context, slug string) (*model.Promotion, error)
 	CreatePromotion(ctx context.Context, p *model.Promotion) (string, error)
 	UpdatePromotion(ctx context.Context, slug string, p *model.Promotion) error
+	PatchPromotion(ctx context.Context, slug string, p *model.Promotion, fields []string) error
 	DeletePromotion(ctx context.Context, slug string) error
 
 	ListPromotionItems(ctx context.Context, slug string, page, size int) (*model.PaginatedPromoItemsData, error)
@@ -121,6 +123,7 @@ func (c *Controller) CreatePromotion(ctx context.Context, p *model.Promotion) (s
 	defer span.Finish()
 
 	p.Slug = slugify.Slugify(p.Title)
+	before := c.itemStates(ctx, promotionItemIDs(p))
 	slug, err := c.repo.CreatePromotion(ctx, p)
 	if err != nil {
 		zap.L().Debug("failed to create promotion", zap.Error(err), zap.String("op", op))
@@ -128,6 +131,9 @@ func (c *Controller) CreatePromotion(ctx context.Context, p *model.Promotion) (s
 	}
 
 	go c.cache.InvalidateKeysByPattern(ctx, invalidatePromoRelatedCachePattern)
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateFavoriteCachePattern)
+	c.notifyFavorites(ctx, before)
+	c.audit(ctx, model.AuditPromotion, model.AuditCreate, slug, nil, p)
 	return slug, nil
 }
 
@@ -137,10 +143,14 @@ func (c *Controller) UpdatePromotion(ctx context.Context, slug string, p *model.
 	ctx = opentracing.ContextWithSpan(ctx, span)
 	defer span.Finish()
 
+	before := c.itemStates(ctx, promotionItemIDs(p))
+	prev := snapshot(ctx, c.repo.GetPromotion, slug)
 	err := c.repo.UpdatePromotion(ctx, slug, p)
 	if err != nil && errors.Is(err, repo.ErrNotFound) {
 		zap.L().Debug("failed to find promotion", zap.Error(err), zap.String("op", op))
 		return ErrNotFound
+	} else if err != nil && errors.Is(err, repo.ErrVersionConflict) {
+		return ErrVersionConflict
 	} else if err != nil {
 		zap.L().Debug("failed to update promotion", zap.Error(err), zap.String("op", op))
 		return err
@@ -151,6 +161,40 @@ func (c *Controller) UpdatePromotion(ctx context.Context, slug string, p *model.
 	}
 
 	go c.cache.InvalidateKeysByPattern(ctx, invalidatePromoRelatedCachePattern)
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateFavoriteCachePattern)
+	c.notifyFavorites(ctx, before)
+	c.audit(ctx, model.AuditPromotion, model.AuditUpdate, slug, prev, snapshot(ctx, c.repo.GetPromotion, slug))
+	return nil
+}
+
+// PatchPromotion updates only the fields of p named in fields.
+func (c *Controller) PatchPromotion(ctx context.Context, slug string, p *model.Promotion, fields []string) error {
+	const op = "promo.PatchPromotion.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	before := c.itemStates(ctx, promotionItemIDs(p))
+	prev := snapshot(ctx, c.repo.GetPromotion, slug)
+	err := c.repo.PatchPromotion(ctx, slug, p, fields)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find promotion", zap.Error(err), zap.String("op", op))
+		return ErrNotFound
+	} else if err != nil && errors.Is(err, repo.ErrVersionConflict) {
+		return ErrVersionConflict
+	} else if err != nil {
+		zap.L().Debug("failed to patch promotion", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	if err = c.cache.Delete(ctx, fmt.Sprintf(promotionCacheKey, slug)); err != nil {
+		zap.L().Debug("failed to delete from cache", zap.Error(err), zap.String("op", op))
+	}
+
+	go c.cache.InvalidateKeysByPattern(ctx, invalidatePromoRelatedCachePattern)
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateFavoriteCachePattern)
+	c.notifyFavorites(ctx, before)
+	c.audit(ctx, model.AuditPromotion, model.AuditUpdate, slug, prev, snapshot(ctx, c.repo.GetPromotion, slug))
 	return nil
 }
 
@@ -160,6 +204,7 @@ func (c *Controller) DeletePromotion(ctx context.Context, slug string) error {
 	ctx = opentracing.ContextWithSpan(ctx, span)
 	defer span.Finish()
 
+	prev := snapshot(ctx, c.repo.GetPromotion, slug)
 	err := c.repo.DeletePromotion(ctx, slug)
 	if err != nil && errors.Is(err, repo.ErrNotFound) {
 		zap.L().Debug("failed to find promotion", zap.Error(err), zap.String("op", op))
@@ -174,6 +219,8 @@ func (c *Controller) DeletePromotion(ctx context.Context, slug string) error {
 	}
 
 	go c.cache.InvalidateKeysByPattern(ctx, invalidatePromoRelatedCachePattern)
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateFavoriteCachePattern)
+	c.audit(ctx, model.AuditPromotion, model.AuditDelete, slug, prev, nil)
 	return nil
 }
 
@@ -201,3 +248,12 @@ func (c *Controller) ListPromotionItems(ctx context.Context, slug string, page,
 	}
 	return res, nil
 }
+
+// promotionItemIDs lists the items a promotion change can make cheaper.
+func promotionItemIDs(p *model.Promotion) []uuid.UUID {
+	res := make([]uuid.UUID, 0, len(p.PromotionItems))
+	for _, v := range p.PromotionItems {
+		res = append(res, v.ItemID)
+	}
+	return res
+}
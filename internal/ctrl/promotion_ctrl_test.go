@@ -20,7 +20,7 @@ func TestController_PromotionSearch(t *testing.T) {
 
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
 
 	tests := []struct {
 		name         string
@@ -120,7 +120,7 @@ func TestController_ListPromotions(t *testing.T) {
 
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
 
 	tests := []struct {
 		name         string
@@ -214,7 +214,7 @@ func TestController_GetPromotion(t *testing.T) {
 
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
 
 	tests := []struct {
 		name         string
@@ -322,7 +322,8 @@ func TestController_CreatePromotion(t *testing.T) {
 
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
+	expectAudit(rr)
 
 	tests := []struct {
 		name         string
@@ -388,7 +389,9 @@ func TestController_UpdatePromotion(t *testing.T) {
 
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
+	expectAudit(rr)
+	rr.EXPECT().GetPromotion(gomock.Any(), gomock.Any()).Return(nil, repo.ErrNotFound).AnyTimes()
 
 	tests := []struct {
 		name         string
@@ -509,7 +512,9 @@ func TestController_DeletePromotion(t *testing.T) {
 
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
+	expectAudit(rr)
+	rr.EXPECT().GetPromotion(gomock.Any(), gomock.Any()).Return(nil, repo.ErrNotFound).AnyTimes()
 
 	tests := []struct {
 		name         string
@@ -612,7 +617,7 @@ func TestController_ListPromotionItems(t *testing.T) {
 
 	rr := mocks.NewMockAppRepo(mock)
 	cc := mocks.NewMockCacheService(mock)
-	ctrl := New(rr, cc)
+	ctrl := New(rr, cc, nil, nil)
 
 	tests := []struct {
 		name         string
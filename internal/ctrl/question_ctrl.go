@@ -0,0 +1,260 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"time"
+)
+
+const itemQuestionsCacheKey = "questions-item:%v:%v:%v"
+const itemQuestionsCachePattern = "questions-item:%v:*"
+
+type questionRepo interface {
+	GetQuestion(ctx context.Context, id uint64) (*model.Question, error)
+	ListItemQuestions(ctx context.Context, uid uuid.UUID, status string, page, size int) (*model.PaginatedQuestionsData, error)
+	ListQuestionsByStatus(ctx context.Context, status string, page, size int) (*model.PaginatedQuestionsData, error)
+	CreateQuestion(ctx context.Context, req *model.Question) (uint64, error)
+	ModerateQuestion(ctx context.Context, id uint64, req *model.Moderation) (uuid.UUID, error)
+	DeleteQuestion(ctx context.Context, id uint64, userID uuid.UUID) (uuid.UUID, error)
+
+	ListAnswersByStatus(ctx context.Context, status string, page, size int) (*model.PaginatedAnswersData, error)
+	CreateAnswer(ctx context.Context, req *model.Answer) (uint64, error)
+	ModerateAnswer(ctx context.Context, id uint64, req *model.Moderation) (*model.Answer, error)
+	SetAnswerOfficial(ctx context.Context, id uint64, official bool) (uuid.UUID, error)
+	DeleteAnswer(ctx context.Context, id uint64, userID uuid.UUID) (uuid.UUID, error)
+}
+
+// ListItemQuestions lists the approved questions of the item with their approved answers, newest first.
+func (c *Controller) ListItemQuestions(ctx context.Context, uid uuid.UUID, page, size int) (*model.PaginatedQuestionsData, error) {
+	const op = "questions.ListItemQuestions.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	cached := &model.PaginatedQuestionsData{}
+	cacheKey := fmt.Sprintf(itemQuestionsCacheKey, uid, page, size)
+	if err := c.cache.GetToStruct(ctx, cacheKey, cached); err == nil {
+		return cached, nil
+	}
+
+	res, err := c.repo.ListItemQuestions(ctx, uid, model.QuestionStatusApproved, page, size)
+	if err != nil {
+		zap.L().Debug("failed to list item questions", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	if bytes, err := json.Marshal(res); err == nil {
+		if err = c.cache.Set(ctx, consts.DefaultCacheTime, cacheKey, bytes); err != nil {
+			zap.L().Debug("failed to set to cache", zap.Error(err), zap.String("op", op))
+		}
+	}
+
+	return res, nil
+}
+
+// ListPendingQuestions is the question moderation queue, oldest first.
+func (c *Controller) ListPendingQuestions(ctx context.Context, page, size int) (*model.PaginatedQuestionsData, error) {
+	const op = "questions.ListPendingQuestions.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	res, err := c.repo.ListQuestionsByStatus(ctx, model.QuestionStatusPending, page, size)
+	if err != nil {
+		zap.L().Debug("failed to list pending questions", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// CreateQuestion submits a question for moderation.
+func (c *Controller) CreateQuestion(ctx context.Context, req *model.Question) (uint64, error) {
+	const op = "questions.CreateQuestion.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	res, err := c.repo.CreateQuestion(ctx, req)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find item", zap.Error(err), zap.String("op", op))
+		return 0, ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to create question", zap.Error(err), zap.String("op", op))
+		return 0, err
+	}
+
+	c.audit(ctx, model.AuditQuestion, model.AuditCreate, res, nil, req)
+	return res, nil
+}
+
+func (c *Controller) ModerateQuestion(ctx context.Context, id uint64, req *model.Moderation) error {
+	const op = "questions.ModerateQuestion.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	prev := snapshot(ctx, c.repo.GetQuestion, id)
+	uid, err := c.repo.ModerateQuestion(ctx, id, req)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find question", zap.Error(err), zap.String("op", op))
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to moderate question", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	go c.cache.InvalidateKeysByPattern(ctx, fmt.Sprintf(itemQuestionsCachePattern, uid))
+	c.audit(ctx, model.AuditQuestion, model.AuditModerate, id, prev, snapshot(ctx, c.repo.GetQuestion, id))
+	return nil
+}
+
+// DeleteQuestion removes a question of the user along with its answers.
+func (c *Controller) DeleteQuestion(ctx context.Context, id uint64, userID uuid.UUID) error {
+	const op = "questions.DeleteQuestion.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	prev := snapshot(ctx, c.repo.GetQuestion, id)
+	uid, err := c.repo.DeleteQuestion(ctx, id, userID)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find question", zap.Error(err), zap.String("op", op))
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to delete question", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	go c.cache.InvalidateKeysByPattern(ctx, fmt.Sprintf(itemQuestionsCachePattern, uid))
+	c.audit(ctx, model.AuditQuestion, model.AuditDelete, id, prev, nil)
+	return nil
+}
+
+// ListPendingAnswers is the answer moderation queue, oldest first.
+func (c *Controller) ListPendingAnswers(ctx context.Context, page, size int) (*model.PaginatedAnswersData, error) {
+	const op = "questions.ListPendingAnswers.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	res, err := c.repo.ListAnswersByStatus(ctx, model.QuestionStatusPending, page, size)
+	if err != nil {
+		zap.L().Debug("failed to list pending answers", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// CreateAnswer submits an answer to a published question for moderation.
+func (c *Controller) CreateAnswer(ctx context.Context, req *model.Answer) (uint64, error) {
+	const op = "questions.CreateAnswer.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	res, err := c.repo.CreateAnswer(ctx, req)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find question", zap.Error(err), zap.String("op", op))
+		return 0, ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to create answer", zap.Error(err), zap.String("op", op))
+		return 0, err
+	}
+
+	c.audit(ctx, model.AuditAnswer, model.AuditCreate, res, nil, req)
+	return res, nil
+}
+
+// ModerateAnswer publishes or rejects an answer, the author of the question is notified once it is published.
+func (c *Controller) ModerateAnswer(ctx context.Context, id uint64, req *model.Moderation) error {
+	const op = "questions.ModerateAnswer.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	a, err := c.repo.ModerateAnswer(ctx, id, req)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find answer", zap.Error(err), zap.String("op", op))
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to moderate answer", zap.Error(err), zap.String("op", op))
+		return err
+	}
+	c.audit(ctx, model.AuditAnswer, model.AuditModerate, id, nil, req)
+
+	q, err := c.repo.GetQuestion(ctx, a.QuestionID)
+	if err != nil {
+		zap.L().Debug("failed to get question", zap.Error(err), zap.String("op", op))
+		return nil
+	}
+
+	go c.cache.InvalidateKeysByPattern(ctx, fmt.Sprintf(itemQuestionsCachePattern, q.ItemID))
+	if a.Status == model.QuestionStatusApproved && c.notifier != nil && a.UserID != q.UserID {
+		e := &model.QuestionEvent{
+			Type:       model.QuestionEventAnswered,
+			ItemID:     q.ItemID,
+			QuestionID: q.ID,
+			AnswerID:   a.ID,
+			Official:   a.Official,
+			UserIDs:    []uuid.UUID{q.UserID},
+			CreatedAt:  time.Now(),
+		}
+		if err = c.notifier.NotifyQuestion(ctx, e); err != nil {
+			zap.L().Debug("failed to notify", zap.Error(err), zap.String("type", string(e.Type)))
+		}
+	}
+
+	return nil
+}
+
+// SetAnswerOfficial flags an answer given on behalf of the shop.
+func (c *Controller) SetAnswerOfficial(ctx context.Context, id uint64, official bool) error {
+	const op = "questions.SetAnswerOfficial.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	uid, err := c.repo.SetAnswerOfficial(ctx, id, official)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find answer", zap.Error(err), zap.String("op", op))
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to set answer official", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	go c.cache.InvalidateKeysByPattern(ctx, fmt.Sprintf(itemQuestionsCachePattern, uid))
+	c.audit(ctx, model.AuditAnswer, model.AuditUpdate, id, nil, map[string]bool{"official": official})
+	return nil
+}
+
+// DeleteAnswer removes an answer of the user.
+func (c *Controller) DeleteAnswer(ctx context.Context, id uint64, userID uuid.UUID) error {
+	const op = "questions.DeleteAnswer.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	uid, err := c.repo.DeleteAnswer(ctx, id, userID)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find answer", zap.Error(err), zap.String("op", op))
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to delete answer", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	go c.cache.InvalidateKeysByPattern(ctx, fmt.Sprintf(itemQuestionsCachePattern, uid))
+	c.audit(ctx, model.AuditAnswer, model.AuditDelete, id, nil, nil)
+	return nil
+}
@@ -0,0 +1,145 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+func TestController_ModerateAnswer(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	nn := mocks.NewMockNotifier(mock)
+	ctrl := New(rr, cc, nn, nil)
+	expectAudit(rr)
+
+	itemID, askerID, staffID := uuid.New(), uuid.New(), uuid.New()
+	q := &model.Question{ID: 2, ItemID: itemID, UserID: askerID}
+	approve := &model.Moderation{Status: model.QuestionStatusApproved}
+	reject := &model.Moderation{Status: model.QuestionStatusRejected, Note: "Off-topic"}
+
+	cc.EXPECT().InvalidateKeysByPattern(gomock.Any(), fmt.Sprintf(itemQuestionsCachePattern, itemID)).
+		Return(nil).AnyTimes()
+
+	tests := []struct {
+		name         string
+		req          *model.Moderation
+		mockExpect   func()
+		expectedResp func(*testing.T, error)
+	}{
+		{
+			name: "Approved answer notifies the asker",
+			req:  approve,
+			mockExpect: func() {
+				rr.EXPECT().ModerateAnswer(gomock.Any(), uint64(4), approve).Return(
+					&model.Answer{ID: 4, QuestionID: 2, UserID: staffID, Official: true, Status: model.QuestionStatusApproved},
+					nil,
+				)
+				rr.EXPECT().GetQuestion(gomock.Any(), uint64(2)).Return(q, nil)
+				nn.EXPECT().NotifyQuestion(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, e *model.QuestionEvent) error {
+						assert.Equal(t, model.QuestionEventAnswered, e.Type)
+						assert.Equal(t, itemID, e.ItemID)
+						assert.Equal(t, uint64(4), e.AnswerID)
+						assert.True(t, e.Official)
+						assert.Equal(t, []uuid.UUID{askerID}, e.UserIDs)
+						return nil
+					},
+				)
+			},
+			expectedResp: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Own answer is not notified",
+			req:  approve,
+			mockExpect: func() {
+				rr.EXPECT().ModerateAnswer(gomock.Any(), uint64(4), approve).Return(
+					&model.Answer{ID: 4, QuestionID: 2, UserID: askerID, Status: model.QuestionStatusApproved}, nil,
+				)
+				rr.EXPECT().GetQuestion(gomock.Any(), uint64(2)).Return(q, nil)
+			},
+			expectedResp: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Rejected answer is not notified",
+			req:  reject,
+			mockExpect: func() {
+				rr.EXPECT().ModerateAnswer(gomock.Any(), uint64(4), reject).Return(
+					&model.Answer{ID: 4, QuestionID: 2, UserID: staffID, Status: model.QuestionStatusRejected}, nil,
+				)
+				rr.EXPECT().GetQuestion(gomock.Any(), uint64(2)).Return(q, nil)
+			},
+			expectedResp: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Not found",
+			req:  approve,
+			mockExpect: func() {
+				rr.EXPECT().ModerateAnswer(gomock.Any(), uint64(4), approve).Return(nil, repo.ErrNotFound)
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.Equal(t, ErrNotFound, err)
+			},
+		},
+		{
+			name: "Notifier error is not returned",
+			req:  approve,
+			mockExpect: func() {
+				rr.EXPECT().ModerateAnswer(gomock.Any(), uint64(4), approve).Return(
+					&model.Answer{ID: 4, QuestionID: 2, UserID: staffID, Status: model.QuestionStatusApproved}, nil,
+				)
+				rr.EXPECT().GetQuestion(gomock.Any(), uint64(2)).Return(q, nil)
+				nn.EXPECT().NotifyQuestion(gomock.Any(), gomock.Any()).Return(errors.New("unavailable"))
+			},
+			expectedResp: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				tt.expectedResp(t, ctrl.ModerateAnswer(context.Background(), 4, tt.req))
+			},
+		)
+	}
+}
+
+func TestController_CreateAnswer(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+	expectAudit(rr)
+	req := &model.Answer{QuestionID: 1, UserID: uuid.New(), Text: "Yes"}
+
+	rr.EXPECT().CreateAnswer(gomock.Any(), req).Return(uint64(0), repo.ErrNotFound)
+	_, err := ctrl.CreateAnswer(context.Background(), req)
+	assert.Equal(t, ErrNotFound, err)
+
+	rr.EXPECT().CreateAnswer(gomock.Any(), req).Return(uint64(3), nil)
+	res, err := ctrl.CreateAnswer(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), res)
+}
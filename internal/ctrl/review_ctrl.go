@@ -0,0 +1,229 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+const itemReviewsCacheKey = "reviews-item:%v:%v:%v"
+const itemReviewsCachePattern = "reviews-item:%v:*"
+const invalidateItemCategoryCachePattern = "items-category*"
+
+type reviewRepo interface {
+	GetReview(ctx context.Context, id uint64) (*model.Review, error)
+	ListItemReviews(ctx context.Context, uid uuid.UUID, status string, page, size int) (*model.PaginatedReviewsData, error)
+	ListReviewsByStatus(ctx context.Context, status string, page, size int) (*model.PaginatedReviewsData, error)
+	CreateReview(ctx context.Context, req *model.Review) (uint64, error)
+	UpdateReview(ctx context.Context, req *model.Review) (uuid.UUID, error)
+	ModerateReview(ctx context.Context, id uint64, req *model.Moderation) (uuid.UUID, error)
+	DeleteReview(ctx context.Context, id uint64, userID uuid.UUID) (uuid.UUID, error)
+	CreateReviewMedia(ctx context.Context, reviewID uint64, req *model.ReviewMedia) (*model.ReviewMedia, error)
+}
+
+// ListItemReviews lists the approved reviews of the item, newest first.
+func (c *Controller) ListItemReviews(ctx context.Context, uid uuid.UUID, page, size int) (*model.PaginatedReviewsData, error) {
+	const op = "reviews.ListItemReviews.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	cached := &model.PaginatedReviewsData{}
+	cacheKey := fmt.Sprintf(itemReviewsCacheKey, uid, page, size)
+	if err := c.cache.GetToStruct(ctx, cacheKey, cached); err == nil {
+		return cached, nil
+	}
+
+	res, err := c.repo.ListItemReviews(ctx, uid, model.ReviewStatusApproved, page, size)
+	if err != nil {
+		zap.L().Debug("failed to list item reviews", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	if bytes, err := json.Marshal(res); err == nil {
+		if err = c.cache.Set(ctx, consts.DefaultCacheTime, cacheKey, bytes); err != nil {
+			zap.L().Debug("failed to set to cache", zap.Error(err), zap.String("op", op))
+		}
+	}
+
+	return res, nil
+}
+
+// ListPendingReviews is the moderation queue, oldest reviews first.
+func (c *Controller) ListPendingReviews(ctx context.Context, page, size int) (*model.PaginatedReviewsData, error) {
+	const op = "reviews.ListPendingReviews.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	res, err := c.repo.ListReviewsByStatus(ctx, model.ReviewStatusPending, page, size)
+	if err != nil {
+		zap.L().Debug("failed to list pending reviews", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// CreateReview submits a review for moderation, the user must have received the item in a delivered order.
+func (c *Controller) CreateReview(ctx context.Context, req *model.Review) (uint64, error) {
+	const op = "reviews.CreateReview.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	res, err := c.repo.CreateReview(ctx, req)
+	if err != nil && errors.Is(err, repo.ErrNotPurchased) {
+		zap.L().Debug("item was not delivered to the user", zap.Error(err), zap.String("op", op))
+		return 0, ErrNotPurchased
+	} else if err != nil && errors.Is(err, repo.ErrAlreadyExists) {
+		zap.L().Debug("review already exists", zap.Error(err), zap.String("op", op))
+		return 0, ErrAlreadyExists
+	} else if err != nil {
+		zap.L().Debug("failed to create review", zap.Error(err), zap.String("op", op))
+		return 0, err
+	}
+
+	c.audit(ctx, model.AuditReview, model.AuditCreate, res, nil, req)
+	return res, nil
+}
+
+// UpdateReview changes a review of the user, it goes back to moderation.
+func (c *Controller) UpdateReview(ctx context.Context, req *model.Review) error {
+	const op = "reviews.UpdateReview.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	prev := snapshot(ctx, c.repo.GetReview, req.ID)
+	uid, err := c.repo.UpdateReview(ctx, req)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find review", zap.Error(err), zap.String("op", op))
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to update review", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	c.invalidateItemReviews(ctx, uid)
+	c.audit(ctx, model.AuditReview, model.AuditUpdate, req.ID, prev, snapshot(ctx, c.repo.GetReview, req.ID))
+	return nil
+}
+
+func (c *Controller) ModerateReview(ctx context.Context, id uint64, req *model.Moderation) error {
+	const op = "reviews.ModerateReview.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	prev := snapshot(ctx, c.repo.GetReview, id)
+	uid, err := c.repo.ModerateReview(ctx, id, req)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find review", zap.Error(err), zap.String("op", op))
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to moderate review", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	c.invalidateItemReviews(ctx, uid)
+	c.audit(ctx, model.AuditReview, model.AuditModerate, id, prev, snapshot(ctx, c.repo.GetReview, id))
+	return nil
+}
+
+// DeleteReview removes a review of the user.
+func (c *Controller) DeleteReview(ctx context.Context, id uint64, userID uuid.UUID) error {
+	const op = "reviews.DeleteReview.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	prev := snapshot(ctx, c.repo.GetReview, id)
+	uid, err := c.repo.DeleteReview(ctx, id, userID)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		zap.L().Debug("failed to find review", zap.Error(err), zap.String("op", op))
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to delete review", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	c.invalidateItemReviews(ctx, uid)
+	c.audit(ctx, model.AuditReview, model.AuditDelete, id, prev, nil)
+	return nil
+}
+
+// UploadReviewMedia attaches an image to a review of the user, the review goes back to moderation.
+func (c *Controller) UploadReviewMedia(ctx context.Context, userID uuid.UUID, id uint64, req *model.MediaUpload) (*model.ReviewMedia, error) {
+	const op = "reviews.UploadReviewMedia.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	if c.storage == nil {
+		return nil, ErrStorageDisabled
+	}
+
+	rv, err := c.repo.GetReview(ctx, id)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to get review", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	if rv.UserID != userID {
+		return nil, ErrNotFound
+	}
+
+	if len(rv.Media) >= consts.ReviewMediaLimit {
+		return nil, ErrTooManyReviewMedia
+	}
+
+	m, stored, err := c.storeImage(ctx, fmt.Sprintf("reviews/%v", id), req.Data)
+	if err != nil {
+		zap.L().Debug("failed to store media", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	res, err := c.repo.CreateReviewMedia(
+		ctx, id, &model.ReviewMedia{
+			Src:         m.Src,
+			StorageKey:  m.StorageKey,
+			ContentType: m.ContentType,
+			Size:        m.Size,
+			Width:       m.Width,
+			Height:      m.Height,
+			Variants:    m.Variants,
+		},
+	)
+	if err != nil {
+		c.removeStored(ctx, stored)
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		zap.L().Debug("failed to create review media", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	c.invalidateItemReviews(ctx, rv.ItemID)
+	c.audit(ctx, model.AuditReviewMedia, model.AuditCreate, res.ID, nil, res)
+	return res, nil
+}
+
+// invalidateItemReviews drops the reviews of the item and everything showing its rating.
+func (c *Controller) invalidateItemReviews(ctx context.Context, uid uuid.UUID) {
+	if err := c.cache.Delete(ctx, fmt.Sprintf(itemCacheKey, uid)); err != nil {
+		zap.L().Debug("failed to delete from cache", zap.Error(err))
+	}
+
+	go c.cache.InvalidateKeysByPattern(ctx, fmt.Sprintf(itemReviewsCachePattern, uid))
+	go c.cache.InvalidateKeysByPattern(ctx, invalidateItemCategoryCachePattern)
+}
@@ -0,0 +1,200 @@
+package ctrl
+
+import (
+	"context"
+	"fmt"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+func TestController_CreateReview(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+	expectAudit(rr)
+	req := &model.Review{ItemID: uuid.New(), UserID: uuid.New(), Rating: 5, Text: "Great"}
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, uint64, error)
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				rr.EXPECT().CreateReview(gomock.Any(), req).Return(uint64(1), nil)
+			},
+			expectedResp: func(t *testing.T, res uint64, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, uint64(1), res)
+			},
+		},
+		{
+			name: "Not delivered",
+			mockExpect: func() {
+				rr.EXPECT().CreateReview(gomock.Any(), req).Return(uint64(0), repo.ErrNotPurchased)
+			},
+			expectedResp: func(t *testing.T, res uint64, err error) {
+				assert.Equal(t, ErrNotPurchased, err)
+			},
+		},
+		{
+			name: "Already reviewed",
+			mockExpect: func() {
+				rr.EXPECT().CreateReview(gomock.Any(), req).Return(uint64(0), repo.ErrAlreadyExists)
+			},
+			expectedResp: func(t *testing.T, res uint64, err error) {
+				assert.Equal(t, ErrAlreadyExists, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := ctrl.CreateReview(context.Background(), req)
+				tt.expectedResp(t, res, err)
+			},
+		)
+	}
+}
+
+func TestController_ModerateReview(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+	expectAudit(rr)
+	rr.EXPECT().GetReview(gomock.Any(), gomock.Any()).Return(nil, repo.ErrNotFound).AnyTimes()
+	itemID := uuid.New()
+	req := &model.Moderation{Status: model.ReviewStatusApproved}
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, error)
+	}{
+		{
+			name: "Rating caches are dropped",
+			mockExpect: func() {
+				rr.EXPECT().ModerateReview(gomock.Any(), uint64(1), req).Return(itemID, nil)
+				cc.EXPECT().Delete(gomock.Any(), fmt.Sprintf(itemCacheKey, itemID)).Return(nil)
+				cc.EXPECT().InvalidateKeysByPattern(gomock.Any(), fmt.Sprintf(itemReviewsCachePattern, itemID)).AnyTimes()
+				cc.EXPECT().InvalidateKeysByPattern(gomock.Any(), invalidateItemCategoryCachePattern).AnyTimes()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "Unknown review",
+			mockExpect: func() {
+				rr.EXPECT().ModerateReview(gomock.Any(), uint64(1), req).Return(uuid.Nil, repo.ErrNotFound)
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.Equal(t, ErrNotFound, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				err := ctrl.ModerateReview(context.Background(), 1, req)
+				tt.expectedResp(t, err)
+			},
+		)
+	}
+}
+
+func TestController_UploadReviewMedia(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ss := mocks.NewMockStorage(mock)
+	ctrl := New(rr, cc, nil, ss)
+	expectAudit(rr)
+	userID, itemID := uuid.New(), uuid.New()
+	data := testPNG(t)
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, *model.ReviewMedia, error)
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				rr.EXPECT().GetReview(gomock.Any(), uint64(1)).Return(&model.Review{ID: 1, ItemID: itemID, UserID: userID}, nil)
+				ss.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("/media/r", nil).Times(4)
+				rr.EXPECT().CreateReviewMedia(gomock.Any(), uint64(1), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ uint64, m *model.ReviewMedia) (*model.ReviewMedia, error) {
+						assert.Equal(t, "/media/r", m.Src)
+						assert.Len(t, m.Variants, 3)
+						m.ID = 7
+						return m, nil
+					},
+				)
+				cc.EXPECT().Delete(gomock.Any(), fmt.Sprintf(itemCacheKey, itemID)).Return(nil)
+				cc.EXPECT().InvalidateKeysByPattern(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectedResp: func(t *testing.T, res *model.ReviewMedia, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, uint64(7), res.ID)
+			},
+		},
+		{
+			name: "Review of another user",
+			mockExpect: func() {
+				rr.EXPECT().GetReview(gomock.Any(), uint64(1)).Return(&model.Review{ID: 1, ItemID: itemID, UserID: uuid.New()}, nil)
+			},
+			expectedResp: func(t *testing.T, res *model.ReviewMedia, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, ErrNotFound, err)
+			},
+		},
+		{
+			name: "Too many files",
+			mockExpect: func() {
+				rr.EXPECT().GetReview(gomock.Any(), uint64(1)).Return(
+					&model.Review{
+						ID:     1,
+						ItemID: itemID,
+						UserID: userID,
+						Media:  make([]model.ReviewMedia, consts.ReviewMediaLimit),
+					}, nil,
+				)
+			},
+			expectedResp: func(t *testing.T, res *model.ReviewMedia, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, ErrTooManyReviewMedia, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := ctrl.UploadReviewMedia(context.Background(), userID, 1, &model.MediaUpload{Data: data})
+				tt.expectedResp(t, res, err)
+			},
+		)
+	}
+}
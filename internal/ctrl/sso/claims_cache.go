@@ -0,0 +1,114 @@
+package sso
+
+import (
+	"crypto/sha256"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"sync"
+	"time"
+)
+
+// claimsCache keeps the user and the granted permissions of tokens until they expire, tokens are keyed by their hash.
+// Both are cached so an SSO outage doesn't turn signed-in staff into anonymous customers mid-session.
+type claimsCache struct {
+	mu   sync.Mutex
+	data map[[sha256.Size]byte]*cachedToken
+}
+
+type cachedToken struct {
+	Claims
+	grants    []string
+	hasGrants bool
+}
+
+func newClaimsCache() *claimsCache {
+	return &claimsCache{
+		data: make(map[[sha256.Size]byte]*cachedToken),
+	}
+}
+
+func (c *claimsCache) get(token string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	v := c.lookup(token)
+	if v == nil || v.UserID == "" {
+		return "", false
+	}
+	return v.UserID, true
+}
+
+func (c *claimsCache) set(token string, claims Claims) {
+	if time.Now().After(claims.ExpiresAt) {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if v := c.lookup(token); v != nil {
+		v.Claims = claims
+		return
+	}
+	c.put(token, &cachedToken{Claims: claims})
+}
+
+// getGrants returns the names of the permissions granted to the owner of token.
+func (c *claimsCache) getGrants(token string) ([]string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	v := c.lookup(token)
+	if v == nil || !v.hasGrants {
+		return nil, false
+	}
+	return v.grants, true
+}
+
+func (c *claimsCache) setGrants(token string, grants []string, expiresAt time.Time) {
+	if time.Now().After(expiresAt) {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	v := c.lookup(token)
+	if v == nil {
+		v = &cachedToken{Claims: Claims{ExpiresAt: expiresAt}}
+		c.put(token, v)
+	}
+	v.grants, v.hasGrants = grants, true
+}
+
+func (c *claimsCache) lookup(token string) *cachedToken {
+	key := sha256.Sum256([]byte(token))
+	v, ok := c.data[key]
+	if !ok {
+		return nil
+	}
+
+	if time.Now().After(v.ExpiresAt) {
+		delete(c.data, key)
+		return nil
+	}
+	return v
+}
+
+func (c *claimsCache) put(token string, v *cachedToken) {
+	if len(c.data) >= consts.ClaimsCacheSize {
+		c.evictExpired()
+	}
+	if len(c.data) >= consts.ClaimsCacheSize {
+		clear(c.data)
+	}
+	c.data[sha256.Sum256([]byte(token))] = v
+}
+
+func (c *claimsCache) evictExpired() {
+	now := time.Now()
+	for k, v := range c.data {
+		if now.After(v.ExpiresAt) {
+			delete(c.data, k)
+		}
+	}
+}
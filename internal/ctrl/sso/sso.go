@@ -3,29 +3,35 @@ package sso
 import (
 	"context"
 	"errors"
-	discovery "github.com/JMURv/par-pro/products/internal/discovery"
+	"github.com/JMURv/par-pro/products/internal/client"
+	"github.com/JMURv/par-pro/products/pkg/consts"
 	pb "github.com/JMURv/protos/par-pro"
 	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"slices"
+	"strings"
 )
 
-var ErrNotFoundSvc = errors.New("service not found")
-var ErrCreateClient = errors.New("failed to create client")
-
 type SSOSvc interface {
 	ParseClaims(ctx context.Context, token string) (string, error)
 	CreateUser(ctx context.Context, name, email, password string) (string, error)
+	GetCustomerGroups(ctx context.Context, token string) ([]string, error)
+	GetPermissions(ctx context.Context, token string) ([]string, error)
 }
 
 type SSO struct {
-	discovery discovery.ServiceDiscovery
+	clients  *client.Manager
+	verifier *Verifier
+	claims   *claimsCache
 }
 
-func New(discovery discovery.ServiceDiscovery) *SSO {
+// New returns the SSO client, a nil verifier leaves checking every token to the SSO service.
+func New(clients *client.Manager, verifier *Verifier) *SSO {
 	return &SSO{
-		discovery: discovery,
+		clients:  clients,
+		verifier: verifier,
+		claims:   newClaimsCache(),
 	}
 }
 
@@ -35,60 +41,132 @@ func (s *SSO) CreateUser(ctx context.Context, name, email, password string) (str
 	ctx = opentracing.ContextWithSpan(ctx, span)
 	defer span.Finish()
 
-	url, err := s.discovery.FindServiceByName(ctx, "sso")
+	var res *pb.SSO_CreateUserRes
+	err := s.clients.Invoke(
+		ctx, consts.SSOService, func(ctx context.Context, cc grpc.ClientConnInterface) (err error) {
+			res, err = pb.NewUsersClient(cc).CreateUser(
+				ctx, &pb.SSO_CreateUserReq{
+					Name:     name,
+					Email:    email,
+					Password: password,
+				},
+			)
+			return err
+		},
+	)
 	if err != nil {
-		zap.L().Debug("failed to find svc", zap.Error(err), zap.String("op", op))
-		return "", ErrNotFoundSvc
+		zap.L().Debug("failed to create user", zap.Error(err), zap.String("op", op))
+		return "", err
 	}
 
-	cli, err := grpc.NewClient(url, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		zap.L().Debug("failed to create client", zap.Error(err), zap.String("op", op))
-		return "", ErrCreateClient
+	return res.Uid, nil
+}
+
+// ParseClaims returns the user of token. Tokens are verified locally when the verifier is configured and by
+// the SSO service otherwise, either way the result is cached until the token expires.
+func (s *SSO) ParseClaims(ctx context.Context, token string) (string, error) {
+	const op = "sso.ParseClaims.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	if uid, ok := s.claims.get(token); ok {
+		return uid, nil
 	}
-	defer cli.Close()
 
-	res, err := pb.NewUsersClient(cli).CreateUser(
-		ctx, &pb.SSO_CreateUserReq{
-			Name:     name,
-			Email:    email,
-			Password: password,
+	if s.verifier != nil {
+		claims, err := s.verifier.Verify(ctx, token)
+		if err == nil {
+			s.claims.set(token, *claims)
+			return claims.UserID, nil
+		} else if !errors.Is(err, errUnverifiable) {
+			zap.L().Debug("failed to verify token", zap.Error(err), zap.String("op", op))
+			return "", err
+		}
+		zap.L().Debug("falling back to sso", zap.String("op", op))
+	}
+
+	var res *pb.SSO_ParseClaimsRes
+	err := s.clients.InvokeIdempotent(
+		ctx, consts.SSOService, func(ctx context.Context, cc grpc.ClientConnInterface) (err error) {
+			res, err = pb.NewSSOClient(cc).ParseClaims(ctx, &pb.SSO_StringMsg{String_: token})
+			return err
 		},
 	)
 	if err != nil {
+		zap.L().Debug("failed to parse claims", zap.Error(err), zap.String("op", op))
 		return "", err
 	}
 
-	return res.Uid, nil
+	if exp, ok := tokenExpiry(token); ok {
+		s.claims.set(token, Claims{UserID: res.Token, ExpiresAt: exp})
+	}
+	return res.Token, nil
 }
 
-func (s *SSO) ParseClaims(ctx context.Context, token string) (string, error) {
-	const op = "sso.ParseClaims.ctrl"
+// GetCustomerGroups returns the slugs of the customer groups the token owner is granted in SSO.
+func (s *SSO) GetCustomerGroups(ctx context.Context, token string) ([]string, error) {
+	const op = "sso.GetCustomerGroups.ctrl"
 	span, _ := opentracing.StartSpanFromContext(ctx, op)
 	ctx = opentracing.ContextWithSpan(ctx, span)
 	defer span.Finish()
 
-	url, err := s.discovery.FindServiceByName(ctx, "sso")
+	grants, err := s.getGrants(ctx, token, op)
 	if err != nil {
-		zap.L().Debug("failed to find svc", zap.Error(err), zap.String("op", op))
-		return "", ErrNotFoundSvc
+		return nil, err
+	}
+
+	groups := make([]string, 0, 1)
+	for _, v := range grants {
+		if slug, ok := strings.CutPrefix(v, consts.CustomerGroupPermission); ok && slug != "" {
+			groups = append(groups, slug)
+		}
 	}
+	return groups, nil
+}
+
+// GetPermissions returns the names of the permissions the token owner is granted in SSO, roles included.
+func (s *SSO) GetPermissions(ctx context.Context, token string) ([]string, error) {
+	const op = "sso.GetPermissions.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
 
-	cli, err := grpc.NewClient(url, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	grants, err := s.getGrants(ctx, token, op)
 	if err != nil {
-		zap.L().Debug("failed to create client", zap.Error(err), zap.String("op", op))
-		return "", ErrCreateClient
+		return nil, err
 	}
-	defer cli.Close()
+	return slices.Clone(grants), nil
+}
 
-	res, err := pb.NewSSOClient(cli).ParseClaims(
-		ctx, &pb.SSO_StringMsg{
-			String_: token,
+// getGrants returns the names of the permissions granted to the token owner, cached like the claims until
+// the token expires.
+func (s *SSO) getGrants(ctx context.Context, token, op string) ([]string, error) {
+	if grants, ok := s.claims.getGrants(token); ok {
+		return grants, nil
+	}
+
+	var res *pb.SSO_User
+	err := s.clients.InvokeIdempotent(
+		ctx, consts.SSOService, func(ctx context.Context, cc grpc.ClientConnInterface) (err error) {
+			res, err = pb.NewSSOClient(cc).GetUserByToken(ctx, &pb.SSO_StringMsg{String_: token})
+			return err
 		},
 	)
 	if err != nil {
-		return "", err
+		zap.L().Debug("failed to get user", zap.Error(err), zap.String("op", op))
+		return nil, err
 	}
 
-	return res.Token, nil
+	grants := make([]string, 0, len(res.Permissions))
+	for _, v := range res.Permissions {
+		if v.Value {
+			grants = append(grants, v.Name)
+		}
+	}
+
+	if exp, ok := tokenExpiry(token); ok {
+		s.claims.setGrants(token, grants, exp)
+	}
+	return grants, nil
 }
@@ -0,0 +1,439 @@
+package sso
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"github.com/JMURv/par-pro/products/internal/apperr"
+	cfg "github.com/JMURv/par-pro/products/pkg/config"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/goccy/go-json"
+	"go.uber.org/zap"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+var ErrInvalidToken = apperr.New(apperr.Unauthenticated, "invalid token")
+var ErrTokenExpired = apperr.New(apperr.Unauthenticated, "token is expired")
+
+// errUnverifiable means the token can't be checked locally, e.g. its key is unknown, and the SSO has to decide.
+var errUnverifiable = errors.New("token can't be verified locally")
+
+// Claims are the parts of a verified token the service relies on.
+type Claims struct {
+	UserID    string
+	ExpiresAt time.Time
+}
+
+// Verifier checks SSO tokens against the keys published as a JWKS or a static public key.
+// JWKS keys are refetched once they are older than the refresh interval or a token names an unknown key.
+type Verifier struct {
+	jwksURL   string
+	refresh   time.Duration
+	issuer    string
+	audience  string
+	userClaim string
+	static    crypto.PublicKey
+	cli       *http.Client
+
+	mu        sync.RWMutex
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+	triedAt   time.Time
+	fetchMu   sync.Mutex
+}
+
+// NewVerifier returns nil when the config names no keys, every token then goes to the SSO.
+func NewVerifier(conf *cfg.AuthConfig) (*Verifier, error) {
+	if conf == nil || (conf.JWKSURL == "" && conf.PublicKey == "") {
+		return nil, nil
+	}
+
+	v := &Verifier{
+		jwksURL:   conf.JWKSURL,
+		refresh:   conf.JWKSRefresh,
+		issuer:    conf.Issuer,
+		audience:  conf.Audience,
+		userClaim: conf.UserClaim,
+		cli:       &http.Client{Timeout: 5 * time.Second},
+		keys:      make(map[string]crypto.PublicKey),
+	}
+	if v.refresh <= 0 {
+		v.refresh = consts.JWKSRefreshInterval
+	}
+	if v.userClaim == "" {
+		v.userClaim = consts.DefaultUserClaim
+	}
+
+	if conf.PublicKey != "" {
+		data, err := os.ReadFile(conf.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if v.static, err = parsePublicKey(data); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// Verify checks the signature and times of token. It returns errUnverifiable when the token has to be
+// checked by the SSO instead.
+func (v *Verifier) Verify(ctx context.Context, token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidToken
+	}
+
+	header := &jwtHeader{}
+	if err := decodeSegment(parts[0], header); err != nil || header.Alg == "" || header.Alg == "none" {
+		return nil, ErrInvalidToken
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	key, err := v.key(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = verifySignature(header.Alg, key, parts[0]+"."+parts[1], sig); err != nil {
+		return nil, err
+	}
+
+	payload := make(map[string]any)
+	if err = decodeSegment(parts[1], &payload); err != nil {
+		return nil, ErrInvalidToken
+	}
+	return v.checkClaims(payload)
+}
+
+func (v *Verifier) checkClaims(payload map[string]any) (*Claims, error) {
+	now := time.Now()
+	exp, ok := payload["exp"].(float64)
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+
+	expiresAt := time.Unix(int64(exp), 0)
+	if now.After(expiresAt.Add(consts.TokenLeeway)) {
+		return nil, ErrTokenExpired
+	}
+
+	if nbf, ok := payload["nbf"].(float64); ok && now.Add(consts.TokenLeeway).Before(time.Unix(int64(nbf), 0)) {
+		return nil, ErrInvalidToken
+	}
+
+	if v.issuer != "" && payload["iss"] != v.issuer {
+		return nil, ErrInvalidToken
+	}
+
+	if v.audience != "" && !hasAudience(payload["aud"], v.audience) {
+		return nil, ErrInvalidToken
+	}
+
+	uid, _ := payload[v.userClaim].(string)
+	if uid == "" {
+		return nil, ErrInvalidToken
+	}
+
+	return &Claims{
+		UserID:    uid,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// hasAudience reports whether the aud claim, a single string or a list of them, names audience.
+func hasAudience(aud any, audience string) bool {
+	switch v := aud.(type) {
+	case string:
+		return v == audience
+	case []any:
+		for _, a := range v {
+			if a == audience {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// key returns the key a token names, the static key serves tokens the JWKS has no key for.
+func (v *Verifier) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	v.mu.RLock()
+	key, ok := v.keys[kid]
+	fresh := time.Since(v.fetchedAt) < v.refresh
+	v.mu.RUnlock()
+
+	if v.jwksURL != "" && (!ok || !fresh) {
+		v.refreshKeys(ctx, !ok)
+
+		v.mu.RLock()
+		key, ok = v.keys[kid]
+		v.mu.RUnlock()
+	}
+
+	if ok {
+		return key, nil
+	}
+	if v.static != nil {
+		return v.static, nil
+	}
+	return nil, errUnverifiable
+}
+
+// refreshKeys refetches the JWKS at most once per consts.JWKSMinRefresh, the old keys stay if it fails.
+// Callers that still hold a usable key don't wait for a fetch already in flight.
+func (v *Verifier) refreshKeys(ctx context.Context, wait bool) {
+	if wait {
+		v.fetchMu.Lock()
+	} else if !v.fetchMu.TryLock() {
+		return
+	}
+	defer v.fetchMu.Unlock()
+
+	v.mu.RLock()
+	recent := time.Since(v.triedAt) < consts.JWKSMinRefresh
+	v.mu.RUnlock()
+	if recent {
+		return
+	}
+
+	keys, err := v.fetchKeys(ctx)
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.triedAt = time.Now()
+	if err != nil {
+		zap.L().Debug("failed to fetch jwks", zap.String("url", v.jwksURL), zap.Error(err))
+		return
+	}
+
+	v.keys, v.fetchedAt = keys, v.triedAt
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (v *Verifier) fetchKeys(ctx context.Context) (map[string]crypto.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.jwksURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := v.cli.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status: %v", resp.StatusCode)
+	}
+
+	set := struct {
+		Keys []jwk `json:"keys"`
+	}{}
+	if err = json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		key, err := k.publicKey()
+		if err != nil {
+			zap.L().Debug("skipping jwk", zap.String("kid", k.Kid), zap.Error(err))
+			continue
+		}
+		keys[k.Kid] = key
+	}
+	return keys, nil
+}
+
+func (k *jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve: %v", k.Crv)
+		}
+
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve: %v", k.Crv)
+		}
+
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, ErrInvalidToken
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type: %v", k.Kty)
+	}
+}
+
+func verifySignature(alg string, key crypto.PublicKey, input string, sig []byte) error {
+	var hash crypto.Hash
+	switch alg {
+	case "RS256", "PS256", "ES256":
+		hash = crypto.SHA256
+	case "RS384", "PS384", "ES384":
+		hash = crypto.SHA384
+	case "RS512", "PS512", "ES512":
+		hash = crypto.SHA512
+	case "EdDSA":
+		k, ok := key.(ed25519.PublicKey)
+		if !ok {
+			return ErrInvalidToken
+		}
+
+		if !ed25519.Verify(k, []byte(input), sig) {
+			return ErrInvalidToken
+		}
+		return nil
+	default:
+		return errUnverifiable
+	}
+
+	h := hash.New()
+	h.Write([]byte(input))
+	digest := h.Sum(nil)
+
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		var err error
+		if alg[0] == 'P' {
+			err = rsa.VerifyPSS(k, hash, digest, sig, nil)
+		} else if alg[0] == 'R' {
+			err = rsa.VerifyPKCS1v15(k, hash, digest, sig)
+		} else {
+			return ErrInvalidToken
+		}
+
+		if err != nil {
+			return ErrInvalidToken
+		}
+		return nil
+	case *ecdsa.PublicKey:
+		size := (k.Curve.Params().BitSize + 7) / 8
+		if alg[0] != 'E' || len(sig) != 2*size {
+			return ErrInvalidToken
+		}
+
+		r, s := new(big.Int).SetBytes(sig[:size]), new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(k, digest, r, s) {
+			return ErrInvalidToken
+		}
+		return nil
+	default:
+		return ErrInvalidToken
+	}
+}
+
+// parsePublicKey reads a PEM encoded PKIX or PKCS #1 public key.
+func parsePublicKey(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+
+	if block.Type == "RSA PUBLIC KEY" {
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
+
+// tokenExpiry reads the expiry of a token without verifying it, only for tokens the SSO has accepted.
+func tokenExpiry(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+
+	payload := struct {
+		Exp float64 `json:"exp"`
+	}{}
+	if err := decodeSegment(parts[1], &payload); err != nil || payload.Exp == 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(int64(payload.Exp), 0), true
+}
+
+func decodeSegment(seg string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(data), nil
+}
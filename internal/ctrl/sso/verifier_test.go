@@ -0,0 +1,262 @@
+package sso
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"github.com/goccy/go-json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	cfg "github.com/JMURv/par-pro/products/pkg/config"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeSegment(t *testing.T, v any) string {
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]any) string {
+	input := encodeSegment(t, map[string]string{"alg": "RS256", "kid": kid}) + "." + encodeSegment(t, claims)
+	digest := sha256.Sum256([]byte(input))
+
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+	return input + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func signES256(t *testing.T, key *ecdsa.PrivateKey, claims map[string]any) string {
+	input := encodeSegment(t, map[string]string{"alg": "ES256"}) + "." + encodeSegment(t, claims)
+	digest := sha256.Sum256([]byte(input))
+
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	require.NoError(t, err)
+
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+	return input + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func rsaJWK(kid string, key *rsa.PublicKey) map[string]string {
+	return map[string]string{
+		"kty": "RSA",
+		"kid": kid,
+		"use": "sig",
+		"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+func validClaims() map[string]any {
+	return map[string]any{"uid": "user-id", "exp": time.Now().Add(time.Hour).Unix()}
+}
+
+func TestVerifier_JWKS(t *testing.T) {
+	oldKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	var rotated atomic.Bool
+	srv := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				keys := []map[string]string{rsaJWK("old", &oldKey.PublicKey)}
+				if rotated.Load() {
+					keys = []map[string]string{rsaJWK("new", &newKey.PublicKey)}
+				}
+				_ = json.NewEncoder(w).Encode(map[string]any{"keys": keys})
+			},
+		),
+	)
+	defer srv.Close()
+
+	v, err := NewVerifier(&cfg.AuthConfig{JWKSURL: srv.URL})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	expired := validClaims()
+	expired["exp"] = time.Now().Add(-time.Hour).Unix()
+	noUser := validClaims()
+	delete(noUser, "uid")
+
+	tests := []struct {
+		name  string
+		token string
+		err   error
+	}{
+		{
+			name:  "Valid",
+			token: signRS256(t, oldKey, "old", validClaims()),
+		},
+		{
+			name:  "Expired",
+			token: signRS256(t, oldKey, "old", expired),
+			err:   ErrTokenExpired,
+		},
+		{
+			name:  "MissingUser",
+			token: signRS256(t, oldKey, "old", noUser),
+			err:   ErrInvalidToken,
+		},
+		{
+			name:  "WrongKey",
+			token: signRS256(t, newKey, "old", validClaims()),
+			err:   ErrInvalidToken,
+		},
+		{
+			name:  "UnknownKey",
+			token: signRS256(t, newKey, "new", validClaims()),
+			err:   errUnverifiable,
+		},
+		{
+			name:  "Malformed",
+			token: "not-a-token",
+			err:   ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				res, err := v.Verify(ctx, tt.token)
+				if tt.err != nil {
+					assert.ErrorIs(t, err, tt.err)
+					return
+				}
+
+				require.NoError(t, err)
+				assert.Equal(t, "user-id", res.UserID)
+			},
+		)
+	}
+
+	t.Run(
+		"Rotation", func(t *testing.T) {
+			rotated.Store(true)
+			v.mu.Lock()
+			v.triedAt = time.Time{}
+			v.mu.Unlock()
+
+			res, err := v.Verify(ctx, signRS256(t, newKey, "new", validClaims()))
+			require.NoError(t, err)
+			assert.Equal(t, "user-id", res.UserID)
+		},
+	)
+}
+
+func TestVerifier_StaticKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "sso.pem")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0600))
+
+	v, err := NewVerifier(&cfg.AuthConfig{PublicKey: path, Issuer: "sso", Audience: "products"})
+	require.NoError(t, err)
+
+	claims := validClaims()
+	claims["iss"] = "sso"
+	claims["aud"] = "products"
+	res, err := v.Verify(context.Background(), signES256(t, key, claims))
+	require.NoError(t, err)
+	assert.Equal(t, "user-id", res.UserID)
+
+	claims["aud"] = []string{"orders", "products"}
+	_, err = v.Verify(context.Background(), signES256(t, key, claims))
+	require.NoError(t, err)
+
+	claims["aud"] = []string{"orders"}
+	_, err = v.Verify(context.Background(), signES256(t, key, claims))
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	delete(claims, "aud")
+	_, err = v.Verify(context.Background(), signES256(t, key, claims))
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	claims["aud"] = "products"
+	claims["iss"] = "other"
+	_, err = v.Verify(context.Background(), signES256(t, key, claims))
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestSSO_ParseClaims_Cache(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode(map[string]any{"keys": []any{rsaJWK("kid", &key.PublicKey)}})
+			},
+		),
+	)
+
+	v, err := NewVerifier(&cfg.AuthConfig{JWKSURL: srv.URL})
+	require.NoError(t, err)
+
+	// Without a client manager any call reaching the SSO service would panic.
+	s := New(nil, v)
+	token := signRS256(t, key, "kid", validClaims())
+
+	uid, err := s.ParseClaims(context.Background(), token)
+	require.NoError(t, err)
+	assert.Equal(t, "user-id", uid)
+
+	srv.Close()
+	v.mu.Lock()
+	v.keys = nil
+	v.mu.Unlock()
+
+	uid, err = s.ParseClaims(context.Background(), token)
+	require.NoError(t, err)
+	assert.Equal(t, "user-id", uid)
+
+	unsigned := encodeSegment(t, map[string]string{"alg": "none"}) + "." + encodeSegment(t, validClaims()) + "."
+	_, err = s.ParseClaims(context.Background(), unsigned)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestSSO_Grants_Cache(t *testing.T) {
+	// Without a client manager any call reaching the SSO service would panic.
+	s := New(nil, nil)
+	token := "header." + encodeSegment(t, validClaims()) + ".sig"
+	s.claims.setGrants(token, []string{"items.write", consts.CustomerGroupPermission + "wholesale"}, time.Now().Add(time.Hour))
+
+	perms, err := s.GetPermissions(context.Background(), token)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"items.write", consts.CustomerGroupPermission + "wholesale"}, perms)
+
+	groups, err := s.GetCustomerGroups(context.Background(), token)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"wholesale"}, groups)
+
+	// Caching the grants doesn't make the token signed in.
+	_, ok := s.claims.get(token)
+	assert.False(t, ok)
+
+	s.claims.setGrants("expired", []string{"items.write"}, time.Now().Add(-time.Minute))
+	_, ok = s.claims.getGrants("expired")
+	assert.False(t, ok)
+}
@@ -0,0 +1,144 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"strconv"
+	"time"
+)
+
+type trashRepo interface {
+	ListTrash(ctx context.Context, kind string, page, size int) (*model.PaginatedTrashData, error)
+	RestoreFromTrash(ctx context.Context, kind, id string) error
+	PurgeFromTrash(ctx context.Context, kind, id string) error
+	PurgeExpiredTrash(ctx context.Context, before time.Time) (int64, error)
+}
+
+// ListTrash is not cached, the trash is only browsed by admins and changes with every deletion.
+func (c *Controller) ListTrash(ctx context.Context, kind string, page, size int) (*model.PaginatedTrashData, error) {
+	const op = "trash.ListTrash.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	res, err := c.repo.ListTrash(ctx, kind, page, size)
+	if err != nil {
+		zap.L().Debug("failed to list trash", zap.Error(err), zap.String("op", op))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// MoveToTrash soft deletes the entity of kind, it is the same as deleting it through its own endpoint.
+func (c *Controller) MoveToTrash(ctx context.Context, kind, id string) error {
+	switch kind {
+	case model.TrashItem:
+		uid, err := uuid.Parse(id)
+		if err != nil {
+			return ErrParseUUID
+		}
+		return c.DeleteItem(ctx, uid)
+	case model.TrashCategory:
+		return c.DeleteCategory(ctx, id)
+	case model.TrashPromotion:
+		return c.DeletePromotion(ctx, id)
+	case model.TrashOrder:
+		orderID, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			return ErrNotFound
+		}
+		return c.DeleteOrder(ctx, orderID)
+	}
+
+	return ErrNotFound
+}
+
+func (c *Controller) RestoreFromTrash(ctx context.Context, kind, id string) error {
+	const op = "trash.RestoreFromTrash.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	err := c.repo.RestoreFromTrash(ctx, kind, id)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return ErrNotFound
+	} else if err != nil {
+		zap.L().Debug("failed to restore from trash", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	c.invalidateTrashed(ctx, kind, id)
+	c.audit(ctx, kind, model.AuditRestore, id, nil, nil)
+	return nil
+}
+
+// PurgeFromTrash deletes the entity for good. Items that are part of an order stay in the trash.
+func (c *Controller) PurgeFromTrash(ctx context.Context, kind, id string) error {
+	const op = "trash.PurgeFromTrash.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	err := c.repo.PurgeFromTrash(ctx, kind, id)
+	if err != nil && errors.Is(err, repo.ErrNotFound) {
+		return ErrNotFound
+	} else if err != nil && errors.Is(err, repo.ErrReferenced) {
+		return ErrStillOrdered
+	} else if err != nil {
+		zap.L().Debug("failed to purge from trash", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	c.audit(ctx, kind, model.AuditPurge, id, nil, nil)
+	return nil
+}
+
+// PurgeExpiredTrash removes what was deleted more than retention ago, it runs as a background job.
+func (c *Controller) PurgeExpiredTrash(ctx context.Context, retention time.Duration) error {
+	const op = "trash.PurgeExpiredTrash.ctrl"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer span.Finish()
+
+	n, err := c.repo.PurgeExpiredTrash(ctx, time.Now().Add(-retention))
+	if err != nil {
+		zap.L().Debug("failed to purge expired trash", zap.Error(err), zap.String("op", op))
+		return err
+	}
+
+	if n > 0 {
+		zap.L().Info("Purged expired trash", zap.Int64("count", n))
+	}
+	return nil
+}
+
+// invalidateTrashed drops the cached lists a restored entity shows up in again.
+func (c *Controller) invalidateTrashed(ctx context.Context, kind, id string) {
+	var key, pattern string
+	switch kind {
+	case model.TrashItem:
+		key, pattern = fmt.Sprintf(itemCacheKey, id), invalidateItemRelatedCachePattern
+		go c.cache.InvalidateKeysByPattern(ctx, invalidateFavoriteCachePattern)
+	case model.TrashCategory:
+		key, pattern = fmt.Sprintf(categoryCacheKey, id), invalidateCategoryRelatedCachePattern
+	case model.TrashPromotion:
+		key, pattern = fmt.Sprintf(promotionCacheKey, id), invalidatePromoRelatedCachePattern
+		go c.cache.InvalidateKeysByPattern(ctx, invalidateFavoriteCachePattern)
+	case model.TrashOrder:
+		key, pattern = fmt.Sprintf(orderCacheKey, id), invalidateOrderRelatedCachePattern
+	default:
+		return
+	}
+
+	if err := c.cache.Delete(ctx, key); err != nil {
+		zap.L().Debug("failed to delete from cache", zap.Error(err))
+	}
+	go c.cache.InvalidateKeysByPattern(ctx, pattern)
+}
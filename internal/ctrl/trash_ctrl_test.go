@@ -0,0 +1,106 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"time"
+)
+
+func TestController_RestoreFromTrash(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	cc := mocks.NewMockCacheService(mock)
+	ctrl := New(rr, cc, nil, nil)
+	expectAudit(rr)
+
+	const slug = "shoes"
+	dbErr := errors.New("db error")
+
+	tests := []struct {
+		name       string
+		mockExpect func()
+		expectErr  error
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				rr.EXPECT().RestoreFromTrash(gomock.Any(), model.TrashCategory, slug).Return(nil)
+				cc.EXPECT().Delete(gomock.Any(), fmt.Sprintf(categoryCacheKey, slug)).Return(nil)
+				cc.EXPECT().InvalidateKeysByPattern(gomock.Any(), invalidateCategoryRelatedCachePattern).AnyTimes()
+			},
+		},
+		{
+			name: "Not in trash",
+			mockExpect: func() {
+				rr.EXPECT().RestoreFromTrash(gomock.Any(), model.TrashCategory, slug).Return(repo.ErrNotFound)
+			},
+			expectErr: ErrNotFound,
+		},
+		{
+			name: "Unexpected error",
+			mockExpect: func() {
+				rr.EXPECT().RestoreFromTrash(gomock.Any(), model.TrashCategory, slug).Return(dbErr)
+			},
+			expectErr: dbErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+			err := ctrl.RestoreFromTrash(context.Background(), model.TrashCategory, slug)
+			if tt.expectErr != nil {
+				assert.ErrorIs(t, err, tt.expectErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestController_PurgeFromTrash(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	ctrl := New(rr, mocks.NewMockCacheService(mock), nil, nil)
+	expectAudit(rr)
+
+	const id = "6f1c4bb6-4cbe-4b52-9a4b-6f0d1b3b1c55"
+
+	rr.EXPECT().PurgeFromTrash(gomock.Any(), model.TrashItem, id).Return(repo.ErrReferenced)
+	assert.ErrorIs(t, ctrl.PurgeFromTrash(context.Background(), model.TrashItem, id), ErrStillOrdered)
+
+	rr.EXPECT().PurgeFromTrash(gomock.Any(), model.TrashItem, id).Return(repo.ErrNotFound)
+	assert.ErrorIs(t, ctrl.PurgeFromTrash(context.Background(), model.TrashItem, id), ErrNotFound)
+
+	rr.EXPECT().PurgeFromTrash(gomock.Any(), model.TrashItem, id).Return(nil)
+	assert.NoError(t, ctrl.PurgeFromTrash(context.Background(), model.TrashItem, id))
+}
+
+func TestController_PurgeExpiredTrash(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	rr := mocks.NewMockAppRepo(mock)
+	ctrl := New(rr, mocks.NewMockCacheService(mock), nil, nil)
+
+	const retention = 24 * time.Hour
+	rr.EXPECT().PurgeExpiredTrash(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, before time.Time) (int64, error) {
+			assert.WithinDuration(t, time.Now().Add(-retention), before, time.Minute)
+			return 2, nil
+		},
+	)
+
+	assert.NoError(t, ctrl.PurgeExpiredTrash(context.Background(), retention))
+}
@@ -0,0 +1,204 @@
+package grpc
+
+import (
+	"context"
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/pkg/model/mapper"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"time"
+)
+
+func (h *Handler) ListAttributes(ctx context.Context, req *pb.Empty) (*pb.AttributeListRes, error) {
+	s, c := time.Now(), codes.OK
+	const op = "attributes.ListAttributes.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	res, err := h.ctrl.ListAttributes(ctx)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.AttributeListRes{Data: mapper.ListAttributesToProto(res)}, nil
+}
+
+func (h *Handler) GetAttribute(ctx context.Context, req *pb.SlugMsg) (*pb.AttributeMsg, error) {
+	s, c := time.Now(), codes.OK
+	const op = "attributes.GetAttribute.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Slug == "" {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	res, err := h.ctrl.GetAttribute(ctx, req.Slug)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return mapper.AttributeToProto(res), nil
+}
+
+func (h *Handler) CreateAttribute(ctx context.Context, req *pb.AttributeMsg) (*pb.SlugMsg, error) {
+	s, c := time.Now(), codes.OK
+	const op = "attributes.CreateAttribute.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	a := mapper.AttributeFromProto(req)
+	if err := validation.AttributeValidation(a); err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
+	}
+
+	res, err := h.ctrl.CreateAttribute(ctx, a)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.SlugMsg{Slug: res}, nil
+}
+
+func (h *Handler) UpdateAttribute(ctx context.Context, req *pb.AttributeMsg) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "attributes.UpdateAttribute.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Slug == "" {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	a := mapper.AttributeFromProto(req)
+	if err := validation.AttributeValidation(a); err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
+	}
+
+	err := h.ctrl.UpdateAttribute(ctx, req.Slug, a)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *Handler) DeleteAttribute(ctx context.Context, req *pb.SlugMsg) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "attributes.DeleteAttribute.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Slug == "" {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	err := h.ctrl.DeleteAttribute(ctx, req.Slug)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *Handler) ListCategoryAttributes(ctx context.Context, req *pb.SlugMsg) (*pb.CategoryAttributeListRes, error) {
+	s, c := time.Now(), codes.OK
+	const op = "attributes.ListCategoryAttributes.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Slug == "" {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	res, err := h.ctrl.ListCategoryAttributes(ctx, req.Slug)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.CategoryAttributeListRes{Data: mapper.ListCategoryAttributesToProto(res)}, nil
+}
+
+func (h *Handler) SetCategoryAttributes(ctx context.Context, req *pb.SetCategoryAttributesReq) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "attributes.SetCategoryAttributes.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Slug == "" {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	attrs := mapper.ListCategoryAttributesFromProto(req.Attributes)
+	if err := validation.CategoryAttributesValidation(attrs); err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
+	}
+
+	err := h.ctrl.SetCategoryAttributes(ctx, req.Slug, attrs)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
@@ -0,0 +1,96 @@
+package grpc
+
+import (
+	"context"
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestHandler_SetCategoryAttributes(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	msso := mocks.NewMockSSOSvc(mock)
+	h := New(mctrl, msso)
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		req          *pb.SetCategoryAttributesReq
+		mockExpect   func()
+		expectedResp func(*testing.T, *pb.Empty, error)
+	}{
+		{
+			name:       "Missing slug",
+			req:        &pb.SetCategoryAttributesReq{},
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "Duplicate attribute",
+			req: &pb.SetCategoryAttributesReq{
+				Slug:       "phones",
+				Attributes: []*pb.CategoryAttributeMsg{{AttributeId: 1}, {AttributeId: 1}},
+			},
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "Not found",
+			req: &pb.SetCategoryAttributesReq{
+				Slug:       "phones",
+				Attributes: []*pb.CategoryAttributeMsg{{AttributeId: 1}},
+			},
+			mockExpect: func() {
+				mctrl.EXPECT().SetCategoryAttributes(gomock.Any(), "phones", gomock.Any()).Return(ctrl.ErrNotFound)
+			},
+			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "Success",
+			req: &pb.SetCategoryAttributesReq{
+				Slug:       "phones",
+				Attributes: []*pb.CategoryAttributeMsg{{AttributeId: 1, IsRequired: true, IsFilterable: true}},
+			},
+			mockExpect: func() {
+				mctrl.EXPECT().SetCategoryAttributes(
+					gomock.Any(),
+					"phones",
+					[]*model.CategoryAttribute{{AttributeID: 1, IsRequired: true, IsFilterable: true}},
+				).Return(nil)
+			},
+			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
+				assert.NoError(t, err)
+				assert.NotNil(t, res)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := h.SetCategoryAttributes(ctx, tt.req)
+				tt.expectedResp(t, res, err)
+			},
+		)
+	}
+}
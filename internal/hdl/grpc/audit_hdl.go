@@ -0,0 +1,51 @@
+package grpc
+
+import (
+	"context"
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/pkg/model/mapper"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"time"
+)
+
+func (h *Handler) ListAuditLog(ctx context.Context, req *pb.ListAuditLogReq) (*pb.PaginatedAuditRes, error) {
+	s, c := time.Now(), codes.OK
+	const op = "audit.ListAuditLog.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Page == 0 || req.Size == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	f := mapper.AuditFilterFromProto(req)
+	if err := validation.AuditFilterValidation(f); err != nil {
+		c = codes.InvalidArgument
+		return nil, errStatus(c, err)
+	}
+
+	res, err := h.ctrl.ListAuditLog(ctx, f, int(req.Page), int(req.Size))
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.PaginatedAuditRes{
+		Data:        mapper.ListAuditToProto(res.Data),
+		Count:       res.Count,
+		TotalPages:  int64(res.TotalPages),
+		CurrentPage: int64(res.CurrentPage),
+		HasNextPage: res.HasNextPage,
+	}, nil
+}
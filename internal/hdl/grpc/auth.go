@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"context"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+)
+
+// ctxUserID returns the SSO user put into the context by the auth interceptor.
+func ctxUserID(ctx context.Context) (uuid.UUID, codes.Code, error) {
+	uidStr := ctrl.UserIDFromContext(ctx)
+	if uidStr == "" {
+		return uuid.Nil, codes.Unauthenticated, ctrl.ErrUnauthenticated
+	}
+
+	uid, err := uuid.Parse(uidStr)
+	if err != nil {
+		return uuid.Nil, codes.InvalidArgument, ctrl.ErrParseUUID
+	}
+
+	return uid, codes.OK, nil
+}
+
+// requireSelf checks that uid is the caller, so ordinary users only touch their own favorites.
+func requireSelf(ctx context.Context, uid uuid.UUID) (codes.Code, error) {
+	caller, c, err := ctxUserID(ctx)
+	if err != nil {
+		return c, err
+	}
+
+	if caller != uid {
+		return codes.PermissionDenied, ctrl.ErrForbidden
+	}
+	return codes.OK, nil
+}
+
+// canAccessOrder lets customers through to their own orders and the staff holding perm to any order.
+func canAccessOrder(ctx context.Context, o *model.Order, perm ctrl.Permission) bool {
+	if ctrl.Authorize(ctx, perm) == nil {
+		return true
+	}
+
+	uid, _, err := ctxUserID(ctx)
+	return err == nil && uid == o.UserID
+}
@@ -2,7 +2,6 @@ package grpc
 
 import (
 	"context"
-	"errors"
 	pb "github.com/JMURv/par-pro/products/api/pb"
 	"github.com/JMURv/par-pro/products/internal/ctrl"
 	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
@@ -11,7 +10,6 @@ import (
 	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"time"
 )
 
@@ -29,13 +27,13 @@ func (h *Handler) CategorySearch(ctx context.Context, req *pb.SearchReq) (*pb.Pa
 	if q == "" || page == 0 || size == 0 {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
 	}
 
 	res, err := h.ctrl.CategorySearch(ctx, q, int(page), int(size))
 	if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return &pb.PaginatedCategoryRes{
@@ -61,13 +59,13 @@ func (h *Handler) ListCategories(ctx context.Context, req *pb.ListReq) (*pb.Pagi
 	if page <= 0 || size <= 0 {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
 	}
 
 	res, err := h.ctrl.ListCategories(ctx, int(page), int(size))
 	if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return &pb.PaginatedCategoryRes{
@@ -92,16 +90,13 @@ func (h *Handler) GetCategory(ctx context.Context, req *pb.SlugMsg) (*pb.Categor
 	if req == nil || req.Slug == "" {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
 	}
 
 	res, err := h.ctrl.GetCategoryBySlug(ctx, req.Slug)
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = codes.NotFound
-		return nil, status.Errorf(c, err.Error())
-	} else if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return mapper.CategoryToProto(res), nil
@@ -120,20 +115,20 @@ func (h *Handler) CreateCategory(ctx context.Context, req *pb.CategoryMsg) (*pb.
 	if req == nil {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
 	}
 
 	obj := mapper.CategoryFromProto(req)
 	if err := validation.CategoryValidation(obj); err != nil {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
-		return nil, status.Errorf(c, err.Error())
+		return nil, errStatus(c, err)
 	}
 
 	res, err := h.ctrl.CreateCategory(ctx, obj)
 	if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return &pb.SlugMsg{Slug: res}, nil
@@ -152,23 +147,37 @@ func (h *Handler) UpdateCategory(ctx context.Context, req *pb.CategoryWithSlug)
 	if req == nil || req.Slug == "" {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	fields := req.GetUpdateMask().GetPaths()
+	if len(fields) > 0 && !req.UpdateMask.IsValid(req.GetCategory()) {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate update mask", zap.String("op", op), zap.Strings("paths", fields))
+		return nil, errStatus(c, validation.ErrUnknownField)
 	}
 
+	var err error
 	obj := mapper.CategoryFromProto(req.Category)
-	if err := validation.CategoryValidation(obj); err != nil {
+	if len(fields) > 0 {
+		err = validation.CategoryPatchValidation(obj, fields)
+	} else {
+		err = validation.CategoryValidation(obj)
+	}
+	if err != nil {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to validate request", zap.String("op", op), zap.Error(err))
-		return nil, status.Errorf(c, err.Error())
+		return nil, errStatus(c, err)
 	}
 
-	err := h.ctrl.UpdateCategory(ctx, req.Slug, obj)
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = codes.NotFound
-		return nil, status.Errorf(c, err.Error())
-	} else if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+	if len(fields) > 0 {
+		err = h.ctrl.PatchCategory(ctx, req.Slug, obj, fields)
+	} else {
+		err = h.ctrl.UpdateCategory(ctx, req.Slug, obj)
+	}
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return &pb.Empty{}, nil
@@ -187,16 +196,13 @@ func (h *Handler) DeleteCategory(ctx context.Context, req *pb.SlugMsg) (*pb.Empt
 	if req == nil || req.Slug == "" {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
 	}
 
 	err := h.ctrl.DeleteCategory(ctx, req.Slug)
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = codes.NotFound
-		return nil, status.Errorf(c, err.Error())
-	} else if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return &pb.Empty{}, nil
@@ -216,13 +222,13 @@ func (h *Handler) CategoryFiltersSearch(ctx context.Context, req *pb.SearchReq)
 	if q == "" || page == 0 || size == 0 {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
 	}
 
 	res, err := h.ctrl.CategoryFiltersSearch(ctx, q, int(page), int(size))
 	if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return &pb.PaginatedFilterRes{
@@ -247,16 +253,41 @@ func (h *Handler) ListCategoryFilters(ctx context.Context, req *pb.SlugMsg) (*pb
 	if req == nil || req.Slug == "" {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
 	}
 
 	res, err := h.ctrl.ListCategoryFilters(ctx, req.Slug)
 	if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return &pb.FilterListRes{
 		Data: mapper.ListFiltersToProto(res),
 	}, nil
 }
+
+func (h *Handler) RebuildCategoryFilters(ctx context.Context, req *pb.SlugMsg) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "items.RebuildCategoryFilters.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Slug == "" {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	err := h.ctrl.RebuildCategoryFilters(ctx, req.Slug)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
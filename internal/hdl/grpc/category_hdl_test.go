@@ -11,6 +11,7 @@ import (
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"testing"
 )
 
@@ -368,6 +369,34 @@ func TestHandler_UpdateCategory(t *testing.T) {
 				assert.Equal(t, codes.InvalidArgument, status.Code(err))
 			},
 		},
+		{
+			name: "Unknown mask path",
+			req: &pb.CategoryWithSlug{
+				Slug:       "test-slug",
+				Category:   &pb.CategoryMsg{Src: "new.png"},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"unknown"}},
+			},
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "Patch with update mask",
+			req: &pb.CategoryWithSlug{
+				Slug:       "test-slug",
+				Category:   &pb.CategoryMsg{Src: "new.png"},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"src"}},
+			},
+			mockExpect: func() {
+				mctrl.EXPECT().PatchCategory(gomock.Any(), "test-slug", gomock.Any(), []string{"src"}).Return(nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
+				assert.NotNil(t, res)
+				assert.Nil(t, err)
+			},
+		},
 		{
 			name: "Success",
 			req:  &pb.CategoryWithSlug{Slug: "test-slug", Category: &pb.CategoryMsg{Title: "Test Category"}},
@@ -390,6 +419,22 @@ func TestHandler_UpdateCategory(t *testing.T) {
 				assert.Equal(t, codes.NotFound, status.Code(err))
 			},
 		},
+		{
+			name: "Version conflict",
+			req:  &pb.CategoryWithSlug{Slug: "test-slug", Category: &pb.CategoryMsg{Title: "Test Category", Version: 2}},
+			mockExpect: func() {
+				mctrl.EXPECT().UpdateCategory(gomock.Any(), "test-slug", gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, req *model.Category) error {
+						assert.Equal(t, 2, req.Version)
+						return ctrl.ErrVersionConflict
+					},
+				).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.Aborted, status.Code(err))
+			},
+		},
 		{
 			name: "Internal error",
 			req:  &pb.CategoryWithSlug{Slug: "test-slug", Category: &pb.CategoryMsg{Title: "Test Category"}},
@@ -0,0 +1,207 @@
+package grpc
+
+import (
+	"context"
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/pkg/model/mapper"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"time"
+)
+
+func (h *Handler) ListCustomerGroups(ctx context.Context, req *pb.Empty) (*pb.CustomerGroupListRes, error) {
+	s, c := time.Now(), codes.OK
+	const op = "customerGroups.ListCustomerGroups.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	res, err := h.ctrl.ListCustomerGroups(ctx)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.CustomerGroupListRes{Data: mapper.ListCustomerGroupsToProto(res)}, nil
+}
+
+func (h *Handler) GetCustomerGroup(ctx context.Context, req *pb.SlugMsg) (*pb.CustomerGroupMsg, error) {
+	s, c := time.Now(), codes.OK
+	const op = "customerGroups.GetCustomerGroup.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Slug == "" {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	res, err := h.ctrl.GetCustomerGroup(ctx, req.Slug)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return mapper.CustomerGroupToProto(res), nil
+}
+
+func (h *Handler) CreateCustomerGroup(ctx context.Context, req *pb.CustomerGroupMsg) (*pb.SlugMsg, error) {
+	s, c := time.Now(), codes.OK
+	const op = "customerGroups.CreateCustomerGroup.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	g := mapper.CustomerGroupFromProto(req)
+	if err := validation.CustomerGroupValidation(g); err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
+	}
+
+	res, err := h.ctrl.CreateCustomerGroup(ctx, g)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.SlugMsg{Slug: res}, nil
+}
+
+func (h *Handler) UpdateCustomerGroup(ctx context.Context, req *pb.CustomerGroupMsg) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "customerGroups.UpdateCustomerGroup.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Slug == "" {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	g := mapper.CustomerGroupFromProto(req)
+	if err := validation.CustomerGroupValidation(g); err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
+	}
+
+	err := h.ctrl.UpdateCustomerGroup(ctx, req.Slug, g)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *Handler) DeleteCustomerGroup(ctx context.Context, req *pb.SlugMsg) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "customerGroups.DeleteCustomerGroup.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Slug == "" {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	err := h.ctrl.DeleteCustomerGroup(ctx, req.Slug)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *Handler) ListQuantityBreaks(ctx context.Context, req *pb.UuidMsg) (*pb.QuantityBreakListRes, error) {
+	s, c := time.Now(), codes.OK
+	const op = "customerGroups.ListQuantityBreaks.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	uid, err := uuid.Parse(req.Uuid)
+	if err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	res, err := h.ctrl.ListQuantityBreaks(ctx, uid)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.QuantityBreakListRes{Data: mapper.ListQuantityBreaksToProto(res)}, nil
+}
+
+func (h *Handler) SetQuantityBreaks(ctx context.Context, req *pb.SetQuantityBreaksReq) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "customerGroups.SetQuantityBreaks.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	uid, err := uuid.Parse(req.ItemId)
+	if err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	breaks := mapper.ListQuantityBreaksFromProto(uid, req.Breaks)
+	if err = validation.QuantityBreaksValidation(breaks); err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
+	}
+
+	err = h.ctrl.SetQuantityBreaks(ctx, uid, breaks)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
@@ -0,0 +1,160 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestHandler_GetCustomerGroup(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	msso := mocks.NewMockSSOSvc(mock)
+	h := New(mctrl, msso)
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		req          *pb.SlugMsg
+		mockExpect   func()
+		expectedResp func(*testing.T, *pb.CustomerGroupMsg, error)
+	}{
+		{
+			name:       "Empty slug",
+			req:        &pb.SlugMsg{},
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *pb.CustomerGroupMsg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "Not found",
+			req:  &pb.SlugMsg{Slug: "wholesale"},
+			mockExpect: func() {
+				mctrl.EXPECT().GetCustomerGroup(gomock.Any(), "wholesale").Return(nil, ctrl.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.CustomerGroupMsg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "Internal error",
+			req:  &pb.SlugMsg{Slug: "wholesale"},
+			mockExpect: func() {
+				mctrl.EXPECT().GetCustomerGroup(gomock.Any(), "wholesale").Return(nil, errors.New("internal error")).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.CustomerGroupMsg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.Internal, status.Code(err))
+			},
+		},
+		{
+			name: "Success",
+			req:  &pb.SlugMsg{Slug: "wholesale"},
+			mockExpect: func() {
+				mctrl.EXPECT().GetCustomerGroup(gomock.Any(), "wholesale").Return(
+					&model.CustomerGroup{ID: 1, Slug: "wholesale", Name: "Wholesale", PriceListID: 2, PriceListSlug: "b2b"}, nil,
+				).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.CustomerGroupMsg, err error) {
+				assert.Equal(t, codes.OK, status.Code(err))
+				assert.Equal(t, "b2b", res.PriceListSlug)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := h.GetCustomerGroup(ctx, tt.req)
+				tt.expectedResp(t, res, err)
+			},
+		)
+	}
+}
+
+func TestHandler_SetQuantityBreaks(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	msso := mocks.NewMockSSOSvc(mock)
+	h := New(mctrl, msso)
+
+	ctx := context.Background()
+	uid := uuid.New()
+	breaks := []*pb.QuantityBreakMsg{{MinQuantity: 10, Discount: 5}}
+
+	tests := []struct {
+		name         string
+		req          *pb.SetQuantityBreaksReq
+		mockExpect   func()
+		expectedResp func(*testing.T, *pb.Empty, error)
+	}{
+		{
+			name:       "Invalid uuid",
+			req:        &pb.SetQuantityBreaksReq{ItemId: "invalid", Breaks: breaks},
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name:       "Invalid discount",
+			req:        &pb.SetQuantityBreaksReq{ItemId: uid.String(), Breaks: []*pb.QuantityBreakMsg{{MinQuantity: 10, Discount: 100}}},
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "Not found",
+			req:  &pb.SetQuantityBreaksReq{ItemId: uid.String(), Breaks: breaks},
+			mockExpect: func() {
+				mctrl.EXPECT().SetQuantityBreaks(gomock.Any(), uid, gomock.Any()).Return(ctrl.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "Success",
+			req:  &pb.SetQuantityBreaksReq{ItemId: uid.String(), Breaks: breaks},
+			mockExpect: func() {
+				mctrl.EXPECT().SetQuantityBreaks(gomock.Any(), uid, gomock.Any()).Return(nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
+				assert.Equal(t, codes.OK, status.Code(err))
+				assert.NotNil(t, res)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := h.SetQuantityBreaks(ctx, tt.req)
+				tt.expectedResp(t, res, err)
+			},
+		)
+	}
+}
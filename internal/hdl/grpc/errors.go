@@ -0,0 +1,9 @@
+package grpc
+
+import "github.com/JMURv/par-pro/products/internal/hdl/grpc/interceptors"
+
+// The handlers translate errors the same way the interceptors do.
+var (
+	errCode   = interceptors.ErrorCode
+	errStatus = interceptors.ErrorStatus
+)
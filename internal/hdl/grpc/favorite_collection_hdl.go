@@ -0,0 +1,411 @@
+package grpc
+
+import (
+	"context"
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/JMURv/par-pro/products/pkg/model/mapper"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"time"
+)
+
+func (h *Handler) ListFavoriteCollections(ctx context.Context, req *pb.UuidMsg) (*pb.FavoriteCollectionListMsg, error) {
+	s, c := time.Now(), codes.OK
+	const op = "favorites.ListFavoriteCollections.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Uuid == "" {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	uid, err := uuid.Parse(req.Uuid)
+	if err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	if c, err = requireSelf(ctx, uid); err != nil {
+		zap.L().Debug("caller does not own the favorites", zap.String("op", op))
+		return nil, errStatus(c, err)
+	}
+
+	res, err := h.ctrl.ListFavoriteCollections(ctx, uid)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.FavoriteCollectionListMsg{
+		Data: mapper.ListFavoriteCollectionsToProto(res),
+	}, nil
+}
+
+func (h *Handler) GetFavoriteCollection(ctx context.Context, req *pb.FavoriteCollectionReq) (*pb.FavoriteCollectionMsg, error) {
+	s, c := time.Now(), codes.OK
+	const op = "favorites.GetFavoriteCollection.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.UserId == "" || req.Id == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	if c, err = requireSelf(ctx, uid); err != nil {
+		zap.L().Debug("caller does not own the favorites", zap.String("op", op))
+		return nil, errStatus(c, err)
+	}
+
+	res, err := h.ctrl.GetFavoriteCollection(ctx, uid, req.Id)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return mapper.FavoriteCollectionToProto(res), nil
+}
+
+func (h *Handler) GetSharedFavoriteCollection(ctx context.Context, req *pb.ShareTokenMsg) (*pb.FavoriteCollectionMsg, error) {
+	s, c := time.Now(), codes.OK
+	const op = "favorites.GetSharedFavoriteCollection.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Token == "" {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	res, err := h.ctrl.GetSharedFavoriteCollection(ctx, req.Token)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return mapper.FavoriteCollectionToProto(res), nil
+}
+
+func (h *Handler) CreateFavoriteCollection(ctx context.Context, req *pb.FavoriteCollectionMsg) (*pb.Uint64Msg, error) {
+	s, c := time.Now(), codes.OK
+	const op = "favorites.CreateFavoriteCollection.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.UserId == "" {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	if c, err = requireSelf(ctx, uid); err != nil {
+		zap.L().Debug("caller does not own the favorites", zap.String("op", op))
+		return nil, errStatus(c, err)
+	}
+
+	obj := &model.FavoriteCollection{Name: req.Name}
+	if err = validation.FavoriteCollectionValidation(obj); err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
+	}
+
+	res, err := h.ctrl.CreateFavoriteCollection(ctx, uid, obj)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Uint64Msg{Value: res}, nil
+}
+
+func (h *Handler) UpdateFavoriteCollection(ctx context.Context, req *pb.FavoriteCollectionMsg) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "favorites.UpdateFavoriteCollection.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.UserId == "" || req.Id == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	if c, err = requireSelf(ctx, uid); err != nil {
+		zap.L().Debug("caller does not own the favorites", zap.String("op", op))
+		return nil, errStatus(c, err)
+	}
+
+	obj := &model.FavoriteCollection{Name: req.Name}
+	if err = validation.FavoriteCollectionValidation(obj); err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
+	}
+
+	err = h.ctrl.UpdateFavoriteCollection(ctx, uid, req.Id, obj)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *Handler) DeleteFavoriteCollection(ctx context.Context, req *pb.FavoriteCollectionReq) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "favorites.DeleteFavoriteCollection.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.UserId == "" || req.Id == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	if c, err = requireSelf(ctx, uid); err != nil {
+		zap.L().Debug("caller does not own the favorites", zap.String("op", op))
+		return nil, errStatus(c, err)
+	}
+
+	err = h.ctrl.DeleteFavoriteCollection(ctx, uid, req.Id)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *Handler) ShareFavoriteCollection(ctx context.Context, req *pb.FavoriteCollectionReq) (*pb.ShareTokenMsg, error) {
+	s, c := time.Now(), codes.OK
+	const op = "favorites.ShareFavoriteCollection.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.UserId == "" || req.Id == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	if c, err = requireSelf(ctx, uid); err != nil {
+		zap.L().Debug("caller does not own the favorites", zap.String("op", op))
+		return nil, errStatus(c, err)
+	}
+
+	res, err := h.ctrl.ShareFavoriteCollection(ctx, uid, req.Id)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.ShareTokenMsg{Token: res}, nil
+}
+
+func (h *Handler) UnshareFavoriteCollection(ctx context.Context, req *pb.FavoriteCollectionReq) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "favorites.UnshareFavoriteCollection.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.UserId == "" || req.Id == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	if c, err = requireSelf(ctx, uid); err != nil {
+		zap.L().Debug("caller does not own the favorites", zap.String("op", op))
+		return nil, errStatus(c, err)
+	}
+
+	err = h.ctrl.UnshareFavoriteCollection(ctx, uid, req.Id)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *Handler) SetFavoriteCollectionItem(ctx context.Context, req *pb.FavoriteCollectionItemMsg) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "favorites.SetFavoriteCollectionItem.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.UserId == "" || req.CollectionId == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	if c, err = requireSelf(ctx, uid); err != nil {
+		zap.L().Debug("caller does not own the favorites", zap.String("op", op))
+		return nil, errStatus(c, err)
+	}
+
+	itemID, err := uuid.Parse(req.ItemId)
+	if err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	obj := &model.FavoriteCollectionItem{ItemID: itemID, Note: req.Note}
+	if err = validation.FavoriteCollectionItemValidation(obj); err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
+	}
+
+	err = h.ctrl.SetFavoriteCollectionItem(ctx, uid, req.CollectionId, obj)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *Handler) RemoveFavoriteCollectionItem(ctx context.Context, req *pb.FavoriteCollectionItemMsg) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "favorites.RemoveFavoriteCollectionItem.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.UserId == "" || req.CollectionId == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	if c, err = requireSelf(ctx, uid); err != nil {
+		zap.L().Debug("caller does not own the favorites", zap.String("op", op))
+		return nil, errStatus(c, err)
+	}
+
+	itemID, err := uuid.Parse(req.ItemId)
+	if err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	err = h.ctrl.RemoveFavoriteCollectionItem(ctx, uid, req.CollectionId, itemID)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
@@ -0,0 +1,162 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestHandler_CreateFavoriteCollection(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	msso := mocks.NewMockSSOSvc(mock)
+	h := New(mctrl, msso)
+
+	uid := uuid.New()
+	ctx := ctrl.WithUserID(context.Background(), uid.String())
+
+	tests := []struct {
+		name         string
+		req          *pb.FavoriteCollectionMsg
+		mockExpect   func()
+		expectedResp func(*testing.T, *pb.Uint64Msg, error)
+	}{
+		{
+			name:       "Invalid request",
+			req:        &pb.FavoriteCollectionMsg{Name: "Birthday"},
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *pb.Uint64Msg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name:       "Missing name",
+			req:        &pb.FavoriteCollectionMsg{UserId: uid.String()},
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *pb.Uint64Msg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "Already exists",
+			req:  &pb.FavoriteCollectionMsg{UserId: uid.String(), Name: "Birthday"},
+			mockExpect: func() {
+				mctrl.EXPECT().CreateFavoriteCollection(gomock.Any(), uid, gomock.Any()).
+					Return(uint64(0), ctrl.ErrAlreadyExists).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.Uint64Msg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.AlreadyExists, status.Code(err))
+			},
+		},
+		{
+			name: "Success",
+			req:  &pb.FavoriteCollectionMsg{UserId: uid.String(), Name: "Birthday"},
+			mockExpect: func() {
+				mctrl.EXPECT().CreateFavoriteCollection(gomock.Any(), uid, gomock.Any()).
+					Return(uint64(1), nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.Uint64Msg, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, uint64(1), res.Value)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := h.CreateFavoriteCollection(ctx, tt.req)
+				tt.expectedResp(t, res, err)
+			},
+		)
+	}
+}
+
+func TestHandler_ShareFavoriteCollection(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	msso := mocks.NewMockSSOSvc(mock)
+	h := New(mctrl, msso)
+
+	uid := uuid.New()
+	ctx := ctrl.WithUserID(context.Background(), uid.String())
+
+	tests := []struct {
+		name         string
+		req          *pb.FavoriteCollectionReq
+		mockExpect   func()
+		expectedResp func(*testing.T, *pb.ShareTokenMsg, error)
+	}{
+		{
+			name:       "Invalid request",
+			req:        &pb.FavoriteCollectionReq{UserId: uid.String()},
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *pb.ShareTokenMsg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "Not found",
+			req:  &pb.FavoriteCollectionReq{UserId: uid.String(), Id: 1},
+			mockExpect: func() {
+				mctrl.EXPECT().ShareFavoriteCollection(gomock.Any(), uid, uint64(1)).
+					Return("", ctrl.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.ShareTokenMsg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "Internal error",
+			req:  &pb.FavoriteCollectionReq{UserId: uid.String(), Id: 1},
+			mockExpect: func() {
+				mctrl.EXPECT().ShareFavoriteCollection(gomock.Any(), uid, uint64(1)).
+					Return("", errors.New("internal error")).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.ShareTokenMsg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.Internal, status.Code(err))
+			},
+		},
+		{
+			name: "Success",
+			req:  &pb.FavoriteCollectionReq{UserId: uid.String(), Id: 1},
+			mockExpect: func() {
+				mctrl.EXPECT().ShareFavoriteCollection(gomock.Any(), uid, uint64(1)).
+					Return("token", nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.ShareTokenMsg, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, "token", res.Token)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := h.ShareFavoriteCollection(ctx, tt.req)
+				tt.expectedResp(t, res, err)
+			},
+		)
+	}
+}
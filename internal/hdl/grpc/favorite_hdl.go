@@ -2,20 +2,20 @@ package grpc
 
 import (
 	"context"
-	"errors"
 	pb "github.com/JMURv/par-pro/products/api/pb"
 	"github.com/JMURv/par-pro/products/internal/ctrl"
 	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/pkg/model"
 	"github.com/JMURv/par-pro/products/pkg/model/mapper"
 	"github.com/google/uuid"
 	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"time"
 )
 
-func (h *Handler) ListFavorites(ctx context.Context, req *pb.UuidMsg) (*pb.FavoriteListMsg, error) {
+func (h *Handler) ListFavorites(ctx context.Context, req *pb.ListFavoritesReq) (*pb.PaginatedFavoriteRes, error) {
 	s, c := time.Now(), codes.OK
 	const op = "items.ListFavorites.handler"
 	span := opentracing.GlobalTracer().StartSpan(op)
@@ -25,27 +25,42 @@ func (h *Handler) ListFavorites(ctx context.Context, req *pb.UuidMsg) (*pb.Favor
 		metrics.ObserveRequest(time.Since(s), int(c), op)
 	}()
 
-	if req == nil || req.Uuid == "" {
+	if req == nil || req.UserId == "" || req.Page == 0 || req.Size == 0 {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
 	}
 
-	uid, err := uuid.Parse(req.Uuid)
+	uid, err := uuid.Parse(req.UserId)
 	if err != nil {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrParseUUID.Error())
+		return nil, errStatus(c, ctrl.ErrParseUUID)
 	}
 
-	res, err := h.ctrl.ListFavorites(ctx, uid)
+	if c, err = requireSelf(ctx, uid); err != nil {
+		zap.L().Debug("caller does not own the favorites", zap.String("op", op))
+		return nil, errStatus(c, err)
+	}
+
+	if err = validation.FavoriteSortValidation(req.Sort); err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
+	}
+
+	res, err := h.ctrl.ListFavorites(ctx, uid, int(req.Page), int(req.Size), req.Sort)
 	if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
-	return &pb.FavoriteListMsg{
-		Data: mapper.ListFavoriteToProto(res),
+	return &pb.PaginatedFavoriteRes{
+		Data:        mapper.ListFavoriteToProto(res.Data),
+		Count:       res.Count,
+		TotalPages:  int64(res.TotalPages),
+		CurrentPage: int64(res.CurrentPage),
+		HasNextPage: res.HasNextPage,
 	}, nil
 }
 
@@ -62,33 +77,32 @@ func (h *Handler) AddToFavorites(ctx context.Context, req *pb.UserAndItemIds) (*
 	if req == nil || req.UserId == "" || req.ItemId == "" {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
 	}
 
 	userUID, err := uuid.Parse(req.UserId)
 	if err != nil {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrParseUUID.Error())
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	if c, err = requireSelf(ctx, userUID); err != nil {
+		zap.L().Debug("caller does not own the favorites", zap.String("op", op))
+		return nil, errStatus(c, err)
 	}
 
 	itemUID, err := uuid.Parse(req.ItemId)
 	if err != nil {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrParseUUID.Error())
+		return nil, errStatus(c, ctrl.ErrParseUUID)
 	}
 
 	res, err := h.ctrl.AddToFavorites(ctx, userUID, itemUID)
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = codes.NotFound
-		return nil, status.Errorf(c, err.Error())
-	} else if err != nil && errors.Is(err, ctrl.ErrAlreadyExists) {
-		c = codes.AlreadyExists
-		return nil, status.Errorf(c, err.Error())
-	} else if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return mapper.FavoriteToProto(res), nil
@@ -107,30 +121,82 @@ func (h *Handler) RemoveFromFavorites(ctx context.Context, req *pb.UserAndItemId
 	if req == nil || req.UserId == "" || req.ItemId == "" {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
 	}
 
 	userUID, err := uuid.Parse(req.UserId)
 	if err != nil {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrParseUUID.Error())
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	if c, err = requireSelf(ctx, userUID); err != nil {
+		zap.L().Debug("caller does not own the favorites", zap.String("op", op))
+		return nil, errStatus(c, err)
 	}
 
 	itemUID, err := uuid.Parse(req.ItemId)
 	if err != nil {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrParseUUID.Error())
+		return nil, errStatus(c, ctrl.ErrParseUUID)
 	}
 
 	err = h.ctrl.RemoveFromFavorites(ctx, userUID, itemUID)
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = codes.NotFound
-		return nil, status.Errorf(c, err.Error())
-	} else if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *Handler) SetFavoriteNotifications(ctx context.Context, req *pb.FavoriteNotificationsReq) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "items.SetFavoriteNotifications.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.UserId == "" || req.ItemId == "" {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	userUID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	if c, err = requireSelf(ctx, userUID); err != nil {
+		zap.L().Debug("caller does not own the favorites", zap.String("op", op))
+		return nil, errStatus(c, err)
+	}
+
+	itemUID, err := uuid.Parse(req.ItemId)
+	if err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	err = h.ctrl.SetFavoriteNotifications(
+		ctx, userUID, &model.Favorite{
+			ItemID:          itemUID,
+			NotifyPriceDrop: req.NotifyPriceDrop,
+			NotifyInStock:   req.NotifyInStock,
+		},
+	)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return &pb.Empty{}, nil
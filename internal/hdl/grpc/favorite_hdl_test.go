@@ -23,57 +23,78 @@ func TestHandler_ListFavorites(t *testing.T) {
 	msso := mocks.NewMockSSOSvc(mock)
 	h := New(mctrl, msso)
 
-	ctx := context.Background()
+	uid := uuid.New()
+	ctx := ctrl.WithUserID(context.Background(), uid.String())
 
 	tests := []struct {
 		name         string
-		req          *pb.UuidMsg
+		req          *pb.ListFavoritesReq
 		mockExpect   func()
-		expectedResp func(*testing.T, *pb.FavoriteListMsg, error)
+		expectedResp func(*testing.T, *pb.PaginatedFavoriteRes, error)
 	}{
 		{
 			name:       "Invalid request",
-			req:        &pb.UuidMsg{Uuid: ""},
+			req:        &pb.ListFavoritesReq{UserId: "", Page: 1, Size: 40},
 			mockExpect: func() {},
-			expectedResp: func(t *testing.T, res *pb.FavoriteListMsg, err error) {
+			expectedResp: func(t *testing.T, res *pb.PaginatedFavoriteRes, err error) {
 				assert.Nil(t, res)
 				assert.Equal(t, codes.InvalidArgument, status.Code(err))
 			},
 		},
 		{
 			name:       "Invalid UUID",
-			req:        &pb.UuidMsg{Uuid: "invalid-uuid"},
+			req:        &pb.ListFavoritesReq{UserId: "invalid-uuid", Page: 1, Size: 40},
 			mockExpect: func() {},
-			expectedResp: func(t *testing.T, res *pb.FavoriteListMsg, err error) {
+			expectedResp: func(t *testing.T, res *pb.PaginatedFavoriteRes, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name:       "Invalid sort",
+			req:        &pb.ListFavoritesReq{UserId: uid.String(), Page: 1, Size: 40, Sort: "title"},
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *pb.PaginatedFavoriteRes, err error) {
 				assert.Nil(t, res)
 				assert.Equal(t, codes.InvalidArgument, status.Code(err))
 			},
 		},
 		{
 			name: "Success",
-			req:  &pb.UuidMsg{Uuid: uuid.New().String()},
+			req:  &pb.ListFavoritesReq{UserId: uid.String(), Page: 1, Size: 40, Sort: "-price"},
 			mockExpect: func() {
-				mctrl.EXPECT().ListFavorites(gomock.Any(), gomock.Any()).Return(
-					[]*model.Favorite{}, nil,
+				mctrl.EXPECT().ListFavorites(gomock.Any(), uid, 1, 40, "-price").Return(
+					&model.PaginatedFavoritesData{
+						Data: []*model.Favorite{
+							{
+								UserID:         uid,
+								Item:           model.Item{Labels: []string{"hit"}},
+								EffectivePrice: model.NewMoney(945, "RUB"),
+							},
+						},
+						Count:       1,
+						TotalPages:  1,
+						CurrentPage: 1,
+					}, nil,
 				).Times(1)
 			},
-			expectedResp: func(t *testing.T, res *pb.FavoriteListMsg, err error) {
-				expectedRes := &pb.FavoriteListMsg{
-					Data: []*pb.FavoriteMsg{},
-				}
-				assert.Equal(t, expectedRes, res)
+			expectedResp: func(t *testing.T, res *pb.PaginatedFavoriteRes, err error) {
 				assert.Equal(t, codes.OK, status.Code(err))
+				assert.Len(t, res.Data, 1)
+				assert.Equal(t, int64(1), res.Count)
+				assert.Equal(t, int64(945), res.Data[0].EffectivePrice.Amount)
+				assert.Equal(t, []string{"hit"}, res.Data[0].Item.Labels)
 			},
 		},
 		{
 			name: "Internal error",
-			req:  &pb.UuidMsg{Uuid: uuid.New().String()},
+			req:  &pb.ListFavoritesReq{UserId: uid.String(), Page: 1, Size: 40},
 			mockExpect: func() {
-				mctrl.EXPECT().ListFavorites(gomock.Any(), gomock.Any()).Return(
+				mctrl.EXPECT().ListFavorites(gomock.Any(), uid, 1, 40, "").Return(
 					nil, errors.New("internal error"),
 				).Times(1)
 			},
-			expectedResp: func(t *testing.T, res *pb.FavoriteListMsg, err error) {
+			expectedResp: func(t *testing.T, res *pb.PaginatedFavoriteRes, err error) {
 				assert.Nil(t, res)
 				assert.Equal(t, codes.Internal, status.Code(err))
 			},
@@ -99,7 +120,8 @@ func TestHandler_AddToFavorites(t *testing.T) {
 	msso := mocks.NewMockSSOSvc(mock)
 	h := New(mctrl, msso)
 
-	ctx := context.Background()
+	uid := uuid.New()
+	ctx := ctrl.WithUserID(context.Background(), uid.String())
 
 	tests := []struct {
 		name         string
@@ -125,9 +147,18 @@ func TestHandler_AddToFavorites(t *testing.T) {
 				assert.Equal(t, codes.InvalidArgument, status.Code(err))
 			},
 		},
+		{
+			name:       "Foreign user",
+			req:        &pb.UserAndItemIds{UserId: uuid.New().String(), ItemId: uuid.New().String()},
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *pb.FavoriteMsg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
 		{
 			name:       "Invalid item UUID",
-			req:        &pb.UserAndItemIds{UserId: uuid.New().String(), ItemId: "invalid-uuid"},
+			req:        &pb.UserAndItemIds{UserId: uid.String(), ItemId: "invalid-uuid"},
 			mockExpect: func() {},
 			expectedResp: func(t *testing.T, res *pb.FavoriteMsg, err error) {
 				assert.Nil(t, res)
@@ -136,7 +167,7 @@ func TestHandler_AddToFavorites(t *testing.T) {
 		},
 		{
 			name: "Success",
-			req:  &pb.UserAndItemIds{UserId: uuid.New().String(), ItemId: uuid.New().String()},
+			req:  &pb.UserAndItemIds{UserId: uid.String(), ItemId: uuid.New().String()},
 			mockExpect: func() {
 				mctrl.EXPECT().AddToFavorites(gomock.Any(), gomock.Any(), gomock.Any()).Return(
 					&model.Favorite{}, nil,
@@ -148,7 +179,7 @@ func TestHandler_AddToFavorites(t *testing.T) {
 		},
 		{
 			name: "Not found",
-			req:  &pb.UserAndItemIds{UserId: uuid.New().String(), ItemId: uuid.New().String()},
+			req:  &pb.UserAndItemIds{UserId: uid.String(), ItemId: uuid.New().String()},
 			mockExpect: func() {
 				mctrl.EXPECT().AddToFavorites(gomock.Any(), gomock.Any(), gomock.Any()).Return(
 					nil, ctrl.ErrNotFound,
@@ -161,7 +192,7 @@ func TestHandler_AddToFavorites(t *testing.T) {
 		},
 		{
 			name: "Already exists",
-			req:  &pb.UserAndItemIds{UserId: uuid.New().String(), ItemId: uuid.New().String()},
+			req:  &pb.UserAndItemIds{UserId: uid.String(), ItemId: uuid.New().String()},
 			mockExpect: func() {
 				mctrl.EXPECT().AddToFavorites(gomock.Any(), gomock.Any(), gomock.Any()).Return(
 					nil, ctrl.ErrAlreadyExists,
@@ -174,7 +205,7 @@ func TestHandler_AddToFavorites(t *testing.T) {
 		},
 		{
 			name: "Internal error",
-			req:  &pb.UserAndItemIds{UserId: uuid.New().String(), ItemId: uuid.New().String()},
+			req:  &pb.UserAndItemIds{UserId: uid.String(), ItemId: uuid.New().String()},
 			mockExpect: func() {
 				mctrl.EXPECT().AddToFavorites(gomock.Any(), gomock.Any(), gomock.Any()).Return(
 					nil, errors.New("internal error"),
@@ -206,7 +237,8 @@ func TestHandler_RemoveFromFavorites(t *testing.T) {
 	msso := mocks.NewMockSSOSvc(mock)
 	h := New(mctrl, msso)
 
-	ctx := context.Background()
+	uid := uuid.New()
+	ctx := ctrl.WithUserID(context.Background(), uid.String())
 
 	tests := []struct {
 		name         string
@@ -234,7 +266,7 @@ func TestHandler_RemoveFromFavorites(t *testing.T) {
 		},
 		{
 			name:       "Invalid item UUID",
-			req:        &pb.UserAndItemIds{UserId: uuid.New().String(), ItemId: "invalid-uuid"},
+			req:        &pb.UserAndItemIds{UserId: uid.String(), ItemId: "invalid-uuid"},
 			mockExpect: func() {},
 			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
 				assert.Nil(t, res)
@@ -243,7 +275,7 @@ func TestHandler_RemoveFromFavorites(t *testing.T) {
 		},
 		{
 			name: "Success",
-			req:  &pb.UserAndItemIds{UserId: uuid.New().String(), ItemId: uuid.New().String()},
+			req:  &pb.UserAndItemIds{UserId: uid.String(), ItemId: uuid.New().String()},
 			mockExpect: func() {
 				mctrl.EXPECT().RemoveFromFavorites(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 			},
@@ -254,7 +286,7 @@ func TestHandler_RemoveFromFavorites(t *testing.T) {
 		},
 		{
 			name: "Not found",
-			req:  &pb.UserAndItemIds{UserId: uuid.New().String(), ItemId: uuid.New().String()},
+			req:  &pb.UserAndItemIds{UserId: uid.String(), ItemId: uuid.New().String()},
 			mockExpect: func() {
 				mctrl.EXPECT().RemoveFromFavorites(
 					gomock.Any(),
@@ -269,7 +301,7 @@ func TestHandler_RemoveFromFavorites(t *testing.T) {
 		},
 		{
 			name: "Internal error",
-			req:  &pb.UserAndItemIds{UserId: uuid.New().String(), ItemId: uuid.New().String()},
+			req:  &pb.UserAndItemIds{UserId: uid.String(), ItemId: uuid.New().String()},
 			mockExpect: func() {
 				mctrl.EXPECT().RemoveFromFavorites(
 					gomock.Any(),
@@ -22,6 +22,15 @@ type Handler struct {
 	pb.PromotionServer
 	pb.FavoriteServer
 	pb.OrderServer
+	pb.PriceListServer
+	pb.CustomerGroupServer
+	pb.MediaServer
+	pb.AttributeServer
+	pb.LabelServer
+	pb.ReviewServer
+	pb.QuestionServer
+	pb.TrashServer
+	pb.AuditServer
 	srv  *grpc.Server
 	hsrv *health.Server
 	ctrl hdl.Ctrl
@@ -31,10 +40,16 @@ type Handler struct {
 func New(ctrl hdl.Ctrl, sso sso.SSOSvc) *Handler {
 	srv := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
+			interceptors.RequestIDUnaryInterceptor(),
 			interceptors.AuthUnaryInterceptor(sso),
+			interceptors.CustomerGroupUnaryInterceptor(sso, ctrl),
+			interceptors.PriceListUnaryInterceptor(ctrl),
+			interceptors.IdempotencyUnaryInterceptor(ctrl),
 			metrics.SrvMetrics.UnaryServerInterceptor(pm.WithExemplarFromContext(metrics.Exemplar)),
 		),
 		grpc.ChainStreamInterceptor(
+			interceptors.RequestIDStreamInterceptor(),
+			interceptors.AuthStreamInterceptor(sso),
 			metrics.SrvMetrics.StreamServerInterceptor(pm.WithExemplarFromContext(metrics.Exemplar)),
 		),
 	)
@@ -56,6 +71,15 @@ func (h *Handler) Start(port int) {
 	pb.RegisterPromotionServer(h.srv, h)
 	pb.RegisterFavoriteServer(h.srv, h)
 	pb.RegisterOrderServer(h.srv, h)
+	pb.RegisterPriceListServer(h.srv, h)
+	pb.RegisterCustomerGroupServer(h.srv, h)
+	pb.RegisterMediaServer(h.srv, h)
+	pb.RegisterAttributeServer(h.srv, h)
+	pb.RegisterLabelServer(h.srv, h)
+	pb.RegisterReviewServer(h.srv, h)
+	pb.RegisterQuestionServer(h.srv, h)
+	pb.RegisterTrashServer(h.srv, h)
+	pb.RegisterAuditServer(h.srv, h)
 	grpc_health_v1.RegisterHealthServer(h.srv, h.hsrv)
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%v", port))
@@ -0,0 +1,155 @@
+package interceptors
+
+import (
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+)
+
+// access is the auth requirement of an RPC.
+type access struct {
+	auth bool
+	perm ctrl.Permission
+}
+
+var (
+	public        = access{}
+	authenticated = access{auth: true}
+)
+
+// admin requires a signed-in caller holding perm.
+func admin(perm ctrl.Permission) access {
+	return access{auth: true, perm: perm}
+}
+
+// methodAccess declares the auth requirement of every RPC, calls to undeclared methods are rejected.
+var methodAccess = map[string]access{
+	grpc_health_v1.Health_Check_FullMethodName:                                   public,
+	grpc_health_v1.Health_Watch_FullMethodName:                                   public,
+	grpc_reflection_v1.ServerReflection_ServerReflectionInfo_FullMethodName:      public,
+	grpc_reflection_v1alpha.ServerReflection_ServerReflectionInfo_FullMethodName: public,
+
+	pb.Item_ItemSearch_FullMethodName:           public,
+	pb.Item_ItemAttrSearch_FullMethodName:       public,
+	pb.Item_ListItems_FullMethodName:            public,
+	pb.Item_GetItem_FullMethodName:              public,
+	pb.Item_ListRelatedItems_FullMethodName:     public,
+	pb.Item_ListCategoryItems_FullMethodName:    public,
+	pb.Item_ListItemsByLabel_FullMethodName:     public,
+	pb.Item_GetPriceTimeline_FullMethodName:     public,
+	pb.Item_CreateItem_FullMethodName:           admin(ctrl.PermCatalogManage),
+	pb.Item_UpdateItem_FullMethodName:           admin(ctrl.PermCatalogManage),
+	pb.Item_DeleteItem_FullMethodName:           admin(ctrl.PermCatalogManage),
+	pb.Item_SchedulePriceChange_FullMethodName:  admin(ctrl.PermPricingManage),
+	pb.Item_CancelScheduledPrice_FullMethodName: admin(ctrl.PermPricingManage),
+	pb.Item_ListItemRevisions_FullMethodName:    admin(ctrl.PermCatalogManage),
+	pb.Item_DiffItemRevisions_FullMethodName:    admin(ctrl.PermCatalogManage),
+	pb.Item_RollbackItem_FullMethodName:         admin(ctrl.PermCatalogManage),
+
+	pb.Category_ListCategories_FullMethodName:         public,
+	pb.Category_CategorySearch_FullMethodName:         public,
+	pb.Category_CategoryFiltersSearch_FullMethodName:  public,
+	pb.Category_GetCategory_FullMethodName:            public,
+	pb.Category_ListCategoryFilters_FullMethodName:    public,
+	pb.Category_CreateCategory_FullMethodName:         admin(ctrl.PermCatalogManage),
+	pb.Category_UpdateCategory_FullMethodName:         admin(ctrl.PermCatalogManage),
+	pb.Category_DeleteCategory_FullMethodName:         admin(ctrl.PermCatalogManage),
+	pb.Category_RebuildCategoryFilters_FullMethodName: admin(ctrl.PermCatalogManage),
+
+	pb.Attribute_ListAttributes_FullMethodName:         public,
+	pb.Attribute_GetAttribute_FullMethodName:           public,
+	pb.Attribute_ListCategoryAttributes_FullMethodName: public,
+	pb.Attribute_CreateAttribute_FullMethodName:        admin(ctrl.PermCatalogManage),
+	pb.Attribute_UpdateAttribute_FullMethodName:        admin(ctrl.PermCatalogManage),
+	pb.Attribute_DeleteAttribute_FullMethodName:        admin(ctrl.PermCatalogManage),
+	pb.Attribute_SetCategoryAttributes_FullMethodName:  admin(ctrl.PermCatalogManage),
+
+	pb.Label_ListLabels_FullMethodName:    public,
+	pb.Label_CreateLabel_FullMethodName:   admin(ctrl.PermCatalogManage),
+	pb.Label_UpdateLabel_FullMethodName:   admin(ctrl.PermCatalogManage),
+	pb.Label_DeleteLabel_FullMethodName:   admin(ctrl.PermCatalogManage),
+	pb.Label_SetItemLabels_FullMethodName: admin(ctrl.PermCatalogManage),
+
+	pb.Media_ListItemMedia_FullMethodName:    public,
+	pb.Media_UploadItemMedia_FullMethodName:  admin(ctrl.PermCatalogManage),
+	pb.Media_UpdateItemMedia_FullMethodName:  admin(ctrl.PermCatalogManage),
+	pb.Media_ReorderItemMedia_FullMethodName: admin(ctrl.PermCatalogManage),
+	pb.Media_DeleteItemMedia_FullMethodName:  admin(ctrl.PermCatalogManage),
+
+	pb.Promotion_ListPromotions_FullMethodName:     public,
+	pb.Promotion_PromotionSearch_FullMethodName:    public,
+	pb.Promotion_GetPromotion_FullMethodName:       public,
+	pb.Promotion_ListPromotionItems_FullMethodName: public,
+	pb.Promotion_CreatePromotion_FullMethodName:    admin(ctrl.PermPromotionsManage),
+	pb.Promotion_UpdatePromotion_FullMethodName:    admin(ctrl.PermPromotionsManage),
+	pb.Promotion_DeletePromotion_FullMethodName:    admin(ctrl.PermPromotionsManage),
+
+	pb.PriceList_ListPriceLists_FullMethodName:      public,
+	pb.PriceList_GetPriceList_FullMethodName:        public,
+	pb.PriceList_CreatePriceList_FullMethodName:     admin(ctrl.PermPricingManage),
+	pb.PriceList_UpdatePriceList_FullMethodName:     admin(ctrl.PermPricingManage),
+	pb.PriceList_DeletePriceList_FullMethodName:     admin(ctrl.PermPricingManage),
+	pb.PriceList_ListPriceListItems_FullMethodName:  admin(ctrl.PermPricingManage),
+	pb.PriceList_SetPriceListItems_FullMethodName:   admin(ctrl.PermPricingManage),
+	pb.PriceList_DeletePriceListItem_FullMethodName: admin(ctrl.PermPricingManage),
+
+	pb.CustomerGroup_ListQuantityBreaks_FullMethodName:  public,
+	pb.CustomerGroup_ListCustomerGroups_FullMethodName:  admin(ctrl.PermPricingManage),
+	pb.CustomerGroup_GetCustomerGroup_FullMethodName:    admin(ctrl.PermPricingManage),
+	pb.CustomerGroup_CreateCustomerGroup_FullMethodName: admin(ctrl.PermPricingManage),
+	pb.CustomerGroup_UpdateCustomerGroup_FullMethodName: admin(ctrl.PermPricingManage),
+	pb.CustomerGroup_DeleteCustomerGroup_FullMethodName: admin(ctrl.PermPricingManage),
+	pb.CustomerGroup_SetQuantityBreaks_FullMethodName:   admin(ctrl.PermPricingManage),
+
+	pb.Favorite_GetSharedFavoriteCollection_FullMethodName:  public,
+	pb.Favorite_ListFavorites_FullMethodName:                authenticated,
+	pb.Favorite_AddToFavorites_FullMethodName:               authenticated,
+	pb.Favorite_RemoveFromFavorites_FullMethodName:          authenticated,
+	pb.Favorite_SetFavoriteNotifications_FullMethodName:     authenticated,
+	pb.Favorite_ListFavoriteCollections_FullMethodName:      authenticated,
+	pb.Favorite_GetFavoriteCollection_FullMethodName:        authenticated,
+	pb.Favorite_CreateFavoriteCollection_FullMethodName:     authenticated,
+	pb.Favorite_UpdateFavoriteCollection_FullMethodName:     authenticated,
+	pb.Favorite_DeleteFavoriteCollection_FullMethodName:     authenticated,
+	pb.Favorite_ShareFavoriteCollection_FullMethodName:      authenticated,
+	pb.Favorite_UnshareFavoriteCollection_FullMethodName:    authenticated,
+	pb.Favorite_SetFavoriteCollectionItem_FullMethodName:    authenticated,
+	pb.Favorite_RemoveFavoriteCollectionItem_FullMethodName: authenticated,
+
+	// Guests check out without an account, one is created for them.
+	pb.Order_CreateOrder_FullMethodName:    public,
+	pb.Order_ListUserOrders_FullMethodName: authenticated,
+	pb.Order_GetOrder_FullMethodName:       authenticated,
+	pb.Order_CancelOrder_FullMethodName:    authenticated,
+	pb.Order_ListOrders_FullMethodName:     admin(ctrl.PermOrdersRead),
+	pb.Order_UpdateOrder_FullMethodName:    admin(ctrl.PermOrdersManage),
+	pb.Order_DeleteOrder_FullMethodName:    admin(ctrl.PermOrdersManage),
+
+	pb.Review_ListItemReviews_FullMethodName:    public,
+	pb.Review_CreateReview_FullMethodName:       authenticated,
+	pb.Review_UpdateReview_FullMethodName:       authenticated,
+	pb.Review_DeleteReview_FullMethodName:       authenticated,
+	pb.Review_UploadReviewMedia_FullMethodName:  authenticated,
+	pb.Review_ListPendingReviews_FullMethodName: admin(ctrl.PermContentModerate),
+	pb.Review_ModerateReview_FullMethodName:     admin(ctrl.PermContentModerate),
+
+	pb.Question_ListItemQuestions_FullMethodName:    public,
+	pb.Question_CreateQuestion_FullMethodName:       authenticated,
+	pb.Question_DeleteQuestion_FullMethodName:       authenticated,
+	pb.Question_CreateAnswer_FullMethodName:         authenticated,
+	pb.Question_DeleteAnswer_FullMethodName:         authenticated,
+	pb.Question_ListPendingQuestions_FullMethodName: admin(ctrl.PermContentModerate),
+	pb.Question_ModerateQuestion_FullMethodName:     admin(ctrl.PermContentModerate),
+	pb.Question_ListPendingAnswers_FullMethodName:   admin(ctrl.PermContentModerate),
+	pb.Question_ModerateAnswer_FullMethodName:       admin(ctrl.PermContentModerate),
+	pb.Question_SetAnswerOfficial_FullMethodName:    admin(ctrl.PermContentModerate),
+
+	pb.Trash_ListTrash_FullMethodName:        admin(ctrl.PermTrashManage),
+	pb.Trash_MoveToTrash_FullMethodName:      admin(ctrl.PermTrashManage),
+	pb.Trash_RestoreFromTrash_FullMethodName: admin(ctrl.PermTrashManage),
+	pb.Trash_PurgeFromTrash_FullMethodName:   admin(ctrl.PermTrashManage),
+
+	pb.Audit_ListAuditLog_FullMethodName: admin(ctrl.PermAuditRead),
+}
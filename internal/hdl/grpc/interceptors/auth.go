@@ -2,37 +2,106 @@ package interceptors
 
 import (
 	"context"
+	"github.com/JMURv/par-pro/products/internal/apperr"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
 	"github.com/JMURv/par-pro/products/internal/ctrl/sso"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	"strings"
 )
 
+var errUndeclaredMethod = apperr.New(apperr.Forbidden, "method has no auth requirement")
+
+// AuthUnaryInterceptor signs the caller in and enforces the auth requirement of the called method.
 func AuthUnaryInterceptor(sso sso.SSOSvc) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			zap.L().Debug("missing metadata")
-			return handler(ctx, req)
+		ctx, err := authorize(ctx, sso, info.FullMethod)
+		if err != nil {
+			return nil, err
 		}
+		return handler(ctx, req)
+	}
+}
 
-		authHeaders := md["authorization"]
-		if len(authHeaders) == 0 {
-			zap.L().Debug("missing authorization token")
-			return handler(ctx, req)
+// AuthStreamInterceptor is AuthUnaryInterceptor for streaming methods.
+func AuthStreamInterceptor(sso sso.SSOSvc) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authorize(ss.Context(), sso, info.FullMethod)
+		if err != nil {
+			return err
 		}
+		return handler(srv, &authStream{ServerStream: ss, ctx: ctx})
+	}
+}
 
-		tokenStr := authHeaders[0]
-		if len(tokenStr) > 7 && tokenStr[:7] == "Bearer " {
-			tokenStr = tokenStr[7:]
-		}
+// authStream serves the handler the context of the signed-in caller.
+type authStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
 
-		uid, err := sso.ParseClaims(ctx, tokenStr)
-		if err != nil {
-			return nil, err
-		}
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
 
-		ctx = context.WithValue(ctx, "uid", uid)
-		return handler(ctx, req)
+func authorize(ctx context.Context, sso sso.SSOSvc, method string) (context.Context, error) {
+	acc, ok := methodAccess[method]
+	if !ok {
+		zap.L().Error("undeclared method", zap.String("method", method))
+		return nil, ErrorStatus(ErrorCode(errUndeclaredMethod), errUndeclaredMethod)
+	}
+
+	ctx, err := authenticate(ctx, sso)
+	if err != nil {
+		return nil, err
 	}
+
+	if !acc.auth {
+		return ctx, nil
+	}
+
+	if ctrl.UserIDFromContext(ctx) == "" {
+		zap.L().Debug("missing authorization token", zap.String("method", method))
+		return nil, ErrorStatus(ErrorCode(ctrl.ErrUnauthenticated), ctrl.ErrUnauthenticated)
+	}
+
+	if acc.perm == "" {
+		return ctx, nil
+	}
+
+	if err = ctrl.Authorize(ctx, acc.perm); err != nil {
+		zap.L().Debug("permission denied", zap.String("method", method), zap.String("perm", string(acc.perm)))
+		return nil, ErrorStatus(ErrorCode(err), err)
+	}
+	return ctx, nil
+}
+
+// authenticate puts the caller of a bearer token into the context, anonymous callers are passed through.
+func authenticate(ctx context.Context, sso sso.SSOSvc) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx, nil
+	}
+
+	authHeaders := md.Get("authorization")
+	if len(authHeaders) == 0 {
+		return ctx, nil
+	}
+
+	tokenStr := strings.TrimPrefix(authHeaders[0], "Bearer ")
+	uid, err := sso.ParseClaims(ctx, tokenStr)
+	if err != nil {
+		zap.L().Debug("failed to parse claims", zap.Error(err))
+		return nil, ErrorStatus(ErrorCode(ctrl.ErrUnauthenticated), ctrl.ErrUnauthenticated)
+	}
+
+	// Without permissions the caller is served as an ordinary customer.
+	perms, err := sso.GetPermissions(ctx, tokenStr)
+	if err != nil {
+		zap.L().Debug("failed to get permissions", zap.Error(err))
+	}
+
+	ctx = ctrl.WithUserID(ctx, uid)
+	return ctrl.WithPermissions(ctx, ctrl.ResolvePermissions(perms)), nil
 }
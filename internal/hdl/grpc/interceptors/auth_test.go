@@ -0,0 +1,169 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestMethodAccess_Declared(t *testing.T) {
+	descs := []grpc.ServiceDesc{
+		pb.Item_ServiceDesc, pb.Category_ServiceDesc, pb.Promotion_ServiceDesc, pb.Favorite_ServiceDesc,
+		pb.Order_ServiceDesc, pb.PriceList_ServiceDesc, pb.CustomerGroup_ServiceDesc, pb.Media_ServiceDesc,
+		pb.Attribute_ServiceDesc, pb.Label_ServiceDesc, pb.Review_ServiceDesc, pb.Question_ServiceDesc,
+	}
+
+	for _, d := range descs {
+		for _, m := range d.Methods {
+			_, ok := methodAccess["/"+d.ServiceName+"/"+m.MethodName]
+			assert.True(t, ok, "%s/%s", d.ServiceName, m.MethodName)
+		}
+		for _, m := range d.Streams {
+			_, ok := methodAccess["/"+d.ServiceName+"/"+m.StreamName]
+			assert.True(t, ok, "%s/%s", d.ServiceName, m.StreamName)
+		}
+	}
+}
+
+func TestAuthUnaryInterceptor(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	msso := mocks.NewMockSSOSvc(mock)
+	interceptor := AuthUnaryInterceptor(msso)
+
+	tests := []struct {
+		name       string
+		method     string
+		token      string
+		mockExpect func()
+		code       codes.Code
+	}{
+		{
+			name:       "PublicAnonymous",
+			method:     pb.Item_GetItem_FullMethodName,
+			mockExpect: func() {},
+			code:       codes.OK,
+		},
+		{
+			name:       "AuthenticatedAnonymous",
+			method:     pb.Order_ListUserOrders_FullMethodName,
+			mockExpect: func() {},
+			code:       codes.Unauthenticated,
+		},
+		{
+			name:   "InvalidToken",
+			method: pb.Item_GetItem_FullMethodName,
+			token:  "invalid-token",
+			mockExpect: func() {
+				msso.EXPECT().ParseClaims(gomock.Any(), "invalid-token").Return("", errors.New("invalid token")).Times(1)
+			},
+			code: codes.Unauthenticated,
+		},
+		{
+			name:   "Authenticated",
+			method: pb.Order_ListUserOrders_FullMethodName,
+			token:  "valid-token",
+			mockExpect: func() {
+				msso.EXPECT().ParseClaims(gomock.Any(), "valid-token").Return("user-id", nil).Times(1)
+				msso.EXPECT().GetPermissions(gomock.Any(), "valid-token").Return(nil, nil).Times(1)
+			},
+			code: codes.OK,
+		},
+		{
+			name:   "AdminWithoutPermission",
+			method: pb.Item_CreateItem_FullMethodName,
+			token:  "valid-token",
+			mockExpect: func() {
+				msso.EXPECT().ParseClaims(gomock.Any(), "valid-token").Return("user-id", nil).Times(1)
+				msso.EXPECT().GetPermissions(gomock.Any(), "valid-token").Return([]string{"role:moderator"}, nil).Times(1)
+			},
+			code: codes.PermissionDenied,
+		},
+		{
+			name:   "Admin",
+			method: pb.Item_CreateItem_FullMethodName,
+			token:  "valid-token",
+			mockExpect: func() {
+				msso.EXPECT().ParseClaims(gomock.Any(), "valid-token").Return("user-id", nil).Times(1)
+				msso.EXPECT().GetPermissions(gomock.Any(), "valid-token").Return([]string{"role:manager"}, nil).Times(1)
+			},
+			code: codes.OK,
+		},
+		{
+			name:       "UndeclaredMethod",
+			method:     "/user.Item/Unknown",
+			mockExpect: func() {},
+			code:       codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+
+				ctx := context.Background()
+				if tt.token != "" {
+					ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+tt.token))
+				}
+
+				handler := func(ctx context.Context, req any) (any, error) {
+					if tt.token != "" {
+						assert.Equal(t, "user-id", ctrl.UserIDFromContext(ctx))
+					}
+					return "ok", nil
+				}
+
+				_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+				assert.Equal(t, tt.code, status.Code(err))
+			},
+		)
+	}
+}
+
+type testStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestAuthStreamInterceptor(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	msso := mocks.NewMockSSOSvc(mock)
+	interceptor := AuthStreamInterceptor(msso)
+	info := &grpc.StreamServerInfo{FullMethod: pb.Review_UploadReviewMedia_FullMethodName}
+
+	err := interceptor(
+		nil, &testStream{ctx: context.Background()}, info, func(srv any, ss grpc.ServerStream) error {
+			return nil
+		},
+	)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	msso.EXPECT().ParseClaims(gomock.Any(), "valid-token").Return("user-id", nil).Times(1)
+	msso.EXPECT().GetPermissions(gomock.Any(), "valid-token").Return(nil, nil).Times(1)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer valid-token"))
+	err = interceptor(
+		nil, &testStream{ctx: ctx}, info, func(srv any, ss grpc.ServerStream) error {
+			assert.Equal(t, "user-id", ctrl.UserIDFromContext(ss.Context()))
+			return nil
+		},
+	)
+	assert.Nil(t, err)
+}
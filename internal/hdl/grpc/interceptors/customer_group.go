@@ -0,0 +1,46 @@
+package interceptors
+
+import (
+	"context"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/internal/ctrl/sso"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"strings"
+)
+
+type customerGroupResolver interface {
+	ResolveCustomerGroup(ctx context.Context, slugs []string) (*model.CustomerGroup, error)
+}
+
+// CustomerGroupUnaryInterceptor resolves the customer group of a signed-in caller from the SSO user.
+func CustomerGroupUnaryInterceptor(sso sso.SSOSvc, cg customerGroupResolver) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		authHeaders := md["authorization"]
+		if len(authHeaders) == 0 {
+			return handler(ctx, req)
+		}
+
+		slugs, err := sso.GetCustomerGroups(ctx, strings.TrimPrefix(authHeaders[0], "Bearer "))
+		if err != nil {
+			zap.L().Debug("failed to get customer groups", zap.Error(err))
+			return handler(ctx, req)
+		}
+
+		g, err := cg.ResolveCustomerGroup(ctx, slugs)
+		if err != nil {
+			return nil, ErrorStatus(ErrorCode(err), err)
+		} else if g == nil {
+			return handler(ctx, req)
+		}
+
+		return handler(ctrl.WithCustomerGroup(ctx, g), req)
+	}
+}
@@ -0,0 +1,80 @@
+package interceptors
+
+import (
+	"errors"
+	"github.com/JMURv/par-pro/products/internal/apperr"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// errorDomain is the domain of the errdetails.ErrorInfo attached to the errors of the service.
+const errorDomain = "products"
+
+var kindCodes = map[apperr.Kind]codes.Code{
+	apperr.Validation:         codes.InvalidArgument,
+	apperr.Unauthenticated:    codes.Unauthenticated,
+	apperr.Forbidden:          codes.PermissionDenied,
+	apperr.NotFound:           codes.NotFound,
+	apperr.AlreadyExists:      codes.AlreadyExists,
+	apperr.Conflict:           codes.FailedPrecondition,
+	apperr.PreconditionFailed: codes.Aborted,
+	apperr.Unavailable:        codes.Unavailable,
+}
+
+// ErrorCode returns the code of the response to a call that failed with err.
+func ErrorCode(err error) codes.Code {
+	if c, ok := kindCodes[apperr.KindOf(err)]; ok {
+		return c
+	}
+	return codes.Internal
+}
+
+// ErrorStatus returns err as a status with code c, internal errors are not disclosed to the client.
+// The kind of err is attached as errdetails.ErrorInfo, violations of the validation package as
+// errdetails.BadRequest with their codes in the metadata of the ErrorInfo, keyed by the field.
+func ErrorStatus(c codes.Code, err error) error {
+	if c == codes.Internal {
+		err = ctrl.ErrInternalError
+	}
+
+	st := status.New(c, err.Error())
+	kind := apperr.KindOf(err)
+	if kind == apperr.Internal && c != codes.Internal {
+		// A failure of the transport itself, e.g. a malformed uuid, there is no kind to report.
+		return st.Err()
+	}
+
+	info := &errdetails.ErrorInfo{
+		Reason: kind.String(),
+		Domain: errorDomain,
+	}
+	details := []protoadapt.MessageV1{info}
+
+	var errs validation.Errors
+	if errors.As(err, &errs) {
+		br := &errdetails.BadRequest{
+			FieldViolations: make([]*errdetails.BadRequest_FieldViolation, len(errs)),
+		}
+		info.Metadata = make(map[string]string, len(errs))
+		for i, v := range errs {
+			br.FieldViolations[i] = &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Message,
+			}
+			info.Metadata[v.Field] = v.Code
+		}
+		details = []protoadapt.MessageV1{br, info}
+	}
+
+	res, detErr := st.WithDetails(details...)
+	if detErr != nil {
+		zap.L().Debug("failed to attach error details", zap.Error(detErr))
+		return st.Err()
+	}
+	return res.Err()
+}
@@ -0,0 +1,86 @@
+package interceptors
+
+import (
+	"errors"
+	"fmt"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestErrorStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		code   codes.Code
+		msg    string
+		reason string
+	}{
+		{
+			name:   "NotFound",
+			err:    ctrl.ErrNotFound,
+			code:   codes.NotFound,
+			msg:    ctrl.ErrNotFound.Error(),
+			reason: "not_found",
+		},
+		{
+			name:   "UniqueViolation",
+			err:    fmt.Errorf("%w: article", repo.ErrAlreadyExists),
+			code:   codes.AlreadyExists,
+			msg:    "already exists: article",
+			reason: "already_exists",
+		},
+		{
+			name:   "StillReferenced",
+			err:    ctrl.ErrStillOrdered,
+			code:   codes.FailedPrecondition,
+			msg:    ctrl.ErrStillOrdered.Error(),
+			reason: "conflict",
+		},
+		{
+			name:   "VersionConflict",
+			err:    ctrl.ErrVersionConflict,
+			code:   codes.Aborted,
+			msg:    ctrl.ErrVersionConflict.Error(),
+			reason: "precondition_failed",
+		},
+		{
+			name:   "Internal",
+			err:    errors.New("pq: connection refused"),
+			code:   codes.Internal,
+			msg:    ctrl.ErrInternalError.Error(),
+			reason: "internal",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				c := ErrorCode(tt.err)
+				assert.Equal(t, tt.code, c)
+
+				st := status.Convert(ErrorStatus(c, tt.err))
+				assert.Equal(t, tt.code, st.Code())
+				assert.Equal(t, tt.msg, st.Message())
+				require.Len(t, st.Details(), 1)
+
+				info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+				require.True(t, ok)
+				assert.Equal(t, tt.reason, info.Reason)
+				assert.Equal(t, errorDomain, info.Domain)
+			},
+		)
+	}
+}
+
+func TestErrorStatus_WithoutKind(t *testing.T) {
+	st := status.Convert(ErrorStatus(codes.InvalidArgument, errors.New("invalid UUID length: 3")))
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, "invalid UUID length: 3", st.Message())
+	assert.Empty(t, st.Details())
+}
@@ -0,0 +1,111 @@
+package interceptors
+
+import (
+	"context"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"net/http"
+)
+
+type idempotencyStore interface {
+	BeginIdempotent(ctx context.Context, key, hash string) (*model.IdempotencyRecord, error)
+	CompleteIdempotent(ctx context.Context, key, hash string, status int, response []byte) error
+	ReleaseIdempotent(ctx context.Context, key string) error
+}
+
+// IdempotencyUnaryInterceptor replays the stored response of a call retried with the same idempotency-key metadata.
+// Keys are scoped to the method and the caller, reusing a key for a different request is rejected.
+// Only successful responses are stored, a failed call can be retried with the same key.
+func IdempotencyUnaryInterceptor(store idempotencyStore) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		key := ""
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if keys := md.Get(consts.IdempotencyMetadataKey); len(keys) > 0 {
+				key = keys[0]
+			}
+		}
+
+		msg, ok := req.(proto.Message)
+		if key == "" || !ok {
+			return handler(ctx, req)
+		}
+
+		if len(key) > consts.MaxIdempotencyKeyLength {
+			return nil, ErrorStatus(ErrorCode(validation.ErrInvalidIdempotencyKey), validation.ErrInvalidIdempotencyKey)
+		}
+
+		body, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+		if err != nil {
+			return nil, ErrorStatus(ErrorCode(ctrl.ErrDecodeRequest), ctrl.ErrDecodeRequest)
+		}
+
+		key = ctrl.IdempotencyKey(info.FullMethod, ctrl.UserIDFromContext(ctx), key)
+		hash := ctrl.IdempotencyKey(string(body))
+
+		rec, err := store.BeginIdempotent(ctx, key, hash)
+		if err != nil {
+			return nil, ErrorStatus(ErrorCode(err), err)
+		} else if rec != nil {
+			return replay(ctx, rec)
+		}
+
+		res, err := handler(ctx, req)
+
+		// The outcome is stored even if the client went away.
+		sctx := context.WithoutCancel(ctx)
+		if err != nil {
+			if rerr := store.ReleaseIdempotent(sctx, key); rerr != nil {
+				zap.L().Debug("failed to release idempotency key", zap.Error(rerr))
+			}
+			return res, err
+		}
+
+		if out, ok := res.(proto.Message); ok {
+			if err = complete(sctx, store, key, hash, out); err != nil {
+				zap.L().Debug("failed to store idempotent response", zap.Error(err))
+			}
+		}
+		return res, nil
+	}
+}
+
+func complete(ctx context.Context, store idempotencyStore, key, hash string, res proto.Message) error {
+	a, err := anypb.New(res)
+	if err != nil {
+		return err
+	}
+
+	data, err := proto.Marshal(a)
+	if err != nil {
+		return err
+	}
+
+	return store.CompleteIdempotent(ctx, key, hash, http.StatusOK, data)
+}
+
+func replay(ctx context.Context, rec *model.IdempotencyRecord) (any, error) {
+	a := &anypb.Any{}
+	if err := proto.Unmarshal(rec.Response, a); err != nil {
+		zap.L().Debug("failed to decode idempotent response", zap.Error(err))
+		return nil, ErrorStatus(codes.Internal, err)
+	}
+
+	res, err := a.UnmarshalNew()
+	if err != nil {
+		zap.L().Debug("failed to decode idempotent response", zap.Error(err))
+		return nil, ErrorStatus(codes.Internal, err)
+	}
+
+	if err = grpc.SetHeader(ctx, metadata.Pairs(consts.IdempotentReplayMetadataKey, "true")); err != nil {
+		zap.L().Debug("failed to set replay header", zap.Error(err))
+	}
+	return res, nil
+}
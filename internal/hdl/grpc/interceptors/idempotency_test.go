@@ -0,0 +1,158 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"net/http"
+	"testing"
+)
+
+// headerStream records the headers a handler sets.
+type headerStream struct {
+	grpc.ServerTransportStream
+	md metadata.MD
+}
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.md = metadata.Join(s.md, md)
+	return nil
+}
+
+func TestIdempotencyUnaryInterceptor(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	interceptor := IdempotencyUnaryInterceptor(mctrl)
+	info := &grpc.UnaryServerInfo{FullMethod: pb.Order_CreateOrder_FullMethodName}
+
+	req := &pb.SlugMsg{Slug: "test"}
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	require.NoError(t, err)
+
+	key := ctrl.IdempotencyKey(info.FullMethod, "user-id", "abc")
+	hash := ctrl.IdempotencyKey(string(body))
+
+	stored, err := anypb.New(&pb.Uint64Msg{Value: 1})
+	require.NoError(t, err)
+	storedBytes, err := proto.Marshal(stored)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		key        string
+		handlerErr error
+		mockExpect func()
+		expected   uint64
+		code       codes.Code
+		replayed   bool
+	}{
+		{
+			name:     "WithoutKey",
+			expected: 2,
+			code:     codes.OK,
+		},
+		{
+			name: "FirstCall",
+			key:  "abc",
+			mockExpect: func() {
+				mctrl.EXPECT().BeginIdempotent(gomock.Any(), key, hash).Return(nil, nil)
+				mctrl.EXPECT().CompleteIdempotent(gomock.Any(), key, hash, http.StatusOK, gomock.Any()).Return(nil)
+			},
+			expected: 2,
+			code:     codes.OK,
+		},
+		{
+			name:       "FailedCall",
+			key:        "abc",
+			handlerErr: status.Error(codes.InvalidArgument, "invalid"),
+			mockExpect: func() {
+				mctrl.EXPECT().BeginIdempotent(gomock.Any(), key, hash).Return(nil, nil)
+				mctrl.EXPECT().ReleaseIdempotent(gomock.Any(), key).Return(nil)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "Replay",
+			key:  "abc",
+			mockExpect: func() {
+				mctrl.EXPECT().BeginIdempotent(gomock.Any(), key, hash).Return(
+					&model.IdempotencyRecord{Key: key, RequestHash: hash, Status: http.StatusOK, Response: storedBytes}, nil,
+				)
+			},
+			expected: 1,
+			code:     codes.OK,
+			replayed: true,
+		},
+		{
+			name: "DifferentRequest",
+			key:  "abc",
+			mockExpect: func() {
+				mctrl.EXPECT().BeginIdempotent(gomock.Any(), key, hash).Return(nil, ctrl.ErrIdempotencyMismatch)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "InProgress",
+			key:  "abc",
+			mockExpect: func() {
+				mctrl.EXPECT().BeginIdempotent(gomock.Any(), key, hash).Return(nil, ctrl.ErrIdempotencyInProgress)
+			},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name: "InternalError",
+			key:  "abc",
+			mockExpect: func() {
+				mctrl.EXPECT().BeginIdempotent(gomock.Any(), key, hash).Return(nil, errors.New("db error"))
+			},
+			code: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				if tt.mockExpect != nil {
+					tt.mockExpect()
+				}
+
+				ctx := ctrl.WithUserID(context.Background(), "user-id")
+				if tt.key != "" {
+					ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(consts.IdempotencyMetadataKey, tt.key))
+				}
+				stream := &headerStream{}
+				ctx = grpc.NewContextWithServerTransportStream(ctx, stream)
+
+				res, err := interceptor(
+					ctx, req, info, func(ctx context.Context, req any) (any, error) {
+						if tt.handlerErr != nil {
+							return nil, tt.handlerErr
+						}
+						return &pb.Uint64Msg{Value: 2}, nil
+					},
+				)
+
+				assert.Equal(t, tt.code, status.Code(err))
+				if tt.code == codes.OK {
+					assert.Equal(t, tt.expected, res.(*pb.Uint64Msg).Value)
+				}
+				assert.Equal(t, tt.replayed, len(stream.md.Get(consts.IdempotentReplayMetadataKey)) > 0)
+			},
+		)
+	}
+}
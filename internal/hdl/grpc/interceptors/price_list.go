@@ -0,0 +1,45 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type priceListSelector interface {
+	SelectPriceList(ctx context.Context, slug string) (*model.PriceList, error)
+}
+
+// PriceListUnaryInterceptor resolves the price list the caller selected with the x-price-list metadata key,
+// falling back to the list of the caller's customer group.
+func PriceListUnaryInterceptor(pl priceListSelector) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		slug := ""
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if slugs := md.Get(consts.PriceListMetadataKey); len(slugs) > 0 {
+				slug = slugs[0]
+			}
+		}
+
+		if slug == "" && ctrl.CustomerGroupFromContext(ctx) == nil {
+			return handler(ctx, req)
+		}
+
+		res, err := pl.SelectPriceList(ctx, slug)
+		if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+			zap.L().Debug("unknown price list", zap.String("slug", slug))
+			return nil, ErrorStatus(ErrorCode(ctrl.ErrUnknownPriceList), ctrl.ErrUnknownPriceList)
+		} else if err != nil {
+			return nil, ErrorStatus(ErrorCode(err), err)
+		} else if res == nil {
+			return handler(ctx, req)
+		}
+
+		return handler(ctrl.WithPriceList(ctx, res), req)
+	}
+}
@@ -0,0 +1,53 @@
+package interceptors
+
+import (
+	"context"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestIDUnaryInterceptor takes the request id from the x-request-id metadata key or generates one,
+// and returns it to the caller in the response header.
+func RequestIDUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(withRequestID(ctx), req)
+	}
+}
+
+// RequestIDStreamInterceptor is RequestIDUnaryInterceptor for streaming methods.
+func RequestIDStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &requestIDStream{ServerStream: ss, ctx: withRequestID(ss.Context())})
+	}
+}
+
+func withRequestID(ctx context.Context) context.Context {
+	id := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(consts.RequestIDMetadataKey); len(ids) > 0 && len(ids[0]) <= consts.MaxRequestIDLength {
+			id = ids[0]
+		}
+	}
+	if id == "" {
+		id = uuid.NewString()
+	}
+
+	if err := grpc.SetHeader(ctx, metadata.Pairs(consts.RequestIDMetadataKey, id)); err != nil {
+		zap.L().Debug("failed to set request id header", zap.Error(err))
+	}
+	return ctrl.WithRequestID(ctx, id)
+}
+
+// requestIDStream serves the handler the context carrying the request id.
+type requestIDStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *requestIDStream) Context() context.Context {
+	return s.ctx
+}
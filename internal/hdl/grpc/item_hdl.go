@@ -2,17 +2,17 @@ package grpc
 
 import (
 	"context"
-	"errors"
 	pb "github.com/JMURv/par-pro/products/api/pb"
 	"github.com/JMURv/par-pro/products/internal/ctrl"
 	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
 	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/pkg/model"
 	"github.com/JMURv/par-pro/products/pkg/model/mapper"
 	"github.com/google/uuid"
 	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"slices"
 	"time"
 )
 
@@ -30,13 +30,13 @@ func (h *Handler) ItemSearch(ctx context.Context, req *pb.SearchReq) (*pb.Pagina
 	if q == "" || page == 0 || size == 0 {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
 	}
 
 	res, err := h.ctrl.ItemSearch(ctx, q, int(page), int(size))
 	if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return &pb.PaginatedItemRes{
@@ -62,13 +62,13 @@ func (h *Handler) ItemAttrSearch(ctx context.Context, req *pb.SearchReq) (*pb.Pa
 	if q == "" || page == 0 || size == 0 {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
 	}
 
 	res, err := h.ctrl.ItemAttrSearch(ctx, q, int(page), int(size))
 	if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return &pb.PaginatedItemAttrsRes{
@@ -94,13 +94,13 @@ func (h *Handler) ListItems(ctx context.Context, req *pb.ListReq) (*pb.Paginated
 	if page == 0 || size == 0 {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
 	}
 
 	res, err := h.ctrl.ListItems(ctx, int(page), int(size))
 	if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return &pb.PaginatedItemRes{
@@ -125,23 +125,20 @@ func (h *Handler) GetItem(ctx context.Context, req *pb.UuidMsg) (*pb.ItemMsg, er
 	if req == nil || req.Uuid == "" {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
 	}
 
 	uid, err := uuid.Parse(req.Uuid)
 	if err != nil {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrParseUUID.Error())
+		return nil, errStatus(c, ctrl.ErrParseUUID)
 	}
 
 	res, err := h.ctrl.GetItemByUUID(ctx, uid)
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = codes.NotFound
-		return nil, status.Errorf(c, err.Error())
-	} else if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return mapper.ItemToProto(res), nil
@@ -160,20 +157,26 @@ func (h *Handler) CreateItem(ctx context.Context, req *pb.ItemMsg) (*pb.UuidMsg,
 	if req == nil {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
 	}
 
 	item := mapper.ItemFromProto(req)
-	if err := validation.ItemValidation(item); err != nil {
+	schema, err := h.ctrl.GetAttributeSchema(ctx, item.CategorySlugs())
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	if err = validation.ItemValidation(item, schema); err != nil {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
-		return nil, status.Errorf(c, err.Error())
+		return nil, errStatus(c, err)
 	}
 
 	res, err := h.ctrl.CreateItem(ctx, item)
 	if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return &pb.UuidMsg{Uuid: res.String()}, nil
@@ -192,30 +195,70 @@ func (h *Handler) UpdateItem(ctx context.Context, req *pb.ItemWithUid) (*pb.Empt
 	if req == nil || req.Uid == "" {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
 	}
 
 	uid, err := uuid.Parse(req.Uid)
 	if err != nil {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrParseUUID.Error())
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	fields := req.GetUpdateMask().GetPaths()
+	if len(fields) > 0 && !req.UpdateMask.IsValid(req.GetItem()) {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate update mask", zap.String("op", op), zap.Strings("paths", fields))
+		return nil, errStatus(c, validation.ErrUnknownField)
 	}
 
 	item := mapper.ItemFromProto(req.Item)
-	if err := validation.ItemValidation(item); err != nil {
+
+	// A patch is checked against the schema of the categories the item ends up with, the stored ones unless they are patched too.
+	var schema []*model.CategoryAttribute
+	var stored []model.ItemAttribute
+	patchAttrs, patchCats := slices.Contains(fields, "attributes"), slices.Contains(fields, "categories")
+	if len(fields) == 0 || patchAttrs || patchCats {
+		slugs := item.CategorySlugs()
+		if len(fields) > 0 && (!patchAttrs || !patchCats) {
+			cur, err := h.ctrl.GetItemByUUID(ctx, uid)
+			if err != nil {
+				c = errCode(err)
+				return nil, errStatus(c, err)
+			}
+
+			if !patchCats {
+				slugs = cur.CategorySlugs()
+			}
+			stored = cur.Attributes
+		}
+
+		schema, err = h.ctrl.GetAttributeSchema(ctx, slugs)
+		if err != nil {
+			c = errCode(err)
+			return nil, errStatus(c, err)
+		}
+	}
+
+	if len(fields) > 0 {
+		err = validation.ItemPatchValidation(item, fields, schema, stored)
+	} else {
+		err = validation.ItemValidation(item, schema)
+	}
+	if err != nil {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to validate request", zap.String("op", op), zap.Error(err))
-		return nil, status.Errorf(c, err.Error())
+		return nil, errStatus(c, err)
 	}
 
-	err = h.ctrl.UpdateItem(ctx, uid, item)
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = codes.NotFound
-		return nil, status.Errorf(c, err.Error())
-	} else if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+	if len(fields) > 0 {
+		err = h.ctrl.PatchItem(ctx, uid, item, fields)
+	} else {
+		err = h.ctrl.UpdateItem(ctx, uid, item)
+	}
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return &pb.Empty{}, nil
@@ -234,23 +277,20 @@ func (h *Handler) DeleteItem(ctx context.Context, req *pb.UuidMsg) (*pb.Empty, e
 	if req == nil || req.Uuid == "" {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
 	}
 
 	uid, err := uuid.Parse(req.Uuid)
 	if err != nil {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrParseUUID.Error())
+		return nil, errStatus(c, ctrl.ErrParseUUID)
 	}
 
 	err = h.ctrl.DeleteItem(ctx, uid)
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = codes.NotFound
-		return nil, status.Errorf(c, err.Error())
-	} else if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return &pb.Empty{}, nil
@@ -269,23 +309,20 @@ func (h *Handler) ListRelatedItems(ctx context.Context, req *pb.UuidMsg) (*pb.Re
 	if req == nil || req.Uuid == "" {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
 	}
 
 	uid, err := uuid.Parse(req.Uuid)
 	if err != nil {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrParseUUID.Error())
+		return nil, errStatus(c, ctrl.ErrParseUUID)
 	}
 
 	res, err := h.ctrl.ListRelatedItems(ctx, uid)
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = codes.NotFound
-		return nil, status.Errorf(c, err.Error())
-	} else if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return &pb.RelatedItemsList{
@@ -306,18 +343,21 @@ func (h *Handler) ListCategoryItems(ctx context.Context, req *pb.ListCategoryIte
 	if req == nil || req.CategorySlug == "" || req.Page <= 0 || req.Size <= 0 {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	if err := validation.ItemSortValidation(req.Sort); err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate sort", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
 	}
 
 	// TODO: Parse filters
 	filters := make(map[string]any)
 	res, err := h.ctrl.ListCategoryItems(ctx, req.CategorySlug, int(req.Page), int(req.Size), filters, req.Sort)
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = codes.NotFound
-		return nil, status.Errorf(c, err.Error())
-	} else if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return &pb.PaginatedItemRes{
@@ -342,13 +382,13 @@ func (h *Handler) ListItemsByLabel(ctx context.Context, req *pb.ListItemsByLabel
 	if req == nil || req.Label == "" || req.Page <= 0 || req.Size <= 0 {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
 	}
 
 	res, err := h.ctrl.ListItemsByLabel(ctx, req.Label, int(req.Page), int(req.Size))
 	if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return &pb.PaginatedItemRes{
@@ -342,6 +342,7 @@ func TestHandler_CreateItem(t *testing.T) {
 	defer mock.Finish()
 
 	mctrl := mocks.NewMockCtrl(mock)
+	mctrl.EXPECT().GetAttributeSchema(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 	msso := mocks.NewMockSSOSvc(mock)
 	h := New(mctrl, msso)
 
@@ -374,7 +375,7 @@ func TestHandler_CreateItem(t *testing.T) {
 		},
 		{
 			name: "Success",
-			req:  &pb.ItemMsg{Title: "Test Item", Description: "Test Description", Price: 100, Src: "test-src"},
+			req:  &pb.ItemMsg{Title: "Test Item", Description: "Test Description", Price: &pb.Money{Amount: 10000, Currency: "RUB"}, Src: "test-src"},
 			mockExpect: func() {
 				mctrl.EXPECT().CreateItem(gomock.Any(), gomock.Any()).Return(itemUUID, nil).Times(1)
 			},
@@ -385,7 +386,7 @@ func TestHandler_CreateItem(t *testing.T) {
 		},
 		{
 			name: "Internal error",
-			req:  &pb.ItemMsg{Title: "Test Item", Description: "Test Description", Price: 100, Src: "test-src"},
+			req:  &pb.ItemMsg{Title: "Test Item", Description: "Test Description", Price: &pb.Money{Amount: 10000, Currency: "RUB"}, Src: "test-src"},
 			mockExpect: func() {
 				mctrl.EXPECT().CreateItem(gomock.Any(), gomock.Any()).Return(
 					uuid.Nil,
@@ -415,6 +416,7 @@ func TestHandler_UpdateItem(t *testing.T) {
 	defer mock.Finish()
 
 	mctrl := mocks.NewMockCtrl(mock)
+	mctrl.EXPECT().GetAttributeSchema(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 	msso := mocks.NewMockSSOSvc(mock)
 	h := New(mctrl, msso)
 
@@ -457,7 +459,7 @@ func TestHandler_UpdateItem(t *testing.T) {
 			name: "Success",
 			req: &pb.ItemWithUid{
 				Uid:  uuid.New().String(),
-				Item: &pb.ItemMsg{Title: "Test Item", Description: "Test Description", Price: 100, Src: "test-src"},
+				Item: &pb.ItemMsg{Title: "Test Item", Description: "Test Description", Price: &pb.Money{Amount: 10000, Currency: "RUB"}, Src: "test-src"},
 			},
 			mockExpect: func() {
 				mctrl.EXPECT().UpdateItem(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
@@ -471,7 +473,7 @@ func TestHandler_UpdateItem(t *testing.T) {
 			name: "Not found",
 			req: &pb.ItemWithUid{
 				Uid:  uuid.New().String(),
-				Item: &pb.ItemMsg{Title: "Test Item", Description: "Test Description", Price: 100, Src: "test-src"},
+				Item: &pb.ItemMsg{Title: "Test Item", Description: "Test Description", Price: &pb.Money{Amount: 10000, Currency: "RUB"}, Src: "test-src"},
 			},
 			mockExpect: func() {
 				mctrl.EXPECT().UpdateItem(gomock.Any(), gomock.Any(), gomock.Any()).Return(ctrl.ErrNotFound).Times(1)
@@ -485,7 +487,7 @@ func TestHandler_UpdateItem(t *testing.T) {
 			name: "Internal error",
 			req: &pb.ItemWithUid{
 				Uid:  uuid.New().String(),
-				Item: &pb.ItemMsg{Title: "Test Item", Description: "Test Description", Price: 100, Src: "test-src"},
+				Item: &pb.ItemMsg{Title: "Test Item", Description: "Test Description", Price: &pb.Money{Amount: 10000, Currency: "RUB"}, Src: "test-src"},
 			},
 			mockExpect: func() {
 				mctrl.EXPECT().UpdateItem(
@@ -714,10 +716,10 @@ func TestHandler_ListCategoryItems(t *testing.T) {
 		},
 		{
 			name: "Success",
-			req:  &pb.ListCategoryItemsReq{CategorySlug: "test-category", Page: 1, Size: 10, Sort: "asc"},
+			req:  &pb.ListCategoryItemsReq{CategorySlug: "test-category", Page: 1, Size: 10, Sort: "-rating"},
 			mockExpect: func() {
 				filters := make(map[string]any)
-				mctrl.EXPECT().ListCategoryItems(gomock.Any(), "test-category", 1, 10, filters, "asc").Return(
+				mctrl.EXPECT().ListCategoryItems(gomock.Any(), "test-category", 1, 10, filters, "-rating").Return(
 					&model.PaginatedItemsData{
 						Data:        []*model.Item{},
 						Count:       10,
@@ -734,10 +736,10 @@ func TestHandler_ListCategoryItems(t *testing.T) {
 		},
 		{
 			name: "Not found",
-			req:  &pb.ListCategoryItemsReq{CategorySlug: "test-category", Page: 1, Size: 10, Sort: "asc"},
+			req:  &pb.ListCategoryItemsReq{CategorySlug: "test-category", Page: 1, Size: 10, Sort: "-rating"},
 			mockExpect: func() {
 				filters := make(map[string]any)
-				mctrl.EXPECT().ListCategoryItems(gomock.Any(), "test-category", 1, 10, filters, "asc").Return(
+				mctrl.EXPECT().ListCategoryItems(gomock.Any(), "test-category", 1, 10, filters, "-rating").Return(
 					nil,
 					ctrl.ErrNotFound,
 				).Times(1)
@@ -749,10 +751,10 @@ func TestHandler_ListCategoryItems(t *testing.T) {
 		},
 		{
 			name: "Internal error",
-			req:  &pb.ListCategoryItemsReq{CategorySlug: "test-category", Page: 1, Size: 10, Sort: "asc"},
+			req:  &pb.ListCategoryItemsReq{CategorySlug: "test-category", Page: 1, Size: 10, Sort: "-rating"},
 			mockExpect: func() {
 				filters := make(map[string]any)
-				mctrl.EXPECT().ListCategoryItems(gomock.Any(), "test-category", 1, 10, filters, "asc").Return(
+				mctrl.EXPECT().ListCategoryItems(gomock.Any(), "test-category", 1, 10, filters, "-rating").Return(
 					nil,
 					errors.New("internal error"),
 				).Times(1)
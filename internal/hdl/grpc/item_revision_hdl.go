@@ -0,0 +1,113 @@
+package grpc
+
+import (
+	"context"
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
+	"github.com/JMURv/par-pro/products/pkg/model/mapper"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"time"
+)
+
+func (h *Handler) ListItemRevisions(ctx context.Context, req *pb.ListItemRevisionsReq) (*pb.PaginatedItemRevisionsRes, error) {
+	s, c := time.Now(), codes.OK
+	const op = "items.ListItemRevisions.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.ItemId == "" || req.Page == 0 || req.Size == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	uid, err := uuid.Parse(req.ItemId)
+	if err != nil {
+		c = codes.InvalidArgument
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	res, err := h.ctrl.ListItemRevisions(ctx, uid, int(req.Page), int(req.Size))
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.PaginatedItemRevisionsRes{
+		Data:        mapper.ListItemRevisionsToProto(res.Data),
+		Count:       res.Count,
+		TotalPages:  int64(res.TotalPages),
+		CurrentPage: int64(res.CurrentPage),
+		HasNextPage: res.HasNextPage,
+	}, nil
+}
+
+func (h *Handler) DiffItemRevisions(ctx context.Context, req *pb.DiffItemRevisionsReq) (*pb.ItemRevisionDiffMsg, error) {
+	s, c := time.Now(), codes.OK
+	const op = "items.DiffItemRevisions.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.ItemId == "" || req.From == 0 || req.To == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	uid, err := uuid.Parse(req.ItemId)
+	if err != nil {
+		c = codes.InvalidArgument
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	res, err := h.ctrl.DiffItemRevisions(ctx, uid, req.From, req.To)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.ItemRevisionDiffMsg{From: res.From, To: res.To, Diff: string(res.Diff)}, nil
+}
+
+func (h *Handler) RollbackItem(ctx context.Context, req *pb.ItemRevisionReq) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "items.RollbackItem.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.ItemId == "" || req.Id == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	uid, err := uuid.Parse(req.ItemId)
+	if err != nil {
+		c = codes.InvalidArgument
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	err = h.ctrl.RollbackItem(ctx, uid, req.Id)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
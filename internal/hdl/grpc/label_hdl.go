@@ -0,0 +1,161 @@
+package grpc
+
+import (
+	"context"
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/pkg/model/mapper"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"time"
+)
+
+func (h *Handler) ListLabels(ctx context.Context, req *pb.Empty) (*pb.LabelListRes, error) {
+	s, c := time.Now(), codes.OK
+	const op = "labels.ListLabels.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	res, err := h.ctrl.ListLabels(ctx)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.LabelListRes{Data: mapper.ListLabelsToProto(res)}, nil
+}
+
+func (h *Handler) CreateLabel(ctx context.Context, req *pb.LabelMsg) (*pb.Uint64Msg, error) {
+	s, c := time.Now(), codes.OK
+	const op = "labels.CreateLabel.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	l := mapper.LabelFromProto(req)
+	if err := validation.LabelValidation(l); err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
+	}
+
+	id, err := h.ctrl.CreateLabel(ctx, l)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Uint64Msg{Value: id}, nil
+}
+
+func (h *Handler) UpdateLabel(ctx context.Context, req *pb.UpdateLabelReq) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "labels.UpdateLabel.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Name == "" || req.Label == nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	l := mapper.LabelFromProto(req.Label)
+	if err := validation.LabelValidation(l); err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
+	}
+
+	err := h.ctrl.UpdateLabel(ctx, req.Name, l)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *Handler) DeleteLabel(ctx context.Context, req *pb.SlugMsg) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "labels.DeleteLabel.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Slug == "" {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	err := h.ctrl.DeleteLabel(ctx, req.Slug)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *Handler) SetItemLabels(ctx context.Context, req *pb.SetItemLabelsReq) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "labels.SetItemLabels.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	uid, err := uuid.Parse(req.Uuid)
+	if err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	if err = validation.ItemLabelsValidation(req.Labels); err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
+	}
+
+	err = h.ctrl.SetItemLabels(ctx, uid, req.Labels)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
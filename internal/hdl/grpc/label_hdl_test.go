@@ -0,0 +1,84 @@
+package grpc
+
+import (
+	"context"
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestHandler_SetItemLabels(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	msso := mocks.NewMockSSOSvc(mock)
+	h := New(mctrl, msso)
+
+	ctx := context.Background()
+	uid := uuid.New()
+
+	tests := []struct {
+		name         string
+		req          *pb.SetItemLabelsReq
+		mockExpect   func()
+		expectedResp func(*testing.T, *pb.Empty, error)
+	}{
+		{
+			name:       "Invalid uuid",
+			req:        &pb.SetItemLabelsReq{Uuid: "invalid", Labels: []string{"hit"}},
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name:       "Duplicate label",
+			req:        &pb.SetItemLabelsReq{Uuid: uid.String(), Labels: []string{"hit", "hit"}},
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "Not found",
+			req:  &pb.SetItemLabelsReq{Uuid: uid.String(), Labels: []string{"unknown"}},
+			mockExpect: func() {
+				mctrl.EXPECT().SetItemLabels(gomock.Any(), uid, []string{"unknown"}).Return(ctrl.ErrNotFound)
+			},
+			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "Success",
+			req:  &pb.SetItemLabelsReq{Uuid: uid.String(), Labels: []string{"hit", "rec"}},
+			mockExpect: func() {
+				mctrl.EXPECT().SetItemLabels(gomock.Any(), uid, []string{"hit", "rec"}).Return(nil)
+			},
+			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
+				assert.NoError(t, err)
+				assert.NotNil(t, res)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := h.SetItemLabels(ctx, tt.req)
+				tt.expectedResp(t, res, err)
+			},
+		)
+	}
+}
@@ -0,0 +1,221 @@
+package grpc
+
+import (
+	"context"
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/JMURv/par-pro/products/pkg/model/mapper"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"io"
+	"time"
+)
+
+func (h *Handler) ListItemMedia(ctx context.Context, req *pb.UuidMsg) (*pb.ItemMediaList, error) {
+	s, c := time.Now(), codes.OK
+	const op = "media.ListItemMedia.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Uuid == "" {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	uid, err := uuid.Parse(req.Uuid)
+	if err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	res, err := h.ctrl.ListItemMedia(ctx, uid)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.ItemMediaList{
+		Data: mapper.ListItemMediaPtrToProto(res),
+	}, nil
+}
+
+// UploadItemMedia expects an info message first, followed by the file in chunks.
+func (h *Handler) UploadItemMedia(stream pb.Media_UploadItemMediaServer) error {
+	s, c := time.Now(), codes.OK
+	const op = "media.UploadItemMedia.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx := opentracing.ContextWithSpan(stream.Context(), span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	first, err := stream.Recv()
+	if err != nil || first.GetInfo() == nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		return errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	info := first.GetInfo()
+	uid, err := uuid.Parse(info.ItemId)
+	if err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		return errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	obj := &model.MediaUpload{Alt: info.Alt, IsPrimary: info.IsPrimary}
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			c = codes.Canceled
+			zap.L().Debug("failed to receive chunk", zap.String("op", op), zap.Error(err))
+			return errStatus(c, err)
+		}
+
+		obj.Data = append(obj.Data, chunk.GetChunk()...)
+		if len(obj.Data) > consts.MaxMediaSize {
+			c = codes.InvalidArgument
+			return errStatus(c, validation.ErrFileTooLarge)
+		}
+	}
+
+	if err = validation.MediaUploadValidation(obj); err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		return errStatus(c, err)
+	}
+
+	res, err := h.ctrl.UploadItemMedia(ctx, uid, obj)
+	if err != nil {
+		c = errCode(err)
+		return errStatus(c, err)
+	}
+
+	return stream.SendAndClose(mapper.ItemMediaToProto(res))
+}
+
+func (h *Handler) UpdateItemMedia(ctx context.Context, req *pb.ItemMedia) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "media.UpdateItemMedia.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.ItemId == "" || req.Id == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	uid, err := uuid.Parse(req.ItemId)
+	if err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	obj := mapper.ItemMediaFromProto(req)
+	if err = validation.ItemMediaValidation(obj); err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
+	}
+
+	err = h.ctrl.UpdateItemMedia(ctx, uid, req.Id, obj)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *Handler) ReorderItemMedia(ctx context.Context, req *pb.ReorderItemMediaReq) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "media.ReorderItemMedia.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.ItemId == "" {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	uid, err := uuid.Parse(req.ItemId)
+	if err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	if err = validation.ReorderItemMediaValidation(req.Ids); err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
+	}
+
+	err = h.ctrl.ReorderItemMedia(ctx, uid, req.Ids)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *Handler) DeleteItemMedia(ctx context.Context, req *pb.ItemMediaReq) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "media.DeleteItemMedia.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.ItemId == "" || req.Id == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	uid, err := uuid.Parse(req.ItemId)
+	if err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	err = h.ctrl.DeleteItemMedia(ctx, uid, req.Id)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
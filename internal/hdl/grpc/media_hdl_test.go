@@ -0,0 +1,151 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestHandler_ListItemMedia(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	msso := mocks.NewMockSSOSvc(mock)
+	h := New(mctrl, msso)
+
+	ctx := context.Background()
+	itemID := uuid.New()
+
+	tests := []struct {
+		name         string
+		req          *pb.UuidMsg
+		mockExpect   func()
+		expectedResp func(*testing.T, *pb.ItemMediaList, error)
+	}{
+		{
+			name:       "Invalid uuid",
+			req:        &pb.UuidMsg{Uuid: "invalid"},
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *pb.ItemMediaList, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "Success",
+			req:  &pb.UuidMsg{Uuid: itemID.String()},
+			mockExpect: func() {
+				mctrl.EXPECT().ListItemMedia(gomock.Any(), itemID).Return(
+					[]*model.ItemMedia{
+						{
+							ID:        1,
+							ItemID:    itemID,
+							IsPrimary: true,
+							Variants:  []model.MediaVariant{{Name: "thumb", Width: 320}},
+						},
+					}, nil,
+				)
+			},
+			expectedResp: func(t *testing.T, res *pb.ItemMediaList, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.Data, 1)
+				assert.True(t, res.Data[0].IsPrimary)
+				require.Len(t, res.Data[0].Variants, 1)
+				assert.Equal(t, int32(320), res.Data[0].Variants[0].Width)
+			},
+		},
+		{
+			name: "Internal error",
+			req:  &pb.UuidMsg{Uuid: itemID.String()},
+			mockExpect: func() {
+				mctrl.EXPECT().ListItemMedia(gomock.Any(), itemID).Return(nil, errors.New("internal error"))
+			},
+			expectedResp: func(t *testing.T, res *pb.ItemMediaList, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.Internal, status.Code(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := h.ListItemMedia(ctx, tt.req)
+				tt.expectedResp(t, res, err)
+			},
+		)
+	}
+}
+
+func TestHandler_DeleteItemMedia(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	msso := mocks.NewMockSSOSvc(mock)
+	h := New(mctrl, msso)
+
+	ctx := context.Background()
+	itemID := uuid.New()
+
+	tests := []struct {
+		name         string
+		req          *pb.ItemMediaReq
+		mockExpect   func()
+		expectedResp func(*testing.T, *pb.Empty, error)
+	}{
+		{
+			name:       "Missing id",
+			req:        &pb.ItemMediaReq{ItemId: itemID.String()},
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "Not found",
+			req:  &pb.ItemMediaReq{ItemId: itemID.String(), Id: 1},
+			mockExpect: func() {
+				mctrl.EXPECT().DeleteItemMedia(gomock.Any(), itemID, uint64(1)).Return(ctrl.ErrNotFound)
+			},
+			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "Success",
+			req:  &pb.ItemMediaReq{ItemId: itemID.String(), Id: 1},
+			mockExpect: func() {
+				mctrl.EXPECT().DeleteItemMedia(gomock.Any(), itemID, uint64(1)).Return(nil)
+			},
+			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
+				assert.NoError(t, err)
+				assert.NotNil(t, res)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := h.DeleteItemMedia(ctx, tt.req)
+				tt.expectedResp(t, res, err)
+			},
+		)
+	}
+}
@@ -2,7 +2,6 @@ package grpc
 
 import (
 	"context"
-	"errors"
 	pb "github.com/JMURv/par-pro/products/api/pb"
 	"github.com/JMURv/par-pro/products/internal/ctrl"
 	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
@@ -12,7 +11,6 @@ import (
 	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"time"
 )
 
@@ -30,15 +28,15 @@ func (h *Handler) ListOrders(ctx context.Context, req *pb.ListReq) (*pb.Paginate
 	if page == 0 || size == 0 {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
 	}
 
 	// TODO: Create filters
 	filters := make(map[string]any)
 	res, err := h.ctrl.ListOrders(ctx, int(page), int(size), filters, "")
 	if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return &pb.PaginatedOrderRes{
@@ -60,31 +58,31 @@ func (h *Handler) ListUserOrders(ctx context.Context, req *pb.ListReq) (*pb.Pagi
 		metrics.ObserveRequest(time.Since(s), int(c), op)
 	}()
 
-	uidStr, ok := ctx.Value("uid").(string)
-	if !ok {
+	uidStr := ctrl.UserIDFromContext(ctx)
+	if uidStr == "" {
 		c = codes.Unauthenticated
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrUnauthenticated.Error())
+		return nil, errStatus(c, ctrl.ErrUnauthenticated)
 	}
 
 	uid, err := uuid.Parse(uidStr)
 	if err != nil {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrParseUUID.Error())
+		return nil, errStatus(c, ctrl.ErrParseUUID)
 	}
 
 	page, size := req.Page, req.Size
 	if page == 0 || size == 0 {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
 	}
 
 	res, err := h.ctrl.ListUserOrders(ctx, uid, int(page), int(size))
 	if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return &pb.PaginatedOrderRes{
@@ -110,16 +108,19 @@ func (h *Handler) GetOrder(ctx context.Context, req *pb.Uint64Msg) (*pb.OrderMsg
 	if orderID == 0 {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
 	}
 
 	res, err := h.ctrl.GetOrder(ctx, orderID)
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	if !canAccessOrder(ctx, res, ctrl.PermOrdersRead) {
 		c = codes.NotFound
-		return nil, status.Errorf(c, err.Error())
-	} else if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+		zap.L().Debug("caller does not own the order", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrNotFound)
 	}
 
 	return mapper.OrderToProto(res), nil
@@ -139,18 +140,18 @@ func (h *Handler) CreateOrder(ctx context.Context, req *pb.OrderMsg) (*pb.Uint64
 	if req == nil {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
 	}
 
 	obj := mapper.OrderFromProto(req)
 	if err = validation.Order(obj); err != nil {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
-		return nil, status.Errorf(c, err.Error())
+		return nil, errStatus(c, err)
 	}
 
 	uid := uuid.Nil
-	if uidStr, ok := ctx.Value("uid").(string); !ok {
+	if uidStr := ctrl.UserIDFromContext(ctx); uidStr == "" {
 		genPass := uuid.NewString()
 		userID, err := h.sso.CreateUser(ctx, obj.FIO, obj.Email, genPass)
 		if err != nil {
@@ -170,17 +171,14 @@ func (h *Handler) CreateOrder(ctx context.Context, req *pb.OrderMsg) (*pb.Uint64
 		if err != nil {
 			c = codes.InvalidArgument
 			zap.L().Debug("failed to decode request", zap.String("op", op))
-			return nil, status.Errorf(c, ctrl.ErrParseUUID.Error())
+			return nil, errStatus(c, ctrl.ErrParseUUID)
 		}
 	}
 
 	res, err := h.ctrl.CreateOrder(ctx, uid, obj)
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = codes.NotFound
-		return nil, status.Errorf(c, err.Error())
-	} else if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return &pb.Uint64Msg{Value: res}, nil
@@ -199,27 +197,55 @@ func (h *Handler) UpdateOrder(ctx context.Context, req *pb.OrderMsg) (*pb.Empty,
 	if req == nil || req.Id == 0 {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	fields := req.GetUpdateMask().GetPaths()
+	if len(fields) > 0 && !req.UpdateMask.IsValid(req) {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate update mask", zap.String("op", op), zap.Strings("paths", fields))
+		return nil, errStatus(c, validation.ErrUnknownField)
 	}
+
+	var err error
 	obj := mapper.OrderFromProto(req)
-	if err := validation.Order(obj); err != nil {
+	if len(fields) > 0 {
+		fields = orderFields(fields)
+		err = validation.OrderPatch(obj, fields)
+	} else {
+		err = validation.Order(obj)
+	}
+	if err != nil {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to validate request", zap.String("op", op), zap.Error(err))
-		return nil, status.Errorf(c, err.Error())
+		return nil, errStatus(c, err)
 	}
 
-	err := h.ctrl.UpdateOrder(ctx, req.Id, obj)
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = codes.NotFound
-		return nil, status.Errorf(c, err.Error())
-	} else if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+	if len(fields) > 0 {
+		err = h.ctrl.PatchOrder(ctx, req.Id, obj, fields)
+	} else {
+		err = h.ctrl.UpdateOrder(ctx, req.Id, obj)
+	}
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return &pb.Empty{}, nil
 }
 
+// orderFields renames the update mask paths of OrderMsg that differ from the fields of model.Order.
+func orderFields(paths []string) []string {
+	res := make([]string, len(paths))
+	for i, v := range paths {
+		if v == "items" {
+			v = "order_items"
+		}
+		res[i] = v
+	}
+	return res
+}
+
 func (h *Handler) CancelOrder(ctx context.Context, req *pb.Uint64Msg) (*pb.Empty, error) {
 	s, c := time.Now(), codes.OK
 	const op = "order.CancelOrder.handler"
@@ -233,16 +259,50 @@ func (h *Handler) CancelOrder(ctx context.Context, req *pb.Uint64Msg) (*pb.Empty
 	if req == nil || req.Value == 0 {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	o, err := h.ctrl.GetOrder(ctx, req.Value)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
-	err := h.ctrl.CancelOrder(ctx, req.Value)
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+	if !canAccessOrder(ctx, o, ctrl.PermOrdersManage) {
 		c = codes.NotFound
-		return nil, status.Errorf(c, err.Error())
-	} else if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+		zap.L().Debug("caller does not own the order", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrNotFound)
+	}
+
+	err = h.ctrl.CancelOrder(ctx, req.Value)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *Handler) DeleteOrder(ctx context.Context, req *pb.Uint64Msg) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "order.DeleteOrder.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Value == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	err := h.ctrl.DeleteOrder(ctx, req.Value)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return &pb.Empty{}, nil
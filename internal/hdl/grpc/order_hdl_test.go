@@ -5,13 +5,17 @@ import (
 	"errors"
 	pb "github.com/JMURv/par-pro/products/api/pb"
 	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/internal/validation"
 	"github.com/JMURv/par-pro/products/mocks"
 	"github.com/JMURv/par-pro/products/pkg/model"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"testing"
 )
 
@@ -127,7 +131,7 @@ func TestHandler_ListUserOrders(t *testing.T) {
 		{
 			name:       "Invalid Request",
 			req:        &pb.ListReq{Page: 0, Size: 0},
-			ctx:        context.WithValue(context.Background(), "uid", "valid-uid"),
+			ctx:        ctrl.WithUserID(context.Background(), "valid-uid"),
 			mockExpect: func() {},
 			expectedResp: func(t *testing.T, res *pb.PaginatedOrderRes, err error) {
 				assert.Nil(t, res)
@@ -137,7 +141,7 @@ func TestHandler_ListUserOrders(t *testing.T) {
 		{
 			name: "Success",
 			req:  &pb.ListReq{Page: 1, Size: 10},
-			ctx:  context.WithValue(context.Background(), "uid", uuid.New().String()),
+			ctx:  ctrl.WithUserID(context.Background(), uuid.New().String()),
 			mockExpect: func() {
 				mctrl.EXPECT().ListUserOrders(gomock.Any(), gomock.Any(), 1, 10).Return(
 					&model.PaginatedOrderData{
@@ -157,7 +161,7 @@ func TestHandler_ListUserOrders(t *testing.T) {
 		{
 			name: "Internal Error",
 			req:  &pb.ListReq{Page: 1, Size: 10},
-			ctx:  context.WithValue(context.Background(), "uid", uuid.New().String()),
+			ctx:  ctrl.WithUserID(context.Background(), uuid.New().String()),
 			mockExpect: func() {
 				mctrl.EXPECT().ListUserOrders(gomock.Any(), gomock.Any(), 1, 10).Return(
 					nil, errors.New("internal error"),
@@ -188,7 +192,8 @@ func TestHandler_GetOrder(t *testing.T) {
 	mctrl := mocks.NewMockCtrl(mock)
 	h := New(mctrl, nil)
 
-	ctx := context.Background()
+	uid := uuid.New()
+	ctx := ctrl.WithUserID(context.Background(), uid.String())
 	tests := []struct {
 		name         string
 		req          *pb.Uint64Msg
@@ -230,12 +235,23 @@ func TestHandler_GetOrder(t *testing.T) {
 			name: "Success",
 			req:  &pb.Uint64Msg{Value: 12345},
 			mockExpect: func() {
-				mctrl.EXPECT().GetOrder(gomock.Any(), uint64(12345)).Return(&model.Order{ID: 12345}, nil).Times(1)
+				mctrl.EXPECT().GetOrder(gomock.Any(), uint64(12345)).Return(&model.Order{ID: 12345, UserID: uid}, nil).Times(1)
 			},
 			expectedResp: func(t *testing.T, res *pb.OrderMsg, err error) {
 				assert.Equal(t, codes.OK, status.Code(err))
 			},
 		},
+		{
+			name: "Foreign Order",
+			req:  &pb.Uint64Msg{Value: 12345},
+			mockExpect: func() {
+				mctrl.EXPECT().GetOrder(gomock.Any(), uint64(12345)).Return(&model.Order{ID: 12345, UserID: uuid.New()}, nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.OrderMsg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -277,20 +293,48 @@ func TestHandler_CreateOrder(t *testing.T) {
 		{
 			name:       "Validation Error",
 			req:        &pb.OrderMsg{Fio: "", Email: "test@example.com", UserId: uuid.NewString()},
-			ctx:        context.WithValue(context.Background(), "uid", uuid.NewString()),
+			ctx:        ctrl.WithUserID(context.Background(), uuid.NewString()),
 			mockExpect: func() {},
 			expectedResp: func(t *testing.T, res *pb.Uint64Msg, err error) {
 				assert.Nil(t, res)
 				assert.Equal(t, codes.InvalidArgument, status.Code(err))
 			},
 		},
+		{
+			name: "Validation Details",
+			req: &pb.OrderMsg{
+				Fio: "Test User", Tel: "+7 (999) 123-45-67", Email: "test", Address: "some-address",
+				Items:  []*pb.OrderItem{{ItemId: uuid.NewString()}},
+				UserId: uuid.NewString(),
+			},
+			ctx:        ctrl.WithUserID(context.Background(), uuid.NewString()),
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *pb.Uint64Msg, err error) {
+				assert.Nil(t, res)
+				st := status.Convert(err)
+				assert.Equal(t, codes.InvalidArgument, st.Code())
+				require.Len(t, st.Details(), 2)
+
+				br, ok := st.Details()[0].(*errdetails.BadRequest)
+				require.True(t, ok)
+				require.Len(t, br.FieldViolations, 2)
+				assert.Equal(t, "email", br.FieldViolations[0].Field)
+				assert.Equal(t, validation.ErrInvalidEmail.Error(), br.FieldViolations[0].Description)
+				assert.Equal(t, "order_items[0].quantity", br.FieldViolations[1].Field)
+
+				info, ok := st.Details()[1].(*errdetails.ErrorInfo)
+				require.True(t, ok)
+				assert.Equal(t, validation.CodeInvalidFormat, info.Metadata["email"])
+				assert.Equal(t, validation.CodeOutOfRange, info.Metadata["order_items[0].quantity"])
+			},
+		},
 		{
 			name: "Success",
 			req: &pb.OrderMsg{
-				Address: "some-address", Fio: "Test User", Tel: "some-tel", Email: "test@example.com",
+				Address: "some-address", Fio: "Test User", Tel: "+7 (999) 123-45-67", Email: "test@example.com",
 				UserId: uuid.NewString(),
 			},
-			ctx: context.WithValue(context.Background(), "uid", uuid.NewString()),
+			ctx: ctrl.WithUserID(context.Background(), uuid.NewString()),
 			mockExpect: func() {
 				mctrl.EXPECT().CreateOrder(gomock.Any(), gomock.Any(), gomock.Any()).Return(uint64(12345), nil).Times(1)
 			},
@@ -301,10 +345,10 @@ func TestHandler_CreateOrder(t *testing.T) {
 		{
 			name: "Internal Error",
 			req: &pb.OrderMsg{
-				Address: "some-address", Fio: "Test User", Tel: "some-tel", Email: "test@example.com",
+				Address: "some-address", Fio: "Test User", Tel: "+7 (999) 123-45-67", Email: "test@example.com",
 				UserId: uuid.NewString(),
 			},
-			ctx: context.WithValue(context.Background(), "uid", uuid.NewString()),
+			ctx: ctrl.WithUserID(context.Background(), uuid.NewString()),
 			mockExpect: func() {
 				mctrl.EXPECT().CreateOrder(gomock.Any(), gomock.Any(), gomock.Any()).Return(
 					uint64(0),
@@ -362,11 +406,27 @@ func TestHandler_UpdateOrder(t *testing.T) {
 				assert.Equal(t, codes.InvalidArgument, status.Code(err))
 			},
 		},
+		{
+			name: "Patch with update mask",
+			req: &pb.OrderMsg{
+				Id:         uint64(12345),
+				Status:     "shipped",
+				Items:      []*pb.OrderItem{{ItemId: uuid.NewString(), Quantity: 2}},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"status", "items"}},
+			},
+			mockExpect: func() {
+				mctrl.EXPECT().PatchOrder(gomock.Any(), uint64(12345), gomock.Any(), []string{"status", "order_items"}).Return(nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
+				assert.NotNil(t, res)
+				assert.Nil(t, err)
+			},
+		},
 		{
 			name: "Order Not Found",
 			req: &pb.OrderMsg{
 				Id:      uint64(12345),
-				Address: "some-address", Fio: "Test User", Tel: "some-tel", Email: "test@example.com",
+				Address: "some-address", Fio: "Test User", Tel: "+7 (999) 123-45-67", Email: "test@example.com",
 				UserId: uuid.NewString(),
 			},
 			mockExpect: func() {
@@ -381,7 +441,7 @@ func TestHandler_UpdateOrder(t *testing.T) {
 			name: "Internal Error",
 			req: &pb.OrderMsg{
 				Id:      uint64(12345),
-				Address: "some-address", Fio: "Test User", Tel: "some-tel", Email: "test@example.com",
+				Address: "some-address", Fio: "Test User", Tel: "+7 (999) 123-45-67", Email: "test@example.com",
 				UserId: uuid.NewString(),
 			},
 			mockExpect: func() {
@@ -400,7 +460,7 @@ func TestHandler_UpdateOrder(t *testing.T) {
 			name: "Success",
 			req: &pb.OrderMsg{
 				Id:      uint64(12345),
-				Address: "some-address", Fio: "Test User", Tel: "some-tel", Email: "test@example.com",
+				Address: "some-address", Fio: "Test User", Tel: "+7 (999) 123-45-67", Email: "test@example.com",
 				UserId: uuid.NewString(),
 			},
 			mockExpect: func() {
@@ -431,11 +491,14 @@ func TestHandler_CancelOrder(t *testing.T) {
 	mctrl := mocks.NewMockCtrl(mock)
 	h := New(mctrl, nil)
 
-	ctx := context.Background()
+	uid := uuid.New()
+	ctx := ctrl.WithUserID(context.Background(), uid.String())
+	order := &model.Order{ID: 12345, UserID: uid}
 
 	tests := []struct {
 		name         string
 		req          *pb.Uint64Msg
+		ctx          context.Context
 		mockExpect   func()
 		expectedResp func(*testing.T, *pb.Empty, error)
 	}{
@@ -452,7 +515,7 @@ func TestHandler_CancelOrder(t *testing.T) {
 			name: "Order Not Found",
 			req:  &pb.Uint64Msg{Value: 12345},
 			mockExpect: func() {
-				mctrl.EXPECT().CancelOrder(gomock.Any(), uint64(12345)).Return(ctrl.ErrNotFound).Times(1)
+				mctrl.EXPECT().GetOrder(gomock.Any(), uint64(12345)).Return(nil, ctrl.ErrNotFound).Times(1)
 			},
 			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
 				assert.Nil(t, res)
@@ -463,6 +526,7 @@ func TestHandler_CancelOrder(t *testing.T) {
 			name: "Internal Error",
 			req:  &pb.Uint64Msg{Value: 12345},
 			mockExpect: func() {
+				mctrl.EXPECT().GetOrder(gomock.Any(), uint64(12345)).Return(order, nil).Times(1)
 				mctrl.EXPECT().CancelOrder(gomock.Any(), uint64(12345)).Return(errors.New("internal error")).Times(1)
 			},
 			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
@@ -474,6 +538,22 @@ func TestHandler_CancelOrder(t *testing.T) {
 			name: "Success",
 			req:  &pb.Uint64Msg{Value: 12345},
 			mockExpect: func() {
+				mctrl.EXPECT().GetOrder(gomock.Any(), uint64(12345)).Return(order, nil).Times(1)
+				mctrl.EXPECT().CancelOrder(gomock.Any(), uint64(12345)).Return(nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
+				assert.NotNil(t, res)
+				assert.Equal(t, codes.OK, status.Code(err))
+			},
+		},
+		{
+			name: "Staff Cancels Foreign Order",
+			req:  &pb.Uint64Msg{Value: 12345},
+			ctx: ctrl.WithPermissions(
+				ctx, ctrl.ResolvePermissions([]string{string(ctrl.PermOrdersManage)}),
+			),
+			mockExpect: func() {
+				mctrl.EXPECT().GetOrder(gomock.Any(), uint64(12345)).Return(&model.Order{ID: 12345, UserID: uuid.New()}, nil).Times(1)
 				mctrl.EXPECT().CancelOrder(gomock.Any(), uint64(12345)).Return(nil).Times(1)
 			},
 			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
@@ -481,13 +561,28 @@ func TestHandler_CancelOrder(t *testing.T) {
 				assert.Equal(t, codes.OK, status.Code(err))
 			},
 		},
+		{
+			name: "Foreign Order",
+			req:  &pb.Uint64Msg{Value: 12345},
+			mockExpect: func() {
+				mctrl.EXPECT().GetOrder(gomock.Any(), uint64(12345)).Return(&model.Order{ID: 12345, UserID: uuid.New()}, nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
 				tt.mockExpect()
-				res, err := h.CancelOrder(ctx, tt.req)
+				reqCtx := ctx
+				if tt.ctx != nil {
+					reqCtx = tt.ctx
+				}
+				res, err := h.CancelOrder(reqCtx, tt.req)
 				tt.expectedResp(t, res, err)
 			},
 		)
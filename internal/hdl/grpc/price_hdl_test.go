@@ -0,0 +1,233 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+	"time"
+)
+
+func TestHandler_GetPriceTimeline(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	msso := mocks.NewMockSSOSvc(mock)
+	h := New(mctrl, msso)
+
+	ctx := context.Background()
+	uid := uuid.New()
+
+	tests := []struct {
+		name         string
+		req          *pb.UuidMsg
+		mockExpect   func()
+		expectedResp func(*testing.T, *pb.PriceTimelineMsg, error)
+	}{
+		{
+			name:       "Invalid UUID",
+			req:        &pb.UuidMsg{Uuid: "invalid-uuid"},
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *pb.PriceTimelineMsg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "Not found",
+			req:  &pb.UuidMsg{Uuid: uid.String()},
+			mockExpect: func() {
+				mctrl.EXPECT().GetPriceTimeline(gomock.Any(), uid).Return(nil, ctrl.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.PriceTimelineMsg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "Success",
+			req:  &pb.UuidMsg{Uuid: uid.String()},
+			mockExpect: func() {
+				mctrl.EXPECT().GetPriceTimeline(gomock.Any(), uid).Return(
+					&model.PriceTimeline{
+						ItemID:         uid,
+						CurrentPrice:   model.NewMoney(8000, model.DefaultCurrency),
+						LowestPrice30d: model.NewMoney(7000, model.DefaultCurrency),
+						History:        []*model.PriceHistory{{ItemID: uid, OldPrice: model.NewMoney(7000, model.DefaultCurrency), NewPrice: model.NewMoney(8000, model.DefaultCurrency)}},
+					}, nil,
+				).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.PriceTimelineMsg, err error) {
+				assert.Equal(t, codes.OK, status.Code(err))
+				assert.Equal(t, int64(7000), res.LowestPrice_30D.Amount)
+				assert.Len(t, res.History, 1)
+			},
+		},
+		{
+			name: "Internal error",
+			req:  &pb.UuidMsg{Uuid: uid.String()},
+			mockExpect: func() {
+				mctrl.EXPECT().GetPriceTimeline(gomock.Any(), uid).Return(nil, errors.New("internal error")).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.PriceTimelineMsg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.Internal, status.Code(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := h.GetPriceTimeline(ctx, tt.req)
+				tt.expectedResp(t, res, err)
+			},
+		)
+	}
+}
+
+func TestHandler_SchedulePriceChange(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	msso := mocks.NewMockSSOSvc(mock)
+	h := New(mctrl, msso)
+
+	ctx := context.Background()
+	uid := uuid.New()
+	future := timestamppb.New(time.Now().Add(time.Hour))
+
+	tests := []struct {
+		name         string
+		req          *pb.ScheduledPriceMsg
+		mockExpect   func()
+		expectedResp func(*testing.T, *pb.Uint64Msg, error)
+	}{
+		{
+			name:       "Invalid request",
+			req:        &pb.ScheduledPriceMsg{Price: &pb.Money{Amount: 1000, Currency: "RUB"}, StartsAt: future},
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *pb.Uint64Msg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "Starts in the past",
+			req: &pb.ScheduledPriceMsg{
+				ItemId:   uid.String(),
+				Price:    &pb.Money{Amount: 1000, Currency: "RUB"},
+				StartsAt: timestamppb.New(time.Now().Add(-time.Hour)),
+			},
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *pb.Uint64Msg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "Success",
+			req:  &pb.ScheduledPriceMsg{ItemId: uid.String(), Price: &pb.Money{Amount: 1000, Currency: "RUB"}, StartsAt: future},
+			mockExpect: func() {
+				mctrl.EXPECT().SchedulePriceChange(gomock.Any(), gomock.Any()).Return(uint64(1), nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.Uint64Msg, err error) {
+				assert.Equal(t, codes.OK, status.Code(err))
+				assert.Equal(t, uint64(1), res.Value)
+			},
+		},
+		{
+			name: "Item not found",
+			req:  &pb.ScheduledPriceMsg{ItemId: uid.String(), Price: &pb.Money{Amount: 1000, Currency: "RUB"}, StartsAt: future},
+			mockExpect: func() {
+				mctrl.EXPECT().SchedulePriceChange(gomock.Any(), gomock.Any()).Return(uint64(0), ctrl.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.Uint64Msg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := h.SchedulePriceChange(ctx, tt.req)
+				tt.expectedResp(t, res, err)
+			},
+		)
+	}
+}
+
+func TestHandler_CancelScheduledPrice(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	msso := mocks.NewMockSSOSvc(mock)
+	h := New(mctrl, msso)
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		req          *pb.Uint64Msg
+		mockExpect   func()
+		expectedResp func(*testing.T, *pb.Empty, error)
+	}{
+		{
+			name:       "Invalid request",
+			req:        &pb.Uint64Msg{Value: 0},
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "Not found",
+			req:  &pb.Uint64Msg{Value: 1},
+			mockExpect: func() {
+				mctrl.EXPECT().CancelScheduledPrice(gomock.Any(), uint64(1)).Return(ctrl.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "Success",
+			req:  &pb.Uint64Msg{Value: 1},
+			mockExpect: func() {
+				mctrl.EXPECT().CancelScheduledPrice(gomock.Any(), uint64(1)).Return(nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
+				assert.Equal(t, &pb.Empty{}, res)
+				assert.Equal(t, codes.OK, status.Code(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := h.CancelScheduledPrice(ctx, tt.req)
+				tt.expectedResp(t, res, err)
+			},
+		)
+	}
+}
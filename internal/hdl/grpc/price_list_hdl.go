@@ -0,0 +1,243 @@
+package grpc
+
+import (
+	"context"
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/pkg/model/mapper"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"time"
+)
+
+func (h *Handler) ListPriceLists(ctx context.Context, req *pb.Empty) (*pb.PriceListListRes, error) {
+	s, c := time.Now(), codes.OK
+	const op = "priceLists.ListPriceLists.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	res, err := h.ctrl.ListPriceLists(ctx)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.PriceListListRes{Data: mapper.ListPriceListsToProto(res)}, nil
+}
+
+func (h *Handler) GetPriceList(ctx context.Context, req *pb.SlugMsg) (*pb.PriceListMsg, error) {
+	s, c := time.Now(), codes.OK
+	const op = "priceLists.GetPriceList.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Slug == "" {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	res, err := h.ctrl.GetPriceList(ctx, req.Slug)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return mapper.PriceListToProto(res), nil
+}
+
+func (h *Handler) CreatePriceList(ctx context.Context, req *pb.PriceListMsg) (*pb.SlugMsg, error) {
+	s, c := time.Now(), codes.OK
+	const op = "priceLists.CreatePriceList.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	pl := mapper.PriceListFromProto(req)
+	if err := validation.PriceListValidation(pl); err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
+	}
+
+	res, err := h.ctrl.CreatePriceList(ctx, pl)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.SlugMsg{Slug: res}, nil
+}
+
+func (h *Handler) UpdatePriceList(ctx context.Context, req *pb.PriceListMsg) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "priceLists.UpdatePriceList.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Slug == "" {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	pl := mapper.PriceListFromProto(req)
+	if err := validation.PriceListValidation(pl); err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
+	}
+
+	err := h.ctrl.UpdatePriceList(ctx, req.Slug, pl)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *Handler) DeletePriceList(ctx context.Context, req *pb.SlugMsg) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "priceLists.DeletePriceList.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Slug == "" {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	err := h.ctrl.DeletePriceList(ctx, req.Slug)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *Handler) ListPriceListItems(ctx context.Context, req *pb.ListPriceListItemsReq) (*pb.PaginatedPriceListItemsRes, error) {
+	s, c := time.Now(), codes.OK
+	const op = "priceLists.ListPriceListItems.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Slug == "" || req.Page == 0 || req.Size == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	res, err := h.ctrl.ListPriceListItems(ctx, req.Slug, int(req.Page), int(req.Size))
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.PaginatedPriceListItemsRes{
+		Data:        mapper.ListPriceListItemsToProto(res.Data),
+		Count:       res.Count,
+		TotalPages:  int64(res.TotalPages),
+		CurrentPage: int64(res.CurrentPage),
+		HasNextPage: res.HasNextPage,
+	}, nil
+}
+
+func (h *Handler) SetPriceListItems(ctx context.Context, req *pb.SetPriceListItemsReq) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "priceLists.SetPriceListItems.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Slug == "" {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	items := mapper.ListPriceListItemsFromProto(req.Items)
+	if err := validation.PriceListItemsValidation(items); err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
+	}
+
+	err := h.ctrl.SetPriceListItems(ctx, req.Slug, items)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *Handler) DeletePriceListItem(ctx context.Context, req *pb.PriceListItemReq) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "priceLists.DeletePriceListItem.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Slug == "" || req.ItemId == "" {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	itemID, err := uuid.Parse(req.ItemId)
+	if err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	err = h.ctrl.DeletePriceListItem(ctx, req.Slug, itemID)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
@@ -0,0 +1,223 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestHandler_GetPriceList(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	msso := mocks.NewMockSSOSvc(mock)
+	h := New(mctrl, msso)
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		req          *pb.SlugMsg
+		mockExpect   func()
+		expectedResp func(*testing.T, *pb.PriceListMsg, error)
+	}{
+		{
+			name:       "Empty slug",
+			req:        &pb.SlugMsg{},
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *pb.PriceListMsg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "Not found",
+			req:  &pb.SlugMsg{Slug: "kz"},
+			mockExpect: func() {
+				mctrl.EXPECT().GetPriceList(gomock.Any(), "kz").Return(nil, ctrl.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.PriceListMsg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "Internal error",
+			req:  &pb.SlugMsg{Slug: "kz"},
+			mockExpect: func() {
+				mctrl.EXPECT().GetPriceList(gomock.Any(), "kz").Return(nil, errors.New("internal error")).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.PriceListMsg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.Internal, status.Code(err))
+			},
+		},
+		{
+			name: "Success",
+			req:  &pb.SlugMsg{Slug: "kz"},
+			mockExpect: func() {
+				mctrl.EXPECT().GetPriceList(gomock.Any(), "kz").Return(
+					&model.PriceList{ID: 1, Slug: "kz", Currency: "KZT", BaseCurrency: "RUB", Rate: 5.47}, nil,
+				).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.PriceListMsg, err error) {
+				assert.Equal(t, codes.OK, status.Code(err))
+				assert.Equal(t, "KZT", res.Currency)
+				assert.Equal(t, 5.47, res.Rate)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := h.GetPriceList(ctx, tt.req)
+				tt.expectedResp(t, res, err)
+			},
+		)
+	}
+}
+
+func TestHandler_CreatePriceList(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	msso := mocks.NewMockSSOSvc(mock)
+	h := New(mctrl, msso)
+
+	ctx := context.Background()
+	req := &pb.PriceListMsg{Name: "Kazakhstan", Currency: "KZT", Rate: 5.47}
+
+	tests := []struct {
+		name         string
+		req          *pb.PriceListMsg
+		mockExpect   func()
+		expectedResp func(*testing.T, *pb.SlugMsg, error)
+	}{
+		{
+			name:       "Invalid currency",
+			req:        &pb.PriceListMsg{Name: "Kazakhstan", Currency: "XX"},
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *pb.SlugMsg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "Already exists",
+			req:  req,
+			mockExpect: func() {
+				mctrl.EXPECT().CreatePriceList(gomock.Any(), gomock.Any()).Return("", ctrl.ErrAlreadyExists).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.SlugMsg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.AlreadyExists, status.Code(err))
+			},
+		},
+		{
+			name: "Success",
+			req:  req,
+			mockExpect: func() {
+				mctrl.EXPECT().CreatePriceList(gomock.Any(), gomock.Any()).Return("kazakhstan", nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.SlugMsg, err error) {
+				assert.Equal(t, codes.OK, status.Code(err))
+				assert.Equal(t, "kazakhstan", res.Slug)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := h.CreatePriceList(ctx, tt.req)
+				tt.expectedResp(t, res, err)
+			},
+		)
+	}
+}
+
+func TestHandler_SetPriceListItems(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	msso := mocks.NewMockSSOSvc(mock)
+	h := New(mctrl, msso)
+
+	ctx := context.Background()
+	items := []*pb.PriceListItemMsg{{ItemId: uuid.NewString(), Price: &pb.Money{Amount: 5000, Currency: "KZT"}}}
+
+	tests := []struct {
+		name         string
+		req          *pb.SetPriceListItemsReq
+		mockExpect   func()
+		expectedResp func(*testing.T, *pb.Empty, error)
+	}{
+		{
+			name:       "No items",
+			req:        &pb.SetPriceListItemsReq{Slug: "kz"},
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "Price unavailable",
+			req:  &pb.SetPriceListItemsReq{Slug: "kz", Items: items},
+			mockExpect: func() {
+				mctrl.EXPECT().SetPriceListItems(gomock.Any(), "kz", gomock.Any()).Return(ctrl.ErrPriceUnavailable).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "Not found",
+			req:  &pb.SetPriceListItemsReq{Slug: "kz", Items: items},
+			mockExpect: func() {
+				mctrl.EXPECT().SetPriceListItems(gomock.Any(), "kz", gomock.Any()).Return(ctrl.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "Success",
+			req:  &pb.SetPriceListItemsReq{Slug: "kz", Items: items},
+			mockExpect: func() {
+				mctrl.EXPECT().SetPriceListItems(gomock.Any(), "kz", gomock.Any()).Return(nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res *pb.Empty, err error) {
+				assert.Equal(t, codes.OK, status.Code(err))
+				assert.NotNil(t, res)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := h.SetPriceListItems(ctx, tt.req)
+				tt.expectedResp(t, res, err)
+			},
+		)
+	}
+}
@@ -2,7 +2,6 @@ package grpc
 
 import (
 	"context"
-	"errors"
 	pb "github.com/JMURv/par-pro/products/api/pb"
 	"github.com/JMURv/par-pro/products/internal/ctrl"
 	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
@@ -11,7 +10,6 @@ import (
 	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"time"
 )
 
@@ -29,13 +27,13 @@ func (h *Handler) PromotionSearch(ctx context.Context, req *pb.SearchReq) (*pb.P
 	if q == "" || page == 0 || size == 0 {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
 	}
 
 	res, err := h.ctrl.PromotionSearch(ctx, q, int(page), int(size))
 	if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return &pb.PaginatedPromoRes{
@@ -61,13 +59,13 @@ func (h *Handler) ListPromotions(ctx context.Context, req *pb.ListReq) (*pb.Pagi
 	if page == 0 || size == 0 {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
 	}
 
 	res, err := h.ctrl.ListPromotions(ctx, int(page), int(size))
 	if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return &pb.PaginatedPromoRes{
@@ -93,16 +91,13 @@ func (h *Handler) ListPromotionItems(ctx context.Context, req *pb.ListPromotionI
 	if req.Slug == "" || page == 0 || size == 0 {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
 	}
 
 	res, err := h.ctrl.ListPromotionItems(ctx, slug, int(page), int(size))
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = codes.NotFound
-		return nil, status.Errorf(c, err.Error())
-	} else if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return &pb.PaginatedPromoItemsRes{
@@ -127,16 +122,13 @@ func (h *Handler) GetPromotion(ctx context.Context, req *pb.SlugMsg) (*pb.PromoM
 	if req == nil || req.Slug == "" {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
 	}
 
 	res, err := h.ctrl.GetPromotion(ctx, req.Slug)
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = codes.NotFound
-		return nil, status.Errorf(c, err.Error())
-	} else if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return mapper.PromoToProto(res), nil
@@ -155,20 +147,20 @@ func (h *Handler) CreatePromotion(ctx context.Context, req *pb.PromoMsg) (*pb.Sl
 	if req == nil {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
 	}
 
 	obj := mapper.PromoFromProto(req)
 	if err := validation.ValidatePromotion(obj); err != nil {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
-		return nil, status.Errorf(c, err.Error())
+		return nil, errStatus(c, err)
 	}
 
 	res, err := h.ctrl.CreatePromotion(ctx, obj)
 	if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return &pb.SlugMsg{Slug: res}, nil
@@ -187,22 +179,37 @@ func (h *Handler) UpdatePromotion(ctx context.Context, req *pb.PromoWithSlug) (*
 	if req == nil || req.Slug == "" {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	fields := req.GetUpdateMask().GetPaths()
+	if len(fields) > 0 && !req.UpdateMask.IsValid(req.GetData()) {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate update mask", zap.String("op", op), zap.Strings("paths", fields))
+		return nil, errStatus(c, validation.ErrUnknownField)
 	}
+
+	var err error
 	obj := mapper.PromoFromProto(req.Data)
-	if err := validation.ValidatePromotion(obj); err != nil {
+	if len(fields) > 0 {
+		err = validation.PromotionPatchValidation(obj, fields)
+	} else {
+		err = validation.ValidatePromotion(obj)
+	}
+	if err != nil {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to validate request", zap.String("op", op), zap.Error(err))
-		return nil, status.Errorf(c, err.Error())
+		return nil, errStatus(c, err)
 	}
 
-	err := h.ctrl.UpdatePromotion(ctx, req.Slug, obj)
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = codes.NotFound
-		return nil, status.Errorf(c, err.Error())
-	} else if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+	if len(fields) > 0 {
+		err = h.ctrl.PatchPromotion(ctx, req.Slug, obj, fields)
+	} else {
+		err = h.ctrl.UpdatePromotion(ctx, req.Slug, obj)
+	}
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return &pb.Empty{}, nil
@@ -221,16 +228,13 @@ func (h *Handler) DeletePromotion(ctx context.Context, req *pb.SlugMsg) (*pb.Emp
 	if req == nil || req.Slug == "" {
 		c = codes.InvalidArgument
 		zap.L().Debug("failed to decode request", zap.String("op", op))
-		return nil, status.Errorf(c, ctrl.ErrDecodeRequest.Error())
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
 	}
 
 	err := h.ctrl.DeletePromotion(ctx, req.Slug)
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = codes.NotFound
-		return nil, status.Errorf(c, err.Error())
-	} else if err != nil {
-		c = codes.Internal
-		return nil, status.Errorf(c, ctrl.ErrInternalError.Error())
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
 	}
 
 	return &pb.Empty{}, nil
@@ -11,7 +11,9 @@ import (
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"testing"
+	"time"
 )
 
 func TestHandler_PromotionSearch(t *testing.T) {
@@ -377,6 +379,7 @@ func TestHandler_CreatePromotion(t *testing.T) {
 				Title:       "Test Promotion",
 				Description: "Test Description",
 				Src:         "test-src",
+				LastsTo:     timestamppb.New(time.Now().Add(24 * time.Hour)),
 			},
 			mockExpect: func() {
 				mctrl.EXPECT().CreatePromotion(gomock.Any(), gomock.Any()).Return(promoSlug, nil).Times(1)
@@ -393,6 +396,7 @@ func TestHandler_CreatePromotion(t *testing.T) {
 				Title:       "Test Promotion",
 				Description: "Test Description",
 				Src:         "test-src",
+				LastsTo:     timestamppb.New(time.Now().Add(24 * time.Hour)),
 			},
 			mockExpect: func() {
 				mctrl.EXPECT().CreatePromotion(gomock.Any(), gomock.Any()).Return(
@@ -461,6 +465,7 @@ func TestHandler_UpdatePromotion(t *testing.T) {
 					Title:       "Test Promotion",
 					Description: "Test Description",
 					Src:         "test-src",
+					LastsTo:     timestamppb.New(time.Now().Add(24 * time.Hour)),
 				},
 			},
 			mockExpect: func() {
@@ -480,6 +485,7 @@ func TestHandler_UpdatePromotion(t *testing.T) {
 					Title:       "Test Promotion",
 					Description: "Test Description",
 					Src:         "test-src",
+					LastsTo:     timestamppb.New(time.Now().Add(24 * time.Hour)),
 				},
 			},
 			mockExpect: func() {
@@ -503,6 +509,7 @@ func TestHandler_UpdatePromotion(t *testing.T) {
 					Title:       "Test Promotion",
 					Description: "Test Description",
 					Src:         "test-src",
+					LastsTo:     timestamppb.New(time.Now().Add(24 * time.Hour)),
 				},
 			},
 			mockExpect: func() {
@@ -0,0 +1,356 @@
+package grpc
+
+import (
+	"context"
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/JMURv/par-pro/products/pkg/model/mapper"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"time"
+)
+
+func (h *Handler) ListItemQuestions(ctx context.Context, req *pb.ListItemQuestionsReq) (*pb.PaginatedQuestionRes, error) {
+	s, c := time.Now(), codes.OK
+	const op = "questions.ListItemQuestions.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Page == 0 || req.Size == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	uid, err := uuid.Parse(req.Uuid)
+	if err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	res, err := h.ctrl.ListItemQuestions(ctx, uid, int(req.Page), int(req.Size))
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.PaginatedQuestionRes{
+		Data:        mapper.ListQuestionsToProto(res.Data),
+		Count:       res.Count,
+		TotalPages:  int64(res.TotalPages),
+		CurrentPage: int64(res.CurrentPage),
+		HasNextPage: res.HasNextPage,
+	}, nil
+}
+
+func (h *Handler) ListPendingQuestions(ctx context.Context, req *pb.ListReq) (*pb.PaginatedQuestionRes, error) {
+	s, c := time.Now(), codes.OK
+	const op = "questions.ListPendingQuestions.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Page == 0 || req.Size == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	res, err := h.ctrl.ListPendingQuestions(ctx, int(req.Page), int(req.Size))
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.PaginatedQuestionRes{
+		Data:        mapper.ListQuestionsToProto(res.Data),
+		Count:       res.Count,
+		TotalPages:  int64(res.TotalPages),
+		CurrentPage: int64(res.CurrentPage),
+		HasNextPage: res.HasNextPage,
+	}, nil
+}
+
+func (h *Handler) CreateQuestion(ctx context.Context, req *pb.QuestionMsg) (*pb.Uint64Msg, error) {
+	s, c := time.Now(), codes.OK
+	const op = "questions.CreateQuestion.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	uid, code, err := ctxUserID(ctx)
+	if err != nil {
+		c = code
+		zap.L().Debug("failed to get user", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
+	}
+
+	q := mapper.QuestionFromProto(req)
+	if q.ItemID == uuid.Nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to parse uuid", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+	q.UserID = uid
+
+	if err = validation.QuestionValidation(q); err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
+	}
+
+	id, err := h.ctrl.CreateQuestion(ctx, q)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Uint64Msg{Value: id}, nil
+}
+
+func (h *Handler) ModerateQuestion(ctx context.Context, req *pb.ModerateQuestionReq) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "questions.ModerateQuestion.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Id == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	m := &model.Moderation{Status: req.Status, Note: req.Note}
+	if err := validation.ModerationValidation(m); err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
+	}
+
+	err := h.ctrl.ModerateQuestion(ctx, req.Id, m)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *Handler) DeleteQuestion(ctx context.Context, req *pb.Uint64Msg) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "questions.DeleteQuestion.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Value == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	uid, code, err := ctxUserID(ctx)
+	if err != nil {
+		c = code
+		zap.L().Debug("failed to get user", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
+	}
+
+	err = h.ctrl.DeleteQuestion(ctx, req.Value, uid)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *Handler) ListPendingAnswers(ctx context.Context, req *pb.ListReq) (*pb.PaginatedAnswerRes, error) {
+	s, c := time.Now(), codes.OK
+	const op = "questions.ListPendingAnswers.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Page == 0 || req.Size == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	res, err := h.ctrl.ListPendingAnswers(ctx, int(req.Page), int(req.Size))
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.PaginatedAnswerRes{
+		Data:        mapper.ListAnswersToProto(res.Data),
+		Count:       res.Count,
+		TotalPages:  int64(res.TotalPages),
+		CurrentPage: int64(res.CurrentPage),
+		HasNextPage: res.HasNextPage,
+	}, nil
+}
+
+func (h *Handler) CreateAnswer(ctx context.Context, req *pb.AnswerMsg) (*pb.Uint64Msg, error) {
+	s, c := time.Now(), codes.OK
+	const op = "questions.CreateAnswer.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.QuestionId == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	uid, code, err := ctxUserID(ctx)
+	if err != nil {
+		c = code
+		zap.L().Debug("failed to get user", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
+	}
+
+	a := mapper.AnswerFromProto(req)
+	a.UserID = uid
+
+	if err = validation.AnswerValidation(a); err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
+	}
+
+	id, err := h.ctrl.CreateAnswer(ctx, a)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Uint64Msg{Value: id}, nil
+}
+
+func (h *Handler) ModerateAnswer(ctx context.Context, req *pb.ModerateQuestionReq) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "questions.ModerateAnswer.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Id == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	m := &model.Moderation{Status: req.Status, Note: req.Note}
+	if err := validation.ModerationValidation(m); err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
+	}
+
+	err := h.ctrl.ModerateAnswer(ctx, req.Id, m)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *Handler) SetAnswerOfficial(ctx context.Context, req *pb.SetAnswerOfficialReq) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "questions.SetAnswerOfficial.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Id == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	err := h.ctrl.SetAnswerOfficial(ctx, req.Id, req.Official)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *Handler) DeleteAnswer(ctx context.Context, req *pb.Uint64Msg) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "questions.DeleteAnswer.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Value == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	uid, code, err := ctxUserID(ctx)
+	if err != nil {
+		c = code
+		zap.L().Debug("failed to get user", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
+	}
+
+	err = h.ctrl.DeleteAnswer(ctx, req.Value, uid)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
@@ -0,0 +1,92 @@
+package grpc
+
+import (
+	"context"
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestHandler_CreateQuestion(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	msso := mocks.NewMockSSOSvc(mock)
+	h := New(mctrl, msso)
+
+	itemID, userID := uuid.New(), uuid.New()
+	authCtx := ctrl.WithUserID(context.Background(), userID.String())
+
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		req          *pb.QuestionMsg
+		mockExpect   func()
+		expectedResp func(*testing.T, *pb.Uint64Msg, error)
+	}{
+		{
+			name:       "Unauthenticated",
+			ctx:        context.Background(),
+			req:        &pb.QuestionMsg{ItemId: itemID.String(), Text: "Is it waterproof?"},
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *pb.Uint64Msg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+		{
+			name:       "Empty text",
+			ctx:        authCtx,
+			req:        &pb.QuestionMsg{ItemId: itemID.String(), Text: "  "},
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *pb.Uint64Msg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "Item not found",
+			ctx:  authCtx,
+			req:  &pb.QuestionMsg{ItemId: itemID.String(), Text: "Is it waterproof?"},
+			mockExpect: func() {
+				mctrl.EXPECT().CreateQuestion(gomock.Any(), gomock.Any()).Return(uint64(0), ctrl.ErrNotFound)
+			},
+			expectedResp: func(t *testing.T, res *pb.Uint64Msg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "User id comes from the token",
+			ctx:  authCtx,
+			req:  &pb.QuestionMsg{ItemId: itemID.String(), UserId: uuid.NewString(), Text: "Is it waterproof?"},
+			mockExpect: func() {
+				mctrl.EXPECT().CreateQuestion(
+					gomock.Any(), &model.Question{ItemID: itemID, UserID: userID, Text: "Is it waterproof?"},
+				).Return(uint64(2), nil)
+			},
+			expectedResp: func(t *testing.T, res *pb.Uint64Msg, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, uint64(2), res.Value)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := h.CreateQuestion(tt.ctx, tt.req)
+				tt.expectedResp(t, res, err)
+			},
+		)
+	}
+}
@@ -0,0 +1,309 @@
+package grpc
+
+import (
+	"context"
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/JMURv/par-pro/products/pkg/model/mapper"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"io"
+	"time"
+)
+
+func (h *Handler) ListItemReviews(ctx context.Context, req *pb.ListItemReviewsReq) (*pb.PaginatedReviewRes, error) {
+	s, c := time.Now(), codes.OK
+	const op = "reviews.ListItemReviews.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Page == 0 || req.Size == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	uid, err := uuid.Parse(req.Uuid)
+	if err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	res, err := h.ctrl.ListItemReviews(ctx, uid, int(req.Page), int(req.Size))
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.PaginatedReviewRes{
+		Data:        mapper.ListReviewsToProto(res.Data),
+		Count:       res.Count,
+		TotalPages:  int64(res.TotalPages),
+		CurrentPage: int64(res.CurrentPage),
+		HasNextPage: res.HasNextPage,
+	}, nil
+}
+
+func (h *Handler) ListPendingReviews(ctx context.Context, req *pb.ListReq) (*pb.PaginatedReviewRes, error) {
+	s, c := time.Now(), codes.OK
+	const op = "reviews.ListPendingReviews.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Page == 0 || req.Size == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	res, err := h.ctrl.ListPendingReviews(ctx, int(req.Page), int(req.Size))
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.PaginatedReviewRes{
+		Data:        mapper.ListReviewsToProto(res.Data),
+		Count:       res.Count,
+		TotalPages:  int64(res.TotalPages),
+		CurrentPage: int64(res.CurrentPage),
+		HasNextPage: res.HasNextPage,
+	}, nil
+}
+
+func (h *Handler) CreateReview(ctx context.Context, req *pb.ReviewMsg) (*pb.Uint64Msg, error) {
+	s, c := time.Now(), codes.OK
+	const op = "reviews.CreateReview.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	r := mapper.ReviewFromProto(req)
+	if r.ItemID == uuid.Nil || r.UserID == uuid.Nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to parse uuid", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	if code, err := requireSelf(ctx, r.UserID); err != nil {
+		c = code
+		zap.L().Debug("caller is not the author", zap.String("op", op))
+		return nil, errStatus(code, err)
+	}
+
+	if err := validation.ReviewValidation(r); err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
+	}
+
+	id, err := h.ctrl.CreateReview(ctx, r)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Uint64Msg{Value: id}, nil
+}
+
+func (h *Handler) UpdateReview(ctx context.Context, req *pb.ReviewMsg) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "reviews.UpdateReview.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Id == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	r := mapper.ReviewFromProto(req)
+	if r.UserID == uuid.Nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to parse uuid", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	if code, err := requireSelf(ctx, r.UserID); err != nil {
+		c = code
+		zap.L().Debug("caller is not the author", zap.String("op", op))
+		return nil, errStatus(code, err)
+	}
+
+	if err := validation.ReviewValidation(r); err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
+	}
+
+	err := h.ctrl.UpdateReview(ctx, r)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *Handler) ModerateReview(ctx context.Context, req *pb.ModerateReviewReq) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "reviews.ModerateReview.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Id == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	m := &model.Moderation{Status: req.Status, Note: req.Note}
+	if err := validation.ModerationValidation(m); err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, err)
+	}
+
+	err := h.ctrl.ModerateReview(ctx, req.Id, m)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *Handler) DeleteReview(ctx context.Context, req *pb.DeleteReviewReq) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "reviews.DeleteReview.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Id == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		return nil, errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	if c, err = requireSelf(ctx, uid); err != nil {
+		zap.L().Debug("caller is not the author", zap.String("op", op))
+		return nil, errStatus(c, err)
+	}
+
+	err = h.ctrl.DeleteReview(ctx, req.Id, uid)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+// UploadReviewMedia expects an info message first, followed by the file in chunks.
+func (h *Handler) UploadReviewMedia(stream pb.Review_UploadReviewMediaServer) error {
+	s, c := time.Now(), codes.OK
+	const op = "reviews.UploadReviewMedia.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx := opentracing.ContextWithSpan(stream.Context(), span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	first, err := stream.Recv()
+	if err != nil || first.GetInfo() == nil || first.GetInfo().ReviewId == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		return errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	info := first.GetInfo()
+	uid, err := uuid.Parse(info.UserId)
+	if err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		return errStatus(c, ctrl.ErrParseUUID)
+	}
+
+	if c, err = requireSelf(ctx, uid); err != nil {
+		zap.L().Debug("caller is not the author", zap.String("op", op))
+		return errStatus(c, err)
+	}
+
+	obj := &model.MediaUpload{}
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			c = codes.Canceled
+			zap.L().Debug("failed to receive chunk", zap.String("op", op), zap.Error(err))
+			return errStatus(c, err)
+		}
+
+		obj.Data = append(obj.Data, chunk.GetChunk()...)
+		if len(obj.Data) > consts.MaxMediaSize {
+			c = codes.InvalidArgument
+			return errStatus(c, validation.ErrFileTooLarge)
+		}
+	}
+
+	if err = validation.MediaUploadValidation(obj); err != nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		return errStatus(c, err)
+	}
+
+	res, err := h.ctrl.UploadReviewMedia(ctx, uid, info.ReviewId, obj)
+	if err != nil {
+		c = errCode(err)
+		return errStatus(c, err)
+	}
+
+	return stream.SendAndClose(mapper.ReviewMediaToProto(res))
+}
@@ -0,0 +1,113 @@
+package grpc
+
+import (
+	"context"
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestHandler_CreateReview(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	msso := mocks.NewMockSSOSvc(mock)
+	h := New(mctrl, msso)
+
+	itemID, userID := uuid.New(), uuid.New()
+	ctx := ctrl.WithUserID(context.Background(), userID.String())
+
+	tests := []struct {
+		name         string
+		req          *pb.ReviewMsg
+		mockExpect   func()
+		expectedResp func(*testing.T, *pb.Uint64Msg, error)
+	}{
+		{
+			name:       "Nil request",
+			req:        nil,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *pb.Uint64Msg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name:       "Invalid uuid",
+			req:        &pb.ReviewMsg{ItemId: "invalid", UserId: userID.String(), Rating: 5, Text: "Great"},
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *pb.Uint64Msg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name:       "Foreign author",
+			req:        &pb.ReviewMsg{ItemId: itemID.String(), UserId: uuid.NewString(), Rating: 5, Text: "Great"},
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *pb.Uint64Msg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+		{
+			name:       "Invalid rating",
+			req:        &pb.ReviewMsg{ItemId: itemID.String(), UserId: userID.String(), Rating: 0, Text: "Great"},
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res *pb.Uint64Msg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "Not purchased",
+			req:  &pb.ReviewMsg{ItemId: itemID.String(), UserId: userID.String(), Rating: 5, Text: "Great"},
+			mockExpect: func() {
+				mctrl.EXPECT().CreateReview(gomock.Any(), gomock.Any()).Return(uint64(0), ctrl.ErrNotPurchased)
+			},
+			expectedResp: func(t *testing.T, res *pb.Uint64Msg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+		{
+			name: "Already exists",
+			req:  &pb.ReviewMsg{ItemId: itemID.String(), UserId: userID.String(), Rating: 5, Text: "Great"},
+			mockExpect: func() {
+				mctrl.EXPECT().CreateReview(gomock.Any(), gomock.Any()).Return(uint64(0), ctrl.ErrAlreadyExists)
+			},
+			expectedResp: func(t *testing.T, res *pb.Uint64Msg, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, codes.AlreadyExists, status.Code(err))
+			},
+		},
+		{
+			name: "Success",
+			req:  &pb.ReviewMsg{ItemId: itemID.String(), UserId: userID.String(), Rating: 4, Cons: "Heavy"},
+			mockExpect: func() {
+				mctrl.EXPECT().CreateReview(gomock.Any(), gomock.Any()).Return(uint64(7), nil)
+			},
+			expectedResp: func(t *testing.T, res *pb.Uint64Msg, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, uint64(7), res.Value)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := h.CreateReview(ctx, tt.req)
+				tt.expectedResp(t, res, err)
+			},
+		)
+	}
+}
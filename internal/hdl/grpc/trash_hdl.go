@@ -0,0 +1,140 @@
+package grpc
+
+import (
+	"context"
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/pkg/model/mapper"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"time"
+)
+
+func (h *Handler) ListTrash(ctx context.Context, req *pb.ListTrashReq) (*pb.PaginatedTrashRes, error) {
+	s, c := time.Now(), codes.OK
+	const op = "trash.ListTrash.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil || req.Page == 0 || req.Size == 0 {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	if err := validation.TrashKindValidation(req.Kind); err != nil {
+		c = codes.InvalidArgument
+		return nil, errStatus(c, err)
+	}
+
+	res, err := h.ctrl.ListTrash(ctx, req.Kind, int(req.Page), int(req.Size))
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.PaginatedTrashRes{
+		Data:        mapper.ListTrashToProto(res.Data),
+		Count:       res.Count,
+		TotalPages:  int64(res.TotalPages),
+		CurrentPage: int64(res.CurrentPage),
+		HasNextPage: res.HasNextPage,
+	}, nil
+}
+
+func (h *Handler) MoveToTrash(ctx context.Context, req *pb.TrashEntryReq) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "trash.MoveToTrash.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	if err := validation.TrashEntryValidation(req.Kind, req.Id); err != nil {
+		c = codes.InvalidArgument
+		return nil, errStatus(c, err)
+	}
+
+	err := h.ctrl.MoveToTrash(ctx, req.Kind, req.Id)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *Handler) RestoreFromTrash(ctx context.Context, req *pb.TrashEntryReq) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "trash.RestoreFromTrash.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	if err := validation.TrashEntryValidation(req.Kind, req.Id); err != nil {
+		c = codes.InvalidArgument
+		return nil, errStatus(c, err)
+	}
+
+	err := h.ctrl.RestoreFromTrash(ctx, req.Kind, req.Id)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *Handler) PurgeFromTrash(ctx context.Context, req *pb.TrashEntryReq) (*pb.Empty, error) {
+	s, c := time.Now(), codes.OK
+	const op = "trash.PurgeFromTrash.handler"
+	span := opentracing.GlobalTracer().StartSpan(op)
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	defer func() {
+		span.Finish()
+		metrics.ObserveRequest(time.Since(s), int(c), op)
+	}()
+
+	if req == nil {
+		c = codes.InvalidArgument
+		zap.L().Debug("failed to decode request", zap.String("op", op))
+		return nil, errStatus(c, ctrl.ErrDecodeRequest)
+	}
+
+	if err := validation.TrashEntryValidation(req.Kind, req.Id); err != nil {
+		c = codes.InvalidArgument
+		return nil, errStatus(c, err)
+	}
+
+	err := h.ctrl.PurgeFromTrash(ctx, req.Kind, req.Id)
+	if err != nil {
+		c = errCode(err)
+		return nil, errStatus(c, err)
+	}
+
+	return &pb.Empty{}, nil
+}
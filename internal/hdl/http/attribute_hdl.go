@@ -0,0 +1,222 @@
+package http
+
+import (
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	mid "github.com/JMURv/par-pro/products/internal/hdl/http/middleware"
+	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	utils "github.com/JMURv/par-pro/products/pkg/utils/http"
+	"github.com/goccy/go-json"
+	"go.uber.org/zap"
+	"net/http"
+	"strings"
+	"time"
+)
+
+func RegisterAttributeRoutes(mux *http.ServeMux, h *Handler) {
+	mux.HandleFunc(
+		"/api/attributes", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				h.listAttributes(w, r)
+			case http.MethodPost:
+				mid.ApplyMiddleware(h.createAttribute, h.requirePermission(ctrl.PermCatalogManage), h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	mux.HandleFunc(
+		"/api/attributes/", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				h.getAttribute(w, r)
+			case http.MethodPut:
+				mid.ApplyMiddleware(h.updateAttribute, h.requirePermission(ctrl.PermCatalogManage), h.authMiddleware)(w, r)
+			case http.MethodDelete:
+				mid.ApplyMiddleware(h.deleteAttribute, h.requirePermission(ctrl.PermCatalogManage), h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	mux.HandleFunc(
+		"/api/category/attributes/", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				h.listCategoryAttributes(w, r)
+			case http.MethodPut:
+				mid.ApplyMiddleware(h.setCategoryAttributes, h.requirePermission(ctrl.PermCatalogManage), h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+}
+
+func (h *Handler) listAttributes(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "attributes.listAttributes.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	res, err := h.ctrl.ListAttributes(r.Context())
+	if err != nil {
+		zap.L().Debug("failed to list attributes", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) getAttribute(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "attributes.getAttribute.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	res, err := h.ctrl.GetAttribute(r.Context(), strings.TrimPrefix(r.URL.Path, "/api/attributes/"))
+	if err != nil {
+		zap.L().Debug("failed to get attribute", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) createAttribute(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusCreated
+	const op = "attributes.createAttribute.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	req := &model.Attribute{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	if err := validation.AttributeValidation(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	res, err := h.ctrl.CreateAttribute(r.Context(), req)
+	if err != nil {
+		zap.L().Debug("failed to create attribute", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) updateAttribute(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "attributes.updateAttribute.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	req := &model.Attribute{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	if err := validation.AttributeValidation(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err := h.ctrl.UpdateAttribute(r.Context(), strings.TrimPrefix(r.URL.Path, "/api/attributes/"), req)
+	if err != nil {
+		zap.L().Debug("failed to update attribute", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
+
+func (h *Handler) deleteAttribute(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusNoContent
+	const op = "attributes.deleteAttribute.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	err := h.ctrl.DeleteAttribute(r.Context(), strings.TrimPrefix(r.URL.Path, "/api/attributes/"))
+	if err != nil {
+		zap.L().Debug("failed to delete attribute", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
+
+func (h *Handler) listCategoryAttributes(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "attributes.listCategoryAttributes.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	res, err := h.ctrl.ListCategoryAttributes(r.Context(), strings.TrimPrefix(r.URL.Path, "/api/category/attributes/"))
+	if err != nil {
+		zap.L().Debug("failed to list category attributes", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) setCategoryAttributes(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "attributes.setCategoryAttributes.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	req := make([]*model.CategoryAttribute, 0, 20)
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	if err := validation.CategoryAttributesValidation(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err := h.ctrl.SetCategoryAttributes(r.Context(), strings.TrimPrefix(r.URL.Path, "/api/category/attributes/"), req)
+	if err != nil {
+		zap.L().Debug("failed to set category attributes", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
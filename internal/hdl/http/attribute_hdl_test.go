@@ -0,0 +1,363 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	utils "github.com/JMURv/par-pro/products/pkg/utils/http"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler_CreateAttribute(t *testing.T) {
+	const uri = "/api/attributes"
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	h := New(mctrl, nil)
+
+	tests := []struct {
+		name         string
+		body         any
+		resType      any
+		status       int
+		mockExpect   func()
+		expectedResp func(*testing.T, any)
+	}{
+		{
+			name:       "InvalidType",
+			body:       &model.Attribute{Name: "Weight", Type: "float"},
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrInvalidAttributeType.Error(), errResp.Error)
+			},
+		},
+		{
+			name:       "EnumWithoutValues",
+			body:       &model.Attribute{Name: "Color", Type: model.AttributeTypeEnum},
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrMissingAllowedValues.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "AlreadyExists",
+			body:    &model.Attribute{Name: "Weight", Type: model.AttributeTypeNumber, Unit: "g"},
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusConflict,
+			mockExpect: func() {
+				mctrl.EXPECT().CreateAttribute(gomock.Any(), gomock.Any()).Return("", ctrl.ErrAlreadyExists).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrAlreadyExists.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "Success",
+			body:    &model.Attribute{Name: "Weight", Type: model.AttributeTypeNumber, Unit: "g"},
+			resType: &utils.Response{},
+			status:  http.StatusCreated,
+			mockExpect: func() {
+				mctrl.EXPECT().CreateAttribute(gomock.Any(), gomock.Any()).Return("weight", nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				resp, ok := res.(*utils.Response)
+				require.True(t, ok)
+				assert.Equal(t, "weight", resp.Data)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+
+				bodyBytes, err := json.Marshal(tt.body)
+				require.NoError(t, err)
+
+				req := httptest.NewRequest(http.MethodPost, uri, bytes.NewReader(bodyBytes))
+				req.Header.Set("Content-Type", "application/json")
+
+				w := httptest.NewRecorder()
+				h.createAttribute(w, req)
+
+				res := tt.resType
+				err = json.NewDecoder(w.Result().Body).Decode(res)
+				assert.Nil(t, err)
+
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+				tt.expectedResp(t, res)
+			},
+		)
+	}
+}
+
+func TestHandler_CreateItemWithAttributeSchema(t *testing.T) {
+	const uri = "/api/item"
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	h := New(mctrl, nil)
+
+	schema := []*model.CategoryAttribute{
+		{
+			CategorySlug: "scales",
+			AttributeID:  1,
+			IsRequired:   true,
+			Attribute:    &model.Attribute{ID: 1, Slug: "ves", Name: "Вес", Type: model.AttributeTypeNumber, Unit: "g"},
+		},
+		{
+			CategorySlug: "scales",
+			AttributeID:  2,
+			Attribute: &model.Attribute{
+				ID: 2, Slug: "color", Name: "Color", Type: model.AttributeTypeEnum, AllowedValues: []string{"Black", "White"},
+			},
+		},
+	}
+
+	item := func(attrs ...model.ItemAttribute) *model.Item {
+		i := *validItm
+		i.Categories = []model.Category{{Slug: "scales"}}
+		i.Attributes = attrs
+		return &i
+	}
+
+	tests := []struct {
+		name         string
+		body         *model.Item
+		resType      any
+		status       int
+		mockExpect   func()
+		expectedResp func(*testing.T, any)
+	}{
+		{
+			name:       "MissingRequired",
+			body:       item(model.ItemAttribute{Name: "color", Value: "black"}),
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrMissingRequiredAttribute.Error()+": Вес", errResp.Error)
+			},
+		},
+		{
+			name:       "NotANumber",
+			body:       item(model.ItemAttribute{Name: "вес", Value: "heavy"}),
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrInvalidAttributeValue.Error()+": Вес", errResp.Error)
+			},
+		},
+		{
+			name:       "NotAllowed",
+			body:       item(model.ItemAttribute{Name: "ves", Value: "10"}, model.ItemAttribute{Name: "Color", Value: "Red"}),
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrInvalidAttributeValue.Error()+": Color", errResp.Error)
+			},
+		},
+		{
+			name: "Canonicalized",
+			body: item(
+				model.ItemAttribute{Name: "ВЕС", Value: "1,50"},
+				model.ItemAttribute{Name: "color", Value: "white"},
+				model.ItemAttribute{Name: "Material", Value: "Steel"},
+			),
+			resType: &utils.Response{},
+			status:  http.StatusCreated,
+			mockExpect: func() {
+				mctrl.EXPECT().CreateItem(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, i *model.Item) (uuid.UUID, error) {
+						assert.Equal(
+							t, []model.ItemAttribute{
+								{Name: "Вес", Value: "1.5", AttributeID: 1},
+								{Name: "Color", Value: "White", AttributeID: 2},
+								{Name: "Material", Value: "Steel"},
+							}, i.Attributes,
+						)
+						return validUUID, nil
+					},
+				).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				resp, ok := res.(*utils.Response)
+				require.True(t, ok)
+				assert.Equal(t, validUUID.String(), resp.Data)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				mctrl.EXPECT().GetAttributeSchema(gomock.Any(), []string{"scales"}).Return(schema, nil).Times(1)
+				tt.mockExpect()
+
+				bodyBytes, err := json.Marshal(tt.body)
+				require.NoError(t, err)
+
+				req := httptest.NewRequest(http.MethodPost, uri, bytes.NewReader(bodyBytes))
+				req.Header.Set("Content-Type", "application/json")
+
+				w := httptest.NewRecorder()
+				h.CreateItem(w, req)
+
+				res := tt.resType
+				err = json.NewDecoder(w.Result().Body).Decode(res)
+				assert.Nil(t, err)
+
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+				tt.expectedResp(t, res)
+			},
+		)
+	}
+}
+
+func TestHandler_PatchItemWithAttributeSchema(t *testing.T) {
+	const uri = "/api/item/"
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	h := New(mctrl, nil)
+	uid := uuid.New()
+
+	schema := []*model.CategoryAttribute{
+		{
+			CategorySlug: "scales",
+			AttributeID:  1,
+			IsRequired:   true,
+			Attribute:    &model.Attribute{ID: 1, Slug: "ves", Name: "Вес", Type: model.AttributeTypeNumber, Unit: "g"},
+		},
+	}
+	stored := &model.Item{
+		Categories: []model.Category{{Slug: "scales"}},
+		Attributes: []model.ItemAttribute{{Name: "Material", Value: "Steel"}},
+	}
+
+	tests := []struct {
+		name         string
+		body         string
+		resType      any
+		status       int
+		mockExpect   func()
+		expectedResp func(*testing.T, any)
+	}{
+		{
+			name:    "AttributesOfStoredCategories",
+			body:    `{"attributes":[{"name":"вес","value":"heavy"}]}`,
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusBadRequest,
+			mockExpect: func() {
+				mctrl.EXPECT().GetItemByUUID(gomock.Any(), uid).Return(stored, nil).Times(1)
+				mctrl.EXPECT().GetAttributeSchema(gomock.Any(), []string{"scales"}).Return(schema, nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrInvalidAttributeValue.Error()+": Вес", errResp.Error)
+			},
+		},
+		{
+			name:    "CategoriesWithoutRequired",
+			body:    `{"categories":[{"slug":"scales"}]}`,
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusBadRequest,
+			mockExpect: func() {
+				mctrl.EXPECT().GetItemByUUID(gomock.Any(), uid).Return(stored, nil).Times(1)
+				mctrl.EXPECT().GetAttributeSchema(gomock.Any(), []string{"scales"}).Return(schema, nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrMissingRequiredAttribute.Error()+": Вес", errResp.Error)
+			},
+		},
+		{
+			name:    "CategoriesWithAttributes",
+			body:    `{"categories":[{"slug":"scales"}],"attributes":[{"name":"ves","value":"1,5"}]}`,
+			resType: &utils.Response{},
+			status:  http.StatusOK,
+			mockExpect: func() {
+				mctrl.EXPECT().GetAttributeSchema(gomock.Any(), []string{"scales"}).Return(schema, nil).Times(1)
+				mctrl.EXPECT().PatchItem(gomock.Any(), uid, gomock.Any(), []string{"attributes", "categories"}).DoAndReturn(
+					func(_ context.Context, _ uuid.UUID, i *model.Item, _ []string) error {
+						assert.Equal(t, []model.ItemAttribute{{Name: "Вес", Value: "1.5", AttributeID: 1}}, i.Attributes)
+						return nil
+					},
+				).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				_, ok := res.(*utils.Response)
+				require.True(t, ok)
+			},
+		},
+		{
+			name:    "StoredItemNotFound",
+			body:    `{"attributes":[{"name":"ves","value":"10"}]}`,
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusNotFound,
+			mockExpect: func() {
+				mctrl.EXPECT().GetItemByUUID(gomock.Any(), uid).Return(nil, ctrl.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrNotFound.Error(), errResp.Error)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+
+				req := httptest.NewRequest(http.MethodPatch, uri+uid.String(), bytes.NewReader([]byte(tt.body)))
+				req.Header.Set("Content-Type", "application/merge-patch+json")
+
+				w := httptest.NewRecorder()
+				h.PatchItem(w, req)
+
+				res := tt.resType
+				err := json.NewDecoder(w.Result().Body).Decode(res)
+				assert.Nil(t, err)
+
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+				tt.expectedResp(t, res)
+			},
+		)
+	}
+}
@@ -0,0 +1,86 @@
+package http
+
+import (
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	mid "github.com/JMURv/par-pro/products/internal/hdl/http/middleware"
+	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	utils "github.com/JMURv/par-pro/products/pkg/utils/http"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+func RegisterAuditRoutes(mux *http.ServeMux, h *Handler) {
+	mux.HandleFunc(
+		"/api/audit", mid.ApplyMiddleware(
+			h.listAuditLog, mid.MethodNotAllowed(http.MethodGet), h.requirePermission(ctrl.PermAuditRead), h.authMiddleware,
+		),
+	)
+}
+
+func (h *Handler) listAuditLog(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "audit.listAuditLog.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	q := r.URL.Query()
+	page, err := strconv.Atoi(q.Get("page"))
+	if err != nil || page < 1 {
+		page = consts.DefaultPage
+	}
+
+	size, err := strconv.Atoi(q.Get("size"))
+	if err != nil || size < 1 {
+		size = consts.DefaultPageSize
+	}
+
+	f := &model.AuditFilter{
+		Entity:   q.Get("entity"),
+		EntityID: q.Get("entity_id"),
+		ActorID:  q.Get("actor"),
+	}
+	if f.From, err = parseAuditTime(q.Get("from")); err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+	if f.To, err = parseAuditTime(q.Get("to")); err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	if err = validation.AuditFilterValidation(f); err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	res, err := h.ctrl.ListAuditLog(r.Context(), f, page, size)
+	if err != nil {
+		zap.L().Debug("failed to list audit log", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+// parseAuditTime returns the zero time for an empty value, which leaves that side of the range open.
+func parseAuditTime(v string) (time.Time, error) {
+	if v == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return time.Time{}, validation.ErrInvalidAuditTime
+	}
+	return t, nil
+}
@@ -0,0 +1,144 @@
+package http
+
+import (
+	"errors"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	utils "github.com/JMURv/par-pro/products/pkg/utils/http"
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHandler_ListAuditLog(t *testing.T) {
+	const uri = "/api/audit"
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	h := New(mctrl, nil)
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	tests := []struct {
+		name         string
+		query        string
+		resType      any
+		status       int
+		mockExpect   func()
+		expectedResp func(*testing.T, any)
+	}{
+		{
+			name:       "InvalidTime",
+			query:      "?from=yesterday",
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrInvalidAuditTime.Error(), errResp.Error)
+			},
+		},
+		{
+			name:       "InvalidRange",
+			query:      "?from=" + to.Format(time.RFC3339) + "&to=" + from.Format(time.RFC3339),
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrInvalidAuditRange.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "InternalError",
+			query:   "",
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusInternalServerError,
+			mockExpect: func() {
+				mctrl.EXPECT().ListAuditLog(gomock.Any(), &model.AuditFilter{}, consts.DefaultPage, consts.DefaultPageSize).
+					Return(nil, errors.New("db error")).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrInternalError.Error(), errResp.Error)
+			},
+		},
+		{
+			name: "Success",
+			query: "?entity=item&entity_id=abc&actor=user-1&from=" + from.Format(time.RFC3339) +
+				"&to=" + to.Format(time.RFC3339) + "&page=2&size=5",
+			resType: &utils.Response{},
+			status:  http.StatusOK,
+			mockExpect: func() {
+				f := &model.AuditFilter{
+					Entity:   model.AuditItem,
+					EntityID: "abc",
+					ActorID:  "user-1",
+					From:     from,
+					To:       to,
+				}
+				mctrl.EXPECT().ListAuditLog(gomock.Any(), f, 2, 5).Return(&model.PaginatedAuditData{}, nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				_, ok := res.(*utils.Response)
+				require.True(t, ok)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+
+				req := httptest.NewRequest(http.MethodGet, uri+tt.query, nil)
+				w := httptest.NewRecorder()
+				h.listAuditLog(w, req)
+
+				res := tt.resType
+				err := json.NewDecoder(w.Result().Body).Decode(res)
+				assert.Nil(t, err)
+
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+				tt.expectedResp(t, res)
+			},
+		)
+	}
+}
+
+func TestRequestIDMiddleware(t *testing.T) {
+	var got string
+	handler := requestIDMiddleware(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				got = ctrl.RequestIDFromContext(r.Context())
+			},
+		),
+	)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/items", nil)
+	req.Header.Set(consts.RequestIDHeader, "req-1")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	assert.Equal(t, "req-1", got)
+	assert.Equal(t, "req-1", w.Header().Get(consts.RequestIDHeader))
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/items", nil))
+	assert.NotEmpty(t, got)
+	assert.NotEqual(t, "req-1", got)
+	assert.Equal(t, got, w.Header().Get(consts.RequestIDHeader))
+}
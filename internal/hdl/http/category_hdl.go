@@ -1,7 +1,6 @@
 package http
 
 import (
-	"errors"
 	"github.com/JMURv/par-pro/products/internal/ctrl"
 	mid "github.com/JMURv/par-pro/products/internal/hdl/http/middleware"
 	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
@@ -29,9 +28,16 @@ func RegisterCategoryRoutes(mux *http.ServeMux, h *Handler) {
 		),
 	)
 	mux.HandleFunc(
-		"/api/category/filters/", mid.ApplyMiddleware(
-			h.listCategoryFilters, mid.MethodNotAllowed(http.MethodGet),
-		),
+		"/api/category/filters/", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				h.listCategoryFilters(w, r)
+			case http.MethodPost:
+				mid.ApplyMiddleware(h.rebuildCategoryFilters, h.requirePermission(ctrl.PermCatalogManage), h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
 	)
 
 	mux.HandleFunc(
@@ -40,7 +46,7 @@ func RegisterCategoryRoutes(mux *http.ServeMux, h *Handler) {
 			case http.MethodGet:
 				h.listCategories(w, r)
 			case http.MethodPost:
-				mid.ApplyMiddleware(h.createCategory, h.authMiddleware)(w, r)
+				mid.ApplyMiddleware(h.createCategory, h.requirePermission(ctrl.PermCatalogManage), h.authMiddleware)(w, r)
 			default:
 				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
 			}
@@ -53,9 +59,11 @@ func RegisterCategoryRoutes(mux *http.ServeMux, h *Handler) {
 			case http.MethodGet:
 				h.getCategory(w, r)
 			case http.MethodPut:
-				mid.ApplyMiddleware(h.updateCategory, h.authMiddleware)(w, r)
+				mid.ApplyMiddleware(h.updateCategory, h.requirePermission(ctrl.PermCatalogManage), h.authMiddleware)(w, r)
+			case http.MethodPatch:
+				mid.ApplyMiddleware(h.patchCategory, h.requirePermission(ctrl.PermCatalogManage), h.authMiddleware)(w, r)
 			case http.MethodDelete:
-				mid.ApplyMiddleware(h.deleteCategory, h.authMiddleware)(w, r)
+				mid.ApplyMiddleware(h.deleteCategory, h.requirePermission(ctrl.PermCatalogManage), h.authMiddleware)(w, r)
 			default:
 				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
 			}
@@ -89,8 +97,7 @@ func (h *Handler) categorySearch(w http.ResponseWriter, r *http.Request) {
 	res, err := h.ctrl.CategorySearch(r.Context(), query, page, size)
 	if err != nil {
 		zap.L().Debug("failed to search categories", zap.String("op", op), zap.String("query", query), zap.Error(err))
-		c = http.StatusInternalServerError
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+		c = errResponse(w, err)
 		return
 	}
 
@@ -116,9 +123,8 @@ func (h *Handler) listCategories(w http.ResponseWriter, r *http.Request) {
 
 	res, err := h.ctrl.ListCategories(r.Context(), page, size)
 	if err != nil {
-		c = http.StatusInternalServerError
 		zap.L().Debug("failed to list categories", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+		c = errResponse(w, err)
 		return
 	}
 
@@ -133,18 +139,13 @@ func (h *Handler) getCategory(w http.ResponseWriter, r *http.Request) {
 	}()
 
 	res, err := h.ctrl.GetCategoryBySlug(r.Context(), strings.TrimPrefix(r.URL.Path, "/api/category/"))
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = http.StatusNotFound
-		zap.L().Debug("failed to found category", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, err)
-		return
-	} else if err != nil {
-		c = http.StatusInternalServerError
+	if err != nil {
 		zap.L().Debug("failed to get category", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+		c = errResponse(w, err)
 		return
 	}
 
+	setETag(w, res.Version)
 	utils.SuccessResponse(w, c, res)
 }
 
@@ -171,15 +172,9 @@ func (h *Handler) createCategory(w http.ResponseWriter, r *http.Request) {
 	}
 
 	res, err := h.ctrl.CreateCategory(r.Context(), req)
-	if err != nil && errors.Is(err, ctrl.ErrAlreadyExists) {
-		c = http.StatusConflict
-		zap.L().Debug("category already exists", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, err)
-		return
-	} else if err != nil {
-		c = http.StatusInternalServerError
+	if err != nil {
 		zap.L().Debug("failed to create category", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+		c = errResponse(w, err)
 		return
 	}
 
@@ -208,19 +203,67 @@ func (h *Handler) updateCategory(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := h.ctrl.UpdateCategory(r.Context(), strings.TrimPrefix(r.URL.Path, "/api/category/"), req)
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = http.StatusNotFound
-		zap.L().Debug("category not found", zap.String("op", op), zap.Error(err))
+	version, err := ifMatch(r, req.Version)
+	if err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to parse If-Match", zap.String("op", op), zap.Error(err))
 		utils.ErrResponse(w, c, err)
 		return
-	} else if err != nil {
-		c = http.StatusInternalServerError
+	}
+	req.Version = version
+
+	err = h.ctrl.UpdateCategory(r.Context(), strings.TrimPrefix(r.URL.Path, "/api/category/"), req)
+	if err != nil {
 		zap.L().Debug("failed to update category", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+		c = errResponse(w, err)
+		return
+	}
+
+	setETag(w, req.Version)
+	utils.SuccessResponse(w, c, "OK")
+}
+
+// patchCategory applies a JSON Merge Patch to the category, filters are left untouched unless the patch names them.
+func (h *Handler) patchCategory(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "category.patchCategory.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	req := &model.Category{}
+	fields, err := decodeMergePatch(r, req)
+	if err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
 		return
 	}
 
+	if err = validation.CategoryPatchValidation(req, fields); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	version, err := ifMatch(r, req.Version)
+	if err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to parse If-Match", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+	req.Version = version
+
+	err = h.ctrl.PatchCategory(r.Context(), strings.TrimPrefix(r.URL.Path, "/api/category/"), req, fields)
+	if err != nil {
+		zap.L().Debug("failed to patch category", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	setETag(w, req.Version)
 	utils.SuccessResponse(w, c, "OK")
 }
 
@@ -232,15 +275,9 @@ func (h *Handler) deleteCategory(w http.ResponseWriter, r *http.Request) {
 	}()
 
 	err := h.ctrl.DeleteCategory(r.Context(), strings.TrimPrefix(r.URL.Path, "/api/category/"))
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = http.StatusNotFound
-		zap.L().Debug("category not found", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, err)
-		return
-	} else if err != nil {
-		c = http.StatusInternalServerError
+	if err != nil {
 		zap.L().Debug("failed to delete category", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+		c = errResponse(w, err)
 		return
 	}
 
@@ -273,8 +310,7 @@ func (h *Handler) categoryFiltersSearch(w http.ResponseWriter, r *http.Request)
 	res, err := h.ctrl.CategoryFiltersSearch(r.Context(), query, page, size)
 	if err != nil {
 		zap.L().Debug("failed to search filters", zap.String("op", op), zap.String("query", query), zap.Error(err))
-		c = http.StatusInternalServerError
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+		c = errResponse(w, err)
 		return
 	}
 
@@ -290,11 +326,27 @@ func (h *Handler) listCategoryFilters(w http.ResponseWriter, r *http.Request) {
 
 	res, err := h.ctrl.ListCategoryFilters(r.Context(), strings.TrimPrefix(r.URL.Path, "/api/category/filters/"))
 	if err != nil {
-		c = http.StatusInternalServerError
 		zap.L().Debug("failed to list category filters", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+		c = errResponse(w, err)
 		return
 	}
 
 	utils.SuccessResponse(w, c, res)
 }
+
+func (h *Handler) rebuildCategoryFilters(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "category.rebuildCategoryFilters.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	err := h.ctrl.RebuildCategoryFilters(r.Context(), strings.TrimPrefix(r.URL.Path, "/api/category/filters/"))
+	if err != nil {
+		zap.L().Debug("failed to rebuild category filters", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
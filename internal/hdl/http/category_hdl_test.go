@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/internal/validation"
 	"github.com/JMURv/par-pro/products/mocks"
 	"github.com/JMURv/par-pro/products/pkg/consts"
 	"github.com/JMURv/par-pro/products/pkg/model"
@@ -15,6 +16,7 @@ import (
 	"go.uber.org/mock/gomock"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -713,8 +715,10 @@ func TestHandler_UpdateCategory(t *testing.T) {
 		method       string
 		url          string
 		body         any
+		ifMatch      string
 		resType      any
 		status       int
+		etag         string
 		mockExpect   func()
 		expectedResp func(*testing.T, any)
 	}{
@@ -804,6 +808,66 @@ func TestHandler_UpdateCategory(t *testing.T) {
 				assert.Equal(t, "OK", resp.Data)
 			},
 		},
+		{
+			name:       "InvalidIfMatch",
+			method:     http.MethodPut,
+			url:        uri + "test-category",
+			body:       validCategory,
+			ifMatch:    `W/"2"`,
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrInvalidIfMatch.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "VersionConflict",
+			method:  http.MethodPut,
+			url:     uri + "test-category",
+			body:    validCategory,
+			ifMatch: `"2"`,
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusPreconditionFailed,
+			mockExpect: func() {
+				mctrl.EXPECT().UpdateCategory(gomock.Any(), "test-category", gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, req *model.Category) error {
+						assert.Equal(t, 2, req.Version)
+						return ctrl.ErrVersionConflict
+					},
+				).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrVersionConflict.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "SuccessWithIfMatch",
+			method:  http.MethodPut,
+			url:     uri + "test-category",
+			body:    validCategory,
+			ifMatch: `"2"`,
+			resType: &utils.Response{},
+			status:  http.StatusOK,
+			etag:    `"3"`,
+			mockExpect: func() {
+				mctrl.EXPECT().UpdateCategory(gomock.Any(), "test-category", gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, req *model.Category) error {
+						req.Version = 3
+						return nil
+					},
+				).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				resp, ok := res.(*utils.Response)
+				require.True(t, ok)
+				assert.Equal(t, "OK", resp.Data)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -822,6 +886,9 @@ func TestHandler_UpdateCategory(t *testing.T) {
 
 				req := httptest.NewRequest(tt.method, tt.url, body)
 				req.Header.Set("Content-Type", "application/json")
+				if tt.ifMatch != "" {
+					req.Header.Set("If-Match", tt.ifMatch)
+				}
 				req = req.WithContext(ctx)
 
 				w := httptest.NewRecorder()
@@ -832,6 +899,154 @@ func TestHandler_UpdateCategory(t *testing.T) {
 				assert.Nil(t, err)
 
 				assert.Equal(t, tt.status, w.Result().StatusCode)
+				assert.Equal(t, tt.etag, w.Result().Header.Get("ETag"))
+				tt.expectedResp(t, res)
+			},
+		)
+	}
+}
+
+func TestHandler_PatchCategory(t *testing.T) {
+	const uri = "/api/category/"
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	h := New(mctrl, nil)
+
+	tests := []struct {
+		name         string
+		body         string
+		ifMatch      string
+		resType      any
+		status       int
+		etag         string
+		mockExpect   func()
+		expectedResp func(*testing.T, any)
+	}{
+		{
+			name:       "InvalidBody",
+			body:       `["title"]`,
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				_, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+			},
+		},
+		{
+			name:       "EmptyPatch",
+			body:       `{"version": 2}`,
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrEmptyPatch.Error(), errResp.Error)
+			},
+		},
+		{
+			name:       "UnknownField",
+			body:       `{"slug": "other"}`,
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Contains(t, errResp.Error, validation.ErrUnknownField.Error())
+			},
+		},
+		{
+			name:       "ClearRequiredField",
+			body:       `{"title": null}`,
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrMissingTitle.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "NotFound",
+			body:    `{"src": "new.png"}`,
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusNotFound,
+			mockExpect: func() {
+				mctrl.EXPECT().PatchCategory(gomock.Any(), "test-category", gomock.Any(), []string{"src"}).
+					Return(ctrl.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrNotFound.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "VersionConflict",
+			body:    `{"src": "new.png"}`,
+			ifMatch: `"2"`,
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusPreconditionFailed,
+			mockExpect: func() {
+				mctrl.EXPECT().PatchCategory(gomock.Any(), "test-category", gomock.Any(), []string{"src"}).
+					Return(ctrl.ErrVersionConflict).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrVersionConflict.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "Success",
+			body:    `{"src": "new.png", "filters": null, "version": 2}`,
+			resType: &utils.Response{},
+			status:  http.StatusOK,
+			etag:    `"3"`,
+			mockExpect: func() {
+				mctrl.EXPECT().PatchCategory(gomock.Any(), "test-category", gomock.Any(), []string{"filters", "src"}).DoAndReturn(
+					func(_ context.Context, _ string, req *model.Category, _ []string) error {
+						assert.Equal(t, "new.png", req.Src)
+						assert.Nil(t, req.Filters)
+						assert.Equal(t, 2, req.Version)
+						req.Version = 3
+						return nil
+					},
+				).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				resp, ok := res.(*utils.Response)
+				require.True(t, ok)
+				assert.Equal(t, "OK", resp.Data)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+
+				req := httptest.NewRequest(http.MethodPatch, uri+"test-category", strings.NewReader(tt.body))
+				req.Header.Set("Content-Type", "application/merge-patch+json")
+				if tt.ifMatch != "" {
+					req.Header.Set("If-Match", tt.ifMatch)
+				}
+
+				w := httptest.NewRecorder()
+				h.patchCategory(w, req)
+
+				res := tt.resType
+				err := json.NewDecoder(w.Result().Body).Decode(res)
+				assert.Nil(t, err)
+
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+				assert.Equal(t, tt.etag, w.Result().Header.Get("ETag"))
 				tt.expectedResp(t, res)
 			},
 		)
@@ -929,3 +1144,86 @@ func TestHandler_DeleteCategory(t *testing.T) {
 		)
 	}
 }
+
+func TestHandler_RebuildCategoryFilters(t *testing.T) {
+	const uri = "/api/category/filters/"
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	ctx := context.Background()
+	mctrl := mocks.NewMockCtrl(mock)
+	h := New(mctrl, nil)
+
+	tests := []struct {
+		name         string
+		url          string
+		resType      any
+		status       int
+		mockExpect   func()
+		expectedResp func(*testing.T, any)
+	}{
+		{
+			name:    "NotFound",
+			url:     uri + "invalid-slug",
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusNotFound,
+			mockExpect: func() {
+				mctrl.EXPECT().RebuildCategoryFilters(gomock.Any(), "invalid-slug").Return(ctrl.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrNotFound.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "InternalError",
+			url:     uri + "test-slug",
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusInternalServerError,
+			mockExpect: func() {
+				mctrl.EXPECT().RebuildCategoryFilters(gomock.Any(), "test-slug").Return(errors.New("internal error")).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrInternalError.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "Success",
+			url:     uri + "test-slug",
+			resType: &utils.Response{},
+			status:  http.StatusOK,
+			mockExpect: func() {
+				mctrl.EXPECT().RebuildCategoryFilters(gomock.Any(), "test-slug").Return(nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				resp, ok := res.(*utils.Response)
+				require.True(t, ok)
+				assert.Equal(t, "OK", resp.Data)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+
+				req := httptest.NewRequest(http.MethodPost, tt.url, nil)
+				req = req.WithContext(ctx)
+
+				w := httptest.NewRecorder()
+				h.rebuildCategoryFilters(w, req)
+
+				res := tt.resType
+				err := json.NewDecoder(w.Result().Body).Decode(res)
+				assert.Nil(t, err)
+
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+				tt.expectedResp(t, res)
+			},
+		)
+	}
+}
@@ -0,0 +1,239 @@
+package http
+
+import (
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	mid "github.com/JMURv/par-pro/products/internal/hdl/http/middleware"
+	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	utils "github.com/JMURv/par-pro/products/pkg/utils/http"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"net/http"
+	"strings"
+	"time"
+)
+
+func RegisterCustomerGroupRoutes(mux *http.ServeMux, h *Handler) {
+	mux.HandleFunc(
+		"/api/customer-groups", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				mid.ApplyMiddleware(h.listCustomerGroups, h.requirePermission(ctrl.PermPricingManage), h.authMiddleware)(w, r)
+			case http.MethodPost:
+				mid.ApplyMiddleware(h.createCustomerGroup, h.requirePermission(ctrl.PermPricingManage), h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	mux.HandleFunc(
+		"/api/customer-groups/", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				mid.ApplyMiddleware(h.getCustomerGroup, h.requirePermission(ctrl.PermPricingManage), h.authMiddleware)(w, r)
+			case http.MethodPut:
+				mid.ApplyMiddleware(h.updateCustomerGroup, h.requirePermission(ctrl.PermPricingManage), h.authMiddleware)(w, r)
+			case http.MethodDelete:
+				mid.ApplyMiddleware(h.deleteCustomerGroup, h.requirePermission(ctrl.PermPricingManage), h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	mux.HandleFunc(
+		"/api/item/quantity-breaks/", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				h.listQuantityBreaks(w, r)
+			case http.MethodPut:
+				mid.ApplyMiddleware(h.setQuantityBreaks, h.requirePermission(ctrl.PermPricingManage), h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+}
+
+func (h *Handler) listCustomerGroups(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "customerGroups.listCustomerGroups.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	res, err := h.ctrl.ListCustomerGroups(r.Context())
+	if err != nil {
+		zap.L().Debug("failed to list customer groups", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) getCustomerGroup(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "customerGroups.getCustomerGroup.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	res, err := h.ctrl.GetCustomerGroup(r.Context(), strings.TrimPrefix(r.URL.Path, "/api/customer-groups/"))
+	if err != nil {
+		zap.L().Debug("failed to get customer group", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) createCustomerGroup(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusCreated
+	const op = "customerGroups.createCustomerGroup.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	req := &model.CustomerGroup{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	if err := validation.CustomerGroupValidation(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	res, err := h.ctrl.CreateCustomerGroup(r.Context(), req)
+	if err != nil {
+		zap.L().Debug("failed to create customer group", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) updateCustomerGroup(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "customerGroups.updateCustomerGroup.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	req := &model.CustomerGroup{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	if err := validation.CustomerGroupValidation(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err := h.ctrl.UpdateCustomerGroup(r.Context(), strings.TrimPrefix(r.URL.Path, "/api/customer-groups/"), req)
+	if err != nil {
+		zap.L().Debug("failed to update customer group", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
+
+func (h *Handler) deleteCustomerGroup(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusNoContent
+	const op = "customerGroups.deleteCustomerGroup.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	err := h.ctrl.DeleteCustomerGroup(r.Context(), strings.TrimPrefix(r.URL.Path, "/api/customer-groups/"))
+	if err != nil {
+		zap.L().Debug("failed to delete customer group", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
+
+func (h *Handler) listQuantityBreaks(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "customerGroups.listQuantityBreaks.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	uid, err := uuid.Parse(strings.TrimPrefix(r.URL.Path, "/api/item/quantity-breaks/"))
+	if err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, ctrl.ErrParseUUID)
+		return
+	}
+
+	res, err := h.ctrl.ListQuantityBreaks(r.Context(), uid)
+	if err != nil {
+		zap.L().Debug("failed to list quantity breaks", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) setQuantityBreaks(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "customerGroups.setQuantityBreaks.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	uid, err := uuid.Parse(strings.TrimPrefix(r.URL.Path, "/api/item/quantity-breaks/"))
+	if err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, ctrl.ErrParseUUID)
+		return
+	}
+
+	req := make([]model.QuantityBreak, 0, 5)
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	if err = validation.QuantityBreaksValidation(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err = h.ctrl.SetQuantityBreaks(r.Context(), uid, req)
+	if err != nil {
+		zap.L().Debug("failed to set quantity breaks", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
@@ -0,0 +1,232 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	utils "github.com/JMURv/par-pro/products/pkg/utils/http"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler_CustomerGroupMiddleware(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	ctx := context.Background()
+	mctrl := mocks.NewMockCtrl(mock)
+	msso := mocks.NewMockSSOSvc(mock)
+	h := New(mctrl, msso)
+
+	g := &model.CustomerGroup{ID: 1, Slug: "wholesale"}
+	next := h.customerGroupMiddleware(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				utils.SuccessResponse(w, http.StatusOK, ctrl.CustomerGroupFromContext(r.Context()))
+			},
+		),
+	)
+
+	tests := []struct {
+		name         string
+		token        string
+		resType      any
+		status       int
+		mockExpect   func()
+		expectedResp func(*testing.T, any)
+	}{
+		{
+			name:       "Anonymous",
+			resType:    &utils.Response{},
+			status:     http.StatusOK,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				resp, ok := res.(*utils.Response)
+				require.True(t, ok)
+				assert.Nil(t, resp.Data)
+			},
+		},
+		{
+			name:    "SSO error",
+			token:   "token",
+			resType: &utils.Response{},
+			status:  http.StatusOK,
+			mockExpect: func() {
+				msso.EXPECT().GetCustomerGroups(gomock.Any(), "token").Return(nil, errors.New("invalid token")).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				resp, ok := res.(*utils.Response)
+				require.True(t, ok)
+				assert.Nil(t, resp.Data)
+			},
+		},
+		{
+			name:    "Internal error",
+			token:   "token",
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusInternalServerError,
+			mockExpect: func() {
+				msso.EXPECT().GetCustomerGroups(gomock.Any(), "token").Return([]string{"wholesale"}, nil).Times(1)
+				mctrl.EXPECT().ResolveCustomerGroup(gomock.Any(), []string{"wholesale"}).Return(nil, errors.New("internal error")).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrInternalError.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "Success",
+			token:   "token",
+			resType: &utils.Response{},
+			status:  http.StatusOK,
+			mockExpect: func() {
+				msso.EXPECT().GetCustomerGroups(gomock.Any(), "token").Return([]string{"wholesale"}, nil).Times(1)
+				mctrl.EXPECT().ResolveCustomerGroup(gomock.Any(), []string{"wholesale"}).Return(g, nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				resp, ok := res.(*utils.Response)
+				require.True(t, ok)
+				assert.NotNil(t, resp.Data)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+
+				req := httptest.NewRequest(http.MethodGet, "/api/items", nil)
+				req = req.WithContext(ctx)
+				if tt.token != "" {
+					req.Header.Set("Authorization", "Bearer "+tt.token)
+				}
+
+				w := httptest.NewRecorder()
+				next.ServeHTTP(w, req)
+
+				res := tt.resType
+				err := json.NewDecoder(w.Result().Body).Decode(res)
+				assert.Nil(t, err)
+
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+				tt.expectedResp(t, res)
+			},
+		)
+	}
+}
+
+func TestHandler_SetQuantityBreaks(t *testing.T) {
+	const uri = "/api/item/quantity-breaks/"
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	ctx := context.Background()
+	mctrl := mocks.NewMockCtrl(mock)
+	h := New(mctrl, nil)
+
+	uid := uuid.New()
+	valid := []model.QuantityBreak{{MinQuantity: 10, Discount: 5}}
+
+	tests := []struct {
+		name         string
+		id           string
+		body         any
+		resType      any
+		status       int
+		mockExpect   func()
+		expectedResp func(*testing.T, any)
+	}{
+		{
+			name:       "Invalid uuid",
+			id:         "invalid",
+			body:       valid,
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrParseUUID.Error(), errResp.Error)
+			},
+		},
+		{
+			name:       "Duplicate break",
+			id:         uid.String(),
+			body:       append(valid, model.QuantityBreak{MinQuantity: 10, Discount: 7}),
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrDuplicateQuantityBreak.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "Not found",
+			id:      uid.String(),
+			body:    valid,
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusNotFound,
+			mockExpect: func() {
+				mctrl.EXPECT().SetQuantityBreaks(gomock.Any(), uid, gomock.Any()).Return(ctrl.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrNotFound.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "Success",
+			id:      uid.String(),
+			body:    valid,
+			resType: &utils.Response{},
+			status:  http.StatusOK,
+			mockExpect: func() {
+				mctrl.EXPECT().SetQuantityBreaks(gomock.Any(), uid, valid).Return(nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				resp, ok := res.(*utils.Response)
+				require.True(t, ok)
+				assert.Equal(t, "OK", resp.Data)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+
+				payload, err := json.Marshal(tt.body)
+				require.NoError(t, err)
+
+				req := httptest.NewRequest(http.MethodPut, uri+tt.id, bytes.NewBuffer(payload))
+				req = req.WithContext(ctx)
+
+				w := httptest.NewRecorder()
+				h.setQuantityBreaks(w, req)
+
+				res := tt.resType
+				err = json.NewDecoder(w.Result().Body).Decode(res)
+				assert.Nil(t, err)
+
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+				tt.expectedResp(t, res)
+			},
+		)
+	}
+}
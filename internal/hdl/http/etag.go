@@ -0,0 +1,35 @@
+package http
+
+import (
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// setETag sends the version of the resource as a strong entity tag, e.g. "3".
+func setETag(w http.ResponseWriter, version int) {
+	if version > 0 {
+		w.Header().Set("ETag", strconv.Quote(strconv.Itoa(version)))
+	}
+}
+
+// ifMatch returns the version the caller expects from the If-Match header.
+// Without the header or with "*" the version sent in the body is kept, zero means any version.
+func ifMatch(r *http.Request, version int) (int, error) {
+	tag := strings.TrimSpace(r.Header.Get("If-Match"))
+	if tag == "" || tag == "*" {
+		return version, nil
+	}
+
+	unquoted, err := strconv.Unquote(tag)
+	if err != nil || !strings.HasPrefix(tag, `"`) {
+		return 0, validation.ErrInvalidIfMatch
+	}
+
+	res, err := strconv.Atoi(unquoted)
+	if err != nil || res <= 0 {
+		return 0, validation.ErrInvalidIfMatch
+	}
+	return res, nil
+}
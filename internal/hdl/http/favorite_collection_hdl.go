@@ -0,0 +1,417 @@
+package http
+
+import (
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	mid "github.com/JMURv/par-pro/products/internal/hdl/http/middleware"
+	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	utils "github.com/JMURv/par-pro/products/pkg/utils/http"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func RegisterFavoriteCollectionRoutes(mux *http.ServeMux, h *Handler) {
+	mux.HandleFunc(
+		"/api/favorite/collections", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				mid.ApplyMiddleware(h.listFavoriteCollections, h.authMiddleware)(w, r)
+			case http.MethodPost:
+				mid.ApplyMiddleware(h.createFavoriteCollection, h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	// /api/favorite/collections/{id}, /api/favorite/collections/{id}/items and /api/favorite/collections/{id}/share
+	mux.HandleFunc(
+		"/api/favorite/collections/", func(w http.ResponseWriter, r *http.Request) {
+			_, sub, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/api/favorite/collections/"), "/")
+			switch {
+			case sub == "" && r.Method == http.MethodGet:
+				mid.ApplyMiddleware(h.getFavoriteCollection, h.authMiddleware)(w, r)
+			case sub == "" && r.Method == http.MethodPut:
+				mid.ApplyMiddleware(h.updateFavoriteCollection, h.authMiddleware)(w, r)
+			case sub == "" && r.Method == http.MethodDelete:
+				mid.ApplyMiddleware(h.deleteFavoriteCollection, h.authMiddleware)(w, r)
+			case sub == "items" && r.Method == http.MethodPut:
+				mid.ApplyMiddleware(h.setFavoriteCollectionItem, h.authMiddleware)(w, r)
+			case sub == "items" && r.Method == http.MethodDelete:
+				mid.ApplyMiddleware(h.removeFavoriteCollectionItem, h.authMiddleware)(w, r)
+			case sub == "share" && r.Method == http.MethodPost:
+				mid.ApplyMiddleware(h.shareFavoriteCollection, h.authMiddleware)(w, r)
+			case sub == "share" && r.Method == http.MethodDelete:
+				mid.ApplyMiddleware(h.unshareFavoriteCollection, h.authMiddleware)(w, r)
+			case sub != "" && sub != "items" && sub != "share":
+				utils.ErrResponse(w, http.StatusNotFound, ctrl.ErrNotFound)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	mux.HandleFunc(
+		"/api/favorite/shared/", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				h.getSharedFavoriteCollection(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+}
+
+// collectionID parses the collection id out of /api/favorite/collections/{id}[/...]
+func collectionID(r *http.Request) (uint64, error) {
+	id, _, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/api/favorite/collections/"), "/")
+	return strconv.ParseUint(id, 10, 64)
+}
+
+func (h *Handler) listFavoriteCollections(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "favorites.listFavoriteCollections.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	uid, err := uuid.Parse(ctrl.UserIDFromContext(r.Context()))
+	if err != nil {
+		c = http.StatusUnauthorized
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	res, err := h.ctrl.ListFavoriteCollections(r.Context(), uid)
+	if err != nil {
+		zap.L().Debug("failed to list favorite collections", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) getFavoriteCollection(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "favorites.getFavoriteCollection.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	uid, err := uuid.Parse(ctrl.UserIDFromContext(r.Context()))
+	if err != nil {
+		c = http.StatusUnauthorized
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	id, err := collectionID(r)
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	res, err := h.ctrl.GetFavoriteCollection(r.Context(), uid, id)
+	if err != nil {
+		zap.L().Debug("failed to get favorite collection", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) getSharedFavoriteCollection(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "favorites.getSharedFavoriteCollection.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	res, err := h.ctrl.GetSharedFavoriteCollection(r.Context(), strings.TrimPrefix(r.URL.Path, "/api/favorite/shared/"))
+	if err != nil {
+		zap.L().Debug("failed to get shared collection", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) createFavoriteCollection(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusCreated
+	const op = "favorites.createFavoriteCollection.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	uid, err := uuid.Parse(ctrl.UserIDFromContext(r.Context()))
+	if err != nil {
+		c = http.StatusUnauthorized
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	req := &model.FavoriteCollection{}
+	if err = json.NewDecoder(r.Body).Decode(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	if err = validation.FavoriteCollectionValidation(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	res, err := h.ctrl.CreateFavoriteCollection(r.Context(), uid, req)
+	if err != nil {
+		zap.L().Debug("failed to create favorite collection", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) updateFavoriteCollection(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "favorites.updateFavoriteCollection.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	uid, err := uuid.Parse(ctrl.UserIDFromContext(r.Context()))
+	if err != nil {
+		c = http.StatusUnauthorized
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	id, err := collectionID(r)
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	req := &model.FavoriteCollection{}
+	if err = json.NewDecoder(r.Body).Decode(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	if err = validation.FavoriteCollectionValidation(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err = h.ctrl.UpdateFavoriteCollection(r.Context(), uid, id, req)
+	if err != nil {
+		zap.L().Debug("failed to update favorite collection", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
+
+func (h *Handler) deleteFavoriteCollection(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusNoContent
+	const op = "favorites.deleteFavoriteCollection.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	uid, err := uuid.Parse(ctrl.UserIDFromContext(r.Context()))
+	if err != nil {
+		c = http.StatusUnauthorized
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	id, err := collectionID(r)
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err = h.ctrl.DeleteFavoriteCollection(r.Context(), uid, id)
+	if err != nil {
+		zap.L().Debug("failed to delete favorite collection", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
+
+func (h *Handler) shareFavoriteCollection(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "favorites.shareFavoriteCollection.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	uid, err := uuid.Parse(ctrl.UserIDFromContext(r.Context()))
+	if err != nil {
+		c = http.StatusUnauthorized
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	id, err := collectionID(r)
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	res, err := h.ctrl.ShareFavoriteCollection(r.Context(), uid, id)
+	if err != nil {
+		zap.L().Debug("failed to share favorite collection", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) unshareFavoriteCollection(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusNoContent
+	const op = "favorites.unshareFavoriteCollection.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	uid, err := uuid.Parse(ctrl.UserIDFromContext(r.Context()))
+	if err != nil {
+		c = http.StatusUnauthorized
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	id, err := collectionID(r)
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err = h.ctrl.UnshareFavoriteCollection(r.Context(), uid, id)
+	if err != nil {
+		zap.L().Debug("failed to unshare favorite collection", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
+
+func (h *Handler) setFavoriteCollectionItem(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "favorites.setFavoriteCollectionItem.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	uid, err := uuid.Parse(ctrl.UserIDFromContext(r.Context()))
+	if err != nil {
+		c = http.StatusUnauthorized
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	id, err := collectionID(r)
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	req := &model.FavoriteCollectionItem{}
+	if err = json.NewDecoder(r.Body).Decode(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	if err = validation.FavoriteCollectionItemValidation(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err = h.ctrl.SetFavoriteCollectionItem(r.Context(), uid, id, req)
+	if err != nil {
+		zap.L().Debug("failed to set favorite collection item", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
+
+func (h *Handler) removeFavoriteCollectionItem(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusNoContent
+	const op = "favorites.removeFavoriteCollectionItem.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	uid, err := uuid.Parse(ctrl.UserIDFromContext(r.Context()))
+	if err != nil {
+		c = http.StatusUnauthorized
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	id, err := collectionID(r)
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	req := &model.FavoriteCollectionItem{}
+	if err = json.NewDecoder(r.Body).Decode(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	if req.ItemID == uuid.Nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, validation.ErrMissingUUID)
+		return
+	}
+
+	err = h.ctrl.RemoveFavoriteCollectionItem(r.Context(), uid, id, req.ItemID)
+	if err != nil {
+		zap.L().Debug("failed to remove favorite collection item", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
@@ -0,0 +1,253 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	utils "github.com/JMURv/par-pro/products/pkg/utils/http"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler_CreateFavoriteCollection(t *testing.T) {
+	const uri = "/api/favorite/collections"
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	uid := uuid.New()
+	invalidCtx := ctrl.WithUserID(context.Background(), uid.String()+"1")
+	validCtx := ctrl.WithUserID(context.Background(), uid.String())
+
+	mctrl := mocks.NewMockCtrl(mock)
+	h := New(mctrl, nil)
+
+	tests := []struct {
+		ctx          context.Context
+		name         string
+		body         any
+		resType      any
+		status       int
+		mockExpect   func()
+		expectedResp func(*testing.T, any)
+	}{
+		{
+			ctx:        invalidCtx,
+			name:       "InvalidUID",
+			body:       &model.FavoriteCollection{Name: "Birthday"},
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusUnauthorized,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Contains(t, errResp.Error, "invalid UUID")
+			},
+		},
+		{
+			ctx:        validCtx,
+			name:       "MissingName",
+			body:       &model.FavoriteCollection{},
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrMissingName.Error(), errResp.Error)
+			},
+		},
+		{
+			ctx:     validCtx,
+			name:    "AlreadyExists",
+			body:    &model.FavoriteCollection{Name: "Birthday"},
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusConflict,
+			mockExpect: func() {
+				mctrl.EXPECT().CreateFavoriteCollection(gomock.Any(), uid, gomock.Any()).
+					Return(uint64(0), ctrl.ErrAlreadyExists).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrAlreadyExists.Error(), errResp.Error)
+			},
+		},
+		{
+			ctx:     validCtx,
+			name:    "InternalError",
+			body:    &model.FavoriteCollection{Name: "Birthday"},
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusInternalServerError,
+			mockExpect: func() {
+				mctrl.EXPECT().CreateFavoriteCollection(gomock.Any(), uid, gomock.Any()).
+					Return(uint64(0), errors.New("internal error")).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrInternalError.Error(), errResp.Error)
+			},
+		},
+		{
+			ctx:     validCtx,
+			name:    "Success",
+			body:    &model.FavoriteCollection{Name: "Birthday"},
+			resType: &utils.Response{},
+			status:  http.StatusCreated,
+			mockExpect: func() {
+				mctrl.EXPECT().CreateFavoriteCollection(gomock.Any(), uid, gomock.Any()).
+					Return(uint64(1), nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				resp, ok := res.(*utils.Response)
+				require.True(t, ok)
+				assert.Equal(t, float64(1), resp.Data)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+
+				bodyBytes, err := json.Marshal(tt.body)
+				require.NoError(t, err)
+
+				req := httptest.NewRequest(http.MethodPost, uri, bytes.NewReader(bodyBytes))
+				req.Header.Set("Content-Type", "application/json")
+				req = req.WithContext(tt.ctx)
+
+				w := httptest.NewRecorder()
+				h.createFavoriteCollection(w, req)
+
+				res := tt.resType
+				err = json.NewDecoder(w.Result().Body).Decode(res)
+				assert.Nil(t, err)
+
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+				tt.expectedResp(t, res)
+			},
+		)
+	}
+}
+
+func TestHandler_SetFavoriteCollectionItem(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	uid, itemID := uuid.New(), uuid.New()
+	ctx := ctrl.WithUserID(context.Background(), uid.String())
+
+	mctrl := mocks.NewMockCtrl(mock)
+	h := New(mctrl, nil)
+
+	tests := []struct {
+		name         string
+		url          string
+		body         any
+		resType      any
+		status       int
+		mockExpect   func()
+		expectedResp func(*testing.T, any)
+	}{
+		{
+			name:       "InvalidID",
+			url:        "/api/favorite/collections/abc/items",
+			body:       &model.FavoriteCollectionItem{ItemID: itemID},
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Contains(t, errResp.Error, "invalid syntax")
+			},
+		},
+		{
+			name:       "MissingItem",
+			url:        "/api/favorite/collections/1/items",
+			body:       &model.FavoriteCollectionItem{Note: "size M"},
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrMissingUUID.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "NotFound",
+			url:     "/api/favorite/collections/1/items",
+			body:    &model.FavoriteCollectionItem{ItemID: itemID},
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusNotFound,
+			mockExpect: func() {
+				mctrl.EXPECT().SetFavoriteCollectionItem(gomock.Any(), uid, uint64(1), gomock.Any()).
+					Return(ctrl.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrNotFound.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "Success",
+			url:     "/api/favorite/collections/1/items",
+			body:    &model.FavoriteCollectionItem{ItemID: itemID, Note: "size M"},
+			resType: &utils.Response{},
+			status:  http.StatusOK,
+			mockExpect: func() {
+				mctrl.EXPECT().SetFavoriteCollectionItem(gomock.Any(), uid, uint64(1), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ uuid.UUID, _ uint64, req *model.FavoriteCollectionItem) error {
+						assert.Equal(t, itemID, req.ItemID)
+						assert.Equal(t, "size M", req.Note)
+						return nil
+					},
+				).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				resp, ok := res.(*utils.Response)
+				require.True(t, ok)
+				assert.Equal(t, "OK", resp.Data)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+
+				bodyBytes, err := json.Marshal(tt.body)
+				require.NoError(t, err)
+
+				req := httptest.NewRequest(http.MethodPut, tt.url, bytes.NewReader(bodyBytes))
+				req.Header.Set("Content-Type", "application/json")
+				req = req.WithContext(ctx)
+
+				w := httptest.NewRecorder()
+				h.setFavoriteCollectionItem(w, req)
+
+				res := tt.resType
+				err = json.NewDecoder(w.Result().Body).Decode(res)
+				assert.Nil(t, err)
+
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+				tt.expectedResp(t, res)
+			},
+		)
+	}
+}
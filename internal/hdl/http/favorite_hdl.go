@@ -1,17 +1,18 @@
 package http
 
 import (
-	"errors"
 	"github.com/JMURv/par-pro/products/internal/ctrl"
 	mid "github.com/JMURv/par-pro/products/internal/hdl/http/middleware"
 	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
 	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/pkg/consts"
 	"github.com/JMURv/par-pro/products/pkg/model"
 	utils "github.com/JMURv/par-pro/products/pkg/utils/http"
 	"github.com/goccy/go-json"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"net/http"
+	"strconv"
 	"time"
 )
 
@@ -30,6 +31,17 @@ func RegisterFavoriteRoutes(mux *http.ServeMux, h *Handler) {
 			}
 		},
 	)
+
+	mux.HandleFunc(
+		"/api/favorite/notifications", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodPut:
+				mid.ApplyMiddleware(h.setFavoriteNotifications, h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
 }
 
 func (h *Handler) listFavorites(w http.ResponseWriter, r *http.Request) {
@@ -39,27 +51,39 @@ func (h *Handler) listFavorites(w http.ResponseWriter, r *http.Request) {
 		metrics.ObserveRequest(time.Since(s), c, op)
 	}()
 
-	uid, err := uuid.Parse(r.Context().Value("uid").(string))
+	uid, err := uuid.Parse(ctrl.UserIDFromContext(r.Context()))
 	if err != nil {
 		c = http.StatusUnauthorized
 		utils.ErrResponse(w, c, err)
 		return
 	}
 
-	res, err := h.ctrl.ListFavorites(r.Context(), uid)
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = http.StatusNotFound
-		zap.L().Debug("failed to find favorites", zap.String("op", op), zap.Error(err))
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil {
+		page = consts.DefaultPage
+	}
+
+	size, err := strconv.Atoi(r.URL.Query().Get("size"))
+	if err != nil {
+		size = consts.DefaultPageSize
+	}
+
+	sort := r.URL.Query().Get("sort")
+	if err = validation.FavoriteSortValidation(sort); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
 		utils.ErrResponse(w, c, err)
 		return
-	} else if err != nil {
-		c = http.StatusInternalServerError
+	}
+
+	res, err := h.ctrl.ListFavorites(r.Context(), uid, page, size, sort)
+	if err != nil {
 		zap.L().Debug("failed to list favorites", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+		c = errResponse(w, err)
 		return
 	}
 
-	utils.SuccessResponse(w, c, res)
+	utils.SuccessPaginatedResponse(w, c, res)
 }
 
 func (h *Handler) addToFavorites(w http.ResponseWriter, r *http.Request) {
@@ -69,7 +93,7 @@ func (h *Handler) addToFavorites(w http.ResponseWriter, r *http.Request) {
 		metrics.ObserveRequest(time.Since(s), c, op)
 	}()
 
-	uid, err := uuid.Parse(r.Context().Value("uid").(string))
+	uid, err := uuid.Parse(ctrl.UserIDFromContext(r.Context()))
 	if err != nil {
 		c = http.StatusUnauthorized
 		utils.ErrResponse(w, c, err)
@@ -91,20 +115,9 @@ func (h *Handler) addToFavorites(w http.ResponseWriter, r *http.Request) {
 	}
 
 	res, err := h.ctrl.AddToFavorites(r.Context(), uid, req.ItemID)
-	if err != nil && errors.Is(err, ctrl.ErrAlreadyExists) {
-		c = http.StatusConflict
-		zap.L().Debug("failed to add to favorites", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, err)
-		return
-	} else if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = http.StatusNotFound
-		zap.L().Debug("failed to find item", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, err)
-		return
-	} else if err != nil {
-		c = http.StatusInternalServerError
+	if err != nil {
 		zap.L().Debug("failed to add to favorites", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+		c = errResponse(w, err)
 		return
 	}
 
@@ -118,7 +131,7 @@ func (h *Handler) removeFromFavorites(w http.ResponseWriter, r *http.Request) {
 		metrics.ObserveRequest(time.Since(s), c, op)
 	}()
 
-	uid, err := uuid.Parse(r.Context().Value("uid").(string))
+	uid, err := uuid.Parse(ctrl.UserIDFromContext(r.Context()))
 	if err != nil {
 		c = http.StatusUnauthorized
 		utils.ErrResponse(w, c, err)
@@ -140,15 +153,47 @@ func (h *Handler) removeFromFavorites(w http.ResponseWriter, r *http.Request) {
 	}
 
 	err = h.ctrl.RemoveFromFavorites(r.Context(), uid, req.ItemID)
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = http.StatusNotFound
-		zap.L().Debug("Failed to find favorite", zap.String("op", op), zap.Error(err))
+	if err != nil {
+		zap.L().Debug("failed to remove from favorites", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
+
+func (h *Handler) setFavoriteNotifications(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "favorites.setFavoriteNotifications.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	uid, err := uuid.Parse(ctrl.UserIDFromContext(r.Context()))
+	if err != nil {
+		c = http.StatusUnauthorized
 		utils.ErrResponse(w, c, err)
 		return
-	} else if err != nil {
-		c = http.StatusInternalServerError
-		zap.L().Debug("failed to remove from favorites", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+	}
+
+	req := &model.Favorite{}
+	if err = json.NewDecoder(r.Body).Decode(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	if req.ItemID == uuid.Nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, validation.ErrMissingUUID)
+		return
+	}
+
+	err = h.ctrl.SetFavoriteNotifications(r.Context(), uid, req)
+	if err != nil {
+		zap.L().Debug("failed to set favorite notifications", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
 		return
 	}
 
@@ -25,8 +25,8 @@ func TestHandler_ListFavorites(t *testing.T) {
 	defer mock.Finish()
 
 	uid := uuid.New()
-	invalidCtx := context.WithValue(context.Background(), "uid", uid.String()+"1")
-	validCtx := context.WithValue(context.Background(), "uid", uid.String())
+	invalidCtx := ctrl.WithUserID(context.Background(), uid.String()+"1")
+	validCtx := ctrl.WithUserID(context.Background(), uid.String())
 
 	mctrl := mocks.NewMockCtrl(mock)
 	h := New(mctrl, nil)
@@ -58,23 +58,18 @@ func TestHandler_ListFavorites(t *testing.T) {
 			},
 		},
 		{
-			ctx:     validCtx,
-			name:    "NotFound",
-			method:  http.MethodGet,
-			url:     uri,
-			body:    nil,
-			resType: &utils.ErrorResponse{},
-			status:  http.StatusNotFound,
-			mockExpect: func() {
-				mctrl.EXPECT().ListFavorites(
-					gomock.Any(),
-					uid,
-				).Return(nil, ctrl.ErrNotFound).Times(1)
-			},
+			ctx:        validCtx,
+			name:       "InvalidSort",
+			method:     http.MethodGet,
+			url:        uri + "?sort=title",
+			body:       nil,
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
 			expectedResp: func(t *testing.T, res any) {
 				errResp, ok := res.(*utils.ErrorResponse)
 				require.True(t, ok)
-				assert.Equal(t, ctrl.ErrNotFound.Error(), errResp.Error)
+				assert.Equal(t, validation.ErrInvalidFavoriteSort.Error(), errResp.Error)
 			},
 		},
 		{
@@ -89,6 +84,9 @@ func TestHandler_ListFavorites(t *testing.T) {
 				mctrl.EXPECT().ListFavorites(
 					gomock.Any(),
 					uid,
+					1,
+					40,
+					"",
 				).Return(nil, errors.New("internal error")).Times(1)
 			},
 			expectedResp: func(t *testing.T, res any) {
@@ -101,20 +99,24 @@ func TestHandler_ListFavorites(t *testing.T) {
 			ctx:     validCtx,
 			name:    "Success",
 			method:  http.MethodGet,
-			url:     uri,
+			url:     uri + "?page=2&size=10&sort=-price",
 			body:    nil,
-			resType: &utils.Response{},
+			resType: &model.PaginatedFavoritesData{},
 			status:  http.StatusOK,
 			mockExpect: func() {
 				mctrl.EXPECT().ListFavorites(
 					gomock.Any(),
 					uid,
-				).Return([]*model.Favorite{}, nil).Times(1)
+					2,
+					10,
+					"-price",
+				).Return(&model.PaginatedFavoritesData{Data: []*model.Favorite{}, Count: 12, CurrentPage: 2}, nil).Times(1)
 			},
 			expectedResp: func(t *testing.T, res any) {
-				resp, ok := res.(*utils.Response)
+				resp, ok := res.(*model.PaginatedFavoritesData)
 				require.True(t, ok)
-				assert.NotNil(t, resp.Data)
+				assert.Equal(t, int64(12), resp.Count)
+				assert.Equal(t, 2, resp.CurrentPage)
 			},
 		},
 	}
@@ -148,8 +150,8 @@ func TestHandler_AddToFavorites(t *testing.T) {
 	defer mock.Finish()
 
 	uid := uuid.New()
-	invalidCtx := context.WithValue(context.Background(), "uid", uid.String()+"1")
-	validCtx := context.WithValue(context.Background(), "uid", uid.String())
+	invalidCtx := ctrl.WithUserID(context.Background(), uid.String()+"1")
+	validCtx := ctrl.WithUserID(context.Background(), uid.String())
 
 	mctrl := mocks.NewMockCtrl(mock)
 	h := New(mctrl, nil)
@@ -334,8 +336,8 @@ func TestHandler_RemoveFromFavorites(t *testing.T) {
 	defer mock.Finish()
 
 	uid := uuid.New()
-	invalidCtx := context.WithValue(context.Background(), "uid", uid.String()+"1")
-	validCtx := context.WithValue(context.Background(), "uid", uid.String())
+	invalidCtx := ctrl.WithUserID(context.Background(), uid.String()+"1")
+	validCtx := ctrl.WithUserID(context.Background(), uid.String())
 
 	mctrl := mocks.NewMockCtrl(mock)
 	h := New(mctrl, nil)
@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
 	"github.com/JMURv/par-pro/products/internal/ctrl/sso"
 	"github.com/JMURv/par-pro/products/internal/hdl"
 	mid "github.com/JMURv/par-pro/products/internal/hdl/http/middleware"
+	"github.com/JMURv/par-pro/products/pkg/consts"
 	utils "github.com/JMURv/par-pro/products/pkg/utils/http"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"net/http"
 	"strings"
@@ -30,10 +33,22 @@ func New(ctrl hdl.Ctrl, sso sso.SSOSvc) *Handler {
 func (h *Handler) Start(port int) {
 	mux := http.NewServeMux()
 	RegisterItemRoutes(mux, h)
+	RegisterItemRevisionRoutes(mux, h)
+	RegisterPriceRoutes(mux, h)
+	RegisterPriceListRoutes(mux, h)
+	RegisterCustomerGroupRoutes(mux, h)
 	RegisterCategoryRoutes(mux, h)
+	RegisterAttributeRoutes(mux, h)
+	RegisterLabelRoutes(mux, h)
+	RegisterReviewRoutes(mux, h)
+	RegisterQuestionRoutes(mux, h)
 	RegisterPromotionRoutes(mux, h)
 	RegisterFavoriteRoutes(mux, h)
+	RegisterFavoriteCollectionRoutes(mux, h)
+	RegisterMediaRoutes(mux, h)
 	RegisterOrderRoutes(mux, h)
+	RegisterTrashRoutes(mux, h)
+	RegisterAuditRoutes(mux, h)
 	mux.HandleFunc(
 		"/health-check", func(w http.ResponseWriter, r *http.Request) {
 			utils.SuccessResponse(w, http.StatusOK, "OK")
@@ -42,6 +57,10 @@ func (h *Handler) Start(port int) {
 
 	handler := mid.RecoverPanic(mux)
 	handler = mid.TracingMiddleware(mux)
+	handler = h.idempotencyMiddleware(handler)
+	handler = h.priceListMiddleware(handler)
+	handler = h.customerGroupMiddleware(handler)
+	handler = requestIDMiddleware(handler)
 	h.srv = &http.Server{
 		Handler:      handler,
 		Addr:         fmt.Sprintf(":%v", port),
@@ -68,23 +87,122 @@ func (h *Handler) authMiddleware(next http.Handler) http.Handler {
 		func(w http.ResponseWriter, r *http.Request) {
 			authHeader := r.Header.Get("Authorization")
 			if authHeader == "" {
-				utils.ErrResponse(w, http.StatusUnauthorized, errors.New("authorization header is missing"))
+				errResponse(w, errMissingAuthHeader)
 				return
 			}
 
 			tokenStr := strings.TrimPrefix(authHeader, "Bearer ")
 			if tokenStr == authHeader {
-				utils.ErrResponse(w, http.StatusUnauthorized, errors.New("invalid token format"))
+				errResponse(w, errInvalidTokenFormat)
 				return
 			}
 
 			token, err := h.sso.ParseClaims(r.Context(), tokenStr)
 			if err != nil {
-				utils.ErrResponse(w, http.StatusUnauthorized, err)
+				zap.L().Debug("failed to parse claims", zap.Error(err))
+				errResponse(w, unauthenticated(err))
 				return
 			}
-			ctx := context.WithValue(r.Context(), "uid", token)
+			ctx := ctrl.WithUserID(r.Context(), token)
+
+			// Without permissions the caller is served as an ordinary customer.
+			perms, err := h.sso.GetPermissions(r.Context(), tokenStr)
+			if err != nil {
+				zap.L().Debug("failed to get permissions", zap.Error(err))
+			}
+			ctx = ctrl.WithPermissions(ctx, ctrl.ResolvePermissions(perms))
 			next.ServeHTTP(w, r.WithContext(ctx))
 		},
 	)
 }
+
+// requirePermission rejects callers without perm, it runs after authMiddleware.
+func (h *Handler) requirePermission(perm ctrl.Permission) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if err := ctrl.Authorize(r.Context(), perm); err != nil {
+					errResponse(w, err)
+					return
+				}
+				next.ServeHTTP(w, r)
+			},
+		)
+	}
+}
+
+// requestIDMiddleware takes the request id from the X-Request-ID header or generates one, and echoes it in the response.
+func requestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			id := r.Header.Get(consts.RequestIDHeader)
+			if id == "" || len(id) > consts.MaxRequestIDLength {
+				id = uuid.NewString()
+			}
+
+			w.Header().Set(consts.RequestIDHeader, id)
+			next.ServeHTTP(w, r.WithContext(ctrl.WithRequestID(r.Context(), id)))
+		},
+	)
+}
+
+// priceListMiddleware resolves the price list the caller selected with the X-Price-List header,
+// falling back to the list of the caller's customer group.
+func (h *Handler) priceListMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			slug := r.Header.Get(consts.PriceListHeader)
+			if slug == "" && ctrl.CustomerGroupFromContext(r.Context()) == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			pl, err := h.ctrl.SelectPriceList(r.Context(), slug)
+			if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+				errResponse(w, ctrl.ErrUnknownPriceList)
+				return
+			} else if err != nil {
+				errResponse(w, err)
+				return
+			} else if pl == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			w.Header().Set(consts.PriceListHeader, pl.Slug)
+			next.ServeHTTP(w, r.WithContext(ctrl.WithPriceList(r.Context(), pl)))
+		},
+	)
+}
+
+// customerGroupMiddleware resolves the customer group of a signed-in caller from the SSO user.
+// Requests with a token SSO does not accept are served as anonymous, protected routes reject them on their own.
+func (h *Handler) customerGroupMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			tokenStr, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || tokenStr == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			slugs, err := h.sso.GetCustomerGroups(r.Context(), tokenStr)
+			if err != nil {
+				zap.L().Debug("failed to get customer groups", zap.Error(err))
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			g, err := h.ctrl.ResolveCustomerGroup(r.Context(), slugs)
+			if err != nil {
+				errResponse(w, err)
+				return
+			} else if g == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(ctrl.WithCustomerGroup(r.Context(), g)))
+		},
+	)
+}
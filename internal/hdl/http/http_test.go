@@ -2,11 +2,16 @@ package http
 
 import (
 	"errors"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
 	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -40,6 +45,16 @@ func TestAuthMiddleware(t *testing.T) {
 			expectedStatus: http.StatusOK,
 			mockGetIDToken: func() {
 				ssoctrl.EXPECT().ParseClaims(gomock.Any(), "valid-token").Return("user-id", nil).Times(1)
+				ssoctrl.EXPECT().GetPermissions(gomock.Any(), "valid-token").Return([]string{"role:manager"}, nil).Times(1)
+			},
+		},
+		{
+			name:           "PermissionsUnavailable",
+			authHeader:     "Bearer valid-token",
+			expectedStatus: http.StatusOK,
+			mockGetIDToken: func() {
+				ssoctrl.EXPECT().ParseClaims(gomock.Any(), "valid-token").Return("user-id", nil).Times(1)
+				ssoctrl.EXPECT().GetPermissions(gomock.Any(), "valid-token").Return(nil, errors.New("unavailable")).Times(1)
 			},
 		},
 		{
@@ -82,3 +97,236 @@ func TestAuthMiddleware(t *testing.T) {
 		)
 	}
 }
+
+func TestRequirePermission(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	ssoctrl := mocks.NewMockSSOSvc(mock)
+	h := New(mctrl, ssoctrl)
+
+	tests := []struct {
+		name           string
+		perms          []string
+		expectedStatus int
+	}{
+		{
+			name:           "Customer",
+			perms:          nil,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Moderator",
+			perms:          []string{"role:moderator"},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Manager",
+			perms:          []string{"role:manager"},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				ssoctrl.EXPECT().ParseClaims(gomock.Any(), "valid-token").Return("user-id", nil).Times(1)
+				ssoctrl.EXPECT().GetPermissions(gomock.Any(), "valid-token").Return(tt.perms, nil).Times(1)
+
+				req, err := http.NewRequest(http.MethodPost, "/", nil)
+				assert.NoError(t, err)
+
+				req.Header.Set("Authorization", "Bearer valid-token")
+				rr := httptest.NewRecorder()
+
+				testHandler := http.HandlerFunc(
+					func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusOK)
+					},
+				)
+
+				handler := h.authMiddleware(h.requirePermission(ctrl.PermCatalogManage)(testHandler))
+				handler.ServeHTTP(rr, req)
+
+				assert.Equal(t, tt.expectedStatus, rr.Code)
+			},
+		)
+	}
+}
+
+func TestIdempotencyMiddleware(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	ssoctrl := mocks.NewMockSSOSvc(mock)
+	h := New(mctrl, ssoctrl)
+
+	body := `{"name":"test"}`
+	key := ctrl.IdempotencyKey(http.MethodPost, "/api/items", "", "abc")
+	userKey := ctrl.IdempotencyKey(http.MethodPost, "/api/items", "user-id", "abc")
+	hash := ctrl.IdempotencyKey(body)
+
+	tests := []struct {
+		name           string
+		method         string
+		key            string
+		token          string
+		status         int
+		mockExpect     func()
+		expectedStatus int
+		expectedBody   string
+		replayed       bool
+	}{
+		{
+			name:           "WithoutKey",
+			method:         http.MethodPost,
+			status:         http.StatusCreated,
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"data":"new"}`,
+		},
+		{
+			name:           "ReadRequest",
+			method:         http.MethodGet,
+			key:            "abc",
+			status:         http.StatusOK,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":"new"}`,
+		},
+		{
+			name:           "KeyTooLong",
+			method:         http.MethodPost,
+			key:            strings.Repeat("a", 256),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "FirstRequest",
+			method: http.MethodPost,
+			key:    "abc",
+			status: http.StatusCreated,
+			mockExpect: func() {
+				mctrl.EXPECT().BeginIdempotent(gomock.Any(), key, hash).Return(nil, nil)
+				mctrl.EXPECT().CompleteIdempotent(gomock.Any(), key, hash, http.StatusCreated, []byte(`{"data":"new"}`)).Return(nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"data":"new"}`,
+		},
+		{
+			name:   "FailedRequest",
+			method: http.MethodPost,
+			key:    "abc",
+			status: http.StatusBadRequest,
+			mockExpect: func() {
+				mctrl.EXPECT().BeginIdempotent(gomock.Any(), key, hash).Return(nil, nil)
+				mctrl.EXPECT().ReleaseIdempotent(gomock.Any(), key).Return(nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"data":"new"}`,
+		},
+		{
+			name:   "Replay",
+			method: http.MethodPost,
+			key:    "abc",
+			mockExpect: func() {
+				mctrl.EXPECT().BeginIdempotent(gomock.Any(), key, hash).Return(
+					&model.IdempotencyRecord{Key: key, RequestHash: hash, Status: http.StatusCreated, Response: []byte(`{"data":"first"}`)},
+					nil,
+				)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"data":"first"}`,
+			replayed:       true,
+		},
+		{
+			name:   "DifferentBody",
+			method: http.MethodPost,
+			key:    "abc",
+			mockExpect: func() {
+				mctrl.EXPECT().BeginIdempotent(gomock.Any(), key, hash).Return(nil, ctrl.ErrIdempotencyMismatch)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "InProgress",
+			method: http.MethodPost,
+			key:    "abc",
+			mockExpect: func() {
+				mctrl.EXPECT().BeginIdempotent(gomock.Any(), key, hash).Return(nil, ctrl.ErrIdempotencyInProgress)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:   "InternalError",
+			method: http.MethodPost,
+			key:    "abc",
+			mockExpect: func() {
+				mctrl.EXPECT().BeginIdempotent(gomock.Any(), key, hash).Return(nil, errors.New("db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name:   "ScopedToUser",
+			method: http.MethodPost,
+			key:    "abc",
+			token:  "refreshed-token",
+			status: http.StatusCreated,
+			mockExpect: func() {
+				ssoctrl.EXPECT().ParseClaims(gomock.Any(), "refreshed-token").Return("user-id", nil)
+				mctrl.EXPECT().BeginIdempotent(gomock.Any(), userKey, hash).Return(nil, nil)
+				mctrl.EXPECT().CompleteIdempotent(gomock.Any(), userKey, hash, http.StatusCreated, []byte(`{"data":"new"}`)).Return(nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"data":"new"}`,
+		},
+		{
+			name:   "InvalidToken",
+			method: http.MethodPost,
+			key:    "abc",
+			token:  "bad-token",
+			mockExpect: func() {
+				ssoctrl.EXPECT().ParseClaims(gomock.Any(), "bad-token").Return("", errors.New("invalid token"))
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				if tt.mockExpect != nil {
+					tt.mockExpect()
+				}
+
+				req, err := http.NewRequest(tt.method, "/api/items", strings.NewReader(body))
+				assert.NoError(t, err)
+				if tt.key != "" {
+					req.Header.Set(consts.IdempotencyHeader, tt.key)
+				}
+				if tt.token != "" {
+					req.Header.Set("Authorization", "Bearer "+tt.token)
+				}
+				rr := httptest.NewRecorder()
+
+				testHandler := http.HandlerFunc(
+					func(w http.ResponseWriter, r *http.Request) {
+						got, err := io.ReadAll(r.Body)
+						assert.NoError(t, err)
+						assert.Equal(t, body, string(got))
+
+						w.WriteHeader(tt.status)
+						w.Write([]byte(`{"data":"new"}`))
+					},
+				)
+
+				h.idempotencyMiddleware(testHandler).ServeHTTP(rr, req)
+
+				assert.Equal(t, tt.expectedStatus, rr.Code)
+				if tt.expectedBody != "" {
+					assert.Equal(t, tt.expectedBody, rr.Body.String())
+				}
+				assert.Equal(t, tt.replayed, rr.Header().Get(consts.IdempotentReplayHeader) == "true")
+			},
+		)
+	}
+}
@@ -0,0 +1,108 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"go.uber.org/zap"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// idempotencyMiddleware replays the stored response of a mutation retried with the same Idempotency-Key header.
+// Keys are scoped to the method, URL and the signed-in user, so a retry with a refreshed token still matches.
+// Reusing a key for a different body is rejected. Only successful responses are stored, a failed request can be
+// retried with the same key.
+func (h *Handler) idempotencyMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(consts.IdempotencyHeader)
+			if key == "" || r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if len(key) > consts.MaxIdempotencyKeyLength {
+				errResponse(w, validation.ErrInvalidIdempotencyKey)
+				return
+			}
+
+			uid := ""
+			if authHeader := r.Header.Get("Authorization"); authHeader != "" {
+				tokenStr, ok := strings.CutPrefix(authHeader, "Bearer ")
+				if !ok {
+					errResponse(w, errInvalidTokenFormat)
+					return
+				}
+
+				var err error
+				if uid, err = h.sso.ParseClaims(r.Context(), tokenStr); err != nil {
+					zap.L().Debug("failed to parse claims", zap.Error(err))
+					errResponse(w, unauthenticated(err))
+					return
+				}
+			}
+
+			// Media uploads are the largest bodies the API accepts.
+			r.Body = http.MaxBytesReader(w, r.Body, consts.MaxMediaSize+multipartOverhead)
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				zap.L().Debug("failed to read idempotent request", zap.Error(err))
+				errResponse(w, ctrl.ErrDecodeRequest)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			key = ctrl.IdempotencyKey(r.Method, r.URL.RequestURI(), uid, key)
+			hash := ctrl.IdempotencyKey(string(body))
+
+			rec, err := h.ctrl.BeginIdempotent(r.Context(), key, hash)
+			if err != nil {
+				errResponse(w, err)
+				return
+			} else if rec != nil {
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set(consts.IdempotentReplayHeader, "true")
+				w.WriteHeader(rec.Status)
+				w.Write(rec.Response)
+				return
+			}
+
+			rw := &recordingWriter{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(rw, r)
+
+			// The response is already sent, the outcome is stored even if the client went away.
+			ctx := context.WithoutCancel(r.Context())
+			if rw.status >= 200 && rw.status < 300 {
+				if err = h.ctrl.CompleteIdempotent(ctx, key, hash, rw.status, rw.body.Bytes()); err != nil {
+					zap.L().Debug("failed to store idempotent response", zap.Error(err))
+				}
+				return
+			}
+
+			if err = h.ctrl.ReleaseIdempotent(ctx, key); err != nil {
+				zap.L().Debug("failed to release idempotency key", zap.Error(err))
+			}
+		},
+	)
+}
+
+// recordingWriter keeps a copy of the status and body written to the client.
+type recordingWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *recordingWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *recordingWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
@@ -1,7 +1,6 @@
 package http
 
 import (
-	"errors"
 	"github.com/JMURv/par-pro/products/internal/ctrl"
 	mid "github.com/JMURv/par-pro/products/internal/hdl/http/middleware"
 	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
@@ -13,6 +12,7 @@ import (
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -51,7 +51,7 @@ func RegisterItemRoutes(mux *http.ServeMux, h *Handler) {
 			case http.MethodGet:
 				h.ListItems(w, r)
 			case http.MethodPost:
-				mid.ApplyMiddleware(h.CreateItem, h.authMiddleware)(w, r)
+				mid.ApplyMiddleware(h.CreateItem, h.requirePermission(ctrl.PermCatalogManage), h.authMiddleware)(w, r)
 			default:
 				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
 			}
@@ -64,9 +64,11 @@ func RegisterItemRoutes(mux *http.ServeMux, h *Handler) {
 			case http.MethodGet:
 				h.GetItem(w, r)
 			case http.MethodPut:
-				mid.ApplyMiddleware(h.UpdateItem, h.authMiddleware)(w, r)
+				mid.ApplyMiddleware(h.UpdateItem, h.requirePermission(ctrl.PermCatalogManage), h.authMiddleware)(w, r)
+			case http.MethodPatch:
+				mid.ApplyMiddleware(h.PatchItem, h.requirePermission(ctrl.PermCatalogManage), h.authMiddleware)(w, r)
 			case http.MethodDelete:
-				mid.ApplyMiddleware(h.DeleteItem, h.authMiddleware)(w, r)
+				mid.ApplyMiddleware(h.DeleteItem, h.requirePermission(ctrl.PermCatalogManage), h.authMiddleware)(w, r)
 			default:
 				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
 			}
@@ -99,8 +101,7 @@ func (h *Handler) itemSearch(w http.ResponseWriter, r *http.Request) {
 	res, err := h.ctrl.ItemSearch(r.Context(), query, page, size)
 	if err != nil {
 		zap.L().Debug("failed to search items", zap.String("op", op), zap.String("query", query), zap.Error(err))
-		c = http.StatusInternalServerError
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+		c = errResponse(w, err)
 		return
 	}
 
@@ -126,9 +127,8 @@ func (h *Handler) ListItems(w http.ResponseWriter, r *http.Request) {
 
 	res, err := h.ctrl.ListItems(r.Context(), page, size)
 	if err != nil {
-		c = http.StatusInternalServerError
 		zap.L().Debug("failed to list items", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+		c = errResponse(w, err)
 		return
 	}
 
@@ -150,18 +150,13 @@ func (h *Handler) GetItem(w http.ResponseWriter, r *http.Request) {
 	}
 
 	res, err := h.ctrl.GetItemByUUID(r.Context(), itemUID)
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = http.StatusNotFound
-		zap.L().Debug("failed to get item", zap.String("op", op), zap.String("uid", itemUID.String()), zap.Error(err))
-		utils.ErrResponse(w, c, err)
-		return
-	} else if err != nil {
-		c = http.StatusInternalServerError
+	if err != nil {
 		zap.L().Debug("failed to get item", zap.String("op", op), zap.String("uid", itemUID.String()), zap.Error(err))
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+		c = errResponse(w, err)
 		return
 	}
 
+	setETag(w, res.Version)
 	utils.SuccessResponse(w, c, res)
 }
 
@@ -180,7 +175,14 @@ func (h *Handler) CreateItem(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := validation.ItemValidation(req); err != nil {
+	schema, err := h.ctrl.GetAttributeSchema(r.Context(), req.CategorySlugs())
+	if err != nil {
+		zap.L().Debug("failed to get attribute schema", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	if err = validation.ItemValidation(req, schema); err != nil {
 		c = http.StatusBadRequest
 		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
 		utils.ErrResponse(w, c, err)
@@ -189,9 +191,8 @@ func (h *Handler) CreateItem(w http.ResponseWriter, r *http.Request) {
 
 	res, err := h.ctrl.CreateItem(r.Context(), req)
 	if err != nil {
-		c = http.StatusInternalServerError
 		zap.L().Debug("failed to create item", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+		c = errResponse(w, err)
 		return
 	}
 
@@ -220,34 +221,123 @@ func (h *Handler) UpdateItem(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err = validation.ItemValidation(req); err != nil {
+	schema, err := h.ctrl.GetAttributeSchema(r.Context(), req.CategorySlugs())
+	if err != nil {
+		zap.L().Debug("failed to get attribute schema", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	if err = validation.ItemValidation(req, schema); err != nil {
 		c = http.StatusBadRequest
 		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
 		utils.ErrResponse(w, c, err)
 		return
 	}
 
+	version, err := ifMatch(r, req.Version)
+	if err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to parse If-Match", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+	req.Version = version
+
 	err = h.ctrl.UpdateItem(r.Context(), itemUID, req)
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = http.StatusNotFound
+	if err != nil {
 		zap.L().Debug(
-			"failed to found item",
+			"failed to update item",
 			zap.String("op", op), zap.String("uid", itemUID.String()),
 			zap.Error(err),
 		)
+		c = errResponse(w, err)
+		return
+	}
+
+	setETag(w, req.Version)
+	utils.SuccessResponse(w, c, "OK")
+}
+
+// PatchItem applies a JSON Merge Patch to the item, sub-collections missing from the patch are left untouched.
+func (h *Handler) PatchItem(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "items.PatchItem.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	itemUID, err := uuid.Parse(strings.TrimPrefix(r.URL.Path, "/api/item/"))
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	req := &model.Item{}
+	fields, err := decodeMergePatch(r, req)
+	if err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	// The schema comes from the categories the item ends up with, the stored ones unless they are patched too.
+	var schema []*model.CategoryAttribute
+	var stored []model.ItemAttribute
+	if patchAttrs, patchCats := slices.Contains(fields, "attributes"), slices.Contains(fields, "categories"); patchAttrs || patchCats {
+		slugs := req.CategorySlugs()
+		if !patchAttrs || !patchCats {
+			cur, err := h.ctrl.GetItemByUUID(r.Context(), itemUID)
+			if err != nil {
+				zap.L().Debug("failed to get item", zap.String("op", op), zap.Error(err))
+				c = errResponse(w, err)
+				return
+			}
+
+			if !patchCats {
+				slugs = cur.CategorySlugs()
+			}
+			stored = cur.Attributes
+		}
+
+		schema, err = h.ctrl.GetAttributeSchema(r.Context(), slugs)
+		if err != nil {
+			zap.L().Debug("failed to get attribute schema", zap.String("op", op), zap.Error(err))
+			c = errResponse(w, err)
+			return
+		}
+	}
+
+	if err = validation.ItemPatchValidation(req, fields, schema, stored); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	version, err := ifMatch(r, req.Version)
+	if err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to parse If-Match", zap.String("op", op), zap.Error(err))
 		utils.ErrResponse(w, c, err)
 		return
-	} else if err != nil {
-		c = http.StatusInternalServerError
+	}
+	req.Version = version
+
+	err = h.ctrl.PatchItem(r.Context(), itemUID, req, fields)
+	if err != nil {
 		zap.L().Debug(
-			"failed to update item",
+			"failed to patch item",
 			zap.String("op", op), zap.String("uid", itemUID.String()),
 			zap.Error(err),
 		)
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+		c = errResponse(w, err)
 		return
 	}
 
+	setETag(w, req.Version)
 	utils.SuccessResponse(w, c, "OK")
 }
 
@@ -266,20 +356,14 @@ func (h *Handler) DeleteItem(w http.ResponseWriter, r *http.Request) {
 	}
 
 	err = h.ctrl.DeleteItem(r.Context(), itemUID)
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = http.StatusNotFound
-		zap.L().Debug("failed to found item", zap.String("op", op), zap.String("uid", itemUID.String()), zap.Error(err))
-		utils.ErrResponse(w, c, err)
-		return
-	} else if err != nil {
-		c = http.StatusInternalServerError
+	if err != nil {
 		zap.L().Debug(
 			"failed to delete item",
 			zap.String("op", op),
 			zap.String("uid", itemUID.String()),
 			zap.Error(err),
 		)
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+		c = errResponse(w, err)
 		return
 	}
 
@@ -304,8 +388,21 @@ func (h *Handler) listCategoryItems(w http.ResponseWriter, r *http.Request) {
 	}
 
 	sort := r.URL.Query().Get("sort")
+	if err = validation.ItemSortValidation(sort); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate sort", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
 
 	filters := utils.ParseFiltersByURL(r)
+	if err = validation.ItemFiltersValidation(filters); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate filters", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
 	res, err := h.ctrl.ListCategoryItems(
 		r.Context(),
 		strings.TrimPrefix(r.URL.Path, "/api/category/items/"),
@@ -315,9 +412,8 @@ func (h *Handler) listCategoryItems(w http.ResponseWriter, r *http.Request) {
 		sort,
 	)
 	if err != nil {
-		c = http.StatusInternalServerError
 		zap.L().Debug("failed to list category items", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+		c = errResponse(w, err)
 		return
 	}
 
@@ -349,8 +445,7 @@ func (h *Handler) itemAttrSearch(w http.ResponseWriter, r *http.Request) {
 	res, err := h.ctrl.ItemAttrSearch(r.Context(), query, size, page)
 	if err != nil {
 		zap.L().Debug("failed to search attributes", zap.String("op", op), zap.String("query", query), zap.Error(err))
-		c = http.StatusInternalServerError
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+		c = errResponse(w, err)
 		return
 	}
 
@@ -372,20 +467,14 @@ func (h *Handler) listRelatedItems(w http.ResponseWriter, r *http.Request) {
 	}
 
 	resp, err := h.ctrl.ListRelatedItems(r.Context(), itemUID)
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = http.StatusNotFound
-		zap.L().Debug("failed to found item", zap.String("op", op), zap.String("uid", itemUID.String()), zap.Error(err))
-		utils.ErrResponse(w, c, err)
-		return
-	} else if err != nil {
-		c = http.StatusInternalServerError
+	if err != nil {
 		zap.L().Debug(
 			"failed to list related items",
 			zap.String("op", op),
 			zap.String("uid", itemUID.String()),
 			zap.Error(err),
 		)
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+		c = errResponse(w, err)
 		return
 	}
 
@@ -417,9 +506,8 @@ func (h *Handler) ListItemsByLabel(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := h.ctrl.ListItemsByLabel(r.Context(), label, page, size)
 	if err != nil {
-		c = http.StatusInternalServerError
 		zap.L().Debug("failed to list items", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+		c = errResponse(w, err)
 		return
 	}
 
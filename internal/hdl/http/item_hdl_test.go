@@ -4,7 +4,10 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/internal/validation"
 	"github.com/JMURv/par-pro/products/mocks"
 	"github.com/JMURv/par-pro/products/pkg/consts"
 	"github.com/JMURv/par-pro/products/pkg/model"
@@ -26,7 +29,7 @@ var (
 	validTitle   = "TestTitle"
 	validDesc    = "TestDescription"
 	invalidPrice = 0
-	validPrice   = float64(299)
+	validPrice   = model.NewMoney(29900, model.DefaultCurrency)
 	validSrc     = "TestPath"
 )
 var validItm = &model.Item{
@@ -397,6 +400,7 @@ func TestHandler_CreateItem(t *testing.T) {
 
 	ctx := context.Background()
 	mctrl := mocks.NewMockCtrl(mock)
+	mctrl.EXPECT().GetAttributeSchema(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 	h := New(mctrl, nil)
 
 	tests := []struct {
@@ -458,6 +462,26 @@ func TestHandler_CreateItem(t *testing.T) {
 				assert.Equal(t, ctrl.ErrInternalError.Error(), errResp.Error)
 			},
 		},
+		{
+			name:    "DuplicateArticle",
+			method:  http.MethodPost,
+			url:     uri,
+			body:    validItm,
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusConflict,
+			mockExpect: func() {
+				mctrl.EXPECT().CreateItem(gomock.Any(), gomock.Any()).Return(
+					uuid.Nil,
+					fmt.Errorf("%w: article", repo.ErrAlreadyExists),
+				).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, http.StatusConflict, errResp.Status)
+				assert.Equal(t, "already exists: article", errResp.Detail)
+			},
+		},
 		{
 			name:    "Success",
 			method:  http.MethodPost,
@@ -519,6 +543,7 @@ func TestHandler_UpdateItem(t *testing.T) {
 
 	ctx := context.Background()
 	mctrl := mocks.NewMockCtrl(mock)
+	mctrl.EXPECT().GetAttributeSchema(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 	h := New(mctrl, nil)
 
 	tests := []struct {
@@ -1044,6 +1069,26 @@ func TestHandler_ListCategoryItems(t *testing.T) {
 		mockExpect   func()
 		expectedResp func(*testing.T, any)
 	}{
+		{
+			name:       "InvalidFilters",
+			method:     http.MethodGet,
+			url:        uri + "test-category?min_price=-1&weight[min]=5&weight[max]=2&color[min]=red",
+			body:       nil,
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(
+					t, []any{
+						map[string]any{"field": "color[min]", "code": validation.CodeInvalidFormat, "message": validation.ErrInvalidRangeFilter.Error()},
+						map[string]any{"field": "min_price", "code": validation.CodeInvalidFormat, "message": validation.ErrInvalidPriceFilter.Error()},
+						map[string]any{"field": "weight", "code": validation.CodeOutOfRange, "message": validation.ErrInvalidRangeFilter.Error()},
+					}, errResp.Violations,
+				)
+			},
+		},
 		{
 			name:    "InvalidPage",
 			method:  http.MethodGet,
@@ -1111,6 +1156,42 @@ func TestHandler_ListCategoryItems(t *testing.T) {
 				assert.Equal(t, ctrl.ErrInternalError.Error(), errResp.Error)
 			},
 		},
+		{
+			name:       "InvalidSort",
+			method:     http.MethodGet,
+			url:        uri + "test-category?page=1&size=10&sort=i.price",
+			body:       nil,
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrInvalidItemSort.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "SortByRating",
+			method:  http.MethodGet,
+			url:     uri + "test-category?page=1&size=10&sort=-rating",
+			body:    nil,
+			resType: &model.PaginatedItemsData{},
+			status:  http.StatusOK,
+			mockExpect: func() {
+				mctrl.EXPECT().ListCategoryItems(
+					gomock.Any(),
+					"test-category",
+					1,
+					10,
+					gomock.Any(),
+					"-rating",
+				).Return(&model.PaginatedItemsData{}, nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				_, ok := res.(*model.PaginatedItemsData)
+				require.True(t, ok)
+			},
+		},
 		{
 			name:    "Success",
 			method:  http.MethodGet,
@@ -0,0 +1,156 @@
+package http
+
+import (
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	mid "github.com/JMURv/par-pro/products/internal/hdl/http/middleware"
+	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	utils "github.com/JMURv/par-pro/products/pkg/utils/http"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func RegisterItemRevisionRoutes(mux *http.ServeMux, h *Handler) {
+	// /api/item/revisions/{itemID}, /api/item/revisions/{itemID}/diff and /api/item/revisions/{itemID}/{id}/rollback
+	mux.HandleFunc(
+		"/api/item/revisions/", func(w http.ResponseWriter, r *http.Request) {
+			_, sub, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/api/item/revisions/"), "/")
+			switch {
+			case sub == "" && r.Method == http.MethodGet:
+				mid.ApplyMiddleware(h.listItemRevisions, h.requirePermission(ctrl.PermCatalogManage), h.authMiddleware)(w, r)
+			case sub == "diff" && r.Method == http.MethodGet:
+				mid.ApplyMiddleware(h.diffItemRevisions, h.requirePermission(ctrl.PermCatalogManage), h.authMiddleware)(w, r)
+			case strings.HasSuffix(sub, "/rollback") && r.Method == http.MethodPost:
+				mid.ApplyMiddleware(h.rollbackItem, h.requirePermission(ctrl.PermCatalogManage), h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+}
+
+// revisionPath splits /api/item/revisions/{itemID}/... into the item id and the rest of the path.
+func revisionPath(r *http.Request) (uuid.UUID, string, error) {
+	item, sub, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/api/item/revisions/"), "/")
+	uid, err := uuid.Parse(item)
+	if err != nil {
+		return uuid.Nil, "", validation.ErrMissingUUID
+	}
+	return uid, sub, nil
+}
+
+func parseRevisionID(v string) (uint64, error) {
+	id, err := strconv.ParseUint(v, 10, 64)
+	if err != nil || id == 0 {
+		return 0, validation.ErrInvalidRevisionID
+	}
+	return id, nil
+}
+
+func (h *Handler) listItemRevisions(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "items.listItemRevisions.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	uid, _, err := revisionPath(r)
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		page = consts.DefaultPage
+	}
+
+	size, err := strconv.Atoi(r.URL.Query().Get("size"))
+	if err != nil || size < 1 {
+		size = consts.DefaultPageSize
+	}
+
+	res, err := h.ctrl.ListItemRevisions(r.Context(), uid, page, size)
+	if err != nil {
+		zap.L().Debug("failed to list item revisions", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) diffItemRevisions(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "items.diffItemRevisions.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	uid, _, err := revisionPath(r)
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	from, err := parseRevisionID(r.URL.Query().Get("from"))
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	to, err := parseRevisionID(r.URL.Query().Get("to"))
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	res, err := h.ctrl.DiffItemRevisions(r.Context(), uid, from, to)
+	if err != nil {
+		zap.L().Debug("failed to diff item revisions", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) rollbackItem(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "items.rollbackItem.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	uid, sub, err := revisionPath(r)
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	id, err := parseRevisionID(strings.TrimSuffix(sub, "/rollback"))
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err = h.ctrl.RollbackItem(r.Context(), uid, id)
+	if err != nil {
+		zap.L().Debug("failed to roll back item", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
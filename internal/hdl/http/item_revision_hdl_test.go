@@ -0,0 +1,129 @@
+package http
+
+import (
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	utils "github.com/JMURv/par-pro/products/pkg/utils/http"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler_RollbackItem(t *testing.T) {
+	const uri = "/api/item/revisions/"
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	h := New(mctrl, nil)
+	uid := uuid.New()
+
+	tests := []struct {
+		name         string
+		path         string
+		resType      any
+		status       int
+		mockExpect   func()
+		expectedResp func(*testing.T, any)
+	}{
+		{
+			name:       "InvalidUUID",
+			path:       "abc/4/rollback",
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrMissingUUID.Error(), errResp.Error)
+			},
+		},
+		{
+			name:       "InvalidRevision",
+			path:       uid.String() + "/0/rollback",
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrInvalidRevisionID.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "NotFound",
+			path:    uid.String() + "/4/rollback",
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusNotFound,
+			mockExpect: func() {
+				mctrl.EXPECT().RollbackItem(gomock.Any(), uid, uint64(4)).Return(ctrl.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrNotFound.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "Success",
+			path:    uid.String() + "/4/rollback",
+			resType: &utils.Response{},
+			status:  http.StatusOK,
+			mockExpect: func() {
+				mctrl.EXPECT().RollbackItem(gomock.Any(), uid, uint64(4)).Return(nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				_, ok := res.(*utils.Response)
+				require.True(t, ok)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+
+				req := httptest.NewRequest(http.MethodPost, uri+tt.path, nil)
+				w := httptest.NewRecorder()
+				h.rollbackItem(w, req)
+
+				res := tt.resType
+				err := json.NewDecoder(w.Result().Body).Decode(res)
+				assert.Nil(t, err)
+
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+				tt.expectedResp(t, res)
+			},
+		)
+	}
+}
+
+func TestHandler_DiffItemRevisions(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	h := New(mctrl, nil)
+	uid := uuid.New()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/item/revisions/"+uid.String()+"/diff?from=1", nil)
+	w := httptest.NewRecorder()
+	h.diffItemRevisions(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+
+	mctrl.EXPECT().DiffItemRevisions(gomock.Any(), uid, uint64(1), uint64(2)).
+		Return(&model.ItemRevisionDiff{From: 1, To: 2, Diff: []byte(`{}`)}, nil).Times(1)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/item/revisions/"+uid.String()+"/diff?from=1&to=2", nil)
+	w = httptest.NewRecorder()
+	h.diffItemRevisions(w, req)
+	assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+}
@@ -0,0 +1,193 @@
+package http
+
+import (
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	mid "github.com/JMURv/par-pro/products/internal/hdl/http/middleware"
+	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	utils "github.com/JMURv/par-pro/products/pkg/utils/http"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"net/http"
+	"strings"
+	"time"
+)
+
+func RegisterLabelRoutes(mux *http.ServeMux, h *Handler) {
+	mux.HandleFunc(
+		"/api/labels", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				h.listLabels(w, r)
+			case http.MethodPost:
+				mid.ApplyMiddleware(h.createLabel, h.requirePermission(ctrl.PermCatalogManage), h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	mux.HandleFunc(
+		"/api/labels/", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodPut:
+				mid.ApplyMiddleware(h.updateLabel, h.requirePermission(ctrl.PermCatalogManage), h.authMiddleware)(w, r)
+			case http.MethodDelete:
+				mid.ApplyMiddleware(h.deleteLabel, h.requirePermission(ctrl.PermCatalogManage), h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	mux.HandleFunc(
+		"/api/item/labels/", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodPut:
+				mid.ApplyMiddleware(h.setItemLabels, h.requirePermission(ctrl.PermCatalogManage), h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+}
+
+func (h *Handler) listLabels(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "labels.listLabels.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	res, err := h.ctrl.ListLabels(r.Context())
+	if err != nil {
+		zap.L().Debug("failed to list labels", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) createLabel(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusCreated
+	const op = "labels.createLabel.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	req := &model.Label{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	if err := validation.LabelValidation(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	res, err := h.ctrl.CreateLabel(r.Context(), req)
+	if err != nil {
+		zap.L().Debug("failed to create label", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) updateLabel(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "labels.updateLabel.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	req := &model.Label{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	if err := validation.LabelValidation(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err := h.ctrl.UpdateLabel(r.Context(), strings.TrimPrefix(r.URL.Path, "/api/labels/"), req)
+	if err != nil {
+		zap.L().Debug("failed to update label", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
+
+func (h *Handler) deleteLabel(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusNoContent
+	const op = "labels.deleteLabel.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	err := h.ctrl.DeleteLabel(r.Context(), strings.TrimPrefix(r.URL.Path, "/api/labels/"))
+	if err != nil {
+		zap.L().Debug("failed to delete label", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
+
+func (h *Handler) setItemLabels(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "labels.setItemLabels.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	uid, err := uuid.Parse(strings.TrimPrefix(r.URL.Path, "/api/item/labels/"))
+	if err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, ctrl.ErrParseUUID)
+		return
+	}
+
+	req := make([]string, 0, 5)
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	if err = validation.ItemLabelsValidation(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err = h.ctrl.SetItemLabels(r.Context(), uid, req)
+	if err != nil {
+		zap.L().Debug("failed to set item labels", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
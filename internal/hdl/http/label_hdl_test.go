@@ -0,0 +1,231 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	utils "github.com/JMURv/par-pro/products/pkg/utils/http"
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler_CreateLabel(t *testing.T) {
+	const uri = "/api/labels"
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	h := New(mctrl, nil)
+
+	tests := []struct {
+		name         string
+		body         any
+		resType      any
+		status       int
+		mockExpect   func()
+		expectedResp func(*testing.T, any)
+	}{
+		{
+			name:       "InvalidName",
+			body:       &model.Label{Name: "Big Sale"},
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrInvalidLabelName.Error(), errResp.Error)
+			},
+		},
+		{
+			name:       "InvalidColor",
+			body:       &model.Label{Name: "eco", Color: "green"},
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrInvalidColor.Error(), errResp.Error)
+			},
+		},
+		{
+			name:       "InvalidRule",
+			body:       &model.Label{Name: "eco", Rule: "weekly"},
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrInvalidLabelRule.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "AlreadyExists",
+			body:    &model.Label{Name: "eco"},
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusConflict,
+			mockExpect: func() {
+				mctrl.EXPECT().CreateLabel(gomock.Any(), gomock.Any()).Return(uint64(0), ctrl.ErrAlreadyExists).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrAlreadyExists.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "RuleDefaults",
+			body:    &model.Label{Name: "fresh", Title: "Fresh", Color: "#00AA00", Rule: model.LabelRuleNew},
+			resType: &utils.Response{},
+			status:  http.StatusCreated,
+			mockExpect: func() {
+				mctrl.EXPECT().CreateLabel(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, l *model.Label) (uint64, error) {
+						assert.Equal(t, consts.NewLabelDays, l.RuleParam)
+						return 5, nil
+					},
+				).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				resp, ok := res.(*utils.Response)
+				require.True(t, ok)
+				assert.Equal(t, float64(5), resp.Data)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+
+				bodyBytes, err := json.Marshal(tt.body)
+				require.NoError(t, err)
+
+				req := httptest.NewRequest(http.MethodPost, uri, bytes.NewReader(bodyBytes))
+				req.Header.Set("Content-Type", "application/json")
+
+				w := httptest.NewRecorder()
+				h.createLabel(w, req)
+
+				res := tt.resType
+				err = json.NewDecoder(w.Result().Body).Decode(res)
+				assert.Nil(t, err)
+
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+				tt.expectedResp(t, res)
+			},
+		)
+	}
+}
+
+func TestHandler_SetItemLabels(t *testing.T) {
+	const uri = "/api/item/labels/"
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	h := New(mctrl, nil)
+
+	tests := []struct {
+		name         string
+		id           string
+		body         any
+		resType      any
+		status       int
+		mockExpect   func()
+		expectedResp func(*testing.T, any)
+	}{
+		{
+			name:       "InvalidUUID",
+			id:         "invalid",
+			body:       []string{"hit"},
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrParseUUID.Error(), errResp.Error)
+			},
+		},
+		{
+			name:       "DuplicateLabel",
+			id:         validUUID.String(),
+			body:       []string{"hit", "hit"},
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrDuplicateLabel.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "NotFound",
+			id:      validUUID.String(),
+			body:    []string{"unknown"},
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusNotFound,
+			mockExpect: func() {
+				mctrl.EXPECT().SetItemLabels(gomock.Any(), validUUID, []string{"unknown"}).Return(ctrl.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrNotFound.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "Success",
+			id:      validUUID.String(),
+			body:    []string{"hit", "rec"},
+			resType: &utils.Response{},
+			status:  http.StatusOK,
+			mockExpect: func() {
+				mctrl.EXPECT().SetItemLabels(gomock.Any(), validUUID, []string{"hit", "rec"}).Return(nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				resp, ok := res.(*utils.Response)
+				require.True(t, ok)
+				assert.Equal(t, "OK", resp.Data)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+
+				bodyBytes, err := json.Marshal(tt.body)
+				require.NoError(t, err)
+
+				req := httptest.NewRequest(http.MethodPut, uri+tt.id, bytes.NewReader(bodyBytes))
+				req.Header.Set("Content-Type", "application/json")
+
+				w := httptest.NewRecorder()
+				h.setItemLabels(w, req)
+
+				res := tt.resType
+				err = json.NewDecoder(w.Result().Body).Decode(res)
+				assert.Nil(t, err)
+
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+				tt.expectedResp(t, res)
+			},
+		)
+	}
+}
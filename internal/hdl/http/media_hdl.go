@@ -0,0 +1,255 @@
+package http
+
+import (
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	mid "github.com/JMURv/par-pro/products/internal/hdl/http/middleware"
+	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	utils "github.com/JMURv/par-pro/products/pkg/utils/http"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// multipartOverhead leaves room for the form fields around the uploaded file.
+const multipartOverhead = 1 << 20
+
+func RegisterMediaRoutes(mux *http.ServeMux, h *Handler) {
+	// /api/item/media/{itemID}, /api/item/media/{itemID}/order and /api/item/media/{itemID}/{mediaID}
+	mux.HandleFunc(
+		"/api/item/media/", func(w http.ResponseWriter, r *http.Request) {
+			_, sub, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/api/item/media/"), "/")
+			switch {
+			case sub == "" && r.Method == http.MethodGet:
+				h.listItemMedia(w, r)
+			case sub == "" && r.Method == http.MethodPost:
+				mid.ApplyMiddleware(h.uploadItemMedia, h.requirePermission(ctrl.PermCatalogManage), h.authMiddleware)(w, r)
+			case sub == "order" && r.Method == http.MethodPut:
+				mid.ApplyMiddleware(h.reorderItemMedia, h.requirePermission(ctrl.PermCatalogManage), h.authMiddleware)(w, r)
+			case sub != "" && sub != "order" && r.Method == http.MethodPut:
+				mid.ApplyMiddleware(h.updateItemMedia, h.requirePermission(ctrl.PermCatalogManage), h.authMiddleware)(w, r)
+			case sub != "" && sub != "order" && r.Method == http.MethodDelete:
+				mid.ApplyMiddleware(h.deleteItemMedia, h.requirePermission(ctrl.PermCatalogManage), h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+}
+
+// mediaPath parses the item uuid and the optional media id out of /api/item/media/{itemID}[/{mediaID}]
+func mediaPath(r *http.Request) (uuid.UUID, uint64, error) {
+	item, sub, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/api/item/media/"), "/")
+	uid, err := uuid.Parse(item)
+	if err != nil {
+		return uuid.Nil, 0, err
+	}
+
+	if sub == "" || sub == "order" {
+		return uid, 0, nil
+	}
+
+	id, err := strconv.ParseUint(sub, 10, 64)
+	if err != nil {
+		return uuid.Nil, 0, err
+	}
+	return uid, id, nil
+}
+
+func (h *Handler) listItemMedia(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "media.listItemMedia.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	uid, _, err := mediaPath(r)
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, validation.ErrMissingUUID)
+		return
+	}
+
+	res, err := h.ctrl.ListItemMedia(r.Context(), uid)
+	if err != nil {
+		zap.L().Debug("failed to list item media", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) uploadItemMedia(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusCreated
+	const op = "media.uploadItemMedia.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	uid, _, err := mediaPath(r)
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, validation.ErrMissingUUID)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, consts.MaxMediaSize+multipartOverhead)
+	if err = r.ParseMultipartForm(consts.MaxMediaSize); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to parse multipart form", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, ctrl.ErrDecodeRequest)
+		return
+	}
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to read file", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, validation.ErrEmptyFile)
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, consts.MaxMediaSize+1))
+	if err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to read file", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, ctrl.ErrDecodeRequest)
+		return
+	}
+
+	isPrimary, _ := strconv.ParseBool(r.FormValue("is_primary"))
+	req := &model.MediaUpload{
+		Alt:       r.FormValue("alt"),
+		IsPrimary: isPrimary,
+		Data:      data,
+	}
+
+	if err = validation.MediaUploadValidation(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	res, err := h.ctrl.UploadItemMedia(r.Context(), uid, req)
+	if err != nil {
+		zap.L().Debug("failed to upload item media", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) updateItemMedia(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "media.updateItemMedia.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	uid, id, err := mediaPath(r)
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	req := &model.ItemMedia{}
+	if err = json.NewDecoder(r.Body).Decode(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	if err = validation.ItemMediaValidation(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err = h.ctrl.UpdateItemMedia(r.Context(), uid, id, req)
+	if err != nil {
+		zap.L().Debug("failed to update item media", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
+
+func (h *Handler) reorderItemMedia(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "media.reorderItemMedia.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	uid, _, err := mediaPath(r)
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, validation.ErrMissingUUID)
+		return
+	}
+
+	req := &struct {
+		IDs []uint64 `json:"ids"`
+	}{}
+	if err = json.NewDecoder(r.Body).Decode(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	if err = validation.ReorderItemMediaValidation(req.IDs); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err = h.ctrl.ReorderItemMedia(r.Context(), uid, req.IDs)
+	if err != nil {
+		zap.L().Debug("failed to reorder item media", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
+
+func (h *Handler) deleteItemMedia(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusNoContent
+	const op = "media.deleteItemMedia.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	uid, id, err := mediaPath(r)
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err = h.ctrl.DeleteItemMedia(r.Context(), uid, id)
+	if err != nil {
+		zap.L().Debug("failed to delete item media", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
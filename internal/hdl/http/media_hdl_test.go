@@ -0,0 +1,141 @@
+package http
+
+import (
+	"bytes"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	utils "github.com/JMURv/par-pro/products/pkg/utils/http"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"image"
+	"image/png"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler_UploadItemMedia(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	itemID := uuid.New()
+	mctrl := mocks.NewMockCtrl(mock)
+	h := New(mctrl, nil)
+
+	img := &bytes.Buffer{}
+	require.NoError(t, png.Encode(img, image.NewRGBA(image.Rect(0, 0, 2, 2))))
+
+	tests := []struct {
+		name         string
+		uri          string
+		file         []byte
+		resType      any
+		status       int
+		mockExpect   func()
+		expectedResp func(*testing.T, any)
+	}{
+		{
+			name:       "InvalidItemID",
+			uri:        "/api/item/media/1",
+			file:       img.Bytes(),
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrMissingUUID.Error(), errResp.Error)
+			},
+		},
+		{
+			name:       "UnsupportedType",
+			uri:        "/api/item/media/" + itemID.String(),
+			file:       []byte("plain text"),
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrUnsupportedMediaType.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "ItemNotFound",
+			uri:     "/api/item/media/" + itemID.String(),
+			file:    img.Bytes(),
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusNotFound,
+			mockExpect: func() {
+				mctrl.EXPECT().UploadItemMedia(gomock.Any(), itemID, gomock.Any()).
+					Return(nil, ctrl.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrNotFound.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "Success",
+			uri:     "/api/item/media/" + itemID.String(),
+			file:    img.Bytes(),
+			resType: &utils.Response{},
+			status:  http.StatusCreated,
+			mockExpect: func() {
+				mctrl.EXPECT().UploadItemMedia(gomock.Any(), itemID, gomock.Any()).
+					DoAndReturn(
+						func(_ any, _ uuid.UUID, req *model.MediaUpload) (*model.ItemMedia, error) {
+							assert.Equal(t, "front", req.Alt)
+							assert.True(t, req.IsPrimary)
+							return &model.ItemMedia{ID: 1, Src: "/media/a.png", IsPrimary: true}, nil
+						},
+					).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				resp, ok := res.(*utils.Response)
+				require.True(t, ok)
+				data, ok := resp.Data.(map[string]any)
+				require.True(t, ok)
+				assert.Equal(t, "/media/a.png", data["src"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+
+				body := &bytes.Buffer{}
+				mw := multipart.NewWriter(body)
+				fw, err := mw.CreateFormFile("file", "a.png")
+				require.NoError(t, err)
+				_, err = fw.Write(tt.file)
+				require.NoError(t, err)
+				require.NoError(t, mw.WriteField("alt", "front"))
+				require.NoError(t, mw.WriteField("is_primary", "true"))
+				require.NoError(t, mw.Close())
+
+				req := httptest.NewRequest(http.MethodPost, tt.uri, body)
+				req.Header.Set("Content-Type", mw.FormDataContentType())
+
+				w := httptest.NewRecorder()
+				h.uploadItemMedia(w, req)
+
+				res := tt.resType
+				err = json.NewDecoder(w.Result().Body).Decode(res)
+				assert.Nil(t, err)
+
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+				tt.expectedResp(t, res)
+			},
+		)
+	}
+}
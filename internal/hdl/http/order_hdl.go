@@ -1,7 +1,6 @@
 package http
 
 import (
-	"errors"
 	ctrl "github.com/JMURv/par-pro/products/internal/ctrl"
 	mid "github.com/JMURv/par-pro/products/internal/hdl/http/middleware"
 	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
@@ -28,7 +27,7 @@ func RegisterOrderRoutes(mux *http.ServeMux, h *Handler) {
 		"/api/order", func(w http.ResponseWriter, r *http.Request) {
 			switch r.Method {
 			case http.MethodGet:
-				mid.ApplyMiddleware(h.listOrders, h.authMiddleware)(w, r)
+				mid.ApplyMiddleware(h.listOrders, h.requirePermission(ctrl.PermOrdersRead), h.authMiddleware)(w, r)
 			case http.MethodPost:
 				mid.ApplyMiddleware(h.createOrder)(w, r)
 			default:
@@ -43,7 +42,9 @@ func RegisterOrderRoutes(mux *http.ServeMux, h *Handler) {
 			case http.MethodGet:
 				mid.ApplyMiddleware(h.getOrder, h.authMiddleware)(w, r)
 			case http.MethodPut:
-				mid.ApplyMiddleware(h.updateOrder, h.authMiddleware)(w, r)
+				mid.ApplyMiddleware(h.updateOrder, h.requirePermission(ctrl.PermOrdersManage), h.authMiddleware)(w, r)
+			case http.MethodPatch:
+				mid.ApplyMiddleware(h.patchOrder, h.requirePermission(ctrl.PermOrdersManage), h.authMiddleware)(w, r)
 			case http.MethodDelete:
 				mid.ApplyMiddleware(h.cancelOrder, h.authMiddleware)(w, r)
 			default:
@@ -75,9 +76,8 @@ func (h *Handler) listOrders(w http.ResponseWriter, r *http.Request) {
 
 	res, err := h.ctrl.ListOrders(r.Context(), page, size, filters, sort)
 	if err != nil {
-		c = http.StatusInternalServerError
 		zap.L().Debug("failed to get orders", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+		c = errResponse(w, err)
 		return
 	}
 
@@ -91,7 +91,7 @@ func (h *Handler) listUserOrders(w http.ResponseWriter, r *http.Request) {
 		metrics.ObserveRequest(time.Since(s), c, op)
 	}()
 
-	uid, err := uuid.Parse(r.Context().Value("uid").(string))
+	uid, err := uuid.Parse(ctrl.UserIDFromContext(r.Context()))
 	if err != nil {
 		c = http.StatusUnauthorized
 		zap.L().Debug("Invalid token", zap.String("op", op), zap.Error(err))
@@ -111,9 +111,8 @@ func (h *Handler) listUserOrders(w http.ResponseWriter, r *http.Request) {
 
 	res, err := h.ctrl.ListUserOrders(r.Context(), uid, page, size)
 	if err != nil {
-		c = http.StatusInternalServerError
 		zap.L().Debug("failed to get orders", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+		c = errResponse(w, err)
 		return
 	}
 
@@ -135,18 +134,19 @@ func (h *Handler) getOrder(w http.ResponseWriter, r *http.Request) {
 	}
 
 	res, err := h.ctrl.GetOrder(r.Context(), orderID)
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = http.StatusNotFound
+	if err != nil {
 		zap.L().Debug("failed to get order", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, err)
+		c = errResponse(w, err)
 		return
-	} else if err != nil {
-		c = http.StatusInternalServerError
-		zap.L().Debug("failed to get order", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+	}
+
+	if !canAccessOrder(r, res, ctrl.PermOrdersRead) {
+		c = http.StatusNotFound
+		utils.ErrResponse(w, c, ctrl.ErrNotFound)
 		return
 	}
 
+	setETag(w, res.Version)
 	utils.SuccessResponse(w, c, res)
 }
 
@@ -207,20 +207,9 @@ func (h *Handler) createOrder(w http.ResponseWriter, r *http.Request) {
 	}
 
 	res, err := h.ctrl.CreateOrder(r.Context(), uid, req)
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = http.StatusNotFound
-		zap.L().Debug("failed to create order", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, err)
-		return
-	} else if err != nil && errors.Is(err, ctrl.ErrAlreadyExists) {
-		c = http.StatusConflict
-		zap.L().Debug("failed to create order", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, err)
-		return
-	} else if err != nil {
-		c = http.StatusInternalServerError
+	if err != nil {
 		zap.L().Debug("failed to create order", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+		c = errResponse(w, err)
 		return
 	}
 
@@ -249,6 +238,15 @@ func (h *Handler) updateOrder(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	version, err := ifMatch(r, req.Version)
+	if err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to parse If-Match", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+	req.Version = version
+
 	orderID, err := strconv.ParseUint(strings.TrimPrefix(r.URL.Path, "/api/order/"), 10, 64)
 	if err != nil {
 		c = http.StatusBadRequest
@@ -257,18 +255,64 @@ func (h *Handler) updateOrder(w http.ResponseWriter, r *http.Request) {
 	}
 
 	err = h.ctrl.UpdateOrder(r.Context(), orderID, req)
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = http.StatusNotFound
+	if err != nil {
 		zap.L().Debug("failed to update order", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	setETag(w, req.Version)
+	utils.SuccessResponse(w, c, "OK")
+}
+
+// patchOrder applies a JSON Merge Patch to the order, patching its items recalculates the total.
+func (h *Handler) patchOrder(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "orders.patchOrder.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	orderID, err := strconv.ParseUint(strings.TrimPrefix(r.URL.Path, "/api/order/"), 10, 64)
+	if err != nil {
+		c = http.StatusBadRequest
 		utils.ErrResponse(w, c, err)
 		return
-	} else if err != nil {
-		c = http.StatusInternalServerError
-		zap.L().Debug("failed to update order", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+	}
+
+	req := &model.Order{}
+	fields, err := decodeMergePatch(r, req)
+	if err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	if err = validation.OrderPatch(req, fields); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	version, err := ifMatch(r, req.Version)
+	if err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to parse If-Match", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+	req.Version = version
+
+	err = h.ctrl.PatchOrder(r.Context(), orderID, req, fields)
+	if err != nil {
+		zap.L().Debug("failed to patch order", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
 		return
 	}
 
+	setETag(w, req.Version)
 	utils.SuccessResponse(w, c, "OK")
 }
 
@@ -286,18 +330,35 @@ func (h *Handler) cancelOrder(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = h.ctrl.CancelOrder(r.Context(), orderID)
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
+	o, err := h.ctrl.GetOrder(r.Context(), orderID)
+	if err != nil {
+		zap.L().Debug("failed to get order", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	if !canAccessOrder(r, o, ctrl.PermOrdersManage) {
 		c = http.StatusNotFound
-		zap.L().Debug("failed to cancel order", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, err)
+		utils.ErrResponse(w, c, ctrl.ErrNotFound)
 		return
-	} else if err != nil {
-		c = http.StatusInternalServerError
+	}
+
+	err = h.ctrl.CancelOrder(r.Context(), orderID)
+	if err != nil {
 		zap.L().Debug("failed to cancel order", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+		c = errResponse(w, err)
 		return
 	}
 
 	utils.SuccessResponse(w, c, "OK")
 }
+
+// canAccessOrder lets customers through to their own orders and the staff holding perm to any order.
+func canAccessOrder(r *http.Request, o *model.Order, perm ctrl.Permission) bool {
+	if ctrl.Authorize(r.Context(), perm) == nil {
+		return true
+	}
+
+	uid, err := uuid.Parse(ctrl.UserIDFromContext(r.Context()))
+	return err == nil && uid == o.UserID
+}
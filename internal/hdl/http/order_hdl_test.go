@@ -276,7 +276,7 @@ func TestHandler_ListUserOrders(t *testing.T) {
 				req := httptest.NewRequest(tt.method, tt.url, nil)
 				req.Header.Set("Content-Type", "application/json")
 
-				req = req.WithContext(context.WithValue(ctx, "uid", tt.uid))
+				req = req.WithContext(ctrl.WithUserID(ctx, tt.uid))
 
 				w := httptest.NewRecorder()
 				h.listUserOrders(w, req)
@@ -297,7 +297,8 @@ func TestHandler_GetOrder(t *testing.T) {
 	mock := gomock.NewController(t)
 	defer mock.Finish()
 
-	ctx := context.Background()
+	ownerID := uuid.New()
+	ctx := ctrl.WithUserID(context.Background(), ownerID.String())
 	mctrl := mocks.NewMockCtrl(mock)
 	h := New(mctrl, nil)
 
@@ -308,6 +309,7 @@ func TestHandler_GetOrder(t *testing.T) {
 		body         any
 		resType      any
 		status       int
+		perms        ctrl.Permissions
 		mockExpect   func()
 		expectedResp func(*testing.T, any)
 	}{
@@ -365,7 +367,7 @@ func TestHandler_GetOrder(t *testing.T) {
 			resType: &utils.Response{},
 			status:  http.StatusOK,
 			mockExpect: func() {
-				order := &model.Order{ID: 12345, Status: "completed"}
+				order := &model.Order{ID: 12345, Status: "completed", UserID: ownerID}
 				mctrl.EXPECT().GetOrder(gomock.Any(), uint64(12345)).Return(order, nil).Times(1)
 			},
 			expectedResp: func(t *testing.T, res any) {
@@ -373,6 +375,40 @@ func TestHandler_GetOrder(t *testing.T) {
 				require.True(t, ok)
 			},
 		},
+		{
+			name:    "ForeignOrder",
+			method:  http.MethodGet,
+			url:     uri + "/12345",
+			body:    nil,
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusNotFound,
+			mockExpect: func() {
+				order := &model.Order{ID: 12345, Status: "completed", UserID: uuid.New()}
+				mctrl.EXPECT().GetOrder(gomock.Any(), uint64(12345)).Return(order, nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrNotFound.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "ForeignOrderAsStaff",
+			method:  http.MethodGet,
+			url:     uri + "/12345",
+			body:    nil,
+			resType: &utils.Response{},
+			status:  http.StatusOK,
+			perms:   ctrl.ResolvePermissions([]string{string(ctrl.PermOrdersRead)}),
+			mockExpect: func() {
+				order := &model.Order{ID: 12345, Status: "completed", UserID: uuid.New()}
+				mctrl.EXPECT().GetOrder(gomock.Any(), uint64(12345)).Return(order, nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				_, ok := res.(*utils.Response)
+				require.True(t, ok)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -382,7 +418,7 @@ func TestHandler_GetOrder(t *testing.T) {
 
 				req := httptest.NewRequest(tt.method, tt.url, nil)
 				req.Header.Set("Content-Type", "application/json")
-				req = req.WithContext(ctx)
+				req = req.WithContext(ctrl.WithPermissions(ctx, tt.perms))
 
 				w := httptest.NewRecorder()
 				h.getOrder(w, req)
@@ -446,6 +482,26 @@ func TestHandler_CreateOrder(t *testing.T) {
 				assert.Equal(t, validation.ErrMissingFIO.Error(), errResp.Error)
 			},
 		},
+		{
+			name:       "ValidationViolations",
+			method:     http.MethodPost,
+			url:        uri,
+			body:       &model.Order{FIO: "", Tel: "12-34", Email: "Test <test@example.com>", Address: "123 Street"},
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(
+					t, []any{
+						map[string]any{"field": "fio", "code": validation.CodeRequired, "message": validation.ErrMissingFIO.Error()},
+						map[string]any{"field": "tel", "code": validation.CodeInvalidFormat, "message": validation.ErrInvalidTel.Error()},
+						map[string]any{"field": "email", "code": validation.CodeInvalidFormat, "message": validation.ErrInvalidEmail.Error()},
+					}, errResp.Violations,
+				)
+			},
+		},
 		{
 			name:   "CreateUserError",
 			method: http.MethodPost,
@@ -732,7 +788,9 @@ func TestHandler_CancelOrder(t *testing.T) {
 	mock := gomock.NewController(t)
 	defer mock.Finish()
 
-	ctx := context.Background()
+	ownerID := uuid.New()
+	ctx := ctrl.WithUserID(context.Background(), ownerID.String())
+	order := &model.Order{ID: 12345, Status: "created", UserID: ownerID}
 	mctrl := mocks.NewMockCtrl(mock)
 	h := New(mctrl, nil)
 
@@ -768,7 +826,7 @@ func TestHandler_CancelOrder(t *testing.T) {
 			resType: &utils.ErrorResponse{},
 			status:  http.StatusNotFound,
 			mockExpect: func() {
-				mctrl.EXPECT().CancelOrder(gomock.Any(), uint64(12345)).Return(ctrl.ErrNotFound).Times(1)
+				mctrl.EXPECT().GetOrder(gomock.Any(), uint64(12345)).Return(nil, ctrl.ErrNotFound).Times(1)
 			},
 			expectedResp: func(t *testing.T, res any) {
 				errResp, ok := res.(*utils.ErrorResponse)
@@ -784,6 +842,7 @@ func TestHandler_CancelOrder(t *testing.T) {
 			resType: &utils.ErrorResponse{},
 			status:  http.StatusInternalServerError,
 			mockExpect: func() {
+				mctrl.EXPECT().GetOrder(gomock.Any(), uint64(12345)).Return(order, nil).Times(1)
 				mctrl.EXPECT().CancelOrder(gomock.Any(), uint64(12345)).Return(errors.New("internal error")).Times(1)
 			},
 			expectedResp: func(t *testing.T, res any) {
@@ -800,6 +859,7 @@ func TestHandler_CancelOrder(t *testing.T) {
 			resType: &utils.Response{},
 			status:  http.StatusOK,
 			mockExpect: func() {
+				mctrl.EXPECT().GetOrder(gomock.Any(), uint64(12345)).Return(order, nil).Times(1)
 				mctrl.EXPECT().CancelOrder(gomock.Any(), uint64(12345)).Return(nil).Times(1)
 			},
 			expectedResp: func(t *testing.T, res any) {
@@ -808,6 +868,24 @@ func TestHandler_CancelOrder(t *testing.T) {
 				assert.Equal(t, "OK", response.Data)
 			},
 		},
+		{
+			name:    "ForeignOrder",
+			method:  http.MethodDelete,
+			url:     uri + "/12345",
+			body:    nil,
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusNotFound,
+			mockExpect: func() {
+				mctrl.EXPECT().GetOrder(gomock.Any(), uint64(12345)).Return(
+					&model.Order{ID: 12345, Status: "created", UserID: uuid.New()}, nil,
+				).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrNotFound.Error(), errResp.Error)
+			},
+		},
 	}
 
 	for _, tt := range tests {
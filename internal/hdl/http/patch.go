@@ -0,0 +1,59 @@
+package http
+
+import (
+	"bytes"
+	"github.com/goccy/go-json"
+	"io"
+	"net/http"
+	"sort"
+)
+
+// decodeMergePatch decodes a JSON Merge Patch (RFC 7386) body into dst and returns the paths of the fields it sets.
+// Objects are merged, so their members are returned as dotted paths, e.g. price.amount, and their other members
+// are left alone. A field set to null is cleared and arrays replace the stored value as a whole.
+// The version is a precondition rather than a field, it is decoded but never returned.
+func decodeMergePatch(r *http.Request, dst any) ([]string, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	patch := make(map[string]json.RawMessage)
+	if err = json.Unmarshal(body, &patch); err != nil {
+		return nil, err
+	}
+	delete(patch, "version")
+
+	if err = json.Unmarshal(body, dst); err != nil {
+		return nil, err
+	}
+
+	fields, err := patchPaths("", patch)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(fields)
+	return fields, nil
+}
+
+func patchPaths(prefix string, patch map[string]json.RawMessage) ([]string, error) {
+	paths := make([]string, 0, len(patch))
+	for k, v := range patch {
+		if !bytes.HasPrefix(bytes.TrimSpace(v), []byte("{")) {
+			paths = append(paths, prefix+k)
+			continue
+		}
+
+		nested := make(map[string]json.RawMessage)
+		if err := json.Unmarshal(v, &nested); err != nil {
+			return nil, err
+		}
+
+		sub, err := patchPaths(prefix+k+".", nested)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, sub...)
+	}
+	return paths, nil
+}
@@ -0,0 +1,57 @@
+package http
+
+import (
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestDecodeMergePatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected []string
+	}{
+		{
+			name:     "Scalars",
+			body:     `{"title":"new","in_stock":true,"version":3}`,
+			expected: []string{"in_stock", "title"},
+		},
+		{
+			name:     "NestedObject",
+			body:     `{"price":{"amount":100}}`,
+			expected: []string{"price.amount"},
+		},
+		{
+			name:     "WholeObject",
+			body:     `{"price":{"amount":100,"currency":"KZT"}}`,
+			expected: []string{"price.amount", "price.currency"},
+		},
+		{
+			name:     "ClearedObject",
+			body:     `{"price":null,"attributes":[]}`,
+			expected: []string{"attributes", "price"},
+		},
+		{
+			name:     "EmptyObject",
+			body:     `{"price":{}}`,
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				req, err := http.NewRequest(http.MethodPatch, "/api/items/id", strings.NewReader(tt.body))
+				require.NoError(t, err)
+
+				fields, err := decodeMergePatch(req, &model.Item{})
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, fields)
+			},
+		)
+	}
+}
@@ -0,0 +1,128 @@
+package http
+
+import (
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	mid "github.com/JMURv/par-pro/products/internal/hdl/http/middleware"
+	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	utils "github.com/JMURv/par-pro/products/pkg/utils/http"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func RegisterPriceRoutes(mux *http.ServeMux, h *Handler) {
+	mux.HandleFunc(
+		"/api/item/prices/scheduled/", mid.ApplyMiddleware(
+			h.cancelScheduledPrice, mid.MethodNotAllowed(http.MethodDelete),
+			h.requirePermission(ctrl.PermPricingManage), h.authMiddleware,
+		),
+	)
+
+	mux.HandleFunc(
+		"/api/item/prices/", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				h.getPriceTimeline(w, r)
+			case http.MethodPost:
+				mid.ApplyMiddleware(h.schedulePriceChange, h.requirePermission(ctrl.PermPricingManage), h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+}
+
+func (h *Handler) getPriceTimeline(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "prices.getPriceTimeline.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	itemUID, err := uuid.Parse(strings.TrimPrefix(r.URL.Path, "/api/item/prices/"))
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	res, err := h.ctrl.GetPriceTimeline(r.Context(), itemUID)
+	if err != nil {
+		zap.L().Debug("failed to get price timeline", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) schedulePriceChange(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusCreated
+	const op = "prices.schedulePriceChange.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	itemUID, err := uuid.Parse(strings.TrimPrefix(r.URL.Path, "/api/item/prices/"))
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	req := &model.ScheduledPrice{}
+	if err = json.NewDecoder(r.Body).Decode(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+	req.ItemID = itemUID
+
+	if err = validation.ScheduledPriceValidation(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	res, err := h.ctrl.SchedulePriceChange(r.Context(), req)
+	if err != nil {
+		zap.L().Debug("failed to schedule price change", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) cancelScheduledPrice(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusNoContent
+	const op = "prices.cancelScheduledPrice.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	id, err := strconv.ParseUint(strings.TrimPrefix(r.URL.Path, "/api/item/prices/scheduled/"), 10, 64)
+	if err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to parse id", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err = h.ctrl.CancelScheduledPrice(r.Context(), id)
+	if err != nil {
+		zap.L().Debug("failed to cancel scheduled price", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
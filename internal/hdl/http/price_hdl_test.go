@@ -0,0 +1,308 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	utils "github.com/JMURv/par-pro/products/pkg/utils/http"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHandler_GetPriceTimeline(t *testing.T) {
+	const uri = "/api/item/prices/"
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	ctx := context.Background()
+	mctrl := mocks.NewMockCtrl(mock)
+	h := New(mctrl, nil)
+
+	uid := uuid.New()
+
+	tests := []struct {
+		name         string
+		url          string
+		resType      any
+		status       int
+		mockExpect   func()
+		expectedResp func(*testing.T, any)
+	}{
+		{
+			name:       "InvalidUUID",
+			url:        uri + "invalid-uuid",
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Contains(t, errResp.Error, "invalid UUID")
+			},
+		},
+		{
+			name:    "NotFound",
+			url:     uri + uid.String(),
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusNotFound,
+			mockExpect: func() {
+				mctrl.EXPECT().GetPriceTimeline(gomock.Any(), uid).Return(nil, ctrl.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrNotFound.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "InternalError",
+			url:     uri + uid.String(),
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusInternalServerError,
+			mockExpect: func() {
+				mctrl.EXPECT().GetPriceTimeline(gomock.Any(), uid).Return(nil, errors.New("internal error")).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrInternalError.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "Success",
+			url:     uri + uid.String(),
+			resType: &utils.Response{},
+			status:  http.StatusOK,
+			mockExpect: func() {
+				mctrl.EXPECT().GetPriceTimeline(gomock.Any(), uid).Return(&model.PriceTimeline{ItemID: uid}, nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				resp, ok := res.(*utils.Response)
+				require.True(t, ok)
+				assert.NotNil(t, resp.Data)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+
+				req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+				req = req.WithContext(ctx)
+
+				w := httptest.NewRecorder()
+				h.getPriceTimeline(w, req)
+
+				res := tt.resType
+				err := json.NewDecoder(w.Result().Body).Decode(res)
+				assert.Nil(t, err)
+
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+				tt.expectedResp(t, res)
+			},
+		)
+	}
+}
+
+func TestHandler_SchedulePriceChange(t *testing.T) {
+	const uri = "/api/item/prices/"
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	ctx := context.Background()
+	mctrl := mocks.NewMockCtrl(mock)
+	h := New(mctrl, nil)
+
+	uid := uuid.New()
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name         string
+		url          string
+		body         any
+		resType      any
+		status       int
+		mockExpect   func()
+		expectedResp func(*testing.T, any)
+	}{
+		{
+			name:       "InvalidUUID",
+			url:        uri + "invalid-uuid",
+			body:       map[string]any{"price": map[string]any{"amount": 1000, "currency": "RUB"}, "starts_at": future},
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Contains(t, errResp.Error, "invalid UUID")
+			},
+		},
+		{
+			name:       "StartsInThePast",
+			url:        uri + uid.String(),
+			body:       map[string]any{"price": map[string]any{"amount": 1000, "currency": "RUB"}, "starts_at": time.Now().Add(-time.Hour)},
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrInvalidStartsAt.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "NotFound",
+			url:     uri + uid.String(),
+			body:    map[string]any{"price": map[string]any{"amount": 1000, "currency": "RUB"}, "starts_at": future},
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusNotFound,
+			mockExpect: func() {
+				mctrl.EXPECT().SchedulePriceChange(gomock.Any(), gomock.Any()).Return(uint64(0), ctrl.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrNotFound.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "Success",
+			url:     uri + uid.String(),
+			body:    map[string]any{"price": map[string]any{"amount": 1000, "currency": "RUB"}, "starts_at": future},
+			resType: &utils.Response{},
+			status:  http.StatusCreated,
+			mockExpect: func() {
+				mctrl.EXPECT().SchedulePriceChange(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, sp *model.ScheduledPrice) (uint64, error) {
+						assert.Equal(t, uid, sp.ItemID)
+						return 1, nil
+					},
+				).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				resp, ok := res.(*utils.Response)
+				require.True(t, ok)
+				assert.Equal(t, float64(1), resp.Data)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+
+				payload, err := json.Marshal(tt.body)
+				require.NoError(t, err)
+
+				req := httptest.NewRequest(http.MethodPost, tt.url, bytes.NewBuffer(payload))
+				req.Header.Set("Content-Type", "application/json")
+				req = req.WithContext(ctx)
+
+				w := httptest.NewRecorder()
+				h.schedulePriceChange(w, req)
+
+				res := tt.resType
+				err = json.NewDecoder(w.Result().Body).Decode(res)
+				assert.Nil(t, err)
+
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+				tt.expectedResp(t, res)
+			},
+		)
+	}
+}
+
+func TestHandler_CancelScheduledPrice(t *testing.T) {
+	const uri = "/api/item/prices/scheduled/"
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	ctx := context.Background()
+	mctrl := mocks.NewMockCtrl(mock)
+	h := New(mctrl, nil)
+
+	tests := []struct {
+		name         string
+		url          string
+		resType      any
+		status       int
+		mockExpect   func()
+		expectedResp func(*testing.T, any)
+	}{
+		{
+			name:       "InvalidID",
+			url:        uri + "abc",
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				_, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+			},
+		},
+		{
+			name:    "NotFound",
+			url:     uri + "1",
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusNotFound,
+			mockExpect: func() {
+				mctrl.EXPECT().CancelScheduledPrice(gomock.Any(), uint64(1)).Return(ctrl.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrNotFound.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "Success",
+			url:     uri + "1",
+			resType: &utils.Response{},
+			status:  http.StatusNoContent,
+			mockExpect: func() {
+				mctrl.EXPECT().CancelScheduledPrice(gomock.Any(), uint64(1)).Return(nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				resp, ok := res.(*utils.Response)
+				require.True(t, ok)
+				assert.Equal(t, "OK", resp.Data)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+
+				req := httptest.NewRequest(http.MethodDelete, tt.url, nil)
+				req = req.WithContext(ctx)
+
+				w := httptest.NewRecorder()
+				h.cancelScheduledPrice(w, req)
+
+				res := tt.resType
+				err := json.NewDecoder(w.Result().Body).Decode(res)
+				assert.Nil(t, err)
+
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+				tt.expectedResp(t, res)
+			},
+		)
+	}
+}
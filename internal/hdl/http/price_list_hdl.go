@@ -0,0 +1,273 @@
+package http
+
+import (
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	mid "github.com/JMURv/par-pro/products/internal/hdl/http/middleware"
+	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	utils "github.com/JMURv/par-pro/products/pkg/utils/http"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func RegisterPriceListRoutes(mux *http.ServeMux, h *Handler) {
+	mux.HandleFunc(
+		"/api/price-lists/items/", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				mid.ApplyMiddleware(h.listPriceListItems, h.requirePermission(ctrl.PermPricingManage), h.authMiddleware)(w, r)
+			case http.MethodPut:
+				mid.ApplyMiddleware(h.setPriceListItems, h.requirePermission(ctrl.PermPricingManage), h.authMiddleware)(w, r)
+			case http.MethodDelete:
+				mid.ApplyMiddleware(h.deletePriceListItem, h.requirePermission(ctrl.PermPricingManage), h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	mux.HandleFunc(
+		"/api/price-lists", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				h.listPriceLists(w, r)
+			case http.MethodPost:
+				mid.ApplyMiddleware(h.createPriceList, h.requirePermission(ctrl.PermPricingManage), h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	mux.HandleFunc(
+		"/api/price-lists/", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				h.getPriceList(w, r)
+			case http.MethodPut:
+				mid.ApplyMiddleware(h.updatePriceList, h.requirePermission(ctrl.PermPricingManage), h.authMiddleware)(w, r)
+			case http.MethodDelete:
+				mid.ApplyMiddleware(h.deletePriceList, h.requirePermission(ctrl.PermPricingManage), h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+}
+
+func (h *Handler) listPriceLists(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "priceLists.listPriceLists.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	res, err := h.ctrl.ListPriceLists(r.Context())
+	if err != nil {
+		zap.L().Debug("failed to list price lists", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) getPriceList(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "priceLists.getPriceList.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	res, err := h.ctrl.GetPriceList(r.Context(), strings.TrimPrefix(r.URL.Path, "/api/price-lists/"))
+	if err != nil {
+		zap.L().Debug("failed to get price list", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) createPriceList(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusCreated
+	const op = "priceLists.createPriceList.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	req := &model.PriceList{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	if err := validation.PriceListValidation(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	res, err := h.ctrl.CreatePriceList(r.Context(), req)
+	if err != nil {
+		zap.L().Debug("failed to create price list", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) updatePriceList(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "priceLists.updatePriceList.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	req := &model.PriceList{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	if err := validation.PriceListValidation(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err := h.ctrl.UpdatePriceList(r.Context(), strings.TrimPrefix(r.URL.Path, "/api/price-lists/"), req)
+	if err != nil {
+		zap.L().Debug("failed to update price list", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
+
+func (h *Handler) deletePriceList(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusNoContent
+	const op = "priceLists.deletePriceList.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	err := h.ctrl.DeletePriceList(r.Context(), strings.TrimPrefix(r.URL.Path, "/api/price-lists/"))
+	if err != nil {
+		zap.L().Debug("failed to delete price list", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
+
+func (h *Handler) listPriceListItems(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "priceLists.listPriceListItems.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil {
+		page = consts.DefaultPage
+	}
+
+	size, err := strconv.Atoi(r.URL.Query().Get("size"))
+	if err != nil {
+		size = consts.DefaultPageSize
+	}
+
+	res, err := h.ctrl.ListPriceListItems(
+		r.Context(),
+		strings.TrimPrefix(r.URL.Path, "/api/price-lists/items/"),
+		page,
+		size,
+	)
+	if err != nil {
+		zap.L().Debug("failed to list price list items", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessPaginatedResponse(w, c, res)
+}
+
+func (h *Handler) setPriceListItems(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "priceLists.setPriceListItems.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	req := make([]*model.PriceListItem, 0, 10)
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	if err := validation.PriceListItemsValidation(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err := h.ctrl.SetPriceListItems(r.Context(), strings.TrimPrefix(r.URL.Path, "/api/price-lists/items/"), req)
+	if err != nil {
+		zap.L().Debug("failed to set price list items", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
+
+func (h *Handler) deletePriceListItem(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusNoContent
+	const op = "priceLists.deletePriceListItem.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	slug, itemID, ok := strings.Cut(strings.TrimPrefix(r.URL.Path, "/api/price-lists/items/"), "/")
+	if !ok {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, ctrl.ErrParseUUID)
+		return
+	}
+
+	itemUID, err := uuid.Parse(itemID)
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, ctrl.ErrParseUUID)
+		return
+	}
+
+	err = h.ctrl.DeletePriceListItem(r.Context(), slug, itemUID)
+	if err != nil {
+		zap.L().Debug("failed to delete price list item", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
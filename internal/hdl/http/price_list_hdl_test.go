@@ -0,0 +1,212 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	utils "github.com/JMURv/par-pro/products/pkg/utils/http"
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler_PriceListMiddleware(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	ctx := context.Background()
+	mctrl := mocks.NewMockCtrl(mock)
+	h := New(mctrl, nil)
+
+	pl := &model.PriceList{ID: 1, Slug: "kz", Currency: "KZT"}
+	next := h.priceListMiddleware(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				utils.SuccessResponse(w, http.StatusOK, ctrl.PriceListFromContext(r.Context()))
+			},
+		),
+	)
+
+	tests := []struct {
+		name         string
+		header       string
+		resType      any
+		status       int
+		mockExpect   func()
+		expectedResp func(*testing.T, *httptest.ResponseRecorder, any)
+	}{
+		{
+			name:       "No header",
+			resType:    &utils.Response{},
+			status:     http.StatusOK,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, w *httptest.ResponseRecorder, res any) {
+				resp, ok := res.(*utils.Response)
+				require.True(t, ok)
+				assert.Nil(t, resp.Data)
+			},
+		},
+		{
+			name:    "Unknown price list",
+			header:  "xx",
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusBadRequest,
+			mockExpect: func() {
+				mctrl.EXPECT().SelectPriceList(gomock.Any(), "xx").Return(nil, ctrl.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, w *httptest.ResponseRecorder, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrUnknownPriceList.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "Internal error",
+			header:  "kz",
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusInternalServerError,
+			mockExpect: func() {
+				mctrl.EXPECT().SelectPriceList(gomock.Any(), "kz").Return(nil, errors.New("internal error")).Times(1)
+			},
+			expectedResp: func(t *testing.T, w *httptest.ResponseRecorder, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrInternalError.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "Success",
+			header:  "kz",
+			resType: &utils.Response{},
+			status:  http.StatusOK,
+			mockExpect: func() {
+				mctrl.EXPECT().SelectPriceList(gomock.Any(), "kz").Return(pl, nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, w *httptest.ResponseRecorder, res any) {
+				resp, ok := res.(*utils.Response)
+				require.True(t, ok)
+				assert.NotNil(t, resp.Data)
+				assert.Equal(t, "kz", w.Header().Get(consts.PriceListHeader))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+
+				req := httptest.NewRequest(http.MethodGet, "/api/items", nil)
+				req = req.WithContext(ctx)
+				if tt.header != "" {
+					req.Header.Set(consts.PriceListHeader, tt.header)
+				}
+
+				w := httptest.NewRecorder()
+				next.ServeHTTP(w, req)
+
+				res := tt.resType
+				err := json.NewDecoder(w.Result().Body).Decode(res)
+				assert.Nil(t, err)
+
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+				tt.expectedResp(t, w, res)
+			},
+		)
+	}
+}
+
+func TestHandler_CreatePriceList(t *testing.T) {
+	const uri = "/api/price-lists"
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	ctx := context.Background()
+	mctrl := mocks.NewMockCtrl(mock)
+	h := New(mctrl, nil)
+
+	valid := &model.PriceList{Name: "Kazakhstan", Currency: "KZT", Rate: 5.47}
+
+	tests := []struct {
+		name         string
+		body         any
+		resType      any
+		status       int
+		mockExpect   func()
+		expectedResp func(*testing.T, any)
+	}{
+		{
+			name:       "Invalid rounding",
+			body:       &model.PriceList{Name: "Kazakhstan", Currency: "KZT", Rounding: "banker"},
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrInvalidRounding.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "Already exists",
+			body:    valid,
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusConflict,
+			mockExpect: func() {
+				mctrl.EXPECT().CreatePriceList(gomock.Any(), gomock.Any()).Return("", ctrl.ErrAlreadyExists).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrAlreadyExists.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "Success",
+			body:    valid,
+			resType: &utils.Response{},
+			status:  http.StatusCreated,
+			mockExpect: func() {
+				mctrl.EXPECT().CreatePriceList(gomock.Any(), gomock.Any()).Return("kazakhstan", nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				resp, ok := res.(*utils.Response)
+				require.True(t, ok)
+				assert.Equal(t, "kazakhstan", resp.Data)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+
+				payload, err := json.Marshal(tt.body)
+				require.NoError(t, err)
+
+				req := httptest.NewRequest(http.MethodPost, uri, bytes.NewBuffer(payload))
+				req = req.WithContext(ctx)
+
+				w := httptest.NewRecorder()
+				h.createPriceList(w, req)
+
+				res := tt.resType
+				err = json.NewDecoder(w.Result().Body).Decode(res)
+				assert.Nil(t, err)
+
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+				tt.expectedResp(t, res)
+			},
+		)
+	}
+}
@@ -0,0 +1,50 @@
+package http
+
+import (
+	"github.com/JMURv/par-pro/products/internal/apperr"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	utils "github.com/JMURv/par-pro/products/pkg/utils/http"
+	"net/http"
+)
+
+var errMissingAuthHeader = apperr.New(apperr.Unauthenticated, "authorization header is missing")
+var errInvalidTokenFormat = apperr.New(apperr.Unauthenticated, "invalid token format")
+
+var kindStatus = map[apperr.Kind]int{
+	apperr.Validation:         http.StatusBadRequest,
+	apperr.Unauthenticated:    http.StatusUnauthorized,
+	apperr.Forbidden:          http.StatusForbidden,
+	apperr.NotFound:           http.StatusNotFound,
+	apperr.AlreadyExists:      http.StatusConflict,
+	apperr.Conflict:           http.StatusConflict,
+	apperr.PreconditionFailed: http.StatusPreconditionFailed,
+	apperr.Unavailable:        http.StatusServiceUnavailable,
+}
+
+// errStatus returns the status of the response to a request that failed with err.
+func errStatus(err error) int {
+	if c, ok := kindStatus[apperr.KindOf(err)]; ok {
+		return c
+	}
+	return http.StatusInternalServerError
+}
+
+// errResponse writes err as a problem with the status of its kind and returns the status.
+// Errors of unknown kind are not disclosed to the client.
+func errResponse(w http.ResponseWriter, err error) int {
+	c := errStatus(err)
+	if c == http.StatusInternalServerError {
+		err = ctrl.ErrInternalError
+	}
+
+	utils.ErrResponse(w, c, err)
+	return c
+}
+
+// unauthenticated returns the error of a rejected token, failures of the SSO itself are not disclosed.
+func unauthenticated(err error) error {
+	if apperr.KindOf(err) == apperr.Unauthenticated {
+		return err
+	}
+	return ctrl.ErrUnauthenticated
+}
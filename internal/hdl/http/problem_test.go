@@ -0,0 +1,80 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	utils "github.com/JMURv/par-pro/products/pkg/utils/http"
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestErrResponse(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		detail string
+	}{
+		{
+			name:   "NotFound",
+			err:    ctrl.ErrNotFound,
+			status: http.StatusNotFound,
+			detail: ctrl.ErrNotFound.Error(),
+		},
+		{
+			name:   "UniqueViolation",
+			err:    fmt.Errorf("%w: article", repo.ErrAlreadyExists),
+			status: http.StatusConflict,
+			detail: "already exists: article",
+		},
+		{
+			name:   "Validation",
+			err:    validation.ErrMissingTitle,
+			status: http.StatusBadRequest,
+			detail: validation.ErrMissingTitle.Error(),
+		},
+		{
+			name:   "Forbidden",
+			err:    ctrl.ErrForbidden,
+			status: http.StatusForbidden,
+			detail: ctrl.ErrForbidden.Error(),
+		},
+		{
+			name:   "PreconditionFailed",
+			err:    ctrl.ErrVersionConflict,
+			status: http.StatusPreconditionFailed,
+			detail: ctrl.ErrVersionConflict.Error(),
+		},
+		{
+			name:   "Internal",
+			err:    errors.New("pq: connection refused"),
+			status: http.StatusInternalServerError,
+			detail: ctrl.ErrInternalError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				w := httptest.NewRecorder()
+				assert.Equal(t, tt.status, errResponse(w, tt.err))
+				assert.Equal(t, tt.status, w.Code)
+				assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+
+				res := &utils.ErrorResponse{}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(res))
+				assert.Equal(t, "about:blank", res.Type)
+				assert.Equal(t, http.StatusText(tt.status), res.Title)
+				assert.Equal(t, tt.status, res.Status)
+				assert.Equal(t, tt.detail, res.Detail)
+			},
+		)
+	}
+}
@@ -1,7 +1,6 @@
 package http
 
 import (
-	"errors"
 	"github.com/JMURv/par-pro/products/internal/ctrl"
 	mid "github.com/JMURv/par-pro/products/internal/hdl/http/middleware"
 	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
@@ -35,7 +34,7 @@ func RegisterPromotionRoutes(mux *http.ServeMux, h *Handler) {
 			case http.MethodGet:
 				h.listPromotions(w, r)
 			case http.MethodPost:
-				mid.ApplyMiddleware(h.createPromotion, h.authMiddleware)(w, r)
+				mid.ApplyMiddleware(h.createPromotion, h.requirePermission(ctrl.PermPromotionsManage), h.authMiddleware)(w, r)
 			default:
 				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
 			}
@@ -48,9 +47,11 @@ func RegisterPromotionRoutes(mux *http.ServeMux, h *Handler) {
 			case http.MethodGet:
 				h.getPromotion(w, r)
 			case http.MethodPut:
-				mid.ApplyMiddleware(h.updatePromotion, h.authMiddleware)(w, r)
+				mid.ApplyMiddleware(h.updatePromotion, h.requirePermission(ctrl.PermPromotionsManage), h.authMiddleware)(w, r)
+			case http.MethodPatch:
+				mid.ApplyMiddleware(h.patchPromotion, h.requirePermission(ctrl.PermPromotionsManage), h.authMiddleware)(w, r)
 			case http.MethodDelete:
-				mid.ApplyMiddleware(h.deletePromotion, h.authMiddleware)(w, r)
+				mid.ApplyMiddleware(h.deletePromotion, h.requirePermission(ctrl.PermPromotionsManage), h.authMiddleware)(w, r)
 			default:
 				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
 			}
@@ -84,8 +85,7 @@ func (h *Handler) promotionSearch(w http.ResponseWriter, r *http.Request) {
 	res, err := h.ctrl.PromotionSearch(r.Context(), query, page, size)
 	if err != nil {
 		zap.L().Debug("failed to search promotions", zap.String("op", op), zap.String("query", query), zap.Error(err))
-		c = http.StatusInternalServerError
-		utils.ErrResponse(w, c, err)
+		c = errResponse(w, err)
 		return
 	}
 
@@ -116,9 +116,8 @@ func (h *Handler) listPromotionItems(w http.ResponseWriter, r *http.Request) {
 		size,
 	)
 	if err != nil {
-		c = http.StatusInternalServerError
 		zap.L().Debug("failed to list promotion items", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, err)
+		c = errResponse(w, err)
 		return
 	}
 
@@ -144,9 +143,8 @@ func (h *Handler) listPromotions(w http.ResponseWriter, r *http.Request) {
 
 	res, err := h.ctrl.ListPromotions(r.Context(), page, size)
 	if err != nil {
-		c = http.StatusInternalServerError
 		zap.L().Debug("failed to list promotions", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, err)
+		c = errResponse(w, err)
 		return
 	}
 
@@ -161,18 +159,13 @@ func (h *Handler) getPromotion(w http.ResponseWriter, r *http.Request) {
 	}()
 
 	res, err := h.ctrl.GetPromotion(r.Context(), strings.TrimPrefix(r.URL.Path, "/api/promotions/"))
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = http.StatusNotFound
-		zap.L().Debug("failed to find promotion", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, err)
-		return
-	} else if err != nil {
-		c = http.StatusInternalServerError
+	if err != nil {
 		zap.L().Debug("failed to get promotion", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+		c = errResponse(w, err)
 		return
 	}
 
+	setETag(w, res.Version)
 	utils.SuccessResponse(w, c, res)
 }
 
@@ -200,9 +193,8 @@ func (h *Handler) createPromotion(w http.ResponseWriter, r *http.Request) {
 
 	res, err := h.ctrl.CreatePromotion(r.Context(), p)
 	if err != nil {
-		c = http.StatusInternalServerError
 		zap.L().Debug("failed to create promotion", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, err)
+		c = errResponse(w, err)
 		return
 	}
 
@@ -231,19 +223,67 @@ func (h *Handler) updatePromotion(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := h.ctrl.UpdatePromotion(r.Context(), strings.TrimPrefix(r.URL.Path, "/api/promotions/"), p)
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = http.StatusNotFound
-		zap.L().Debug("failed to find promotion", zap.String("op", op), zap.Error(err))
+	version, err := ifMatch(r, p.Version)
+	if err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to parse If-Match", zap.String("op", op), zap.Error(err))
 		utils.ErrResponse(w, c, err)
 		return
-	} else if err != nil {
-		c = http.StatusInternalServerError
+	}
+	p.Version = version
+
+	err = h.ctrl.UpdatePromotion(r.Context(), strings.TrimPrefix(r.URL.Path, "/api/promotions/"), p)
+	if err != nil {
 		zap.L().Debug("failed to update promotion", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+		c = errResponse(w, err)
 		return
 	}
 
+	setETag(w, p.Version)
+	utils.SuccessResponse(w, c, "OK")
+}
+
+// patchPromotion applies a JSON Merge Patch to the promotion, its items are left untouched unless the patch names them.
+func (h *Handler) patchPromotion(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "promo.patchPromotion.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	p := &model.Promotion{}
+	fields, err := decodeMergePatch(r, p)
+	if err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	if err = validation.PromotionPatchValidation(p, fields); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	version, err := ifMatch(r, p.Version)
+	if err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to parse If-Match", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+	p.Version = version
+
+	err = h.ctrl.PatchPromotion(r.Context(), strings.TrimPrefix(r.URL.Path, "/api/promotions/"), p, fields)
+	if err != nil {
+		zap.L().Debug("failed to patch promotion", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	setETag(w, p.Version)
 	utils.SuccessResponse(w, c, "OK")
 }
 
@@ -255,15 +295,9 @@ func (h *Handler) deletePromotion(w http.ResponseWriter, r *http.Request) {
 	}()
 
 	err := h.ctrl.DeletePromotion(r.Context(), strings.TrimPrefix(r.URL.Path, "/api/promotions/"))
-	if err != nil && errors.Is(err, ctrl.ErrNotFound) {
-		c = http.StatusNotFound
-		zap.L().Debug("failed to find promotion", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, err)
-		return
-	} else if err != nil {
-		c = http.StatusInternalServerError
+	if err != nil {
 		zap.L().Debug("failed to delete promotion", zap.String("op", op), zap.Error(err))
-		utils.ErrResponse(w, c, ctrl.ErrInternalError)
+		c = errResponse(w, err)
 		return
 	}
 
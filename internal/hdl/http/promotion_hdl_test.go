@@ -16,12 +16,14 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 var validPromotion = &model.Promotion{
 	Title:       "Test Promotion",
 	Description: "Test Description",
 	Src:         "test-src",
+	LastsTo:     time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second),
 }
 
 func TestHandler_PromotionSearch(t *testing.T) {
@@ -0,0 +1,465 @@
+package http
+
+import (
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	mid "github.com/JMURv/par-pro/products/internal/hdl/http/middleware"
+	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	utils "github.com/JMURv/par-pro/products/pkg/utils/http"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func RegisterQuestionRoutes(mux *http.ServeMux, h *Handler) {
+	mux.HandleFunc(
+		"/api/item/questions/", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				h.listItemQuestions(w, r)
+			case http.MethodPost:
+				mid.ApplyMiddleware(h.createQuestion, h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	// /api/questions/{id} and /api/questions/{id}/answers
+	mux.HandleFunc(
+		"/api/questions/", func(w http.ResponseWriter, r *http.Request) {
+			_, sub, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/api/questions/"), "/")
+			switch {
+			case sub == "" && r.Method == http.MethodDelete:
+				mid.ApplyMiddleware(h.deleteQuestion, h.authMiddleware)(w, r)
+			case sub == "answers" && r.Method == http.MethodPost:
+				mid.ApplyMiddleware(h.createAnswer, h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	mux.HandleFunc(
+		"/api/questions/moderation", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				mid.ApplyMiddleware(h.listPendingQuestions, h.requirePermission(ctrl.PermContentModerate), h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	mux.HandleFunc(
+		"/api/questions/moderation/", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodPut:
+				mid.ApplyMiddleware(h.moderateQuestion, h.requirePermission(ctrl.PermContentModerate), h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	// /api/answers/{id} and /api/answers/{id}/official
+	mux.HandleFunc(
+		"/api/answers/", func(w http.ResponseWriter, r *http.Request) {
+			_, sub, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/api/answers/"), "/")
+			switch {
+			case sub == "" && r.Method == http.MethodDelete:
+				mid.ApplyMiddleware(h.deleteAnswer, h.authMiddleware)(w, r)
+			case sub == "official" && r.Method == http.MethodPut:
+				mid.ApplyMiddleware(h.setAnswerOfficial, h.requirePermission(ctrl.PermContentModerate), h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	mux.HandleFunc(
+		"/api/answers/moderation", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				mid.ApplyMiddleware(h.listPendingAnswers, h.requirePermission(ctrl.PermContentModerate), h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	mux.HandleFunc(
+		"/api/answers/moderation/", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodPut:
+				mid.ApplyMiddleware(h.moderateAnswer, h.requirePermission(ctrl.PermContentModerate), h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+}
+
+func (h *Handler) listItemQuestions(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "questions.listItemQuestions.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	uid, err := uuid.Parse(strings.TrimPrefix(r.URL.Path, "/api/item/questions/"))
+	if err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, ctrl.ErrParseUUID)
+		return
+	}
+
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		page = consts.DefaultPage
+	}
+
+	size, err := strconv.Atoi(r.URL.Query().Get("size"))
+	if err != nil || size < 1 {
+		size = consts.DefaultPageSize
+	}
+
+	res, err := h.ctrl.ListItemQuestions(r.Context(), uid, page, size)
+	if err != nil {
+		zap.L().Debug("failed to list item questions", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) listPendingQuestions(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "questions.listPendingQuestions.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		page = consts.DefaultPage
+	}
+
+	size, err := strconv.Atoi(r.URL.Query().Get("size"))
+	if err != nil || size < 1 {
+		size = consts.DefaultPageSize
+	}
+
+	res, err := h.ctrl.ListPendingQuestions(r.Context(), page, size)
+	if err != nil {
+		zap.L().Debug("failed to list pending questions", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) createQuestion(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusCreated
+	const op = "questions.createQuestion.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	userID, err := uuid.Parse(ctrl.UserIDFromContext(r.Context()))
+	if err != nil {
+		c = http.StatusUnauthorized
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	itemID, err := uuid.Parse(strings.TrimPrefix(r.URL.Path, "/api/item/questions/"))
+	if err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, ctrl.ErrParseUUID)
+		return
+	}
+
+	req := &model.Question{}
+	if err = json.NewDecoder(r.Body).Decode(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+	req.ItemID, req.UserID = itemID, userID
+
+	if err = validation.QuestionValidation(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	res, err := h.ctrl.CreateQuestion(r.Context(), req)
+	if err != nil {
+		zap.L().Debug("failed to create question", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) deleteQuestion(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusNoContent
+	const op = "questions.deleteQuestion.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	userID, err := uuid.Parse(ctrl.UserIDFromContext(r.Context()))
+	if err != nil {
+		c = http.StatusUnauthorized
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	id, err := pathID(r, "/api/questions/")
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err = h.ctrl.DeleteQuestion(r.Context(), id, userID)
+	if err != nil {
+		zap.L().Debug("failed to delete question", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
+
+func (h *Handler) moderateQuestion(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "questions.moderateQuestion.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	id, err := pathID(r, "/api/questions/moderation/")
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	req := &model.Moderation{}
+	if err = json.NewDecoder(r.Body).Decode(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	if err = validation.ModerationValidation(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err = h.ctrl.ModerateQuestion(r.Context(), id, req)
+	if err != nil {
+		zap.L().Debug("failed to moderate question", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
+
+func (h *Handler) listPendingAnswers(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "questions.listPendingAnswers.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		page = consts.DefaultPage
+	}
+
+	size, err := strconv.Atoi(r.URL.Query().Get("size"))
+	if err != nil || size < 1 {
+		size = consts.DefaultPageSize
+	}
+
+	res, err := h.ctrl.ListPendingAnswers(r.Context(), page, size)
+	if err != nil {
+		zap.L().Debug("failed to list pending answers", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) createAnswer(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusCreated
+	const op = "questions.createAnswer.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	userID, err := uuid.Parse(ctrl.UserIDFromContext(r.Context()))
+	if err != nil {
+		c = http.StatusUnauthorized
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	id, err := pathID(r, "/api/questions/")
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	req := &model.Answer{}
+	if err = json.NewDecoder(r.Body).Decode(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+	req.QuestionID, req.UserID = id, userID
+
+	if err = validation.AnswerValidation(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	res, err := h.ctrl.CreateAnswer(r.Context(), req)
+	if err != nil {
+		zap.L().Debug("failed to create answer", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) moderateAnswer(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "questions.moderateAnswer.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	id, err := pathID(r, "/api/answers/moderation/")
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	req := &model.Moderation{}
+	if err = json.NewDecoder(r.Body).Decode(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	if err = validation.ModerationValidation(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err = h.ctrl.ModerateAnswer(r.Context(), id, req)
+	if err != nil {
+		zap.L().Debug("failed to moderate answer", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
+
+func (h *Handler) setAnswerOfficial(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "questions.setAnswerOfficial.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	id, err := pathID(r, "/api/answers/")
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	req := &struct {
+		Official bool `json:"official"`
+	}{}
+	if err = json.NewDecoder(r.Body).Decode(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err = h.ctrl.SetAnswerOfficial(r.Context(), id, req.Official)
+	if err != nil {
+		zap.L().Debug("failed to set answer official", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
+
+func (h *Handler) deleteAnswer(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusNoContent
+	const op = "questions.deleteAnswer.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	userID, err := uuid.Parse(ctrl.UserIDFromContext(r.Context()))
+	if err != nil {
+		c = http.StatusUnauthorized
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	id, err := pathID(r, "/api/answers/")
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err = h.ctrl.DeleteAnswer(r.Context(), id, userID)
+	if err != nil {
+		zap.L().Debug("failed to delete answer", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
@@ -0,0 +1,186 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	utils "github.com/JMURv/par-pro/products/pkg/utils/http"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler_CreateAnswer(t *testing.T) {
+	const uri = "/api/questions/"
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	userID := uuid.New()
+	ctx := ctrl.WithUserID(context.Background(), userID.String())
+
+	mctrl := mocks.NewMockCtrl(mock)
+	h := New(mctrl, nil)
+
+	tests := []struct {
+		name         string
+		url          string
+		body         any
+		resType      any
+		status       int
+		mockExpect   func()
+		expectedResp func(*testing.T, any)
+	}{
+		{
+			name:       "InvalidID",
+			url:        uri + "abc/answers",
+			body:       &model.Answer{Text: "Yes"},
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				_, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+			},
+		},
+		{
+			name:       "EmptyText",
+			url:        uri + "1/answers",
+			body:       &model.Answer{Text: " "},
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrEmptyQuestion.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "QuestionNotFound",
+			url:     uri + "1/answers",
+			body:    &model.Answer{Text: "Yes"},
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusNotFound,
+			mockExpect: func() {
+				mctrl.EXPECT().CreateAnswer(gomock.Any(), gomock.Any()).Return(uint64(0), ctrl.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrNotFound.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "Success",
+			url:     uri + "1/answers",
+			body:    &model.Answer{Text: "Yes", Official: true},
+			resType: &utils.Response{},
+			status:  http.StatusCreated,
+			mockExpect: func() {
+				mctrl.EXPECT().CreateAnswer(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, a *model.Answer) (uint64, error) {
+						assert.Equal(t, uint64(1), a.QuestionID)
+						assert.Equal(t, userID, a.UserID)
+						return 5, nil
+					},
+				).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				resp, ok := res.(*utils.Response)
+				require.True(t, ok)
+				assert.Equal(t, float64(5), resp.Data)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+
+				bodyBytes, err := json.Marshal(tt.body)
+				require.NoError(t, err)
+
+				req := httptest.NewRequest(http.MethodPost, tt.url, bytes.NewReader(bodyBytes))
+				req.Header.Set("Content-Type", "application/json")
+				req = req.WithContext(ctx)
+
+				w := httptest.NewRecorder()
+				h.createAnswer(w, req)
+
+				res := tt.resType
+				err = json.NewDecoder(w.Result().Body).Decode(res)
+				assert.Nil(t, err)
+
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+				tt.expectedResp(t, res)
+			},
+		)
+	}
+}
+
+func TestHandler_SetAnswerOfficial(t *testing.T) {
+	const uri = "/api/answers/"
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	h := New(mctrl, nil)
+
+	tests := []struct {
+		name       string
+		url        string
+		body       string
+		status     int
+		mockExpect func()
+	}{
+		{
+			name:       "InvalidBody",
+			url:        uri + "4/official",
+			body:       `{"official": "yes"}`,
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+		},
+		{
+			name:   "NotFound",
+			url:    uri + "4/official",
+			body:   `{"official": true}`,
+			status: http.StatusNotFound,
+			mockExpect: func() {
+				mctrl.EXPECT().SetAnswerOfficial(gomock.Any(), uint64(4), true).Return(ctrl.ErrNotFound).Times(1)
+			},
+		},
+		{
+			name:   "Success",
+			url:    uri + "4/official",
+			body:   `{"official": false}`,
+			status: http.StatusOK,
+			mockExpect: func() {
+				mctrl.EXPECT().SetAnswerOfficial(gomock.Any(), uint64(4), false).Return(nil).Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+
+				req := httptest.NewRequest(http.MethodPut, tt.url, bytes.NewBufferString(tt.body))
+				req.Header.Set("Content-Type", "application/json")
+
+				w := httptest.NewRecorder()
+				h.setAnswerOfficial(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
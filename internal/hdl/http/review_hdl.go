@@ -0,0 +1,369 @@
+package http
+
+import (
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	mid "github.com/JMURv/par-pro/products/internal/hdl/http/middleware"
+	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	utils "github.com/JMURv/par-pro/products/pkg/utils/http"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func RegisterReviewRoutes(mux *http.ServeMux, h *Handler) {
+	mux.HandleFunc(
+		"/api/item/reviews/", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				h.listItemReviews(w, r)
+			case http.MethodPost:
+				mid.ApplyMiddleware(h.createReview, h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	// /api/reviews/{id} and /api/reviews/{id}/media
+	mux.HandleFunc(
+		"/api/reviews/", func(w http.ResponseWriter, r *http.Request) {
+			_, sub, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/api/reviews/"), "/")
+			switch {
+			case sub == "" && r.Method == http.MethodPut:
+				mid.ApplyMiddleware(h.updateReview, h.authMiddleware)(w, r)
+			case sub == "" && r.Method == http.MethodDelete:
+				mid.ApplyMiddleware(h.deleteReview, h.authMiddleware)(w, r)
+			case sub == "media" && r.Method == http.MethodPost:
+				mid.ApplyMiddleware(h.uploadReviewMedia, h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	mux.HandleFunc(
+		"/api/reviews/moderation", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				mid.ApplyMiddleware(h.listPendingReviews, h.requirePermission(ctrl.PermContentModerate), h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+
+	mux.HandleFunc(
+		"/api/reviews/moderation/", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodPut:
+				mid.ApplyMiddleware(h.moderateReview, h.requirePermission(ctrl.PermContentModerate), h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+}
+
+func pathID(r *http.Request, prefix string) (uint64, error) {
+	id, _, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, prefix), "/")
+	return strconv.ParseUint(id, 10, 64)
+}
+
+func (h *Handler) listItemReviews(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "reviews.listItemReviews.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	uid, err := uuid.Parse(strings.TrimPrefix(r.URL.Path, "/api/item/reviews/"))
+	if err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, ctrl.ErrParseUUID)
+		return
+	}
+
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		page = consts.DefaultPage
+	}
+
+	size, err := strconv.Atoi(r.URL.Query().Get("size"))
+	if err != nil || size < 1 {
+		size = consts.DefaultPageSize
+	}
+
+	res, err := h.ctrl.ListItemReviews(r.Context(), uid, page, size)
+	if err != nil {
+		zap.L().Debug("failed to list item reviews", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) listPendingReviews(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "reviews.listPendingReviews.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		page = consts.DefaultPage
+	}
+
+	size, err := strconv.Atoi(r.URL.Query().Get("size"))
+	if err != nil || size < 1 {
+		size = consts.DefaultPageSize
+	}
+
+	res, err := h.ctrl.ListPendingReviews(r.Context(), page, size)
+	if err != nil {
+		zap.L().Debug("failed to list pending reviews", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) createReview(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusCreated
+	const op = "reviews.createReview.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	userID, err := uuid.Parse(ctrl.UserIDFromContext(r.Context()))
+	if err != nil {
+		c = http.StatusUnauthorized
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	itemID, err := uuid.Parse(strings.TrimPrefix(r.URL.Path, "/api/item/reviews/"))
+	if err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to parse uuid", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, ctrl.ErrParseUUID)
+		return
+	}
+
+	req := &model.Review{}
+	if err = json.NewDecoder(r.Body).Decode(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+	req.ItemID, req.UserID = itemID, userID
+
+	if err = validation.ReviewValidation(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	res, err := h.ctrl.CreateReview(r.Context(), req)
+	if err != nil {
+		zap.L().Debug("failed to create review", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) updateReview(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "reviews.updateReview.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	userID, err := uuid.Parse(ctrl.UserIDFromContext(r.Context()))
+	if err != nil {
+		c = http.StatusUnauthorized
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	id, err := pathID(r, "/api/reviews/")
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	req := &model.Review{}
+	if err = json.NewDecoder(r.Body).Decode(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+	req.ID, req.UserID = id, userID
+
+	if err = validation.ReviewValidation(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err = h.ctrl.UpdateReview(r.Context(), req)
+	if err != nil {
+		zap.L().Debug("failed to update review", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
+
+func (h *Handler) deleteReview(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusNoContent
+	const op = "reviews.deleteReview.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	userID, err := uuid.Parse(ctrl.UserIDFromContext(r.Context()))
+	if err != nil {
+		c = http.StatusUnauthorized
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	id, err := pathID(r, "/api/reviews/")
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err = h.ctrl.DeleteReview(r.Context(), id, userID)
+	if err != nil {
+		zap.L().Debug("failed to delete review", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
+
+func (h *Handler) moderateReview(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "reviews.moderateReview.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	id, err := pathID(r, "/api/reviews/moderation/")
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	req := &model.Moderation{}
+	if err = json.NewDecoder(r.Body).Decode(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to decode request", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	if err = validation.ModerationValidation(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err = h.ctrl.ModerateReview(r.Context(), id, req)
+	if err != nil {
+		zap.L().Debug("failed to moderate review", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
+
+func (h *Handler) uploadReviewMedia(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusCreated
+	const op = "reviews.uploadReviewMedia.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	userID, err := uuid.Parse(ctrl.UserIDFromContext(r.Context()))
+	if err != nil {
+		c = http.StatusUnauthorized
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	id, err := pathID(r, "/api/reviews/")
+	if err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, consts.MaxMediaSize+multipartOverhead)
+	if err = r.ParseMultipartForm(consts.MaxMediaSize); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to parse multipart form", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, ctrl.ErrDecodeRequest)
+		return
+	}
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to read file", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, validation.ErrEmptyFile)
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, consts.MaxMediaSize+1))
+	if err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to read file", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, ctrl.ErrDecodeRequest)
+		return
+	}
+
+	req := &model.MediaUpload{Data: data}
+	if err = validation.MediaUploadValidation(req); err != nil {
+		c = http.StatusBadRequest
+		zap.L().Debug("failed to validate obj", zap.String("op", op), zap.Error(err))
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	res, err := h.ctrl.UploadReviewMedia(r.Context(), userID, id, req)
+	if err != nil {
+		zap.L().Debug("failed to upload review media", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
@@ -0,0 +1,231 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	utils "github.com/JMURv/par-pro/products/pkg/utils/http"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler_CreateReview(t *testing.T) {
+	const uri = "/api/item/reviews/"
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	userID := uuid.New()
+	ctx := ctrl.WithUserID(context.Background(), userID.String())
+
+	mctrl := mocks.NewMockCtrl(mock)
+	h := New(mctrl, nil)
+
+	tests := []struct {
+		name         string
+		id           string
+		body         any
+		resType      any
+		status       int
+		mockExpect   func()
+		expectedResp func(*testing.T, any)
+	}{
+		{
+			name:       "InvalidUUID",
+			id:         "invalid",
+			body:       &model.Review{Rating: 5, Text: "Great"},
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrParseUUID.Error(), errResp.Error)
+			},
+		},
+		{
+			name:       "InvalidRating",
+			id:         validUUID.String(),
+			body:       &model.Review{Rating: 6, Text: "Great"},
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrInvalidRating.Error(), errResp.Error)
+			},
+		},
+		{
+			name:       "EmptyReview",
+			id:         validUUID.String(),
+			body:       &model.Review{Rating: 5, Text: "  "},
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrEmptyReview.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "NotPurchased",
+			id:      validUUID.String(),
+			body:    &model.Review{Rating: 5, Text: "Great"},
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusForbidden,
+			mockExpect: func() {
+				mctrl.EXPECT().CreateReview(gomock.Any(), gomock.Any()).Return(uint64(0), ctrl.ErrNotPurchased).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrNotPurchased.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "Success",
+			id:      validUUID.String(),
+			body:    &model.Review{Rating: 4, Pros: "Light", Status: model.ReviewStatusApproved},
+			resType: &utils.Response{},
+			status:  http.StatusCreated,
+			mockExpect: func() {
+				mctrl.EXPECT().CreateReview(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, r *model.Review) (uint64, error) {
+						assert.Equal(t, validUUID, r.ItemID)
+						assert.Equal(t, userID, r.UserID)
+						return 3, nil
+					},
+				).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				resp, ok := res.(*utils.Response)
+				require.True(t, ok)
+				assert.Equal(t, float64(3), resp.Data)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+
+				bodyBytes, err := json.Marshal(tt.body)
+				require.NoError(t, err)
+
+				req := httptest.NewRequest(http.MethodPost, uri+tt.id, bytes.NewReader(bodyBytes))
+				req.Header.Set("Content-Type", "application/json")
+				req = req.WithContext(ctx)
+
+				w := httptest.NewRecorder()
+				h.createReview(w, req)
+
+				res := tt.resType
+				err = json.NewDecoder(w.Result().Body).Decode(res)
+				assert.Nil(t, err)
+
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+				tt.expectedResp(t, res)
+			},
+		)
+	}
+}
+
+func TestHandler_ModerateReview(t *testing.T) {
+	const uri = "/api/reviews/moderation/"
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	h := New(mctrl, nil)
+
+	tests := []struct {
+		name         string
+		id           string
+		body         any
+		resType      any
+		status       int
+		mockExpect   func()
+		expectedResp func(*testing.T, any)
+	}{
+		{
+			name:       "InvalidStatus",
+			id:         "1",
+			body:       &model.Moderation{Status: model.ReviewStatusPending},
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrInvalidModerationStatus.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "NotFound",
+			id:      "1",
+			body:    &model.Moderation{Status: model.ReviewStatusRejected, Note: "Spam"},
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusNotFound,
+			mockExpect: func() {
+				mctrl.EXPECT().ModerateReview(gomock.Any(), uint64(1), gomock.Any()).Return(ctrl.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrNotFound.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "Success",
+			id:      "1",
+			body:    &model.Moderation{Status: model.ReviewStatusApproved},
+			resType: &utils.Response{},
+			status:  http.StatusOK,
+			mockExpect: func() {
+				mctrl.EXPECT().ModerateReview(
+					gomock.Any(), uint64(1), &model.Moderation{Status: model.ReviewStatusApproved},
+				).Return(nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				resp, ok := res.(*utils.Response)
+				require.True(t, ok)
+				assert.Equal(t, "OK", resp.Data)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+
+				bodyBytes, err := json.Marshal(tt.body)
+				require.NoError(t, err)
+
+				req := httptest.NewRequest(http.MethodPut, uri+tt.id, bytes.NewReader(bodyBytes))
+				req.Header.Set("Content-Type", "application/json")
+
+				w := httptest.NewRecorder()
+				h.moderateReview(w, req)
+
+				res := tt.resType
+				err = json.NewDecoder(w.Result().Body).Decode(res)
+				assert.Nil(t, err)
+
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+				tt.expectedResp(t, res)
+			},
+		)
+	}
+}
@@ -0,0 +1,155 @@
+package http
+
+import (
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	mid "github.com/JMURv/par-pro/products/internal/hdl/http/middleware"
+	metrics "github.com/JMURv/par-pro/products/internal/metrics/prometheus"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	utils "github.com/JMURv/par-pro/products/pkg/utils/http"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func RegisterTrashRoutes(mux *http.ServeMux, h *Handler) {
+	mux.HandleFunc(
+		"/api/trash", mid.ApplyMiddleware(
+			h.listTrash, mid.MethodNotAllowed(http.MethodGet), h.requirePermission(ctrl.PermTrashManage), h.authMiddleware,
+		),
+	)
+
+	// /api/trash/{kind}, /api/trash/{kind}/{id} and /api/trash/{kind}/{id}/restore
+	mux.HandleFunc(
+		"/api/trash/", func(w http.ResponseWriter, r *http.Request) {
+			_, rest, _ := trashPath(r)
+			id, sub, _ := strings.Cut(rest, "/")
+			switch {
+			case id == "" && r.Method == http.MethodGet:
+				mid.ApplyMiddleware(h.listTrash, h.requirePermission(ctrl.PermTrashManage), h.authMiddleware)(w, r)
+			case id != "" && sub == "" && r.Method == http.MethodPost:
+				mid.ApplyMiddleware(h.moveToTrash, h.requirePermission(ctrl.PermTrashManage), h.authMiddleware)(w, r)
+			case id != "" && sub == "" && r.Method == http.MethodDelete:
+				mid.ApplyMiddleware(h.purgeFromTrash, h.requirePermission(ctrl.PermTrashManage), h.authMiddleware)(w, r)
+			case sub == "restore" && r.Method == http.MethodPost:
+				mid.ApplyMiddleware(h.restoreFromTrash, h.requirePermission(ctrl.PermTrashManage), h.authMiddleware)(w, r)
+			default:
+				utils.ErrResponse(w, http.StatusMethodNotAllowed, mid.ErrMethodNotAllowed)
+			}
+		},
+	)
+}
+
+// trashPath splits /api/trash/{kind}/{id}/... into the kind and the rest of the path.
+func trashPath(r *http.Request) (string, string, bool) {
+	return strings.Cut(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/trash"), "/"), "/")
+}
+
+func (h *Handler) listTrash(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "trash.listTrash.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		page = consts.DefaultPage
+	}
+
+	size, err := strconv.Atoi(r.URL.Query().Get("size"))
+	if err != nil || size < 1 {
+		size = consts.DefaultPageSize
+	}
+
+	kind, _, _ := trashPath(r)
+	if err = validation.TrashKindValidation(kind); err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	res, err := h.ctrl.ListTrash(r.Context(), kind, page, size)
+	if err != nil {
+		zap.L().Debug("failed to list trash", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, res)
+}
+
+func (h *Handler) moveToTrash(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "trash.moveToTrash.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	kind, id, _ := trashPath(r)
+	if err := validation.TrashEntryValidation(kind, id); err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err := h.ctrl.MoveToTrash(r.Context(), kind, id)
+	if err != nil {
+		zap.L().Debug("failed to move to trash", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
+
+func (h *Handler) restoreFromTrash(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "trash.restoreFromTrash.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	kind, id, _ := trashPath(r)
+	id = strings.TrimSuffix(id, "/restore")
+	if err := validation.TrashEntryValidation(kind, id); err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err := h.ctrl.RestoreFromTrash(r.Context(), kind, id)
+	if err != nil {
+		zap.L().Debug("failed to restore from trash", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
+
+func (h *Handler) purgeFromTrash(w http.ResponseWriter, r *http.Request) {
+	s, c := time.Now(), http.StatusOK
+	const op = "trash.purgeFromTrash.handler"
+	defer func() {
+		metrics.ObserveRequest(time.Since(s), c, op)
+	}()
+
+	kind, id, _ := trashPath(r)
+	if err := validation.TrashEntryValidation(kind, id); err != nil {
+		c = http.StatusBadRequest
+		utils.ErrResponse(w, c, err)
+		return
+	}
+
+	err := h.ctrl.PurgeFromTrash(r.Context(), kind, id)
+	if err != nil {
+		zap.L().Debug("failed to purge from trash", zap.String("op", op), zap.Error(err))
+		c = errResponse(w, err)
+		return
+	}
+
+	utils.SuccessResponse(w, c, "OK")
+}
@@ -0,0 +1,134 @@
+package http
+
+import (
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	"github.com/JMURv/par-pro/products/internal/validation"
+	"github.com/JMURv/par-pro/products/mocks"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	utils "github.com/JMURv/par-pro/products/pkg/utils/http"
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler_PurgeFromTrash(t *testing.T) {
+	const uri = "/api/trash/"
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	h := New(mctrl, nil)
+
+	tests := []struct {
+		name         string
+		path         string
+		resType      any
+		status       int
+		mockExpect   func()
+		expectedResp func(*testing.T, any)
+	}{
+		{
+			name:       "InvalidKind",
+			path:       "label/1",
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrInvalidTrashKind.Error(), errResp.Error)
+			},
+		},
+		{
+			name:       "InvalidID",
+			path:       "order/abc",
+			resType:    &utils.ErrorResponse{},
+			status:     http.StatusBadRequest,
+			mockExpect: func() {},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, validation.ErrInvalidTrashID.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "NotFound",
+			path:    "category/shoes",
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusNotFound,
+			mockExpect: func() {
+				mctrl.EXPECT().PurgeFromTrash(gomock.Any(), model.TrashCategory, "shoes").Return(ctrl.ErrNotFound).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrNotFound.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "StillOrdered",
+			path:    "item/" + validUUID.String(),
+			resType: &utils.ErrorResponse{},
+			status:  http.StatusConflict,
+			mockExpect: func() {
+				mctrl.EXPECT().PurgeFromTrash(gomock.Any(), model.TrashItem, validUUID.String()).Return(ctrl.ErrStillOrdered).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				errResp, ok := res.(*utils.ErrorResponse)
+				require.True(t, ok)
+				assert.Equal(t, ctrl.ErrStillOrdered.Error(), errResp.Error)
+			},
+		},
+		{
+			name:    "Success",
+			path:    "order/12",
+			resType: &utils.Response{},
+			status:  http.StatusOK,
+			mockExpect: func() {
+				mctrl.EXPECT().PurgeFromTrash(gomock.Any(), model.TrashOrder, "12").Return(nil).Times(1)
+			},
+			expectedResp: func(t *testing.T, res any) {
+				_, ok := res.(*utils.Response)
+				require.True(t, ok)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+
+				req := httptest.NewRequest(http.MethodDelete, uri+tt.path, nil)
+				w := httptest.NewRecorder()
+				h.purgeFromTrash(w, req)
+
+				res := tt.resType
+				err := json.NewDecoder(w.Result().Body).Decode(res)
+				assert.Nil(t, err)
+
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+				tt.expectedResp(t, res)
+			},
+		)
+	}
+}
+
+func TestHandler_RestoreFromTrash(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockCtrl(mock)
+	h := New(mctrl, nil)
+
+	mctrl.EXPECT().RestoreFromTrash(gomock.Any(), model.TrashPromotion, "black-friday").Return(nil).Times(1)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/trash/promotion/black-friday/restore", nil)
+	w := httptest.NewRecorder()
+	h.restoreFromTrash(w, req)
+	assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+}
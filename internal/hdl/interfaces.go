@@ -7,9 +7,21 @@ import (
 )
 
 type Ctrl interface {
-	ListFavorites(ctx context.Context, uid uuid.UUID) ([]*model.Favorite, error)
+	ListFavorites(ctx context.Context, uid uuid.UUID, page, size int, sort string) (*model.PaginatedFavoritesData, error)
 	AddToFavorites(ctx context.Context, uid uuid.UUID, itemID uuid.UUID) (*model.Favorite, error)
 	RemoveFromFavorites(ctx context.Context, uid uuid.UUID, itemID uuid.UUID) error
+	SetFavoriteNotifications(ctx context.Context, uid uuid.UUID, req *model.Favorite) error
+
+	ListFavoriteCollections(ctx context.Context, uid uuid.UUID) ([]*model.FavoriteCollection, error)
+	GetFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64) (*model.FavoriteCollection, error)
+	GetSharedFavoriteCollection(ctx context.Context, token string) (*model.FavoriteCollection, error)
+	CreateFavoriteCollection(ctx context.Context, uid uuid.UUID, req *model.FavoriteCollection) (uint64, error)
+	UpdateFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64, req *model.FavoriteCollection) error
+	DeleteFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64) error
+	ShareFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64) (string, error)
+	UnshareFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64) error
+	SetFavoriteCollectionItem(ctx context.Context, uid uuid.UUID, id uint64, req *model.FavoriteCollectionItem) error
+	RemoveFavoriteCollectionItem(ctx context.Context, uid uuid.UUID, id uint64, itemID uuid.UUID) error
 
 	ListCategoryItems(ctx context.Context, slug string, page int, size int, filters map[string]any, sort string) (*model.PaginatedItemsData, error)
 	ItemAttrSearch(ctx context.Context, query string, size int, page int) (*model.PaginatedItemAttrData, error)
@@ -20,23 +32,90 @@ type Ctrl interface {
 	GetItemByUUID(ctx context.Context, uid uuid.UUID) (*model.Item, error)
 	CreateItem(ctx context.Context, i *model.Item) (uuid.UUID, error)
 	UpdateItem(ctx context.Context, uid uuid.UUID, i *model.Item) error
+	PatchItem(ctx context.Context, uid uuid.UUID, i *model.Item, fields []string) error
 	DeleteItem(ctx context.Context, uid uuid.UUID) error
 
+	ListItemMedia(ctx context.Context, itemID uuid.UUID) ([]*model.ItemMedia, error)
+	UploadItemMedia(ctx context.Context, itemID uuid.UUID, req *model.MediaUpload) (*model.ItemMedia, error)
+	UpdateItemMedia(ctx context.Context, itemID uuid.UUID, id uint64, req *model.ItemMedia) error
+	ReorderItemMedia(ctx context.Context, itemID uuid.UUID, ids []uint64) error
+	DeleteItemMedia(ctx context.Context, itemID uuid.UUID, id uint64) error
+
+	GetPriceTimeline(ctx context.Context, uid uuid.UUID) (*model.PriceTimeline, error)
+	SchedulePriceChange(ctx context.Context, sp *model.ScheduledPrice) (uint64, error)
+	CancelScheduledPrice(ctx context.Context, id uint64) error
+
+	ListPriceLists(ctx context.Context) ([]*model.PriceList, error)
+	GetPriceList(ctx context.Context, slug string) (*model.PriceList, error)
+	CreatePriceList(ctx context.Context, pl *model.PriceList) (string, error)
+	UpdatePriceList(ctx context.Context, slug string, pl *model.PriceList) error
+	DeletePriceList(ctx context.Context, slug string) error
+	ListPriceListItems(ctx context.Context, slug string, page, size int) (*model.PaginatedPriceListItemsData, error)
+	SetPriceListItems(ctx context.Context, slug string, items []*model.PriceListItem) error
+	DeletePriceListItem(ctx context.Context, slug string, itemID uuid.UUID) error
+	SelectPriceList(ctx context.Context, slug string) (*model.PriceList, error)
+
+	ListCustomerGroups(ctx context.Context) ([]*model.CustomerGroup, error)
+	GetCustomerGroup(ctx context.Context, slug string) (*model.CustomerGroup, error)
+	CreateCustomerGroup(ctx context.Context, g *model.CustomerGroup) (string, error)
+	UpdateCustomerGroup(ctx context.Context, slug string, g *model.CustomerGroup) error
+	DeleteCustomerGroup(ctx context.Context, slug string) error
+	ResolveCustomerGroup(ctx context.Context, slugs []string) (*model.CustomerGroup, error)
+	ListQuantityBreaks(ctx context.Context, itemID uuid.UUID) ([]model.QuantityBreak, error)
+	SetQuantityBreaks(ctx context.Context, itemID uuid.UUID, breaks []model.QuantityBreak) error
+
 	CategoryFiltersSearch(ctx context.Context, query string, page int, size int) (*model.PaginatedFilterData, error)
 	CategorySearch(ctx context.Context, query string, page int, size int) (*model.PaginatedCategoryData, error)
 	ListCategoryFilters(ctx context.Context, slug string) ([]*model.Filter, error)
+	RebuildCategoryFilters(ctx context.Context, slug string) error
 	ListCategories(ctx context.Context, page int, size int) (*model.PaginatedCategoryData, error)
 	GetCategoryBySlug(ctx context.Context, slug string) (*model.Category, error)
 	CreateCategory(ctx context.Context, category *model.Category) (string, error)
 	UpdateCategory(ctx context.Context, slug string, category *model.Category) error
+	PatchCategory(ctx context.Context, slug string, category *model.Category, fields []string) error
 	DeleteCategory(ctx context.Context, slug string) error
 
+	ListAttributes(ctx context.Context) ([]*model.Attribute, error)
+	GetAttribute(ctx context.Context, slug string) (*model.Attribute, error)
+	CreateAttribute(ctx context.Context, a *model.Attribute) (string, error)
+	UpdateAttribute(ctx context.Context, slug string, a *model.Attribute) error
+	DeleteAttribute(ctx context.Context, slug string) error
+	ListCategoryAttributes(ctx context.Context, slug string) ([]*model.CategoryAttribute, error)
+	SetCategoryAttributes(ctx context.Context, slug string, attrs []*model.CategoryAttribute) error
+	GetAttributeSchema(ctx context.Context, slugs []string) ([]*model.CategoryAttribute, error)
+
+	ListLabels(ctx context.Context) ([]*model.Label, error)
+	CreateLabel(ctx context.Context, l *model.Label) (uint64, error)
+	UpdateLabel(ctx context.Context, name string, l *model.Label) error
+	DeleteLabel(ctx context.Context, name string) error
+	SetItemLabels(ctx context.Context, uid uuid.UUID, names []string) error
+
+	ListItemReviews(ctx context.Context, uid uuid.UUID, page, size int) (*model.PaginatedReviewsData, error)
+	ListPendingReviews(ctx context.Context, page, size int) (*model.PaginatedReviewsData, error)
+	CreateReview(ctx context.Context, req *model.Review) (uint64, error)
+	UpdateReview(ctx context.Context, req *model.Review) error
+	ModerateReview(ctx context.Context, id uint64, req *model.Moderation) error
+	DeleteReview(ctx context.Context, id uint64, userID uuid.UUID) error
+	UploadReviewMedia(ctx context.Context, userID uuid.UUID, id uint64, req *model.MediaUpload) (*model.ReviewMedia, error)
+
+	ListItemQuestions(ctx context.Context, uid uuid.UUID, page, size int) (*model.PaginatedQuestionsData, error)
+	ListPendingQuestions(ctx context.Context, page, size int) (*model.PaginatedQuestionsData, error)
+	CreateQuestion(ctx context.Context, req *model.Question) (uint64, error)
+	ModerateQuestion(ctx context.Context, id uint64, req *model.Moderation) error
+	DeleteQuestion(ctx context.Context, id uint64, userID uuid.UUID) error
+	ListPendingAnswers(ctx context.Context, page, size int) (*model.PaginatedAnswersData, error)
+	CreateAnswer(ctx context.Context, req *model.Answer) (uint64, error)
+	ModerateAnswer(ctx context.Context, id uint64, req *model.Moderation) error
+	SetAnswerOfficial(ctx context.Context, id uint64, official bool) error
+	DeleteAnswer(ctx context.Context, id uint64, userID uuid.UUID) error
+
 	PromotionSearch(ctx context.Context, query string, page int, size int) (*model.PaginatedPromosData, error)
 	ListPromotionItems(ctx context.Context, slug string, page int, size int) (*model.PaginatedPromoItemsData, error)
 	ListPromotions(ctx context.Context, page int, size int) (*model.PaginatedPromosData, error)
 	GetPromotion(ctx context.Context, slug string) (*model.Promotion, error)
 	CreatePromotion(ctx context.Context, p *model.Promotion) (string, error)
 	UpdatePromotion(ctx context.Context, slug string, p *model.Promotion) error
+	PatchPromotion(ctx context.Context, slug string, p *model.Promotion, fields []string) error
 	DeletePromotion(ctx context.Context, slug string) error
 
 	ListOrders(ctx context.Context, page, size int, filters map[string]any, sort string) (*model.PaginatedOrderData, error)
@@ -44,5 +123,22 @@ type Ctrl interface {
 	GetOrder(ctx context.Context, orderID uint64) (*model.Order, error)
 	CreateOrder(ctx context.Context, uid uuid.UUID, req *model.Order) (uint64, error)
 	UpdateOrder(ctx context.Context, orderID uint64, newData *model.Order) error
+	PatchOrder(ctx context.Context, orderID uint64, newData *model.Order, fields []string) error
 	CancelOrder(ctx context.Context, orderID uint64) error
+	DeleteOrder(ctx context.Context, orderID uint64) error
+
+	BeginIdempotent(ctx context.Context, key, hash string) (*model.IdempotencyRecord, error)
+	CompleteIdempotent(ctx context.Context, key, hash string, status int, response []byte) error
+	ReleaseIdempotent(ctx context.Context, key string) error
+
+	ListTrash(ctx context.Context, kind string, page, size int) (*model.PaginatedTrashData, error)
+	MoveToTrash(ctx context.Context, kind, id string) error
+	RestoreFromTrash(ctx context.Context, kind, id string) error
+	PurgeFromTrash(ctx context.Context, kind, id string) error
+
+	ListAuditLog(ctx context.Context, f *model.AuditFilter, page, size int) (*model.PaginatedAuditData, error)
+
+	ListItemRevisions(ctx context.Context, uid uuid.UUID, page, size int) (*model.PaginatedItemRevisionsData, error)
+	DiffItemRevisions(ctx context.Context, uid uuid.UUID, from, to uint64) (*model.ItemRevisionDiff, error)
+	RollbackItem(ctx context.Context, uid uuid.UUID, id uint64) error
 }
@@ -0,0 +1,113 @@
+package media
+
+import (
+	"bytes"
+	"errors"
+	"github.com/HugoSmits86/nativewebp"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"net/http"
+)
+
+const WebPType = "image/webp"
+
+var ErrTooManyPixels = errors.New("image has too many pixels")
+
+// Extensions maps the accepted upload content types to the extension of the stored original.
+var Extensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+	WebPType:     ".webp",
+}
+
+// Variant is a WebP copy generated on upload, scaled down so its longer side fits MaxSide.
+type Variant struct {
+	Name    string
+	MaxSide int
+}
+
+var Variants = []Variant{
+	{Name: "thumb", MaxSide: 320},
+	{Name: "medium", MaxSide: 1024},
+	{Name: "webp", MaxSide: 2048},
+}
+
+// Encoded is a generated variant ready to be stored.
+type Encoded struct {
+	Name   string
+	Data   []byte
+	Width  int
+	Height int
+}
+
+func DetectContentType(data []byte) string {
+	return http.DetectContentType(data)
+}
+
+// Decode reads the header first and refuses images larger than maxPixels before allocating their pixels.
+func Decode(data []byte, maxPixels int) (image.Image, error) {
+	conf, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	if conf.Width <= 0 || conf.Height <= 0 || conf.Width > maxPixels/conf.Height {
+		return nil, ErrTooManyPixels
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	return img, err
+}
+
+// Resize scales img down so its longer side is at most maxSide, smaller images are returned as is.
+func Resize(img image.Image, maxSide int) image.Image {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= maxSide && h <= maxSide {
+		return img
+	}
+
+	if w >= h {
+		w, h = maxSide, max(1, h*maxSide/w)
+	} else {
+		w, h = max(1, w*maxSide/h), maxSide
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, b, draw.Src, nil)
+	return dst
+}
+
+func EncodeWebP(img image.Image) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := nativewebp.Encode(buf, img, nil); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func GenerateVariants(img image.Image) ([]Encoded, error) {
+	res := make([]Encoded, 0, len(Variants))
+	for _, v := range Variants {
+		scaled := Resize(img, v.MaxSide)
+		data, err := EncodeWebP(scaled)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(
+			res, Encoded{
+				Name:   v.Name,
+				Data:   data,
+				Width:  scaled.Bounds().Dx(),
+				Height: scaled.Bounds().Dy(),
+			},
+		)
+	}
+	return res, nil
+}
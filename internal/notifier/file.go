@@ -0,0 +1,53 @@
+package notifier
+
+import (
+	"context"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/goccy/go-json"
+	"os"
+	"sync"
+)
+
+const defaultPath = "notifications.jsonl"
+
+// File appends favorite and question events to a file, one JSON object per line.
+type File struct {
+	mu   sync.Mutex
+	path string
+}
+
+func NewFile(path string) *File {
+	if path == "" {
+		path = defaultPath
+	}
+	return &File{path: path}
+}
+
+func (n *File) Notify(_ context.Context, e *model.FavoriteEvent) error {
+	return n.append(e)
+}
+
+func (n *File) NotifyQuestion(_ context.Context, e *model.QuestionEvent) error {
+	return n.append(e)
+}
+
+func (n *File) append(e any) error {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	f, err := os.OpenFile(n.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+
+	if _, err = f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
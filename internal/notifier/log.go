@@ -0,0 +1,39 @@
+package notifier
+
+import (
+	"context"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"go.uber.org/zap"
+)
+
+// Log writes favorite and question events to the application log.
+type Log struct{}
+
+func NewLog() *Log {
+	return &Log{}
+}
+
+func (n *Log) Notify(_ context.Context, e *model.FavoriteEvent) error {
+	zap.L().Info(
+		"Favorite event",
+		zap.String("type", string(e.Type)),
+		zap.String("item_id", e.ItemID.String()),
+		zap.String("old_price", e.OldPrice.String()),
+		zap.String("new_price", e.NewPrice.String()),
+		zap.Int("recipients", len(e.UserIDs)),
+	)
+	return nil
+}
+
+func (n *Log) NotifyQuestion(_ context.Context, e *model.QuestionEvent) error {
+	zap.L().Info(
+		"Question event",
+		zap.String("type", string(e.Type)),
+		zap.String("item_id", e.ItemID.String()),
+		zap.Uint64("question_id", e.QuestionID),
+		zap.Uint64("answer_id", e.AnswerID),
+		zap.Bool("official", e.Official),
+		zap.Int("recipients", len(e.UserIDs)),
+	)
+	return nil
+}
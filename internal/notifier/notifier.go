@@ -0,0 +1,25 @@
+package notifier
+
+import (
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	cfg "github.com/JMURv/par-pro/products/pkg/config"
+	"go.uber.org/zap"
+)
+
+const (
+	TypeLog  = "log"
+	TypeFile = "file"
+)
+
+// New picks the notifier implementation from the config, unknown types fall back to the log notifier.
+func New(conf *cfg.NotifierConfig) ctrl.Notifier {
+	switch conf.Type {
+	case TypeFile:
+		return NewFile(conf.Path)
+	case TypeLog, "":
+		return NewLog()
+	default:
+		zap.L().Warn("Unknown notifier type, using log", zap.String("type", conf.Type))
+		return NewLog()
+	}
+}
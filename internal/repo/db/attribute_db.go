@@ -0,0 +1,233 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/lib/pq"
+	"github.com/opentracing/opentracing-go"
+)
+
+func (r *Repository) ListAttributes(ctx context.Context) ([]*model.Attribute, error) {
+	const op = "attributes.ListAttributes.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	rows, err := r.conn.Query(attributeListQ)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*model.Attribute, 0, 20)
+	for rows.Next() {
+		a, err := scanAttribute(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, a)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *Repository) GetAttribute(ctx context.Context, slug string) (*model.Attribute, error) {
+	const op = "attributes.GetAttribute.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := scanAttribute(r.conn.QueryRow(attributeGetQ, slug))
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return nil, repo.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *Repository) CreateAttribute(ctx context.Context, a *model.Attribute) (string, error) {
+	const op = "attributes.CreateAttribute.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var slug string
+	err := r.conn.QueryRow(
+		attributeCreateQ,
+		a.Slug,
+		a.Name,
+		a.Type,
+		a.Unit,
+		pq.Array(a.AllowedValues),
+	).Scan(&slug)
+	if err != nil {
+		return "", mapError(err)
+	}
+
+	return slug, nil
+}
+
+// UpdateAttribute changes the definition and refreshes the filters derived from it.
+func (r *Repository) UpdateAttribute(ctx context.Context, slug string, a *model.Attribute) error {
+	const op = "attributes.UpdateAttribute.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return mapError(err)
+	}
+
+	var id uint64
+	err = tx.QueryRow(
+		attributeUpdateQ,
+		a.Name,
+		a.Type,
+		a.Unit,
+		pq.Array(a.AllowedValues),
+		slug,
+	).Scan(&id)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		tx.Rollback()
+		return repo.ErrNotFound
+	} else if err != nil {
+		tx.Rollback()
+		return mapError(err)
+	}
+
+	if _, err = tx.Exec(filterDeriveQ, "", id); err != nil {
+		tx.Rollback()
+		return mapError(err)
+	}
+
+	return tx.Commit()
+}
+
+// DeleteAttribute removes the definition with its derived filters, item attributes keep their values unbound.
+func (r *Repository) DeleteAttribute(ctx context.Context, slug string) error {
+	const op = "attributes.DeleteAttribute.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.Exec(attributeDeleteQ, slug)
+	if err != nil {
+		return mapError(err)
+	}
+
+	if aff, _ := res.RowsAffected(); aff == 0 {
+		return repo.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) ListCategoryAttributes(ctx context.Context, slugs []string) ([]*model.CategoryAttribute, error) {
+	const op = "attributes.ListCategoryAttributes.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	rows, err := r.conn.Query(categoryAttributeListQ, pq.Array(slugs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*model.CategoryAttribute, 0, 20)
+	for rows.Next() {
+		ca := &model.CategoryAttribute{Attribute: &model.Attribute{}}
+		if err = rows.Scan(
+			&ca.CategorySlug,
+			&ca.IsRequired,
+			&ca.IsFilterable,
+			&ca.Position,
+			&ca.Attribute.ID,
+			&ca.Attribute.Slug,
+			&ca.Attribute.Name,
+			&ca.Attribute.Type,
+			&ca.Attribute.Unit,
+			pq.Array(&ca.Attribute.AllowedValues),
+			&ca.Attribute.CreatedAt,
+			&ca.Attribute.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		ca.AttributeID = ca.Attribute.ID
+		res = append(res, ca)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// SetCategoryAttributes replaces the schema of the category and derives its filters from it.
+func (r *Repository) SetCategoryAttributes(ctx context.Context, slug string, attrs []*model.CategoryAttribute) error {
+	const op = "attributes.SetCategoryAttributes.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return mapError(err)
+	}
+
+	if _, err = tx.Exec(categoryAttributeDeleteQ, slug); err != nil {
+		tx.Rollback()
+		return mapError(err)
+	}
+
+	for _, v := range attrs {
+		if _, err = tx.Exec(
+			categoryAttributeCreateQ,
+			slug,
+			v.AttributeID,
+			v.IsRequired,
+			v.IsFilterable,
+			v.Position,
+		); err != nil {
+			tx.Rollback()
+			if errors.Is(mapError(err), repo.ErrMissingReference) {
+				return repo.ErrNotFound
+			}
+			return mapError(err)
+		}
+	}
+
+	if _, err = tx.Exec(filterStaleDeleteQ, slug); err != nil {
+		tx.Rollback()
+		return mapError(err)
+	}
+
+	if _, err = tx.Exec(filterDeriveQ, slug, 0); err != nil {
+		tx.Rollback()
+		return mapError(err)
+	}
+
+	return tx.Commit()
+}
+
+func scanAttribute(row rowScanner) (*model.Attribute, error) {
+	a := &model.Attribute{}
+	if err := row.Scan(
+		&a.ID,
+		&a.Slug,
+		&a.Name,
+		&a.Type,
+		&a.Unit,
+		pq.Array(&a.AllowedValues),
+		&a.CreatedAt,
+		&a.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
@@ -0,0 +1,74 @@
+package db
+
+const attributeListQ = `
+	SELECT id, slug, name, type, unit, allowed_values, created_at, updated_at
+	FROM attribute
+	ORDER BY name
+`
+
+const attributeGetQ = `
+	SELECT id, slug, name, type, unit, allowed_values, created_at, updated_at
+	FROM attribute
+	WHERE slug = $1
+`
+
+const attributeCreateQ = `
+	INSERT INTO attribute (slug, name, type, unit, allowed_values)
+	VALUES ($1, $2, $3, $4, $5)
+	RETURNING slug
+`
+
+const attributeUpdateQ = `
+	UPDATE attribute
+	SET name = $1, type = $2, unit = $3, allowed_values = $4, updated_at = NOW()
+	WHERE slug = $5
+	RETURNING id
+`
+
+const attributeDeleteQ = `DELETE FROM attribute WHERE slug = $1`
+
+const categoryAttributeListQ = `
+	SELECT ca.category_slug, ca.is_required, ca.is_filterable, ca.position,
+	       a.id, a.slug, a.name, a.type, a.unit, a.allowed_values, a.created_at, a.updated_at
+	FROM category_attribute ca
+	JOIN attribute a ON a.id = ca.attribute_id
+	WHERE ca.category_slug = ANY($1)
+	ORDER BY ca.category_slug, ca.position, a.name
+`
+
+const categoryAttributeDeleteQ = `DELETE FROM category_attribute WHERE category_slug = $1`
+
+const categoryAttributeCreateQ = `
+	INSERT INTO category_attribute (category_slug, attribute_id, is_required, is_filterable, position)
+	VALUES ($1, $2, $3, $4, $5)
+`
+
+// Derives a filter for every filterable attribute of the category ($1) or of the attribute ($2).
+// Enums offer their allowed values, numbers become range filters.
+const filterDeriveQ = `
+	INSERT INTO filter (name, values, filter_type, min_value, max_value, category_slug, attribute_id)
+	SELECT a.name,
+	       CASE a.type
+	           WHEN 'enum' THEN a.allowed_values
+	           WHEN 'boolean' THEN ARRAY ['true', 'false']::VARCHAR(255)[]
+	           ELSE '{}'::VARCHAR(255)[]
+	       END,
+	       CASE WHEN a.type = 'number' THEN 'range' ELSE 'equality' END,
+	       0,
+	       0,
+	       ca.category_slug,
+	       a.id
+	FROM category_attribute ca
+	JOIN attribute a ON a.id = ca.attribute_id
+	WHERE ca.is_filterable AND (ca.category_slug = $1 OR ca.attribute_id = $2)
+	ON CONFLICT (category_slug, attribute_id) WHERE attribute_id IS NOT NULL
+	DO UPDATE SET name = EXCLUDED.name, values = EXCLUDED.values, filter_type = EXCLUDED.filter_type, updated_at = NOW()
+`
+
+const filterStaleDeleteQ = `
+	DELETE FROM filter f
+	WHERE f.category_slug = $1 AND f.attribute_id IS NOT NULL AND NOT EXISTS (
+		SELECT 1 FROM category_attribute ca
+		WHERE ca.category_slug = f.category_slug AND ca.attribute_id = f.attribute_id AND ca.is_filterable
+	)
+`
@@ -0,0 +1,162 @@
+package db
+
+import (
+	"context"
+	repo2 "github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestRepository_ListCategoryAttributes(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(categoryAttributeListQ)).
+		WithArgs(pq.Array([]string{"phones"})).
+		WillReturnRows(
+			sqlmock.NewRows(
+				[]string{
+					"category_slug", "is_required", "is_filterable", "position",
+					"id", "slug", "name", "type", "unit", "allowed_values", "created_at", "updated_at",
+				},
+			).AddRow("phones", true, true, 0, 1, "color", "Color", model.AttributeTypeEnum, "", "{Black,White}", now, now),
+		)
+
+	res, err := repo.ListCategoryAttributes(context.Background(), []string{"phones"})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, uint64(1), res[0].AttributeID)
+	assert.True(t, res[0].IsRequired)
+	assert.Equal(t, []string{"Black", "White"}, res[0].Attribute.AllowedValues)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_SetCategoryAttributes(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	attrs := []*model.CategoryAttribute{{AttributeID: 1, IsRequired: true, IsFilterable: true}}
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, error)
+	}{
+		{
+			name: "Filters are derived",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(categoryAttributeDeleteQ)).
+					WithArgs("phones").
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectExec(regexp.QuoteMeta(categoryAttributeCreateQ)).
+					WithArgs("phones", uint64(1), true, true, 0).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(filterStaleDeleteQ)).
+					WithArgs("phones").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(filterDeriveQ)).
+					WithArgs("phones", 0).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "Unknown attribute",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(categoryAttributeDeleteQ)).
+					WithArgs("phones").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(regexp.QuoteMeta(categoryAttributeCreateQ)).
+					WithArgs("phones", uint64(1), true, true, 0).
+					WillReturnError(&pq.Error{Code: "23503", Detail: "Key (attribute_id)=(1) is not present in table \"attribute\"."})
+				mock.ExpectRollback()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.Equal(t, repo2.ErrNotFound, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				err := repo.SetCategoryAttributes(context.Background(), "phones", attrs)
+				tt.expectedResp(t, err)
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_UpdateAttribute(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	a := &model.Attribute{Name: "Color", Type: model.AttributeTypeEnum, AllowedValues: []string{"Black", "Red"}}
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, error)
+	}{
+		{
+			name: "Derived filters follow",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(attributeUpdateQ)).
+					WithArgs(a.Name, a.Type, a.Unit, pq.Array(a.AllowedValues), "color").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectExec(regexp.QuoteMeta(filterDeriveQ)).
+					WithArgs("", uint64(1)).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectCommit()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "Name taken",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(attributeUpdateQ)).
+					WillReturnError(&pq.Error{Code: "23505", Detail: "Key (name)=(test) already exists."})
+				mock.ExpectRollback()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, repo2.ErrAlreadyExists)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				err := repo.UpdateAttribute(context.Background(), "color", a)
+				tt.expectedResp(t, err)
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
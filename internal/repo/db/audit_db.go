@@ -0,0 +1,68 @@
+package db
+
+import (
+	"context"
+	md "github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/opentracing/opentracing-go"
+	"time"
+)
+
+func (r *Repository) CreateAuditEntry(ctx context.Context, e *md.AuditEntry) error {
+	const op = "audit.CreateAuditEntry.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	return r.conn.QueryRow(auditCreateQ, e.ActorID, e.Entity, e.EntityID, e.Action, []byte(e.Diff), e.RequestID).
+		Scan(&e.ID, &e.CreatedAt)
+}
+
+func (r *Repository) ListAuditEntries(ctx context.Context, f *md.AuditFilter, page, size int) (*md.PaginatedAuditData, error) {
+	const op = "audit.ListAuditEntries.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	args := []any{f.Entity, f.EntityID, f.ActorID, nullTime(f.From), nullTime(f.To)}
+
+	var count int64
+	if err := r.conn.QueryRow(auditCountQ, args...).Scan(&count); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.conn.Query(auditListQ, append(args, (page-1)*size, size)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*md.AuditEntry, 0, size)
+	for rows.Next() {
+		e := &md.AuditEntry{}
+		var diff []byte
+		if err = rows.Scan(&e.ID, &e.ActorID, &e.Entity, &e.EntityID, &e.Action, &diff, &e.RequestID, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		e.Diff = diff
+		res = append(res, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	totalPages := int((count + int64(size) - 1) / int64(size))
+	return &md.PaginatedAuditData{
+		Data:        res,
+		Count:       count,
+		TotalPages:  totalPages,
+		CurrentPage: page,
+		HasNextPage: page < totalPages,
+	}, nil
+}
+
+// nullTime maps the zero time to NULL.
+func nullTime(t time.Time) any {
+	if t.IsZero() {
+		return nil
+	}
+	return t
+}
@@ -0,0 +1,25 @@
+package db
+
+const auditCreateQ = `
+	INSERT INTO audit_log (actor_id, entity, entity_id, action, diff, request_id)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	RETURNING id, created_at
+`
+
+// Empty filters and NULL times match every entry.
+const auditFilterQ = `
+	WHERE ($1 = '' OR entity = $1)
+	  AND ($2 = '' OR entity_id = $2)
+	  AND ($3 = '' OR actor_id = $3)
+	  AND ($4::TIMESTAMPTZ IS NULL OR created_at >= $4)
+	  AND ($5::TIMESTAMPTZ IS NULL OR created_at < $5)
+`
+
+const auditCountQ = `SELECT COUNT(*) FROM audit_log` + auditFilterQ
+
+const auditListQ = `
+	SELECT id, actor_id, entity, entity_id, action, diff, request_id, created_at
+	FROM audit_log` + auditFilterQ + `
+	ORDER BY created_at DESC, id DESC
+	OFFSET $6 LIMIT $7
+`
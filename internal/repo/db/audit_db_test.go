@@ -0,0 +1,72 @@
+package db
+
+import (
+	"context"
+	md "github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestRepository_CreateAuditEntry(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	now := time.Now()
+	e := &md.AuditEntry{
+		ActorID:   "user-1",
+		Entity:    md.AuditCategory,
+		EntityID:  "shoes",
+		Action:    md.AuditUpdate,
+		Diff:      []byte(`{"before":{"title":"Shoes"},"after":{"title":"Boots"}}`),
+		RequestID: "req-1",
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(auditCreateQ)).
+		WithArgs(e.ActorID, e.Entity, e.EntityID, e.Action, []byte(e.Diff), e.RequestID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(7, now))
+
+	require.NoError(t, repo.CreateAuditEntry(context.Background(), e))
+	assert.Equal(t, uint64(7), e.ID)
+	assert.Equal(t, now, e.CreatedAt)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ListAuditEntries(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	now := time.Now()
+	from := now.Add(-time.Hour)
+	f := &md.AuditFilter{Entity: md.AuditItem, ActorID: "user-1", From: from}
+
+	mock.ExpectQuery(regexp.QuoteMeta(auditCountQ)).
+		WithArgs(md.AuditItem, "", "user-1", from, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+	mock.ExpectQuery(regexp.QuoteMeta(auditListQ)).
+		WithArgs(md.AuditItem, "", "user-1", from, nil, 0, 2).
+		WillReturnRows(
+			sqlmock.NewRows(
+				[]string{"id", "actor_id", "entity", "entity_id", "action", "diff", "request_id", "created_at"},
+			).
+				AddRow(2, "user-1", md.AuditItem, "a", md.AuditDelete, []byte(`{}`), "req-2", now).
+				AddRow(1, "user-1", md.AuditItem, "a", md.AuditCreate, []byte(`{"after":{"title":"A"}}`), "req-1", now),
+		)
+
+	res, err := repo.ListAuditEntries(context.Background(), f, 1, 2)
+	require.NoError(t, err)
+	require.Len(t, res.Data, 2)
+	assert.Equal(t, uint64(2), res.Data[0].ID)
+	assert.JSONEq(t, `{"after":{"title":"A"}}`, string(res.Data[1].Diff))
+	assert.Equal(t, 2, res.TotalPages)
+	assert.True(t, res.HasNextPage)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -9,7 +9,8 @@ import (
 	"github.com/JMURv/par-pro/products/pkg/model"
 	"github.com/lib/pq"
 	"github.com/opentracing/opentracing-go"
-	"strings"
+	"slices"
+	"time"
 )
 
 func (r *Repository) CategorySearch(ctx context.Context, query string, page, size int) (*model.PaginatedCategoryData, error) {
@@ -107,7 +108,7 @@ func (r *Repository) GetCategoryBySlug(ctx context.Context, slug string) (*model
 	children := make([]string, 0, consts.DefaultPageSize)
 	filters := make([]string, 0, consts.DefaultPageSize)
 	err := r.conn.QueryRow(categoryGetQ, slug).
-		Scan(&res.Slug, &res.Title, &res.Src, &res.Alt, &res.ParentSlug, pq.Array(&children), pq.Array(&filters))
+		Scan(&res.Slug, &res.Title, &res.Src, &res.Alt, &res.ParentSlug, &res.Version, pq.Array(&children), pq.Array(&filters))
 	if err != nil && errors.Is(err, sql.ErrNoRows) {
 		return nil, repo.ErrNotFound
 	} else if err != nil {
@@ -130,7 +131,7 @@ func (r *Repository) GetCategoryBySlug(ctx context.Context, slug string) (*model
 			`SELECT COUNT(*) 
 			 FROM item 
 			 JOIN item_category ON item_category.item_id = item.id
-			 WHERE item_category.category_slug = $1`, res.Children[i].Slug,
+			 WHERE item_category.category_slug = $1 AND item.deleted_at IS NULL`, res.Children[i].Slug,
 		).Scan(&count)
 		if err != nil {
 			return nil, err
@@ -149,26 +150,23 @@ func (r *Repository) CreateCategory(ctx context.Context, req *model.Category) (s
 
 	tx, err := r.conn.Begin()
 	if err != nil {
-		return "", err
+		return "", mapError(err)
 	}
 
 	var slug string
 	if err = tx.QueryRow(categoryCreateQ, req.Slug, req.Title, req.Src, req.Alt, req.ParentSlug).
 		Scan(&slug); err != nil {
 		tx.Rollback()
-		if strings.Contains(err.Error(), "unique constraint") {
-			return "", repo.ErrAlreadyExists
-		}
-		return "", err
+		return "", mapError(err)
 	}
 
 	if err = CreateFilters(tx, slug, req.Filters); err != nil {
 		tx.Rollback()
-		return "", err
+		return "", mapError(err)
 	}
 
 	if err = tx.Commit(); err != nil {
-		return "", err
+		return "", mapError(err)
 	}
 	return slug, nil
 }
@@ -180,13 +178,19 @@ func (r *Repository) UpdateCategory(ctx context.Context, slug string, req *model
 
 	tx, err := r.conn.Begin()
 	if err != nil {
-		return err
+		return mapError(err)
 	}
 
-	res, err := r.conn.Exec(categoryUpdateQ, req.Title, req.Src, req.Alt, req.ParentSlug, slug)
+	version, err := bumpVersion(tx, categoryBumpVersionQ, categoryVersionQ, slug, req.Version)
 	if err != nil {
 		tx.Rollback()
-		return err
+		return mapError(err)
+	}
+
+	res, err := tx.Exec(categoryUpdateQ, req.Title, req.Src, req.Alt, req.ParentSlug, slug)
+	if err != nil {
+		tx.Rollback()
+		return mapError(err)
 	}
 
 	if aff, _ := res.RowsAffected(); aff == 0 {
@@ -196,10 +200,66 @@ func (r *Repository) UpdateCategory(ctx context.Context, slug string, req *model
 
 	if err = UpdateFilters(tx, slug, req.Filters); err != nil {
 		tx.Rollback()
-		return err
+		return mapError(err)
 	}
 
-	return tx.Commit()
+	if err = tx.Commit(); err != nil {
+		return mapError(err)
+	}
+
+	req.Version = version
+	return nil
+}
+
+// PatchCategory writes only the fields of req named in fields, filters are left untouched unless named.
+func (r *Repository) PatchCategory(ctx context.Context, slug string, req *model.Category, fields []string) error {
+	const op = "category.PatchCategory.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return mapError(err)
+	}
+
+	version, err := bumpVersion(tx, categoryBumpVersionQ, categoryVersionQ, slug, req.Version)
+	if err != nil {
+		tx.Rollback()
+		return mapError(err)
+	}
+
+	cols := make([]column, 0, len(fields))
+	for _, v := range fields {
+		switch v {
+		case "title":
+			cols = append(cols, column{"title", req.Title})
+		case "src":
+			cols = append(cols, column{"src", req.Src})
+		case "alt":
+			cols = append(cols, column{"alt", req.Alt})
+		case "parent_slug":
+			cols = append(cols, column{"parent_slug", req.ParentSlug})
+		}
+	}
+
+	if err = patchRow(tx, "category", "slug", slug, cols); err != nil {
+		tx.Rollback()
+		return mapError(err)
+	}
+
+	if slices.Contains(fields, "filters") {
+		if err = UpdateFilters(tx, slug, req.Filters); err != nil {
+			tx.Rollback()
+			return mapError(err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return mapError(err)
+	}
+
+	req.Version = version
+	return nil
 }
 
 func (r *Repository) DeleteCategory(ctx context.Context, slug string) error {
@@ -209,7 +269,7 @@ func (r *Repository) DeleteCategory(ctx context.Context, slug string) error {
 
 	res, err := r.conn.ExecContext(ctx, categoryDeleteQ, slug)
 	if err != nil {
-		return err
+		return mapError(err)
 	}
 
 	if aff, _ := res.RowsAffected(); aff == 0 {
@@ -295,3 +355,72 @@ func (r *Repository) ListCategoryFilters(ctx context.Context, slug string) ([]*m
 
 	return res, err
 }
+
+// ListStaleFilterCategories returns the categories whose items have not changed since before, oldest first.
+func (r *Repository) ListStaleFilterCategories(ctx context.Context, before time.Time, limit int) ([]string, error) {
+	const op = "category.ListStaleFilterCategories.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	rows, err := r.conn.Query(categoryFiltersStaleListQ, before, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]string, 0, limit)
+	for rows.Next() {
+		var slug string
+		if err = rows.Scan(&slug); err != nil {
+			return nil, err
+		}
+		res = append(res, slug)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// RebuildCategoryFilters refreshes the values of the category filters from the items in it
+// and creates the price filter when the category has none.
+func (r *Repository) RebuildCategoryFilters(ctx context.Context, slug string) error {
+	const op = "category.RebuildCategoryFilters.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	res, err := tx.Exec(categoryFiltersFreshQ, slug)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if aff, _ := res.RowsAffected(); aff == 0 {
+		tx.Rollback()
+		return repo.ErrNotFound
+	}
+
+	if _, err = tx.Exec(filterEqualityRebuildQ, slug); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if _, err = tx.Exec(filterRangeRebuildQ, slug, consts.PriceFilterName); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if _, err = tx.Exec(filterPriceRebuildQ, slug, consts.PriceFilterName); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
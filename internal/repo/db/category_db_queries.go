@@ -40,13 +40,13 @@ const filterUpdateQ = `
 	WHERE id = $6
 `
 
-const categoryCountQ = "SELECT COUNT(*) FROM category;"
-const categorySearchCountQ = `SELECT COUNT(*) FROM category WHERE title ILIKE ? OR slug ILIKE ?`
+const categoryCountQ = "SELECT COUNT(*) FROM category WHERE deleted_at IS NULL;"
+const categorySearchCountQ = `SELECT COUNT(*) FROM category WHERE deleted_at IS NULL AND (title ILIKE ? OR slug ILIKE ?)`
 
 const categorySearchQ = `
 	SELECT slug, title, src, alt, parent_slug 
 	FROM category
-	WHERE title ILIKE $1 OR slug ILIKE $2
+	WHERE deleted_at IS NULL AND (title ILIKE $1 OR slug ILIKE $2)
 	OFFSET $3 LIMIT $4;
 `
 
@@ -58,13 +58,14 @@ const categoryListQ = `
 		) AS children
 	FROM category AS p
 	JOIN category AS child 
-	ON child.parent_slug = p.slug
+	ON child.parent_slug = p.slug AND child.deleted_at IS NULL
+	WHERE p.deleted_at IS NULL
 	GROUP BY p.slug, p.title
 	OFFSET $1 LIMIT $2;
 `
 
 const categoryGetQ = `
-	SELECT c.slug, c.title, c.src, c.alt, c.parent_slug,
+	SELECT c.slug, c.title, c.src, c.alt, c.parent_slug, c.version,
 	ARRAY_AGG(
 		child.slug || '|' || child.title || '|' || child.src || '|' || child.alt || '|' || child.parent_slug
 	) AS children,
@@ -72,9 +73,9 @@ const categoryGetQ = `
 		f.id || '|' || f.name || '|' || f.values || '|' || f.filter_type || '|' || f.min_value || '|' || f.max_value
 	) AS filters
 	FROM category c 
-	JOIN category child ON child.parent_slug = c.slug
+	JOIN category child ON child.parent_slug = c.slug AND child.deleted_at IS NULL
 	JOIN filter f ON f.category_slug = c.slug
-	WHERE c.slug = $1
+	WHERE c.slug = $1 AND c.deleted_at IS NULL
 	GROUP BY c.slug
 `
 
@@ -90,8 +91,12 @@ const categoryUpdateQ = `
 	WHERE slug = $5
 `
 
+const categoryBumpVersionQ = `UPDATE category SET version = version + 1 WHERE slug = $1 AND deleted_at IS NULL AND ($2 = 0 OR version = $2) RETURNING version`
+const categoryVersionQ = `SELECT version FROM category WHERE slug = $1 AND deleted_at IS NULL`
+
+// Categories are moved to the trash, they are only removed for good once purged from there.
 const categoryDeleteQ = `
-	DELETE FROM category WHERE slug = $1
+	UPDATE category SET deleted_at = NOW() WHERE slug = $1 AND deleted_at IS NULL
 `
 
 const filterCreateQ = `
@@ -102,3 +107,67 @@ const filterCreateQ = `
 const filterDeleteQ = `
 	DELETE FROM filter WHERE id = ? AND category_slug = ?
 `
+
+const categoryFiltersStaleListQ = `
+	SELECT slug FROM category
+	WHERE filters_stale_at <= $1 AND deleted_at IS NULL
+	ORDER BY filters_stale_at
+	LIMIT $2
+`
+
+const categoryFiltersFreshQ = `
+	UPDATE category SET filters_stale_at = NULL WHERE slug = $1
+`
+
+// Attribute bound filters take the values of their attribute, hand made ones the item_attr rows of the same name.
+// Items in the trash do not count.
+const filterEqualityRebuildQ = `
+	UPDATE filter f
+	SET values = ARRAY(
+	        SELECT DISTINCT LEFT(ia.value, 255)
+	        FROM item_attr ia
+	        JOIN item_category ic ON ic.item_id = ia.item_id AND ic.category_slug = f.category_slug
+	        JOIN item i ON i.id = ia.item_id AND i.deleted_at IS NULL
+	        WHERE ia.attribute_id = f.attribute_id OR (f.attribute_id IS NULL AND LOWER(ia.name) = LOWER(f.name))
+	        ORDER BY 1
+	    ),
+	    updated_at = NOW()
+	WHERE f.category_slug = $1 AND f.filter_type = 'equality'
+`
+
+const filterRangeRebuildQ = `
+	UPDATE filter f
+	SET (min_value, max_value) = (
+	        SELECT COALESCE(MIN(v.value), 0), COALESCE(MAX(v.value), 0)
+	        FROM (
+	            SELECT CASE WHEN ia.value ~ '^-?[0-9]+(\.[0-9]+)?$' THEN ia.value::DOUBLE PRECISION END AS value
+	            FROM item_attr ia
+	            JOIN item_category ic ON ic.item_id = ia.item_id AND ic.category_slug = f.category_slug
+	            JOIN item i ON i.id = ia.item_id AND i.deleted_at IS NULL
+	            WHERE ia.attribute_id = f.attribute_id OR (f.attribute_id IS NULL AND LOWER(ia.name) = LOWER(f.name))
+	        ) v
+	    ),
+	    updated_at = NOW()
+	WHERE f.category_slug = $1 AND f.filter_type = 'range' AND NOT (f.attribute_id IS NULL AND f.name = $2)
+`
+
+// The price filter is kept in minor units, the same ones min_price and max_price are given in.
+const filterPriceRebuildQ = `
+	WITH prices AS (
+	    SELECT COALESCE(MIN(i.price), 0)::DOUBLE PRECISION AS min_value,
+	           COALESCE(MAX(i.price), 0)::DOUBLE PRECISION AS max_value
+	    FROM item i
+	    JOIN item_category ic ON ic.item_id = i.id
+	    WHERE ic.category_slug = $1 AND i.deleted_at IS NULL
+	), updated AS (
+	    UPDATE filter f
+	    SET min_value = p.min_value, max_value = p.max_value, updated_at = NOW()
+	    FROM prices p
+	    WHERE f.category_slug = $1 AND f.attribute_id IS NULL AND f.name = $2 AND f.filter_type = 'range'
+	    RETURNING f.id
+	)
+	INSERT INTO filter (name, values, filter_type, min_value, max_value, category_slug)
+	SELECT $2, '{}', 'range', p.min_value, p.max_value, $1
+	FROM prices p
+	WHERE NOT EXISTS (SELECT 1 FROM updated)
+`
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	repo2 "github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/consts"
 	"github.com/JMURv/par-pro/products/pkg/model"
 	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
@@ -264,9 +265,9 @@ func TestRepository_GetCategoryBySlug(t *testing.T) {
 		{
 			name: "Success",
 			mockExpect: func() {
-				rows := sqlmock.NewRows([]string{"slug", "title", "src", "alt", "parent_slug", "children", "filters"}).
+				rows := sqlmock.NewRows([]string{"slug", "title", "src", "alt", "parent_slug", "version", "children", "filters"}).
 					AddRow(
-						"test-slug", "Test title", "test-src", "test-alt", "test-parent",
+						"test-slug", "Test title", "test-src", "test-alt", "test-parent", 3,
 						"{child-slug|child-title|child-src|child-alt|test-parent}",
 						"{123|filter-name|filter-values|filter-type|1.23|1.23}",
 					)
@@ -284,6 +285,7 @@ func TestRepository_GetCategoryBySlug(t *testing.T) {
 				assert.NotNil(t, res)
 				assert.Equal(t, "test-slug", res.Slug)
 				assert.Equal(t, "Test title", res.Title)
+				assert.Equal(t, 3, res.Version)
 				assert.Equal(t, int64(10), res.Children[0].ProductQuantity)
 			},
 		},
@@ -316,9 +318,9 @@ func TestRepository_GetCategoryBySlug(t *testing.T) {
 		{
 			name: "ChildrenScanError",
 			mockExpect: func() {
-				rows := sqlmock.NewRows([]string{"slug", "title", "src", "alt", "parent_slug", "children", "filters"}).
+				rows := sqlmock.NewRows([]string{"slug", "title", "src", "alt", "parent_slug", "version", "children", "filters"}).
 					AddRow(
-						"test-slug", "Test title", "test-src", "test-alt", "test-parent", "invalid-children",
+						"test-slug", "Test title", "test-src", "test-alt", "test-parent", 3, "invalid-children",
 						"{123|filter-name|filter-values|filter-type|1.23|1.23}",
 					)
 
@@ -335,9 +337,9 @@ func TestRepository_GetCategoryBySlug(t *testing.T) {
 		{
 			name: "FiltersScanError",
 			mockExpect: func() {
-				rows := sqlmock.NewRows([]string{"slug", "title", "src", "alt", "parent_slug", "children", "filters"}).
+				rows := sqlmock.NewRows([]string{"slug", "title", "src", "alt", "parent_slug", "version", "children", "filters"}).
 					AddRow(
-						"test-slug", "Test title", "test-src", "test-alt", "test-parent",
+						"test-slug", "Test title", "test-src", "test-alt", "test-parent", 3,
 						"{child-slug|child-title|child-src|child-alt|test-parent", "invalid-filters}",
 					)
 
@@ -456,13 +458,13 @@ func TestRepository_CreateCategory(t *testing.T) {
 
 				mock.ExpectQuery(regexp.QuoteMeta(categoryCreateQ)).
 					WithArgs(category.Slug, category.Title, category.Src, category.Alt, category.ParentSlug).
-					WillReturnError(errors.New("unique constraint violation"))
+					WillReturnError(&pq.Error{Code: "23505", Detail: "Key (title)=(test) already exists."})
 
 				mock.ExpectRollback()
 			},
 			expectedResp: func(t *testing.T, slug string, err error) {
 				assert.Error(t, err)
-				assert.Equal(t, repo2.ErrAlreadyExists, err)
+				assert.ErrorIs(t, err, repo2.ErrAlreadyExists)
 				assert.Empty(t, slug)
 			},
 		},
@@ -570,6 +572,9 @@ func TestRepository_UpdateCategory(t *testing.T) {
 			name: "Success",
 			mockExpect: func() {
 				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(categoryBumpVersionQ)).
+					WithArgs(category.Slug, category.Version).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(2))
 
 				mock.ExpectExec(regexp.QuoteMeta(categoryUpdateQ)).
 					WithArgs(category.Title, category.Src, category.Alt, category.ParentSlug, category.Slug).
@@ -616,6 +621,7 @@ func TestRepository_UpdateCategory(t *testing.T) {
 			},
 			expectedResp: func(t *testing.T, err error) {
 				require.NoError(t, err)
+				assert.Equal(t, 2, category.Version)
 			},
 		},
 		{
@@ -632,6 +638,9 @@ func TestRepository_UpdateCategory(t *testing.T) {
 			name: "UpdateError",
 			mockExpect: func() {
 				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(categoryBumpVersionQ)).
+					WithArgs(category.Slug, category.Version).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(2))
 
 				mock.ExpectExec(regexp.QuoteMeta(categoryUpdateQ)).
 					WithArgs(category.Title, category.Src, category.Alt, category.ParentSlug, category.Slug).
@@ -648,11 +657,12 @@ func TestRepository_UpdateCategory(t *testing.T) {
 			name: "NotFoundError",
 			mockExpect: func() {
 				mock.ExpectBegin()
-
-				mock.ExpectExec(regexp.QuoteMeta(categoryUpdateQ)).
-					WithArgs(category.Title, category.Src, category.Alt, category.ParentSlug, category.Slug).
-					WillReturnResult(sqlmock.NewResult(0, 0))
-
+				mock.ExpectQuery(regexp.QuoteMeta(categoryBumpVersionQ)).
+					WithArgs(category.Slug, category.Version).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}))
+				mock.ExpectQuery(regexp.QuoteMeta(categoryVersionQ)).
+					WithArgs(category.Slug).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}))
 				mock.ExpectRollback()
 			},
 			expectedResp: func(t *testing.T, err error) {
@@ -660,10 +670,29 @@ func TestRepository_UpdateCategory(t *testing.T) {
 				assert.Equal(t, repo2.ErrNotFound, err)
 			},
 		},
+		{
+			name: "VersionConflict",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(categoryBumpVersionQ)).
+					WithArgs(category.Slug, category.Version).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}))
+				mock.ExpectQuery(regexp.QuoteMeta(categoryVersionQ)).
+					WithArgs(category.Slug).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(5))
+				mock.ExpectRollback()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, repo2.ErrVersionConflict)
+			},
+		},
 		{
 			name: "UpdateFiltersError",
 			mockExpect: func() {
 				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(categoryBumpVersionQ)).
+					WithArgs(category.Slug, category.Version).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(2))
 
 				mock.ExpectExec(regexp.QuoteMeta(categoryUpdateQ)).
 					WithArgs(category.Title, category.Src, category.Alt, category.ParentSlug, category.Slug).
@@ -717,6 +746,9 @@ func TestRepository_UpdateCategory(t *testing.T) {
 			name: "CommitError",
 			mockExpect: func() {
 				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(categoryBumpVersionQ)).
+					WithArgs(category.Slug, category.Version).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(2))
 
 				mock.ExpectExec(regexp.QuoteMeta(categoryUpdateQ)).
 					WithArgs(category.Title, category.Src, category.Alt, category.ParentSlug, category.Slug).
@@ -781,6 +813,105 @@ func TestRepository_UpdateCategory(t *testing.T) {
 	}
 }
 
+func TestRepository_PatchCategory(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	filterColumns := []string{"id", "name", "values", "filter_type", "min_value", "max_value", "category_slug"}
+
+	tests := []struct {
+		name         string
+		fields       []string
+		mockExpect   func()
+		expectedResp func(*testing.T, *model.Category, error)
+	}{
+		{
+			name:   "OnlyTitle",
+			fields: []string{"title"},
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(categoryBumpVersionQ)).
+					WithArgs("test-slug", 0).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(2))
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE category SET title = $1 WHERE slug = $2")).
+					WithArgs("Patched title", "test-slug").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expectedResp: func(t *testing.T, req *model.Category, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, 2, req.Version)
+			},
+		},
+		{
+			name:   "OnlyFilters",
+			fields: []string{"filters"},
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(categoryBumpVersionQ)).
+					WithArgs("test-slug", 0).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(2))
+				mock.ExpectQuery(regexp.QuoteMeta(filterListQ)).
+					WithArgs("test-slug").
+					WillReturnRows(sqlmock.NewRows(filterColumns))
+				mock.ExpectCommit()
+			},
+			expectedResp: func(t *testing.T, req *model.Category, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, 2, req.Version)
+			},
+		},
+		{
+			name:   "VersionConflict",
+			fields: []string{"title"},
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(categoryBumpVersionQ)).
+					WithArgs("test-slug", 0).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}))
+				mock.ExpectQuery(regexp.QuoteMeta(categoryVersionQ)).
+					WithArgs("test-slug").
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(5))
+				mock.ExpectRollback()
+			},
+			expectedResp: func(t *testing.T, req *model.Category, err error) {
+				assert.ErrorIs(t, err, repo2.ErrVersionConflict)
+			},
+		},
+		{
+			name:   "UpdateError",
+			fields: []string{"title"},
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(categoryBumpVersionQ)).
+					WithArgs("test-slug", 0).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(2))
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE category SET title = $1 WHERE slug = $2")).
+					WithArgs("Patched title", "test-slug").
+					WillReturnError(errors.New("update error"))
+				mock.ExpectRollback()
+			},
+			expectedResp: func(t *testing.T, req *model.Category, err error) {
+				assert.EqualError(t, err, "update error")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				req := &model.Category{Title: "Patched title"}
+				err := repo.PatchCategory(context.Background(), "test-slug", req, tt.fields)
+				tt.expectedResp(t, req, err)
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
 func TestRepository_DeleteCategory(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -1105,3 +1236,82 @@ func TestRepository_ListCategoryFilters(t *testing.T) {
 		)
 	}
 }
+
+func TestRepository_RebuildCategoryFilters(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	slug := "test-slug"
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, error)
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(categoryFiltersFreshQ)).
+					WithArgs(slug).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(filterEqualityRebuildQ)).
+					WithArgs(slug).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectExec(regexp.QuoteMeta(filterRangeRebuildQ)).
+					WithArgs(slug, consts.PriceFilterName).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(filterPriceRebuildQ)).
+					WithArgs(slug, consts.PriceFilterName).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "NotFound",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(categoryFiltersFreshQ)).
+					WithArgs(slug).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.Equal(t, repo2.ErrNotFound, err)
+			},
+		},
+		{
+			name: "ExecError",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(categoryFiltersFreshQ)).
+					WithArgs(slug).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(filterEqualityRebuildQ)).
+					WithArgs(slug).
+					WillReturnError(errors.New("exec error"))
+				mock.ExpectRollback()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.Equal(t, "exec error", err.Error())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				err := repo.RebuildCategoryFilters(context.Background(), slug)
+				tt.expectedResp(t, err)
+				err = mock.ExpectationsWereMet()
+				assert.NoError(t, err)
+			},
+		)
+	}
+}
@@ -0,0 +1,242 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/opentracing/opentracing-go"
+)
+
+func (r *Repository) ListCustomerGroups(ctx context.Context) ([]*model.CustomerGroup, error) {
+	const op = "customerGroups.ListCustomerGroups.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	rows, err := r.conn.Query(customerGroupListQ)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*model.CustomerGroup, 0, 5)
+	for rows.Next() {
+		g, err := scanCustomerGroup(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, g)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *Repository) GetCustomerGroup(ctx context.Context, slug string) (*model.CustomerGroup, error) {
+	const op = "customerGroups.GetCustomerGroup.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := scanCustomerGroup(r.conn.QueryRow(customerGroupGetQ, slug))
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return nil, repo.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *Repository) CreateCustomerGroup(ctx context.Context, g *model.CustomerGroup) (string, error) {
+	const op = "customerGroups.CreateCustomerGroup.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	priceListID, err := r.customerGroupPriceList(g.PriceListSlug)
+	if err != nil {
+		return "", mapError(err)
+	}
+
+	var slug string
+	err = r.conn.QueryRow(customerGroupCreateQ, g.Slug, g.Name, priceListID).Scan(&slug)
+	if err != nil {
+		return "", mapError(err)
+	}
+
+	return slug, nil
+}
+
+func (r *Repository) UpdateCustomerGroup(ctx context.Context, slug string, g *model.CustomerGroup) error {
+	const op = "customerGroups.UpdateCustomerGroup.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	priceListID, err := r.customerGroupPriceList(g.PriceListSlug)
+	if err != nil {
+		return mapError(err)
+	}
+
+	res, err := r.conn.Exec(customerGroupUpdateQ, g.Name, priceListID, slug)
+	if err != nil {
+		return mapError(err)
+	}
+
+	if aff, _ := res.RowsAffected(); aff == 0 {
+		return repo.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteCustomerGroup(ctx context.Context, slug string) error {
+	const op = "customerGroups.DeleteCustomerGroup.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.Exec(customerGroupDeleteQ, slug)
+	if err != nil {
+		return mapError(err)
+	}
+
+	if aff, _ := res.RowsAffected(); aff == 0 {
+		return repo.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) ListQuantityBreaks(ctx context.Context, itemID uuid.UUID) ([]model.QuantityBreak, error) {
+	const op = "customerGroups.ListQuantityBreaks.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	rows, err := r.conn.Query(quantityBreakListQ, itemID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]model.QuantityBreak, 0, 5)
+	for rows.Next() {
+		qb := model.QuantityBreak{}
+		if err = rows.Scan(&qb.ID, &qb.ItemID, &qb.CustomerGroupID, &qb.MinQuantity, &qb.Discount); err != nil {
+			return nil, err
+		}
+		res = append(res, qb)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// SetQuantityBreaks replaces every break of the item.
+func (r *Repository) SetQuantityBreaks(ctx context.Context, itemID uuid.UUID, breaks []model.QuantityBreak) error {
+	const op = "customerGroups.SetQuantityBreaks.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return mapError(err)
+	}
+
+	if _, err = tx.Exec(quantityBreakDeleteQ, itemID); err != nil {
+		tx.Rollback()
+		return mapError(err)
+	}
+
+	for _, v := range breaks {
+		if _, err = tx.Exec(
+			quantityBreakCreateQ,
+			itemID,
+			sql.NullInt64{Int64: int64(v.CustomerGroupID), Valid: v.CustomerGroupID != 0},
+			v.MinQuantity,
+			v.Discount,
+		); err != nil {
+			tx.Rollback()
+			if errors.Is(mapError(err), repo.ErrMissingReference) {
+				return repo.ErrNotFound
+			}
+			return mapError(err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetApplicableQuantityBreaks returns the breaks every customer gets plus the ones of the group, grouped by item.
+func (r *Repository) GetApplicableQuantityBreaks(ctx context.Context, groupID uint64, items []uuid.UUID) (map[uuid.UUID][]model.QuantityBreak, error) {
+	const op = "customerGroups.GetApplicableQuantityBreaks.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	ids := make([]string, 0, len(items))
+	for _, v := range items {
+		ids = append(ids, v.String())
+	}
+
+	rows, err := r.conn.Query(quantityBreakApplicableQ, pq.Array(ids), groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make(map[uuid.UUID][]model.QuantityBreak, len(items))
+	for rows.Next() {
+		qb := model.QuantityBreak{}
+		if err = rows.Scan(&qb.ID, &qb.ItemID, &qb.CustomerGroupID, &qb.MinQuantity, &qb.Discount); err != nil {
+			return nil, err
+		}
+		res[qb.ItemID] = append(res[qb.ItemID], qb)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// customerGroupPriceList resolves the price list a group is given by slug, an empty slug leaves the group without one.
+func (r *Repository) customerGroupPriceList(slug string) (sql.NullInt64, error) {
+	if slug == "" {
+		return sql.NullInt64{}, nil
+	}
+
+	var id int64
+	var currency string
+	err := r.conn.QueryRow(priceListIDQ, slug).Scan(&id, &currency)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return sql.NullInt64{}, repo.ErrNotFound
+	} else if err != nil {
+		return sql.NullInt64{}, err
+	}
+
+	return sql.NullInt64{Int64: id, Valid: true}, nil
+}
+
+func scanCustomerGroup(row rowScanner) (*model.CustomerGroup, error) {
+	g := &model.CustomerGroup{}
+	if err := row.Scan(
+		&g.ID,
+		&g.Slug,
+		&g.Name,
+		&g.PriceListID,
+		&g.PriceListSlug,
+		&g.CreatedAt,
+		&g.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
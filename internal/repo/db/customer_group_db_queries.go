@@ -0,0 +1,50 @@
+package db
+
+const customerGroupListQ = `
+	SELECT g.id, g.slug, g.name, COALESCE(g.price_list_id, 0), COALESCE(pl.slug, ''), g.created_at, g.updated_at
+	FROM customer_group g
+	LEFT JOIN price_list pl ON pl.id = g.price_list_id
+	ORDER BY g.slug
+`
+
+const customerGroupGetQ = `
+	SELECT g.id, g.slug, g.name, COALESCE(g.price_list_id, 0), COALESCE(pl.slug, ''), g.created_at, g.updated_at
+	FROM customer_group g
+	LEFT JOIN price_list pl ON pl.id = g.price_list_id
+	WHERE g.slug = $1
+`
+
+const customerGroupCreateQ = `
+	INSERT INTO customer_group (slug, name, price_list_id)
+	VALUES ($1, $2, $3)
+	RETURNING slug
+`
+
+const customerGroupUpdateQ = `
+	UPDATE customer_group
+	SET name = $1, price_list_id = $2, updated_at = NOW()
+	WHERE slug = $3
+`
+
+const customerGroupDeleteQ = `DELETE FROM customer_group WHERE slug = $1`
+
+const quantityBreakListQ = `
+	SELECT id, item_id, COALESCE(customer_group_id, 0), min_quantity, discount
+	FROM quantity_break
+	WHERE item_id = $1
+	ORDER BY customer_group_id NULLS FIRST, min_quantity
+`
+
+const quantityBreakDeleteQ = `DELETE FROM quantity_break WHERE item_id = $1`
+
+const quantityBreakCreateQ = `
+	INSERT INTO quantity_break (item_id, customer_group_id, min_quantity, discount)
+	VALUES ($1, $2, $3, $4)
+`
+
+const quantityBreakApplicableQ = `
+	SELECT id, item_id, COALESCE(customer_group_id, 0), min_quantity, discount
+	FROM quantity_break
+	WHERE item_id = ANY($1) AND (customer_group_id IS NULL OR customer_group_id = $2)
+	ORDER BY min_quantity
+`
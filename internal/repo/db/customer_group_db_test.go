@@ -0,0 +1,244 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	repo2 "github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var customerGroupCols = []string{"id", "slug", "name", "price_list_id", "price_list_slug", "created_at", "updated_at"}
+
+func TestRepository_GetCustomerGroup(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, *model.CustomerGroup, error)
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(customerGroupGetQ)).
+					WithArgs("wholesale").
+					WillReturnRows(
+						sqlmock.NewRows(customerGroupCols).AddRow(1, "wholesale", "Wholesale", 2, "b2b", now, now),
+					)
+			},
+			expectedResp: func(t *testing.T, res *model.CustomerGroup, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, uint64(2), res.PriceListID)
+				assert.Equal(t, "b2b", res.PriceListSlug)
+			},
+		},
+		{
+			name: "Not found",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(customerGroupGetQ)).
+					WithArgs("wholesale").
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedResp: func(t *testing.T, res *model.CustomerGroup, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, repo2.ErrNotFound, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := repo.GetCustomerGroup(context.Background(), "wholesale")
+				tt.expectedResp(t, res, err)
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_CreateCustomerGroup(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+
+	tests := []struct {
+		name         string
+		req          *model.CustomerGroup
+		mockExpect   func()
+		expectedResp func(*testing.T, string, error)
+	}{
+		{
+			name: "Without price list",
+			req:  &model.CustomerGroup{Slug: "wholesale", Name: "Wholesale"},
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(customerGroupCreateQ)).
+					WithArgs("wholesale", "Wholesale", sql.NullInt64{}).
+					WillReturnRows(sqlmock.NewRows([]string{"slug"}).AddRow("wholesale"))
+			},
+			expectedResp: func(t *testing.T, res string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "wholesale", res)
+			},
+		},
+		{
+			name: "With price list",
+			req:  &model.CustomerGroup{Slug: "wholesale", Name: "Wholesale", PriceListSlug: "b2b"},
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(priceListIDQ)).
+					WithArgs("b2b").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "currency"}).AddRow(2, "RUB"))
+				mock.ExpectQuery(regexp.QuoteMeta(customerGroupCreateQ)).
+					WithArgs("wholesale", "Wholesale", sql.NullInt64{Int64: 2, Valid: true}).
+					WillReturnRows(sqlmock.NewRows([]string{"slug"}).AddRow("wholesale"))
+			},
+			expectedResp: func(t *testing.T, res string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "wholesale", res)
+			},
+		},
+		{
+			name: "Price list not found",
+			req:  &model.CustomerGroup{Slug: "wholesale", Name: "Wholesale", PriceListSlug: "b2b"},
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(priceListIDQ)).
+					WithArgs("b2b").
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedResp: func(t *testing.T, res string, err error) {
+				assert.Empty(t, res)
+				assert.Equal(t, repo2.ErrNotFound, err)
+			},
+		},
+		{
+			name: "Already exists",
+			req:  &model.CustomerGroup{Slug: "wholesale", Name: "Wholesale"},
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(customerGroupCreateQ)).
+					WithArgs("wholesale", "Wholesale", sql.NullInt64{}).
+					WillReturnError(&pq.Error{Code: "23505", Detail: "Key (slug)=(test) already exists."})
+			},
+			expectedResp: func(t *testing.T, res string, err error) {
+				assert.Empty(t, res)
+				assert.ErrorIs(t, err, repo2.ErrAlreadyExists)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := repo.CreateCustomerGroup(context.Background(), tt.req)
+				tt.expectedResp(t, res, err)
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_SetQuantityBreaks(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	uid := uuid.New()
+	breaks := []model.QuantityBreak{
+		{MinQuantity: 10, Discount: 5},
+		{MinQuantity: 10, Discount: 8, CustomerGroupID: 1},
+	}
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, error)
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(quantityBreakDeleteQ)).
+					WithArgs(uid).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectExec(regexp.QuoteMeta(quantityBreakCreateQ)).
+					WithArgs(uid, sql.NullInt64{}, 10, 5).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(regexp.QuoteMeta(quantityBreakCreateQ)).
+					WithArgs(uid, sql.NullInt64{Int64: 1, Valid: true}, 10, 8).
+					WillReturnResult(sqlmock.NewResult(2, 1))
+				mock.ExpectCommit()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Item or group not found",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(quantityBreakDeleteQ)).
+					WithArgs(uid).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(regexp.QuoteMeta(quantityBreakCreateQ)).
+					WithArgs(uid, sql.NullInt64{}, 10, 5).
+					WillReturnError(&pq.Error{Code: "23503", Detail: "Key (item_id)=(test) is not present in table \"item\"."})
+				mock.ExpectRollback()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.Equal(t, repo2.ErrNotFound, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				err := repo.SetQuantityBreaks(context.Background(), uid, breaks)
+				tt.expectedResp(t, err)
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_GetApplicableQuantityBreaks(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	first, second := uuid.New(), uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(quantityBreakApplicableQ)).
+		WithArgs(pq.Array([]string{first.String(), second.String()}), 1).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "item_id", "customer_group_id", "min_quantity", "discount"}).
+				AddRow(1, first.String(), 0, 10, 5).
+				AddRow(2, first.String(), 1, 50, 10),
+		)
+
+	res, err := repo.GetApplicableQuantityBreaks(context.Background(), 1, []uuid.UUID{first, second})
+	require.NoError(t, err)
+	require.Len(t, res[first], 2)
+	assert.Equal(t, uint64(1), res[first][1].CustomerGroupID)
+	assert.Empty(t, res[second])
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,54 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/lib/pq"
+	"strings"
+)
+
+// Codes of the integrity constraint violations, see https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	pqNotNullViolation    = "23502"
+	pqForeignKeyViolation = "23503"
+	pqUniqueViolation     = "23505"
+	pqCheckViolation      = "23514"
+)
+
+// mapError translates constraint violations reported by postgres to repository errors naming the offending column,
+// e.g. a duplicate article becomes "already exists: article". Other errors are returned as is.
+func mapError(err error) error {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return err
+	}
+
+	switch pqErr.Code {
+	case pqUniqueViolation:
+		return fmt.Errorf("%w: %v", repo.ErrAlreadyExists, pqColumn(pqErr))
+	case pqForeignKeyViolation:
+		if strings.Contains(pqErr.Detail, "is still referenced") {
+			return fmt.Errorf("%w: %v", repo.ErrReferenced, pqErr.Table)
+		}
+		return fmt.Errorf("%w: %v", repo.ErrMissingReference, pqColumn(pqErr))
+	case pqNotNullViolation, pqCheckViolation:
+		return fmt.Errorf("%w: %v", repo.ErrConstraint, pqColumn(pqErr))
+	}
+	return err
+}
+
+// pqColumn returns the column of the violation, postgres only reports the key in the detail of unique and
+// foreign key violations, e.g. "Key (article)=(A-1) already exists.", so the constraint is the fallback.
+func pqColumn(err *pq.Error) string {
+	if err.Column != "" {
+		return err.Column
+	}
+
+	if rest, ok := strings.CutPrefix(err.Detail, "Key ("); ok {
+		if col, _, ok := strings.Cut(rest, ")="); ok {
+			return col
+		}
+	}
+	return err.Constraint
+}
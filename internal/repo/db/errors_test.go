@@ -0,0 +1,67 @@
+package db
+
+import (
+	"errors"
+	repo2 "github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMapError(t *testing.T) {
+	plain := errors.New("connection reset")
+
+	tests := []struct {
+		name     string
+		err      error
+		expected error
+		msg      string
+	}{
+		{
+			name:     "Unique violation",
+			err:      &pq.Error{Code: pqUniqueViolation, Detail: "Key (article)=(A-1) already exists.", Constraint: "item_article_key"},
+			expected: repo2.ErrAlreadyExists,
+			msg:      "already exists: article",
+		},
+		{
+			name:     "Unique violation without detail",
+			err:      &pq.Error{Code: pqUniqueViolation, Constraint: "category_title_key"},
+			expected: repo2.ErrAlreadyExists,
+			msg:      "already exists: category_title_key",
+		},
+		{
+			name:     "Missing reference",
+			err:      &pq.Error{Code: pqForeignKeyViolation, Detail: `Key (item_id)=(1) is not present in table "item".`},
+			expected: repo2.ErrMissingReference,
+			msg:      "references a missing record: item_id",
+		},
+		{
+			name:     "Still referenced",
+			err:      &pq.Error{Code: pqForeignKeyViolation, Table: "item", Detail: `Key (id)=(1) is still referenced from table "order_item".`},
+			expected: repo2.ErrReferenced,
+			msg:      "still referenced: item",
+		},
+		{
+			name:     "Check violation",
+			err:      &pq.Error{Code: pqCheckViolation, Column: "quantity"},
+			expected: repo2.ErrConstraint,
+			msg:      "violates a constraint: quantity",
+		},
+		{
+			name:     "Other error",
+			err:      plain,
+			expected: plain,
+			msg:      plain.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				err := mapError(tt.err)
+				assert.ErrorIs(t, err, tt.expected)
+				assert.Equal(t, tt.msg, err.Error())
+			},
+		)
+	}
+}
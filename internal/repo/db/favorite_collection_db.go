@@ -0,0 +1,261 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/opentracing/opentracing-go"
+)
+
+func (r *Repository) ListFavoriteCollections(ctx context.Context, uid uuid.UUID) ([]*model.FavoriteCollection, error) {
+	const op = "favorites.ListFavoriteCollections.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	rows, err := r.conn.Query(favCollectionListQ, uid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*model.FavoriteCollection, 0, 5)
+	for rows.Next() {
+		fc, err := scanFavoriteCollection(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, fc)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err = r.populateFavoriteCollections(res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *Repository) GetFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64) (*model.FavoriteCollection, error) {
+	const op = "favorites.GetFavoriteCollection.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := scanFavoriteCollection(r.conn.QueryRow(favCollectionGetQ, id, uid))
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return nil, repo.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err = r.populateFavoriteCollections([]*model.FavoriteCollection{res}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *Repository) GetSharedFavoriteCollection(ctx context.Context, token string) (*model.FavoriteCollection, error) {
+	const op = "favorites.GetSharedFavoriteCollection.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := scanFavoriteCollection(r.conn.QueryRow(favCollectionSharedQ, token))
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return nil, repo.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err = r.populateFavoriteCollections([]*model.FavoriteCollection{res}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *Repository) CreateFavoriteCollection(ctx context.Context, uid uuid.UUID, req *model.FavoriteCollection) (uint64, error) {
+	const op = "favorites.CreateFavoriteCollection.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var id uint64
+	err := r.conn.QueryRow(favCollectionCreateQ, uid, req.Name).Scan(&id)
+	if err != nil {
+		return 0, mapError(err)
+	}
+
+	return id, nil
+}
+
+func (r *Repository) UpdateFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64, req *model.FavoriteCollection) error {
+	const op = "favorites.UpdateFavoriteCollection.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.Exec(favCollectionUpdateQ, req.Name, id, uid)
+	if err != nil {
+		return mapError(err)
+	}
+
+	if aff, _ := res.RowsAffected(); aff == 0 {
+		return repo.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64) error {
+	const op = "favorites.DeleteFavoriteCollection.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.Exec(favCollectionDeleteQ, id, uid)
+	if err != nil {
+		return mapError(err)
+	}
+
+	if aff, _ := res.RowsAffected(); aff == 0 {
+		return repo.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) ShareFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64, token string) (string, error) {
+	const op = "favorites.ShareFavoriteCollection.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var res string
+	err := r.conn.QueryRow(favCollectionShareQ, token, id, uid).Scan(&res)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return "", repo.ErrNotFound
+	} else if err != nil {
+		return "", mapError(err)
+	}
+
+	return res, nil
+}
+
+func (r *Repository) UnshareFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64) error {
+	const op = "favorites.UnshareFavoriteCollection.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.Exec(favCollectionUnshareQ, id, uid)
+	if err != nil {
+		return mapError(err)
+	}
+
+	if aff, _ := res.RowsAffected(); aff == 0 {
+		return repo.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) SetFavoriteCollectionItem(ctx context.Context, uid uuid.UUID, id uint64, req *model.FavoriteCollectionItem) error {
+	const op = "favorites.SetFavoriteCollectionItem.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.Exec(favCollectionItemSetQ, id, uid, req.ItemID, req.Note)
+	if err != nil && errors.Is(mapError(err), repo.ErrMissingReference) {
+		return repo.ErrNotFound
+	} else if err != nil {
+		return mapError(err)
+	}
+
+	if aff, _ := res.RowsAffected(); aff == 0 {
+		return repo.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) RemoveFavoriteCollectionItem(ctx context.Context, uid uuid.UUID, id uint64, itemID uuid.UUID) error {
+	const op = "favorites.RemoveFavoriteCollectionItem.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.Exec(favCollectionItemDeleteQ, id, uid, itemID)
+	if err != nil {
+		return mapError(err)
+	}
+
+	if aff, _ := res.RowsAffected(); aff == 0 {
+		return repo.ErrNotFound
+	}
+
+	return nil
+}
+
+// populateFavoriteCollections loads the items of all collections in one query.
+func (r *Repository) populateFavoriteCollections(collections []*model.FavoriteCollection) error {
+	if len(collections) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(collections))
+	byID := make(map[uint64]*model.FavoriteCollection, len(collections))
+	for _, v := range collections {
+		v.Items = make([]model.FavoriteCollectionItem, 0, 10)
+		ids = append(ids, int64(v.ID))
+		byID[v.ID] = v
+	}
+
+	rows, err := r.conn.Query(favCollectionItemsQ, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		ci := model.FavoriteCollectionItem{}
+		if err = rows.Scan(
+			&ci.CollectionID,
+			&ci.ItemID,
+			&ci.Note,
+			&ci.CreatedAt,
+			&ci.UpdatedAt,
+			&ci.Item.ID,
+			&ci.Item.Title,
+			&ci.Item.Src,
+			&ci.Item.Alt,
+			&ci.Item.Price.Amount,
+			&ci.Item.Price.Currency,
+			&ci.Item.InStock,
+		); err != nil {
+			return err
+		}
+
+		if fc, ok := byID[ci.CollectionID]; ok {
+			fc.Items = append(fc.Items, ci)
+		}
+	}
+
+	return rows.Err()
+}
+
+func scanFavoriteCollection(row rowScanner) (*model.FavoriteCollection, error) {
+	fc := &model.FavoriteCollection{}
+	if err := row.Scan(
+		&fc.ID,
+		&fc.UserID,
+		&fc.Name,
+		&fc.ShareToken,
+		&fc.CreatedAt,
+		&fc.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return fc, nil
+}
@@ -0,0 +1,247 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	repo2 "github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var favCollectionCols = []string{"id", "user_id", "name", "share_token", "created_at", "updated_at"}
+
+func TestRepository_GetFavoriteCollection(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	uid, itemID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, *model.FavoriteCollection, error)
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(favCollectionGetQ)).
+					WithArgs(uint64(1), uid).
+					WillReturnRows(sqlmock.NewRows(favCollectionCols).AddRow(1, uid.String(), "Birthday", "", now, now))
+				mock.ExpectQuery(regexp.QuoteMeta(favCollectionItemsQ)).
+					WithArgs(pq.Array([]int64{1})).
+					WillReturnRows(
+						sqlmock.NewRows(
+							[]string{
+								"collection_id", "item_id", "note", "created_at", "updated_at",
+								"id", "title", "src", "alt", "price", "currency", "in_stock",
+							},
+						).AddRow(1, itemID.String(), "size M", now, now, itemID.String(), "Item", "src", "alt", 1050, "RUB", true),
+					)
+			},
+			expectedResp: func(t *testing.T, res *model.FavoriteCollection, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "Birthday", res.Name)
+				require.Len(t, res.Items, 1)
+				assert.Equal(t, "size M", res.Items[0].Note)
+				assert.Equal(t, model.NewMoney(1050, "RUB"), res.Items[0].Item.Price)
+			},
+		},
+		{
+			name: "Not found",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(favCollectionGetQ)).
+					WithArgs(uint64(1), uid).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedResp: func(t *testing.T, res *model.FavoriteCollection, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, repo2.ErrNotFound, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := repo.GetFavoriteCollection(context.Background(), uid, 1)
+				tt.expectedResp(t, res, err)
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_CreateFavoriteCollection(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	uid := uuid.New()
+	req := &model.FavoriteCollection{Name: "Birthday"}
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, uint64, error)
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(favCollectionCreateQ)).
+					WithArgs(uid, req.Name).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+			},
+			expectedResp: func(t *testing.T, res uint64, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, uint64(3), res)
+			},
+		},
+		{
+			name: "Already exists",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(favCollectionCreateQ)).
+					WithArgs(uid, req.Name).
+					WillReturnError(&pq.Error{Code: "23505", Detail: "Key (user_id, name)=(test) already exists."})
+			},
+			expectedResp: func(t *testing.T, res uint64, err error) {
+				assert.Equal(t, uint64(0), res)
+				assert.ErrorIs(t, err, repo2.ErrAlreadyExists)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := repo.CreateFavoriteCollection(context.Background(), uid, req)
+				tt.expectedResp(t, res, err)
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_ShareFavoriteCollection(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	uid := uuid.New()
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, string, error)
+	}{
+		{
+			name: "Keeps existing token",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(favCollectionShareQ)).
+					WithArgs("new", uint64(1), uid).
+					WillReturnRows(sqlmock.NewRows([]string{"share_token"}).AddRow("old"))
+			},
+			expectedResp: func(t *testing.T, res string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "old", res)
+			},
+		},
+		{
+			name: "Not owned",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(favCollectionShareQ)).
+					WithArgs("new", uint64(1), uid).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedResp: func(t *testing.T, res string, err error) {
+				assert.Empty(t, res)
+				assert.Equal(t, repo2.ErrNotFound, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := repo.ShareFavoriteCollection(context.Background(), uid, 1, "new")
+				tt.expectedResp(t, res, err)
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_SetFavoriteCollectionItem(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	uid := uuid.New()
+	req := &model.FavoriteCollectionItem{ItemID: uuid.New(), Note: "size M"}
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, error)
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				mock.ExpectExec(regexp.QuoteMeta(favCollectionItemSetQ)).
+					WithArgs(uint64(1), uid, req.ItemID, req.Note).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			expectedResp: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Collection not owned",
+			mockExpect: func() {
+				mock.ExpectExec(regexp.QuoteMeta(favCollectionItemSetQ)).
+					WithArgs(uint64(1), uid, req.ItemID, req.Note).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.Equal(t, repo2.ErrNotFound, err)
+			},
+		},
+		{
+			name: "Item not found",
+			mockExpect: func() {
+				mock.ExpectExec(regexp.QuoteMeta(favCollectionItemSetQ)).
+					WithArgs(uint64(1), uid, req.ItemID, req.Note).
+					WillReturnError(&pq.Error{Code: "23503", Detail: "Key (item_id)=(test) is not present in table \"item\"."})
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.Equal(t, repo2.ErrNotFound, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				err := repo.SetFavoriteCollectionItem(context.Background(), uid, 1, req)
+				tt.expectedResp(t, err)
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
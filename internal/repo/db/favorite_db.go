@@ -2,42 +2,67 @@ package db
 
 import (
 	"context"
-	"database/sql"
 	"errors"
+	"fmt"
 	"github.com/JMURv/par-pro/products/internal/repo"
 	"github.com/JMURv/par-pro/products/pkg/model"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/opentracing/opentracing-go"
-	"strings"
 )
 
-func (r *Repository) GetFavorites(ctx context.Context, uid uuid.UUID) ([]*model.Favorite, error) {
-	const op = "favorites.GetFavorites.repo"
+// favSortOrders whitelists the ORDER BY clauses for ListFavorites. Price sorts by the effective price.
+var favSortOrders = map[string]string{
+	model.FavoriteSortDateAdded:       "f.created_at ASC, i.id",
+	"-" + model.FavoriteSortDateAdded: "f.created_at DESC, i.id",
+	model.FavoriteSortPrice:           "i.price * (100 - COALESCE(d.discount, 0)) ASC, i.id",
+	"-" + model.FavoriteSortPrice:     "i.price * (100 - COALESCE(d.discount, 0)) DESC, i.id",
+}
+
+func (r *Repository) ListFavorites(ctx context.Context, uid uuid.UUID, page, size int, sort string) (*model.PaginatedFavoritesData, error) {
+	const op = "favorites.ListFavorites.repo"
 	span, _ := opentracing.StartSpanFromContext(ctx, op)
 	defer span.Finish()
 
-	rows, err := r.conn.Query(favGetQ, uid)
-	if err != nil && errors.Is(err, sql.ErrNoRows) {
-		return nil, repo.ErrNotFound
-	} else if err != nil {
+	order, ok := favSortOrders[sort]
+	if !ok {
+		order = favSortOrders["-"+model.FavoriteSortDateAdded]
+	}
+
+	var count int64
+	if err := r.conn.QueryRow(favCountQ, uid).Scan(&count); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.conn.Query(fmt.Sprintf(favListQ, order), uid, (page-1)*size, size)
+	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	res := make([]*model.Favorite, 0, 20)
+	res := make([]*model.Favorite, 0, size)
 	for rows.Next() {
 		f := &model.Favorite{}
 		if err = rows.Scan(
 			&f.UserID,
 			&f.ItemID,
+			&f.NotifyPriceDrop,
+			&f.NotifyInStock,
+			&f.CreatedAt,
 			&f.Item.ID,
 			&f.Item.Title,
+			&f.Item.Article,
 			&f.Item.Src,
 			&f.Item.Alt,
-			&f.Item.Price,
+			&f.Item.Price.Amount,
+			&f.Item.Price.Currency,
+			&f.Item.InStock,
+			&f.Item.ParentItemID,
+			&f.Discount,
 		); err != nil {
 			return nil, err
 		}
+		f.EffectivePrice = f.Item.Price.Discount(f.Discount)
 		res = append(res, f)
 	}
 
@@ -45,7 +70,81 @@ func (r *Repository) GetFavorites(ctx context.Context, uid uuid.UUID) ([]*model.
 		return nil, err
 	}
 
-	return res, nil
+	if err = r.populateFavoriteItems(res); err != nil {
+		return nil, err
+	}
+
+	totalPages := int((count + int64(size) - 1) / int64(size))
+	return &model.PaginatedFavoritesData{
+		Data:        res,
+		Count:       count,
+		TotalPages:  totalPages,
+		CurrentPage: page,
+		HasNextPage: page < totalPages,
+	}, nil
+}
+
+// populateFavoriteItems loads labels and variants for a page of favorites in one query each.
+func (r *Repository) populateFavoriteItems(favs []*model.Favorite) error {
+	if len(favs) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(favs))
+	items := make(map[uuid.UUID][]*model.Item, len(favs))
+	for i, f := range favs {
+		ids[i] = f.ItemID.String()
+		items[f.ItemID] = append(items[f.ItemID], &f.Item)
+	}
+
+	rows, err := r.conn.Query(favItemLabelsQ, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var itemID uuid.UUID
+		var label string
+		if err = rows.Scan(&itemID, &label); err != nil {
+			return err
+		}
+		for _, i := range items[itemID] {
+			i.Labels = append(i.Labels, label)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	rows, err = r.conn.Query(favItemVariantsQ, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		v := model.Item{}
+		if err = rows.Scan(
+			&v.ID,
+			&v.ParentItemID,
+			&v.Title,
+			&v.Article,
+			&v.Src,
+			&v.Alt,
+			&v.Price.Amount,
+			&v.Price.Currency,
+			&v.InStock,
+		); err != nil {
+			return err
+		}
+		for _, i := range items[v.ParentItemID] {
+			i.Variants = append(i.Variants, v)
+		}
+	}
+
+	return rows.Err()
 }
 
 func (r *Repository) AddToFavorites(ctx context.Context, uid uuid.UUID, itemID uuid.UUID) (*model.Favorite, error) {
@@ -57,10 +156,10 @@ func (r *Repository) AddToFavorites(ctx context.Context, uid uuid.UUID, itemID u
 	var insertedItemID uuid.UUID
 	err = r.conn.QueryRow(favAddQ, uid, itemID).Scan(&insertedItemID)
 	if err != nil {
-		if strings.Contains(err.Error(), "violates foreign key constraint") {
+		if errors.Is(mapError(err), repo.ErrMissingReference) {
 			return nil, repo.ErrNotFound
 		}
-		return nil, err
+		return nil, mapError(err)
 	}
 
 	if insertedItemID == uuid.Nil {
@@ -80,7 +179,7 @@ func (r *Repository) RemoveFromFavorites(ctx context.Context, uid uuid.UUID, ite
 
 	res, err := r.conn.Exec(favDelQ, uid, itemID)
 	if err != nil {
-		return err
+		return mapError(err)
 	}
 
 	if aff, _ := res.RowsAffected(); aff == 0 {
@@ -89,3 +188,88 @@ func (r *Repository) RemoveFromFavorites(ctx context.Context, uid uuid.UUID, ite
 
 	return nil
 }
+
+func (r *Repository) SetFavoriteNotifications(ctx context.Context, uid uuid.UUID, req *model.Favorite) error {
+	const op = "favorites.SetFavoriteNotifications.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.Exec(favNotificationsQ, req.NotifyPriceDrop, req.NotifyInStock, uid, req.ItemID)
+	if err != nil {
+		return mapError(err)
+	}
+
+	if aff, _ := res.RowsAffected(); aff == 0 {
+		return repo.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) ListFavoriteSubscribers(ctx context.Context, itemID uuid.UUID, t model.FavoriteEventType) ([]uuid.UUID, error) {
+	const op = "favorites.ListFavoriteSubscribers.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	q := favPriceDropSubscribersQ
+	if t == model.FavoriteEventBackInStock {
+		q = favInStockSubscribersQ
+	}
+
+	rows, err := r.conn.Query(q, itemID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]uuid.UUID, 0, 10)
+	for rows.Next() {
+		var uid uuid.UUID
+		if err = rows.Scan(&uid); err != nil {
+			return nil, err
+		}
+		res = append(res, uid)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *Repository) GetItemStates(ctx context.Context, items []uuid.UUID) (map[uuid.UUID]model.ItemState, error) {
+	const op = "favorites.GetItemStates.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	ids := make([]string, 0, len(items))
+	for _, v := range items {
+		ids = append(ids, v.String())
+	}
+
+	rows, err := r.conn.Query(favItemStatesQ, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make(map[uuid.UUID]model.ItemState, len(items))
+	for rows.Next() {
+		var uid uuid.UUID
+		var discount int
+		st := model.ItemState{}
+		if err = rows.Scan(&uid, &st.Price.Amount, &st.Price.Currency, &st.InStock, &discount); err != nil {
+			return nil, err
+		}
+
+		st.Price = st.Price.Discount(discount)
+		res[uid] = st
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
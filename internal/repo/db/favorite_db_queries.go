@@ -1,20 +1,147 @@
 package db
 
-const favGetQ = `
-	SELECT f.user_id, f.item_id, i.id, i.title, i.src, i.alt, i.price 
+const favCountQ = `SELECT COUNT(*) FROM favorites f JOIN item i ON i.id = f.item_id AND i.deleted_at IS NULL WHERE f.user_id = $1`
+
+// favListQ is completed with an ORDER BY clause from favSortOrders.
+const favListQ = `
+	SELECT f.user_id, f.item_id, f.notify_price_drop, f.notify_in_stock, f.created_at,
+	       i.id, i.title, i.article, i.src, i.alt, i.price, i.currency, i.in_stock, i.parent_id,
+	       COALESCE(d.discount, 0)
 	FROM favorites f
-	JOIN item i ON i.id = f.item_id
-	WHERE user_id = ?
+	JOIN item i ON i.id = f.item_id AND i.deleted_at IS NULL
+	LEFT JOIN LATERAL (
+		SELECT MAX(pi.discount) AS discount
+		FROM promotion_item pi
+		JOIN promotion p ON p.slug = pi.promotion_slug AND p.deleted_at IS NULL
+		WHERE pi.item_id = i.id AND p.lasts_to > NOW()
+	) d ON TRUE
+	WHERE f.user_id = $1
+	ORDER BY %s
+	OFFSET $2 LIMIT $3
+`
+
+const favItemLabelsQ = `
+	SELECT il.item_id, l.name
+	FROM item_label il
+	JOIN label l ON l.id = il.label_id
+	WHERE il.item_id = ANY($1)
+	ORDER BY l.priority DESC, l.name
+`
+
+const favItemVariantsQ = `
+	SELECT i.id, i.parent_id, i.title, i.article, i.src, i.alt, i.price, i.currency, i.in_stock
+	FROM item i
+	WHERE i.parent_id = ANY($1) AND i.deleted_at IS NULL
+	ORDER BY i.title
 `
 
 const favAddQ = `
 	INSERT INTO favorites (user_id, item_id) 
-	VALUES (?, ?)
+	VALUES ($1, $2)
 	ON CONFLICT (user_id, item_id) DO NOTHING
 	RETURNING item_id
 `
 
 const favDelQ = `
 	DELETE FROM favorites
-	WHERE user_id = ? AND item_id = ?
+	WHERE user_id = $1 AND item_id = $2
+`
+
+const favNotificationsQ = `
+	UPDATE favorites
+	SET notify_price_drop = $1, notify_in_stock = $2
+	WHERE user_id = $3 AND item_id = $4
+`
+
+const favPriceDropSubscribersQ = `
+	SELECT user_id FROM favorites
+	WHERE item_id = $1 AND notify_price_drop
+`
+
+const favInStockSubscribersQ = `
+	SELECT user_id FROM favorites
+	WHERE item_id = $1 AND notify_in_stock
+`
+
+const favItemStatesQ = `
+	SELECT i.id, i.price, i.currency, i.in_stock,
+	       COALESCE(MAX(pi.discount) FILTER (WHERE p.lasts_to > NOW()), 0)
+	FROM item i
+	LEFT JOIN promotion_item pi ON pi.item_id = i.id
+	LEFT JOIN promotion p ON p.slug = pi.promotion_slug AND p.deleted_at IS NULL
+	WHERE i.id = ANY($1)
+	GROUP BY i.id
+`
+
+const favCollectionListQ = `
+	SELECT id, user_id, name, COALESCE(share_token, ''), created_at, updated_at
+	FROM favorite_collection
+	WHERE user_id = $1
+	ORDER BY created_at
+`
+
+const favCollectionGetQ = `
+	SELECT id, user_id, name, COALESCE(share_token, ''), created_at, updated_at
+	FROM favorite_collection
+	WHERE id = $1 AND user_id = $2
+`
+
+const favCollectionSharedQ = `
+	SELECT id, user_id, name, COALESCE(share_token, ''), created_at, updated_at
+	FROM favorite_collection
+	WHERE share_token = $1
+`
+
+const favCollectionItemsQ = `
+	SELECT ci.collection_id, ci.item_id, ci.note, ci.created_at, ci.updated_at,
+	       i.id, i.title, i.src, i.alt, i.price, i.currency, i.in_stock
+	FROM favorite_collection_item ci
+	JOIN item i ON i.id = ci.item_id AND i.deleted_at IS NULL
+	WHERE ci.collection_id = ANY($1)
+	ORDER BY ci.created_at
+`
+
+const favCollectionCreateQ = `
+	INSERT INTO favorite_collection (user_id, name)
+	VALUES ($1, $2)
+	RETURNING id
+`
+
+const favCollectionUpdateQ = `
+	UPDATE favorite_collection
+	SET name = $1, updated_at = CURRENT_TIMESTAMP
+	WHERE id = $2 AND user_id = $3
+`
+
+const favCollectionDeleteQ = `
+	DELETE FROM favorite_collection
+	WHERE id = $1 AND user_id = $2
+`
+
+// An already shared collection keeps its token, so links handed out earlier stay valid
+const favCollectionShareQ = `
+	UPDATE favorite_collection
+	SET share_token = COALESCE(share_token, $1)
+	WHERE id = $2 AND user_id = $3
+	RETURNING share_token
+`
+
+const favCollectionUnshareQ = `
+	UPDATE favorite_collection
+	SET share_token = NULL
+	WHERE id = $1 AND user_id = $2
+`
+
+const favCollectionItemSetQ = `
+	INSERT INTO favorite_collection_item (collection_id, item_id, note)
+	SELECT id, $3, $4 FROM favorite_collection
+	WHERE id = $1 AND user_id = $2
+	ON CONFLICT (collection_id, item_id) DO UPDATE
+	SET note = EXCLUDED.note, updated_at = CURRENT_TIMESTAMP
+`
+
+const favCollectionItemDeleteQ = `
+	DELETE FROM favorite_collection_item ci
+	USING favorite_collection c
+	WHERE c.id = ci.collection_id AND c.id = $1 AND c.user_id = $2 AND ci.item_id = $3
 `
@@ -2,76 +2,109 @@ package db
 
 import (
 	"context"
-	"database/sql"
 	"errors"
+	"fmt"
 	repo2 "github.com/JMURv/par-pro/products/internal/repo"
 	"github.com/JMURv/par-pro/products/pkg/model"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/DATA-DOG/go-sqlmock.v1"
 	"regexp"
 	"testing"
+	"time"
 )
 
-func TestRepository_GetFavorites(t *testing.T) {
+func TestRepository_ListFavorites(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
 	defer db.Close()
 
 	repo := Repository{conn: db}
 	uid := uuid.New()
+	itemID, variantID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	cols := []string{
+		"user_id", "item_id", "notify_price_drop", "notify_in_stock", "created_at",
+		"id", "title", "article", "src", "alt", "price", "currency", "in_stock", "parent_id", "discount",
+	}
+	listQ := fmt.Sprintf(favListQ, favSortOrders["-"+model.FavoriteSortPrice])
 
 	tests := []struct {
 		name         string
 		mockExpect   func()
-		expectedResp func(*testing.T, []*model.Favorite, error)
+		expectedResp func(*testing.T, *model.PaginatedFavoritesData, error)
 	}{
 		{
 			name: "Success",
 			mockExpect: func() {
-				rows := sqlmock.NewRows(
-					[]string{
-						"user_id", "item_id", "id", "title", "src", "alt", "price",
-					},
-				).
-					AddRow(uid.String(), uuid.New().String(), uuid.New().String(), "Item 1", "src1", "alt1", 10.5).
-					AddRow(uid.String(), uuid.New().String(), uuid.New().String(), "Item 2", "src2", "alt2", 15.5)
-
-				mock.ExpectQuery(regexp.QuoteMeta(favGetQ)).
+				mock.ExpectQuery(regexp.QuoteMeta(favCountQ)).
 					WithArgs(uid).
-					WillReturnRows(rows)
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+				mock.ExpectQuery(regexp.QuoteMeta(listQ)).
+					WithArgs(uid, 2, 2).
+					WillReturnRows(
+						sqlmock.NewRows(cols).
+							AddRow(uid.String(), itemID.String(), true, false, now, itemID.String(), "Item 1", "A-1", "src1", "alt1", int64(1050), "RUB", true, nil, 10),
+					)
+
+				mock.ExpectQuery(regexp.QuoteMeta(favItemLabelsQ)).
+					WithArgs(pq.Array([]string{itemID.String()})).
+					WillReturnRows(sqlmock.NewRows([]string{"item_id", "name"}).AddRow(itemID.String(), "hit"))
+
+				mock.ExpectQuery(regexp.QuoteMeta(favItemVariantsQ)).
+					WithArgs(pq.Array([]string{itemID.String()})).
+					WillReturnRows(
+						sqlmock.NewRows(
+							[]string{"id", "parent_id", "title", "article", "src", "alt", "price", "currency", "in_stock"},
+						).AddRow(variantID.String(), itemID.String(), "Item 1 XL", "A-1-XL", "src", "alt", int64(1100), "RUB", false),
+					)
 			},
-			expectedResp: func(t *testing.T, res []*model.Favorite, err error) {
+			expectedResp: func(t *testing.T, res *model.PaginatedFavoritesData, err error) {
 				require.NoError(t, err)
-				require.NotNil(t, res)
-				assert.Len(t, res, 2)
-				assert.Equal(t, "Item 1", res[0].Item.Title)
-				assert.Equal(t, "Item 2", res[1].Item.Title)
+				require.Len(t, res.Data, 1)
+				assert.Equal(t, int64(3), res.Count)
+				assert.Equal(t, 2, res.TotalPages)
+				assert.False(t, res.HasNextPage)
+
+				fav := res.Data[0]
+				assert.True(t, fav.NotifyPriceDrop)
+				assert.True(t, fav.Item.InStock)
+				assert.Equal(t, model.NewMoney(1050, "RUB"), fav.Item.Price)
+				assert.Equal(t, 10, fav.Discount)
+				assert.Equal(t, model.NewMoney(945, "RUB"), fav.EffectivePrice)
+				assert.Equal(t, []string{"hit"}, fav.Item.Labels)
+				require.Len(t, fav.Item.Variants, 1)
+				assert.Equal(t, variantID, fav.Item.Variants[0].ID)
 			},
 		},
 		{
-			name: "NotFound",
+			name: "CountError",
 			mockExpect: func() {
-				mock.ExpectQuery(regexp.QuoteMeta(favGetQ)).
+				mock.ExpectQuery(regexp.QuoteMeta(favCountQ)).
 					WithArgs(uid).
-					WillReturnError(sql.ErrNoRows)
+					WillReturnError(errors.New("query error"))
 			},
-			expectedResp: func(t *testing.T, res []*model.Favorite, err error) {
-				assert.Error(t, err)
-				assert.Equal(t, repo2.ErrNotFound, err)
+			expectedResp: func(t *testing.T, res *model.PaginatedFavoritesData, err error) {
+				assert.Equal(t, "query error", err.Error())
 				assert.Nil(t, res)
 			},
 		},
 		{
 			name: "QueryError",
 			mockExpect: func() {
-				mock.ExpectQuery(regexp.QuoteMeta(favGetQ)).
+				mock.ExpectQuery(regexp.QuoteMeta(favCountQ)).
 					WithArgs(uid).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+				mock.ExpectQuery(regexp.QuoteMeta(listQ)).
+					WithArgs(uid, 2, 2).
 					WillReturnError(errors.New("query error"))
 			},
-			expectedResp: func(t *testing.T, res []*model.Favorite, err error) {
-				assert.Error(t, err)
+			expectedResp: func(t *testing.T, res *model.PaginatedFavoritesData, err error) {
 				assert.Equal(t, "query error", err.Error())
 				assert.Nil(t, res)
 			},
@@ -79,19 +112,18 @@ func TestRepository_GetFavorites(t *testing.T) {
 		{
 			name: "ScanError",
 			mockExpect: func() {
-				rows := sqlmock.NewRows(
-					[]string{
-						"user_id", "item_id", "id", "title", "src", "alt", "price",
-					},
-				).
-					AddRow(uuid.Nil, uuid.New().String(), uuid.New().String(), "Item 1", "src1", "alt1", 10.5)
-
-				mock.ExpectQuery(regexp.QuoteMeta(favGetQ)).
+				mock.ExpectQuery(regexp.QuoteMeta(favCountQ)).
 					WithArgs(uid).
-					WillReturnRows(rows)
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+				mock.ExpectQuery(regexp.QuoteMeta(listQ)).
+					WithArgs(uid, 2, 2).
+					WillReturnRows(
+						sqlmock.NewRows(cols).
+							AddRow(uuid.Nil, itemID.String(), true, false, now, itemID.String(), "Item 1", "A-1", "src1", "alt1", int64(1050), "RUB", true, nil, 10),
+					)
 			},
-			expectedResp: func(t *testing.T, res []*model.Favorite, err error) {
-				assert.Error(t, err)
+			expectedResp: func(t *testing.T, res *model.PaginatedFavoritesData, err error) {
 				assert.Contains(t, err.Error(), "Scan")
 				assert.Nil(t, res)
 			},
@@ -102,7 +134,7 @@ func TestRepository_GetFavorites(t *testing.T) {
 		t.Run(
 			tt.name, func(t *testing.T) {
 				tt.mockExpect()
-				res, err := repo.GetFavorites(context.Background(), uid)
+				res, err := repo.ListFavorites(context.Background(), uid, 2, 2, "-"+model.FavoriteSortPrice)
 				tt.expectedResp(t, res, err)
 				err = mock.ExpectationsWereMet()
 				assert.NoError(t, err)
@@ -111,6 +143,27 @@ func TestRepository_GetFavorites(t *testing.T) {
 	}
 }
 
+func TestRepository_ListFavoritesUnknownSort(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	uid := uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(favCountQ)).
+		WithArgs(uid).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(favListQ, favSortOrders["-"+model.FavoriteSortDateAdded]))).
+		WithArgs(uid, 0, 40).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}))
+
+	res, err := repo.ListFavorites(context.Background(), uid, 1, 40, "title; DROP TABLE item")
+	require.NoError(t, err)
+	assert.Empty(t, res.Data)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestRepository_AddToFavorites(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -157,7 +210,7 @@ func TestRepository_AddToFavorites(t *testing.T) {
 			mockExpect: func() {
 				mock.ExpectQuery(regexp.QuoteMeta(favAddQ)).
 					WithArgs(uid, itemID).
-					WillReturnError(errors.New("violates foreign key constraint"))
+					WillReturnError(&pq.Error{Code: "23503", Detail: "Key (item_id)=(test) is not present in table \"item\"."})
 			},
 			expectedResp: func(t *testing.T, res *model.Favorite, err error) {
 				assert.Error(t, err)
@@ -256,3 +309,44 @@ func TestRepository_RemoveFromFavorites(t *testing.T) {
 		)
 	}
 }
+
+func TestRepository_GetItemStates(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	promoted, regular := uuid.New(), uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(favItemStatesQ)).
+		WithArgs(pq.Array([]string{promoted.String(), regular.String()})).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "price", "currency", "in_stock", "discount"}).
+				AddRow(promoted.String(), 1050, "RUB", true, 10).
+				AddRow(regular.String(), 2000, "RUB", false, 0),
+		)
+
+	res, err := repo.GetItemStates(context.Background(), []uuid.UUID{promoted, regular})
+	require.NoError(t, err)
+	assert.Equal(t, model.ItemState{Price: model.NewMoney(945, "RUB"), InStock: true}, res[promoted])
+	assert.Equal(t, model.ItemState{Price: model.NewMoney(2000, "RUB")}, res[regular])
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_SetFavoriteNotifications(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	uid := uuid.New()
+	req := &model.Favorite{ItemID: uuid.New(), NotifyPriceDrop: true}
+
+	mock.ExpectExec(regexp.QuoteMeta(favNotificationsQ)).
+		WithArgs(true, false, uid, req.ItemID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.SetFavoriteNotifications(context.Background(), uid, req)
+	assert.Equal(t, repo2.ErrNotFound, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
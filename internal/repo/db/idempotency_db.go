@@ -0,0 +1,93 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/opentracing/opentracing-go"
+	"time"
+)
+
+// ClaimIdempotencyKey stores key for a request in flight, it returns false when the key is already taken.
+func (r *Repository) ClaimIdempotencyKey(ctx context.Context, key, hash string, lock time.Duration) (bool, error) {
+	const op = "idempotency.ClaimIdempotencyKey.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var claimed string
+	err := r.conn.QueryRow(idempotencyClaimQ, key, hash, lock.Seconds()).Scan(&claimed)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *Repository) GetIdempotencyRecord(ctx context.Context, key string) (*model.IdempotencyRecord, error) {
+	const op = "idempotency.GetIdempotencyRecord.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res := &model.IdempotencyRecord{}
+	err := r.conn.QueryRow(idempotencyGetQ, key).Scan(
+		&res.Key,
+		&res.RequestHash,
+		&res.Status,
+		&res.Response,
+		&res.CreatedAt,
+	)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return nil, repo.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *Repository) SaveIdempotencyResponse(ctx context.Context, key string, status int, response []byte) error {
+	const op = "idempotency.SaveIdempotencyResponse.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.Exec(idempotencySaveQ, key, status, response)
+	if err != nil {
+		return err
+	}
+
+	aff, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if aff == 0 {
+		return repo.ErrNotFound
+	}
+	return nil
+}
+
+// ReleaseIdempotencyKey forgets a claim whose request failed, stored responses are kept.
+func (r *Repository) ReleaseIdempotencyKey(ctx context.Context, key string) error {
+	const op = "idempotency.ReleaseIdempotencyKey.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	_, err := r.conn.Exec(idempotencyReleaseQ, key)
+	return err
+}
+
+func (r *Repository) DeleteExpiredIdempotencyKeys(ctx context.Context, before time.Time) (int64, error) {
+	const op = "idempotency.DeleteExpiredIdempotencyKeys.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.Exec(idempotencyDeleteExpiredQ, before)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
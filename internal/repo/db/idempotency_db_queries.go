@@ -0,0 +1,22 @@
+package db
+
+// A claim still in flight after the lock timeout was abandoned and is taken over.
+const idempotencyClaimQ = `
+	INSERT INTO idempotency_key (key, request_hash)
+	VALUES ($1, $2)
+	ON CONFLICT (key) DO UPDATE SET request_hash = EXCLUDED.request_hash, created_at = NOW()
+	WHERE idempotency_key.status = 0 AND idempotency_key.created_at < NOW() - $3 * INTERVAL '1 second'
+	RETURNING key
+`
+
+const idempotencyGetQ = `
+	SELECT key, request_hash, status, response, created_at
+	FROM idempotency_key
+	WHERE key = $1
+`
+
+const idempotencySaveQ = `UPDATE idempotency_key SET status = $2, response = $3 WHERE key = $1`
+
+const idempotencyReleaseQ = `DELETE FROM idempotency_key WHERE key = $1 AND status = 0`
+
+const idempotencyDeleteExpiredQ = `DELETE FROM idempotency_key WHERE created_at < $1`
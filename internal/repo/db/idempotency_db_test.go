@@ -0,0 +1,101 @@
+package db
+
+import (
+	"context"
+	"errors"
+	repo2 "github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestRepository_ClaimIdempotencyKey(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	dbErr := errors.New("db error")
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, bool, error)
+	}{
+		{
+			name: "Claimed",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(idempotencyClaimQ)).
+					WithArgs("key", "hash", float64(60)).
+					WillReturnRows(sqlmock.NewRows([]string{"key"}).AddRow("key"))
+			},
+			expectedResp: func(t *testing.T, ok bool, err error) {
+				require.NoError(t, err)
+				assert.True(t, ok)
+			},
+		},
+		{
+			name: "Already taken",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(idempotencyClaimQ)).
+					WithArgs("key", "hash", float64(60)).
+					WillReturnRows(sqlmock.NewRows([]string{"key"}))
+			},
+			expectedResp: func(t *testing.T, ok bool, err error) {
+				require.NoError(t, err)
+				assert.False(t, ok)
+			},
+		},
+		{
+			name: "Unexpected error",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(idempotencyClaimQ)).
+					WithArgs("key", "hash", float64(60)).
+					WillReturnError(dbErr)
+			},
+			expectedResp: func(t *testing.T, ok bool, err error) {
+				assert.ErrorIs(t, err, dbErr)
+				assert.False(t, ok)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+			ok, err := repo.ClaimIdempotencyKey(context.Background(), "key", "hash", time.Minute)
+			tt.expectedResp(t, ok, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_GetIdempotencyRecord(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	now := time.Now()
+	columns := []string{"key", "request_hash", "status", "response", "created_at"}
+
+	mock.ExpectQuery(regexp.QuoteMeta(idempotencyGetQ)).
+		WithArgs("key").
+		WillReturnRows(sqlmock.NewRows(columns).AddRow("key", "hash", 201, []byte(`{"id":1}`), now))
+
+	res, err := repo.GetIdempotencyRecord(context.Background(), "key")
+	require.NoError(t, err)
+	assert.Equal(t, 201, res.Status)
+	assert.Equal(t, []byte(`{"id":1}`), res.Response)
+
+	mock.ExpectQuery(regexp.QuoteMeta(idempotencyGetQ)).
+		WithArgs("missing").
+		WillReturnRows(sqlmock.NewRows(columns))
+
+	_, err = repo.GetIdempotencyRecord(context.Background(), "missing")
+	assert.ErrorIs(t, err, repo2.ErrNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
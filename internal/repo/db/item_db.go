@@ -10,9 +10,22 @@ import (
 	"github.com/google/uuid"
 	"github.com/lib/pq"
 	"github.com/opentracing/opentracing-go"
+	"slices"
 	"strings"
 )
 
+// itemSortOrders whitelists the ORDER BY clauses for ListCategoryItems. Equal ratings rank by the number of reviews.
+var itemSortOrders = map[string]string{
+	md.ItemSortCreatedAt:       "i.created_at ASC, i.id",
+	"-" + md.ItemSortCreatedAt: "i.created_at DESC, i.id",
+	md.ItemSortPrice:           "i.price ASC, i.id",
+	"-" + md.ItemSortPrice:     "i.price DESC, i.id",
+	md.ItemSortTitle:           "i.title ASC, i.id",
+	"-" + md.ItemSortTitle:     "i.title DESC, i.id",
+	md.ItemSortRating:          "i.rating ASC, i.review_count ASC, i.id",
+	"-" + md.ItemSortRating:    "i.rating DESC, i.review_count DESC, i.id",
+}
+
 func (r *Repository) ListCategoryItems(ctx context.Context, slug string, page, size int, filters map[string]any, sort string) (*md.PaginatedItemsData, error) {
 	const op = "items.ListCategoryItems.repo"
 	span, _ := opentracing.StartSpanFromContext(ctx, op)
@@ -26,7 +39,7 @@ func (r *Repository) ListCategoryItems(ctx context.Context, slug string, page, s
 		JOIN item_category ic ON ic.item_id = i.id
 		JOIN category c ON c.slug = ic.category_slug
 		JOIN item_attr ia ON i.id = ia.item_id
-		WHERE ic.category_slug = ?
+		WHERE ic.category_slug = ? AND i.deleted_at IS NULL AND c.deleted_at IS NULL
 	`,
 	)
 
@@ -43,27 +56,28 @@ func (r *Repository) ListCategoryItems(ctx context.Context, slug string, page, s
 
 	q.WriteString(
 		`
-		SELECT i.id, i.title, i.price, i.src, i.alt, 
+		SELECT i.id, i.title, i.price, i.currency, i.src, i.alt, i.rating, i.review_count,
 		ARRAY_AGG(c.title || '|' || c.slug) AS categories,
 		ARRAY_AGG(ia.name || '|' || ia.value) AS attrs
 		FROM item i
 		JOIN item_category ic ON ic.item_id = i.id
 		JOIN category c ON c.slug = ic.category_slug
 		JOIN item_attr ia ON i.id = ia.item_id
-		WHERE ic.category_slug = ?
+		WHERE ic.category_slug = ? AND i.deleted_at IS NULL AND c.deleted_at IS NULL
 	`,
 	)
 
 	args = append(args, slug)
 	args = dbutils.FilterItems(q, args, filters)
 
-	if sort == "" {
-		sort = "i.created_at DESC"
+	order, ok := itemSortOrders[sort]
+	if !ok {
+		order = itemSortOrders["-"+md.ItemSortCreatedAt]
 	}
 
 	q.WriteString(" GROUP BY i.id, i.created_at")
 	q.WriteString(" ORDER BY ")
-	q.WriteString(sort)
+	q.WriteString(order)
 
 	q.WriteString(" OFFSET ? LIMIT ?;")
 	args = append(args, (page-1)*size, size)
@@ -81,9 +95,12 @@ func (r *Repository) ListCategoryItems(ctx context.Context, slug string, page, s
 		if err = rows.Scan(
 			&item.ID,
 			&item.Title,
-			&item.Price,
+			&item.Price.Amount,
+			&item.Price.Currency,
 			&item.Src,
 			&item.Alt,
+			&item.Rating,
+			&item.ReviewCount,
 			pq.Array(&categories),
 			pq.Array(&attrs),
 		); err != nil {
@@ -165,16 +182,17 @@ func (r *Repository) ItemSearch(ctx context.Context, query string, page, size in
 	defer span.Finish()
 
 	terms := strings.Fields(query)
-	conds := make([]string, 0, len(terms))
+	conds := make([]string, 0, len(terms)+1)
+	conds = append(conds, "i.deleted_at IS NULL")
 	args := make([]any, 0, len(terms)+2)
 	for _, term := range terms {
-		conds = append(conds, "title ILIKE ?")
+		conds = append(conds, "i.title ILIKE ?")
 		args = append(args, "%"+term+"%")
 	}
 	searchQ := strings.Join(conds, " AND ")
 
 	var q strings.Builder
-	q.WriteString("SELECT COUNT(*) FROM item WHERE ")
+	q.WriteString("SELECT COUNT(*) FROM item i WHERE ")
 	q.WriteString(searchQ)
 
 	var count int64
@@ -191,6 +209,7 @@ func (r *Repository) ItemSearch(ctx context.Context, query string, page, size in
 	    i.article,
 	    i.description,
 	    i.price,
+	    i.currency,
 	    i.src,
 	    i.alt,
 	    i.in_stock,
@@ -225,7 +244,8 @@ func (r *Repository) ItemSearch(ctx context.Context, query string, page, size in
 			&item.Title,
 			&item.Article,
 			&item.Description,
-			&item.Price,
+			&item.Price.Amount,
+			&item.Price.Currency,
 			&item.Src,
 			&item.Alt,
 			&item.InStock,
@@ -285,7 +305,8 @@ func (r *Repository) ListItems(ctx context.Context, page, size int) (*md.Paginat
 			&item.Title,
 			&item.Article,
 			&item.Description,
-			&item.Price,
+			&item.Price.Amount,
+			&item.Price.Currency,
 			&item.Src,
 			&item.Alt,
 			&item.InStock,
@@ -343,13 +364,17 @@ func (r *Repository) GetItemByUUID(ctx context.Context, uid uuid.UUID) (*md.Item
 		&res.Title,
 		&res.Article,
 		&res.Description,
-		&res.Price,
+		&res.Price.Amount,
+		&res.Price.Currency,
 		&res.Src,
 		&res.Alt,
 		&res.InStock,
 		&res.IsHit,
 		&res.IsRec,
 		&res.ParentItemID,
+		&res.Rating,
+		&res.ReviewCount,
+		&res.Version,
 		&res.CreatedAt,
 		&res.UpdatedAt,
 		pq.Array(&media),
@@ -384,7 +409,7 @@ func (r *Repository) CreateItem(ctx context.Context, i *md.Item) (uuid.UUID, err
 
 	tx, err := r.conn.Begin()
 	if err != nil {
-		return uuid.Nil, err
+		return uuid.Nil, mapError(err)
 	}
 
 	var id uuid.UUID
@@ -393,7 +418,8 @@ func (r *Repository) CreateItem(ctx context.Context, i *md.Item) (uuid.UUID, err
 		i.Title,
 		i.Article,
 		i.Description,
-		i.Price,
+		i.Price.Amount,
+		i.Price.Currency,
 		i.Src,
 		i.Alt,
 		i.InStock,
@@ -402,22 +428,22 @@ func (r *Repository) CreateItem(ctx context.Context, i *md.Item) (uuid.UUID, err
 		i.ParentItemID,
 	).Scan(&id); err != nil {
 		tx.Rollback()
-		return uuid.Nil, err
+		return uuid.Nil, mapError(err)
 	}
 
 	if err = createItemMedias(tx, id, i.Media); err != nil {
 		tx.Rollback()
-		return uuid.Nil, err
+		return uuid.Nil, mapError(err)
 	}
 
 	if err = createItemAttrs(tx, id, i.Attributes); err != nil {
 		tx.Rollback()
-		return uuid.Nil, err
+		return uuid.Nil, mapError(err)
 	}
 
 	if err = CreateItemCategories(tx, id, i.Categories); err != nil {
 		tx.Rollback()
-		return uuid.Nil, err
+		return uuid.Nil, mapError(err)
 	}
 
 	if len(i.Variants) > 0 {
@@ -427,7 +453,8 @@ func (r *Repository) CreateItem(ctx context.Context, i *md.Item) (uuid.UUID, err
 				v.Title,
 				v.Article,
 				v.Description,
-				v.Price,
+				v.Price.Amount,
+				v.Price.Currency,
 				v.Src,
 				v.Alt,
 				v.InStock,
@@ -436,23 +463,23 @@ func (r *Repository) CreateItem(ctx context.Context, i *md.Item) (uuid.UUID, err
 				id,
 			); err != nil {
 				tx.Rollback()
-				return uuid.Nil, err
+				return uuid.Nil, mapError(err)
 			}
 		}
 	}
 
 	if len(i.RelatedProducts) > 0 {
 		for _, v := range i.RelatedProducts {
-			if _, err = r.conn.Exec(itemRelatedProductCreateQ, id, v.RelatedItemID); err != nil {
+			if _, err = tx.Exec(itemRelatedProductCreateQ, id, v.RelatedItemID, v.Type, v.IsSymmetric, v.Position); err != nil {
 				tx.Rollback()
-				return uuid.Nil, err
+				return uuid.Nil, mapError(err)
 			}
 		}
 	}
 
 	if err = tx.Commit(); err != nil {
 		tx.Rollback()
-		return uuid.Nil, err
+		return uuid.Nil, mapError(err)
 	}
 
 	return id, nil
@@ -465,7 +492,18 @@ func (r *Repository) UpdateItem(ctx context.Context, uid uuid.UUID, req *md.Item
 
 	tx, err := r.conn.Begin()
 	if err != nil {
-		return err
+		return mapError(err)
+	}
+
+	version, err := bumpVersion(tx, itemBumpVersionQ, itemVersionQ, uid, req.Version)
+	if err != nil {
+		tx.Rollback()
+		return mapError(err)
+	}
+
+	if err = saveItemRevision(tx, uid); err != nil {
+		tx.Rollback()
+		return mapError(err)
 	}
 
 	res, err := tx.Exec(
@@ -473,7 +511,8 @@ func (r *Repository) UpdateItem(ctx context.Context, uid uuid.UUID, req *md.Item
 		req.Title,
 		req.Article,
 		req.Description,
-		req.Price,
+		req.Price.Amount,
+		req.Price.Currency,
 		req.Src,
 		req.Alt,
 		req.InStock,
@@ -484,7 +523,7 @@ func (r *Repository) UpdateItem(ctx context.Context, uid uuid.UUID, req *md.Item
 	)
 	if err != nil {
 		tx.Rollback()
-		return err
+		return mapError(err)
 	}
 
 	if aff, _ := res.RowsAffected(); aff == 0 {
@@ -494,30 +533,140 @@ func (r *Repository) UpdateItem(ctx context.Context, uid uuid.UUID, req *md.Item
 
 	if err = UpdateItemMedia(tx, uid, req.Media); err != nil {
 		tx.Rollback()
-		return err
+		return mapError(err)
 	}
 
 	if err = UpdateItemAttributes(tx, uid, req.Attributes); err != nil {
 		tx.Rollback()
-		return err
+		return mapError(err)
 	}
 
 	if err = UpdateItemCategories(tx, uid, req.Categories); err != nil {
 		tx.Rollback()
-		return err
+		return mapError(err)
 	}
 
 	if err = UpdateItemRelatedProducts(tx, uid, req.RelatedProducts); err != nil {
 		tx.Rollback()
-		return err
+		return mapError(err)
 	}
 
 	if err = UpdateItemVariants(tx, uid, req.Variants); err != nil {
 		tx.Rollback()
-		return err
+		return mapError(err)
 	}
 
-	return tx.Commit()
+	if err = tx.Commit(); err != nil {
+		return mapError(err)
+	}
+
+	req.Version = version
+	return nil
+}
+
+// PatchItem writes only the fields of req named in fields, sub-collections that are not named are left untouched.
+func (r *Repository) PatchItem(ctx context.Context, uid uuid.UUID, req *md.Item, fields []string) error {
+	const op = "items.PatchItem.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return mapError(err)
+	}
+
+	version, err := bumpVersion(tx, itemBumpVersionQ, itemVersionQ, uid, req.Version)
+	if err != nil {
+		tx.Rollback()
+		return mapError(err)
+	}
+
+	if err = saveItemRevision(tx, uid); err != nil {
+		tx.Rollback()
+		return mapError(err)
+	}
+
+	if err = patchRow(tx, "item", "id", uid, itemPatchColumns(req, fields)); err != nil {
+		tx.Rollback()
+		return mapError(err)
+	}
+
+	if slices.Contains(fields, "media") {
+		if err = UpdateItemMedia(tx, uid, req.Media); err != nil {
+			tx.Rollback()
+			return mapError(err)
+		}
+	}
+
+	if slices.Contains(fields, "attributes") {
+		if err = UpdateItemAttributes(tx, uid, req.Attributes); err != nil {
+			tx.Rollback()
+			return mapError(err)
+		}
+	}
+
+	if slices.Contains(fields, "categories") {
+		if err = UpdateItemCategories(tx, uid, req.Categories); err != nil {
+			tx.Rollback()
+			return mapError(err)
+		}
+	}
+
+	if slices.Contains(fields, "related_products") {
+		if err = UpdateItemRelatedProducts(tx, uid, req.RelatedProducts); err != nil {
+			tx.Rollback()
+			return mapError(err)
+		}
+	}
+
+	if slices.Contains(fields, "variants") {
+		if err = UpdateItemVariants(tx, uid, req.Variants); err != nil {
+			tx.Rollback()
+			return mapError(err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return mapError(err)
+	}
+
+	req.Version = version
+	return nil
+}
+
+// itemPatchColumns returns the columns of the scalar fields named in fields, a patch of the price may set
+// only its amount or its currency.
+func itemPatchColumns(req *md.Item, fields []string) []column {
+	cols := make([]column, 0, len(fields))
+	for _, v := range fields {
+		switch v {
+		case "title":
+			cols = append(cols, column{"title", req.Title})
+		case "article":
+			cols = append(cols, column{"article", req.Article})
+		case "description":
+			cols = append(cols, column{"description", req.Description})
+		case "price":
+			cols = append(cols, column{"price", req.Price.Amount}, column{"currency", req.Price.Currency})
+		case "price.amount":
+			cols = append(cols, column{"price", req.Price.Amount})
+		case "price.currency":
+			cols = append(cols, column{"currency", req.Price.Currency})
+		case "src":
+			cols = append(cols, column{"src", req.Src})
+		case "alt":
+			cols = append(cols, column{"alt", req.Alt})
+		case "in_stock":
+			cols = append(cols, column{"in_stock", req.InStock})
+		case "is_hit":
+			cols = append(cols, column{"is_hit", req.IsHit})
+		case "is_rec":
+			cols = append(cols, column{"is_rec", req.IsRec})
+		case "parent_item_id":
+			cols = append(cols, column{"parent_id", req.ParentItemID})
+		}
+	}
+	return cols
 }
 
 func (r *Repository) DeleteItem(ctx context.Context, uid uuid.UUID) error {
@@ -527,7 +676,7 @@ func (r *Repository) DeleteItem(ctx context.Context, uid uuid.UUID) error {
 
 	res, err := r.conn.Exec(itemDeleteQ, uid)
 	if err != nil {
-		return err
+		return mapError(err)
 	}
 
 	if aff, _ := res.RowsAffected(); aff == 0 {
@@ -560,7 +709,8 @@ func (r *Repository) ListItemVariants(ctx context.Context, uid uuid.UUID) ([]*md
 			&i.Title,
 			&i.Article,
 			&i.Description,
-			&i.Price,
+			&i.Price.Amount,
+			&i.Price.Currency,
 			&i.Src,
 			&i.Alt,
 			&i.InStock,
@@ -608,16 +758,62 @@ func (r *Repository) ListRelatedItems(ctx context.Context, uid uuid.UUID) ([]*md
 
 	res := make([]*md.RelatedProduct, 0, 15)
 	for rows.Next() {
-		var rp md.RelatedProduct
+		rp := md.RelatedProduct{ItemID: uid}
+		if err = rows.Scan(
+			&rp.RelatedItem.ID,
+			&rp.RelatedItem.Title,
+			&rp.RelatedItem.Price.Amount,
+			&rp.RelatedItem.Price.Currency,
+			&rp.RelatedItem.Src,
+			&rp.RelatedItem.Alt,
+			&rp.Type,
+			&rp.IsSymmetric,
+			&rp.Position,
+		); err != nil {
+			return nil, err
+		}
+		rp.RelatedItemID = rp.RelatedItem.ID
+		res = append(res, &rp)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// RecommendItems suggests up to limit in stock items for uid from co-purchases and shared categories and attributes.
+func (r *Repository) RecommendItems(ctx context.Context, uid uuid.UUID, exclude []uuid.UUID, limit int) ([]*md.RelatedProduct, error) {
+	const op = "items.RecommendItems.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	ids := make([]string, 0, len(exclude)+1)
+	ids = append(ids, uid.String())
+	for _, v := range exclude {
+		ids = append(ids, v.String())
+	}
+
+	rows, err := r.conn.Query(itemRecommendQ, uid, pq.Array(ids), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*md.RelatedProduct, 0, limit)
+	for rows.Next() {
+		rp := md.RelatedProduct{ItemID: uid, Type: md.RelatedTypeCrossSell, IsRecommended: true}
 		if err = rows.Scan(
 			&rp.RelatedItem.ID,
 			&rp.RelatedItem.Title,
-			&rp.RelatedItem.Price,
+			&rp.RelatedItem.Price.Amount,
+			&rp.RelatedItem.Price.Currency,
 			&rp.RelatedItem.Src,
 			&rp.RelatedItem.Alt,
 		); err != nil {
 			return nil, err
 		}
+		rp.RelatedItemID = rp.RelatedItem.ID
 		res = append(res, &rp)
 	}
 
@@ -649,7 +845,8 @@ func (r *Repository) ListItemsByLabel(ctx context.Context, label string, page in
 		if err = rows.Scan(
 			&i.ID,
 			&i.Title,
-			&i.Price,
+			&i.Price.Amount,
+			&i.Price.Currency,
 			&i.Src,
 			&i.Alt,
 			&i.IsHit,
@@ -1,7 +1,7 @@
 package db
 
-const itemCountQ = `SELECT COUNT(*) FROM item`
-const itemCountRecQ = `SELECT COUNT(*) FROM item WHERE is_rec = TRUE;`
+const itemCountQ = `SELECT COUNT(*) FROM item WHERE deleted_at IS NULL`
+const itemCountRecQ = `SELECT COUNT(*) FROM item WHERE is_rec = TRUE AND deleted_at IS NULL;`
 const itemCountAttrsQ = `SELECT COUNT(*) FROM item_attr WHERE name ILIKE ?;`
 const itemSearchAttrQ = `SELECT id, name, value FROM item_attr WHERE name ILIKE ? OFFSET ? LIMIT ?`
 
@@ -11,6 +11,7 @@ const itemListQ = `SELECT
     	 i.article,
     	 i.description,
     	 i.price,
+    	 i.currency,
     	 i.src,
     	 i.alt,
     	 i.in_stock,
@@ -22,13 +23,14 @@ const itemListQ = `SELECT
     	 ARRAY_AGG(c.title || '|' || c.slug) AS categories
 		 FROM item i
 		 JOIN item_category ic ON i.id = ic.item_id
-		 JOIN category c ON c.slug = ic.category_slug
+		 JOIN category c ON c.slug = ic.category_slug AND c.deleted_at IS NULL
+		 WHERE i.deleted_at IS NULL
 		 GROUP BY i.id, i.created_at
 		 ORDER BY i.created_at DESC 
 		 OFFSET ? LIMIT ?`
 
 const itemAttrList = `
-	SELECT ia.id, ia.name, ia.value
+	SELECT ia.id, ia.name, ia.value, COALESCE(ia.attribute_id, 0)
 	FROM item_attr ia
 	WHERE ia.item_id = $1
 `
@@ -46,7 +48,7 @@ const itemCategoriesListQ = `
 `
 
 const itemRelatedProductList = `
-	SELECT rp.item_id, rp.related_item_id
+	SELECT rp.item_id, rp.related_item_id, rp.type, rp.is_symmetric, rp.position
 	FROM related_product rp
 	WHERE rp.item_id = $1
 `
@@ -57,6 +59,7 @@ const itemListVarsQ = `SELECT
 	i.article,
 	i.description,
 	i.price,
+	i.currency,
 	i.src,
 	i.alt,
 	i.in_stock,
@@ -68,15 +71,69 @@ const itemListVarsQ = `SELECT
 	FROM item i
 	JOIN item_attr ia ON ia.item_id = i.id
 	JOIN item_media im ON im.item_id = i.id
-	WHERE parent_id = $1
+	WHERE parent_id = $1 AND i.deleted_at IS NULL
 	GROUP BY i.id;
 `
 
-const itemListRelated = `SELECT 
-    i.id, i.title, i.price, i.src, i.alt 
-	FROM related_product rp
-	JOIN item i ON i.id = rp.item_id
-	WHERE item_id = $1;
+// Symmetric relations are also listed from their related item, a relation stored in both directions is listed once.
+const itemListRelated = `
+	SELECT i.id, i.title, i.price, i.currency, i.src, i.alt, r.type, r.is_symmetric, r.position
+	FROM (
+	    SELECT DISTINCT ON (rel.id) rel.id, rel.type, rel.is_symmetric, rel.position
+	    FROM (
+	        SELECT rp.related_item_id AS id, rp.type, rp.is_symmetric, rp.position, 0 AS direction
+	        FROM related_product rp
+	        WHERE rp.item_id = $1
+	        UNION ALL
+	        SELECT rp.item_id, rp.type, rp.is_symmetric, rp.position, 1
+	        FROM related_product rp
+	        WHERE rp.related_item_id = $1 AND rp.is_symmetric
+	    ) rel
+	    ORDER BY rel.id, rel.direction
+	) r
+	JOIN item i ON i.id = r.id AND i.deleted_at IS NULL
+	ORDER BY r.position, i.title
+`
+
+// Items bought together weigh the most, then the categories and attribute values shared with the item.
+const itemRecommendQ = `
+	WITH bought AS (
+	    SELECT oi2.item_id AS id, COUNT(DISTINCT oi2.order_id) * 3 AS score
+	    FROM order_item oi1
+	    JOIN "order" o ON o.id = oi1.order_id AND o.status <> 'cancelled' AND o.deleted_at IS NULL
+	    JOIN order_item oi2 ON oi2.order_id = oi1.order_id AND oi2.item_id <> oi1.item_id
+	    WHERE oi1.item_id = $1
+	    GROUP BY oi2.item_id
+	), shared_category AS (
+	    SELECT ic2.item_id AS id, COUNT(*) AS score
+	    FROM item_category ic1
+	    JOIN item_category ic2 ON ic2.category_slug = ic1.category_slug AND ic2.item_id <> ic1.item_id
+	    WHERE ic1.item_id = $1
+	    GROUP BY ic2.item_id
+	), shared_attr AS (
+	    SELECT ia2.item_id AS id, COUNT(*) AS score
+	    FROM item_attr ia1
+	    JOIN item_attr ia2 ON LOWER(ia2.name) = LOWER(ia1.name) AND ia2.value = ia1.value AND ia2.item_id <> ia1.item_id
+	    JOIN shared_category sc ON sc.id = ia2.item_id
+	    WHERE ia1.item_id = $1
+	    GROUP BY ia2.item_id
+	)
+	SELECT i.id, i.title, i.price, i.currency, i.src, i.alt
+	FROM (
+	    SELECT s.id, SUM(s.score) AS score
+	    FROM (
+	        SELECT id, score FROM bought
+	        UNION ALL
+	        SELECT id, score FROM shared_category
+	        UNION ALL
+	        SELECT id, score FROM shared_attr
+	    ) s
+	    GROUP BY s.id
+	) s
+	JOIN item i ON i.id = s.id
+	WHERE i.in_stock AND i.deleted_at IS NULL AND i.id <> ALL($2::UUID[])
+	ORDER BY s.score DESC, i.id
+	LIMIT $3
 `
 
 const itemCountLabelQ = `
@@ -84,16 +141,17 @@ SELECT COUNT(*)
 FROM item 
 JOIN item_label il ON il.item_id = item.id
 JOIN label l ON il.label_id = l.id
-WHERE l.name = ?;
+WHERE l.name = $1 AND item.deleted_at IS NULL;
 `
 
 const itemListLabelQ = `
-	SELECT i.id, i.title, i.price, i.src, i.alt, i.is_hit, i.is_rec
+	SELECT i.id, i.title, i.price, i.currency, i.src, i.alt, i.is_hit, i.is_rec
 	FROM item i
 	JOIN item_label il ON il.item_id = i.id
 	JOIN label l ON il.label_id = l.id
-	WHERE l.name = ?
-	OFFSET $1 LIMIT $2;
+	WHERE l.name = $1 AND i.deleted_at IS NULL
+	ORDER BY i.created_at DESC, i.id
+	OFFSET $2 LIMIT $3;
 `
 
 const itemGetByIDQ = `SELECT 
@@ -102,12 +160,16 @@ const itemGetByIDQ = `SELECT
 		 i.article, 
 		 i.description,
 		 i.price,
+		 i.currency,
 		 i.src,
 		 i.alt,
 		 i.in_stock,
 		 i.is_hit,
 		 i.is_rec,
 		 i.parent_id,
+		 i.rating,
+		 i.review_count,
+		 i.version,
 		 i.created_at,
 		 i.updated_at,
 		 ARRAY_AGG(im.src || '|' || im.alt) AS media,
@@ -118,7 +180,7 @@ const itemGetByIDQ = `SELECT
 		 JOIN item_attr ia ON i.id = ia.item_id
 		 JOIN item_category ic ON i.id = ic.item_id
 		 JOIN category c ON c.slug = ic.category_slug
-		 WHERE i.id=?
+		 WHERE i.id=? AND i.deleted_at IS NULL
 		 GROUP BY i.id
 `
 
@@ -128,6 +190,7 @@ const itemCreateQ = `INSERT INTO item
      article, 
      description, 
      price, 
+     currency,
      src, 
      alt, 
      in_stock,
@@ -135,20 +198,24 @@ const itemCreateQ = `INSERT INTO item
      is_rec,
      parent_id
      )
-	 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) 
+	 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) 
 	 RETURNING id;
 `
 
 const itemMediaCreateQ = `INSERT INTO item_media (item_id, src, alt) VALUES ($1, $2, $3)`
-const itemAttrCreateQ = `INSERT INTO item_attr (item_id, name, value) VALUES ($1, $2, $3)`
+const itemAttrCreateQ = `INSERT INTO item_attr (item_id, name, value, attribute_id) VALUES ($1, $2, $3, NULLIF($4, 0))`
 const itemCategoryCreateQ = `INSERT INTO item_category (item_id, category_slug) VALUES ($1, $2)`
-const itemRelatedProductCreateQ = `INSERT INTO related_product (item_id, related_item_id) VALUES ($1, $2)`
+const itemRelatedProductCreateQ = `
+	INSERT INTO related_product (item_id, related_item_id, type, is_symmetric, position) 
+	VALUES ($1, $2, $3, $4, $5)
+`
 
 const itemUpdateQ = `UPDATE item 
 	SET title = ?, 
 	article = ?, 
 	description = ?,
 	price = ?, 
+	currency = ?,
 	src = ?, 
 	alt = ?,
 	in_stock = ?,
@@ -158,15 +225,25 @@ const itemUpdateQ = `UPDATE item
 	WHERE id = ?
 `
 
+const itemBumpVersionQ = `UPDATE item SET version = version + 1 WHERE id = $1 AND deleted_at IS NULL AND ($2 = 0 OR version = $2) RETURNING version`
+const itemVersionQ = `SELECT version FROM item WHERE id = $1 AND deleted_at IS NULL`
+
+const itemRelatedProductUpdateQ = `
+	UPDATE related_product 
+	SET type = $3, is_symmetric = $4, position = $5, updated_at = NOW() 
+	WHERE item_id = $1 AND related_item_id = $2
+`
+
 const itemAttrUpdateQ = `
 	UPDATE item_attr 
-	SET name = ?, value = ? 
+	SET name = ?, value = ?, attribute_id = NULLIF(?, 0) 
 	WHERE id = ?
 `
 
-const itemDeleteQ = `DELETE FROM item WHERE id = ?`
-const itemDeleteByParentQ = `DELETE FROM item WHERE id = ? AND parent_id = ?`
+// Deleted items are moved to the trash together with their variants.
+const itemDeleteQ = `UPDATE item SET deleted_at = NOW() WHERE (id = $1 OR parent_id = $1) AND deleted_at IS NULL`
+const itemDeleteByParentQ = `UPDATE item SET deleted_at = NOW() WHERE parent_id = $1 AND id = $2 AND deleted_at IS NULL`
 const itemMediaDeleteQ = `DELETE FROM item_media WHERE id = ?`
 const itemAttrDeleteQ = `DELETE FROM item_attr WHERE name = ? AND item_id = ?`
 const itemCategoryDeleteQ = `DELETE FROM item_category WHERE item_id = ? AND category_slug = ?`
-const itemRelatedProductDeleteQ = `DELETE FROM related_product WHERE item_id = ? AND related_item_id = ?`
+const itemRelatedProductDeleteQ = `DELETE FROM related_product WHERE item_id = $1 AND related_item_id = $2`
@@ -135,7 +135,7 @@ func TestRepository_ItemSearch(t *testing.T) {
 	size := 10
 	expectedCount := int64(2)
 	expectedTotalPages := int((expectedCount + int64(size) - 1) / int64(size))
-	countQ := "SELECT COUNT(*) FROM item WHERE title ILIKE ? AND title ILIKE ?"
+	countQ := "SELECT COUNT(*) FROM item i WHERE i.deleted_at IS NULL AND i.title ILIKE ? AND i.title ILIKE ?"
 
 	tests := []struct {
 		name         string
@@ -151,7 +151,7 @@ func TestRepository_ItemSearch(t *testing.T) {
 
 				rows := sqlmock.NewRows(
 					[]string{
-						"id", "title", "article", "description", "price", "src", "alt",
+						"id", "title", "article", "description", "price", "currency", "src", "alt",
 						"in_stock", "is_hit", "is_rec", "parent_id", "created_at", "updated_at", "categories",
 					},
 				).
@@ -160,7 +160,8 @@ func TestRepository_ItemSearch(t *testing.T) {
 						"Test Item 1",
 						"Article 1",
 						"Description 1",
-						10.5,
+						int64(1050),
+						"RUB",
 						"src1",
 						"alt1",
 						true,
@@ -176,7 +177,8 @@ func TestRepository_ItemSearch(t *testing.T) {
 						"Test Item 2",
 						"Article 2",
 						"Description 2",
-						15.5,
+						int64(1550),
+						"RUB",
 						"src2",
 						"alt2",
 						false,
@@ -241,7 +243,7 @@ func TestRepository_ItemSearch(t *testing.T) {
 
 				rows := sqlmock.NewRows(
 					[]string{
-						"id", "title", "article", "description", "price", "src", "alt",
+						"id", "title", "article", "description", "price", "currency", "src", "alt",
 						"in_stock", "is_hit", "is_rec", "parent_id", "created_at", "updated_at", "categories",
 					},
 				).
@@ -250,7 +252,8 @@ func TestRepository_ItemSearch(t *testing.T) {
 						"Test Item 1",
 						"Article 1",
 						"Description 1",
-						10.5,
+						int64(1050),
+						"RUB",
 						"src1",
 						"alt1",
 						true,
@@ -266,7 +269,8 @@ func TestRepository_ItemSearch(t *testing.T) {
 						"Test Item 2",
 						"Article 2",
 						"Description 2",
-						15.5,
+						int64(1550),
+						"RUB",
 						"src2",
 						"alt2",
 						false,
@@ -327,7 +331,7 @@ func TestRepository_ListItems(t *testing.T) {
 
 				rows := sqlmock.NewRows(
 					[]string{
-						"id", "title", "article", "description", "price", "src", "alt",
+						"id", "title", "article", "description", "price", "currency", "src", "alt",
 						"in_stock", "is_hit", "is_rec", "parent_id", "created_at", "updated_at", "categories",
 					},
 				).
@@ -336,7 +340,8 @@ func TestRepository_ListItems(t *testing.T) {
 						"Test Item 1",
 						"Article 1",
 						"Description 1",
-						10.5,
+						int64(1050),
+						"RUB",
 						"src1",
 						"alt1",
 						true,
@@ -352,7 +357,8 @@ func TestRepository_ListItems(t *testing.T) {
 						"Test Item 2",
 						"Article 2",
 						"Description 2",
-						15.5,
+						int64(1550),
+						"RUB",
 						"src2",
 						"alt2",
 						false,
@@ -414,7 +420,7 @@ func TestRepository_ListItems(t *testing.T) {
 
 				rows := sqlmock.NewRows(
 					[]string{
-						"id", "title", "article", "description", "price", "src", "alt",
+						"id", "title", "article", "description", "price", "currency", "src", "alt",
 						"in_stock", "is_hit", "is_rec", "parent_id", "created_at", "updated_at", "categories",
 					},
 				).
@@ -423,7 +429,8 @@ func TestRepository_ListItems(t *testing.T) {
 						"Test Item 1",
 						"Article 1",
 						"Description 1",
-						10.5,
+						int64(1050),
+						"RUB",
 						"src1",
 						"alt1",
 						true,
@@ -483,12 +490,16 @@ func TestRepository_GetItemByUUID(t *testing.T) {
 						"article",
 						"description",
 						"price",
+						"currency",
 						"src",
 						"alt",
 						"in_stock",
 						"is_hit",
 						"is_rec",
 						"parent_id",
+						"rating",
+						"review_count",
+						"version",
 						"created_at",
 						"updated_at",
 						"media",
@@ -501,13 +512,17 @@ func TestRepository_GetItemByUUID(t *testing.T) {
 						"Test Item",
 						"Article",
 						"Description",
-						10.5,
+						int64(1050),
+						"RUB",
 						"src1",
 						"alt1",
 						true,
 						false,
 						false,
 						uuid.New().String(),
+						4.5,
+						2,
+						3,
 						time.Now(),
 						time.Now(),
 						"{src1|alt1}",
@@ -523,6 +538,9 @@ func TestRepository_GetItemByUUID(t *testing.T) {
 				require.NoError(t, err)
 				require.NotNil(t, res)
 				assert.Equal(t, "Test Item", res.Title)
+				assert.Equal(t, 4.5, res.Rating)
+				assert.Equal(t, 2, res.ReviewCount)
+				assert.Equal(t, 3, res.Version)
 				assert.Len(t, res.Media, 1)
 				assert.Len(t, res.Attributes, 1)
 				assert.Len(t, res.Categories, 1)
@@ -564,12 +582,15 @@ func TestRepository_GetItemByUUID(t *testing.T) {
 						"article",
 						"description",
 						"price",
+						"currency",
 						"src",
 						"alt",
 						"in_stock",
 						"is_hit",
 						"is_rec",
 						"parent_id",
+						"rating",
+						"review_count",
 						"created_at",
 						"updated_at",
 						"media",
@@ -582,13 +603,16 @@ func TestRepository_GetItemByUUID(t *testing.T) {
 						"Test Item",
 						"Article",
 						"Description",
-						10.5,
+						int64(1050),
+						"RUB",
 						"src1",
 						"alt1",
 						true,
 						false,
 						false,
 						uuid.New().String(),
+						4.5,
+						2,
 						time.Now(),
 						time.Now(),
 						pq.Array([]string{"src1|alt1"}),
@@ -632,7 +656,7 @@ func TestRepository_CreateItem(t *testing.T) {
 		Title:        "Test Item",
 		Article:      "Article 1",
 		Description:  "Description 1",
-		Price:        10.5,
+		Price:        md.NewMoney(1050, md.DefaultCurrency),
 		Src:          "src1",
 		Alt:          "alt1",
 		InStock:      true,
@@ -664,7 +688,8 @@ func TestRepository_CreateItem(t *testing.T) {
 						item.Title,
 						item.Article,
 						item.Description,
-						item.Price,
+						item.Price.Amount,
+						item.Price.Currency,
 						item.Src,
 						item.Alt,
 						item.InStock,
@@ -679,7 +704,7 @@ func TestRepository_CreateItem(t *testing.T) {
 					WillReturnResult(sqlmock.NewResult(1, 1))
 
 				mock.ExpectExec(regexp.QuoteMeta(itemAttrCreateQ)).
-					WithArgs(sqlmock.AnyArg(), item.Attributes[0].Name, item.Attributes[0].Value).
+					WithArgs(sqlmock.AnyArg(), item.Attributes[0].Name, item.Attributes[0].Value, item.Attributes[0].AttributeID).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 
 				mock.ExpectExec(regexp.QuoteMeta(itemCategoryCreateQ)).
@@ -714,7 +739,8 @@ func TestRepository_CreateItem(t *testing.T) {
 						item.Title,
 						item.Article,
 						item.Description,
-						item.Price,
+						item.Price.Amount,
+						item.Price.Currency,
 						item.Src,
 						item.Alt,
 						item.InStock,
@@ -742,7 +768,8 @@ func TestRepository_CreateItem(t *testing.T) {
 						item.Title,
 						item.Article,
 						item.Description,
-						item.Price,
+						item.Price.Amount,
+						item.Price.Currency,
 						item.Src,
 						item.Alt,
 						item.InStock,
@@ -757,7 +784,7 @@ func TestRepository_CreateItem(t *testing.T) {
 					WillReturnResult(sqlmock.NewResult(1, 1))
 
 				mock.ExpectExec(regexp.QuoteMeta(itemAttrCreateQ)).
-					WithArgs(sqlmock.AnyArg(), item.Attributes[0].Name, item.Attributes[0].Value).
+					WithArgs(sqlmock.AnyArg(), item.Attributes[0].Name, item.Attributes[0].Value, item.Attributes[0].AttributeID).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 
 				mock.ExpectExec(regexp.QuoteMeta(itemCategoryCreateQ)).
@@ -800,7 +827,7 @@ func TestRepository_UpdateItem(t *testing.T) {
 		Title:        "Updated Item",
 		Article:      "Updated Article",
 		Description:  "Updated Description",
-		Price:        20.0,
+		Price:        md.NewMoney(2000, md.DefaultCurrency),
 		Src:          "updated-src",
 		Alt:          "updated-alt",
 		InStock:      true,
@@ -817,14 +844,14 @@ func TestRepository_UpdateItem(t *testing.T) {
 			{Slug: "updated-slug1"},
 		},
 		RelatedProducts: []md.RelatedProduct{
-			{RelatedItemID: relatedID},
+			{RelatedItemID: relatedID, Type: md.RelatedTypeCrossSell},
 		},
 		Variants: []md.Item{
 			{
 				Title:       "Variant Item",
 				Article:     "Variant Article",
 				Description: "Variant Description",
-				Price:       15.0,
+				Price:       md.NewMoney(1500, md.DefaultCurrency),
 				Src:         "variant-src",
 				Alt:         "variant-alt",
 				InStock:     false,
@@ -843,13 +870,20 @@ func TestRepository_UpdateItem(t *testing.T) {
 			name: "Success",
 			mockExpect: func() {
 				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(itemBumpVersionQ)).
+					WithArgs(uid, item.Version).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(2))
+				mock.ExpectExec(regexp.QuoteMeta(itemRevisionCreateQ)).
+					WithArgs(uid).
+					WillReturnResult(sqlmock.NewResult(1, 1))
 
 				mock.ExpectExec(regexp.QuoteMeta(itemUpdateQ)).
 					WithArgs(
 						item.Title,
 						item.Article,
 						item.Description,
-						item.Price,
+						item.Price.Amount,
+						item.Price.Currency,
 						item.Src,
 						item.Alt,
 						item.InStock,
@@ -871,8 +905,8 @@ func TestRepository_UpdateItem(t *testing.T) {
 				mock.ExpectQuery(regexp.QuoteMeta(itemAttrList)).
 					WithArgs(uid).
 					WillReturnRows(
-						sqlmock.NewRows([]string{"id", "name", "value"}).
-							AddRow(uint64(1), "updated-attr1", "updated-value1"),
+						sqlmock.NewRows([]string{"id", "name", "value", "attribute_id"}).
+							AddRow(uint64(1), "updated-attr1", "updated-value1", 0),
 					)
 				// No update cause no difference in attr
 
@@ -888,12 +922,12 @@ func TestRepository_UpdateItem(t *testing.T) {
 				mock.ExpectQuery(regexp.QuoteMeta(itemRelatedProductList)).
 					WithArgs(uid).
 					WillReturnRows(
-						sqlmock.NewRows([]string{"item_id", "related_item_id"}).
-							AddRow(uid.String(), relatedID.String()),
+						sqlmock.NewRows([]string{"item_id", "related_item_id", "type", "is_symmetric", "position"}).
+							AddRow(uid.String(), relatedID.String(), md.RelatedTypeCrossSell, false, 0),
 					)
 
 				// Expect variant update
-				mock.ExpectQuery(regexp.QuoteMeta("SELECT id, title, article, description, price, src, alt, in_stock, is_hit, is_rec FROM item WHERE parent_id = $1")).
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT id, title, article, description, price, currency, src, alt, in_stock, is_hit, is_rec FROM item WHERE parent_id = $1")).
 					WithArgs(uid).
 					WillReturnRows(
 						sqlmock.NewRows(
@@ -903,6 +937,7 @@ func TestRepository_UpdateItem(t *testing.T) {
 								"article",
 								"description",
 								"price",
+								"currency",
 								"src",
 								"alt",
 								"in_stock",
@@ -915,7 +950,8 @@ func TestRepository_UpdateItem(t *testing.T) {
 								item.Variants[0].Title,
 								item.Variants[0].Article,
 								item.Variants[0].Description,
-								item.Variants[0].Price,
+								item.Variants[0].Price.Amount,
+								item.Variants[0].Price.Currency,
 								item.Variants[0].Src,
 								item.Variants[0].Alt,
 								item.Variants[0].InStock,
@@ -929,7 +965,8 @@ func TestRepository_UpdateItem(t *testing.T) {
 						item.Variants[0].Title,
 						item.Variants[0].Article,
 						item.Variants[0].Description,
-						item.Variants[0].Price,
+						item.Variants[0].Price.Amount,
+						item.Variants[0].Price.Currency,
 						item.Variants[0].Src,
 						item.Variants[0].Alt,
 						item.Variants[0].InStock,
@@ -949,6 +986,22 @@ func TestRepository_UpdateItem(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+		{
+			name: "VersionConflict",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(itemBumpVersionQ)).
+					WithArgs(uid, item.Version).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}))
+				mock.ExpectQuery(regexp.QuoteMeta(itemVersionQ)).
+					WithArgs(uid).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(5))
+				mock.ExpectRollback()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, repo2.ErrVersionConflict)
+			},
+		},
 		{
 			name: "BeginError",
 			mockExpect: func() {
@@ -963,13 +1016,20 @@ func TestRepository_UpdateItem(t *testing.T) {
 			name: "UpdateError",
 			mockExpect: func() {
 				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(itemBumpVersionQ)).
+					WithArgs(uid, item.Version).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(2))
+				mock.ExpectExec(regexp.QuoteMeta(itemRevisionCreateQ)).
+					WithArgs(uid).
+					WillReturnResult(sqlmock.NewResult(1, 1))
 
 				mock.ExpectExec(regexp.QuoteMeta(itemUpdateQ)).
 					WithArgs(
 						item.Title,
 						item.Article,
 						item.Description,
-						item.Price,
+						item.Price.Amount,
+						item.Price.Currency,
 						item.Src,
 						item.Alt,
 						item.InStock,
@@ -991,13 +1051,20 @@ func TestRepository_UpdateItem(t *testing.T) {
 			name: "CommitError",
 			mockExpect: func() {
 				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(itemBumpVersionQ)).
+					WithArgs(uid, item.Version).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(2))
+				mock.ExpectExec(regexp.QuoteMeta(itemRevisionCreateQ)).
+					WithArgs(uid).
+					WillReturnResult(sqlmock.NewResult(1, 1))
 
 				mock.ExpectExec(regexp.QuoteMeta(itemUpdateQ)).
 					WithArgs(
 						item.Title,
 						item.Article,
 						item.Description,
-						item.Price,
+						item.Price.Amount,
+						item.Price.Currency,
 						item.Src,
 						item.Alt,
 						item.InStock,
@@ -1019,8 +1086,8 @@ func TestRepository_UpdateItem(t *testing.T) {
 				mock.ExpectQuery(regexp.QuoteMeta(itemAttrList)).
 					WithArgs(uid).
 					WillReturnRows(
-						sqlmock.NewRows([]string{"id", "name", "value"}).
-							AddRow(uint64(1), "updated-attr1", "updated-value1"),
+						sqlmock.NewRows([]string{"id", "name", "value", "attribute_id"}).
+							AddRow(uint64(1), "updated-attr1", "updated-value1", 0),
 					)
 
 				// Expect category update
@@ -1035,12 +1102,12 @@ func TestRepository_UpdateItem(t *testing.T) {
 				mock.ExpectQuery(regexp.QuoteMeta(itemRelatedProductList)).
 					WithArgs(uid).
 					WillReturnRows(
-						sqlmock.NewRows([]string{"item_id", "related_item_id"}).
-							AddRow(uid.String(), relatedID.String()),
+						sqlmock.NewRows([]string{"item_id", "related_item_id", "type", "is_symmetric", "position"}).
+							AddRow(uid.String(), relatedID.String(), md.RelatedTypeCrossSell, false, 0),
 					)
 
 				// Expect variant update
-				mock.ExpectQuery(regexp.QuoteMeta("SELECT id, title, article, description, price, src, alt, in_stock, is_hit, is_rec FROM item WHERE parent_id = $1")).
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT id, title, article, description, price, currency, src, alt, in_stock, is_hit, is_rec FROM item WHERE parent_id = $1")).
 					WithArgs(uid).
 					WillReturnRows(
 						sqlmock.NewRows(
@@ -1050,6 +1117,7 @@ func TestRepository_UpdateItem(t *testing.T) {
 								"article",
 								"description",
 								"price",
+								"currency",
 								"src",
 								"alt",
 								"in_stock",
@@ -1062,7 +1130,8 @@ func TestRepository_UpdateItem(t *testing.T) {
 								item.Variants[0].Title,
 								item.Variants[0].Article,
 								item.Variants[0].Description,
-								item.Variants[0].Price,
+								item.Variants[0].Price.Amount,
+								item.Variants[0].Price.Currency,
 								item.Variants[0].Src,
 								item.Variants[0].Alt,
 								item.Variants[0].InStock,
@@ -1076,7 +1145,8 @@ func TestRepository_UpdateItem(t *testing.T) {
 						item.Variants[0].Title,
 						item.Variants[0].Article,
 						item.Variants[0].Description,
-						item.Variants[0].Price,
+						item.Variants[0].Price.Amount,
+						item.Variants[0].Price.Currency,
 						item.Variants[0].Src,
 						item.Variants[0].Alt,
 						item.Variants[0].InStock,
@@ -1112,6 +1182,116 @@ func TestRepository_UpdateItem(t *testing.T) {
 	}
 }
 
+func TestRepository_PatchItem(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	uid := uuid.New()
+
+	tests := []struct {
+		name         string
+		fields       []string
+		mockExpect   func()
+		expectedResp func(*testing.T, *md.Item, error)
+	}{
+		{
+			name:   "Success",
+			fields: []string{"title", "price", "in_stock"},
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(itemBumpVersionQ)).
+					WithArgs(uid, 3).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(4))
+				mock.ExpectExec(regexp.QuoteMeta(itemRevisionCreateQ)).
+					WithArgs(uid).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE item SET title = $1, price = $2, currency = $3, in_stock = $4 WHERE id = $5")).
+					WithArgs("Patched", int64(1500), "RUB", true, uid).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expectedResp: func(t *testing.T, req *md.Item, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, 4, req.Version)
+			},
+		},
+		{
+			name:   "OnlyAmount",
+			fields: []string{"price.amount"},
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(itemBumpVersionQ)).
+					WithArgs(uid, 3).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(4))
+				mock.ExpectExec(regexp.QuoteMeta(itemRevisionCreateQ)).
+					WithArgs(uid).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE item SET price = $1 WHERE id = $2")).
+					WithArgs(int64(1500), uid).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expectedResp: func(t *testing.T, req *md.Item, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:   "OnlyVersion",
+			fields: []string{},
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(itemBumpVersionQ)).
+					WithArgs(uid, 3).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(4))
+				mock.ExpectExec(regexp.QuoteMeta(itemRevisionCreateQ)).
+					WithArgs(uid).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			expectedResp: func(t *testing.T, req *md.Item, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, 4, req.Version)
+			},
+		},
+		{
+			name:   "NotFound",
+			fields: []string{"title"},
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(itemBumpVersionQ)).
+					WithArgs(uid, 3).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}))
+				mock.ExpectQuery(regexp.QuoteMeta(itemVersionQ)).
+					WithArgs(uid).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}))
+				mock.ExpectRollback()
+			},
+			expectedResp: func(t *testing.T, req *md.Item, err error) {
+				assert.ErrorIs(t, err, repo2.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				req := &md.Item{
+					Title:   "Patched",
+					Price:   md.Money{Amount: 1500, Currency: "RUB"},
+					InStock: true,
+					Version: 3,
+				}
+				err := repo.PatchItem(context.Background(), uid, req, tt.fields)
+				tt.expectedResp(t, req, err)
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
 func TestRepository_DeleteItem(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -1193,7 +1373,7 @@ func TestRepository_ListItemVariants(t *testing.T) {
 			mockExpect: func() {
 				rows := sqlmock.NewRows(
 					[]string{
-						"id", "title", "article", "description", "price", "src", "alt",
+						"id", "title", "article", "description", "price", "currency", "src", "alt",
 						"in_stock", "is_hit", "is_rec", "parent_id", "media", "attrs",
 					},
 				).
@@ -1202,7 +1382,8 @@ func TestRepository_ListItemVariants(t *testing.T) {
 						"Variant Item 1",
 						"Article 1",
 						"Description 1",
-						10.5,
+						int64(1050),
+						"RUB",
 						"src1",
 						"alt1",
 						true,
@@ -1217,7 +1398,8 @@ func TestRepository_ListItemVariants(t *testing.T) {
 						"Variant Item 2",
 						"Article 2",
 						"Description 2",
-						15.5,
+						int64(1550),
+						"RUB",
 						"src2",
 						"alt2",
 						false,
@@ -1271,7 +1453,7 @@ func TestRepository_ListItemVariants(t *testing.T) {
 			mockExpect: func() {
 				rows := sqlmock.NewRows(
 					[]string{
-						"id", "title", "article", "description", "price", "src", "alt",
+						"id", "title", "article", "description", "price", "currency", "src", "alt",
 						"in_stock", "is_hit", "is_rec", "parent_id", "media", "attrs",
 					},
 				).
@@ -1280,7 +1462,8 @@ func TestRepository_ListItemVariants(t *testing.T) {
 						"Variant Item 1",
 						"Article 1",
 						"Description 1",
-						10.5,
+						int64(1050),
+						"RUB",
 						"src1",
 						"alt1",
 						true,
@@ -1334,14 +1517,14 @@ func TestRepository_ListRelatedItems(t *testing.T) {
 			mockExpect: func() {
 				rows := sqlmock.NewRows(
 					[]string{
-						"id", "title", "price", "src", "alt",
+						"id", "title", "price", "currency", "src", "alt", "type", "is_symmetric", "position",
 					},
 				).
 					AddRow(
-						uid.String(), "Related Item 1", 10.5, "src1", "alt1",
+						uid.String(), "Related Item 1", int64(1050), "RUB", "src1", "alt1", md.RelatedTypeAccessory, false, 0,
 					).
 					AddRow(
-						uid.String(), "Related Item 2", 15.5, "src2", "alt2",
+						uid.String(), "Related Item 2", int64(1550), "RUB", "src2", "alt2", md.RelatedTypeAnalogue, true, 1,
 					)
 
 				mock.ExpectQuery(regexp.QuoteMeta(itemListRelated)).
@@ -1354,6 +1537,9 @@ func TestRepository_ListRelatedItems(t *testing.T) {
 				assert.Len(t, res, 2)
 				assert.Equal(t, "Related Item 1", res[0].RelatedItem.Title)
 				assert.Equal(t, "Related Item 2", res[1].RelatedItem.Title)
+				assert.Equal(t, md.RelatedTypeAnalogue, res[1].Type)
+				assert.True(t, res[1].IsSymmetric)
+				assert.Equal(t, uid, res[1].ItemID)
 			},
 		},
 		{
@@ -1387,11 +1573,11 @@ func TestRepository_ListRelatedItems(t *testing.T) {
 			mockExpect: func() {
 				rows := sqlmock.NewRows(
 					[]string{
-						"id", "title", "price", "src", "alt",
+						"id", "title", "price", "currency", "src", "alt", "type", "is_symmetric", "position",
 					},
 				).
 					AddRow(
-						uuid.Nil, "Related Item 1", 10.5, "src1", "alt1",
+						uuid.Nil, "Related Item 1", int64(1050), "RUB", "src1", "alt1", md.RelatedTypeCrossSell, false, 0,
 					)
 
 				mock.ExpectQuery(regexp.QuoteMeta(itemListRelated)).
@@ -1419,6 +1605,64 @@ func TestRepository_ListRelatedItems(t *testing.T) {
 	}
 }
 
+func TestRepository_RecommendItems(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	uid, relatedID, recommendedID := uuid.New(), uuid.New(), uuid.New()
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, []*md.RelatedProduct, error)
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(itemRecommendQ)).
+					WithArgs(uid, pq.Array([]string{uid.String(), relatedID.String()}), 5).
+					WillReturnRows(
+						sqlmock.NewRows([]string{"id", "title", "price", "currency", "src", "alt"}).
+							AddRow(recommendedID.String(), "Recommended", int64(1050), "RUB", "src", "alt"),
+					)
+			},
+			expectedResp: func(t *testing.T, res []*md.RelatedProduct, err error) {
+				require.NoError(t, err)
+				require.Len(t, res, 1)
+				assert.Equal(t, uid, res[0].ItemID)
+				assert.Equal(t, recommendedID, res[0].RelatedItemID)
+				assert.Equal(t, md.RelatedTypeCrossSell, res[0].Type)
+				assert.True(t, res[0].IsRecommended)
+			},
+		},
+		{
+			name: "QueryError",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(itemRecommendQ)).
+					WillReturnError(errors.New("query error"))
+			},
+			expectedResp: func(t *testing.T, res []*md.RelatedProduct, err error) {
+				assert.Equal(t, "query error", err.Error())
+				assert.Nil(t, res)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := repo.RecommendItems(context.Background(), uid, []uuid.UUID{relatedID}, 5)
+				tt.expectedResp(t, res, err)
+				err = mock.ExpectationsWereMet()
+				assert.NoError(t, err)
+			},
+		)
+	}
+}
+
 func TestRepository_ListItemsByLabel(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -1444,11 +1688,11 @@ func TestRepository_ListItemsByLabel(t *testing.T) {
 
 				rows := sqlmock.NewRows(
 					[]string{
-						"id", "title", "price", "src", "alt", "is_hit", "is_rec",
+						"id", "title", "price", "currency", "src", "alt", "is_hit", "is_rec",
 					},
 				).
-					AddRow(uuid.New().String(), "Hit Item 1", 10.5, "src1", "alt1", true, false).
-					AddRow(uuid.New().String(), "Hit Item 2", 15.5, "src2", "alt2", true, true)
+					AddRow(uuid.New().String(), "Hit Item 1", int64(1050), "RUB", "src1", "alt1", true, false).
+					AddRow(uuid.New().String(), "Hit Item 2", int64(1550), "RUB", "src2", "alt2", true, true)
 
 				mock.ExpectQuery(regexp.QuoteMeta(itemListLabelQ)).
 					WithArgs(label, (page-1)*size, size).
@@ -1500,10 +1744,10 @@ func TestRepository_ListItemsByLabel(t *testing.T) {
 
 				rows := sqlmock.NewRows(
 					[]string{
-						"id", "title", "price", "src", "alt", "is_hit", "is_rec",
+						"id", "title", "price", "currency", "src", "alt", "is_hit", "is_rec",
 					},
 				).
-					AddRow(uuid.Nil, "Hit Item 1", 10.5, "src1", "alt1", true, false)
+					AddRow(uuid.Nil, "Hit Item 1", int64(1050), "RUB", "src1", "alt1", true, false)
 
 				mock.ExpectQuery(regexp.QuoteMeta(itemListLabelQ)).
 					WithArgs(label, (page-1)*size, size).
@@ -8,7 +8,7 @@ import (
 )
 
 func createItemAttr(tx *sql.Tx, uid uuid.UUID, attr model.ItemAttribute) error {
-	if _, err := tx.Exec(itemAttrCreateQ, uid, attr.Name, attr.Value); err != nil {
+	if _, err := tx.Exec(itemAttrCreateQ, uid, attr.Name, attr.Value, attr.AttributeID); err != nil {
 		return err
 	}
 	return nil
@@ -106,7 +106,7 @@ func UpdateItemAttributes(tx *sql.Tx, uid uuid.UUID, req []model.ItemAttribute)
 	existing := make(map[string]*model.ItemAttribute)
 	for rows.Next() {
 		attr := &model.ItemAttribute{}
-		if err = rows.Scan(&attr.ID, &attr.Name, &attr.Value); err != nil {
+		if err = rows.Scan(&attr.ID, &attr.Name, &attr.Value, &attr.AttributeID); err != nil {
 			return err
 		}
 		existing[attr.Name] = attr
@@ -118,8 +118,8 @@ func UpdateItemAttributes(tx *sql.Tx, uid uuid.UUID, req []model.ItemAttribute)
 
 	for _, v := range req {
 		if attr, exists := existing[v.Name]; exists {
-			if attr.Name != v.Name || attr.Value != v.Value {
-				if _, err = tx.Exec(itemAttrUpdateQ, v.Name, v.Value, attr.ID); err != nil {
+			if attr.Value != v.Value || attr.AttributeID != v.AttributeID {
+				if _, err = tx.Exec(itemAttrUpdateQ, v.Name, v.Value, v.AttributeID, attr.ID); err != nil {
 					return err
 				}
 			}
@@ -196,22 +196,28 @@ func UpdateItemRelatedProducts(tx *sql.Tx, uid uuid.UUID, req []model.RelatedPro
 	}
 	defer rows.Close()
 
-	existing := make(map[uuid.UUID]struct{})
+	existing := make(map[uuid.UUID]model.RelatedProduct)
 	for rows.Next() {
-		related := &model.RelatedProduct{}
-		if err = rows.Scan(&related.ItemID, &related.RelatedItemID); err != nil {
+		related := model.RelatedProduct{}
+		if err = rows.Scan(
+			&related.ItemID,
+			&related.RelatedItemID,
+			&related.Type,
+			&related.IsSymmetric,
+			&related.Position,
+		); err != nil {
 			return err
 		}
-		existing[related.RelatedItemID] = struct{}{}
+		existing[related.RelatedItemID] = related
 	}
 
 	if err = rows.Err(); err != nil {
 		return err
 	}
 
-	reqMap := make(map[uuid.UUID]struct{}, len(req))
+	reqMap := make(map[uuid.UUID]model.RelatedProduct, len(req))
 	for _, v := range req {
-		reqMap[v.RelatedItemID] = struct{}{}
+		reqMap[v.RelatedItemID] = v
 	}
 
 	for id := range existing {
@@ -222,9 +228,17 @@ func UpdateItemRelatedProducts(tx *sql.Tx, uid uuid.UUID, req []model.RelatedPro
 		}
 	}
 
-	for id := range reqMap {
-		if _, exists := existing[id]; !exists {
-			if _, err = tx.Exec(itemRelatedProductCreateQ, uid, id); err != nil {
+	for id, v := range reqMap {
+		old, exists := existing[id]
+		if !exists {
+			if _, err = tx.Exec(itemRelatedProductCreateQ, uid, id, v.Type, v.IsSymmetric, v.Position); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if old.Type != v.Type || old.IsSymmetric != v.IsSymmetric || old.Position != v.Position {
+			if _, err = tx.Exec(itemRelatedProductUpdateQ, uid, id, v.Type, v.IsSymmetric, v.Position); err != nil {
 				return err
 			}
 		}
@@ -264,7 +278,8 @@ func UpdateItemVariants(tx *sql.Tx, uid uuid.UUID, req []model.Item) error {
 				v.Title,
 				v.Article,
 				v.Description,
-				v.Price,
+				v.Price.Amount,
+				v.Price.Currency,
 				v.Src,
 				v.Alt,
 				v.InStock,
@@ -293,7 +308,8 @@ func UpdateItemVariants(tx *sql.Tx, uid uuid.UUID, req []model.Item) error {
 				v.Title,
 				v.Article,
 				v.Description,
-				v.Price,
+				v.Price.Amount,
+				v.Price.Currency,
 				v.Src,
 				v.Alt,
 				v.InStock,
@@ -320,7 +336,7 @@ func UpdateItemVariants(tx *sql.Tx, uid uuid.UUID, req []model.Item) error {
 
 func getVariantsByParentID(tx *sql.Tx, parentID uuid.UUID) ([]model.Item, error) {
 	rows, err := tx.Query(
-		"SELECT id, title, article, description, price, src, alt, in_stock, is_hit, is_rec FROM item WHERE parent_id = $1",
+		"SELECT id, title, article, description, price, currency, src, alt, in_stock, is_hit, is_rec FROM item WHERE parent_id = $1 AND deleted_at IS NULL",
 		parentID,
 	)
 	if err != nil {
@@ -336,7 +352,8 @@ func getVariantsByParentID(tx *sql.Tx, parentID uuid.UUID) ([]model.Item, error)
 			&v.Title,
 			&v.Article,
 			&v.Description,
-			&v.Price,
+			&v.Price.Amount,
+			&v.Price.Currency,
 			&v.Src,
 			&v.Alt,
 			&v.InStock,
@@ -0,0 +1,317 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	md "github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+)
+
+// saveItemRevision snapshots the item before tx changes it.
+// It must run after the version bump, which locks the row, and before anything else is written.
+func saveItemRevision(tx *sql.Tx, uid uuid.UUID) error {
+	_, err := tx.Exec(itemRevisionCreateQ, uid)
+	return err
+}
+
+func (r *Repository) ListItemRevisions(ctx context.Context, uid uuid.UUID, page, size int) (*md.PaginatedItemRevisionsData, error) {
+	const op = "items.ListItemRevisions.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var count int64
+	if err := r.conn.QueryRow(itemRevisionCountQ, uid).Scan(&count); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.conn.Query(itemRevisionListQ, uid, (page-1)*size, size)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*md.ItemRevision, 0, size)
+	for rows.Next() {
+		rev, err := scanItemRevision(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, rev)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	totalPages := int((count + int64(size) - 1) / int64(size))
+	return &md.PaginatedItemRevisionsData{
+		Data:        res,
+		Count:       count,
+		TotalPages:  totalPages,
+		CurrentPage: page,
+		HasNextPage: page < totalPages,
+	}, nil
+}
+
+func (r *Repository) GetItemRevision(ctx context.Context, uid uuid.UUID, id uint64) (*md.ItemRevision, error) {
+	const op = "items.GetItemRevision.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := scanItemRevision(r.conn.QueryRow(itemRevisionGetQ, id, uid))
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return nil, repo.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// RollbackItem writes the state of the revision back to the item in a single transaction.
+// The state it replaces is saved as a revision too, so a rollback can itself be rolled back.
+// Media is restored by restoreItemMedia.
+func (r *Repository) RollbackItem(ctx context.Context, uid uuid.UUID, id uint64) error {
+	const op = "items.RollbackItem.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return mapError(err)
+	}
+
+	rev, err := scanItemRevision(tx.QueryRow(itemRevisionGetQ, id, uid))
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		tx.Rollback()
+		return repo.ErrNotFound
+	} else if err != nil {
+		tx.Rollback()
+		return mapError(err)
+	}
+
+	if _, err = bumpVersion(tx, itemBumpVersionQ, itemVersionQ, uid, 0); err != nil {
+		tx.Rollback()
+		return mapError(err)
+	}
+
+	if err = saveItemRevision(tx, uid); err != nil {
+		tx.Rollback()
+		return mapError(err)
+	}
+
+	i := rev.Item
+	if _, err = tx.Exec(
+		itemRevisionRestoreQ,
+		i.Title,
+		i.Article,
+		i.Description,
+		i.Price.Amount,
+		i.Price.Currency,
+		i.Src,
+		i.Alt,
+		i.InStock,
+		i.IsHit,
+		i.IsRec,
+		uid,
+	); err != nil {
+		tx.Rollback()
+		return mapError(err)
+	}
+
+	if err = restoreItemMedia(tx, uid, i); err != nil {
+		tx.Rollback()
+		return mapError(err)
+	}
+
+	if err = UpdateItemAttributes(tx, uid, i.Attributes); err != nil {
+		tx.Rollback()
+		return mapError(err)
+	}
+
+	if err = UpdateItemCategories(tx, uid, i.Categories); err != nil {
+		tx.Rollback()
+		return mapError(err)
+	}
+
+	if err = UpdateItemRelatedProducts(tx, uid, i.RelatedProducts); err != nil {
+		tx.Rollback()
+		return mapError(err)
+	}
+
+	return tx.Commit()
+}
+
+// restoreItemMedia brings back the media of the snapshot i. Rows still there are matched by src and kept.
+// Rows that are gone are recreated with their files, but only while the files are queued for removal:
+// the key is taken off the queue in tx, a key that is no longer queued has been removed from the storage
+// and its media or variant is left out. Media without a key points at a file the service does not own.
+func restoreItemMedia(tx *sql.Tx, uid uuid.UUID, i *md.Item) error {
+	rows, err := tx.Query(itemMediaList, uid)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]uint64)
+	for rows.Next() {
+		var id uint64
+		var src, alt string
+		if err = rows.Scan(&id, &src, &alt); err != nil {
+			rows.Close()
+			return err
+		}
+		existing[src] = id
+	}
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	kept := make(map[string]struct{}, len(i.Media))
+	primary, lostPrimary := "", false
+	for _, m := range i.Media {
+		if _, ok := existing[m.Src]; ok {
+			if _, err = tx.Exec(itemRevisionMediaPositionQ, m.Position, uid, m.Src); err != nil {
+				return err
+			}
+		} else if restored, err := restoreMediaRow(tx, uid, m); err != nil {
+			return err
+		} else if !restored {
+			lostPrimary = lostPrimary || m.IsPrimary
+			continue
+		}
+
+		kept[m.Src] = struct{}{}
+		if m.IsPrimary {
+			primary = m.Src
+		}
+	}
+
+	for src, id := range existing {
+		if _, ok := kept[src]; !ok {
+			if _, err = tx.Exec(itemMediaDeleteQ, id); err != nil {
+				return err
+			}
+		}
+	}
+
+	if primary == "" && !lostPrimary {
+		return nil
+	}
+
+	if _, err = tx.Exec(itemRevisionMediaUnsetPrimaryQ, uid, primary); err != nil {
+		return err
+	}
+
+	if primary != "" {
+		_, err = tx.Exec(itemRevisionMediaSetPrimaryQ, uid, primary)
+		return err
+	}
+
+	// The primary image of the snapshot is gone, the item shows the first media left instead.
+	var src, alt string
+	err = tx.QueryRow(mediaPromoteFirstQ, uid).Scan(&src, &alt)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	_, err = tx.Exec(mediaSetItemImageQ, src, alt, uid)
+	return err
+}
+
+// restoreMediaRow recreates a media row and those of its variants whose files are still there.
+// It reports false when the file of the media itself is gone.
+func restoreMediaRow(tx *sql.Tx, uid uuid.UUID, m md.ItemMedia) (bool, error) {
+	if m.StorageKey != "" {
+		if ok, err := claimOrphanMedia(tx, m.StorageKey); err != nil || !ok {
+			return false, err
+		}
+	}
+
+	var id uint64
+	if err := tx.QueryRow(
+		itemRevisionMediaRestoreQ,
+		uid,
+		m.Src,
+		m.Alt,
+		m.StorageKey,
+		m.ContentType,
+		m.Size,
+		m.Width,
+		m.Height,
+		m.Position,
+	).Scan(&id); err != nil {
+		return false, err
+	}
+
+	for _, v := range m.Variants {
+		if ok, err := claimOrphanMedia(tx, v.StorageKey); err != nil {
+			return false, err
+		} else if !ok {
+			continue
+		}
+
+		if _, err := tx.Exec(mediaVariantCreateQ, id, v.Name, v.Src, v.StorageKey, v.ContentType, v.Width, v.Height); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func claimOrphanMedia(tx *sql.Tx, key string) (bool, error) {
+	res, err := tx.Exec(itemRevisionClaimOrphanQ, key)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+// revisionKeys holds the storage keys of a snapshot, they are not part of the JSON of the item.
+type revisionKeys struct {
+	Media []struct {
+		StorageKey string `json:"storage_key"`
+		Variants   []struct {
+			StorageKey string `json:"storage_key"`
+		} `json:"variants"`
+	} `json:"media"`
+}
+
+func scanItemRevision(row rowScanner) (*md.ItemRevision, error) {
+	res := &md.ItemRevision{}
+	var snapshot []byte
+	if err := row.Scan(&res.ID, &res.ItemID, &res.Version, &snapshot, &res.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	res.Item = &md.Item{}
+	if err := json.Unmarshal(snapshot, res.Item); err != nil {
+		return nil, err
+	}
+
+	keys := revisionKeys{}
+	if err := json.Unmarshal(snapshot, &keys); err != nil {
+		return nil, err
+	}
+	for i := range keys.Media {
+		if i >= len(res.Item.Media) {
+			break
+		}
+
+		m := &res.Item.Media[i]
+		m.StorageKey = keys.Media[i].StorageKey
+		for j := range keys.Media[i].Variants {
+			if j < len(m.Variants) {
+				m.Variants[j].StorageKey = keys.Media[i].Variants[j].StorageKey
+			}
+		}
+	}
+	return res, nil
+}
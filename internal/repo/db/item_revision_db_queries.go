@@ -0,0 +1,108 @@
+package db
+
+// itemRevisionCreateQ snapshots the item as it was before the running update, it is run right after the version bump.
+// The keys match the JSON of model.Item so the snapshot decodes into one.
+const itemRevisionCreateQ = `
+	INSERT INTO item_revision (item_id, version, snapshot)
+	SELECT i.id, i.version - 1, jsonb_build_object(
+		'id', i.id,
+		'title', i.title,
+		'article', i.article,
+		'description', i.description,
+		'price', jsonb_build_object('amount', i.price, 'currency', i.currency),
+		'src', i.src,
+		'alt', i.alt,
+		'in_stock', i.in_stock,
+		'is_hit', i.is_hit,
+		'is_rec', i.is_rec,
+		'attributes', COALESCE((
+			SELECT jsonb_agg(
+				jsonb_build_object('name', ia.name, 'value', ia.value, 'attribute_id', COALESCE(ia.attribute_id, 0))
+				ORDER BY ia.id
+			)
+			FROM item_attr ia
+			WHERE ia.item_id = i.id
+		), '[]'),
+		'media', COALESCE((
+			SELECT jsonb_agg(
+				jsonb_build_object(
+					'src', im.src, 'alt', im.alt, 'position', im.position, 'is_primary', im.is_primary,
+					'storage_key', im.storage_key, 'content_type', im.content_type, 'size', im.size,
+					'width', im.width, 'height', im.height,
+					'variants', COALESCE((
+						SELECT jsonb_agg(
+							jsonb_build_object(
+								'name', mv.name, 'src', mv.src, 'storage_key', mv.storage_key,
+								'content_type', mv.content_type, 'width', mv.width, 'height', mv.height
+							)
+							ORDER BY mv.width
+						)
+						FROM item_media_variant mv
+						WHERE mv.media_id = im.id
+					), '[]')
+				)
+				ORDER BY im.position, im.id
+			)
+			FROM item_media im
+			WHERE im.item_id = i.id
+		), '[]'),
+		'categories', COALESCE((
+			SELECT jsonb_agg(jsonb_build_object('slug', ic.category_slug) ORDER BY ic.category_slug)
+			FROM item_category ic
+			WHERE ic.item_id = i.id
+		), '[]'),
+		'related_products', COALESCE((
+			SELECT jsonb_agg(
+				jsonb_build_object(
+					'related_item_id', rp.related_item_id, 'type', rp.type,
+					'is_symmetric', rp.is_symmetric, 'position', rp.position
+				)
+				ORDER BY rp.position, rp.related_item_id
+			)
+			FROM related_product rp
+			WHERE rp.item_id = i.id
+		), '[]')
+	)
+	FROM item i
+	WHERE i.id = $1
+	ON CONFLICT (item_id, version) DO NOTHING
+`
+
+const itemRevisionCountQ = `SELECT COUNT(*) FROM item_revision WHERE item_id = $1`
+
+const itemRevisionListQ = `
+	SELECT id, item_id, version, snapshot, created_at
+	FROM item_revision
+	WHERE item_id = $1
+	ORDER BY version DESC
+	OFFSET $2 LIMIT $3
+`
+
+const itemRevisionGetQ = `
+	SELECT id, item_id, version, snapshot, created_at
+	FROM item_revision
+	WHERE id = $1 AND item_id = $2
+`
+
+// itemRevisionRestoreQ writes back the core fields, the parent of a variant is not part of a revision.
+const itemRevisionRestoreQ = `
+	UPDATE item
+	SET title = $1, article = $2, description = $3, price = $4, currency = $5,
+		src = $6, alt = $7, in_stock = $8, is_hit = $9, is_rec = $10, updated_at = NOW()
+	WHERE id = $11
+`
+
+const itemRevisionMediaRestoreQ = `
+	INSERT INTO item_media (item_id, src, alt, storage_key, content_type, size, width, height, position)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	RETURNING id
+`
+
+const itemRevisionMediaPositionQ = `UPDATE item_media SET position = $1, updated_at = NOW() WHERE item_id = $2 AND src = $3`
+
+// itemRevisionMediaUnsetPrimaryQ runs before the primary is set, the item has at most one.
+const itemRevisionMediaUnsetPrimaryQ = `UPDATE item_media SET is_primary = FALSE WHERE item_id = $1 AND is_primary AND src <> $2`
+const itemRevisionMediaSetPrimaryQ = `UPDATE item_media SET is_primary = TRUE WHERE item_id = $1 AND src = $2`
+
+// itemRevisionClaimOrphanQ takes a key off the removal queue, no row means the file is already gone or being removed.
+const itemRevisionClaimOrphanQ = `DELETE FROM media_orphan WHERE storage_key = $1`
@@ -0,0 +1,333 @@
+package db
+
+import (
+	"context"
+	"errors"
+	repo2 "github.com/JMURv/par-pro/products/internal/repo"
+	md "github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"regexp"
+	"testing"
+	"time"
+)
+
+const revisionSnapshot = `{
+	"id": "00000000-0000-0000-0000-000000000000",
+	"title": "Old title",
+	"article": "A-1",
+	"description": "",
+	"price": {"amount": 1000, "currency": "RUB"},
+	"src": "",
+	"alt": "",
+	"in_stock": true,
+	"is_hit": false,
+	"is_rec": false,
+	"attributes": [],
+	"media": [],
+	"categories": [{"slug": "shoes"}],
+	"related_products": []
+}`
+
+func TestRepository_ListItemRevisions(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	uid := uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(itemRevisionCountQ)).
+		WithArgs(uid).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+	mock.ExpectQuery(regexp.QuoteMeta(itemRevisionListQ)).
+		WithArgs(uid, 0, 2).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "item_id", "version", "snapshot", "created_at"}).
+				AddRow(5, uid.String(), 3, []byte(revisionSnapshot), now).
+				AddRow(4, uid.String(), 2, []byte(revisionSnapshot), now),
+		)
+
+	res, err := repo.ListItemRevisions(context.Background(), uid, 1, 2)
+	require.NoError(t, err)
+	require.Len(t, res.Data, 2)
+	assert.Equal(t, 3, res.Data[0].Version)
+	assert.Equal(t, "Old title", res.Data[0].Item.Title)
+	assert.Equal(t, md.NewMoney(1000, "RUB"), res.Data[0].Item.Price)
+	assert.Equal(t, "shoes", res.Data[0].Item.Categories[0].Slug)
+	assert.Equal(t, 2, res.TotalPages)
+	assert.True(t, res.HasNextPage)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GetItemRevision(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	uid := uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(itemRevisionGetQ)).
+		WithArgs(uint64(9), uid).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "item_id", "version", "snapshot", "created_at"}))
+
+	_, err = repo.GetItemRevision(context.Background(), uid, 9)
+	assert.ErrorIs(t, err, repo2.ErrNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_RollbackItem(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	uid := uuid.New()
+	dbErr := errors.New("db error")
+
+	expectRevision := func() {
+		mock.ExpectQuery(regexp.QuoteMeta(itemRevisionGetQ)).
+			WithArgs(uint64(4), uid).
+			WillReturnRows(
+				sqlmock.NewRows([]string{"id", "item_id", "version", "snapshot", "created_at"}).
+					AddRow(4, uid.String(), 2, []byte(revisionSnapshot), time.Now()),
+			)
+	}
+
+	tests := []struct {
+		name       string
+		mockExpect func()
+		expectErr  error
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				expectRevision()
+				mock.ExpectQuery(regexp.QuoteMeta(itemBumpVersionQ)).
+					WithArgs(uid, 0).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(5))
+				mock.ExpectExec(regexp.QuoteMeta(itemRevisionCreateQ)).
+					WithArgs(uid).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(regexp.QuoteMeta(itemRevisionRestoreQ)).
+					WithArgs("Old title", "A-1", "", int64(1000), "RUB", "", "", true, false, false, uid).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(regexp.QuoteMeta(itemMediaList)).
+					WithArgs(uid).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "src", "alt"}))
+				mock.ExpectQuery(regexp.QuoteMeta(itemAttrList)).
+					WithArgs(uid).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "value", "attribute_id"}))
+				mock.ExpectQuery(regexp.QuoteMeta(itemCategoriesListQ)).
+					WithArgs(uid).
+					WillReturnRows(sqlmock.NewRows([]string{"item_id", "category_slug"}).AddRow(uid.String(), "hats"))
+				mock.ExpectExec(regexp.QuoteMeta(itemCategoryDeleteQ)).
+					WithArgs(uid, "hats").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(itemCategoryCreateQ)).
+					WithArgs(uid, "shoes").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(regexp.QuoteMeta(itemRelatedProductList)).
+					WithArgs(uid).
+					WillReturnRows(
+						sqlmock.NewRows([]string{"item_id", "related_item_id", "type", "is_symmetric", "position"}),
+					)
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Unknown revision",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(itemRevisionGetQ)).
+					WithArgs(uint64(4), uid).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "item_id", "version", "snapshot", "created_at"}))
+				mock.ExpectRollback()
+			},
+			expectErr: repo2.ErrNotFound,
+		},
+		{
+			name: "Restore error",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				expectRevision()
+				mock.ExpectQuery(regexp.QuoteMeta(itemBumpVersionQ)).
+					WithArgs(uid, 0).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(5))
+				mock.ExpectExec(regexp.QuoteMeta(itemRevisionCreateQ)).
+					WithArgs(uid).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(regexp.QuoteMeta(itemRevisionRestoreQ)).
+					WillReturnError(dbErr)
+				mock.ExpectRollback()
+			},
+			expectErr: dbErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				err := repo.RollbackItem(context.Background(), uid, 4)
+				if tt.expectErr != nil {
+					assert.ErrorIs(t, err, tt.expectErr)
+				} else {
+					require.NoError(t, err)
+				}
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+const mediaSnapshot = `{
+	"title": "Old title",
+	"media": [
+		{
+			"src": "/media/a.jpg", "alt": "A", "position": 0, "is_primary": true,
+			"storage_key": "items/a.jpg", "content_type": "image/jpeg", "size": 100, "width": 800, "height": 600,
+			"variants": [
+				{"name": "thumb", "src": "/media/a_thumb.webp", "storage_key": "items/a_thumb.webp", "content_type": "image/webp", "width": 200, "height": 150},
+				{"name": "large", "src": "/media/a_large.webp", "storage_key": "items/a_large.webp", "content_type": "image/webp", "width": 800, "height": 600}
+			]
+		},
+		{"src": "/media/b.jpg", "alt": "B", "position": 1, "is_primary": false, "storage_key": "items/b.jpg", "variants": []}
+	]
+}`
+
+func TestScanItemRevision_StorageKeys(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	uid := uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(itemRevisionGetQ)).
+		WithArgs(uint64(4), uid).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "item_id", "version", "snapshot", "created_at"}).
+				AddRow(4, uid.String(), 2, []byte(mediaSnapshot), time.Now()),
+		)
+
+	res, err := repo.GetItemRevision(context.Background(), uid, 4)
+	require.NoError(t, err)
+	require.Len(t, res.Item.Media, 2)
+	assert.Equal(t, "items/a.jpg", res.Item.Media[0].StorageKey)
+	assert.Equal(t, "items/a_thumb.webp", res.Item.Media[0].Variants[0].StorageKey)
+	assert.Equal(t, "items/a_large.webp", res.Item.Media[0].Variants[1].StorageKey)
+	assert.Equal(t, "items/b.jpg", res.Item.Media[1].StorageKey)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRestoreItemMedia(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	uid := uuid.New()
+	snapshot := func() *md.Item {
+		return &md.Item{
+			Media: []md.ItemMedia{
+				{
+					Src: "/media/a.jpg", Alt: "A", Position: 0, IsPrimary: true,
+					StorageKey: "items/a.jpg", ContentType: "image/jpeg", Size: 100, Width: 800, Height: 600,
+					Variants: []md.MediaVariant{
+						{Name: "thumb", Src: "/media/a_thumb.webp", StorageKey: "items/a_thumb.webp", ContentType: "image/webp", Width: 200, Height: 150},
+						{Name: "large", Src: "/media/a_large.webp", StorageKey: "items/a_large.webp", ContentType: "image/webp", Width: 800, Height: 600},
+					},
+				},
+				{Src: "/media/b.jpg", Alt: "B", Position: 1, StorageKey: "items/b.jpg"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		mockExpect func()
+	}{
+		{
+			name: "Restored",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(itemMediaList)).
+					WithArgs(uid).
+					WillReturnRows(
+						sqlmock.NewRows([]string{"id", "src", "alt"}).
+							AddRow(7, "/media/b.jpg", "B").
+							AddRow(8, "/media/c.jpg", "C"),
+					)
+				mock.ExpectExec(regexp.QuoteMeta(itemRevisionClaimOrphanQ)).
+					WithArgs("items/a.jpg").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(regexp.QuoteMeta(itemRevisionMediaRestoreQ)).
+					WithArgs(uid, "/media/a.jpg", "A", "items/a.jpg", "image/jpeg", int64(100), 800, 600, 0).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(9))
+				mock.ExpectExec(regexp.QuoteMeta(itemRevisionClaimOrphanQ)).
+					WithArgs("items/a_thumb.webp").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(mediaVariantCreateQ)).
+					WithArgs(uint64(9), "thumb", "/media/a_thumb.webp", "items/a_thumb.webp", "image/webp", 200, 150).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(itemRevisionClaimOrphanQ)).
+					WithArgs("items/a_large.webp").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(regexp.QuoteMeta(itemRevisionMediaPositionQ)).
+					WithArgs(1, uid, "/media/b.jpg").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(itemMediaDeleteQ)).
+					WithArgs(uint64(8)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(itemRevisionMediaUnsetPrimaryQ)).
+					WithArgs(uid, "/media/a.jpg").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(regexp.QuoteMeta(itemRevisionMediaSetPrimaryQ)).
+					WithArgs(uid, "/media/a.jpg").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "File removed",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(itemMediaList)).
+					WithArgs(uid).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "src", "alt"}).AddRow(7, "/media/b.jpg", "B"))
+				mock.ExpectExec(regexp.QuoteMeta(itemRevisionClaimOrphanQ)).
+					WithArgs("items/a.jpg").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(regexp.QuoteMeta(itemRevisionMediaPositionQ)).
+					WithArgs(1, uid, "/media/b.jpg").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(itemRevisionMediaUnsetPrimaryQ)).
+					WithArgs(uid, "").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(regexp.QuoteMeta(mediaPromoteFirstQ)).
+					WithArgs(uid).
+					WillReturnRows(sqlmock.NewRows([]string{"src", "alt"}).AddRow("/media/b.jpg", "B"))
+				mock.ExpectExec(regexp.QuoteMeta(mediaSetItemImageQ)).
+					WithArgs("/media/b.jpg", "B", uid).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				mock.ExpectBegin()
+				tt.mockExpect()
+
+				tx, err := db.Begin()
+				require.NoError(t, err)
+				require.NoError(t, restoreItemMedia(tx, uid, snapshot()))
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
@@ -0,0 +1,170 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/opentracing/opentracing-go"
+)
+
+func (r *Repository) ListLabels(ctx context.Context) ([]*model.Label, error) {
+	const op = "labels.ListLabels.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	rows, err := r.conn.Query(labelListQ)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*model.Label, 0, 10)
+	for rows.Next() {
+		l := &model.Label{}
+		if err = rows.Scan(
+			&l.ID,
+			&l.Name,
+			&l.Title,
+			&l.Color,
+			&l.Priority,
+			&l.Rule,
+			&l.RuleParam,
+			&l.CreatedAt,
+			&l.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		res = append(res, l)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *Repository) CreateLabel(ctx context.Context, l *model.Label) (uint64, error) {
+	const op = "labels.CreateLabel.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var id uint64
+	err := r.conn.QueryRow(
+		labelCreateQ,
+		l.Name,
+		l.Title,
+		l.Color,
+		l.Priority,
+		l.Rule,
+		l.RuleParam,
+	).Scan(&id)
+	if err != nil {
+		return 0, mapError(err)
+	}
+
+	return id, nil
+}
+
+func (r *Repository) UpdateLabel(ctx context.Context, name string, l *model.Label) error {
+	const op = "labels.UpdateLabel.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.Exec(
+		labelUpdateQ,
+		l.Name,
+		l.Title,
+		l.Color,
+		l.Priority,
+		l.Rule,
+		l.RuleParam,
+		name,
+	)
+	if err != nil {
+		return mapError(err)
+	}
+
+	if aff, _ := res.RowsAffected(); aff == 0 {
+		return repo.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteLabel(ctx context.Context, name string) error {
+	const op = "labels.DeleteLabel.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.Exec(labelDeleteQ, name)
+	if err != nil {
+		return mapError(err)
+	}
+
+	if aff, _ := res.RowsAffected(); aff == 0 {
+		return repo.ErrNotFound
+	}
+
+	return nil
+}
+
+// SetItemLabels replaces the labels assigned to the item by hand, the ones assigned by rules are kept.
+func (r *Repository) SetItemLabels(ctx context.Context, uid uuid.UUID, names []string) error {
+	const op = "labels.SetItemLabels.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return mapError(err)
+	}
+
+	if _, err = tx.Exec(itemLabelManualDeleteQ, uid); err != nil {
+		tx.Rollback()
+		return mapError(err)
+	}
+
+	res, err := tx.Exec(itemLabelSetQ, uid, pq.Array(names))
+	if err != nil {
+		tx.Rollback()
+		if errors.Is(mapError(err), repo.ErrMissingReference) {
+			return repo.ErrNotFound
+		}
+		return mapError(err)
+	}
+
+	if aff, _ := res.RowsAffected(); aff != int64(len(names)) {
+		tx.Rollback()
+		return repo.ErrNotFound
+	}
+
+	return tx.Commit()
+}
+
+// RecomputeLabels assigns the rule labels again from the current items, orders and promotions.
+func (r *Repository) RecomputeLabels(ctx context.Context) error {
+	const op = "labels.RecomputeLabels.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.Exec(itemLabelAutoDeleteQ); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if _, err = tx.Exec(itemLabelAutoAssignQ); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,63 @@
+package db
+
+const labelListQ = `
+	SELECT id, name, title, color, priority, rule, rule_param, created_at, updated_at
+	FROM label
+	ORDER BY priority DESC, name
+`
+
+const labelCreateQ = `
+	INSERT INTO label (name, title, color, priority, rule, rule_param)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	RETURNING id
+`
+
+const labelUpdateQ = `
+	UPDATE label
+	SET name = $1, title = $2, color = $3, priority = $4, rule = $5, rule_param = $6, updated_at = NOW()
+	WHERE name = $7
+`
+
+const labelDeleteQ = `DELETE FROM label WHERE name = $1`
+
+const itemLabelManualDeleteQ = `DELETE FROM item_label WHERE item_id = $1 AND NOT is_auto`
+
+// A manual assignment of a label the item already has by rule takes it over.
+const itemLabelSetQ = `
+	INSERT INTO item_label (item_id, label_id, is_auto)
+	SELECT $1, l.id, FALSE
+	FROM label l
+	WHERE l.name = ANY($2)
+	ON CONFLICT (item_id, label_id) DO UPDATE SET is_auto = FALSE
+`
+
+const itemLabelAutoDeleteQ = `DELETE FROM item_label WHERE is_auto`
+
+const itemLabelAutoAssignQ = `
+	INSERT INTO item_label (item_id, label_id, is_auto)
+	SELECT i.id, l.id, TRUE
+	FROM label l
+	JOIN item i ON i.created_at >= NOW() - MAKE_INTERVAL(days => l.rule_param) AND i.deleted_at IS NULL
+	WHERE l.rule = 'new'
+	UNION
+	SELECT top.item_id, l.id, TRUE
+	FROM label l
+	CROSS JOIN LATERAL (
+	    SELECT oi.item_id
+	    FROM order_item oi
+	    JOIN "order" o ON o.id = oi.order_id AND o.status <> 'cancelled' AND o.deleted_at IS NULL
+	    JOIN item i ON i.id = oi.item_id AND i.deleted_at IS NULL
+	    GROUP BY oi.item_id
+	    ORDER BY SUM(oi.quantity) DESC, oi.item_id
+	    LIMIT l.rule_param
+	) top
+	WHERE l.rule = 'hit'
+	UNION
+	SELECT pi.item_id, l.id, TRUE
+	FROM label l
+	JOIN promotion p ON p.lasts_to > NOW() AND p.deleted_at IS NULL
+	JOIN promotion_item pi ON pi.promotion_slug = p.slug AND pi.discount > 0
+	JOIN item i ON i.id = pi.item_id AND i.deleted_at IS NULL
+	WHERE l.rule = 'sale'
+	ON CONFLICT (item_id, label_id) DO NOTHING
+`
@@ -0,0 +1,144 @@
+package db
+
+import (
+	"context"
+	"errors"
+	repo2 "github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"regexp"
+	"testing"
+)
+
+func TestRepository_SetItemLabels(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	uid := uuid.New()
+	names := []string{"hit", "rec"}
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, error)
+	}{
+		{
+			name: "Manual labels are replaced",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(itemLabelManualDeleteQ)).
+					WithArgs(uid).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(itemLabelSetQ)).
+					WithArgs(uid, pq.Array(names)).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectCommit()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "Unknown label",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(itemLabelManualDeleteQ)).
+					WithArgs(uid).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(regexp.QuoteMeta(itemLabelSetQ)).
+					WithArgs(uid, pq.Array(names)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectRollback()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.Equal(t, repo2.ErrNotFound, err)
+			},
+		},
+		{
+			name: "Unknown item",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(itemLabelManualDeleteQ)).
+					WithArgs(uid).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(regexp.QuoteMeta(itemLabelSetQ)).
+					WithArgs(uid, pq.Array(names)).
+					WillReturnError(&pq.Error{Code: "23503", Detail: "Key (item_id)=(test) is not present in table \"item\"."})
+				mock.ExpectRollback()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.Equal(t, repo2.ErrNotFound, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				err := repo.SetItemLabels(context.Background(), uid, names)
+				tt.expectedResp(t, err)
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_RecomputeLabels(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, error)
+	}{
+		{
+			name: "Rule labels are reassigned",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(itemLabelAutoDeleteQ)).
+					WillReturnResult(sqlmock.NewResult(0, 10))
+				mock.ExpectExec(regexp.QuoteMeta(itemLabelAutoAssignQ)).
+					WillReturnResult(sqlmock.NewResult(0, 12))
+				mock.ExpectCommit()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "Assign fails",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(itemLabelAutoDeleteQ)).
+					WillReturnResult(sqlmock.NewResult(0, 10))
+				mock.ExpectExec(regexp.QuoteMeta(itemLabelAutoAssignQ)).
+					WillReturnError(errors.New("db error"))
+				mock.ExpectRollback()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.Error(t, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				err := repo.RecomputeLabels(context.Background())
+				tt.expectedResp(t, err)
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
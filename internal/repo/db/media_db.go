@@ -0,0 +1,296 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/opentracing/opentracing-go"
+)
+
+func (r *Repository) ListItemMedia(ctx context.Context, itemID uuid.UUID) ([]*model.ItemMedia, error) {
+	const op = "media.ListItemMedia.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	rows, err := r.conn.Query(mediaListQ, itemID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*model.ItemMedia, 0, 10)
+	byID := make(map[uint64]*model.ItemMedia, 10)
+	ids := make([]int64, 0, 10)
+	for rows.Next() {
+		m := &model.ItemMedia{}
+		if err = rows.Scan(
+			&m.ID,
+			&m.ItemID,
+			&m.Src,
+			&m.Alt,
+			&m.StorageKey,
+			&m.ContentType,
+			&m.Size,
+			&m.Width,
+			&m.Height,
+			&m.Position,
+			&m.IsPrimary,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		res = append(res, m)
+		byID[m.ID] = m
+		ids = append(ids, int64(m.ID))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(ids) == 0 {
+		return res, nil
+	}
+
+	vRows, err := r.conn.Query(mediaVariantListQ, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer vRows.Close()
+
+	for vRows.Next() {
+		var mediaID uint64
+		v := model.MediaVariant{}
+		if err = vRows.Scan(&mediaID, &v.Name, &v.Src, &v.StorageKey, &v.ContentType, &v.Width, &v.Height); err != nil {
+			return nil, err
+		}
+		if m, ok := byID[mediaID]; ok {
+			m.Variants = append(m.Variants, v)
+		}
+	}
+
+	if err = vRows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *Repository) CreateItemMedia(ctx context.Context, itemID uuid.UUID, req *model.ItemMedia) (*model.ItemMedia, error) {
+	const op = "media.CreateItemMedia.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	if req.IsPrimary {
+		if _, err = tx.Exec(mediaUnsetPrimaryQ, itemID, 0); err != nil {
+			tx.Rollback()
+			return nil, mapError(err)
+		}
+	}
+
+	res := *req
+	res.ItemID = itemID
+	if err = tx.QueryRow(
+		mediaCreateQ,
+		itemID,
+		req.Src,
+		req.Alt,
+		req.StorageKey,
+		req.ContentType,
+		req.Size,
+		req.Width,
+		req.Height,
+		req.IsPrimary,
+	).Scan(&res.ID, &res.Position, &res.IsPrimary, &res.CreatedAt, &res.UpdatedAt); err != nil {
+		tx.Rollback()
+		if errors.Is(mapError(err), repo.ErrMissingReference) {
+			return nil, repo.ErrNotFound
+		}
+		return nil, mapError(err)
+	}
+
+	for _, v := range req.Variants {
+		if _, err = tx.Exec(
+			mediaVariantCreateQ,
+			res.ID,
+			v.Name,
+			v.Src,
+			v.StorageKey,
+			v.ContentType,
+			v.Width,
+			v.Height,
+		); err != nil {
+			tx.Rollback()
+			return nil, mapError(err)
+		}
+	}
+
+	if res.IsPrimary {
+		if _, err = tx.Exec(mediaSetItemImageQ, res.Src, res.Alt, itemID); err != nil {
+			tx.Rollback()
+			return nil, mapError(err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, mapError(err)
+	}
+
+	return &res, nil
+}
+
+// UpdateItemMediaByID changes the alt text, IsPrimary can only promote the media, another one is demoted.
+func (r *Repository) UpdateItemMediaByID(ctx context.Context, itemID uuid.UUID, id uint64, req *model.ItemMedia) error {
+	const op = "media.UpdateItemMediaByID.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return mapError(err)
+	}
+
+	if req.IsPrimary {
+		if _, err = tx.Exec(mediaUnsetPrimaryQ, itemID, id); err != nil {
+			tx.Rollback()
+			return mapError(err)
+		}
+	}
+
+	var src, alt string
+	var isPrimary bool
+	err = tx.QueryRow(mediaUpdateQ, req.Alt, req.IsPrimary, id, itemID).Scan(&src, &alt, &isPrimary)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		tx.Rollback()
+		return repo.ErrNotFound
+	} else if err != nil {
+		tx.Rollback()
+		return mapError(err)
+	}
+
+	if isPrimary {
+		if _, err = tx.Exec(mediaSetItemImageQ, src, alt, itemID); err != nil {
+			tx.Rollback()
+			return mapError(err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ReorderItemMedia moves the media to the position of its id in ids, every id must belong to the item.
+func (r *Repository) ReorderItemMedia(ctx context.Context, itemID uuid.UUID, ids []uint64) error {
+	const op = "media.ReorderItemMedia.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return mapError(err)
+	}
+
+	arr := make([]int64, len(ids))
+	for i, v := range ids {
+		arr[i] = int64(v)
+	}
+
+	res, err := tx.Exec(mediaReorderQ, itemID, pq.Array(arr))
+	if err != nil {
+		tx.Rollback()
+		return mapError(err)
+	}
+
+	if aff, _ := res.RowsAffected(); aff != int64(len(ids)) {
+		tx.Rollback()
+		return repo.ErrNotFound
+	}
+
+	return tx.Commit()
+}
+
+// DeleteItemMedia removes the media, the storage files are queued for cleanup by the database.
+// When the primary media goes, the first remaining one takes its place.
+func (r *Repository) DeleteItemMedia(ctx context.Context, itemID uuid.UUID, id uint64) error {
+	const op = "media.DeleteItemMedia.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return mapError(err)
+	}
+
+	var wasPrimary bool
+	err = tx.QueryRow(mediaDeleteQ, id, itemID).Scan(&wasPrimary)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		tx.Rollback()
+		return repo.ErrNotFound
+	} else if err != nil {
+		tx.Rollback()
+		return mapError(err)
+	}
+
+	if wasPrimary {
+		var src, alt string
+		err = tx.QueryRow(mediaPromoteFirstQ, itemID).Scan(&src, &alt)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			tx.Rollback()
+			return mapError(err)
+		}
+
+		if _, err = tx.Exec(mediaSetItemImageQ, src, alt, itemID); err != nil {
+			tx.Rollback()
+			return mapError(err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ClaimOrphanMedia takes up to limit keys off the removal queue, the caller removes their files.
+func (r *Repository) ClaimOrphanMedia(ctx context.Context, limit int) ([]string, error) {
+	const op = "media.ClaimOrphanMedia.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	rows, err := r.conn.Query(mediaOrphanClaimQ, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]string, 0, limit)
+	for rows.Next() {
+		var key string
+		if err = rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		res = append(res, key)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// QueueOrphanMedia puts keys back on the removal queue, e.g. when removing their files failed.
+func (r *Repository) QueueOrphanMedia(ctx context.Context, keys []string) error {
+	const op = "media.QueueOrphanMedia.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	_, err := r.conn.Exec(mediaOrphanQueueQ, pq.Array(keys))
+	return err
+}
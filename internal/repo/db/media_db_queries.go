@@ -0,0 +1,79 @@
+package db
+
+const mediaListQ = `
+	SELECT id, item_id, src, alt, storage_key, content_type, size, width, height, position, is_primary, created_at, updated_at
+	FROM item_media
+	WHERE item_id = $1
+	ORDER BY position, id
+`
+
+const mediaVariantListQ = `
+	SELECT media_id, name, src, storage_key, content_type, width, height
+	FROM item_media_variant
+	WHERE media_id = ANY($1)
+	ORDER BY width
+`
+
+const mediaUnsetPrimaryQ = `
+	UPDATE item_media SET is_primary = FALSE
+	WHERE item_id = $1 AND is_primary AND id <> $2
+`
+
+// The first media of an item becomes primary, new media goes to the end.
+const mediaCreateQ = `
+	INSERT INTO item_media (item_id, src, alt, storage_key, content_type, size, width, height, position, is_primary)
+	VALUES (
+		$1, $2, $3, $4, $5, $6, $7, $8,
+		(SELECT COALESCE(MAX(position) + 1, 0) FROM item_media WHERE item_id = $1),
+		$9 OR NOT EXISTS (SELECT 1 FROM item_media WHERE item_id = $1)
+	)
+	RETURNING id, position, is_primary, created_at, updated_at
+`
+
+const mediaVariantCreateQ = `
+	INSERT INTO item_media_variant (media_id, name, src, storage_key, content_type, width, height)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+const mediaUpdateQ = `
+	UPDATE item_media
+	SET alt = $1, is_primary = is_primary OR $2, updated_at = NOW()
+	WHERE id = $3 AND item_id = $4
+	RETURNING src, alt, is_primary
+`
+
+const mediaReorderQ = `
+	UPDATE item_media m
+	SET position = o.ord - 1, updated_at = NOW()
+	FROM UNNEST($2::INTEGER[]) WITH ORDINALITY AS o(id, ord)
+	WHERE m.id = o.id AND m.item_id = $1
+`
+
+const mediaDeleteQ = `
+	DELETE FROM item_media
+	WHERE id = $1 AND item_id = $2
+	RETURNING is_primary
+`
+
+const mediaPromoteFirstQ = `
+	UPDATE item_media SET is_primary = TRUE
+	WHERE id = (SELECT id FROM item_media WHERE item_id = $1 ORDER BY position, id LIMIT 1)
+	RETURNING src, alt
+`
+
+const mediaSetItemImageQ = `UPDATE item SET src = $1, alt = $2 WHERE id = $3`
+
+// mediaOrphanClaimQ takes keys off the queue before their files are removed,
+// keys a running rollback has taken are skipped, so a restored row never loses its file.
+const mediaOrphanClaimQ = `
+	DELETE FROM media_orphan
+	WHERE storage_key IN (
+		SELECT storage_key FROM media_orphan
+		ORDER BY created_at
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	)
+	RETURNING storage_key
+`
+
+const mediaOrphanQueueQ = `INSERT INTO media_orphan (storage_key) SELECT UNNEST($1::VARCHAR[]) ON CONFLICT DO NOTHING`
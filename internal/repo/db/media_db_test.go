@@ -0,0 +1,223 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	repo2 "github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestRepository_CreateItemMedia(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	itemID := uuid.New()
+	now := time.Now()
+	req := &model.ItemMedia{
+		Src:         "/media/a.jpg",
+		Alt:         "alt",
+		StorageKey:  "a.jpg",
+		ContentType: "image/jpeg",
+		Size:        10,
+		Width:       640,
+		Height:      480,
+		Variants: []model.MediaVariant{
+			{Name: "thumb", Src: "/media/a_thumb.webp", StorageKey: "a_thumb.webp", ContentType: "image/webp", Width: 320, Height: 240},
+		},
+	}
+	returning := []string{"id", "position", "is_primary", "created_at", "updated_at"}
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, *model.ItemMedia, error)
+	}{
+		{
+			name: "First media becomes primary",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(mediaCreateQ)).
+					WithArgs(itemID, req.Src, req.Alt, req.StorageKey, req.ContentType, req.Size, req.Width, req.Height, false).
+					WillReturnRows(sqlmock.NewRows(returning).AddRow(1, 0, true, now, now))
+				mock.ExpectExec(regexp.QuoteMeta(mediaVariantCreateQ)).
+					WithArgs(uint64(1), "thumb", "/media/a_thumb.webp", "a_thumb.webp", "image/webp", 320, 240).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(mediaSetItemImageQ)).
+					WithArgs(req.Src, req.Alt, itemID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expectedResp: func(t *testing.T, res *model.ItemMedia, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, uint64(1), res.ID)
+				assert.Equal(t, itemID, res.ItemID)
+				assert.True(t, res.IsPrimary)
+			},
+		},
+		{
+			name: "Item not found",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(mediaCreateQ)).
+					WillReturnError(&pq.Error{Code: "23503", Detail: "Key (item_id)=(test) is not present in table \"item\"."})
+				mock.ExpectRollback()
+			},
+			expectedResp: func(t *testing.T, res *model.ItemMedia, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, repo2.ErrNotFound, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := repo.CreateItemMedia(context.Background(), itemID, req)
+				tt.expectedResp(t, res, err)
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_ReorderItemMedia(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	itemID := uuid.New()
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, error)
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(mediaReorderQ)).
+					WithArgs(itemID, pq.Array([]int64{2, 1})).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectCommit()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "Foreign media id",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(mediaReorderQ)).
+					WithArgs(itemID, pq.Array([]int64{2, 1})).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectRollback()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.Equal(t, repo2.ErrNotFound, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				err := repo.ReorderItemMedia(context.Background(), itemID, []uint64{2, 1})
+				tt.expectedResp(t, err)
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_DeleteItemMedia(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	itemID := uuid.New()
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, error)
+	}{
+		{
+			name: "Primary is replaced",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(mediaDeleteQ)).
+					WithArgs(uint64(1), itemID).
+					WillReturnRows(sqlmock.NewRows([]string{"is_primary"}).AddRow(true))
+				mock.ExpectQuery(regexp.QuoteMeta(mediaPromoteFirstQ)).
+					WithArgs(itemID).
+					WillReturnRows(sqlmock.NewRows([]string{"src", "alt"}).AddRow("/media/b.jpg", "b"))
+				mock.ExpectExec(regexp.QuoteMeta(mediaSetItemImageQ)).
+					WithArgs("/media/b.jpg", "b", itemID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "Last media clears the item image",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(mediaDeleteQ)).
+					WithArgs(uint64(1), itemID).
+					WillReturnRows(sqlmock.NewRows([]string{"is_primary"}).AddRow(true))
+				mock.ExpectQuery(regexp.QuoteMeta(mediaPromoteFirstQ)).
+					WithArgs(itemID).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectExec(regexp.QuoteMeta(mediaSetItemImageQ)).
+					WithArgs("", "", itemID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "Not found",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(mediaDeleteQ)).
+					WithArgs(uint64(1), itemID).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.Equal(t, repo2.ErrNotFound, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				err := repo.DeleteItemMedia(context.Background(), itemID, 1)
+				tt.expectedResp(t, err)
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
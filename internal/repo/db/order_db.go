@@ -10,7 +10,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/lib/pq"
 	"github.com/opentracing/opentracing-go"
+	"slices"
 	"strings"
+	"time"
 )
 
 func (r *Repository) ListOrders(ctx context.Context, page, size int, filters map[string]any, sort string) (*model.PaginatedOrderData, error) {
@@ -20,7 +22,7 @@ func (r *Repository) ListOrders(ctx context.Context, page, size int, filters map
 
 	q := &strings.Builder{}
 	q.WriteString(
-		`SELECT COUNT(*) FROM "order" o JOIN "order_item" oi ON o.id = oi.order_id JOIN item i ON oi.item_id = i.id WHERE 1=1`,
+		`SELECT COUNT(*) FROM "order" o JOIN "order_item" oi ON o.id = oi.order_id JOIN item i ON oi.item_id = i.id WHERE o.deleted_at IS NULL`,
 	)
 	args := make([]any, 0, 20)
 	args = dbutils.FilterOrders(q, args, filters)
@@ -43,7 +45,7 @@ func (r *Repository) ListOrders(ctx context.Context, page, size int, filters map
 		FROM "order" o 
 		JOIN "order_item" oi ON o.id = oi.order_id
 		JOIN item i ON oi.item_id = i.id 
-		WHERE 1=1 `,
+		WHERE o.deleted_at IS NULL `,
 	)
 	args = dbutils.FilterOrders(q, args, filters)
 	if sort == "" {
@@ -129,7 +131,8 @@ func (r *Repository) ListUserOrders(ctx context.Context, uid uuid.UUID, page, si
 		if err = rows.Scan(
 			&order.ID,
 			&order.Status,
-			&order.TotalAmount,
+			&order.TotalAmount.Amount,
+			&order.TotalAmount.Currency,
 			&order.FIO,
 			&order.Tel,
 			&order.Email,
@@ -183,7 +186,8 @@ func (r *Repository) GetOrder(ctx context.Context, orderID uint64) (*model.Order
 	err := r.conn.QueryRow(orderGetQ, orderID).Scan(
 		&res.ID,
 		&res.Status,
-		&res.TotalAmount,
+		&res.TotalAmount.Amount,
+		&res.TotalAmount.Currency,
 		&res.FIO,
 		&res.Tel,
 		&res.Email,
@@ -191,6 +195,7 @@ func (r *Repository) GetOrder(ctx context.Context, orderID uint64) (*model.Order
 		&res.Delivery,
 		&res.PaymentMethod,
 		&res.UserID,
+		&res.Version,
 		&res.CreatedAt,
 		&res.UpdatedAt,
 		pq.Array(&items),
@@ -223,19 +228,23 @@ func (r *Repository) CreateOrder(ctx context.Context, uid uuid.UUID, req *model.
 
 	tx, err := r.conn.Begin()
 	if err != nil {
-		return 0, err
+		return 0, mapError(err)
 	}
 
-	var total float64
-	for _, v := range req.OrderItems {
-		total += v.Item.Price * float64(v.Quantity)
+	total, err := orderTotal(tx, req.OrderItems, req.PriceListID, req.CustomerGroupID)
+	if err != nil {
+		tx.Rollback()
+		return 0, mapError(err)
 	}
 
 	orderID := uint64(0)
 	err = tx.QueryRow(
 		orderCreateQ,
 		model.OrderStatusPending,
-		total,
+		total.Amount,
+		total.Currency,
+		sql.NullInt64{Int64: int64(req.PriceListID), Valid: req.PriceListID != 0},
+		sql.NullInt64{Int64: int64(req.CustomerGroupID), Valid: req.CustomerGroupID != 0},
 		req.FIO,
 		req.Tel,
 		req.Email,
@@ -246,18 +255,18 @@ func (r *Repository) CreateOrder(ctx context.Context, uid uuid.UUID, req *model.
 	).Scan(&orderID)
 	if err != nil {
 		tx.Rollback()
-		return 0, err
+		return 0, mapError(err)
 	}
 
 	for i := 0; i < len(req.OrderItems); i++ {
 		if err = createOrderItem(tx, orderID, req.OrderItems[i]); err != nil {
 			tx.Rollback()
-			return 0, err
+			return 0, mapError(err)
 		}
 	}
 
 	if err = tx.Commit(); err != nil {
-		return 0, err
+		return 0, mapError(err)
 	}
 	return orderID, nil
 }
@@ -269,42 +278,42 @@ func (r *Repository) UpdateOrder(ctx context.Context, orderID uint64, newData *m
 
 	tx, err := r.conn.Begin()
 	if err != nil {
-		return err
+		return mapError(err)
+	}
+
+	version, err := bumpVersion(tx, orderBumpVersionQ, orderVersionQ, orderID, newData.Version)
+	if err != nil {
+		tx.Rollback()
+		return mapError(err)
 	}
 
 	if len(newData.OrderItems) > 0 {
 		if err = UpdateOrderItems(tx, orderID, newData.OrderItems); err != nil {
 			tx.Rollback()
-			return err
+			return mapError(err)
 		}
 
-		var total float64
-		orderItems := make([]model.OrderItem, len(newData.OrderItems))
-		for i := 0; i < len(newData.OrderItems); i++ {
-			v := newData.OrderItems[i]
-			price := float64(0)
-
-			if err = tx.QueryRow(orderItemPriceQ, v.ItemID).Scan(&price); err != nil && errors.Is(err, sql.ErrNoRows) {
-				tx.Rollback()
-				return repo.ErrNotFound
-			} else if err != nil {
-				tx.Rollback()
-				return err
-			}
+		var priceListID, groupID uint64
+		if err = tx.QueryRow(orderPricingQ, orderID).Scan(&priceListID, &groupID); err != nil && errors.Is(err, sql.ErrNoRows) {
+			tx.Rollback()
+			return repo.ErrNotFound
+		} else if err != nil {
+			tx.Rollback()
+			return mapError(err)
+		}
 
-			total += price * float64(v.Quantity)
-			orderItems[i] = model.OrderItem{
-				Quantity: v.Quantity,
-				ItemID:   v.ItemID,
-			}
+		newData.TotalAmount, err = orderTotal(tx, newData.OrderItems, priceListID, groupID)
+		if err != nil {
+			tx.Rollback()
+			return mapError(err)
 		}
-		newData.TotalAmount = total
 	}
 
 	res, err := tx.Exec(
 		orderUpdateQ,
 		newData.Status,
-		newData.TotalAmount,
+		newData.TotalAmount.Amount,
+		newData.TotalAmount.Currency,
 		newData.FIO,
 		newData.Tel,
 		newData.Email,
@@ -316,7 +325,7 @@ func (r *Repository) UpdateOrder(ctx context.Context, orderID uint64, newData *m
 
 	if err != nil {
 		tx.Rollback()
-		return err
+		return mapError(err)
 	}
 
 	if eff, _ := res.RowsAffected(); eff == 0 {
@@ -324,8 +333,94 @@ func (r *Repository) UpdateOrder(ctx context.Context, orderID uint64, newData *m
 		return repo.ErrNotFound
 	}
 
-	return tx.Commit()
+	if err = tx.Commit(); err != nil {
+		return mapError(err)
+	}
+
+	newData.Version = version
+	return nil
+}
+
+// PatchOrder writes only the fields of req named in fields.
+// Patching the order items recalculates the total of the order.
+func (r *Repository) PatchOrder(ctx context.Context, orderID uint64, req *model.Order, fields []string) error {
+	const op = "orders.PatchOrder.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return mapError(err)
+	}
+
+	version, err := bumpVersion(tx, orderBumpVersionQ, orderVersionQ, orderID, req.Version)
+	if err != nil {
+		tx.Rollback()
+		return mapError(err)
+	}
+
+	cols := make([]column, 0, len(fields)+3)
+	for _, v := range fields {
+		switch v {
+		case "status":
+			cols = append(cols, column{"status", req.Status})
+		case "fio":
+			cols = append(cols, column{"fio", req.FIO})
+		case "tel":
+			cols = append(cols, column{"tel", req.Tel})
+		case "email":
+			cols = append(cols, column{"email", req.Email})
+		case "address":
+			cols = append(cols, column{"address", req.Address})
+		case "delivery":
+			cols = append(cols, column{"delivery", req.Delivery})
+		case "payment_method":
+			cols = append(cols, column{"payment_method", req.PaymentMethod})
+		}
+	}
 
+	if slices.Contains(fields, "order_items") {
+		if err = UpdateOrderItems(tx, orderID, req.OrderItems); err != nil {
+			tx.Rollback()
+			return mapError(err)
+		}
+
+		var priceListID, groupID uint64
+		if err = tx.QueryRow(orderPricingQ, orderID).Scan(&priceListID, &groupID); err != nil && errors.Is(err, sql.ErrNoRows) {
+			tx.Rollback()
+			return repo.ErrNotFound
+		} else if err != nil {
+			tx.Rollback()
+			return mapError(err)
+		}
+
+		req.TotalAmount, err = orderTotal(tx, req.OrderItems, priceListID, groupID)
+		if err != nil {
+			tx.Rollback()
+			return mapError(err)
+		}
+
+		cols = append(cols, column{"total_amount", req.TotalAmount.Amount})
+		if req.TotalAmount.Currency != "" {
+			cols = append(cols, column{"currency", req.TotalAmount.Currency})
+		}
+	}
+
+	if len(cols) > 0 {
+		cols = append(cols, column{"updated_at", time.Now()})
+	}
+
+	if err = patchRow(tx, `"order"`, "id", orderID, cols); err != nil {
+		tx.Rollback()
+		return mapError(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return mapError(err)
+	}
+
+	req.Version = version
+	return nil
 }
 
 func (r *Repository) CancelOrder(ctx context.Context, orderID uint64) error {
@@ -335,13 +430,13 @@ func (r *Repository) CancelOrder(ctx context.Context, orderID uint64) error {
 
 	tx, err := r.conn.Begin()
 	if err != nil {
-		return err
+		return mapError(err)
 	}
 
 	res, err := tx.Exec(orderCancelQ, model.OrderStatusCancelled, orderID)
 	if err != nil {
 		tx.Rollback()
-		return err
+		return mapError(err)
 	}
 
 	if eff, _ := res.RowsAffected(); eff == 0 {
@@ -351,3 +446,19 @@ func (r *Repository) CancelOrder(ctx context.Context, orderID uint64) error {
 
 	return tx.Commit()
 }
+
+func (r *Repository) DeleteOrder(ctx context.Context, orderID uint64) error {
+	const op = "orders.DeleteOrder.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.ExecContext(ctx, orderDeleteQ, orderID)
+	if err != nil {
+		return mapError(err)
+	}
+
+	if aff, _ := res.RowsAffected(); aff == 0 {
+		return repo.ErrNotFound
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ SELECT
 	o.id, 
 	o.status, 
 	o.total_amount, 
+	o.currency,
 	o.fio, 
 	o.tel, 
 	o.email,
@@ -12,20 +13,22 @@ SELECT
 	o.delivery, 
 	o.payment_method,
 	o.user_id,
+	o.version,
 	o.created_at,
 	o.updated_at,
 	ARRAY_AGG(oi.id || '|' || oi.item_id || '|' || oi.quantity) AS order_items
 FROM "order" o
 JOIN "order_item" oi ON o.id = oi.order_id
-WHERE o.id=$1 
+WHERE o.id=$1 AND o.deleted_at IS NULL
 `
 
-const userOrderCountQ = `SELECT COUNT(*) FROM "order" WHERE user_id=?`
+const userOrderCountQ = `SELECT COUNT(*) FROM "order" WHERE user_id=$1 AND deleted_at IS NULL`
 const userOrdersQ = `
 SELECT 
 	o.id, 
 	o.status, 
 	o.total_amount, 
+	o.currency,
 	o.fio, 
 	o.tel, 
 	o.email,
@@ -38,24 +41,27 @@ SELECT
 	ARRAY_AGG(oi.id || '|' || oi.item_id || '|' || oi.quantity) AS order_items
 FROM "order" o
 JOIN "order_item" oi ON o.id = oi.order_id
-WHERE user_id=$1 
+WHERE user_id=$1 AND o.deleted_at IS NULL
 ORDER BY created_at DESC 
 LIMIT $2 OFFSET $3
 `
 
 const orderCreateQ = `
 INSERT INTO "order" 
-    (status, total_amount, fio, tel, email, address, delivery, payment_method, user_id, created_at, updated_at) 
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW())
+    (status, total_amount, currency, price_list_id, customer_group_id, fio, tel, email, address, delivery, payment_method, user_id, created_at, updated_at) 
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NOW(), NOW())
 RETURNING id
 `
 
 const orderUpdateQ = `
 UPDATE "order"
-SET status=$1, total_amount=$2, fio=$3, tel=$4, email=$5, address=$6, delivery=$7, payment_method=$8, updated_at=NOW()
-WHERE id=$9
+SET status=$1, total_amount=$2, currency=COALESCE(NULLIF($3, ''), currency), fio=$4, tel=$5, email=$6, address=$7, delivery=$8, payment_method=$9, updated_at=NOW()
+WHERE id=$10
 `
 
+const orderBumpVersionQ = `UPDATE "order" SET version = version + 1 WHERE id = $1 AND deleted_at IS NULL AND ($2 = 0 OR version = $2) RETURNING version`
+const orderVersionQ = `SELECT version FROM "order" WHERE id = $1 AND deleted_at IS NULL`
+
 const orderItemListQ = `
 SELECT
 	oi.id,
@@ -69,6 +75,20 @@ const orderItemCreateQ = `INSERT INTO "order_item" (order_id, item_id, quantity)
 
 const orderItemDeleteQ = `DELETE FROM "order_item" WHERE id=$1`
 
-const orderCancelQ = `UPDATE "order" SET status=$1, updated_at = NOW() WHERE id=$2`
+// orderCancelQ bumps the version, so a client holding the ETag of the open order cannot overwrite the cancellation.
+const orderCancelQ = `UPDATE "order" SET status=$1, version = version + 1, updated_at = NOW() WHERE id=$2 AND deleted_at IS NULL`
+
+const orderDeleteQ = `UPDATE "order" SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
+
+const orderItemPriceQ = `
+SELECT i.price, i.currency, pli.price, COALESCE((
+    SELECT MAX(qb.discount)
+    FROM quantity_break qb
+    WHERE qb.item_id = i.id AND qb.min_quantity <= $3 AND (qb.customer_group_id IS NULL OR qb.customer_group_id = $4)
+), 0)
+FROM item i
+LEFT JOIN price_list_item pli ON pli.item_id = i.id AND pli.price_list_id = $2
+WHERE i.id = $1 AND i.deleted_at IS NULL
+`
 
-const orderItemPriceQ = `SELECT price FROM item WHERE id = $1`
+const orderPricingQ = `SELECT COALESCE(price_list_id, 0), COALESCE(customer_group_id, 0) FROM "order" WHERE id = $1`
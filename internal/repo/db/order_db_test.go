@@ -45,6 +45,7 @@ func TestRepository_ListUserOrders(t *testing.T) {
 						"id",
 						"status",
 						"total_amount",
+						"currency",
 						"fio",
 						"tel",
 						"email",
@@ -60,7 +61,8 @@ func TestRepository_ListUserOrders(t *testing.T) {
 					AddRow(
 						1,
 						"completed",
-						100.0,
+						int64(10000),
+						"RUB",
 						"John Doe",
 						"1234567890",
 						"john@example.com",
@@ -75,7 +77,8 @@ func TestRepository_ListUserOrders(t *testing.T) {
 					AddRow(
 						2,
 						"pending",
-						50.0,
+						int64(5000),
+						"RUB",
 						"Jane Doe",
 						"0987654321",
 						"jane@example.com",
@@ -140,6 +143,7 @@ func TestRepository_ListUserOrders(t *testing.T) {
 								"id",
 								"status",
 								"total_amount",
+								"currency",
 								"fio",
 								"tel",
 								"email",
@@ -190,7 +194,7 @@ func TestRepository_GetOrder(t *testing.T) {
 	expectedOrder := &model.Order{
 		ID:            orderID,
 		Status:        "completed",
-		TotalAmount:   100.0,
+		TotalAmount:   model.NewMoney(10000, "RUB"),
 		FIO:           "John Doe",
 		Tel:           "1234567890",
 		Email:         "john@example.com",
@@ -209,7 +213,7 @@ func TestRepository_GetOrder(t *testing.T) {
 					ID:      itemID1,
 					Title:   "Item 1",
 					Article: "Article 1",
-					Price:   10.0,
+					Price:   model.NewMoney(1000, "RUB"),
 					Src:     "src1",
 					Alt:     "alt1",
 					InStock: true,
@@ -236,6 +240,7 @@ func TestRepository_GetOrder(t *testing.T) {
 								"id",
 								"status",
 								"total_amount",
+								"currency",
 								"fio",
 								"tel",
 								"email",
@@ -243,6 +248,7 @@ func TestRepository_GetOrder(t *testing.T) {
 								"delivery",
 								"payment_method",
 								"user_id",
+								"version",
 								"created_at",
 								"updated_at",
 								"order_items",
@@ -251,7 +257,8 @@ func TestRepository_GetOrder(t *testing.T) {
 							AddRow(
 								expectedOrder.ID,
 								expectedOrder.Status,
-								expectedOrder.TotalAmount,
+								expectedOrder.TotalAmount.Amount,
+								expectedOrder.TotalAmount.Currency,
 								expectedOrder.FIO,
 								expectedOrder.Tel,
 								expectedOrder.Email,
@@ -259,6 +266,7 @@ func TestRepository_GetOrder(t *testing.T) {
 								expectedOrder.Delivery,
 								expectedOrder.PaymentMethod,
 								expectedOrder.UserID.String(),
+								3,
 								expectedOrder.CreatedAt,
 								expectedOrder.UpdatedAt,
 								fmt.Sprintf("{1|%v|3}", itemID1),
@@ -269,7 +277,7 @@ func TestRepository_GetOrder(t *testing.T) {
 					mock.ExpectQuery(
 						regexp.QuoteMeta(
 							`SELECT 
-							 i.id, i.title, i.article, i.price, i.src, i.alt, i.in_stock
+							 i.id, i.title, i.article, i.price, i.currency, i.src, i.alt, i.in_stock
 							 FROM item i
 							 WHERE id = $1`,
 						),
@@ -277,13 +285,14 @@ func TestRepository_GetOrder(t *testing.T) {
 						WithArgs(item.ItemID).
 						WillReturnRows(
 							sqlmock.NewRows(
-								[]string{"id", "title", "article", "price", "src", "alt", "in_stock"},
+								[]string{"id", "title", "article", "price", "currency", "src", "alt", "in_stock"},
 							).
 								AddRow(
 									item.Item.ID,
 									item.Item.Title,
 									item.Item.Article,
-									item.Item.Price,
+									item.Item.Price.Amount,
+									item.Item.Price.Currency,
 									item.Item.Src,
 									item.Item.Alt,
 									item.Item.InStock,
@@ -294,6 +303,7 @@ func TestRepository_GetOrder(t *testing.T) {
 			expectedResp: func(t *testing.T, res *model.Order, err error) {
 				require.NoError(t, err)
 				require.NotNil(t, res)
+				assert.Equal(t, 3, res.Version)
 			},
 		},
 		{
@@ -361,7 +371,7 @@ func TestRepository_CreateOrder(t *testing.T) {
 				Quantity: 3,
 				Item: model.Item{
 					ID:    uuid.New(),
-					Price: 10.0,
+					Price: model.NewMoney(1000, "RUB"),
 				},
 			},
 			{
@@ -369,7 +379,7 @@ func TestRepository_CreateOrder(t *testing.T) {
 				Quantity: 5,
 				Item: model.Item{
 					ID:    uuid.New(),
-					Price: 20.0,
+					Price: model.NewMoney(2000, "RUB"),
 				},
 			},
 		},
@@ -385,10 +395,22 @@ func TestRepository_CreateOrder(t *testing.T) {
 			mockExpect: func() {
 				mock.ExpectBegin()
 
+				for _, item := range order.OrderItems {
+					mock.ExpectQuery(regexp.QuoteMeta(orderItemPriceQ)).
+						WithArgs(item.ItemID, uint64(0), item.Quantity, uint64(0)).
+						WillReturnRows(
+							sqlmock.NewRows([]string{"price", "currency", "list_price", "discount"}).
+								AddRow(item.Item.Price.Amount, item.Item.Price.Currency, nil, 0),
+						)
+				}
+
 				mock.ExpectQuery(regexp.QuoteMeta(orderCreateQ)).
 					WithArgs(
 						model.OrderStatusPending,
-						130.0,
+						int64(13000),
+						"RUB",
+						sql.NullInt64{},
+						sql.NullInt64{},
 						order.FIO,
 						order.Tel,
 						order.Email,
@@ -417,10 +439,22 @@ func TestRepository_CreateOrder(t *testing.T) {
 			mockExpect: func() {
 				mock.ExpectBegin()
 
+				for _, item := range order.OrderItems {
+					mock.ExpectQuery(regexp.QuoteMeta(orderItemPriceQ)).
+						WithArgs(item.ItemID, uint64(0), item.Quantity, uint64(0)).
+						WillReturnRows(
+							sqlmock.NewRows([]string{"price", "currency", "list_price", "discount"}).
+								AddRow(item.Item.Price.Amount, item.Item.Price.Currency, nil, 0),
+						)
+				}
+
 				mock.ExpectQuery(regexp.QuoteMeta(orderCreateQ)).
 					WithArgs(
 						model.OrderStatusPending,
-						130.0, // total amount
+						int64(13000), // total amount
+						"RUB",
+						sql.NullInt64{},
+						sql.NullInt64{},
 						order.FIO,
 						order.Tel,
 						order.Email,
@@ -443,10 +477,22 @@ func TestRepository_CreateOrder(t *testing.T) {
 			mockExpect: func() {
 				mock.ExpectBegin()
 
+				for _, item := range order.OrderItems {
+					mock.ExpectQuery(regexp.QuoteMeta(orderItemPriceQ)).
+						WithArgs(item.ItemID, uint64(0), item.Quantity, uint64(0)).
+						WillReturnRows(
+							sqlmock.NewRows([]string{"price", "currency", "list_price", "discount"}).
+								AddRow(item.Item.Price.Amount, item.Item.Price.Currency, nil, 0),
+						)
+				}
+
 				mock.ExpectQuery(regexp.QuoteMeta(orderCreateQ)).
 					WithArgs(
 						model.OrderStatusPending,
-						130.0, // total amount
+						int64(13000), // total amount
+						"RUB",
+						sql.NullInt64{},
+						sql.NullInt64{},
 						order.FIO,
 						order.Tel,
 						order.Email,
@@ -473,10 +519,22 @@ func TestRepository_CreateOrder(t *testing.T) {
 			mockExpect: func() {
 				mock.ExpectBegin()
 
+				for _, item := range order.OrderItems {
+					mock.ExpectQuery(regexp.QuoteMeta(orderItemPriceQ)).
+						WithArgs(item.ItemID, uint64(0), item.Quantity, uint64(0)).
+						WillReturnRows(
+							sqlmock.NewRows([]string{"price", "currency", "list_price", "discount"}).
+								AddRow(item.Item.Price.Amount, item.Item.Price.Currency, nil, 0),
+						)
+				}
+
 				mock.ExpectQuery(regexp.QuoteMeta(orderCreateQ)).
 					WithArgs(
 						model.OrderStatusPending,
-						130.0, // total amount
+						int64(13000), // total amount
+						"RUB",
+						sql.NullInt64{},
+						sql.NullInt64{},
 						order.FIO,
 						order.Tel,
 						order.Email,
@@ -515,6 +573,209 @@ func TestRepository_CreateOrder(t *testing.T) {
 	}
 }
 
+func TestRepository_CreateOrderWithPriceList(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	uid := uuid.New()
+	orderID := uint64(12345)
+	listID := uint64(7)
+
+	order := &model.Order{
+		FIO:           "John Doe",
+		Tel:           "1234567890",
+		Email:         "john@example.com",
+		Address:       "123 Street",
+		Delivery:      "delivery",
+		PaymentMethod: "credit_card",
+		UserID:        uid,
+		PriceListID:   listID,
+		OrderItems: []*model.OrderItem{
+			{ItemID: uuid.New(), Quantity: 3},
+			{ItemID: uuid.New(), Quantity: 5},
+		},
+	}
+
+	priceListRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows(
+			[]string{
+				"id", "slug", "name", "currency", "base_currency", "rate",
+				"rounding", "rounding_step", "created_at", "updated_at",
+			},
+		).AddRow(listID, "kz", "Kazakhstan", "KZT", "RUB", 5.47, model.RoundingHalfUp, 100, time.Now(), time.Now())
+	}
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, uint64, error)
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(priceListGetByIDQ)).
+					WithArgs(listID).
+					WillReturnRows(priceListRows())
+
+				// Explicit list price
+				mock.ExpectQuery(regexp.QuoteMeta(orderItemPriceQ)).
+					WithArgs(order.OrderItems[0].ItemID, listID, order.OrderItems[0].Quantity, uint64(0)).
+					WillReturnRows(
+						sqlmock.NewRows([]string{"price", "currency", "list_price", "discount"}).AddRow(1000, "RUB", 6000, 0),
+					)
+
+				// 20 RUB * 5.47 = 109.40 KZT, rounded to whole tenge
+				mock.ExpectQuery(regexp.QuoteMeta(orderItemPriceQ)).
+					WithArgs(order.OrderItems[1].ItemID, listID, order.OrderItems[1].Quantity, uint64(0)).
+					WillReturnRows(
+						sqlmock.NewRows([]string{"price", "currency", "list_price", "discount"}).AddRow(2000, "RUB", nil, 0),
+					)
+
+				mock.ExpectQuery(regexp.QuoteMeta(orderCreateQ)).
+					WithArgs(
+						model.OrderStatusPending,
+						int64(3*6000+5*10900),
+						"KZT",
+						sql.NullInt64{Int64: int64(listID), Valid: true},
+						sql.NullInt64{},
+						order.FIO,
+						order.Tel,
+						order.Email,
+						order.Address,
+						order.Delivery,
+						order.PaymentMethod,
+						uid,
+					).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(orderID))
+
+				for _, item := range order.OrderItems {
+					mock.ExpectExec(regexp.QuoteMeta(orderItemCreateQ)).
+						WithArgs(orderID, item.ItemID, item.Quantity).
+						WillReturnResult(sqlmock.NewResult(1, 1))
+				}
+
+				mock.ExpectCommit()
+			},
+			expectedResp: func(t *testing.T, res uint64, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, orderID, res)
+			},
+		},
+		{
+			name: "Item Not Priced",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(priceListGetByIDQ)).
+					WithArgs(listID).
+					WillReturnRows(priceListRows())
+
+				mock.ExpectQuery(regexp.QuoteMeta(orderItemPriceQ)).
+					WithArgs(order.OrderItems[0].ItemID, listID, order.OrderItems[0].Quantity, uint64(0)).
+					WillReturnRows(
+						sqlmock.NewRows([]string{"price", "currency", "list_price", "discount"}).AddRow(1000, "USD", nil, 0),
+					)
+
+				mock.ExpectRollback()
+			},
+			expectedResp: func(t *testing.T, res uint64, err error) {
+				assert.ErrorIs(t, err, model.ErrCurrencyMismatch)
+				assert.Equal(t, uint64(0), res)
+			},
+		},
+		{
+			name: "Price List Not Found",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(priceListGetByIDQ)).
+					WithArgs(listID).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectedResp: func(t *testing.T, res uint64, err error) {
+				assert.Equal(t, repo2.ErrNotFound, err)
+				assert.Equal(t, uint64(0), res)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := repo.CreateOrder(context.Background(), uid, order)
+				tt.expectedResp(t, res, err)
+				err = mock.ExpectationsWereMet()
+				assert.NoError(t, err)
+			},
+		)
+	}
+}
+
+func TestRepository_CreateOrderWithQuantityBreak(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	uid := uuid.New()
+	orderID := uint64(12345)
+	groupID := uint64(3)
+
+	order := &model.Order{
+		FIO:             "John Doe",
+		Tel:             "1234567890",
+		Email:           "john@example.com",
+		Address:         "123 Street",
+		Delivery:        "delivery",
+		PaymentMethod:   "credit_card",
+		UserID:          uid,
+		CustomerGroupID: groupID,
+		OrderItems: []*model.OrderItem{
+			{ItemID: uuid.New(), Quantity: 12},
+		},
+	}
+
+	mock.ExpectBegin()
+
+	// 10.50 RUB with a 5% break is 9.98 RUB per unit
+	mock.ExpectQuery(regexp.QuoteMeta(orderItemPriceQ)).
+		WithArgs(order.OrderItems[0].ItemID, uint64(0), order.OrderItems[0].Quantity, groupID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"price", "currency", "list_price", "discount"}).AddRow(1050, "RUB", nil, 5),
+		)
+
+	mock.ExpectQuery(regexp.QuoteMeta(orderCreateQ)).
+		WithArgs(
+			model.OrderStatusPending,
+			int64(12*998),
+			"RUB",
+			sql.NullInt64{},
+			sql.NullInt64{Int64: int64(groupID), Valid: true},
+			order.FIO,
+			order.Tel,
+			order.Email,
+			order.Address,
+			order.Delivery,
+			order.PaymentMethod,
+			uid,
+		).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(orderID))
+
+	mock.ExpectExec(regexp.QuoteMeta(orderItemCreateQ)).
+		WithArgs(orderID, order.OrderItems[0].ItemID, order.OrderItems[0].Quantity).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mock.ExpectCommit()
+
+	res, err := repo.CreateOrder(context.Background(), uid, order)
+	require.NoError(t, err)
+	assert.Equal(t, orderID, res)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestRepository_UpdateOrder(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -543,11 +804,15 @@ func TestRepository_UpdateOrder(t *testing.T) {
 			name: "Success",
 			mockExpect: func() {
 				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(orderBumpVersionQ)).
+					WithArgs(orderID, order.Version).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(2))
 
 				mock.ExpectExec(regexp.QuoteMeta(orderUpdateQ)).
 					WithArgs(
 						model.OrderStatusPending,
-						0.,
+						int64(0),
+						"",
 						order.FIO,
 						order.Tel,
 						order.Email,
@@ -562,17 +827,54 @@ func TestRepository_UpdateOrder(t *testing.T) {
 			},
 			expectedResp: func(t *testing.T, err error) {
 				require.NoError(t, err)
+				assert.Equal(t, 2, order.Version)
+			},
+		},
+		{
+			name: "Not Found",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(orderBumpVersionQ)).
+					WithArgs(orderID, order.Version).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}))
+				mock.ExpectQuery(regexp.QuoteMeta(orderVersionQ)).
+					WithArgs(orderID).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}))
+				mock.ExpectRollback()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, repo2.ErrNotFound)
+			},
+		},
+		{
+			name: "Version Conflict",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(orderBumpVersionQ)).
+					WithArgs(orderID, order.Version).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}))
+				mock.ExpectQuery(regexp.QuoteMeta(orderVersionQ)).
+					WithArgs(orderID).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(5))
+				mock.ExpectRollback()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, repo2.ErrVersionConflict)
 			},
 		},
 		{
 			name: "Update Order Error",
 			mockExpect: func() {
 				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(orderBumpVersionQ)).
+					WithArgs(orderID, order.Version).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(2))
 
 				mock.ExpectExec(regexp.QuoteMeta(orderUpdateQ)).
 					WithArgs(
 						model.OrderStatusPending,
-						0.,
+						int64(0),
+						"",
 						order.FIO,
 						order.Tel,
 						order.Email,
@@ -594,11 +896,15 @@ func TestRepository_UpdateOrder(t *testing.T) {
 			name: "Commit Error",
 			mockExpect: func() {
 				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(orderBumpVersionQ)).
+					WithArgs(orderID, order.Version).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(2))
 
 				mock.ExpectExec(regexp.QuoteMeta(orderUpdateQ)).
 					WithArgs(
 						model.OrderStatusPending,
-						0.,
+						int64(0),
+						"",
 						order.FIO,
 						order.Tel,
 						order.Email,
@@ -659,6 +965,21 @@ func TestRepository_CancelOrder(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+		{
+			name: "Bumps Version",
+			mockExpect: func() {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(`UPDATE "order" SET status=\$1, version = version \+ 1`).
+					WithArgs(model.OrderStatusCancelled, orderID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				mock.ExpectCommit()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
 		{
 			name: "Repo Not Found",
 			mockExpect: func() {
@@ -66,7 +66,7 @@ func PopulateOrderItems(tx *sql.DB, itemID uuid.UUID) (model.Item, error) {
 	i := model.Item{}
 	err := tx.QueryRow(
 		`SELECT 
-    		i.id, i.title, i.article, i.price, i.src, i.alt, i.in_stock
+    		i.id, i.title, i.article, i.price, i.currency, i.src, i.alt, i.in_stock
 			FROM item i
 			WHERE id = $1`,
 		itemID,
@@ -74,7 +74,8 @@ func PopulateOrderItems(tx *sql.DB, itemID uuid.UUID) (model.Item, error) {
 		&i.ID,
 		&i.Title,
 		&i.Article,
-		&i.Price,
+		&i.Price.Amount,
+		&i.Price.Currency,
 		&i.Src,
 		&i.Alt,
 		&i.InStock,
@@ -86,3 +87,56 @@ func PopulateOrderItems(tx *sql.DB, itemID uuid.UUID) (model.Item, error) {
 
 	return i, nil
 }
+
+// orderTotal sums the current item prices in minor units, so the total is exact regardless of the number of items.
+// With a price list every item is priced in the list currency, items it cannot price fail with model.ErrCurrencyMismatch.
+// Unit prices then get the best quantity break the customer group is entitled to for the ordered quantity.
+func orderTotal(tx *sql.Tx, items []*model.OrderItem, priceListID, groupID uint64) (model.Money, error) {
+	var pl *model.PriceList
+	if priceListID != 0 {
+		var err error
+		pl, err = scanPriceList(tx.QueryRow(priceListGetByIDQ, priceListID))
+		if err != nil && errors.Is(err, sql.ErrNoRows) {
+			return model.Money{}, repo.ErrNotFound
+		} else if err != nil {
+			return model.Money{}, err
+		}
+	}
+
+	total := model.Money{}
+	for _, v := range items {
+		price := model.Money{}
+		listPrice := sql.NullInt64{}
+		discount := 0
+		err := tx.QueryRow(orderItemPriceQ, v.ItemID, priceListID, v.Quantity, groupID).
+			Scan(&price.Amount, &price.Currency, &listPrice, &discount)
+		if err != nil && errors.Is(err, sql.ErrNoRows) {
+			return model.Money{}, repo.ErrNotFound
+		} else if err != nil {
+			return model.Money{}, err
+		}
+
+		if pl != nil {
+			var explicit *int64
+			if listPrice.Valid {
+				explicit = &listPrice.Int64
+			}
+
+			if price = pl.Price(price, explicit); price.Currency != pl.Currency {
+				return model.Money{}, model.ErrCurrencyMismatch
+			}
+		}
+
+		if total, err = total.Add(price.Discount(discount).Mul(int64(v.Quantity))); err != nil {
+			return model.Money{}, err
+		}
+	}
+
+	switch {
+	case pl != nil:
+		total.Currency = pl.Currency
+	case total.Currency == "":
+		total.Currency = model.DefaultCurrency
+	}
+	return total, nil
+}
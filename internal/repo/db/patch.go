@@ -0,0 +1,41 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"strings"
+)
+
+// column is a column written by a partial update.
+type column struct {
+	name  string
+	value any
+}
+
+// patchRow updates the given columns of the row of table where key = id.
+// Nothing is written when cols is empty, the caller already locked the row by bumping its version.
+func patchRow(tx *sql.Tx, table, key string, id any, cols []column) error {
+	if len(cols) == 0 {
+		return nil
+	}
+
+	set := make([]string, 0, len(cols))
+	args := make([]any, 0, len(cols)+1)
+	for i, v := range cols {
+		set = append(set, fmt.Sprintf("%v = $%v", v.name, i+1))
+		args = append(args, v.value)
+	}
+	args = append(args, id)
+
+	q := fmt.Sprintf("UPDATE %v SET %v WHERE %v = $%v", table, strings.Join(set, ", "), key, len(args))
+	res, err := tx.Exec(q, args...)
+	if err != nil {
+		return err
+	}
+
+	if aff, _ := res.RowsAffected(); aff == 0 {
+		return repo.ErrNotFound
+	}
+	return nil
+}
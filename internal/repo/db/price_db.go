@@ -0,0 +1,213 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"time"
+)
+
+func (r *Repository) GetPriceTimeline(ctx context.Context, uid uuid.UUID) (*model.PriceTimeline, error) {
+	const op = "prices.GetPriceTimeline.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res := &model.PriceTimeline{
+		ItemID:    uid,
+		History:   make([]*model.PriceHistory, 0, 10),
+		Scheduled: make([]*model.ScheduledPrice, 0, 2),
+	}
+
+	err := r.conn.QueryRow(priceItemCurrentQ, uid).Scan(&res.CurrentPrice.Amount, &res.CurrentPrice.Currency)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return nil, repo.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	rows, err := r.conn.Query(priceHistoryListQ, uid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		h := &model.PriceHistory{}
+		var oldPrice sql.NullInt64
+		if err = rows.Scan(
+			&h.ID,
+			&h.ItemID,
+			&oldPrice,
+			&h.NewPrice.Amount,
+			&h.NewPrice.Currency,
+			&h.Source,
+			&h.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		h.OldPrice = model.NewMoney(oldPrice.Int64, h.NewPrice.Currency)
+		res.History = append(res.History, h)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	schRows, err := r.conn.Query(priceScheduledListQ, uid)
+	if err != nil {
+		return nil, err
+	}
+	defer schRows.Close()
+
+	for schRows.Next() {
+		sp := &model.ScheduledPrice{}
+		if err = schRows.Scan(
+			&sp.ID,
+			&sp.ItemID,
+			&sp.Price.Amount,
+			&sp.Price.Currency,
+			&sp.StartsAt,
+			&sp.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		res.Scheduled = append(res.Scheduled, sp)
+	}
+
+	if err = schRows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *Repository) CreateScheduledPrice(ctx context.Context, sp *model.ScheduledPrice) (uint64, error) {
+	const op = "prices.CreateScheduledPrice.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var id uint64
+	err := r.conn.QueryRow(priceScheduleCreateQ, sp.ItemID, sp.Price.Amount, sp.Price.Currency, sp.StartsAt).Scan(&id)
+	if err != nil {
+		if errors.Is(mapError(err), repo.ErrMissingReference) {
+			return 0, repo.ErrNotFound
+		}
+		return 0, mapError(err)
+	}
+
+	return id, nil
+}
+
+func (r *Repository) DeleteScheduledPrice(ctx context.Context, id uint64) error {
+	const op = "prices.DeleteScheduledPrice.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.Exec(priceScheduleDeleteQ, id)
+	if err != nil {
+		return mapError(err)
+	}
+
+	if aff, _ := res.RowsAffected(); aff == 0 {
+		return repo.ErrNotFound
+	}
+
+	return nil
+}
+
+// ListDueScheduledItems returns the IDs of the items with a scheduled price due at now.
+func (r *Repository) ListDueScheduledItems(ctx context.Context, now time.Time) ([]uuid.UUID, error) {
+	const op = "prices.ListDueScheduledItems.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	rows, err := r.conn.Query(priceScheduledDueItemsQ, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]uuid.UUID, 0, 10)
+	for rows.Next() {
+		var uid uuid.UUID
+		if err = rows.Scan(&uid); err != nil {
+			return nil, err
+		}
+		res = append(res, uid)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ApplyScheduledPrices moves every due scheduled price onto its item and returns the IDs of the items it touched.
+// Rows are locked with SKIP LOCKED so concurrent workers never apply the same change twice.
+func (r *Repository) ApplyScheduledPrices(ctx context.Context, now time.Time) ([]uuid.UUID, error) {
+	const op = "prices.ApplyScheduledPrices.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = tx.Exec(priceSetSourceQ, model.PriceSourceScheduled); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	rows, err := tx.Query(priceScheduledDueQ, now)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	due := make([]*model.ScheduledPrice, 0, 10)
+	for rows.Next() {
+		sp := &model.ScheduledPrice{}
+		if err = rows.Scan(&sp.ID, &sp.ItemID, &sp.Price.Amount, &sp.Price.Currency); err != nil {
+			rows.Close()
+			tx.Rollback()
+			return nil, err
+		}
+		due = append(due, sp)
+	}
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	seen := make(map[uuid.UUID]struct{}, len(due))
+	items := make([]uuid.UUID, 0, len(due))
+	for _, sp := range due {
+		if _, err = tx.Exec(priceApplyItemQ, sp.Price.Amount, sp.Price.Currency, sp.ItemID); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		if _, err = tx.Exec(priceMarkAppliedQ, now, sp.ID); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		if _, ok := seen[sp.ItemID]; !ok {
+			seen[sp.ItemID] = struct{}{}
+			items = append(items, sp.ItemID)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
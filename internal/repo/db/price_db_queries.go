@@ -0,0 +1,61 @@
+package db
+
+const priceItemCurrentQ = `
+	SELECT price, currency
+	FROM item
+	WHERE id = $1
+`
+
+const priceHistoryListQ = `
+	SELECT id, item_id, old_price, new_price, currency, source, created_at
+	FROM price_history
+	WHERE item_id = $1
+	ORDER BY created_at DESC, id DESC
+`
+
+const priceScheduledListQ = `
+	SELECT id, item_id, price, currency, starts_at, created_at
+	FROM scheduled_price
+	WHERE item_id = $1 AND applied_at IS NULL
+	ORDER BY starts_at
+`
+
+const priceScheduleCreateQ = `
+	INSERT INTO scheduled_price (item_id, price, currency, starts_at)
+	VALUES ($1, $2, $3, $4)
+	RETURNING id
+`
+
+const priceScheduleDeleteQ = `
+	DELETE FROM scheduled_price
+	WHERE id = $1 AND applied_at IS NULL
+`
+
+const priceScheduledDueItemsQ = `
+	SELECT DISTINCT item_id
+	FROM scheduled_price
+	WHERE applied_at IS NULL AND starts_at <= $1
+`
+
+const priceSetSourceQ = `SELECT set_config('app.price_source', $1, TRUE)`
+
+const priceScheduledDueQ = `
+	SELECT id, item_id, price, currency
+	FROM scheduled_price
+	WHERE applied_at IS NULL AND starts_at <= $1
+	ORDER BY starts_at, id
+	FOR UPDATE SKIP LOCKED
+`
+
+// priceApplyItemQ bumps the version, the applied price is a change like any other.
+const priceApplyItemQ = `
+	UPDATE item
+	SET price = $1, currency = $2, version = version + 1, updated_at = NOW()
+	WHERE id = $3
+`
+
+const priceMarkAppliedQ = `
+	UPDATE scheduled_price
+	SET applied_at = $1
+	WHERE id = $2
+`
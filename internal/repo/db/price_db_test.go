@@ -0,0 +1,386 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	repo2 "github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestRepository_GetPriceTimeline(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	uid := uuid.New()
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, *model.PriceTimeline, error)
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(priceItemCurrentQ)).
+					WithArgs(uid).
+					WillReturnRows(sqlmock.NewRows([]string{"price", "currency"}).AddRow(8000, "RUB"))
+
+				mock.ExpectQuery(regexp.QuoteMeta(priceHistoryListQ)).
+					WithArgs(uid).
+					WillReturnRows(
+						sqlmock.NewRows(
+							[]string{"id", "item_id", "old_price", "new_price", "currency", "source", "created_at"},
+						).
+							AddRow(2, uid.String(), 10000, 8000, "RUB", model.PriceSourceScheduled, now).
+							AddRow(1, uid.String(), nil, 10000, "RUB", model.PriceSourceManual, now.Add(-time.Hour)),
+					)
+
+				mock.ExpectQuery(regexp.QuoteMeta(priceScheduledListQ)).
+					WithArgs(uid).
+					WillReturnRows(
+						sqlmock.NewRows([]string{"id", "item_id", "price", "currency", "starts_at", "created_at"}).
+							AddRow(1, uid.String(), 9000, "RUB", now.Add(time.Hour), now),
+					)
+			},
+			expectedResp: func(t *testing.T, res *model.PriceTimeline, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, model.NewMoney(8000, "RUB"), res.CurrentPrice)
+				require.Len(t, res.History, 2)
+				assert.Equal(t, model.NewMoney(10000, "RUB"), res.History[0].OldPrice)
+				assert.True(t, res.History[1].OldPrice.IsZero())
+				require.Len(t, res.Scheduled, 1)
+				assert.Equal(t, model.NewMoney(9000, "RUB"), res.Scheduled[0].Price)
+			},
+		},
+		{
+			name: "NotFound",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(priceItemCurrentQ)).
+					WithArgs(uid).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedResp: func(t *testing.T, res *model.PriceTimeline, err error) {
+				assert.Equal(t, repo2.ErrNotFound, err)
+				assert.Nil(t, res)
+			},
+		},
+		{
+			name: "HistoryError",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(priceItemCurrentQ)).
+					WithArgs(uid).
+					WillReturnRows(sqlmock.NewRows([]string{"price", "currency"}).AddRow(8000, "RUB"))
+
+				mock.ExpectQuery(regexp.QuoteMeta(priceHistoryListQ)).
+					WithArgs(uid).
+					WillReturnError(errors.New("query error"))
+			},
+			expectedResp: func(t *testing.T, res *model.PriceTimeline, err error) {
+				assert.Equal(t, "query error", err.Error())
+				assert.Nil(t, res)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := repo.GetPriceTimeline(context.Background(), uid)
+				tt.expectedResp(t, res, err)
+				assert.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_CreateScheduledPrice(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	sp := &model.ScheduledPrice{
+		ItemID:   uuid.New(),
+		Price:    model.NewMoney(9990, "RUB"),
+		StartsAt: time.Now().Add(time.Hour),
+	}
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, uint64, error)
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(priceScheduleCreateQ)).
+					WithArgs(sp.ItemID, sp.Price.Amount, sp.Price.Currency, sp.StartsAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+			},
+			expectedResp: func(t *testing.T, res uint64, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, uint64(1), res)
+			},
+		},
+		{
+			name: "ItemNotFound",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(priceScheduleCreateQ)).
+					WithArgs(sp.ItemID, sp.Price.Amount, sp.Price.Currency, sp.StartsAt).
+					WillReturnError(&pq.Error{Code: "23503", Detail: "Key (item_id)=(test) is not present in table \"item\"."})
+			},
+			expectedResp: func(t *testing.T, res uint64, err error) {
+				assert.Equal(t, repo2.ErrNotFound, err)
+				assert.Zero(t, res)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := repo.CreateScheduledPrice(context.Background(), sp)
+				tt.expectedResp(t, res, err)
+				assert.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_DeleteScheduledPrice(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	const id = uint64(1)
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, error)
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				mock.ExpectExec(regexp.QuoteMeta(priceScheduleDeleteQ)).
+					WithArgs(id).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "NotFound",
+			mockExpect: func() {
+				mock.ExpectExec(regexp.QuoteMeta(priceScheduleDeleteQ)).
+					WithArgs(id).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.Equal(t, repo2.ErrNotFound, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				tt.expectedResp(t, repo.DeleteScheduledPrice(context.Background(), id))
+				assert.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_ListDueScheduledItems(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	itemID := uuid.New()
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, []uuid.UUID, error)
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(priceScheduledDueItemsQ)).
+					WithArgs(now).
+					WillReturnRows(sqlmock.NewRows([]string{"item_id"}).AddRow(itemID.String()))
+			},
+			expectedResp: func(t *testing.T, res []uuid.UUID, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, []uuid.UUID{itemID}, res)
+			},
+		},
+		{
+			name: "QueryError",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(priceScheduledDueItemsQ)).
+					WithArgs(now).
+					WillReturnError(errors.New("db error"))
+			},
+			expectedResp: func(t *testing.T, res []uuid.UUID, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, res)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := repo.ListDueScheduledItems(context.Background(), now)
+				tt.expectedResp(t, res, err)
+				assert.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_ApplyScheduledPrices(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	itemID := uuid.New()
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, []uuid.UUID, error)
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(priceSetSourceQ)).
+					WithArgs(model.PriceSourceScheduled).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(regexp.QuoteMeta(priceScheduledDueQ)).
+					WithArgs(now).
+					WillReturnRows(
+						sqlmock.NewRows([]string{"id", "item_id", "price", "currency"}).
+							AddRow(1, itemID.String(), 9000, "RUB").
+							AddRow(2, itemID.String(), 8500, "RUB"),
+					)
+				mock.ExpectExec(regexp.QuoteMeta(priceApplyItemQ)).
+					WithArgs(int64(9000), "RUB", itemID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(priceMarkAppliedQ)).
+					WithArgs(now, uint64(1)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(priceApplyItemQ)).
+					WithArgs(int64(8500), "RUB", itemID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(priceMarkAppliedQ)).
+					WithArgs(now, uint64(2)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expectedResp: func(t *testing.T, res []uuid.UUID, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, []uuid.UUID{itemID}, res)
+			},
+		},
+		{
+			name: "Bumps version",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(priceSetSourceQ)).
+					WithArgs(model.PriceSourceScheduled).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(regexp.QuoteMeta(priceScheduledDueQ)).
+					WithArgs(now).
+					WillReturnRows(
+						sqlmock.NewRows([]string{"id", "item_id", "price", "currency"}).
+							AddRow(1, itemID.String(), 9000, "RUB"),
+					)
+				mock.ExpectExec(`UPDATE item\s+SET price = \$1, currency = \$2, version = version \+ 1`).
+					WithArgs(int64(9000), "RUB", itemID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(priceMarkAppliedQ)).
+					WithArgs(now, uint64(1)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expectedResp: func(t *testing.T, res []uuid.UUID, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, []uuid.UUID{itemID}, res)
+			},
+		},
+		{
+			name: "Nothing due",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(priceSetSourceQ)).
+					WithArgs(model.PriceSourceScheduled).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(regexp.QuoteMeta(priceScheduledDueQ)).
+					WithArgs(now).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "item_id", "price", "currency"}))
+				mock.ExpectCommit()
+			},
+			expectedResp: func(t *testing.T, res []uuid.UUID, err error) {
+				require.NoError(t, err)
+				assert.Empty(t, res)
+			},
+		},
+		{
+			name: "Apply error",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(priceSetSourceQ)).
+					WithArgs(model.PriceSourceScheduled).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(regexp.QuoteMeta(priceScheduledDueQ)).
+					WithArgs(now).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "item_id", "price", "currency"}).AddRow(1, itemID.String(), 9000, "RUB"))
+				mock.ExpectExec(regexp.QuoteMeta(priceApplyItemQ)).
+					WithArgs(int64(9000), "RUB", itemID).
+					WillReturnError(errors.New("exec error"))
+				mock.ExpectRollback()
+			},
+			expectedResp: func(t *testing.T, res []uuid.UUID, err error) {
+				assert.Equal(t, "exec error", err.Error())
+				assert.Nil(t, res)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := repo.ApplyScheduledPrices(context.Background(), now)
+				tt.expectedResp(t, res, err)
+				assert.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
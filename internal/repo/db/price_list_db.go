@@ -0,0 +1,251 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/opentracing/opentracing-go"
+)
+
+func (r *Repository) ListPriceLists(ctx context.Context) ([]*model.PriceList, error) {
+	const op = "priceLists.ListPriceLists.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	rows, err := r.conn.Query(priceListListQ)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*model.PriceList, 0, 5)
+	for rows.Next() {
+		pl, err := scanPriceList(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, pl)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *Repository) GetPriceList(ctx context.Context, slug string) (*model.PriceList, error) {
+	const op = "priceLists.GetPriceList.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := scanPriceList(r.conn.QueryRow(priceListGetQ, slug))
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return nil, repo.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *Repository) CreatePriceList(ctx context.Context, pl *model.PriceList) (string, error) {
+	const op = "priceLists.CreatePriceList.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var slug string
+	err := r.conn.QueryRow(
+		priceListCreateQ,
+		pl.Slug,
+		pl.Name,
+		pl.Currency,
+		pl.BaseCurrency,
+		nullRate(pl.Rate),
+		pl.Rounding,
+		pl.RoundingStep,
+	).Scan(&slug)
+	if err != nil {
+		return "", mapError(err)
+	}
+
+	return slug, nil
+}
+
+func (r *Repository) UpdatePriceList(ctx context.Context, slug string, pl *model.PriceList) error {
+	const op = "priceLists.UpdatePriceList.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.Exec(
+		priceListUpdateQ,
+		pl.Name,
+		pl.Currency,
+		pl.BaseCurrency,
+		nullRate(pl.Rate),
+		pl.Rounding,
+		pl.RoundingStep,
+		slug,
+	)
+	if err != nil {
+		return mapError(err)
+	}
+
+	if aff, _ := res.RowsAffected(); aff == 0 {
+		return repo.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) DeletePriceList(ctx context.Context, slug string) error {
+	const op = "priceLists.DeletePriceList.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.Exec(priceListDeleteQ, slug)
+	if err != nil {
+		return mapError(err)
+	}
+
+	if aff, _ := res.RowsAffected(); aff == 0 {
+		return repo.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) ListPriceListItems(ctx context.Context, slug string, page, size int) (*model.PaginatedPriceListItemsData, error) {
+	const op = "priceLists.ListPriceListItems.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var count int64
+	if err := r.conn.QueryRow(priceListItemCountQ, slug).Scan(&count); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.conn.Query(priceListItemListQ, slug, (page-1)*size, size)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*model.PriceListItem, 0, size)
+	for rows.Next() {
+		pi := &model.PriceListItem{}
+		if err = rows.Scan(&pi.PriceListID, &pi.ItemID, &pi.Price.Amount, &pi.Price.Currency); err != nil {
+			return nil, err
+		}
+		res = append(res, pi)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	totalPages := int((count + int64(size) - 1) / int64(size))
+	return &model.PaginatedPriceListItemsData{
+		Data:        res,
+		Count:       count,
+		TotalPages:  totalPages,
+		CurrentPage: page,
+		HasNextPage: page < totalPages,
+	}, nil
+}
+
+// SetPriceListItems creates or replaces explicit item prices in the list.
+// Prices are stored in the list currency, a different currency is rejected with model.ErrCurrencyMismatch.
+func (r *Repository) SetPriceListItems(ctx context.Context, slug string, items []*model.PriceListItem) error {
+	const op = "priceLists.SetPriceListItems.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return mapError(err)
+	}
+
+	var listID uint64
+	var currency string
+	err = tx.QueryRow(priceListIDQ, slug).Scan(&listID, &currency)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		tx.Rollback()
+		return repo.ErrNotFound
+	} else if err != nil {
+		tx.Rollback()
+		return mapError(err)
+	}
+
+	for _, v := range items {
+		if v.Price.Currency != "" && v.Price.Currency != currency {
+			tx.Rollback()
+			return model.ErrCurrencyMismatch
+		}
+
+		if _, err = tx.Exec(priceListItemUpsertQ, listID, v.ItemID, v.Price.Amount); err != nil {
+			tx.Rollback()
+			if errors.Is(mapError(err), repo.ErrMissingReference) {
+				return repo.ErrNotFound
+			}
+			return mapError(err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *Repository) DeletePriceListItem(ctx context.Context, slug string, itemID uuid.UUID) error {
+	const op = "priceLists.DeletePriceListItem.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	res, err := r.conn.Exec(priceListItemDeleteQ, slug, itemID)
+	if err != nil {
+		return mapError(err)
+	}
+
+	if aff, _ := res.RowsAffected(); aff == 0 {
+		return repo.ErrNotFound
+	}
+
+	return nil
+}
+
+// GetPriceListPrices returns the explicit list prices of the given items, items without one are left out.
+func (r *Repository) GetPriceListPrices(ctx context.Context, listID uint64, items []uuid.UUID) (map[uuid.UUID]int64, error) {
+	const op = "priceLists.GetPriceListPrices.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	ids := make([]string, 0, len(items))
+	for _, v := range items {
+		ids = append(ids, v.String())
+	}
+
+	rows, err := r.conn.Query(priceListItemPricesQ, listID, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make(map[uuid.UUID]int64, len(items))
+	for rows.Next() {
+		var itemID uuid.UUID
+		var price int64
+		if err = rows.Scan(&itemID, &price); err != nil {
+			return nil, err
+		}
+		res[itemID] = price
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
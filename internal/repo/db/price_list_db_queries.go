@@ -0,0 +1,69 @@
+package db
+
+const priceListListQ = `
+	SELECT id, slug, name, currency, base_currency, rate, rounding, rounding_step, created_at, updated_at
+	FROM price_list
+	ORDER BY slug
+`
+
+const priceListGetQ = `
+	SELECT id, slug, name, currency, base_currency, rate, rounding, rounding_step, created_at, updated_at
+	FROM price_list
+	WHERE slug = $1
+`
+
+const priceListGetByIDQ = `
+	SELECT id, slug, name, currency, base_currency, rate, rounding, rounding_step, created_at, updated_at
+	FROM price_list
+	WHERE id = $1
+`
+
+const priceListCreateQ = `
+	INSERT INTO price_list (slug, name, currency, base_currency, rate, rounding, rounding_step)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	RETURNING slug
+`
+
+const priceListUpdateQ = `
+	UPDATE price_list
+	SET name = $1, currency = $2, base_currency = $3, rate = $4, rounding = $5, rounding_step = $6, updated_at = NOW()
+	WHERE slug = $7
+`
+
+const priceListDeleteQ = `DELETE FROM price_list WHERE slug = $1`
+
+const priceListIDQ = `SELECT id, currency FROM price_list WHERE slug = $1`
+
+const priceListItemCountQ = `
+	SELECT COUNT(*)
+	FROM price_list_item pli
+	JOIN price_list pl ON pl.id = pli.price_list_id
+	WHERE pl.slug = $1
+`
+
+const priceListItemListQ = `
+	SELECT pli.price_list_id, pli.item_id, pli.price, pl.currency
+	FROM price_list_item pli
+	JOIN price_list pl ON pl.id = pli.price_list_id
+	WHERE pl.slug = $1
+	ORDER BY pli.item_id
+	OFFSET $2 LIMIT $3
+`
+
+const priceListItemUpsertQ = `
+	INSERT INTO price_list_item (price_list_id, item_id, price)
+	VALUES ($1, $2, $3)
+	ON CONFLICT (price_list_id, item_id) DO UPDATE SET price = EXCLUDED.price
+`
+
+const priceListItemDeleteQ = `
+	DELETE FROM price_list_item pli
+	USING price_list pl
+	WHERE pl.id = pli.price_list_id AND pl.slug = $1 AND pli.item_id = $2
+`
+
+const priceListItemPricesQ = `
+	SELECT item_id, price
+	FROM price_list_item
+	WHERE price_list_id = $1 AND item_id = ANY($2)
+`
@@ -0,0 +1,37 @@
+package db
+
+import (
+	"database/sql"
+	"github.com/JMURv/par-pro/products/pkg/model"
+)
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanPriceList(row rowScanner) (*model.PriceList, error) {
+	pl := &model.PriceList{}
+	rate := sql.NullFloat64{}
+	if err := row.Scan(
+		&pl.ID,
+		&pl.Slug,
+		&pl.Name,
+		&pl.Currency,
+		&pl.BaseCurrency,
+		&rate,
+		&pl.Rounding,
+		&pl.RoundingStep,
+		&pl.CreatedAt,
+		&pl.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	pl.Rate = rate.Float64
+	return pl, nil
+}
+
+// nullRate stores a missing rate as NULL, which means the list only has explicit prices.
+func nullRate(rate float64) sql.NullFloat64 {
+	return sql.NullFloat64{Float64: rate, Valid: rate > 0}
+}
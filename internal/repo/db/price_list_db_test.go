@@ -0,0 +1,299 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	repo2 "github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var priceListCols = []string{
+	"id", "slug", "name", "currency", "base_currency", "rate", "rounding", "rounding_step", "created_at", "updated_at",
+}
+
+func TestRepository_GetPriceList(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, *model.PriceList, error)
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(priceListGetQ)).
+					WithArgs("kz").
+					WillReturnRows(
+						sqlmock.NewRows(priceListCols).
+							AddRow(1, "kz", "Kazakhstan", "KZT", "RUB", 5.47, model.RoundingHalfUp, 100, now, now),
+					)
+			},
+			expectedResp: func(t *testing.T, res *model.PriceList, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "KZT", res.Currency)
+				assert.Equal(t, 5.47, res.Rate)
+				assert.Equal(t, int64(100), res.RoundingStep)
+			},
+		},
+		{
+			name: "Without rate",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(priceListGetQ)).
+					WithArgs("kz").
+					WillReturnRows(
+						sqlmock.NewRows(priceListCols).
+							AddRow(1, "kz", "Kazakhstan", "KZT", "RUB", nil, model.RoundingHalfUp, 1, now, now),
+					)
+			},
+			expectedResp: func(t *testing.T, res *model.PriceList, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, float64(0), res.Rate)
+			},
+		},
+		{
+			name: "Not found",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(priceListGetQ)).
+					WithArgs("kz").
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedResp: func(t *testing.T, res *model.PriceList, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, repo2.ErrNotFound, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := repo.GetPriceList(context.Background(), "kz")
+				tt.expectedResp(t, res, err)
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_CreatePriceList(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	pl := &model.PriceList{
+		Slug:         "kz",
+		Name:         "Kazakhstan",
+		Currency:     "KZT",
+		BaseCurrency: "RUB",
+		Rate:         5.47,
+		Rounding:     model.RoundingHalfUp,
+		RoundingStep: 100,
+	}
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, string, error)
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(priceListCreateQ)).
+					WithArgs(
+						pl.Slug, pl.Name, pl.Currency, pl.BaseCurrency,
+						sql.NullFloat64{Float64: pl.Rate, Valid: true}, pl.Rounding, pl.RoundingStep,
+					).
+					WillReturnRows(sqlmock.NewRows([]string{"slug"}).AddRow(pl.Slug))
+			},
+			expectedResp: func(t *testing.T, res string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, pl.Slug, res)
+			},
+		},
+		{
+			name: "Already exists",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(priceListCreateQ)).
+					WithArgs(
+						pl.Slug, pl.Name, pl.Currency, pl.BaseCurrency,
+						sql.NullFloat64{Float64: pl.Rate, Valid: true}, pl.Rounding, pl.RoundingStep,
+					).
+					WillReturnError(&pq.Error{Code: "23505", Detail: "Key (slug)=(test) already exists."})
+			},
+			expectedResp: func(t *testing.T, res string, err error) {
+				assert.Empty(t, res)
+				assert.ErrorIs(t, err, repo2.ErrAlreadyExists)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := repo.CreatePriceList(context.Background(), pl)
+				tt.expectedResp(t, res, err)
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_SetPriceListItems(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	uid := uuid.New()
+
+	tests := []struct {
+		name         string
+		items        []*model.PriceListItem
+		mockExpect   func()
+		expectedResp func(*testing.T, error)
+	}{
+		{
+			name:  "Success",
+			items: []*model.PriceListItem{{ItemID: uid, Price: model.NewMoney(5000, "KZT")}},
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(priceListIDQ)).
+					WithArgs("kz").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "currency"}).AddRow(1, "KZT"))
+				mock.ExpectExec(regexp.QuoteMeta(priceListItemUpsertQ)).
+					WithArgs(1, uid, int64(5000)).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:  "Price list not found",
+			items: []*model.PriceListItem{{ItemID: uid, Price: model.NewMoney(5000, "KZT")}},
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(priceListIDQ)).
+					WithArgs("kz").
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.Equal(t, repo2.ErrNotFound, err)
+			},
+		},
+		{
+			name:  "Currency mismatch",
+			items: []*model.PriceListItem{{ItemID: uid, Price: model.NewMoney(5000, "USD")}},
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(priceListIDQ)).
+					WithArgs("kz").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "currency"}).AddRow(1, "KZT"))
+				mock.ExpectRollback()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.Equal(t, model.ErrCurrencyMismatch, err)
+			},
+		},
+		{
+			name:  "Item not found",
+			items: []*model.PriceListItem{{ItemID: uid, Price: model.NewMoney(5000, "KZT")}},
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(priceListIDQ)).
+					WithArgs("kz").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "currency"}).AddRow(1, "KZT"))
+				mock.ExpectExec(regexp.QuoteMeta(priceListItemUpsertQ)).
+					WithArgs(1, uid, int64(5000)).
+					WillReturnError(&pq.Error{Code: "23503", Detail: "Key (item_id)=(test) is not present in table \"item\"."})
+				mock.ExpectRollback()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.Equal(t, repo2.ErrNotFound, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				err := repo.SetPriceListItems(context.Background(), "kz", tt.items)
+				tt.expectedResp(t, err)
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_GetPriceListPrices(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	priced, unpriced := uuid.New(), uuid.New()
+	ids := pq.Array([]string{priced.String(), unpriced.String()})
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, map[uuid.UUID]int64, error)
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(priceListItemPricesQ)).
+					WithArgs(1, ids).
+					WillReturnRows(sqlmock.NewRows([]string{"item_id", "price"}).AddRow(priced.String(), 5000))
+			},
+			expectedResp: func(t *testing.T, res map[uuid.UUID]int64, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, map[uuid.UUID]int64{priced: 5000}, res)
+			},
+		},
+		{
+			name: "Query error",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(priceListItemPricesQ)).
+					WithArgs(1, ids).
+					WillReturnError(errors.New("query error"))
+			},
+			expectedResp: func(t *testing.T, res map[uuid.UUID]int64, err error) {
+				assert.Nil(t, res)
+				assert.Error(t, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := repo.GetPriceListPrices(context.Background(), 1, []uuid.UUID{priced, unpriced})
+				tt.expectedResp(t, res, err)
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
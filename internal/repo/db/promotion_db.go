@@ -7,6 +7,7 @@ import (
 	"github.com/JMURv/par-pro/products/internal/repo"
 	"github.com/JMURv/par-pro/products/pkg/model"
 	"github.com/opentracing/opentracing-go"
+	"slices"
 )
 
 func (r *Repository) PromotionSearch(ctx context.Context, query string, page, size int) (*model.PaginatedPromosData, error) {
@@ -79,7 +80,8 @@ func (r *Repository) ListPromotionItems(ctx context.Context, slug string, page,
 			&pi.PromotionSlug,
 			&pi.ItemID,
 			&pi.Item.Title,
-			&pi.Item.Price,
+			&pi.Item.Price.Amount,
+			&pi.Item.Price.Currency,
 			&pi.Item.Src,
 			&pi.Item.Alt,
 		); err != nil {
@@ -116,6 +118,7 @@ func (r *Repository) GetPromotion(ctx context.Context, slug string) (*model.Prom
 		&res.Src,
 		&res.Alt,
 		&res.LastsTo,
+		&res.Version,
 	)
 
 	if err != nil && errors.Is(err, sql.ErrNoRows) {
@@ -180,23 +183,23 @@ func (r *Repository) CreatePromotion(ctx context.Context, req *model.Promotion)
 
 	tx, err := r.conn.Begin()
 	if err != nil {
-		return "", err
+		return "", mapError(err)
 	}
 
 	var slug string
 	err = tx.QueryRow(promoCreateQ, req.Slug, req.Title, req.Description, req.Src, req.Alt, req.LastsTo).Scan(&slug)
 	if err != nil {
 		tx.Rollback()
-		return "", err
+		return "", mapError(err)
 	}
 
 	if err = CreatePromotionItems(tx, slug, req.PromotionItems); err != nil {
 		tx.Rollback()
-		return "", err
+		return "", mapError(err)
 	}
 
 	if err = tx.Commit(); err != nil {
-		return "", err
+		return "", mapError(err)
 	}
 	return slug, nil
 }
@@ -208,7 +211,13 @@ func (r *Repository) UpdatePromotion(ctx context.Context, slug string, req *mode
 
 	tx, err := r.conn.Begin()
 	if err != nil {
-		return err
+		return mapError(err)
+	}
+
+	version, err := bumpVersion(tx, promoBumpVersionQ, promoVersionQ, slug, req.Version)
+	if err != nil {
+		tx.Rollback()
+		return mapError(err)
 	}
 
 	res, err := tx.Exec(
@@ -221,7 +230,7 @@ func (r *Repository) UpdatePromotion(ctx context.Context, slug string, req *mode
 	)
 	if err != nil {
 		tx.Rollback()
-		return err
+		return mapError(err)
 	}
 
 	if aff, _ := res.RowsAffected(); aff == 0 {
@@ -231,10 +240,68 @@ func (r *Repository) UpdatePromotion(ctx context.Context, slug string, req *mode
 
 	if err = UpdatePromotionItems(tx, slug, req.PromotionItems); err != nil {
 		tx.Rollback()
-		return err
+		return mapError(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return mapError(err)
+	}
+
+	req.Version = version
+	return nil
+}
+
+// PatchPromotion writes only the fields of req named in fields, promotion items are left untouched unless named.
+func (r *Repository) PatchPromotion(ctx context.Context, slug string, req *model.Promotion, fields []string) error {
+	const op = "promo.PatchPromotion.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return mapError(err)
+	}
+
+	version, err := bumpVersion(tx, promoBumpVersionQ, promoVersionQ, slug, req.Version)
+	if err != nil {
+		tx.Rollback()
+		return mapError(err)
 	}
 
-	return tx.Commit()
+	cols := make([]column, 0, len(fields))
+	for _, v := range fields {
+		switch v {
+		case "title":
+			cols = append(cols, column{"title", req.Title})
+		case "description":
+			cols = append(cols, column{"description", req.Description})
+		case "src":
+			cols = append(cols, column{"src", req.Src})
+		case "alt":
+			cols = append(cols, column{"alt", req.Alt})
+		case "lasts_to":
+			cols = append(cols, column{"lasts_to", req.LastsTo})
+		}
+	}
+
+	if err = patchRow(tx, "promotion", "slug", slug, cols); err != nil {
+		tx.Rollback()
+		return mapError(err)
+	}
+
+	if slices.Contains(fields, "promotion_items") {
+		if err = UpdatePromotionItems(tx, slug, req.PromotionItems); err != nil {
+			tx.Rollback()
+			return mapError(err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return mapError(err)
+	}
+
+	req.Version = version
+	return nil
 }
 
 func (r *Repository) DeletePromotion(ctx context.Context, slug string) error {
@@ -244,7 +311,7 @@ func (r *Repository) DeletePromotion(ctx context.Context, slug string) error {
 
 	res, err := r.conn.ExecContext(ctx, promoDeleteQ, slug)
 	if err != nil {
-		return err
+		return mapError(err)
 	}
 
 	if aff, _ := res.RowsAffected(); aff == 0 {
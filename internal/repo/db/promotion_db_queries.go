@@ -1,27 +1,30 @@
 package db
 
 const (
-	promoCountQ       = `SELECT COUNT(*) FROM promotion;`
-	promoSearchCountQ = `SELECT COUNT(*) FROM promotion WHERE title ILIKE $1 OR slug ILIKE $2;`
+	promoCountQ       = `SELECT COUNT(*) FROM promotion WHERE deleted_at IS NULL;`
+	promoSearchCountQ = `SELECT COUNT(*) FROM promotion WHERE deleted_at IS NULL AND (title ILIKE $1 OR slug ILIKE $2);`
 )
 
 const promoSearchQ = `
 	SELECT slug, title, description, src, alt, lasts_to
 	FROM promotion
-	WHERE title ILIKE $1 OR slug ILIKE $2
+	WHERE deleted_at IS NULL AND (title ILIKE $1 OR slug ILIKE $2)
 	OFFSET $3 LIMIT $4;
 `
 
 const promoCountItemsQ = `
-	SELECT COUNT(*) FROM promotion_items WHERE promotion_slug=$1;
+	SELECT COUNT(*) 
+	FROM promotion_items pi
+	JOIN item i ON i.id = pi.item_id AND i.deleted_at IS NULL
+	WHERE pi.promotion_slug=$1;
 `
 
 const promoItemsQ = `
 	SELECT 
 	    pi.discount, pi.promotion_slug, pi.item_id, 
-	    i.title, i.price, i.src, i.alt
+	    i.title, i.price, i.currency, i.src, i.alt
 	FROM promotion_items pi
-	JOIN item i ON i.id = pi.item_id
+	JOIN item i ON i.id = pi.item_id AND i.deleted_at IS NULL
 	WHERE promotion_slug=$1
 	GROUP BY pi.promotion_slug
 	OFFSET $2 LIMIT $3;
@@ -30,14 +33,15 @@ const promoItemsQ = `
 const promoListQ = `
 	SELECT slug, title, description, src, alt, lasts_to 
 	FROM promotion 
+	WHERE deleted_at IS NULL
 	OFFSET $1 LIMIT $2;
 `
 
 const promoGetQ = `
 	SELECT 
-	    slug, title, description, src, alt, lasts_to 
+	    slug, title, description, src, alt, lasts_to, version 
 	FROM promotion
-	WHERE slug=$1;
+	WHERE slug=$1 AND deleted_at IS NULL;
 `
 
 const promoCreateQ = `
@@ -72,8 +76,11 @@ const promoUpdateQ = `
 	WHERE slug = $6;
 `
 
+const promoBumpVersionQ = `UPDATE promotion SET version = version + 1 WHERE slug = $1 AND deleted_at IS NULL AND ($2 = 0 OR version = $2) RETURNING version`
+const promoVersionQ = `SELECT version FROM promotion WHERE slug = $1 AND deleted_at IS NULL`
+
 const promoDeleteQ = `
-	DELETE FROM promotion WHERE slug = $1
+	UPDATE promotion SET deleted_at = NOW() WHERE slug = $1 AND deleted_at IS NULL
 `
 
 const promoItemDelete = `
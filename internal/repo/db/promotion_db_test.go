@@ -155,11 +155,11 @@ func TestRepository_ListPromotionItems(t *testing.T) {
 
 				rows := sqlmock.NewRows(
 					[]string{
-						"discount", "promotion_slug", "item_id", "title", "price", "src", "alt",
+						"discount", "promotion_slug", "item_id", "title", "price", "currency", "src", "alt",
 					},
 				).
-					AddRow(10, "test-promo", uuid.New().String(), "Item 1", 10.5, "src1", "alt1").
-					AddRow(20, "test-promo", uuid.New().String(), "Item 2", 15.5, "src2", "alt2")
+					AddRow(10, "test-promo", uuid.New().String(), "Item 1", int64(1050), "RUB", "src1", "alt1").
+					AddRow(20, "test-promo", uuid.New().String(), "Item 2", int64(1550), "RUB", "src2", "alt2")
 
 				mock.ExpectQuery(regexp.QuoteMeta(promoItemsQ)).
 					WithArgs(slug, (page-1)*size, size).
@@ -214,10 +214,10 @@ func TestRepository_ListPromotionItems(t *testing.T) {
 
 				rows := sqlmock.NewRows(
 					[]string{
-						"discount", "promotion_slug", "item_id", "title", "price", "src", "alt",
+						"discount", "promotion_slug", "item_id", "title", "price", "currency", "src", "alt",
 					},
 				).
-					AddRow(nil, "test-promo", uuid.New().String(), "Item 1", 10.5, "src1", "alt1")
+					AddRow(nil, "test-promo", uuid.New().String(), "Item 1", int64(1050), "RUB", "src1", "alt1")
 
 				mock.ExpectQuery(regexp.QuoteMeta(promoItemsQ)).
 					WithArgs(slug, (page-1)*size, size).
@@ -265,7 +265,7 @@ func TestRepository_GetPromotion(t *testing.T) {
 					WillReturnRows(
 						sqlmock.NewRows(
 							[]string{
-								"slug", "title", "description", "src", "alt", "lasts_to",
+								"slug", "title", "description", "src", "alt", "lasts_to", "version",
 							},
 						).AddRow(
 							"test-promo",
@@ -274,6 +274,7 @@ func TestRepository_GetPromotion(t *testing.T) {
 							"src1",
 							"alt1",
 							time.Now(),
+							3,
 						),
 					)
 			},
@@ -282,6 +283,7 @@ func TestRepository_GetPromotion(t *testing.T) {
 				require.NotNil(t, res)
 				assert.Equal(t, "Test Promotion", res.Title)
 				assert.Equal(t, "Description of test promotion", res.Description)
+				assert.Equal(t, 3, res.Version)
 			},
 		},
 		{
@@ -613,6 +615,9 @@ func TestRepository_UpdatePromotion(t *testing.T) {
 			name: "Success",
 			mockExpect: func() {
 				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(promoBumpVersionQ)).
+					WithArgs(promotion.Slug, promotion.Version).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(2))
 
 				mock.ExpectExec(regexp.QuoteMeta(promoUpdateQ)).
 					WithArgs(
@@ -653,6 +658,22 @@ func TestRepository_UpdatePromotion(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+		{
+			name: "VersionConflict",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(promoBumpVersionQ)).
+					WithArgs(promotion.Slug, promotion.Version).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}))
+				mock.ExpectQuery(regexp.QuoteMeta(promoVersionQ)).
+					WithArgs(promotion.Slug).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(5))
+				mock.ExpectRollback()
+			},
+			expectedResp: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, repo2.ErrVersionConflict)
+			},
+		},
 		{
 			name: "BeginError",
 			mockExpect: func() {
@@ -667,6 +688,9 @@ func TestRepository_UpdatePromotion(t *testing.T) {
 			name: "UpdateError",
 			mockExpect: func() {
 				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(promoBumpVersionQ)).
+					WithArgs(promotion.Slug, promotion.Version).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(2))
 
 				mock.ExpectExec(regexp.QuoteMeta(promoUpdateQ)).
 					WithArgs(
@@ -690,6 +714,9 @@ func TestRepository_UpdatePromotion(t *testing.T) {
 			name: "CommitError",
 			mockExpect: func() {
 				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(promoBumpVersionQ)).
+					WithArgs(promotion.Slug, promotion.Version).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(2))
 
 				mock.ExpectExec(regexp.QuoteMeta(promoUpdateQ)).
 					WithArgs(
@@ -0,0 +1,347 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/opentracing/opentracing-go"
+)
+
+// GetQuestion returns the question with its approved answers.
+func (r *Repository) GetQuestion(ctx context.Context, id uint64) (*model.Question, error) {
+	const op = "questions.GetQuestion.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	rows, err := r.conn.Query(questionGetQ, id)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := scanQuestions(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res) == 0 {
+		return nil, repo.ErrNotFound
+	}
+
+	if err = r.attachAnswers(res); err != nil {
+		return nil, err
+	}
+
+	return res[0], nil
+}
+
+func (r *Repository) ListItemQuestions(ctx context.Context, uid uuid.UUID, status string, page, size int) (*model.PaginatedQuestionsData, error) {
+	const op = "questions.ListItemQuestions.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var count int64
+	if err := r.conn.QueryRow(questionItemCountQ, uid, status).Scan(&count); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.conn.Query(questionItemListQ, uid, status, (page-1)*size, size)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.paginateQuestions(rows, count, page, size)
+}
+
+func (r *Repository) ListQuestionsByStatus(ctx context.Context, status string, page, size int) (*model.PaginatedQuestionsData, error) {
+	const op = "questions.ListQuestionsByStatus.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var count int64
+	if err := r.conn.QueryRow(questionStatusCountQ, status).Scan(&count); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.conn.Query(questionStatusListQ, status, (page-1)*size, size)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.paginateQuestions(rows, count, page, size)
+}
+
+func (r *Repository) CreateQuestion(ctx context.Context, req *model.Question) (uint64, error) {
+	const op = "questions.CreateQuestion.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var id uint64
+	err := r.conn.QueryRow(questionCreateQ, req.ItemID, req.UserID, req.Text).Scan(&id)
+	if err != nil && errors.Is(mapError(err), repo.ErrMissingReference) {
+		return 0, repo.ErrNotFound
+	} else if err != nil {
+		return 0, mapError(err)
+	}
+
+	return id, nil
+}
+
+// ModerateQuestion returns the id of the item the question was asked on.
+func (r *Repository) ModerateQuestion(ctx context.Context, id uint64, req *model.Moderation) (uuid.UUID, error) {
+	const op = "questions.ModerateQuestion.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var uid uuid.UUID
+	err := r.conn.QueryRow(questionModerateQ, req.Status, req.Note, id).Scan(&uid)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, repo.ErrNotFound
+	} else if err != nil {
+		return uuid.Nil, mapError(err)
+	}
+
+	return uid, nil
+}
+
+// DeleteQuestion removes a question of the user together with its answers and returns the id of the item.
+func (r *Repository) DeleteQuestion(ctx context.Context, id uint64, userID uuid.UUID) (uuid.UUID, error) {
+	const op = "questions.DeleteQuestion.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var uid uuid.UUID
+	err := r.conn.QueryRow(questionDeleteQ, id, userID).Scan(&uid)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, repo.ErrNotFound
+	} else if err != nil {
+		return uuid.Nil, mapError(err)
+	}
+
+	return uid, nil
+}
+
+func (r *Repository) ListAnswersByStatus(ctx context.Context, status string, page, size int) (*model.PaginatedAnswersData, error) {
+	const op = "questions.ListAnswersByStatus.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var count int64
+	if err := r.conn.QueryRow(answerStatusCountQ, status).Scan(&count); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.conn.Query(answerStatusListQ, status, (page-1)*size, size)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := scanAnswers(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := int((count + int64(size) - 1) / int64(size))
+	return &model.PaginatedAnswersData{
+		Data:        res,
+		Count:       count,
+		TotalPages:  totalPages,
+		CurrentPage: page,
+		HasNextPage: page < totalPages,
+	}, nil
+}
+
+// CreateAnswer returns repo.ErrNotFound when the question does not exist or is not published.
+func (r *Repository) CreateAnswer(ctx context.Context, req *model.Answer) (uint64, error) {
+	const op = "questions.CreateAnswer.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var id uint64
+	err := r.conn.QueryRow(answerCreateQ, req.QuestionID, req.UserID, req.Text, model.QuestionStatusApproved).Scan(&id)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return 0, repo.ErrNotFound
+	} else if err != nil {
+		return 0, mapError(err)
+	}
+
+	return id, nil
+}
+
+func (r *Repository) ModerateAnswer(ctx context.Context, id uint64, req *model.Moderation) (*model.Answer, error) {
+	const op = "questions.ModerateAnswer.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	rows, err := r.conn.Query(answerModerateQ, req.Status, req.Note, id)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	res, err := scanAnswers(rows)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	if len(res) == 0 {
+		return nil, repo.ErrNotFound
+	}
+
+	return res[0], nil
+}
+
+// SetAnswerOfficial flags or unflags the official answer and returns the id of the item.
+func (r *Repository) SetAnswerOfficial(ctx context.Context, id uint64, official bool) (uuid.UUID, error) {
+	const op = "questions.SetAnswerOfficial.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var uid uuid.UUID
+	err := r.conn.QueryRow(answerSetOfficialQ, official, id).Scan(&uid)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, repo.ErrNotFound
+	} else if err != nil {
+		return uuid.Nil, mapError(err)
+	}
+
+	return uid, nil
+}
+
+// DeleteAnswer removes an answer of the user and returns the id of the item.
+func (r *Repository) DeleteAnswer(ctx context.Context, id uint64, userID uuid.UUID) (uuid.UUID, error) {
+	const op = "questions.DeleteAnswer.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var uid uuid.UUID
+	err := r.conn.QueryRow(answerDeleteQ, id, userID).Scan(&uid)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, repo.ErrNotFound
+	} else if err != nil {
+		return uuid.Nil, mapError(err)
+	}
+
+	return uid, nil
+}
+
+func (r *Repository) paginateQuestions(rows *sql.Rows, count int64, page, size int) (*model.PaginatedQuestionsData, error) {
+	res, err := scanQuestions(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.attachAnswers(res); err != nil {
+		return nil, err
+	}
+
+	totalPages := int((count + int64(size) - 1) / int64(size))
+	return &model.PaginatedQuestionsData{
+		Data:        res,
+		Count:       count,
+		TotalPages:  totalPages,
+		CurrentPage: page,
+		HasNextPage: page < totalPages,
+	}, nil
+}
+
+func scanQuestions(rows *sql.Rows) ([]*model.Question, error) {
+	defer rows.Close()
+
+	res := make([]*model.Question, 0, 10)
+	for rows.Next() {
+		v := &model.Question{}
+		var moderatedAt sql.NullTime
+		if err := rows.Scan(
+			&v.ID,
+			&v.ItemID,
+			&v.UserID,
+			&v.Text,
+			&v.Status,
+			&v.ModerationNote,
+			&moderatedAt,
+			&v.CreatedAt,
+			&v.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		if moderatedAt.Valid {
+			v.ModeratedAt = &moderatedAt.Time
+		}
+		res = append(res, v)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func scanAnswers(rows *sql.Rows) ([]*model.Answer, error) {
+	defer rows.Close()
+
+	res := make([]*model.Answer, 0, 10)
+	for rows.Next() {
+		v := &model.Answer{}
+		var moderatedAt sql.NullTime
+		if err := rows.Scan(
+			&v.ID,
+			&v.QuestionID,
+			&v.UserID,
+			&v.Text,
+			&v.Official,
+			&v.Status,
+			&v.ModerationNote,
+			&moderatedAt,
+			&v.CreatedAt,
+			&v.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		if moderatedAt.Valid {
+			v.ModeratedAt = &moderatedAt.Time
+		}
+		res = append(res, v)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// attachAnswers loads the approved answers of the questions in one query.
+func (r *Repository) attachAnswers(questions []*model.Question) error {
+	if len(questions) == 0 {
+		return nil
+	}
+
+	byQuestion := make(map[uint64]*model.Question, len(questions))
+	ids := make([]int64, 0, len(questions))
+	for _, v := range questions {
+		byQuestion[v.ID] = v
+		ids = append(ids, int64(v.ID))
+	}
+
+	rows, err := r.conn.Query(answerQuestionListQ, pq.Array(ids), model.QuestionStatusApproved)
+	if err != nil {
+		return err
+	}
+
+	answers, err := scanAnswers(rows)
+	if err != nil {
+		return err
+	}
+
+	for _, a := range answers {
+		if q, ok := byQuestion[a.QuestionID]; ok {
+			q.Answers = append(q.Answers, *a)
+		}
+	}
+
+	return nil
+}
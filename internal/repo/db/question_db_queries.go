@@ -0,0 +1,83 @@
+package db
+
+const questionSelect = `
+	SELECT id, item_id, user_id, text, status, moderation_note, moderated_at, created_at, updated_at
+	FROM question
+`
+
+const questionGetQ = questionSelect + `WHERE id = $1`
+
+const questionItemCountQ = `SELECT COUNT(*) FROM question WHERE item_id = $1 AND status = $2`
+
+const questionItemListQ = questionSelect + `
+	WHERE item_id = $1 AND status = $2
+	ORDER BY created_at DESC, id DESC
+	OFFSET $3 LIMIT $4
+`
+
+const questionStatusCountQ = `SELECT COUNT(*) FROM question WHERE status = $1`
+
+const questionStatusListQ = questionSelect + `
+	WHERE status = $1
+	ORDER BY created_at, id
+	OFFSET $2 LIMIT $3
+`
+
+const questionCreateQ = `INSERT INTO question (item_id, user_id, text) VALUES ($1, $2, $3) RETURNING id`
+
+const questionModerateQ = `
+	UPDATE question
+	SET status = $1, moderation_note = $2, moderated_at = NOW(), updated_at = NOW()
+	WHERE id = $3
+	RETURNING item_id
+`
+
+const questionDeleteQ = `DELETE FROM question WHERE id = $1 AND user_id = $2 RETURNING item_id`
+
+const answerSelect = `
+	SELECT id, question_id, user_id, text, official, status, moderation_note, moderated_at, created_at, updated_at
+	FROM answer
+`
+
+// Official answers come first, the rest in the order they were given.
+const answerQuestionListQ = answerSelect + `
+	WHERE question_id = ANY($1) AND status = $2
+	ORDER BY official DESC, created_at, id
+`
+
+const answerStatusCountQ = `SELECT COUNT(*) FROM answer WHERE status = $1`
+
+const answerStatusListQ = answerSelect + `
+	WHERE status = $1
+	ORDER BY created_at, id
+	OFFSET $2 LIMIT $3
+`
+
+// Answers are only accepted for published questions.
+const answerCreateQ = `
+	INSERT INTO answer (question_id, user_id, text)
+	SELECT id, $2, $3 FROM question WHERE id = $1 AND status = $4
+	RETURNING id
+`
+
+const answerModerateQ = `
+	UPDATE answer
+	SET status = $1, moderation_note = $2, moderated_at = NOW(), updated_at = NOW()
+	WHERE id = $3
+	RETURNING id, question_id, user_id, text, official, status, moderation_note, moderated_at, created_at, updated_at
+`
+
+const answerSetOfficialQ = `
+	UPDATE answer a
+	SET official = $1, updated_at = NOW()
+	FROM question q
+	WHERE a.id = $2 AND q.id = a.question_id
+	RETURNING q.item_id
+`
+
+const answerDeleteQ = `
+	DELETE FROM answer a
+	USING question q
+	WHERE a.id = $1 AND a.user_id = $2 AND q.id = a.question_id
+	RETURNING q.item_id
+`
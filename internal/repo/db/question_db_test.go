@@ -0,0 +1,158 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	repo2 "github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestRepository_ListItemQuestions(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	uid, userID, staffID := uuid.New(), uuid.New(), uuid.New()
+	now := time.Now()
+
+	answerCols := []string{
+		"id", "question_id", "user_id", "text", "official", "status", "moderation_note", "moderated_at", "created_at",
+		"updated_at",
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(questionItemCountQ)).
+		WithArgs(uid, model.QuestionStatusApproved).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectQuery(regexp.QuoteMeta(questionItemListQ)).
+		WithArgs(uid, model.QuestionStatusApproved, 0, 2).
+		WillReturnRows(
+			sqlmock.NewRows(
+				[]string{"id", "item_id", "user_id", "text", "status", "moderation_note", "moderated_at", "created_at", "updated_at"},
+			).
+				AddRow(2, uid.String(), userID.String(), "Is it waterproof?", model.QuestionStatusApproved, "", now, now, now).
+				AddRow(1, uid.String(), userID.String(), "What size?", model.QuestionStatusApproved, "", now, now, now),
+		)
+	mock.ExpectQuery(regexp.QuoteMeta(answerQuestionListQ)).
+		WithArgs(pq.Array([]int64{2, 1}), model.QuestionStatusApproved).
+		WillReturnRows(
+			sqlmock.NewRows(answerCols).
+				AddRow(5, 2, staffID.String(), "Yes, IP67", true, model.QuestionStatusApproved, "", now, now, now).
+				AddRow(4, 2, userID.String(), "Mine survived rain", false, model.QuestionStatusApproved, "", now, now, now),
+		)
+
+	res, err := repo.ListItemQuestions(context.Background(), uid, model.QuestionStatusApproved, 1, 2)
+	require.NoError(t, err)
+	require.Len(t, res.Data, 2)
+	assert.Equal(t, int64(3), res.Count)
+	assert.True(t, res.HasNextPage)
+	require.Len(t, res.Data[0].Answers, 2)
+	assert.True(t, res.Data[0].Answers[0].Official)
+	assert.Empty(t, res.Data[1].Answers)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CreateAnswer(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	req := &model.Answer{QuestionID: 1, UserID: uuid.New(), Text: "Yes"}
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, uint64, error)
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(answerCreateQ)).
+					WithArgs(uint64(1), req.UserID, "Yes", model.QuestionStatusApproved).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+			},
+			expectedResp: func(t *testing.T, res uint64, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, uint64(3), res)
+			},
+		},
+		{
+			name: "Question not published",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(answerCreateQ)).
+					WithArgs(uint64(1), req.UserID, "Yes", model.QuestionStatusApproved).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedResp: func(t *testing.T, res uint64, err error) {
+				assert.Equal(t, repo2.ErrNotFound, err)
+			},
+		},
+		{
+			name: "Error",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(answerCreateQ)).
+					WillReturnError(errors.New("connection refused"))
+			},
+			expectedResp: func(t *testing.T, res uint64, err error) {
+				assert.Error(t, err)
+				assert.Zero(t, res)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := repo.CreateAnswer(context.Background(), req)
+				tt.expectedResp(t, res, err)
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_ModerateAnswer(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	userID := uuid.New()
+	now := time.Now()
+	req := &model.Moderation{Status: model.QuestionStatusApproved}
+
+	mock.ExpectQuery(regexp.QuoteMeta(answerModerateQ)).
+		WithArgs(model.QuestionStatusApproved, "", uint64(4)).
+		WillReturnRows(
+			sqlmock.NewRows(
+				[]string{
+					"id", "question_id", "user_id", "text", "official", "status", "moderation_note", "moderated_at",
+					"created_at", "updated_at",
+				},
+			).AddRow(4, 2, userID.String(), "Yes", false, model.QuestionStatusApproved, "", now, now, now),
+		)
+
+	res, err := repo.ModerateAnswer(context.Background(), 4, req)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), res.QuestionID)
+	assert.Equal(t, userID, res.UserID)
+
+	mock.ExpectQuery(regexp.QuoteMeta(answerModerateQ)).
+		WithArgs(model.QuestionStatusApproved, "", uint64(5)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, err = repo.ModerateAnswer(context.Background(), 5, req)
+	assert.Equal(t, repo2.ErrNotFound, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
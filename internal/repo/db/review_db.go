@@ -0,0 +1,349 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/opentracing/opentracing-go"
+)
+
+func (r *Repository) GetReview(ctx context.Context, id uint64) (*model.Review, error) {
+	const op = "reviews.GetReview.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	rows, err := r.conn.Query(reviewGetQ, id)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := scanReviews(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res) == 0 {
+		return nil, repo.ErrNotFound
+	}
+
+	if err = r.attachReviewMedia(res); err != nil {
+		return nil, err
+	}
+
+	return res[0], nil
+}
+
+func (r *Repository) ListItemReviews(ctx context.Context, uid uuid.UUID, status string, page, size int) (*model.PaginatedReviewsData, error) {
+	const op = "reviews.ListItemReviews.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var count int64
+	if err := r.conn.QueryRow(reviewItemCountQ, uid, status).Scan(&count); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.conn.Query(reviewItemListQ, uid, status, (page-1)*size, size)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.paginateReviews(rows, count, page, size)
+}
+
+func (r *Repository) ListReviewsByStatus(ctx context.Context, status string, page, size int) (*model.PaginatedReviewsData, error) {
+	const op = "reviews.ListReviewsByStatus.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var count int64
+	if err := r.conn.QueryRow(reviewStatusCountQ, status).Scan(&count); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.conn.Query(reviewStatusListQ, status, (page-1)*size, size)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.paginateReviews(rows, count, page, size)
+}
+
+// CreateReview returns repo.ErrNotPurchased when the user has no delivered order with the item.
+func (r *Repository) CreateReview(ctx context.Context, req *model.Review) (uint64, error) {
+	const op = "reviews.CreateReview.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var id uint64
+	err := r.conn.QueryRow(
+		reviewCreateQ,
+		req.ItemID,
+		req.UserID,
+		req.Rating,
+		req.Text,
+		req.Pros,
+		req.Cons,
+		model.OrderStatusDelivered,
+	).Scan(&id)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return 0, repo.ErrNotPurchased
+	} else if err != nil {
+		return 0, mapError(err)
+	}
+
+	return id, nil
+}
+
+// UpdateReview changes a review of the user, req.ID and req.UserID select it. The id of the reviewed item is returned.
+func (r *Repository) UpdateReview(ctx context.Context, req *model.Review) (uuid.UUID, error) {
+	const op = "reviews.UpdateReview.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var uid uuid.UUID
+	err := r.conn.QueryRow(reviewUpdateQ, req.Rating, req.Text, req.Pros, req.Cons, req.ID, req.UserID).Scan(&uid)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, repo.ErrNotFound
+	} else if err != nil {
+		return uuid.Nil, mapError(err)
+	}
+
+	return uid, nil
+}
+
+// ModerateReview returns the id of the reviewed item, its rating changes with the status.
+func (r *Repository) ModerateReview(ctx context.Context, id uint64, req *model.Moderation) (uuid.UUID, error) {
+	const op = "reviews.ModerateReview.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var uid uuid.UUID
+	err := r.conn.QueryRow(reviewModerateQ, req.Status, req.Note, id).Scan(&uid)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, repo.ErrNotFound
+	} else if err != nil {
+		return uuid.Nil, mapError(err)
+	}
+
+	return uid, nil
+}
+
+// DeleteReview removes a review of the user and returns the id of the reviewed item.
+func (r *Repository) DeleteReview(ctx context.Context, id uint64, userID uuid.UUID) (uuid.UUID, error) {
+	const op = "reviews.DeleteReview.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var uid uuid.UUID
+	err := r.conn.QueryRow(reviewDeleteQ, id, userID).Scan(&uid)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, repo.ErrNotFound
+	} else if err != nil {
+		return uuid.Nil, mapError(err)
+	}
+
+	return uid, nil
+}
+
+// CreateReviewMedia records an uploaded file, the review goes back to moderation.
+func (r *Repository) CreateReviewMedia(ctx context.Context, reviewID uint64, req *model.ReviewMedia) (*model.ReviewMedia, error) {
+	const op = "reviews.CreateReviewMedia.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	res := *req
+	res.ReviewID = reviewID
+	if err = tx.QueryRow(
+		reviewMediaCreateQ,
+		reviewID,
+		req.Src,
+		req.StorageKey,
+		req.ContentType,
+		req.Size,
+		req.Width,
+		req.Height,
+	).Scan(&res.ID, &res.Position, &res.CreatedAt); err != nil {
+		tx.Rollback()
+		if errors.Is(mapError(err), repo.ErrMissingReference) {
+			return nil, repo.ErrNotFound
+		}
+		return nil, mapError(err)
+	}
+
+	for _, v := range req.Variants {
+		if _, err = tx.Exec(
+			reviewMediaVariantCreateQ,
+			res.ID,
+			v.Name,
+			v.Src,
+			v.StorageKey,
+			v.ContentType,
+			v.Width,
+			v.Height,
+		); err != nil {
+			tx.Rollback()
+			return nil, mapError(err)
+		}
+	}
+
+	if _, err = tx.Exec(reviewResetModerationQ, reviewID); err != nil {
+		tx.Rollback()
+		return nil, mapError(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, mapError(err)
+	}
+
+	return &res, nil
+}
+
+func (r *Repository) paginateReviews(rows *sql.Rows, count int64, page, size int) (*model.PaginatedReviewsData, error) {
+	res, err := scanReviews(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.attachReviewMedia(res); err != nil {
+		return nil, err
+	}
+
+	totalPages := int((count + int64(size) - 1) / int64(size))
+	return &model.PaginatedReviewsData{
+		Data:        res,
+		Count:       count,
+		TotalPages:  totalPages,
+		CurrentPage: page,
+		HasNextPage: page < totalPages,
+	}, nil
+}
+
+func scanReviews(rows *sql.Rows) ([]*model.Review, error) {
+	defer rows.Close()
+
+	res := make([]*model.Review, 0, 10)
+	for rows.Next() {
+		v := &model.Review{}
+		var moderatedAt sql.NullTime
+		if err := rows.Scan(
+			&v.ID,
+			&v.ItemID,
+			&v.UserID,
+			&v.OrderID,
+			&v.Rating,
+			&v.Text,
+			&v.Pros,
+			&v.Cons,
+			&v.Status,
+			&v.ModerationNote,
+			&moderatedAt,
+			&v.CreatedAt,
+			&v.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		if moderatedAt.Valid {
+			v.ModeratedAt = &moderatedAt.Time
+		}
+		res = append(res, v)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *Repository) attachReviewMedia(reviews []*model.Review) error {
+	if len(reviews) == 0 {
+		return nil
+	}
+
+	byReview := make(map[uint64]*model.Review, len(reviews))
+	ids := make([]int64, 0, len(reviews))
+	for _, v := range reviews {
+		byReview[v.ID] = v
+		ids = append(ids, int64(v.ID))
+	}
+
+	rows, err := r.conn.Query(reviewMediaListQ, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	media := make([]*model.ReviewMedia, 0, 10)
+	mediaIDs := make([]int64, 0, 10)
+	for rows.Next() {
+		m := &model.ReviewMedia{}
+		if err = rows.Scan(
+			&m.ID,
+			&m.ReviewID,
+			&m.Src,
+			&m.StorageKey,
+			&m.ContentType,
+			&m.Size,
+			&m.Width,
+			&m.Height,
+			&m.Position,
+			&m.CreatedAt,
+		); err != nil {
+			return err
+		}
+		media = append(media, m)
+		mediaIDs = append(mediaIDs, int64(m.ID))
+	}
+
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	if len(media) == 0 {
+		return nil
+	}
+
+	byMedia := make(map[uint64]*model.ReviewMedia, len(media))
+	for _, m := range media {
+		byMedia[m.ID] = m
+	}
+
+	vRows, err := r.conn.Query(reviewMediaVariantListQ, pq.Array(mediaIDs))
+	if err != nil {
+		return err
+	}
+	defer vRows.Close()
+
+	for vRows.Next() {
+		var mediaID uint64
+		v := model.MediaVariant{}
+		if err = vRows.Scan(&mediaID, &v.Name, &v.Src, &v.StorageKey, &v.ContentType, &v.Width, &v.Height); err != nil {
+			return err
+		}
+		if m, ok := byMedia[mediaID]; ok {
+			m.Variants = append(m.Variants, v)
+		}
+	}
+
+	if err = vRows.Err(); err != nil {
+		return err
+	}
+
+	for _, m := range media {
+		if rv, ok := byReview[m.ReviewID]; ok {
+			rv.Media = append(rv.Media, *m)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package db
+
+const reviewSelect = `
+	SELECT id, item_id, user_id, COALESCE(order_id, 0), rating, text, pros, cons,
+	       status, moderation_note, moderated_at, created_at, updated_at
+	FROM review
+`
+
+const reviewGetQ = reviewSelect + `WHERE id = $1`
+
+const reviewItemCountQ = `SELECT COUNT(*) FROM review WHERE item_id = $1 AND status = $2`
+
+const reviewItemListQ = reviewSelect + `
+	WHERE item_id = $1 AND status = $2
+	ORDER BY created_at DESC, id DESC
+	OFFSET $3 LIMIT $4
+`
+
+const reviewStatusCountQ = `SELECT COUNT(*) FROM review WHERE status = $1`
+
+// The moderation queue is worked oldest first.
+const reviewStatusListQ = reviewSelect + `
+	WHERE status = $1
+	ORDER BY created_at, id
+	OFFSET $2 LIMIT $3
+`
+
+// A review is only created when the user has a delivered order with the item, the latest one is referenced.
+const reviewCreateQ = `
+	INSERT INTO review (item_id, user_id, order_id, rating, text, pros, cons)
+	SELECT $1, $2, o.id, $3, $4, $5, $6
+	FROM "order" o
+	JOIN order_item oi ON oi.order_id = o.id
+	WHERE oi.item_id = $1 AND o.user_id = $2 AND o.status = $7 AND o.deleted_at IS NULL
+	ORDER BY o.created_at DESC
+	LIMIT 1
+	RETURNING id
+`
+
+// Edited reviews go through moderation again.
+const reviewUpdateQ = `
+	UPDATE review
+	SET rating = $1, text = $2, pros = $3, cons = $4,
+	    status = 'pending', moderation_note = '', moderated_at = NULL, updated_at = NOW()
+	WHERE id = $5 AND user_id = $6
+	RETURNING item_id
+`
+
+const reviewModerateQ = `
+	UPDATE review
+	SET status = $1, moderation_note = $2, moderated_at = NOW(), updated_at = NOW()
+	WHERE id = $3
+	RETURNING item_id
+`
+
+const reviewResetModerationQ = `
+	UPDATE review
+	SET status = 'pending', moderation_note = '', moderated_at = NULL, updated_at = NOW()
+	WHERE id = $1
+`
+
+const reviewDeleteQ = `DELETE FROM review WHERE id = $1 AND user_id = $2 RETURNING item_id`
+
+const reviewMediaListQ = `
+	SELECT id, review_id, src, storage_key, content_type, size, width, height, position, created_at
+	FROM review_media
+	WHERE review_id = ANY($1)
+	ORDER BY position, id
+`
+
+const reviewMediaVariantListQ = `
+	SELECT media_id, name, src, storage_key, content_type, width, height
+	FROM review_media_variant
+	WHERE media_id = ANY($1)
+	ORDER BY width
+`
+
+const reviewMediaCreateQ = `
+	INSERT INTO review_media (review_id, src, storage_key, content_type, size, width, height, position)
+	VALUES (
+		$1, $2, $3, $4, $5, $6, $7,
+		(SELECT COALESCE(MAX(position) + 1, 0) FROM review_media WHERE review_id = $1)
+	)
+	RETURNING id, position, created_at
+`
+
+const reviewMediaVariantCreateQ = `
+	INSERT INTO review_media_variant (media_id, name, src, storage_key, content_type, width, height)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
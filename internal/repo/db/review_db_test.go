@@ -0,0 +1,162 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	repo2 "github.com/JMURv/par-pro/products/internal/repo"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestRepository_CreateReview(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	req := &model.Review{ItemID: uuid.New(), UserID: uuid.New(), Rating: 4, Text: "Good", Pros: "Cheap"}
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedResp func(*testing.T, uint64, error)
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(reviewCreateQ)).
+					WithArgs(req.ItemID, req.UserID, 4, "Good", "Cheap", "", model.OrderStatusDelivered).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+			},
+			expectedResp: func(t *testing.T, res uint64, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, uint64(1), res)
+			},
+		},
+		{
+			name: "No delivered order",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(reviewCreateQ)).
+					WithArgs(req.ItemID, req.UserID, 4, "Good", "Cheap", "", model.OrderStatusDelivered).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedResp: func(t *testing.T, res uint64, err error) {
+				assert.Equal(t, repo2.ErrNotPurchased, err)
+			},
+		},
+		{
+			name: "Already reviewed",
+			mockExpect: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(reviewCreateQ)).
+					WillReturnError(&pq.Error{Code: "23505", Detail: "Key (item_id, user_id)=(test) already exists."})
+			},
+			expectedResp: func(t *testing.T, res uint64, err error) {
+				assert.ErrorIs(t, err, repo2.ErrAlreadyExists)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.mockExpect()
+				res, err := repo.CreateReview(context.Background(), req)
+				tt.expectedResp(t, res, err)
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_ListItemReviews(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	uid, userID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(reviewItemCountQ)).
+		WithArgs(uid, model.ReviewStatusApproved).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectQuery(regexp.QuoteMeta(reviewItemListQ)).
+		WithArgs(uid, model.ReviewStatusApproved, 2, 2).
+		WillReturnRows(
+			sqlmock.NewRows(
+				[]string{
+					"id", "item_id", "user_id", "order_id", "rating", "text", "pros", "cons",
+					"status", "moderation_note", "moderated_at", "created_at", "updated_at",
+				},
+			).AddRow(3, uid.String(), userID.String(), 10, 5, "Great", "", "", model.ReviewStatusApproved, "", now, now, now),
+		)
+	mock.ExpectQuery(regexp.QuoteMeta(reviewMediaListQ)).
+		WithArgs(pq.Array([]int64{3})).
+		WillReturnRows(
+			sqlmock.NewRows(
+				[]string{"id", "review_id", "src", "storage_key", "content_type", "size", "width", "height", "position", "created_at"},
+			).AddRow(8, 3, "/media/r.png", "reviews/3/a.png", "image/png", 100, 4, 3, 0, now),
+		)
+	mock.ExpectQuery(regexp.QuoteMeta(reviewMediaVariantListQ)).
+		WithArgs(pq.Array([]int64{8})).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"media_id", "name", "src", "storage_key", "content_type", "width", "height"}).
+				AddRow(8, "thumb", "/media/r_thumb.webp", "reviews/3/a_thumb.webp", "image/webp", 4, 3),
+		)
+
+	res, err := repo.ListItemReviews(context.Background(), uid, model.ReviewStatusApproved, 2, 2)
+	require.NoError(t, err)
+	require.Len(t, res.Data, 1)
+	assert.Equal(t, int64(3), res.Count)
+	assert.False(t, res.HasNextPage)
+	assert.Equal(t, uint64(10), res.Data[0].OrderID)
+	require.NotNil(t, res.Data[0].ModeratedAt)
+	require.Len(t, res.Data[0].Media, 1)
+	assert.Len(t, res.Data[0].Media[0].Variants, 1)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CreateReviewMedia(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	now := time.Now()
+	m := &model.ReviewMedia{
+		Src:         "/media/r.png",
+		StorageKey:  "reviews/1/a.png",
+		ContentType: "image/png",
+		Size:        100,
+		Width:       4,
+		Height:      3,
+		Variants: []model.MediaVariant{
+			{Name: "thumb", Src: "/media/r_thumb.webp", StorageKey: "reviews/1/a_thumb.webp", ContentType: "image/webp", Width: 4, Height: 3},
+		},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(reviewMediaCreateQ)).
+		WithArgs(uint64(1), m.Src, m.StorageKey, m.ContentType, m.Size, m.Width, m.Height).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "position", "created_at"}).AddRow(5, 0, now))
+	mock.ExpectExec(regexp.QuoteMeta(reviewMediaVariantCreateQ)).
+		WithArgs(5, "thumb", "/media/r_thumb.webp", "reviews/1/a_thumb.webp", "image/webp", 4, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(reviewResetModerationQ)).
+		WithArgs(uint64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	res, err := repo.CreateReviewMedia(context.Background(), 1, m)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), res.ID)
+	assert.Equal(t, uint64(1), res.ReviewID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,156 @@
+package db
+
+import (
+	"context"
+	"github.com/JMURv/par-pro/products/internal/repo"
+	md "github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/opentracing/opentracing-go"
+	"time"
+)
+
+var trashRestoreQueries = map[string]string{
+	md.TrashItem:      trashItemRestoreQ,
+	md.TrashCategory:  trashCategoryRestoreQ,
+	md.TrashPromotion: trashPromoRestoreQ,
+	md.TrashOrder:     trashOrderRestoreQ,
+}
+
+var trashPurgeQueries = map[string]string{
+	md.TrashItem:      trashItemPurgeQ,
+	md.TrashCategory:  trashCategoryPurgeQ,
+	md.TrashPromotion: trashPromoPurgeQ,
+	md.TrashOrder:     trashOrderPurgeQ,
+}
+
+// ListTrash lists the soft deleted entities of kind, the most recently deleted first. An empty kind lists all of them.
+func (r *Repository) ListTrash(ctx context.Context, kind string, page, size int) (*md.PaginatedTrashData, error) {
+	const op = "trash.ListTrash.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	var count int64
+	if err := r.conn.QueryRow(trashCountQ, kind).Scan(&count); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.conn.Query(trashListQ, kind, (page-1)*size, size)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*md.TrashEntry, 0, size)
+	for rows.Next() {
+		e := &md.TrashEntry{}
+		if err = rows.Scan(&e.Kind, &e.ID, &e.Title, &e.DeletedAt); err != nil {
+			return nil, err
+		}
+		res = append(res, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	totalPages := int((count + int64(size) - 1) / int64(size))
+	return &md.PaginatedTrashData{
+		Data:        res,
+		Count:       count,
+		TotalPages:  totalPages,
+		CurrentPage: page,
+		HasNextPage: page < totalPages,
+	}, nil
+}
+
+func (r *Repository) RestoreFromTrash(ctx context.Context, kind, id string) error {
+	const op = "trash.RestoreFromTrash.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	q, ok := trashRestoreQueries[kind]
+	if !ok {
+		return repo.ErrNotFound
+	}
+
+	res, err := r.conn.ExecContext(ctx, q, id)
+	if err != nil {
+		return mapError(err)
+	}
+
+	if aff, _ := res.RowsAffected(); aff == 0 {
+		return repo.ErrNotFound
+	}
+	return nil
+}
+
+// PurgeFromTrash removes a soft deleted entity for good. Items still ordered are kept and ErrReferenced is returned.
+func (r *Repository) PurgeFromTrash(ctx context.Context, kind, id string) error {
+	const op = "trash.PurgeFromTrash.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	q, ok := trashPurgeQueries[kind]
+	if !ok {
+		return repo.ErrNotFound
+	}
+
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return mapError(err)
+	}
+
+	if kind == md.TrashItem {
+		var referenced bool
+		if err = tx.QueryRow(trashItemReferencedQ, id).Scan(&referenced); err != nil {
+			tx.Rollback()
+			return mapError(err)
+		}
+
+		if referenced {
+			tx.Rollback()
+			return repo.ErrReferenced
+		}
+	}
+
+	res, err := tx.Exec(q, id)
+	if err != nil {
+		tx.Rollback()
+		return mapError(err)
+	}
+
+	if aff, _ := res.RowsAffected(); aff == 0 {
+		tx.Rollback()
+		return repo.ErrNotFound
+	}
+
+	return tx.Commit()
+}
+
+// PurgeExpiredTrash removes the entities deleted before the given time and returns how many were removed.
+func (r *Repository) PurgeExpiredTrash(ctx context.Context, before time.Time) (int64, error) {
+	const op = "trash.PurgeExpiredTrash.repo"
+	span, _ := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return 0, err
+	}
+
+	var total int64
+	for _, q := range []string{trashOrderExpiredQ, trashPromoExpiredQ, trashCategoryExpiredQ, trashItemExpiredQ} {
+		res, err := tx.Exec(q, before)
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+
+		aff, _ := res.RowsAffected()
+		total += aff
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
@@ -0,0 +1,62 @@
+package db
+
+// trashQ lists every soft deleted entity, the order is titled by the name of the customer.
+const trashQ = `
+	WITH trash AS (
+	    SELECT 'item' AS kind, id::TEXT AS id, title, deleted_at FROM item WHERE deleted_at IS NOT NULL
+	    UNION ALL
+	    SELECT 'category', slug, title, deleted_at FROM category WHERE deleted_at IS NOT NULL
+	    UNION ALL
+	    SELECT 'promotion', slug, title, deleted_at FROM promotion WHERE deleted_at IS NOT NULL
+	    UNION ALL
+	    SELECT 'order', id::TEXT, fio, deleted_at FROM "order" WHERE deleted_at IS NOT NULL
+	)
+`
+
+const trashCountQ = trashQ + `SELECT COUNT(*) FROM trash WHERE $1 = '' OR kind = $1`
+
+const trashListQ = trashQ + `
+	SELECT kind, id, title, deleted_at
+	FROM trash
+	WHERE $1 = '' OR kind = $1
+	ORDER BY deleted_at DESC, id
+	OFFSET $2 LIMIT $3
+`
+
+// Variants are trashed together with their parent and come back with it.
+const trashItemRestoreQ = `
+	UPDATE item SET deleted_at = NULL, version = version + 1
+	WHERE (id = $1 OR parent_id = $1)
+	  AND deleted_at = (SELECT deleted_at FROM item WHERE id = $1 AND deleted_at IS NOT NULL)
+`
+
+const trashCategoryRestoreQ = `UPDATE category SET deleted_at = NULL, version = version + 1 WHERE slug = $1 AND deleted_at IS NOT NULL`
+const trashPromoRestoreQ = `UPDATE promotion SET deleted_at = NULL, version = version + 1 WHERE slug = $1 AND deleted_at IS NOT NULL`
+const trashOrderRestoreQ = `UPDATE "order" SET deleted_at = NULL, version = version + 1 WHERE id = $1 AND deleted_at IS NOT NULL`
+
+// Order lines are removed together with the item, so an item stays in the trash while an order still has it.
+const trashItemReferencedQ = `
+	SELECT EXISTS (
+	    SELECT 1 FROM order_item oi
+	    JOIN item i ON i.id = oi.item_id
+	    WHERE i.id = $1 OR i.parent_id = $1
+	)
+`
+
+const trashItemPurgeQ = `DELETE FROM item WHERE id = $1 AND deleted_at IS NOT NULL`
+const trashCategoryPurgeQ = `DELETE FROM category WHERE slug = $1 AND deleted_at IS NOT NULL`
+const trashPromoPurgeQ = `DELETE FROM promotion WHERE slug = $1 AND deleted_at IS NOT NULL`
+const trashOrderPurgeQ = `DELETE FROM "order" WHERE id = $1 AND deleted_at IS NOT NULL`
+
+// Orders go first so that the items only they referenced are purged in the same run.
+const trashOrderExpiredQ = `DELETE FROM "order" WHERE deleted_at < $1`
+const trashPromoExpiredQ = `DELETE FROM promotion WHERE deleted_at < $1`
+const trashCategoryExpiredQ = `DELETE FROM category WHERE deleted_at < $1`
+const trashItemExpiredQ = `
+	DELETE FROM item i
+	WHERE i.deleted_at < $1 AND NOT EXISTS (
+	    SELECT 1 FROM order_item oi
+	    JOIN item v ON v.id = oi.item_id
+	    WHERE v.id = i.id OR v.parent_id = i.id
+	)
+`
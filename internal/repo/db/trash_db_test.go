@@ -0,0 +1,196 @@
+package db
+
+import (
+	"context"
+	"errors"
+	repo2 "github.com/JMURv/par-pro/products/internal/repo"
+	md "github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestRepository_ListTrash(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(trashCountQ)).
+		WithArgs(md.TrashCategory).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+	mock.ExpectQuery(regexp.QuoteMeta(trashListQ)).
+		WithArgs(md.TrashCategory, 0, 2).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"kind", "id", "title", "deleted_at"}).
+				AddRow(md.TrashCategory, "shoes", "Shoes", now).
+				AddRow(md.TrashCategory, "hats", "Hats", now),
+		)
+
+	res, err := repo.ListTrash(context.Background(), md.TrashCategory, 1, 2)
+	require.NoError(t, err)
+	assert.Len(t, res.Data, 2)
+	assert.Equal(t, "shoes", res.Data[0].ID)
+	assert.Equal(t, 2, res.TotalPages)
+	assert.True(t, res.HasNextPage)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_RestoreFromTrash(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	dbErr := errors.New("db error")
+
+	tests := []struct {
+		name       string
+		kind       string
+		mockExpect func()
+		expectErr  error
+	}{
+		{
+			name: "Success",
+			kind: md.TrashPromotion,
+			mockExpect: func() {
+				mock.ExpectExec(regexp.QuoteMeta(trashPromoRestoreQ)).
+					WithArgs("sale").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "Not in trash",
+			kind: md.TrashPromotion,
+			mockExpect: func() {
+				mock.ExpectExec(regexp.QuoteMeta(trashPromoRestoreQ)).
+					WithArgs("sale").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectErr: repo2.ErrNotFound,
+		},
+		{
+			name:       "Unknown kind",
+			kind:       "label",
+			mockExpect: func() {},
+			expectErr:  repo2.ErrNotFound,
+		},
+		{
+			name: "Unexpected error",
+			kind: md.TrashPromotion,
+			mockExpect: func() {
+				mock.ExpectExec(regexp.QuoteMeta(trashPromoRestoreQ)).
+					WithArgs("sale").
+					WillReturnError(dbErr)
+			},
+			expectErr: dbErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+			err := repo.RestoreFromTrash(context.Background(), tt.kind, "sale")
+			if tt.expectErr != nil {
+				assert.ErrorIs(t, err, tt.expectErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_PurgeFromTrash(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	id := "6f1c4bb6-4cbe-4b52-9a4b-6f0d1b3b1c55"
+
+	tests := []struct {
+		name       string
+		mockExpect func()
+		expectErr  error
+	}{
+		{
+			name: "Success",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(trashItemReferencedQ)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+				mock.ExpectExec(regexp.QuoteMeta(trashItemPurgeQ)).
+					WithArgs(id).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Still ordered",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(trashItemReferencedQ)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+				mock.ExpectRollback()
+			},
+			expectErr: repo2.ErrReferenced,
+		},
+		{
+			name: "Not in trash",
+			mockExpect: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(trashItemReferencedQ)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+				mock.ExpectExec(regexp.QuoteMeta(trashItemPurgeQ)).
+					WithArgs(id).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectErr: repo2.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+			err := repo.PurgeFromTrash(context.Background(), md.TrashItem, id)
+			if tt.expectErr != nil {
+				assert.ErrorIs(t, err, tt.expectErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_PurgeExpiredTrash(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{conn: db}
+	before := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(trashOrderExpiredQ)).WithArgs(before).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(trashPromoExpiredQ)).WithArgs(before).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(trashCategoryExpiredQ)).WithArgs(before).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta(trashItemExpiredQ)).WithArgs(before).WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectCommit()
+
+	n, err := repo.PurgeExpiredTrash(context.Background(), before)
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), n)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
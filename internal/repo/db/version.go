@@ -0,0 +1,28 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/JMURv/par-pro/products/internal/repo"
+)
+
+// bumpVersion increments the version of the row with id and returns the new one.
+// The row stays locked until tx ends, so concurrent updates of the same row are applied one after another.
+// A non zero expected version must match the stored one, otherwise repo.ErrVersionConflict is returned.
+func bumpVersion(tx *sql.Tx, bumpQ, getQ string, id any, expected int) (int, error) {
+	var version int
+	err := tx.QueryRow(bumpQ, id, expected).Scan(&version)
+	if err == nil {
+		return version, nil
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return 0, err
+	}
+
+	if err = tx.QueryRow(getQ, id).Scan(&version); err != nil && errors.Is(err, sql.ErrNoRows) {
+		return 0, repo.ErrNotFound
+	} else if err != nil {
+		return 0, err
+	}
+
+	return 0, repo.ErrVersionConflict
+}
@@ -1,6 +1,11 @@
 package repo
 
-import "errors"
+import "github.com/JMURv/par-pro/products/internal/apperr"
 
-var ErrNotFound = errors.New("not found")
-var ErrAlreadyExists = errors.New("already exists")
+var ErrNotFound = apperr.New(apperr.NotFound, "not found")
+var ErrAlreadyExists = apperr.New(apperr.AlreadyExists, "already exists")
+var ErrNotPurchased = apperr.New(apperr.Forbidden, "item was not delivered to the user")
+var ErrVersionConflict = apperr.New(apperr.PreconditionFailed, "version does not match")
+var ErrReferenced = apperr.New(apperr.Conflict, "still referenced")
+var ErrMissingReference = apperr.New(apperr.Validation, "references a missing record")
+var ErrConstraint = apperr.New(apperr.Validation, "violates a constraint")
@@ -0,0 +1,23 @@
+package storage
+
+import (
+	"errors"
+	"github.com/JMURv/par-pro/products/internal/ctrl"
+	cfg "github.com/JMURv/par-pro/products/pkg/config"
+	"go.uber.org/zap"
+)
+
+const TypeLocal = "local"
+
+var ErrInvalidKey = errors.New("invalid storage key")
+
+// New picks the storage implementation from the config, unknown types fall back to the local filesystem.
+func New(conf *cfg.StorageConfig) ctrl.Storage {
+	switch conf.Type {
+	case TypeLocal, "":
+		return NewLocal(conf.Dir, conf.URL)
+	default:
+		zap.L().Warn("Unknown storage type, using local", zap.String("type", conf.Type))
+		return NewLocal(conf.Dir, conf.URL)
+	}
+}
@@ -0,0 +1,156 @@
+package validation
+
+import (
+	"fmt"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var booleanValues = map[string]bool{
+	"true":  true,
+	"1":     true,
+	"yes":   true,
+	"да":    true,
+	"false": false,
+	"0":     false,
+	"no":    false,
+	"нет":   false,
+}
+
+func AttributeValidation(req *model.Attribute) error {
+	if strings.TrimSpace(req.Name) == "" {
+		return ErrMissingName
+	}
+
+	switch req.Type {
+	case model.AttributeTypeString, model.AttributeTypeNumber, model.AttributeTypeBoolean:
+		req.AllowedValues = nil
+	case model.AttributeTypeEnum:
+		if len(req.AllowedValues) == 0 {
+			return ErrMissingAllowedValues
+		}
+	default:
+		return ErrInvalidAttributeType
+	}
+
+	seen := make(map[string]struct{}, len(req.AllowedValues))
+	for _, v := range req.AllowedValues {
+		key := strings.ToLower(strings.TrimSpace(v))
+		if key == "" {
+			return ErrMissingAllowedValues
+		}
+		if _, ok := seen[key]; ok {
+			return ErrDuplicateAllowedValue
+		}
+		seen[key] = struct{}{}
+	}
+
+	return nil
+}
+
+func CategoryAttributesValidation(req []*model.CategoryAttribute) error {
+	seen := make(map[uint64]struct{}, len(req))
+	for _, v := range req {
+		if v.AttributeID == 0 {
+			return ErrMissingAttributeID
+		}
+		if _, ok := seen[v.AttributeID]; ok {
+			return ErrDuplicateAttribute
+		}
+		seen[v.AttributeID] = struct{}{}
+	}
+
+	return nil
+}
+
+// ItemAttributesValidation checks the attributes against the schema of the item categories.
+// Attributes matching a definition by name or slug, in any case, are bound to it and get its
+// canonical name and value, the rest are kept as free-form text.
+func ItemAttributesValidation(attrs []model.ItemAttribute, schema []*model.CategoryAttribute) error {
+	defs := make(map[string]*model.Attribute, len(schema)*2)
+	for _, v := range schema {
+		defs[strings.ToLower(v.Attribute.Name)] = v.Attribute
+		defs[strings.ToLower(v.Attribute.Slug)] = v.Attribute
+	}
+
+	v := &violations{}
+	seen := make(map[string]struct{}, len(attrs))
+	for i := range attrs {
+		attr, field := &attrs[i], index("attributes", i)
+		attr.Name = strings.TrimSpace(attr.Name)
+		if attr.Name == "" {
+			v.add(field+".name", CodeRequired, ErrMissingName)
+			continue
+		}
+
+		if def, ok := defs[strings.ToLower(attr.Name)]; ok {
+			value, err := attributeValue(def, attr.Value)
+			if err != nil {
+				v.add(field+".value", CodeInvalid, err)
+			} else {
+				attr.Value = value
+			}
+			attr.Name, attr.AttributeID = def.Name, def.ID
+		} else {
+			attr.AttributeID = 0
+		}
+
+		key := strings.ToLower(attr.Name)
+		if _, ok := seen[key]; ok {
+			v.add(field+".name", CodeDuplicate, fmt.Errorf("%w: %v", ErrDuplicateAttribute, attr.Name))
+		}
+		seen[key] = struct{}{}
+	}
+
+	requiredAttributesValidation(v, attrs, schema)
+	return v.err()
+}
+
+// requiredAttributesValidation reports the required attributes of the schema that attrs lack.
+func requiredAttributesValidation(v *violations, attrs []model.ItemAttribute, schema []*model.CategoryAttribute) {
+	names := make(map[string]struct{}, len(attrs))
+	for _, attr := range attrs {
+		names[strings.ToLower(strings.TrimSpace(attr.Name))] = struct{}{}
+	}
+
+	for _, def := range schema {
+		if _, ok := names[strings.ToLower(def.Attribute.Name)]; def.IsRequired && !ok {
+			v.add("attributes", CodeRequired, fmt.Errorf("%w: %v", ErrMissingRequiredAttribute, def.Attribute.Name))
+		}
+	}
+}
+
+// attributeValue returns the canonical form of the value, numbers accept a decimal comma.
+func attributeValue(def *model.Attribute, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	invalid := fmt.Errorf("%w: %v", ErrInvalidAttributeValue, def.Name)
+
+	switch def.Type {
+	case model.AttributeTypeNumber:
+		f, err := strconv.ParseFloat(strings.Replace(value, ",", ".", 1), 64)
+		if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+			return "", invalid
+		}
+		return strconv.FormatFloat(f, 'f', -1, 64), nil
+	case model.AttributeTypeBoolean:
+		b, ok := booleanValues[strings.ToLower(value)]
+		if !ok {
+			return "", invalid
+		}
+		return strconv.FormatBool(b), nil
+	case model.AttributeTypeEnum:
+		for _, v := range def.AllowedValues {
+			if strings.EqualFold(v, value) {
+				return v, nil
+			}
+		}
+		return "", invalid
+	default:
+		if value == "" {
+			return "", invalid
+		}
+		return value, nil
+	}
+}
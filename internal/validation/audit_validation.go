@@ -0,0 +1,14 @@
+package validation
+
+import (
+	"github.com/JMURv/par-pro/products/pkg/model"
+)
+
+// AuditFilterValidation accepts an open range on either side.
+func AuditFilterValidation(f *model.AuditFilter) error {
+	if !f.From.IsZero() && !f.To.IsZero() && !f.From.Before(f.To) {
+		return ErrInvalidAuditRange
+	}
+
+	return nil
+}
@@ -1,12 +1,69 @@
 package validation
 
-import "github.com/JMURv/par-pro/products/pkg/model"
+import (
+	"fmt"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"strings"
+)
 
 func CategoryValidation(req *model.Category) error {
+	v := &violations{}
 	if req.Title == "" {
-		return ErrMissingTitle
+		v.add("title", CodeRequired, ErrMissingTitle)
 	}
 
-	return nil
+	v.merge(CategoryFiltersValidation(req.Filters))
+	return v.err()
+}
+
+// CategoryFiltersValidation checks the filters stored along with the category.
+func CategoryFiltersValidation(filters []model.Filter) error {
+	v := &violations{}
+	seen := make(map[string]struct{}, len(filters))
+	for i := range filters {
+		f, field := &filters[i], index("filters", i)
+		f.Name = strings.TrimSpace(f.Name)
+		if f.Name == "" {
+			v.add(field+".name", CodeRequired, ErrMissingName)
+		} else if _, ok := seen[strings.ToLower(f.Name)]; ok {
+			v.add(field+".name", CodeDuplicate, fmt.Errorf("%w: %v", ErrDuplicateFilter, f.Name))
+		}
+		seen[strings.ToLower(f.Name)] = struct{}{}
+
+		switch f.FilterType {
+		case "equality":
+		case "range":
+			if f.MinValue > f.MaxValue {
+				v.add(field+".min_value", CodeOutOfRange, ErrInvalidFilterRange)
+			}
+		default:
+			v.add(field+".filter_type", CodeInvalid, ErrInvalidFilterType)
+		}
+	}
+
+	return v.err()
+}
+
+// CategoryPatchValidation checks the fields of the category named in fields.
+func CategoryPatchValidation(req *model.Category, fields []string) error {
+	v := &violations{}
+	if len(fields) == 0 {
+		v.add("", CodeRequired, ErrEmptyPatch)
+	}
+
+	for _, f := range fields {
+		switch f {
+		case "title":
+			if req.Title == "" {
+				v.add(f, CodeRequired, ErrMissingTitle)
+			}
+		case "filters":
+			v.merge(CategoryFiltersValidation(req.Filters))
+		case "src", "alt", "parent_slug":
+		default:
+			v.add(f, CodeUnknownField, fmt.Errorf("%w: %v", ErrUnknownField, f))
+		}
+	}
 
+	return v.err()
 }
@@ -0,0 +1,39 @@
+package validation
+
+import (
+	"github.com/JMURv/par-pro/products/pkg/model"
+)
+
+func CustomerGroupValidation(g *model.CustomerGroup) error {
+	if g.Name == "" {
+		return ErrMissingName
+	}
+
+	return nil
+}
+
+func QuantityBreaksValidation(breaks []model.QuantityBreak) error {
+	type key struct {
+		group uint64
+		qty   int
+	}
+
+	seen := make(map[key]struct{}, len(breaks))
+	for _, v := range breaks {
+		if v.MinQuantity <= 1 {
+			return ErrInvalidMinQuantity
+		}
+
+		if v.Discount <= 0 || v.Discount >= 100 {
+			return ErrInvalidDiscount
+		}
+
+		k := key{group: v.CustomerGroupID, qty: v.MinQuantity}
+		if _, ok := seen[k]; ok {
+			return ErrDuplicateQuantityBreak
+		}
+		seen[k] = struct{}{}
+	}
+
+	return nil
+}
@@ -1,14 +1,89 @@
 package validation
 
-import "errors"
-
-var ErrMissingTitle = errors.New("missing title")
-var ErrMissingDescription = errors.New("missing description")
-var ErrMissingPrice = errors.New("missing price")
-var ErrMissingSrc = errors.New("missing src")
-var ErrMissingUUID = errors.New("missing uuid")
-
-var ErrMissingFIO = errors.New("missing fio")
-var ErrMissingTel = errors.New("missing tel")
-var ErrMissingEmail = errors.New("missing email")
-var ErrMissingAddress = errors.New("missing address")
+import "github.com/JMURv/par-pro/products/internal/apperr"
+
+var ErrMissingTitle = apperr.New(apperr.Validation, "missing title")
+var ErrMissingDescription = apperr.New(apperr.Validation, "missing description")
+var ErrMissingPrice = apperr.New(apperr.Validation, "missing price")
+var ErrInvalidCurrency = apperr.New(apperr.Validation, "invalid currency code")
+var ErrMissingSrc = apperr.New(apperr.Validation, "missing src")
+var ErrMissingUUID = apperr.New(apperr.Validation, "missing uuid")
+
+var ErrMissingFIO = apperr.New(apperr.Validation, "missing fio")
+var ErrMissingTel = apperr.New(apperr.Validation, "missing tel")
+var ErrMissingEmail = apperr.New(apperr.Validation, "missing email")
+var ErrMissingAddress = apperr.New(apperr.Validation, "missing address")
+
+var ErrInvalidStartsAt = apperr.New(apperr.Validation, "starts_at must be in the future")
+
+var ErrMissingName = apperr.New(apperr.Validation, "missing name")
+var ErrInvalidRate = apperr.New(apperr.Validation, "rate must not be negative")
+var ErrInvalidRounding = apperr.New(apperr.Validation, "invalid rounding rule")
+
+var ErrInvalidMinQuantity = apperr.New(apperr.Validation, "min_quantity must be greater than 1")
+var ErrInvalidDiscount = apperr.New(apperr.Validation, "discount must be between 1 and 99")
+var ErrDuplicateQuantityBreak = apperr.New(apperr.Validation, "duplicate quantity break")
+
+var ErrNoteTooLong = apperr.New(apperr.Validation, "note must not exceed 1000 characters")
+var ErrInvalidFavoriteSort = apperr.New(apperr.Validation, "sort must be one of date_added, -date_added, price, -price")
+
+var ErrEmptyFile = apperr.New(apperr.Validation, "missing file")
+var ErrFileTooLarge = apperr.New(apperr.Validation, "file must not exceed 10 MB")
+var ErrUnsupportedMediaType = apperr.New(apperr.Validation, "file must be a jpeg, png, gif or webp image")
+var ErrAltTooLong = apperr.New(apperr.Validation, "alt must not exceed 255 characters")
+var ErrMissingMediaIDs = apperr.New(apperr.Validation, "missing media ids")
+var ErrDuplicateMediaID = apperr.New(apperr.Validation, "duplicate media id")
+
+var ErrInvalidAttributeType = apperr.New(apperr.Validation, "type must be one of string, number, boolean, enum")
+var ErrMissingAllowedValues = apperr.New(apperr.Validation, "enum attribute must have allowed values")
+var ErrDuplicateAllowedValue = apperr.New(apperr.Validation, "duplicate allowed value")
+var ErrMissingAttributeID = apperr.New(apperr.Validation, "missing attribute id")
+var ErrDuplicateAttribute = apperr.New(apperr.Validation, "duplicate attribute")
+var ErrMissingRequiredAttribute = apperr.New(apperr.Validation, "missing required attribute")
+var ErrInvalidAttributeValue = apperr.New(apperr.Validation, "invalid attribute value")
+
+var ErrInvalidRelationType = apperr.New(apperr.Validation, "relation type must be one of accessory, analogue, upsell, cross_sell")
+var ErrSelfRelation = apperr.New(apperr.Validation, "item cannot be related to itself")
+var ErrDuplicateRelation = apperr.New(apperr.Validation, "duplicate related item")
+
+var ErrInvalidLabelName = apperr.New(apperr.Validation, "label name must consist of lowercase letters, digits, - and _")
+var ErrInvalidColor = apperr.New(apperr.Validation, "color must be in #RRGGBB format")
+var ErrInvalidLabelRule = apperr.New(apperr.Validation, "rule must be one of manual, new, hit, sale")
+var ErrInvalidRuleParam = apperr.New(apperr.Validation, "rule_param must not be negative")
+var ErrDuplicateLabel = apperr.New(apperr.Validation, "duplicate label")
+
+var ErrInvalidItemSort = apperr.New(apperr.Validation, "sort must be one of created_at, price, title, rating, optionally prefixed with -")
+
+var ErrInvalidRating = apperr.New(apperr.Validation, "rating must be between 1 and 5")
+var ErrReviewTooLong = apperr.New(apperr.Validation, "text, pros and cons must not exceed 5000 characters each")
+var ErrEmptyReview = apperr.New(apperr.Validation, "review must have text, pros or cons")
+var ErrInvalidModerationStatus = apperr.New(apperr.Validation, "status must be one of approved, rejected")
+var ErrModerationNoteTooLong = apperr.New(apperr.Validation, "moderation note must not exceed 255 characters")
+
+var ErrEmptyQuestion = apperr.New(apperr.Validation, "text is required")
+var ErrQuestionTooLong = apperr.New(apperr.Validation, "text must not exceed 2000 characters")
+
+var ErrInvalidIdempotencyKey = apperr.New(apperr.Validation, "idempotency key must not be longer than 255 characters")
+var ErrInvalidIfMatch = apperr.New(apperr.Validation, "invalid If-Match header, expected a single entity tag returned by the server")
+var ErrEmptyPatch = apperr.New(apperr.Validation, "patch must name at least one field")
+var ErrUnknownField = apperr.New(apperr.Validation, "field cannot be patched")
+
+var ErrInvalidTrashKind = apperr.New(apperr.Validation, "kind must be one of item, category, promotion, order")
+var ErrInvalidTrashID = apperr.New(apperr.Validation, "id must be an item uuid, a category or promotion slug or an order number")
+
+var ErrInvalidAuditTime = apperr.New(apperr.Validation, "from and to must be RFC 3339 timestamps")
+var ErrInvalidAuditRange = apperr.New(apperr.Validation, "from must be before to")
+
+var ErrInvalidRevisionID = apperr.New(apperr.Validation, "revision id must be a positive number")
+
+var ErrInvalidFilterType = apperr.New(apperr.Validation, "filter_type must be one of equality, range")
+var ErrInvalidFilterRange = apperr.New(apperr.Validation, "min_value must not be greater than max_value")
+var ErrDuplicateFilter = apperr.New(apperr.Validation, "duplicate filter")
+var ErrInvalidPriceFilter = apperr.New(apperr.Validation, "price filter must be a non-negative integer")
+var ErrInvalidRangeFilter = apperr.New(apperr.Validation, "range filter bounds must be numbers, min not greater than max")
+
+var ErrInvalidLastsTo = apperr.New(apperr.Validation, "lasts_to must be in the future")
+
+var ErrInvalidEmail = apperr.New(apperr.Validation, "invalid email")
+var ErrInvalidTel = apperr.New(apperr.Validation, "tel must be a phone number of 10 to 15 digits")
+var ErrInvalidQuantity = apperr.New(apperr.Validation, "quantity must be positive")
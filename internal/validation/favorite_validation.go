@@ -0,0 +1,41 @@
+package validation
+
+import (
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"unicode/utf8"
+)
+
+const maxNoteLength = 1000
+
+// FavoriteSortValidation accepts an empty sort, which falls back to the newest favorites first.
+func FavoriteSortValidation(sort string) error {
+	switch sort {
+	case "",
+		model.FavoriteSortDateAdded, "-" + model.FavoriteSortDateAdded,
+		model.FavoriteSortPrice, "-" + model.FavoriteSortPrice:
+		return nil
+	}
+
+	return ErrInvalidFavoriteSort
+}
+
+func FavoriteCollectionValidation(req *model.FavoriteCollection) error {
+	if req.Name == "" {
+		return ErrMissingName
+	}
+
+	return nil
+}
+
+func FavoriteCollectionItemValidation(req *model.FavoriteCollectionItem) error {
+	if req.ItemID == uuid.Nil {
+		return ErrMissingUUID
+	}
+
+	if utf8.RuneCountInString(req.Note) > maxNoteLength {
+		return ErrNoteTooLong
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package validation
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ItemFiltersValidation checks the filters parsed from the query of an item listing.
+// Prices are in minor units, attribute ranges accept a decimal comma like the attribute values do.
+func ItemFiltersValidation(filters map[string]any) error {
+	keys := make([]string, 0, len(filters))
+	for k := range filters {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	v := &violations{}
+	for _, key := range keys {
+		switch value := filters[key].(type) {
+		case []string:
+			if key != "min_price" && key != "max_price" {
+				continue
+			}
+
+			if len(value) != 1 {
+				v.add(key, CodeInvalid, ErrInvalidPriceFilter)
+			} else if n, err := strconv.ParseInt(value[0], 10, 64); err != nil || n < 0 {
+				v.add(key, CodeInvalidFormat, ErrInvalidPriceFilter)
+			}
+		case map[string]any:
+			lo, loOK := rangeBound(v, key+"[min]", value["min"])
+			hi, hiOK := rangeBound(v, key+"[max]", value["max"])
+			if loOK && hiOK && lo > hi {
+				v.add(key, CodeOutOfRange, ErrInvalidRangeFilter)
+			}
+		}
+	}
+
+	return v.err()
+}
+
+// rangeBound parses a bound of a range filter, a missing bound is valid but not reported as set.
+func rangeBound(v *violations, field string, bound any) (float64, bool) {
+	s, ok := bound.(string)
+	if !ok {
+		return 0, false
+	}
+
+	f, err := strconv.ParseFloat(strings.Replace(strings.TrimSpace(s), ",", ".", 1), 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		v.add(field, CodeInvalidFormat, ErrInvalidRangeFilter)
+		return 0, false
+	}
+	return f, true
+}
@@ -1,22 +1,146 @@
 package validation
 
-import "github.com/JMURv/par-pro/products/pkg/model"
+import (
+	"fmt"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"slices"
+	"strings"
+)
 
-func ItemValidation(i *model.Item) error {
+// ItemValidation checks the item, its attributes are checked against the schema of its categories.
+func ItemValidation(i *model.Item, schema []*model.CategoryAttribute) error {
+	v := &violations{}
 	if i.Title == "" {
-		return ErrMissingTitle
+		v.add("title", CodeRequired, ErrMissingTitle)
 	}
 
 	if i.Description == "" {
-		return ErrMissingDescription
+		v.add("description", CodeRequired, ErrMissingDescription)
 	}
 
-	if i.Price <= 0 {
-		return ErrMissingPrice
-	}
+	itemPriceValidation(v, i)
 
 	if i.Src == "" {
-		return ErrMissingSrc
+		v.add("src", CodeRequired, ErrMissingSrc)
+	}
+
+	v.merge(RelatedProductsValidation(i))
+	v.merge(ItemAttributesValidation(i.Attributes, schema))
+	return v.err()
+}
+
+func itemPriceValidation(v *violations, i *model.Item) {
+	itemAmountValidation(v, i)
+	itemCurrencyValidation(v, i)
+}
+
+func itemAmountValidation(v *violations, i *model.Item) {
+	switch {
+	case i.Price.Amount == 0:
+		v.add("price.amount", CodeRequired, ErrMissingPrice)
+	case !i.Price.IsPositive():
+		v.add("price.amount", CodeOutOfRange, ErrMissingPrice)
+	}
+}
+
+func itemCurrencyValidation(v *violations, i *model.Item) {
+	if i.Price.Currency != "" && !model.IsValidCurrency(i.Price.Currency) {
+		v.add("price.currency", CodeInvalid, ErrInvalidCurrency)
+	}
+}
+
+var relatedTypes = map[string]struct{}{
+	model.RelatedTypeAccessory: {},
+	model.RelatedTypeAnalogue:  {},
+	model.RelatedTypeUpsell:    {},
+	model.RelatedTypeCrossSell: {},
+}
+
+// RelatedProductsValidation checks the relations of the item, a relation without a type becomes a cross-sell.
+func RelatedProductsValidation(i *model.Item) error {
+	v := &violations{}
+	seen := make(map[uuid.UUID]struct{}, len(i.RelatedProducts))
+	for idx := range i.RelatedProducts {
+		rel := &i.RelatedProducts[idx]
+		field := index("related_products", idx)
+		switch _, dup := seen[rel.RelatedItemID]; {
+		case rel.RelatedItemID == uuid.Nil:
+			v.add(field+".related_item_id", CodeRequired, ErrMissingUUID)
+		case rel.RelatedItemID == i.ID:
+			v.add(field+".related_item_id", CodeInvalid, ErrSelfRelation)
+		case dup:
+			v.add(field+".related_item_id", CodeDuplicate, ErrDuplicateRelation)
+		}
+		seen[rel.RelatedItemID] = struct{}{}
+
+		if rel.Type == "" {
+			rel.Type = model.RelatedTypeCrossSell
+		}
+
+		if _, ok := relatedTypes[rel.Type]; !ok {
+			v.add(field+".type", CodeInvalid, ErrInvalidRelationType)
+		}
+	}
+
+	return v.err()
+}
+
+// ItemPatchValidation checks the fields of the item named in fields, the members of the price may be named on their own.
+// The schema is the one of the categories the item has after the patch. When the categories change
+// and the attributes do not, the stored attributes must still hold the required ones.
+func ItemPatchValidation(i *model.Item, fields []string, schema []*model.CategoryAttribute, stored []model.ItemAttribute) error {
+	v := &violations{}
+	if len(fields) == 0 {
+		v.add("", CodeRequired, ErrEmptyPatch)
 	}
-	return nil
+
+	for _, f := range fields {
+		switch f {
+		case "title":
+			if i.Title == "" {
+				v.add(f, CodeRequired, ErrMissingTitle)
+			}
+		case "description":
+			if i.Description == "" {
+				v.add(f, CodeRequired, ErrMissingDescription)
+			}
+		case "price":
+			itemPriceValidation(v, i)
+		case "price.amount":
+			itemAmountValidation(v, i)
+		case "price.currency":
+			itemCurrencyValidation(v, i)
+		case "src":
+			if i.Src == "" {
+				v.add(f, CodeRequired, ErrMissingSrc)
+			}
+		case "related_products":
+			v.merge(RelatedProductsValidation(i))
+		case "attributes":
+			v.merge(ItemAttributesValidation(i.Attributes, schema))
+		case "categories":
+			if !slices.Contains(fields, "attributes") {
+				requiredAttributesValidation(v, stored, schema)
+			}
+		case "article", "alt", "in_stock", "is_hit", "is_rec", "parent_item_id", "media", "variants":
+		default:
+			v.add(f, CodeUnknownField, fmt.Errorf("%w: %v", ErrUnknownField, f))
+		}
+	}
+
+	return v.err()
+}
+
+// ItemSortValidation accepts an empty sort, which falls back to the newest items first.
+func ItemSortValidation(sort string) error {
+	switch strings.TrimPrefix(sort, "-") {
+	case "", model.ItemSortCreatedAt, model.ItemSortPrice, model.ItemSortTitle, model.ItemSortRating:
+		if sort == "-" {
+			return ErrInvalidItemSort
+		}
+		return nil
+	}
+
+	return ErrInvalidItemSort
 }
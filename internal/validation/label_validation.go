@@ -0,0 +1,65 @@
+package validation
+
+import (
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"regexp"
+	"strings"
+)
+
+var labelNameRe = regexp.MustCompile(`^[a-z0-9_-]+$`)
+var colorRe = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// LabelValidation checks the label, a missing rule means a manual label and a missing rule_param gets the rule default.
+func LabelValidation(req *model.Label) error {
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return ErrMissingName
+	}
+
+	if !labelNameRe.MatchString(req.Name) {
+		return ErrInvalidLabelName
+	}
+
+	if req.Color != "" && !colorRe.MatchString(req.Color) {
+		return ErrInvalidColor
+	}
+
+	if req.RuleParam < 0 {
+		return ErrInvalidRuleParam
+	}
+
+	switch req.Rule {
+	case "", model.LabelRuleManual, model.LabelRuleSale:
+		if req.Rule == "" {
+			req.Rule = model.LabelRuleManual
+		}
+		req.RuleParam = 0
+	case model.LabelRuleNew:
+		if req.RuleParam == 0 {
+			req.RuleParam = consts.NewLabelDays
+		}
+	case model.LabelRuleHit:
+		if req.RuleParam == 0 {
+			req.RuleParam = consts.HitLabelSize
+		}
+	default:
+		return ErrInvalidLabelRule
+	}
+
+	return nil
+}
+
+func ItemLabelsValidation(names []string) error {
+	seen := make(map[string]struct{}, len(names))
+	for _, v := range names {
+		if v == "" {
+			return ErrMissingName
+		}
+		if _, ok := seen[v]; ok {
+			return ErrDuplicateLabel
+		}
+		seen[v] = struct{}{}
+	}
+	return nil
+}
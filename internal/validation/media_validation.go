@@ -0,0 +1,54 @@
+package validation
+
+import (
+	"github.com/JMURv/par-pro/products/internal/media"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"unicode/utf8"
+)
+
+const maxAltLength = 255
+
+func MediaUploadValidation(req *model.MediaUpload) error {
+	if len(req.Data) == 0 {
+		return ErrEmptyFile
+	}
+
+	if len(req.Data) > consts.MaxMediaSize {
+		return ErrFileTooLarge
+	}
+
+	if _, ok := media.Extensions[media.DetectContentType(req.Data)]; !ok {
+		return ErrUnsupportedMediaType
+	}
+
+	if utf8.RuneCountInString(req.Alt) > maxAltLength {
+		return ErrAltTooLong
+	}
+
+	return nil
+}
+
+func ItemMediaValidation(req *model.ItemMedia) error {
+	if utf8.RuneCountInString(req.Alt) > maxAltLength {
+		return ErrAltTooLong
+	}
+
+	return nil
+}
+
+func ReorderItemMediaValidation(ids []uint64) error {
+	if len(ids) == 0 {
+		return ErrMissingMediaIDs
+	}
+
+	seen := make(map[uint64]struct{}, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			return ErrDuplicateMediaID
+		}
+		seen[id] = struct{}{}
+	}
+
+	return nil
+}
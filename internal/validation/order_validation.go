@@ -1,26 +1,28 @@
 package validation
 
 import (
+	"fmt"
 	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"net/mail"
+	"strings"
 )
 
 func Order(o *model.Order) error {
+	v := &violations{}
 	if o.FIO == "" {
-		return ErrMissingFIO
+		v.add("fio", CodeRequired, ErrMissingFIO)
 	}
 
-	if o.Tel == "" {
-		return ErrMissingTel
-	}
-
-	if o.Email == "" {
-		return ErrMissingEmail
-	}
+	telValidation(v, o.Tel)
+	emailValidation(v, o.Email)
 
 	if o.Address == "" {
-		return ErrMissingAddress
+		v.add("address", CodeRequired, ErrMissingAddress)
 	}
 
+	orderItemsValidation(v, o.OrderItems)
+
 	//if o.Delivery == "" {
 	//	return ErrMissingDeliveryType
 	//}
@@ -29,5 +31,80 @@ func Order(o *model.Order) error {
 	//	return ErrMissingPaymentMethod
 	//}
 
-	return nil
+	return v.err()
+}
+
+// telValidation accepts digits separated by spaces, dashes and parentheses, optionally prefixed with +.
+func telValidation(v *violations, tel string) {
+	if tel == "" {
+		v.add("tel", CodeRequired, ErrMissingTel)
+		return
+	}
+
+	digits := strings.TrimPrefix(strings.NewReplacer(" ", "", "-", "", "(", "", ")", "").Replace(tel), "+")
+	if len(digits) < 10 || len(digits) > 15 || strings.TrimLeft(digits, "0123456789") != "" {
+		v.add("tel", CodeInvalidFormat, ErrInvalidTel)
+	}
+}
+
+// emailValidation accepts a bare address only, display names like "Name <addr>" are rejected.
+func emailValidation(v *violations, email string) {
+	if email == "" {
+		v.add("email", CodeRequired, ErrMissingEmail)
+		return
+	}
+
+	if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email {
+		v.add("email", CodeInvalidFormat, ErrInvalidEmail)
+	}
+}
+
+func orderItemsValidation(v *violations, items []*model.OrderItem) {
+	for i, item := range items {
+		field := index("order_items", i)
+		if item == nil {
+			v.add(field, CodeRequired, ErrMissingUUID)
+			continue
+		}
+
+		if item.ItemID == uuid.Nil {
+			v.add(field+".item_id", CodeRequired, ErrMissingUUID)
+		}
+
+		if item.Quantity <= 0 {
+			v.add(field+".quantity", CodeOutOfRange, ErrInvalidQuantity)
+		}
+	}
+}
+
+// OrderPatch checks the fields of the order named in fields.
+func OrderPatch(o *model.Order, fields []string) error {
+	v := &violations{}
+	if len(fields) == 0 {
+		v.add("", CodeRequired, ErrEmptyPatch)
+	}
+
+	for _, f := range fields {
+		switch f {
+		case "fio":
+			if o.FIO == "" {
+				v.add(f, CodeRequired, ErrMissingFIO)
+			}
+		case "tel":
+			telValidation(v, o.Tel)
+		case "email":
+			emailValidation(v, o.Email)
+		case "address":
+			if o.Address == "" {
+				v.add(f, CodeRequired, ErrMissingAddress)
+			}
+		case "order_items":
+			orderItemsValidation(v, o.OrderItems)
+		case "status", "delivery", "payment_method":
+		default:
+			v.add(f, CodeUnknownField, fmt.Errorf("%w: %v", ErrUnknownField, f))
+		}
+	}
+
+	return v.err()
 }
@@ -0,0 +1,79 @@
+package validation
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestTelValidation(t *testing.T) {
+	tests := []struct {
+		name     string
+		tel      string
+		code     string
+		expected error
+	}{
+		{name: "Plain", tel: "79991234567"},
+		{name: "Formatted", tel: "+7 (999) 123-45-67"},
+		{name: "Longest", tel: "123456789012345"},
+		{name: "Missing", tel: "", code: CodeRequired, expected: ErrMissingTel},
+		{name: "TooShort", tel: "123456789", code: CodeInvalidFormat, expected: ErrInvalidTel},
+		{name: "TooLong", tel: "1234567890123456", code: CodeInvalidFormat, expected: ErrInvalidTel},
+		{name: "Letters", tel: "+7 999 CALL-NOW", code: CodeInvalidFormat, expected: ErrInvalidTel},
+		{name: "PlusInside", tel: "7999+1234567", code: CodeInvalidFormat, expected: ErrInvalidTel},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				v := &violations{}
+				telValidation(v, tt.tel)
+				assertViolation(t, v.err(), "tel", tt.code, tt.expected)
+			},
+		)
+	}
+}
+
+func TestEmailValidation(t *testing.T) {
+	tests := []struct {
+		name     string
+		email    string
+		code     string
+		expected error
+	}{
+		{name: "Plain", email: "user@example.com"},
+		{name: "Subaddress", email: "user+orders@mail.example.com"},
+		{name: "Missing", email: "", code: CodeRequired, expected: ErrMissingEmail},
+		{name: "NoDomain", email: "user@", code: CodeInvalidFormat, expected: ErrInvalidEmail},
+		{name: "NoAt", email: "user.example.com", code: CodeInvalidFormat, expected: ErrInvalidEmail},
+		{name: "DisplayName", email: "User <user@example.com>", code: CodeInvalidFormat, expected: ErrInvalidEmail},
+		{name: "Padded", email: " user@example.com", code: CodeInvalidFormat, expected: ErrInvalidEmail},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				v := &violations{}
+				emailValidation(v, tt.email)
+				assertViolation(t, v.err(), "email", tt.code, tt.expected)
+			},
+		)
+	}
+}
+
+// assertViolation checks err holds the single violation expected, a nil expected means no violation.
+func assertViolation(t *testing.T, err error, field, code string, expected error) {
+	t.Helper()
+	if expected == nil {
+		assert.NoError(t, err)
+		return
+	}
+
+	var errs Errors
+	require.True(t, errors.As(err, &errs))
+	require.Len(t, errs, 1)
+	assert.Equal(t, field, errs[0].Field)
+	assert.Equal(t, code, errs[0].Code)
+	assert.ErrorIs(t, err, expected)
+}
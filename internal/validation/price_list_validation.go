@@ -0,0 +1,58 @@
+package validation
+
+import (
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+)
+
+func PriceListValidation(pl *model.PriceList) error {
+	if pl.Name == "" {
+		return ErrMissingName
+	}
+
+	if !model.IsValidCurrency(pl.Currency) {
+		return ErrInvalidCurrency
+	}
+
+	if pl.BaseCurrency != "" && !model.IsValidCurrency(pl.BaseCurrency) {
+		return ErrInvalidCurrency
+	}
+
+	if pl.Rate < 0 {
+		return ErrInvalidRate
+	}
+
+	switch pl.Rounding {
+	case "", model.RoundingHalfUp, model.RoundingUp, model.RoundingDown:
+	default:
+		return ErrInvalidRounding
+	}
+
+	if pl.RoundingStep < 0 {
+		return ErrInvalidRounding
+	}
+
+	return nil
+}
+
+func PriceListItemsValidation(items []*model.PriceListItem) error {
+	if len(items) == 0 {
+		return ErrMissingPrice
+	}
+
+	for _, v := range items {
+		if v.ItemID == uuid.Nil {
+			return ErrMissingUUID
+		}
+
+		if !v.Price.IsPositive() {
+			return ErrMissingPrice
+		}
+
+		if v.Price.Currency != "" && !model.IsValidCurrency(v.Price.Currency) {
+			return ErrInvalidCurrency
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package validation
+
+import (
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"time"
+)
+
+func ScheduledPriceValidation(sp *model.ScheduledPrice) error {
+	if !sp.Price.IsPositive() {
+		return ErrMissingPrice
+	}
+
+	if sp.Price.Currency != "" && !model.IsValidCurrency(sp.Price.Currency) {
+		return ErrInvalidCurrency
+	}
+
+	if !sp.StartsAt.After(time.Now()) {
+		return ErrInvalidStartsAt
+	}
+
+	return nil
+}
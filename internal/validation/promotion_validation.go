@@ -1,21 +1,87 @@
 package validation
 
 import (
+	"fmt"
 	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"time"
 )
 
 func ValidatePromotion(p *model.Promotion) error {
+	v := &violations{}
 	if p.Title == "" {
-		return ErrMissingTitle
+		v.add("title", CodeRequired, ErrMissingTitle)
 	}
 
 	if p.Description == "" {
-		return ErrMissingDescription
+		v.add("description", CodeRequired, ErrMissingDescription)
 	}
 
 	if p.Src == "" {
-		return ErrMissingSrc
+		v.add("src", CodeRequired, ErrMissingSrc)
 	}
 
-	return nil
+	lastsToValidation(v, p.LastsTo)
+	promotionItemsValidation(v, p.PromotionItems)
+	return v.err()
+}
+
+func lastsToValidation(v *violations, lastsTo time.Time) {
+	if lastsTo.IsZero() {
+		v.add("lasts_to", CodeRequired, ErrInvalidLastsTo)
+	} else if !lastsTo.After(time.Now()) {
+		v.add("lasts_to", CodeNotInFuture, ErrInvalidLastsTo)
+	}
+}
+
+func promotionItemsValidation(v *violations, items []*model.PromotionItem) {
+	for i, item := range items {
+		field := index("promotion_items", i)
+		if item == nil {
+			v.add(field, CodeRequired, ErrMissingUUID)
+			continue
+		}
+
+		if item.ItemID == uuid.Nil {
+			v.add(field+".item_id", CodeRequired, ErrMissingUUID)
+		}
+
+		if item.Discount <= 0 || item.Discount >= 100 {
+			v.add(field+".discount", CodeOutOfRange, ErrInvalidDiscount)
+		}
+	}
+}
+
+// PromotionPatchValidation checks the fields of the promotion named in fields.
+func PromotionPatchValidation(p *model.Promotion, fields []string) error {
+	v := &violations{}
+	if len(fields) == 0 {
+		v.add("", CodeRequired, ErrEmptyPatch)
+	}
+
+	for _, f := range fields {
+		switch f {
+		case "title":
+			if p.Title == "" {
+				v.add(f, CodeRequired, ErrMissingTitle)
+			}
+		case "description":
+			if p.Description == "" {
+				v.add(f, CodeRequired, ErrMissingDescription)
+			}
+		case "src":
+			if p.Src == "" {
+				v.add(f, CodeRequired, ErrMissingSrc)
+			}
+		case "lasts_to":
+			lastsToValidation(v, p.LastsTo)
+		case "promotion_items":
+			promotionItemsValidation(v, p.PromotionItems)
+		case "alt":
+		default:
+			v.add(f, CodeUnknownField, fmt.Errorf("%w: %v", ErrUnknownField, f))
+		}
+	}
+
+	return v.err()
 }
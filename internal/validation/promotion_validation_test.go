@@ -0,0 +1,29 @@
+package validation
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLastsToValidation(t *testing.T) {
+	tests := []struct {
+		name     string
+		lastsTo  time.Time
+		code     string
+		expected error
+	}{
+		{name: "Future", lastsTo: time.Now().Add(time.Hour)},
+		{name: "Missing", code: CodeRequired, expected: ErrInvalidLastsTo},
+		{name: "Past", lastsTo: time.Now().Add(-time.Hour), code: CodeNotInFuture, expected: ErrInvalidLastsTo},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				v := &violations{}
+				lastsToValidation(v, tt.lastsTo)
+				assertViolation(t, v.err(), "lasts_to", tt.code, tt.expected)
+			},
+		)
+	}
+}
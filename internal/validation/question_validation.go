@@ -0,0 +1,31 @@
+package validation
+
+import (
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"strings"
+	"unicode/utf8"
+)
+
+const maxQuestionLength = 2000
+
+func QuestionValidation(req *model.Question) error {
+	req.Text = strings.TrimSpace(req.Text)
+	return qaTextValidation(req.Text)
+}
+
+func AnswerValidation(req *model.Answer) error {
+	req.Text = strings.TrimSpace(req.Text)
+	return qaTextValidation(req.Text)
+}
+
+func qaTextValidation(text string) error {
+	if text == "" {
+		return ErrEmptyQuestion
+	}
+
+	if utf8.RuneCountInString(text) > maxQuestionLength {
+		return ErrQuestionTooLong
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package validation
+
+import (
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"strings"
+	"unicode/utf8"
+)
+
+const maxReviewFieldLength = 5000
+const maxModerationNoteLength = 255
+
+func ReviewValidation(req *model.Review) error {
+	if req.Rating < 1 || req.Rating > 5 {
+		return ErrInvalidRating
+	}
+
+	req.Text = strings.TrimSpace(req.Text)
+	req.Pros = strings.TrimSpace(req.Pros)
+	req.Cons = strings.TrimSpace(req.Cons)
+	if req.Text == "" && req.Pros == "" && req.Cons == "" {
+		return ErrEmptyReview
+	}
+
+	for _, v := range []string{req.Text, req.Pros, req.Cons} {
+		if utf8.RuneCountInString(v) > maxReviewFieldLength {
+			return ErrReviewTooLong
+		}
+	}
+
+	return nil
+}
+
+func ModerationValidation(req *model.Moderation) error {
+	if req.Status != model.ReviewStatusApproved && req.Status != model.ReviewStatusRejected {
+		return ErrInvalidModerationStatus
+	}
+
+	if utf8.RuneCountInString(req.Note) > maxModerationNoteLength {
+		return ErrModerationNoteTooLong
+	}
+
+	return nil
+}
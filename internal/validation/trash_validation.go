@@ -0,0 +1,40 @@
+package validation
+
+import (
+	"github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"slices"
+	"strconv"
+)
+
+// TrashKindValidation accepts an empty kind, which lists the whole trash.
+func TrashKindValidation(kind string) error {
+	if kind == "" || slices.Contains(model.TrashKinds, kind) {
+		return nil
+	}
+
+	return ErrInvalidTrashKind
+}
+
+func TrashEntryValidation(kind, id string) error {
+	if !slices.Contains(model.TrashKinds, kind) {
+		return ErrInvalidTrashKind
+	}
+
+	switch kind {
+	case model.TrashItem:
+		if _, err := uuid.Parse(id); err != nil {
+			return ErrInvalidTrashID
+		}
+	case model.TrashOrder:
+		if _, err := strconv.ParseUint(id, 10, 64); err != nil {
+			return ErrInvalidTrashID
+		}
+	default:
+		if id == "" {
+			return ErrInvalidTrashID
+		}
+	}
+
+	return nil
+}
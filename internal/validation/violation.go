@@ -0,0 +1,96 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"github.com/JMURv/par-pro/products/internal/apperr"
+	"strings"
+)
+
+// Codes of the violations, clients may rely on them, so they never change once released.
+const (
+	CodeRequired      = "required"
+	CodeInvalid       = "invalid"
+	CodeInvalidFormat = "invalid_format"
+	CodeDuplicate     = "duplicate"
+	CodeOutOfRange    = "out_of_range"
+	CodeNotInFuture   = "not_in_future"
+	CodeUnknownField  = "unknown_field"
+)
+
+// Violation is a single failed check of a request field.
+// Field is a path into the request body, e.g. attributes[2].value.
+type Violation struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+
+	err error
+}
+
+func (v *Violation) Error() string {
+	return v.Message
+}
+
+func (v *Violation) Unwrap() error {
+	return v.err
+}
+
+// Errors holds every violation found in a request, errors.Is matches the sentinels behind them.
+type Errors []*Violation
+
+func (e Errors) Error() string {
+	msgs := make([]string, len(e))
+	for i, v := range e {
+		msgs[i] = v.Message
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e Errors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, v := range e {
+		errs[i] = v
+	}
+	return errs
+}
+
+func (e Errors) Kind() apperr.Kind {
+	return apperr.Validation
+}
+
+// Violations returns the violations to be rendered in the error body.
+func (e Errors) Violations() any {
+	return []*Violation(e)
+}
+
+// violations collects the violations of a request while it is being checked.
+type violations struct {
+	errs Errors
+}
+
+func (v *violations) add(field, code string, err error) {
+	v.errs = append(v.errs, &Violation{Field: field, Code: code, Message: err.Error(), err: err})
+}
+
+// merge adds the violations found by a nested check.
+func (v *violations) merge(err error) {
+	var errs Errors
+	if errors.As(err, &errs) {
+		v.errs = append(v.errs, errs...)
+	} else if err != nil {
+		v.add("", CodeInvalid, err)
+	}
+}
+
+func (v *violations) err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+// index returns the path of the element i of the list field.
+func index(field string, i int) string {
+	return fmt.Sprintf("%v[%d]", field, i)
+}
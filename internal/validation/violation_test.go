@@ -0,0 +1,109 @@
+package validation
+
+import (
+	"errors"
+	"github.com/JMURv/par-pro/products/internal/apperr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestViolations(t *testing.T) {
+	nested := &violations{}
+	nested.add("attributes[0].name", CodeRequired, ErrMissingName)
+
+	tests := []struct {
+		name     string
+		collect  func(v *violations)
+		expected Errors
+	}{
+		{
+			name:    "Empty",
+			collect: func(v *violations) {},
+		},
+		{
+			name: "Add",
+			collect: func(v *violations) {
+				v.add("title", CodeRequired, ErrMissingTitle)
+				v.add(index("order_items", 2)+".quantity", CodeOutOfRange, ErrInvalidQuantity)
+			},
+			expected: Errors{
+				{Field: "title", Code: CodeRequired, Message: ErrMissingTitle.Error(), err: ErrMissingTitle},
+				{Field: "order_items[2].quantity", Code: CodeOutOfRange, Message: ErrInvalidQuantity.Error(), err: ErrInvalidQuantity},
+			},
+		},
+		{
+			name: "MergeViolations",
+			collect: func(v *violations) {
+				v.add("title", CodeRequired, ErrMissingTitle)
+				v.merge(nested.err())
+			},
+			expected: Errors{
+				{Field: "title", Code: CodeRequired, Message: ErrMissingTitle.Error(), err: ErrMissingTitle},
+				{Field: "attributes[0].name", Code: CodeRequired, Message: ErrMissingName.Error(), err: ErrMissingName},
+			},
+		},
+		{
+			name: "MergePlainError",
+			collect: func(v *violations) {
+				v.merge(ErrMissingSrc)
+			},
+			expected: Errors{
+				{Field: "", Code: CodeInvalid, Message: ErrMissingSrc.Error(), err: ErrMissingSrc},
+			},
+		},
+		{
+			name: "MergeNil",
+			collect: func(v *violations) {
+				v.merge(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				v := &violations{}
+				tt.collect(v)
+
+				err := v.err()
+				if tt.expected == nil {
+					assert.NoError(t, err)
+					return
+				}
+
+				var errs Errors
+				require.True(t, errors.As(err, &errs))
+				assert.Equal(t, tt.expected, errs)
+			},
+		)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	v := &violations{}
+	v.add("tel", CodeRequired, ErrMissingTel)
+	v.add("email", CodeInvalidFormat, ErrInvalidEmail)
+	err := v.err()
+
+	tests := []struct {
+		name     string
+		target   error
+		expected bool
+	}{
+		{name: "FirstSentinel", target: ErrMissingTel, expected: true},
+		{name: "SecondSentinel", target: ErrInvalidEmail, expected: true},
+		{name: "OtherSentinel", target: ErrMissingEmail, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.expected, errors.Is(err, tt.target))
+			},
+		)
+	}
+
+	assert.Equal(t, ErrMissingTel.Error()+"; "+ErrInvalidEmail.Error(), err.Error())
+	assert.Equal(t, apperr.Validation, apperr.KindOf(err))
+}
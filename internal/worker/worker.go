@@ -0,0 +1,47 @@
+package worker
+
+import (
+	"context"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"go.uber.org/zap"
+	"time"
+)
+
+type Job func(ctx context.Context) error
+
+// Worker runs a job on a fixed interval until its context is cancelled.
+type Worker struct {
+	name     string
+	interval time.Duration
+	job      Job
+}
+
+func New(name string, interval time.Duration, job Job) *Worker {
+	if interval <= 0 {
+		interval = consts.DefaultJobInterval
+	}
+
+	return &Worker{
+		name:     name,
+		interval: interval,
+		job:      job,
+	}
+}
+
+func (w *Worker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	zap.L().Info("Starting worker", zap.String("name", w.name), zap.Duration("interval", w.interval))
+	for {
+		select {
+		case <-ctx.Done():
+			zap.L().Info("Stopping worker", zap.String("name", w.name))
+			return
+		case <-ticker.C:
+			if err := w.job(ctx); err != nil {
+				zap.L().Debug("worker job failed", zap.String("name", w.name), zap.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package worker
+
+import (
+	"context"
+	"github.com/JMURv/par-pro/products/pkg/consts"
+	"github.com/stretchr/testify/assert"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWorker_Start(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var calls atomic.Int32
+	w := New(
+		"test", time.Millisecond, func(ctx context.Context) error {
+			if calls.Add(1) == 3 {
+				cancel()
+			}
+			return nil
+		},
+	)
+
+	done := make(chan struct{})
+	go func() {
+		w.Start(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("worker did not stop after context cancellation")
+	}
+	assert.GreaterOrEqual(t, calls.Load(), int32(3))
+}
+
+func TestNew_DefaultInterval(t *testing.T) {
+	w := New("test", 0, func(ctx context.Context) error { return nil })
+	assert.Equal(t, consts.DefaultJobInterval, w.interval)
+}
@@ -56,6 +56,21 @@ func (mr *MockCtrlMockRecorder) AddToFavorites(ctx, uid, itemID any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToFavorites", reflect.TypeOf((*MockCtrl)(nil).AddToFavorites), ctx, uid, itemID)
 }
 
+// BeginIdempotent mocks base method.
+func (m *MockCtrl) BeginIdempotent(ctx context.Context, key, hash string) (*model.IdempotencyRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginIdempotent", ctx, key, hash)
+	ret0, _ := ret[0].(*model.IdempotencyRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginIdempotent indicates an expected call of BeginIdempotent.
+func (mr *MockCtrlMockRecorder) BeginIdempotent(ctx, key, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginIdempotent", reflect.TypeOf((*MockCtrl)(nil).BeginIdempotent), ctx, key, hash)
+}
+
 // CancelOrder mocks base method.
 func (m *MockCtrl) CancelOrder(ctx context.Context, orderID uint64) error {
 	m.ctrl.T.Helper()
@@ -70,6 +85,20 @@ func (mr *MockCtrlMockRecorder) CancelOrder(ctx, orderID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOrder", reflect.TypeOf((*MockCtrl)(nil).CancelOrder), ctx, orderID)
 }
 
+// CancelScheduledPrice mocks base method.
+func (m *MockCtrl) CancelScheduledPrice(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelScheduledPrice", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelScheduledPrice indicates an expected call of CancelScheduledPrice.
+func (mr *MockCtrlMockRecorder) CancelScheduledPrice(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduledPrice", reflect.TypeOf((*MockCtrl)(nil).CancelScheduledPrice), ctx, id)
+}
+
 // CategoryFiltersSearch mocks base method.
 func (m *MockCtrl) CategoryFiltersSearch(ctx context.Context, query string, page, size int) (*model.PaginatedFilterData, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +129,50 @@ func (mr *MockCtrlMockRecorder) CategorySearch(ctx, query, page, size any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CategorySearch", reflect.TypeOf((*MockCtrl)(nil).CategorySearch), ctx, query, page, size)
 }
 
+// CompleteIdempotent mocks base method.
+func (m *MockCtrl) CompleteIdempotent(ctx context.Context, key, hash string, status int, response []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteIdempotent", ctx, key, hash, status, response)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteIdempotent indicates an expected call of CompleteIdempotent.
+func (mr *MockCtrlMockRecorder) CompleteIdempotent(ctx, key, hash, status, response any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteIdempotent", reflect.TypeOf((*MockCtrl)(nil).CompleteIdempotent), ctx, key, hash, status, response)
+}
+
+// CreateAnswer mocks base method.
+func (m *MockCtrl) CreateAnswer(ctx context.Context, req *model.Answer) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAnswer", ctx, req)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAnswer indicates an expected call of CreateAnswer.
+func (mr *MockCtrlMockRecorder) CreateAnswer(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAnswer", reflect.TypeOf((*MockCtrl)(nil).CreateAnswer), ctx, req)
+}
+
+// CreateAttribute mocks base method.
+func (m *MockCtrl) CreateAttribute(ctx context.Context, a *model.Attribute) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAttribute", ctx, a)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAttribute indicates an expected call of CreateAttribute.
+func (mr *MockCtrlMockRecorder) CreateAttribute(ctx, a any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttribute", reflect.TypeOf((*MockCtrl)(nil).CreateAttribute), ctx, a)
+}
+
 // CreateCategory mocks base method.
 func (m *MockCtrl) CreateCategory(ctx context.Context, category *model.Category) (string, error) {
 	m.ctrl.T.Helper()
@@ -115,6 +188,36 @@ func (mr *MockCtrlMockRecorder) CreateCategory(ctx, category any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockCtrl)(nil).CreateCategory), ctx, category)
 }
 
+// CreateCustomerGroup mocks base method.
+func (m *MockCtrl) CreateCustomerGroup(ctx context.Context, g *model.CustomerGroup) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomerGroup", ctx, g)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCustomerGroup indicates an expected call of CreateCustomerGroup.
+func (mr *MockCtrlMockRecorder) CreateCustomerGroup(ctx, g any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomerGroup", reflect.TypeOf((*MockCtrl)(nil).CreateCustomerGroup), ctx, g)
+}
+
+// CreateFavoriteCollection mocks base method.
+func (m *MockCtrl) CreateFavoriteCollection(ctx context.Context, uid uuid.UUID, req *model.FavoriteCollection) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFavoriteCollection", ctx, uid, req)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFavoriteCollection indicates an expected call of CreateFavoriteCollection.
+func (mr *MockCtrlMockRecorder) CreateFavoriteCollection(ctx, uid, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFavoriteCollection", reflect.TypeOf((*MockCtrl)(nil).CreateFavoriteCollection), ctx, uid, req)
+}
+
 // CreateItem mocks base method.
 func (m *MockCtrl) CreateItem(ctx context.Context, i *model.Item) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +233,21 @@ func (mr *MockCtrlMockRecorder) CreateItem(ctx, i any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateItem", reflect.TypeOf((*MockCtrl)(nil).CreateItem), ctx, i)
 }
 
+// CreateLabel mocks base method.
+func (m *MockCtrl) CreateLabel(ctx context.Context, l *model.Label) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLabel", ctx, l)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLabel indicates an expected call of CreateLabel.
+func (mr *MockCtrlMockRecorder) CreateLabel(ctx, l any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLabel", reflect.TypeOf((*MockCtrl)(nil).CreateLabel), ctx, l)
+}
+
 // CreateOrder mocks base method.
 func (m *MockCtrl) CreateOrder(ctx context.Context, uid uuid.UUID, req *model.Order) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -145,6 +263,21 @@ func (mr *MockCtrlMockRecorder) CreateOrder(ctx, uid, req any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockCtrl)(nil).CreateOrder), ctx, uid, req)
 }
 
+// CreatePriceList mocks base method.
+func (m *MockCtrl) CreatePriceList(ctx context.Context, pl *model.PriceList) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePriceList", ctx, pl)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePriceList indicates an expected call of CreatePriceList.
+func (mr *MockCtrlMockRecorder) CreatePriceList(ctx, pl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePriceList", reflect.TypeOf((*MockCtrl)(nil).CreatePriceList), ctx, pl)
+}
+
 // CreatePromotion mocks base method.
 func (m *MockCtrl) CreatePromotion(ctx context.Context, p *model.Promotion) (string, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +293,64 @@ func (mr *MockCtrlMockRecorder) CreatePromotion(ctx, p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromotion", reflect.TypeOf((*MockCtrl)(nil).CreatePromotion), ctx, p)
 }
 
+// CreateQuestion mocks base method.
+func (m *MockCtrl) CreateQuestion(ctx context.Context, req *model.Question) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateQuestion", ctx, req)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateQuestion indicates an expected call of CreateQuestion.
+func (mr *MockCtrlMockRecorder) CreateQuestion(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQuestion", reflect.TypeOf((*MockCtrl)(nil).CreateQuestion), ctx, req)
+}
+
+// CreateReview mocks base method.
+func (m *MockCtrl) CreateReview(ctx context.Context, req *model.Review) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReview", ctx, req)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReview indicates an expected call of CreateReview.
+func (mr *MockCtrlMockRecorder) CreateReview(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReview", reflect.TypeOf((*MockCtrl)(nil).CreateReview), ctx, req)
+}
+
+// DeleteAnswer mocks base method.
+func (m *MockCtrl) DeleteAnswer(ctx context.Context, id uint64, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAnswer", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAnswer indicates an expected call of DeleteAnswer.
+func (mr *MockCtrlMockRecorder) DeleteAnswer(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAnswer", reflect.TypeOf((*MockCtrl)(nil).DeleteAnswer), ctx, id, userID)
+}
+
+// DeleteAttribute mocks base method.
+func (m *MockCtrl) DeleteAttribute(ctx context.Context, slug string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttribute", ctx, slug)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttribute indicates an expected call of DeleteAttribute.
+func (mr *MockCtrlMockRecorder) DeleteAttribute(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttribute", reflect.TypeOf((*MockCtrl)(nil).DeleteAttribute), ctx, slug)
+}
+
 // DeleteCategory mocks base method.
 func (m *MockCtrl) DeleteCategory(ctx context.Context, slug string) error {
 	m.ctrl.T.Helper()
@@ -174,6 +365,34 @@ func (mr *MockCtrlMockRecorder) DeleteCategory(ctx, slug any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockCtrl)(nil).DeleteCategory), ctx, slug)
 }
 
+// DeleteCustomerGroup mocks base method.
+func (m *MockCtrl) DeleteCustomerGroup(ctx context.Context, slug string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomerGroup", ctx, slug)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCustomerGroup indicates an expected call of DeleteCustomerGroup.
+func (mr *MockCtrlMockRecorder) DeleteCustomerGroup(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomerGroup", reflect.TypeOf((*MockCtrl)(nil).DeleteCustomerGroup), ctx, slug)
+}
+
+// DeleteFavoriteCollection mocks base method.
+func (m *MockCtrl) DeleteFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFavoriteCollection", ctx, uid, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFavoriteCollection indicates an expected call of DeleteFavoriteCollection.
+func (mr *MockCtrlMockRecorder) DeleteFavoriteCollection(ctx, uid, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFavoriteCollection", reflect.TypeOf((*MockCtrl)(nil).DeleteFavoriteCollection), ctx, uid, id)
+}
+
 // DeleteItem mocks base method.
 func (m *MockCtrl) DeleteItem(ctx context.Context, uid uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -188,6 +407,76 @@ func (mr *MockCtrlMockRecorder) DeleteItem(ctx, uid any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItem", reflect.TypeOf((*MockCtrl)(nil).DeleteItem), ctx, uid)
 }
 
+// DeleteItemMedia mocks base method.
+func (m *MockCtrl) DeleteItemMedia(ctx context.Context, itemID uuid.UUID, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteItemMedia", ctx, itemID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteItemMedia indicates an expected call of DeleteItemMedia.
+func (mr *MockCtrlMockRecorder) DeleteItemMedia(ctx, itemID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItemMedia", reflect.TypeOf((*MockCtrl)(nil).DeleteItemMedia), ctx, itemID, id)
+}
+
+// DeleteLabel mocks base method.
+func (m *MockCtrl) DeleteLabel(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLabel", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLabel indicates an expected call of DeleteLabel.
+func (mr *MockCtrlMockRecorder) DeleteLabel(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLabel", reflect.TypeOf((*MockCtrl)(nil).DeleteLabel), ctx, name)
+}
+
+// DeleteOrder mocks base method.
+func (m *MockCtrl) DeleteOrder(ctx context.Context, orderID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrder", ctx, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOrder indicates an expected call of DeleteOrder.
+func (mr *MockCtrlMockRecorder) DeleteOrder(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrder", reflect.TypeOf((*MockCtrl)(nil).DeleteOrder), ctx, orderID)
+}
+
+// DeletePriceList mocks base method.
+func (m *MockCtrl) DeletePriceList(ctx context.Context, slug string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePriceList", ctx, slug)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePriceList indicates an expected call of DeletePriceList.
+func (mr *MockCtrlMockRecorder) DeletePriceList(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePriceList", reflect.TypeOf((*MockCtrl)(nil).DeletePriceList), ctx, slug)
+}
+
+// DeletePriceListItem mocks base method.
+func (m *MockCtrl) DeletePriceListItem(ctx context.Context, slug string, itemID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePriceListItem", ctx, slug, itemID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePriceListItem indicates an expected call of DeletePriceListItem.
+func (mr *MockCtrlMockRecorder) DeletePriceListItem(ctx, slug, itemID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePriceListItem", reflect.TypeOf((*MockCtrl)(nil).DeletePriceListItem), ctx, slug, itemID)
+}
+
 // DeletePromotion mocks base method.
 func (m *MockCtrl) DeletePromotion(ctx context.Context, slug string) error {
 	m.ctrl.T.Helper()
@@ -202,6 +491,79 @@ func (mr *MockCtrlMockRecorder) DeletePromotion(ctx, slug any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePromotion", reflect.TypeOf((*MockCtrl)(nil).DeletePromotion), ctx, slug)
 }
 
+// DeleteQuestion mocks base method.
+func (m *MockCtrl) DeleteQuestion(ctx context.Context, id uint64, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQuestion", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteQuestion indicates an expected call of DeleteQuestion.
+func (mr *MockCtrlMockRecorder) DeleteQuestion(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQuestion", reflect.TypeOf((*MockCtrl)(nil).DeleteQuestion), ctx, id, userID)
+}
+
+// DeleteReview mocks base method.
+func (m *MockCtrl) DeleteReview(ctx context.Context, id uint64, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReview", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReview indicates an expected call of DeleteReview.
+func (mr *MockCtrlMockRecorder) DeleteReview(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReview", reflect.TypeOf((*MockCtrl)(nil).DeleteReview), ctx, id, userID)
+}
+
+// DiffItemRevisions mocks base method.
+func (m *MockCtrl) DiffItemRevisions(ctx context.Context, uid uuid.UUID, from, to uint64) (*model.ItemRevisionDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffItemRevisions", ctx, uid, from, to)
+	ret0, _ := ret[0].(*model.ItemRevisionDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffItemRevisions indicates an expected call of DiffItemRevisions.
+func (mr *MockCtrlMockRecorder) DiffItemRevisions(ctx, uid, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffItemRevisions", reflect.TypeOf((*MockCtrl)(nil).DiffItemRevisions), ctx, uid, from, to)
+}
+
+// GetAttribute mocks base method.
+func (m *MockCtrl) GetAttribute(ctx context.Context, slug string) (*model.Attribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttribute", ctx, slug)
+	ret0, _ := ret[0].(*model.Attribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttribute indicates an expected call of GetAttribute.
+func (mr *MockCtrlMockRecorder) GetAttribute(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttribute", reflect.TypeOf((*MockCtrl)(nil).GetAttribute), ctx, slug)
+}
+
+// GetAttributeSchema mocks base method.
+func (m *MockCtrl) GetAttributeSchema(ctx context.Context, slugs []string) ([]*model.CategoryAttribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttributeSchema", ctx, slugs)
+	ret0, _ := ret[0].([]*model.CategoryAttribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttributeSchema indicates an expected call of GetAttributeSchema.
+func (mr *MockCtrlMockRecorder) GetAttributeSchema(ctx, slugs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttributeSchema", reflect.TypeOf((*MockCtrl)(nil).GetAttributeSchema), ctx, slugs)
+}
+
 // GetCategoryBySlug mocks base method.
 func (m *MockCtrl) GetCategoryBySlug(ctx context.Context, slug string) (*model.Category, error) {
 	m.ctrl.T.Helper()
@@ -217,6 +579,36 @@ func (mr *MockCtrlMockRecorder) GetCategoryBySlug(ctx, slug any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryBySlug", reflect.TypeOf((*MockCtrl)(nil).GetCategoryBySlug), ctx, slug)
 }
 
+// GetCustomerGroup mocks base method.
+func (m *MockCtrl) GetCustomerGroup(ctx context.Context, slug string) (*model.CustomerGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomerGroup", ctx, slug)
+	ret0, _ := ret[0].(*model.CustomerGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomerGroup indicates an expected call of GetCustomerGroup.
+func (mr *MockCtrlMockRecorder) GetCustomerGroup(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerGroup", reflect.TypeOf((*MockCtrl)(nil).GetCustomerGroup), ctx, slug)
+}
+
+// GetFavoriteCollection mocks base method.
+func (m *MockCtrl) GetFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64) (*model.FavoriteCollection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFavoriteCollection", ctx, uid, id)
+	ret0, _ := ret[0].(*model.FavoriteCollection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFavoriteCollection indicates an expected call of GetFavoriteCollection.
+func (mr *MockCtrlMockRecorder) GetFavoriteCollection(ctx, uid, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoriteCollection", reflect.TypeOf((*MockCtrl)(nil).GetFavoriteCollection), ctx, uid, id)
+}
+
 // GetItemByUUID mocks base method.
 func (m *MockCtrl) GetItemByUUID(ctx context.Context, uid uuid.UUID) (*model.Item, error) {
 	m.ctrl.T.Helper()
@@ -247,6 +639,36 @@ func (mr *MockCtrlMockRecorder) GetOrder(ctx, orderID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrder", reflect.TypeOf((*MockCtrl)(nil).GetOrder), ctx, orderID)
 }
 
+// GetPriceList mocks base method.
+func (m *MockCtrl) GetPriceList(ctx context.Context, slug string) (*model.PriceList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceList", ctx, slug)
+	ret0, _ := ret[0].(*model.PriceList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceList indicates an expected call of GetPriceList.
+func (mr *MockCtrlMockRecorder) GetPriceList(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceList", reflect.TypeOf((*MockCtrl)(nil).GetPriceList), ctx, slug)
+}
+
+// GetPriceTimeline mocks base method.
+func (m *MockCtrl) GetPriceTimeline(ctx context.Context, uid uuid.UUID) (*model.PriceTimeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceTimeline", ctx, uid)
+	ret0, _ := ret[0].(*model.PriceTimeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceTimeline indicates an expected call of GetPriceTimeline.
+func (mr *MockCtrlMockRecorder) GetPriceTimeline(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceTimeline", reflect.TypeOf((*MockCtrl)(nil).GetPriceTimeline), ctx, uid)
+}
+
 // GetPromotion mocks base method.
 func (m *MockCtrl) GetPromotion(ctx context.Context, slug string) (*model.Promotion, error) {
 	m.ctrl.T.Helper()
@@ -262,6 +684,21 @@ func (mr *MockCtrlMockRecorder) GetPromotion(ctx, slug any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromotion", reflect.TypeOf((*MockCtrl)(nil).GetPromotion), ctx, slug)
 }
 
+// GetSharedFavoriteCollection mocks base method.
+func (m *MockCtrl) GetSharedFavoriteCollection(ctx context.Context, token string) (*model.FavoriteCollection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSharedFavoriteCollection", ctx, token)
+	ret0, _ := ret[0].(*model.FavoriteCollection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSharedFavoriteCollection indicates an expected call of GetSharedFavoriteCollection.
+func (mr *MockCtrlMockRecorder) GetSharedFavoriteCollection(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedFavoriteCollection", reflect.TypeOf((*MockCtrl)(nil).GetSharedFavoriteCollection), ctx, token)
+}
+
 // ItemAttrSearch mocks base method.
 func (m *MockCtrl) ItemAttrSearch(ctx context.Context, query string, size, page int) (*model.PaginatedItemAttrData, error) {
 	m.ctrl.T.Helper()
@@ -292,22 +729,67 @@ func (mr *MockCtrlMockRecorder) ItemSearch(ctx, query, page, size any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ItemSearch", reflect.TypeOf((*MockCtrl)(nil).ItemSearch), ctx, query, page, size)
 }
 
-// ListCategories mocks base method.
-func (m *MockCtrl) ListCategories(ctx context.Context, page, size int) (*model.PaginatedCategoryData, error) {
+// ListAttributes mocks base method.
+func (m *MockCtrl) ListAttributes(ctx context.Context) ([]*model.Attribute, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListCategories", ctx, page, size)
-	ret0, _ := ret[0].(*model.PaginatedCategoryData)
+	ret := m.ctrl.Call(m, "ListAttributes", ctx)
+	ret0, _ := ret[0].([]*model.Attribute)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListCategories indicates an expected call of ListCategories.
-func (mr *MockCtrlMockRecorder) ListCategories(ctx, page, size any) *gomock.Call {
+// ListAttributes indicates an expected call of ListAttributes.
+func (mr *MockCtrlMockRecorder) ListAttributes(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategories", reflect.TypeOf((*MockCtrl)(nil).ListCategories), ctx, page, size)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttributes", reflect.TypeOf((*MockCtrl)(nil).ListAttributes), ctx)
 }
 
-// ListCategoryFilters mocks base method.
+// ListAuditLog mocks base method.
+func (m *MockCtrl) ListAuditLog(ctx context.Context, f *model.AuditFilter, page, size int) (*model.PaginatedAuditData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditLog", ctx, f, page, size)
+	ret0, _ := ret[0].(*model.PaginatedAuditData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditLog indicates an expected call of ListAuditLog.
+func (mr *MockCtrlMockRecorder) ListAuditLog(ctx, f, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditLog", reflect.TypeOf((*MockCtrl)(nil).ListAuditLog), ctx, f, page, size)
+}
+
+// ListCategories mocks base method.
+func (m *MockCtrl) ListCategories(ctx context.Context, page, size int) (*model.PaginatedCategoryData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCategories", ctx, page, size)
+	ret0, _ := ret[0].(*model.PaginatedCategoryData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategories indicates an expected call of ListCategories.
+func (mr *MockCtrlMockRecorder) ListCategories(ctx, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategories", reflect.TypeOf((*MockCtrl)(nil).ListCategories), ctx, page, size)
+}
+
+// ListCategoryAttributes mocks base method.
+func (m *MockCtrl) ListCategoryAttributes(ctx context.Context, slug string) ([]*model.CategoryAttribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCategoryAttributes", ctx, slug)
+	ret0, _ := ret[0].([]*model.CategoryAttribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategoryAttributes indicates an expected call of ListCategoryAttributes.
+func (mr *MockCtrlMockRecorder) ListCategoryAttributes(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategoryAttributes", reflect.TypeOf((*MockCtrl)(nil).ListCategoryAttributes), ctx, slug)
+}
+
+// ListCategoryFilters mocks base method.
 func (m *MockCtrl) ListCategoryFilters(ctx context.Context, slug string) ([]*model.Filter, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListCategoryFilters", ctx, slug)
@@ -337,19 +819,109 @@ func (mr *MockCtrlMockRecorder) ListCategoryItems(ctx, slug, page, size, filters
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategoryItems", reflect.TypeOf((*MockCtrl)(nil).ListCategoryItems), ctx, slug, page, size, filters, sort)
 }
 
+// ListCustomerGroups mocks base method.
+func (m *MockCtrl) ListCustomerGroups(ctx context.Context) ([]*model.CustomerGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomerGroups", ctx)
+	ret0, _ := ret[0].([]*model.CustomerGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomerGroups indicates an expected call of ListCustomerGroups.
+func (mr *MockCtrlMockRecorder) ListCustomerGroups(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomerGroups", reflect.TypeOf((*MockCtrl)(nil).ListCustomerGroups), ctx)
+}
+
+// ListFavoriteCollections mocks base method.
+func (m *MockCtrl) ListFavoriteCollections(ctx context.Context, uid uuid.UUID) ([]*model.FavoriteCollection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFavoriteCollections", ctx, uid)
+	ret0, _ := ret[0].([]*model.FavoriteCollection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFavoriteCollections indicates an expected call of ListFavoriteCollections.
+func (mr *MockCtrlMockRecorder) ListFavoriteCollections(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFavoriteCollections", reflect.TypeOf((*MockCtrl)(nil).ListFavoriteCollections), ctx, uid)
+}
+
 // ListFavorites mocks base method.
-func (m *MockCtrl) ListFavorites(ctx context.Context, uid uuid.UUID) ([]*model.Favorite, error) {
+func (m *MockCtrl) ListFavorites(ctx context.Context, uid uuid.UUID, page, size int, sort string) (*model.PaginatedFavoritesData, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListFavorites", ctx, uid)
-	ret0, _ := ret[0].([]*model.Favorite)
+	ret := m.ctrl.Call(m, "ListFavorites", ctx, uid, page, size, sort)
+	ret0, _ := ret[0].(*model.PaginatedFavoritesData)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListFavorites indicates an expected call of ListFavorites.
-func (mr *MockCtrlMockRecorder) ListFavorites(ctx, uid any) *gomock.Call {
+func (mr *MockCtrlMockRecorder) ListFavorites(ctx, uid, page, size, sort any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFavorites", reflect.TypeOf((*MockCtrl)(nil).ListFavorites), ctx, uid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFavorites", reflect.TypeOf((*MockCtrl)(nil).ListFavorites), ctx, uid, page, size, sort)
+}
+
+// ListItemMedia mocks base method.
+func (m *MockCtrl) ListItemMedia(ctx context.Context, itemID uuid.UUID) ([]*model.ItemMedia, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListItemMedia", ctx, itemID)
+	ret0, _ := ret[0].([]*model.ItemMedia)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListItemMedia indicates an expected call of ListItemMedia.
+func (mr *MockCtrlMockRecorder) ListItemMedia(ctx, itemID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItemMedia", reflect.TypeOf((*MockCtrl)(nil).ListItemMedia), ctx, itemID)
+}
+
+// ListItemQuestions mocks base method.
+func (m *MockCtrl) ListItemQuestions(ctx context.Context, uid uuid.UUID, page, size int) (*model.PaginatedQuestionsData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListItemQuestions", ctx, uid, page, size)
+	ret0, _ := ret[0].(*model.PaginatedQuestionsData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListItemQuestions indicates an expected call of ListItemQuestions.
+func (mr *MockCtrlMockRecorder) ListItemQuestions(ctx, uid, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItemQuestions", reflect.TypeOf((*MockCtrl)(nil).ListItemQuestions), ctx, uid, page, size)
+}
+
+// ListItemReviews mocks base method.
+func (m *MockCtrl) ListItemReviews(ctx context.Context, uid uuid.UUID, page, size int) (*model.PaginatedReviewsData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListItemReviews", ctx, uid, page, size)
+	ret0, _ := ret[0].(*model.PaginatedReviewsData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListItemReviews indicates an expected call of ListItemReviews.
+func (mr *MockCtrlMockRecorder) ListItemReviews(ctx, uid, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItemReviews", reflect.TypeOf((*MockCtrl)(nil).ListItemReviews), ctx, uid, page, size)
+}
+
+// ListItemRevisions mocks base method.
+func (m *MockCtrl) ListItemRevisions(ctx context.Context, uid uuid.UUID, page, size int) (*model.PaginatedItemRevisionsData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListItemRevisions", ctx, uid, page, size)
+	ret0, _ := ret[0].(*model.PaginatedItemRevisionsData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListItemRevisions indicates an expected call of ListItemRevisions.
+func (mr *MockCtrlMockRecorder) ListItemRevisions(ctx, uid, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItemRevisions", reflect.TypeOf((*MockCtrl)(nil).ListItemRevisions), ctx, uid, page, size)
 }
 
 // ListItems mocks base method.
@@ -382,6 +954,21 @@ func (mr *MockCtrlMockRecorder) ListItemsByLabel(ctx, label, page, size any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItemsByLabel", reflect.TypeOf((*MockCtrl)(nil).ListItemsByLabel), ctx, label, page, size)
 }
 
+// ListLabels mocks base method.
+func (m *MockCtrl) ListLabels(ctx context.Context) ([]*model.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLabels", ctx)
+	ret0, _ := ret[0].([]*model.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLabels indicates an expected call of ListLabels.
+func (mr *MockCtrlMockRecorder) ListLabels(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLabels", reflect.TypeOf((*MockCtrl)(nil).ListLabels), ctx)
+}
+
 // ListOrders mocks base method.
 func (m *MockCtrl) ListOrders(ctx context.Context, page, size int, filters map[string]any, sort string) (*model.PaginatedOrderData, error) {
 	m.ctrl.T.Helper()
@@ -397,6 +984,81 @@ func (mr *MockCtrlMockRecorder) ListOrders(ctx, page, size, filters, sort any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrders", reflect.TypeOf((*MockCtrl)(nil).ListOrders), ctx, page, size, filters, sort)
 }
 
+// ListPendingAnswers mocks base method.
+func (m *MockCtrl) ListPendingAnswers(ctx context.Context, page, size int) (*model.PaginatedAnswersData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingAnswers", ctx, page, size)
+	ret0, _ := ret[0].(*model.PaginatedAnswersData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingAnswers indicates an expected call of ListPendingAnswers.
+func (mr *MockCtrlMockRecorder) ListPendingAnswers(ctx, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingAnswers", reflect.TypeOf((*MockCtrl)(nil).ListPendingAnswers), ctx, page, size)
+}
+
+// ListPendingQuestions mocks base method.
+func (m *MockCtrl) ListPendingQuestions(ctx context.Context, page, size int) (*model.PaginatedQuestionsData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingQuestions", ctx, page, size)
+	ret0, _ := ret[0].(*model.PaginatedQuestionsData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingQuestions indicates an expected call of ListPendingQuestions.
+func (mr *MockCtrlMockRecorder) ListPendingQuestions(ctx, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingQuestions", reflect.TypeOf((*MockCtrl)(nil).ListPendingQuestions), ctx, page, size)
+}
+
+// ListPendingReviews mocks base method.
+func (m *MockCtrl) ListPendingReviews(ctx context.Context, page, size int) (*model.PaginatedReviewsData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingReviews", ctx, page, size)
+	ret0, _ := ret[0].(*model.PaginatedReviewsData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingReviews indicates an expected call of ListPendingReviews.
+func (mr *MockCtrlMockRecorder) ListPendingReviews(ctx, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingReviews", reflect.TypeOf((*MockCtrl)(nil).ListPendingReviews), ctx, page, size)
+}
+
+// ListPriceListItems mocks base method.
+func (m *MockCtrl) ListPriceListItems(ctx context.Context, slug string, page, size int) (*model.PaginatedPriceListItemsData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPriceListItems", ctx, slug, page, size)
+	ret0, _ := ret[0].(*model.PaginatedPriceListItemsData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPriceListItems indicates an expected call of ListPriceListItems.
+func (mr *MockCtrlMockRecorder) ListPriceListItems(ctx, slug, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPriceListItems", reflect.TypeOf((*MockCtrl)(nil).ListPriceListItems), ctx, slug, page, size)
+}
+
+// ListPriceLists mocks base method.
+func (m *MockCtrl) ListPriceLists(ctx context.Context) ([]*model.PriceList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPriceLists", ctx)
+	ret0, _ := ret[0].([]*model.PriceList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPriceLists indicates an expected call of ListPriceLists.
+func (mr *MockCtrlMockRecorder) ListPriceLists(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPriceLists", reflect.TypeOf((*MockCtrl)(nil).ListPriceLists), ctx)
+}
+
 // ListPromotionItems mocks base method.
 func (m *MockCtrl) ListPromotionItems(ctx context.Context, slug string, page, size int) (*model.PaginatedPromoItemsData, error) {
 	m.ctrl.T.Helper()
@@ -427,6 +1089,21 @@ func (mr *MockCtrlMockRecorder) ListPromotions(ctx, page, size any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPromotions", reflect.TypeOf((*MockCtrl)(nil).ListPromotions), ctx, page, size)
 }
 
+// ListQuantityBreaks mocks base method.
+func (m *MockCtrl) ListQuantityBreaks(ctx context.Context, itemID uuid.UUID) ([]model.QuantityBreak, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQuantityBreaks", ctx, itemID)
+	ret0, _ := ret[0].([]model.QuantityBreak)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQuantityBreaks indicates an expected call of ListQuantityBreaks.
+func (mr *MockCtrlMockRecorder) ListQuantityBreaks(ctx, itemID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQuantityBreaks", reflect.TypeOf((*MockCtrl)(nil).ListQuantityBreaks), ctx, itemID)
+}
+
 // ListRelatedItems mocks base method.
 func (m *MockCtrl) ListRelatedItems(ctx context.Context, uid uuid.UUID) ([]*model.RelatedProduct, error) {
 	m.ctrl.T.Helper()
@@ -442,6 +1119,21 @@ func (mr *MockCtrlMockRecorder) ListRelatedItems(ctx, uid any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRelatedItems", reflect.TypeOf((*MockCtrl)(nil).ListRelatedItems), ctx, uid)
 }
 
+// ListTrash mocks base method.
+func (m *MockCtrl) ListTrash(ctx context.Context, kind string, page, size int) (*model.PaginatedTrashData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrash", ctx, kind, page, size)
+	ret0, _ := ret[0].(*model.PaginatedTrashData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrash indicates an expected call of ListTrash.
+func (mr *MockCtrlMockRecorder) ListTrash(ctx, kind, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrash", reflect.TypeOf((*MockCtrl)(nil).ListTrash), ctx, kind, page, size)
+}
+
 // ListUserOrders mocks base method.
 func (m *MockCtrl) ListUserOrders(ctx context.Context, uid uuid.UUID, page, size int) (*model.PaginatedOrderData, error) {
 	m.ctrl.T.Helper()
@@ -457,6 +1149,118 @@ func (mr *MockCtrlMockRecorder) ListUserOrders(ctx, uid, page, size any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserOrders", reflect.TypeOf((*MockCtrl)(nil).ListUserOrders), ctx, uid, page, size)
 }
 
+// ModerateAnswer mocks base method.
+func (m *MockCtrl) ModerateAnswer(ctx context.Context, id uint64, req *model.Moderation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModerateAnswer", ctx, id, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ModerateAnswer indicates an expected call of ModerateAnswer.
+func (mr *MockCtrlMockRecorder) ModerateAnswer(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModerateAnswer", reflect.TypeOf((*MockCtrl)(nil).ModerateAnswer), ctx, id, req)
+}
+
+// ModerateQuestion mocks base method.
+func (m *MockCtrl) ModerateQuestion(ctx context.Context, id uint64, req *model.Moderation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModerateQuestion", ctx, id, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ModerateQuestion indicates an expected call of ModerateQuestion.
+func (mr *MockCtrlMockRecorder) ModerateQuestion(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModerateQuestion", reflect.TypeOf((*MockCtrl)(nil).ModerateQuestion), ctx, id, req)
+}
+
+// ModerateReview mocks base method.
+func (m *MockCtrl) ModerateReview(ctx context.Context, id uint64, req *model.Moderation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModerateReview", ctx, id, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ModerateReview indicates an expected call of ModerateReview.
+func (mr *MockCtrlMockRecorder) ModerateReview(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModerateReview", reflect.TypeOf((*MockCtrl)(nil).ModerateReview), ctx, id, req)
+}
+
+// MoveToTrash mocks base method.
+func (m *MockCtrl) MoveToTrash(ctx context.Context, kind, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveToTrash", ctx, kind, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveToTrash indicates an expected call of MoveToTrash.
+func (mr *MockCtrlMockRecorder) MoveToTrash(ctx, kind, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveToTrash", reflect.TypeOf((*MockCtrl)(nil).MoveToTrash), ctx, kind, id)
+}
+
+// PatchCategory mocks base method.
+func (m *MockCtrl) PatchCategory(ctx context.Context, slug string, category *model.Category, fields []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchCategory", ctx, slug, category, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchCategory indicates an expected call of PatchCategory.
+func (mr *MockCtrlMockRecorder) PatchCategory(ctx, slug, category, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchCategory", reflect.TypeOf((*MockCtrl)(nil).PatchCategory), ctx, slug, category, fields)
+}
+
+// PatchItem mocks base method.
+func (m *MockCtrl) PatchItem(ctx context.Context, uid uuid.UUID, i *model.Item, fields []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchItem", ctx, uid, i, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchItem indicates an expected call of PatchItem.
+func (mr *MockCtrlMockRecorder) PatchItem(ctx, uid, i, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchItem", reflect.TypeOf((*MockCtrl)(nil).PatchItem), ctx, uid, i, fields)
+}
+
+// PatchOrder mocks base method.
+func (m *MockCtrl) PatchOrder(ctx context.Context, orderID uint64, newData *model.Order, fields []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchOrder", ctx, orderID, newData, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchOrder indicates an expected call of PatchOrder.
+func (mr *MockCtrlMockRecorder) PatchOrder(ctx, orderID, newData, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchOrder", reflect.TypeOf((*MockCtrl)(nil).PatchOrder), ctx, orderID, newData, fields)
+}
+
+// PatchPromotion mocks base method.
+func (m *MockCtrl) PatchPromotion(ctx context.Context, slug string, p *model.Promotion, fields []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchPromotion", ctx, slug, p, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchPromotion indicates an expected call of PatchPromotion.
+func (mr *MockCtrlMockRecorder) PatchPromotion(ctx, slug, p, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchPromotion", reflect.TypeOf((*MockCtrl)(nil).PatchPromotion), ctx, slug, p, fields)
+}
+
 // PromotionSearch mocks base method.
 func (m *MockCtrl) PromotionSearch(ctx context.Context, query string, page, size int) (*model.PaginatedPromosData, error) {
 	m.ctrl.T.Helper()
@@ -472,6 +1276,62 @@ func (mr *MockCtrlMockRecorder) PromotionSearch(ctx, query, page, size any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromotionSearch", reflect.TypeOf((*MockCtrl)(nil).PromotionSearch), ctx, query, page, size)
 }
 
+// PurgeFromTrash mocks base method.
+func (m *MockCtrl) PurgeFromTrash(ctx context.Context, kind, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeFromTrash", ctx, kind, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeFromTrash indicates an expected call of PurgeFromTrash.
+func (mr *MockCtrlMockRecorder) PurgeFromTrash(ctx, kind, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeFromTrash", reflect.TypeOf((*MockCtrl)(nil).PurgeFromTrash), ctx, kind, id)
+}
+
+// RebuildCategoryFilters mocks base method.
+func (m *MockCtrl) RebuildCategoryFilters(ctx context.Context, slug string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildCategoryFilters", ctx, slug)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebuildCategoryFilters indicates an expected call of RebuildCategoryFilters.
+func (mr *MockCtrlMockRecorder) RebuildCategoryFilters(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildCategoryFilters", reflect.TypeOf((*MockCtrl)(nil).RebuildCategoryFilters), ctx, slug)
+}
+
+// ReleaseIdempotent mocks base method.
+func (m *MockCtrl) ReleaseIdempotent(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseIdempotent", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseIdempotent indicates an expected call of ReleaseIdempotent.
+func (mr *MockCtrlMockRecorder) ReleaseIdempotent(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseIdempotent", reflect.TypeOf((*MockCtrl)(nil).ReleaseIdempotent), ctx, key)
+}
+
+// RemoveFavoriteCollectionItem mocks base method.
+func (m *MockCtrl) RemoveFavoriteCollectionItem(ctx context.Context, uid uuid.UUID, id uint64, itemID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFavoriteCollectionItem", ctx, uid, id, itemID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFavoriteCollectionItem indicates an expected call of RemoveFavoriteCollectionItem.
+func (mr *MockCtrlMockRecorder) RemoveFavoriteCollectionItem(ctx, uid, id, itemID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFavoriteCollectionItem", reflect.TypeOf((*MockCtrl)(nil).RemoveFavoriteCollectionItem), ctx, uid, id, itemID)
+}
+
 // RemoveFromFavorites mocks base method.
 func (m *MockCtrl) RemoveFromFavorites(ctx context.Context, uid, itemID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -486,6 +1346,234 @@ func (mr *MockCtrlMockRecorder) RemoveFromFavorites(ctx, uid, itemID any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromFavorites", reflect.TypeOf((*MockCtrl)(nil).RemoveFromFavorites), ctx, uid, itemID)
 }
 
+// ReorderItemMedia mocks base method.
+func (m *MockCtrl) ReorderItemMedia(ctx context.Context, itemID uuid.UUID, ids []uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderItemMedia", ctx, itemID, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderItemMedia indicates an expected call of ReorderItemMedia.
+func (mr *MockCtrlMockRecorder) ReorderItemMedia(ctx, itemID, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderItemMedia", reflect.TypeOf((*MockCtrl)(nil).ReorderItemMedia), ctx, itemID, ids)
+}
+
+// ResolveCustomerGroup mocks base method.
+func (m *MockCtrl) ResolveCustomerGroup(ctx context.Context, slugs []string) (*model.CustomerGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveCustomerGroup", ctx, slugs)
+	ret0, _ := ret[0].(*model.CustomerGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveCustomerGroup indicates an expected call of ResolveCustomerGroup.
+func (mr *MockCtrlMockRecorder) ResolveCustomerGroup(ctx, slugs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveCustomerGroup", reflect.TypeOf((*MockCtrl)(nil).ResolveCustomerGroup), ctx, slugs)
+}
+
+// RestoreFromTrash mocks base method.
+func (m *MockCtrl) RestoreFromTrash(ctx context.Context, kind, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreFromTrash", ctx, kind, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreFromTrash indicates an expected call of RestoreFromTrash.
+func (mr *MockCtrlMockRecorder) RestoreFromTrash(ctx, kind, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreFromTrash", reflect.TypeOf((*MockCtrl)(nil).RestoreFromTrash), ctx, kind, id)
+}
+
+// RollbackItem mocks base method.
+func (m *MockCtrl) RollbackItem(ctx context.Context, uid uuid.UUID, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackItem", ctx, uid, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackItem indicates an expected call of RollbackItem.
+func (mr *MockCtrlMockRecorder) RollbackItem(ctx, uid, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackItem", reflect.TypeOf((*MockCtrl)(nil).RollbackItem), ctx, uid, id)
+}
+
+// SchedulePriceChange mocks base method.
+func (m *MockCtrl) SchedulePriceChange(ctx context.Context, sp *model.ScheduledPrice) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SchedulePriceChange", ctx, sp)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SchedulePriceChange indicates an expected call of SchedulePriceChange.
+func (mr *MockCtrlMockRecorder) SchedulePriceChange(ctx, sp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchedulePriceChange", reflect.TypeOf((*MockCtrl)(nil).SchedulePriceChange), ctx, sp)
+}
+
+// SelectPriceList mocks base method.
+func (m *MockCtrl) SelectPriceList(ctx context.Context, slug string) (*model.PriceList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectPriceList", ctx, slug)
+	ret0, _ := ret[0].(*model.PriceList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectPriceList indicates an expected call of SelectPriceList.
+func (mr *MockCtrlMockRecorder) SelectPriceList(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectPriceList", reflect.TypeOf((*MockCtrl)(nil).SelectPriceList), ctx, slug)
+}
+
+// SetAnswerOfficial mocks base method.
+func (m *MockCtrl) SetAnswerOfficial(ctx context.Context, id uint64, official bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAnswerOfficial", ctx, id, official)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAnswerOfficial indicates an expected call of SetAnswerOfficial.
+func (mr *MockCtrlMockRecorder) SetAnswerOfficial(ctx, id, official any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAnswerOfficial", reflect.TypeOf((*MockCtrl)(nil).SetAnswerOfficial), ctx, id, official)
+}
+
+// SetCategoryAttributes mocks base method.
+func (m *MockCtrl) SetCategoryAttributes(ctx context.Context, slug string, attrs []*model.CategoryAttribute) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCategoryAttributes", ctx, slug, attrs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCategoryAttributes indicates an expected call of SetCategoryAttributes.
+func (mr *MockCtrlMockRecorder) SetCategoryAttributes(ctx, slug, attrs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCategoryAttributes", reflect.TypeOf((*MockCtrl)(nil).SetCategoryAttributes), ctx, slug, attrs)
+}
+
+// SetFavoriteCollectionItem mocks base method.
+func (m *MockCtrl) SetFavoriteCollectionItem(ctx context.Context, uid uuid.UUID, id uint64, req *model.FavoriteCollectionItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFavoriteCollectionItem", ctx, uid, id, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFavoriteCollectionItem indicates an expected call of SetFavoriteCollectionItem.
+func (mr *MockCtrlMockRecorder) SetFavoriteCollectionItem(ctx, uid, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFavoriteCollectionItem", reflect.TypeOf((*MockCtrl)(nil).SetFavoriteCollectionItem), ctx, uid, id, req)
+}
+
+// SetFavoriteNotifications mocks base method.
+func (m *MockCtrl) SetFavoriteNotifications(ctx context.Context, uid uuid.UUID, req *model.Favorite) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFavoriteNotifications", ctx, uid, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFavoriteNotifications indicates an expected call of SetFavoriteNotifications.
+func (mr *MockCtrlMockRecorder) SetFavoriteNotifications(ctx, uid, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFavoriteNotifications", reflect.TypeOf((*MockCtrl)(nil).SetFavoriteNotifications), ctx, uid, req)
+}
+
+// SetItemLabels mocks base method.
+func (m *MockCtrl) SetItemLabels(ctx context.Context, uid uuid.UUID, names []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetItemLabels", ctx, uid, names)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetItemLabels indicates an expected call of SetItemLabels.
+func (mr *MockCtrlMockRecorder) SetItemLabels(ctx, uid, names any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetItemLabels", reflect.TypeOf((*MockCtrl)(nil).SetItemLabels), ctx, uid, names)
+}
+
+// SetPriceListItems mocks base method.
+func (m *MockCtrl) SetPriceListItems(ctx context.Context, slug string, items []*model.PriceListItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPriceListItems", ctx, slug, items)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPriceListItems indicates an expected call of SetPriceListItems.
+func (mr *MockCtrlMockRecorder) SetPriceListItems(ctx, slug, items any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPriceListItems", reflect.TypeOf((*MockCtrl)(nil).SetPriceListItems), ctx, slug, items)
+}
+
+// SetQuantityBreaks mocks base method.
+func (m *MockCtrl) SetQuantityBreaks(ctx context.Context, itemID uuid.UUID, breaks []model.QuantityBreak) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetQuantityBreaks", ctx, itemID, breaks)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetQuantityBreaks indicates an expected call of SetQuantityBreaks.
+func (mr *MockCtrlMockRecorder) SetQuantityBreaks(ctx, itemID, breaks any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetQuantityBreaks", reflect.TypeOf((*MockCtrl)(nil).SetQuantityBreaks), ctx, itemID, breaks)
+}
+
+// ShareFavoriteCollection mocks base method.
+func (m *MockCtrl) ShareFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShareFavoriteCollection", ctx, uid, id)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShareFavoriteCollection indicates an expected call of ShareFavoriteCollection.
+func (mr *MockCtrlMockRecorder) ShareFavoriteCollection(ctx, uid, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShareFavoriteCollection", reflect.TypeOf((*MockCtrl)(nil).ShareFavoriteCollection), ctx, uid, id)
+}
+
+// UnshareFavoriteCollection mocks base method.
+func (m *MockCtrl) UnshareFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnshareFavoriteCollection", ctx, uid, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnshareFavoriteCollection indicates an expected call of UnshareFavoriteCollection.
+func (mr *MockCtrlMockRecorder) UnshareFavoriteCollection(ctx, uid, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnshareFavoriteCollection", reflect.TypeOf((*MockCtrl)(nil).UnshareFavoriteCollection), ctx, uid, id)
+}
+
+// UpdateAttribute mocks base method.
+func (m *MockCtrl) UpdateAttribute(ctx context.Context, slug string, a *model.Attribute) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttribute", ctx, slug, a)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAttribute indicates an expected call of UpdateAttribute.
+func (mr *MockCtrlMockRecorder) UpdateAttribute(ctx, slug, a any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttribute", reflect.TypeOf((*MockCtrl)(nil).UpdateAttribute), ctx, slug, a)
+}
+
 // UpdateCategory mocks base method.
 func (m *MockCtrl) UpdateCategory(ctx context.Context, slug string, category *model.Category) error {
 	m.ctrl.T.Helper()
@@ -500,6 +1588,34 @@ func (mr *MockCtrlMockRecorder) UpdateCategory(ctx, slug, category any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockCtrl)(nil).UpdateCategory), ctx, slug, category)
 }
 
+// UpdateCustomerGroup mocks base method.
+func (m *MockCtrl) UpdateCustomerGroup(ctx context.Context, slug string, g *model.CustomerGroup) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomerGroup", ctx, slug, g)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCustomerGroup indicates an expected call of UpdateCustomerGroup.
+func (mr *MockCtrlMockRecorder) UpdateCustomerGroup(ctx, slug, g any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomerGroup", reflect.TypeOf((*MockCtrl)(nil).UpdateCustomerGroup), ctx, slug, g)
+}
+
+// UpdateFavoriteCollection mocks base method.
+func (m *MockCtrl) UpdateFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64, req *model.FavoriteCollection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFavoriteCollection", ctx, uid, id, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFavoriteCollection indicates an expected call of UpdateFavoriteCollection.
+func (mr *MockCtrlMockRecorder) UpdateFavoriteCollection(ctx, uid, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFavoriteCollection", reflect.TypeOf((*MockCtrl)(nil).UpdateFavoriteCollection), ctx, uid, id, req)
+}
+
 // UpdateItem mocks base method.
 func (m *MockCtrl) UpdateItem(ctx context.Context, uid uuid.UUID, i *model.Item) error {
 	m.ctrl.T.Helper()
@@ -514,6 +1630,34 @@ func (mr *MockCtrlMockRecorder) UpdateItem(ctx, uid, i any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItem", reflect.TypeOf((*MockCtrl)(nil).UpdateItem), ctx, uid, i)
 }
 
+// UpdateItemMedia mocks base method.
+func (m *MockCtrl) UpdateItemMedia(ctx context.Context, itemID uuid.UUID, id uint64, req *model.ItemMedia) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateItemMedia", ctx, itemID, id, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateItemMedia indicates an expected call of UpdateItemMedia.
+func (mr *MockCtrlMockRecorder) UpdateItemMedia(ctx, itemID, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItemMedia", reflect.TypeOf((*MockCtrl)(nil).UpdateItemMedia), ctx, itemID, id, req)
+}
+
+// UpdateLabel mocks base method.
+func (m *MockCtrl) UpdateLabel(ctx context.Context, name string, l *model.Label) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLabel", ctx, name, l)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLabel indicates an expected call of UpdateLabel.
+func (mr *MockCtrlMockRecorder) UpdateLabel(ctx, name, l any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLabel", reflect.TypeOf((*MockCtrl)(nil).UpdateLabel), ctx, name, l)
+}
+
 // UpdateOrder mocks base method.
 func (m *MockCtrl) UpdateOrder(ctx context.Context, orderID uint64, newData *model.Order) error {
 	m.ctrl.T.Helper()
@@ -528,6 +1672,20 @@ func (mr *MockCtrlMockRecorder) UpdateOrder(ctx, orderID, newData any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrder", reflect.TypeOf((*MockCtrl)(nil).UpdateOrder), ctx, orderID, newData)
 }
 
+// UpdatePriceList mocks base method.
+func (m *MockCtrl) UpdatePriceList(ctx context.Context, slug string, pl *model.PriceList) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePriceList", ctx, slug, pl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePriceList indicates an expected call of UpdatePriceList.
+func (mr *MockCtrlMockRecorder) UpdatePriceList(ctx, slug, pl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePriceList", reflect.TypeOf((*MockCtrl)(nil).UpdatePriceList), ctx, slug, pl)
+}
+
 // UpdatePromotion mocks base method.
 func (m *MockCtrl) UpdatePromotion(ctx context.Context, slug string, p *model.Promotion) error {
 	m.ctrl.T.Helper()
@@ -541,3 +1699,47 @@ func (mr *MockCtrlMockRecorder) UpdatePromotion(ctx, slug, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePromotion", reflect.TypeOf((*MockCtrl)(nil).UpdatePromotion), ctx, slug, p)
 }
+
+// UpdateReview mocks base method.
+func (m *MockCtrl) UpdateReview(ctx context.Context, req *model.Review) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReview", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReview indicates an expected call of UpdateReview.
+func (mr *MockCtrlMockRecorder) UpdateReview(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReview", reflect.TypeOf((*MockCtrl)(nil).UpdateReview), ctx, req)
+}
+
+// UploadItemMedia mocks base method.
+func (m *MockCtrl) UploadItemMedia(ctx context.Context, itemID uuid.UUID, req *model.MediaUpload) (*model.ItemMedia, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadItemMedia", ctx, itemID, req)
+	ret0, _ := ret[0].(*model.ItemMedia)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadItemMedia indicates an expected call of UploadItemMedia.
+func (mr *MockCtrlMockRecorder) UploadItemMedia(ctx, itemID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadItemMedia", reflect.TypeOf((*MockCtrl)(nil).UploadItemMedia), ctx, itemID, req)
+}
+
+// UploadReviewMedia mocks base method.
+func (m *MockCtrl) UploadReviewMedia(ctx context.Context, userID uuid.UUID, id uint64, req *model.MediaUpload) (*model.ReviewMedia, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadReviewMedia", ctx, userID, id, req)
+	ret0, _ := ret[0].(*model.ReviewMedia)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadReviewMedia indicates an expected call of UploadReviewMedia.
+func (mr *MockCtrlMockRecorder) UploadReviewMedia(ctx, userID, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadReviewMedia", reflect.TypeOf((*MockCtrl)(nil).UploadReviewMedia), ctx, userID, id, req)
+}
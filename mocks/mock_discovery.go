@@ -8,3 +8,90 @@
 
 // Package mocks is a generated GoMock package.
 package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockServiceDiscovery is a mock of ServiceDiscovery interface.
+type MockServiceDiscovery struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceDiscoveryMockRecorder
+}
+
+// MockServiceDiscoveryMockRecorder is the mock recorder for MockServiceDiscovery.
+type MockServiceDiscoveryMockRecorder struct {
+	mock *MockServiceDiscovery
+}
+
+// NewMockServiceDiscovery creates a new mock instance.
+func NewMockServiceDiscovery(ctrl *gomock.Controller) *MockServiceDiscovery {
+	mock := &MockServiceDiscovery{ctrl: ctrl}
+	mock.recorder = &MockServiceDiscoveryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceDiscovery) EXPECT() *MockServiceDiscoveryMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockServiceDiscovery) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockServiceDiscoveryMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockServiceDiscovery)(nil).Close))
+}
+
+// Deregister mocks base method.
+func (m *MockServiceDiscovery) Deregister(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deregister", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Deregister indicates an expected call of Deregister.
+func (mr *MockServiceDiscoveryMockRecorder) Deregister(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deregister", reflect.TypeOf((*MockServiceDiscovery)(nil).Deregister), ctx)
+}
+
+// FindServiceByName mocks base method.
+func (m *MockServiceDiscovery) FindServiceByName(ctx context.Context, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindServiceByName", ctx, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindServiceByName indicates an expected call of FindServiceByName.
+func (mr *MockServiceDiscoveryMockRecorder) FindServiceByName(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindServiceByName", reflect.TypeOf((*MockServiceDiscovery)(nil).FindServiceByName), ctx, name)
+}
+
+// Register mocks base method.
+func (m *MockServiceDiscovery) Register(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockServiceDiscoveryMockRecorder) Register(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockServiceDiscovery)(nil).Register), ctx)
+}
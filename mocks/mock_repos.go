@@ -57,6 +57,21 @@ func (mr *MockAppRepoMockRecorder) AddToFavorites(ctx, uid, itemID any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToFavorites", reflect.TypeOf((*MockAppRepo)(nil).AddToFavorites), ctx, uid, itemID)
 }
 
+// ApplyScheduledPrices mocks base method.
+func (m *MockAppRepo) ApplyScheduledPrices(ctx context.Context, now time.Time) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyScheduledPrices", ctx, now)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyScheduledPrices indicates an expected call of ApplyScheduledPrices.
+func (mr *MockAppRepoMockRecorder) ApplyScheduledPrices(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyScheduledPrices", reflect.TypeOf((*MockAppRepo)(nil).ApplyScheduledPrices), ctx, now)
+}
+
 // CancelOrder mocks base method.
 func (m *MockAppRepo) CancelOrder(ctx context.Context, orderID uint64) error {
 	m.ctrl.T.Helper()
@@ -101,6 +116,80 @@ func (mr *MockAppRepoMockRecorder) CategorySearch(ctx, query, page, size any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CategorySearch", reflect.TypeOf((*MockAppRepo)(nil).CategorySearch), ctx, query, page, size)
 }
 
+// ClaimIdempotencyKey mocks base method.
+func (m *MockAppRepo) ClaimIdempotencyKey(ctx context.Context, key, hash string, lock time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimIdempotencyKey", ctx, key, hash, lock)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimIdempotencyKey indicates an expected call of ClaimIdempotencyKey.
+func (mr *MockAppRepoMockRecorder) ClaimIdempotencyKey(ctx, key, hash, lock any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimIdempotencyKey", reflect.TypeOf((*MockAppRepo)(nil).ClaimIdempotencyKey), ctx, key, hash, lock)
+}
+
+// ClaimOrphanMedia mocks base method.
+func (m *MockAppRepo) ClaimOrphanMedia(ctx context.Context, limit int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimOrphanMedia", ctx, limit)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimOrphanMedia indicates an expected call of ClaimOrphanMedia.
+func (mr *MockAppRepoMockRecorder) ClaimOrphanMedia(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimOrphanMedia", reflect.TypeOf((*MockAppRepo)(nil).ClaimOrphanMedia), ctx, limit)
+}
+
+// CreateAnswer mocks base method.
+func (m *MockAppRepo) CreateAnswer(ctx context.Context, req *model.Answer) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAnswer", ctx, req)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAnswer indicates an expected call of CreateAnswer.
+func (mr *MockAppRepoMockRecorder) CreateAnswer(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAnswer", reflect.TypeOf((*MockAppRepo)(nil).CreateAnswer), ctx, req)
+}
+
+// CreateAttribute mocks base method.
+func (m *MockAppRepo) CreateAttribute(ctx context.Context, a *model.Attribute) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAttribute", ctx, a)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAttribute indicates an expected call of CreateAttribute.
+func (mr *MockAppRepoMockRecorder) CreateAttribute(ctx, a any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttribute", reflect.TypeOf((*MockAppRepo)(nil).CreateAttribute), ctx, a)
+}
+
+// CreateAuditEntry mocks base method.
+func (m *MockAppRepo) CreateAuditEntry(ctx context.Context, e *model.AuditEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuditEntry", ctx, e)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAuditEntry indicates an expected call of CreateAuditEntry.
+func (mr *MockAppRepoMockRecorder) CreateAuditEntry(ctx, e any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuditEntry", reflect.TypeOf((*MockAppRepo)(nil).CreateAuditEntry), ctx, e)
+}
+
 // CreateCategory mocks base method.
 func (m *MockAppRepo) CreateCategory(ctx context.Context, c *model.Category) (string, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +205,36 @@ func (mr *MockAppRepoMockRecorder) CreateCategory(ctx, c any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockAppRepo)(nil).CreateCategory), ctx, c)
 }
 
+// CreateCustomerGroup mocks base method.
+func (m *MockAppRepo) CreateCustomerGroup(ctx context.Context, g *model.CustomerGroup) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomerGroup", ctx, g)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCustomerGroup indicates an expected call of CreateCustomerGroup.
+func (mr *MockAppRepoMockRecorder) CreateCustomerGroup(ctx, g any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomerGroup", reflect.TypeOf((*MockAppRepo)(nil).CreateCustomerGroup), ctx, g)
+}
+
+// CreateFavoriteCollection mocks base method.
+func (m *MockAppRepo) CreateFavoriteCollection(ctx context.Context, uid uuid.UUID, req *model.FavoriteCollection) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFavoriteCollection", ctx, uid, req)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFavoriteCollection indicates an expected call of CreateFavoriteCollection.
+func (mr *MockAppRepoMockRecorder) CreateFavoriteCollection(ctx, uid, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFavoriteCollection", reflect.TypeOf((*MockAppRepo)(nil).CreateFavoriteCollection), ctx, uid, req)
+}
+
 // CreateItem mocks base method.
 func (m *MockAppRepo) CreateItem(ctx context.Context, i *model.Item) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -131,6 +250,36 @@ func (mr *MockAppRepoMockRecorder) CreateItem(ctx, i any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateItem", reflect.TypeOf((*MockAppRepo)(nil).CreateItem), ctx, i)
 }
 
+// CreateItemMedia mocks base method.
+func (m *MockAppRepo) CreateItemMedia(ctx context.Context, itemID uuid.UUID, req *model.ItemMedia) (*model.ItemMedia, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateItemMedia", ctx, itemID, req)
+	ret0, _ := ret[0].(*model.ItemMedia)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateItemMedia indicates an expected call of CreateItemMedia.
+func (mr *MockAppRepoMockRecorder) CreateItemMedia(ctx, itemID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateItemMedia", reflect.TypeOf((*MockAppRepo)(nil).CreateItemMedia), ctx, itemID, req)
+}
+
+// CreateLabel mocks base method.
+func (m *MockAppRepo) CreateLabel(ctx context.Context, l *model.Label) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLabel", ctx, l)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLabel indicates an expected call of CreateLabel.
+func (mr *MockAppRepoMockRecorder) CreateLabel(ctx, l any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLabel", reflect.TypeOf((*MockAppRepo)(nil).CreateLabel), ctx, l)
+}
+
 // CreateOrder mocks base method.
 func (m *MockAppRepo) CreateOrder(ctx context.Context, uid uuid.UUID, req *model.Order) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -146,6 +295,21 @@ func (mr *MockAppRepoMockRecorder) CreateOrder(ctx, uid, req any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockAppRepo)(nil).CreateOrder), ctx, uid, req)
 }
 
+// CreatePriceList mocks base method.
+func (m *MockAppRepo) CreatePriceList(ctx context.Context, pl *model.PriceList) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePriceList", ctx, pl)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePriceList indicates an expected call of CreatePriceList.
+func (mr *MockAppRepoMockRecorder) CreatePriceList(ctx, pl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePriceList", reflect.TypeOf((*MockAppRepo)(nil).CreatePriceList), ctx, pl)
+}
+
 // CreatePromotion mocks base method.
 func (m *MockAppRepo) CreatePromotion(ctx context.Context, p *model.Promotion) (string, error) {
 	m.ctrl.T.Helper()
@@ -161,6 +325,95 @@ func (mr *MockAppRepoMockRecorder) CreatePromotion(ctx, p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromotion", reflect.TypeOf((*MockAppRepo)(nil).CreatePromotion), ctx, p)
 }
 
+// CreateQuestion mocks base method.
+func (m *MockAppRepo) CreateQuestion(ctx context.Context, req *model.Question) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateQuestion", ctx, req)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateQuestion indicates an expected call of CreateQuestion.
+func (mr *MockAppRepoMockRecorder) CreateQuestion(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQuestion", reflect.TypeOf((*MockAppRepo)(nil).CreateQuestion), ctx, req)
+}
+
+// CreateReview mocks base method.
+func (m *MockAppRepo) CreateReview(ctx context.Context, req *model.Review) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReview", ctx, req)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReview indicates an expected call of CreateReview.
+func (mr *MockAppRepoMockRecorder) CreateReview(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReview", reflect.TypeOf((*MockAppRepo)(nil).CreateReview), ctx, req)
+}
+
+// CreateReviewMedia mocks base method.
+func (m *MockAppRepo) CreateReviewMedia(ctx context.Context, reviewID uint64, req *model.ReviewMedia) (*model.ReviewMedia, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReviewMedia", ctx, reviewID, req)
+	ret0, _ := ret[0].(*model.ReviewMedia)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReviewMedia indicates an expected call of CreateReviewMedia.
+func (mr *MockAppRepoMockRecorder) CreateReviewMedia(ctx, reviewID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReviewMedia", reflect.TypeOf((*MockAppRepo)(nil).CreateReviewMedia), ctx, reviewID, req)
+}
+
+// CreateScheduledPrice mocks base method.
+func (m *MockAppRepo) CreateScheduledPrice(ctx context.Context, sp *model.ScheduledPrice) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScheduledPrice", ctx, sp)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateScheduledPrice indicates an expected call of CreateScheduledPrice.
+func (mr *MockAppRepoMockRecorder) CreateScheduledPrice(ctx, sp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScheduledPrice", reflect.TypeOf((*MockAppRepo)(nil).CreateScheduledPrice), ctx, sp)
+}
+
+// DeleteAnswer mocks base method.
+func (m *MockAppRepo) DeleteAnswer(ctx context.Context, id uint64, userID uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAnswer", ctx, id, userID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAnswer indicates an expected call of DeleteAnswer.
+func (mr *MockAppRepoMockRecorder) DeleteAnswer(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAnswer", reflect.TypeOf((*MockAppRepo)(nil).DeleteAnswer), ctx, id, userID)
+}
+
+// DeleteAttribute mocks base method.
+func (m *MockAppRepo) DeleteAttribute(ctx context.Context, slug string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttribute", ctx, slug)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttribute indicates an expected call of DeleteAttribute.
+func (mr *MockAppRepoMockRecorder) DeleteAttribute(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttribute", reflect.TypeOf((*MockAppRepo)(nil).DeleteAttribute), ctx, slug)
+}
+
 // DeleteCategory mocks base method.
 func (m *MockAppRepo) DeleteCategory(ctx context.Context, slug string) error {
 	m.ctrl.T.Helper()
@@ -175,6 +428,49 @@ func (mr *MockAppRepoMockRecorder) DeleteCategory(ctx, slug any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockAppRepo)(nil).DeleteCategory), ctx, slug)
 }
 
+// DeleteCustomerGroup mocks base method.
+func (m *MockAppRepo) DeleteCustomerGroup(ctx context.Context, slug string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomerGroup", ctx, slug)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCustomerGroup indicates an expected call of DeleteCustomerGroup.
+func (mr *MockAppRepoMockRecorder) DeleteCustomerGroup(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomerGroup", reflect.TypeOf((*MockAppRepo)(nil).DeleteCustomerGroup), ctx, slug)
+}
+
+// DeleteExpiredIdempotencyKeys mocks base method.
+func (m *MockAppRepo) DeleteExpiredIdempotencyKeys(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredIdempotencyKeys", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredIdempotencyKeys indicates an expected call of DeleteExpiredIdempotencyKeys.
+func (mr *MockAppRepoMockRecorder) DeleteExpiredIdempotencyKeys(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredIdempotencyKeys", reflect.TypeOf((*MockAppRepo)(nil).DeleteExpiredIdempotencyKeys), ctx, before)
+}
+
+// DeleteFavoriteCollection mocks base method.
+func (m *MockAppRepo) DeleteFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFavoriteCollection", ctx, uid, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFavoriteCollection indicates an expected call of DeleteFavoriteCollection.
+func (mr *MockAppRepoMockRecorder) DeleteFavoriteCollection(ctx, uid, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFavoriteCollection", reflect.TypeOf((*MockAppRepo)(nil).DeleteFavoriteCollection), ctx, uid, id)
+}
+
 // DeleteItem mocks base method.
 func (m *MockAppRepo) DeleteItem(ctx context.Context, uid uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -189,6 +485,76 @@ func (mr *MockAppRepoMockRecorder) DeleteItem(ctx, uid any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItem", reflect.TypeOf((*MockAppRepo)(nil).DeleteItem), ctx, uid)
 }
 
+// DeleteItemMedia mocks base method.
+func (m *MockAppRepo) DeleteItemMedia(ctx context.Context, itemID uuid.UUID, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteItemMedia", ctx, itemID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteItemMedia indicates an expected call of DeleteItemMedia.
+func (mr *MockAppRepoMockRecorder) DeleteItemMedia(ctx, itemID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItemMedia", reflect.TypeOf((*MockAppRepo)(nil).DeleteItemMedia), ctx, itemID, id)
+}
+
+// DeleteLabel mocks base method.
+func (m *MockAppRepo) DeleteLabel(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLabel", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLabel indicates an expected call of DeleteLabel.
+func (mr *MockAppRepoMockRecorder) DeleteLabel(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLabel", reflect.TypeOf((*MockAppRepo)(nil).DeleteLabel), ctx, name)
+}
+
+// DeleteOrder mocks base method.
+func (m *MockAppRepo) DeleteOrder(ctx context.Context, orderID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrder", ctx, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOrder indicates an expected call of DeleteOrder.
+func (mr *MockAppRepoMockRecorder) DeleteOrder(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrder", reflect.TypeOf((*MockAppRepo)(nil).DeleteOrder), ctx, orderID)
+}
+
+// DeletePriceList mocks base method.
+func (m *MockAppRepo) DeletePriceList(ctx context.Context, slug string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePriceList", ctx, slug)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePriceList indicates an expected call of DeletePriceList.
+func (mr *MockAppRepoMockRecorder) DeletePriceList(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePriceList", reflect.TypeOf((*MockAppRepo)(nil).DeletePriceList), ctx, slug)
+}
+
+// DeletePriceListItem mocks base method.
+func (m *MockAppRepo) DeletePriceListItem(ctx context.Context, slug string, itemID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePriceListItem", ctx, slug, itemID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePriceListItem indicates an expected call of DeletePriceListItem.
+func (mr *MockAppRepoMockRecorder) DeletePriceListItem(ctx, slug, itemID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePriceListItem", reflect.TypeOf((*MockAppRepo)(nil).DeletePriceListItem), ctx, slug, itemID)
+}
+
 // DeletePromotion mocks base method.
 func (m *MockAppRepo) DeletePromotion(ctx context.Context, slug string) error {
 	m.ctrl.T.Helper()
@@ -203,288 +569,1240 @@ func (mr *MockAppRepoMockRecorder) DeletePromotion(ctx, slug any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePromotion", reflect.TypeOf((*MockAppRepo)(nil).DeletePromotion), ctx, slug)
 }
 
-// GetCategoryBySlug mocks base method.
-func (m *MockAppRepo) GetCategoryBySlug(ctx context.Context, slug string) (*model.Category, error) {
+// DeleteQuestion mocks base method.
+func (m *MockAppRepo) DeleteQuestion(ctx context.Context, id uint64, userID uuid.UUID) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCategoryBySlug", ctx, slug)
-	ret0, _ := ret[0].(*model.Category)
+	ret := m.ctrl.Call(m, "DeleteQuestion", ctx, id, userID)
+	ret0, _ := ret[0].(uuid.UUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCategoryBySlug indicates an expected call of GetCategoryBySlug.
-func (mr *MockAppRepoMockRecorder) GetCategoryBySlug(ctx, slug any) *gomock.Call {
+// DeleteQuestion indicates an expected call of DeleteQuestion.
+func (mr *MockAppRepoMockRecorder) DeleteQuestion(ctx, id, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryBySlug", reflect.TypeOf((*MockAppRepo)(nil).GetCategoryBySlug), ctx, slug)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQuestion", reflect.TypeOf((*MockAppRepo)(nil).DeleteQuestion), ctx, id, userID)
 }
 
-// GetFavorites mocks base method.
-func (m *MockAppRepo) GetFavorites(ctx context.Context, uid uuid.UUID) ([]*model.Favorite, error) {
+// DeleteReview mocks base method.
+func (m *MockAppRepo) DeleteReview(ctx context.Context, id uint64, userID uuid.UUID) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFavorites", ctx, uid)
-	ret0, _ := ret[0].([]*model.Favorite)
+	ret := m.ctrl.Call(m, "DeleteReview", ctx, id, userID)
+	ret0, _ := ret[0].(uuid.UUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetFavorites indicates an expected call of GetFavorites.
-func (mr *MockAppRepoMockRecorder) GetFavorites(ctx, uid any) *gomock.Call {
+// DeleteReview indicates an expected call of DeleteReview.
+func (mr *MockAppRepoMockRecorder) DeleteReview(ctx, id, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavorites", reflect.TypeOf((*MockAppRepo)(nil).GetFavorites), ctx, uid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReview", reflect.TypeOf((*MockAppRepo)(nil).DeleteReview), ctx, id, userID)
 }
 
-// GetItemByUUID mocks base method.
-func (m *MockAppRepo) GetItemByUUID(ctx context.Context, uid uuid.UUID) (*model.Item, error) {
+// DeleteScheduledPrice mocks base method.
+func (m *MockAppRepo) DeleteScheduledPrice(ctx context.Context, id uint64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetItemByUUID", ctx, uid)
-	ret0, _ := ret[0].(*model.Item)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteScheduledPrice", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetItemByUUID indicates an expected call of GetItemByUUID.
-func (mr *MockAppRepoMockRecorder) GetItemByUUID(ctx, uid any) *gomock.Call {
+// DeleteScheduledPrice indicates an expected call of DeleteScheduledPrice.
+func (mr *MockAppRepoMockRecorder) DeleteScheduledPrice(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemByUUID", reflect.TypeOf((*MockAppRepo)(nil).GetItemByUUID), ctx, uid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScheduledPrice", reflect.TypeOf((*MockAppRepo)(nil).DeleteScheduledPrice), ctx, id)
 }
 
-// GetOrder mocks base method.
-func (m *MockAppRepo) GetOrder(ctx context.Context, orderID uint64) (*model.Order, error) {
+// GetApplicableQuantityBreaks mocks base method.
+func (m *MockAppRepo) GetApplicableQuantityBreaks(ctx context.Context, groupID uint64, items []uuid.UUID) (map[uuid.UUID][]model.QuantityBreak, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrder", ctx, orderID)
-	ret0, _ := ret[0].(*model.Order)
+	ret := m.ctrl.Call(m, "GetApplicableQuantityBreaks", ctx, groupID, items)
+	ret0, _ := ret[0].(map[uuid.UUID][]model.QuantityBreak)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetOrder indicates an expected call of GetOrder.
-func (mr *MockAppRepoMockRecorder) GetOrder(ctx, orderID any) *gomock.Call {
+// GetApplicableQuantityBreaks indicates an expected call of GetApplicableQuantityBreaks.
+func (mr *MockAppRepoMockRecorder) GetApplicableQuantityBreaks(ctx, groupID, items any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrder", reflect.TypeOf((*MockAppRepo)(nil).GetOrder), ctx, orderID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicableQuantityBreaks", reflect.TypeOf((*MockAppRepo)(nil).GetApplicableQuantityBreaks), ctx, groupID, items)
 }
 
-// GetPromotion mocks base method.
-func (m *MockAppRepo) GetPromotion(ctx context.Context, slug string) (*model.Promotion, error) {
+// GetAttribute mocks base method.
+func (m *MockAppRepo) GetAttribute(ctx context.Context, slug string) (*model.Attribute, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPromotion", ctx, slug)
-	ret0, _ := ret[0].(*model.Promotion)
+	ret := m.ctrl.Call(m, "GetAttribute", ctx, slug)
+	ret0, _ := ret[0].(*model.Attribute)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPromotion indicates an expected call of GetPromotion.
-func (mr *MockAppRepoMockRecorder) GetPromotion(ctx, slug any) *gomock.Call {
+// GetAttribute indicates an expected call of GetAttribute.
+func (mr *MockAppRepoMockRecorder) GetAttribute(ctx, slug any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromotion", reflect.TypeOf((*MockAppRepo)(nil).GetPromotion), ctx, slug)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttribute", reflect.TypeOf((*MockAppRepo)(nil).GetAttribute), ctx, slug)
 }
 
-// ItemAttrSearch mocks base method.
-func (m *MockAppRepo) ItemAttrSearch(ctx context.Context, query string, size, page int) (*model.PaginatedItemAttrData, error) {
+// GetCategoryBySlug mocks base method.
+func (m *MockAppRepo) GetCategoryBySlug(ctx context.Context, slug string) (*model.Category, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ItemAttrSearch", ctx, query, size, page)
-	ret0, _ := ret[0].(*model.PaginatedItemAttrData)
+	ret := m.ctrl.Call(m, "GetCategoryBySlug", ctx, slug)
+	ret0, _ := ret[0].(*model.Category)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ItemAttrSearch indicates an expected call of ItemAttrSearch.
-func (mr *MockAppRepoMockRecorder) ItemAttrSearch(ctx, query, size, page any) *gomock.Call {
+// GetCategoryBySlug indicates an expected call of GetCategoryBySlug.
+func (mr *MockAppRepoMockRecorder) GetCategoryBySlug(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryBySlug", reflect.TypeOf((*MockAppRepo)(nil).GetCategoryBySlug), ctx, slug)
+}
+
+// GetCustomerGroup mocks base method.
+func (m *MockAppRepo) GetCustomerGroup(ctx context.Context, slug string) (*model.CustomerGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomerGroup", ctx, slug)
+	ret0, _ := ret[0].(*model.CustomerGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomerGroup indicates an expected call of GetCustomerGroup.
+func (mr *MockAppRepoMockRecorder) GetCustomerGroup(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerGroup", reflect.TypeOf((*MockAppRepo)(nil).GetCustomerGroup), ctx, slug)
+}
+
+// GetFavoriteCollection mocks base method.
+func (m *MockAppRepo) GetFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64) (*model.FavoriteCollection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFavoriteCollection", ctx, uid, id)
+	ret0, _ := ret[0].(*model.FavoriteCollection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFavoriteCollection indicates an expected call of GetFavoriteCollection.
+func (mr *MockAppRepoMockRecorder) GetFavoriteCollection(ctx, uid, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoriteCollection", reflect.TypeOf((*MockAppRepo)(nil).GetFavoriteCollection), ctx, uid, id)
+}
+
+// GetIdempotencyRecord mocks base method.
+func (m *MockAppRepo) GetIdempotencyRecord(ctx context.Context, key string) (*model.IdempotencyRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdempotencyRecord", ctx, key)
+	ret0, _ := ret[0].(*model.IdempotencyRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIdempotencyRecord indicates an expected call of GetIdempotencyRecord.
+func (mr *MockAppRepoMockRecorder) GetIdempotencyRecord(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdempotencyRecord", reflect.TypeOf((*MockAppRepo)(nil).GetIdempotencyRecord), ctx, key)
+}
+
+// GetItemByUUID mocks base method.
+func (m *MockAppRepo) GetItemByUUID(ctx context.Context, uid uuid.UUID) (*model.Item, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemByUUID", ctx, uid)
+	ret0, _ := ret[0].(*model.Item)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItemByUUID indicates an expected call of GetItemByUUID.
+func (mr *MockAppRepoMockRecorder) GetItemByUUID(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemByUUID", reflect.TypeOf((*MockAppRepo)(nil).GetItemByUUID), ctx, uid)
+}
+
+// GetItemRevision mocks base method.
+func (m *MockAppRepo) GetItemRevision(ctx context.Context, uid uuid.UUID, id uint64) (*model.ItemRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemRevision", ctx, uid, id)
+	ret0, _ := ret[0].(*model.ItemRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItemRevision indicates an expected call of GetItemRevision.
+func (mr *MockAppRepoMockRecorder) GetItemRevision(ctx, uid, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemRevision", reflect.TypeOf((*MockAppRepo)(nil).GetItemRevision), ctx, uid, id)
+}
+
+// GetItemStates mocks base method.
+func (m *MockAppRepo) GetItemStates(ctx context.Context, items []uuid.UUID) (map[uuid.UUID]model.ItemState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemStates", ctx, items)
+	ret0, _ := ret[0].(map[uuid.UUID]model.ItemState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItemStates indicates an expected call of GetItemStates.
+func (mr *MockAppRepoMockRecorder) GetItemStates(ctx, items any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemStates", reflect.TypeOf((*MockAppRepo)(nil).GetItemStates), ctx, items)
+}
+
+// GetOrder mocks base method.
+func (m *MockAppRepo) GetOrder(ctx context.Context, orderID uint64) (*model.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrder", ctx, orderID)
+	ret0, _ := ret[0].(*model.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrder indicates an expected call of GetOrder.
+func (mr *MockAppRepoMockRecorder) GetOrder(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrder", reflect.TypeOf((*MockAppRepo)(nil).GetOrder), ctx, orderID)
+}
+
+// GetPriceList mocks base method.
+func (m *MockAppRepo) GetPriceList(ctx context.Context, slug string) (*model.PriceList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceList", ctx, slug)
+	ret0, _ := ret[0].(*model.PriceList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceList indicates an expected call of GetPriceList.
+func (mr *MockAppRepoMockRecorder) GetPriceList(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceList", reflect.TypeOf((*MockAppRepo)(nil).GetPriceList), ctx, slug)
+}
+
+// GetPriceListPrices mocks base method.
+func (m *MockAppRepo) GetPriceListPrices(ctx context.Context, listID uint64, items []uuid.UUID) (map[uuid.UUID]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceListPrices", ctx, listID, items)
+	ret0, _ := ret[0].(map[uuid.UUID]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceListPrices indicates an expected call of GetPriceListPrices.
+func (mr *MockAppRepoMockRecorder) GetPriceListPrices(ctx, listID, items any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceListPrices", reflect.TypeOf((*MockAppRepo)(nil).GetPriceListPrices), ctx, listID, items)
+}
+
+// GetPriceTimeline mocks base method.
+func (m *MockAppRepo) GetPriceTimeline(ctx context.Context, uid uuid.UUID) (*model.PriceTimeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceTimeline", ctx, uid)
+	ret0, _ := ret[0].(*model.PriceTimeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceTimeline indicates an expected call of GetPriceTimeline.
+func (mr *MockAppRepoMockRecorder) GetPriceTimeline(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceTimeline", reflect.TypeOf((*MockAppRepo)(nil).GetPriceTimeline), ctx, uid)
+}
+
+// GetPromotion mocks base method.
+func (m *MockAppRepo) GetPromotion(ctx context.Context, slug string) (*model.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromotion", ctx, slug)
+	ret0, _ := ret[0].(*model.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromotion indicates an expected call of GetPromotion.
+func (mr *MockAppRepoMockRecorder) GetPromotion(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromotion", reflect.TypeOf((*MockAppRepo)(nil).GetPromotion), ctx, slug)
+}
+
+// GetQuestion mocks base method.
+func (m *MockAppRepo) GetQuestion(ctx context.Context, id uint64) (*model.Question, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuestion", ctx, id)
+	ret0, _ := ret[0].(*model.Question)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuestion indicates an expected call of GetQuestion.
+func (mr *MockAppRepoMockRecorder) GetQuestion(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuestion", reflect.TypeOf((*MockAppRepo)(nil).GetQuestion), ctx, id)
+}
+
+// GetReview mocks base method.
+func (m *MockAppRepo) GetReview(ctx context.Context, id uint64) (*model.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReview", ctx, id)
+	ret0, _ := ret[0].(*model.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReview indicates an expected call of GetReview.
+func (mr *MockAppRepoMockRecorder) GetReview(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReview", reflect.TypeOf((*MockAppRepo)(nil).GetReview), ctx, id)
+}
+
+// GetSharedFavoriteCollection mocks base method.
+func (m *MockAppRepo) GetSharedFavoriteCollection(ctx context.Context, token string) (*model.FavoriteCollection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSharedFavoriteCollection", ctx, token)
+	ret0, _ := ret[0].(*model.FavoriteCollection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSharedFavoriteCollection indicates an expected call of GetSharedFavoriteCollection.
+func (mr *MockAppRepoMockRecorder) GetSharedFavoriteCollection(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedFavoriteCollection", reflect.TypeOf((*MockAppRepo)(nil).GetSharedFavoriteCollection), ctx, token)
+}
+
+// ItemAttrSearch mocks base method.
+func (m *MockAppRepo) ItemAttrSearch(ctx context.Context, query string, size, page int) (*model.PaginatedItemAttrData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ItemAttrSearch", ctx, query, size, page)
+	ret0, _ := ret[0].(*model.PaginatedItemAttrData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ItemAttrSearch indicates an expected call of ItemAttrSearch.
+func (mr *MockAppRepoMockRecorder) ItemAttrSearch(ctx, query, size, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ItemAttrSearch", reflect.TypeOf((*MockAppRepo)(nil).ItemAttrSearch), ctx, query, size, page)
+}
+
+// ItemSearch mocks base method.
+func (m *MockAppRepo) ItemSearch(ctx context.Context, query string, size, page int) (*model.PaginatedItemsData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ItemSearch", ctx, query, size, page)
+	ret0, _ := ret[0].(*model.PaginatedItemsData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ItemSearch indicates an expected call of ItemSearch.
+func (mr *MockAppRepoMockRecorder) ItemSearch(ctx, query, size, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ItemSearch", reflect.TypeOf((*MockAppRepo)(nil).ItemSearch), ctx, query, size, page)
+}
+
+// ListAnswersByStatus mocks base method.
+func (m *MockAppRepo) ListAnswersByStatus(ctx context.Context, status string, page, size int) (*model.PaginatedAnswersData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAnswersByStatus", ctx, status, page, size)
+	ret0, _ := ret[0].(*model.PaginatedAnswersData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAnswersByStatus indicates an expected call of ListAnswersByStatus.
+func (mr *MockAppRepoMockRecorder) ListAnswersByStatus(ctx, status, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAnswersByStatus", reflect.TypeOf((*MockAppRepo)(nil).ListAnswersByStatus), ctx, status, page, size)
+}
+
+// ListAttributes mocks base method.
+func (m *MockAppRepo) ListAttributes(ctx context.Context) ([]*model.Attribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttributes", ctx)
+	ret0, _ := ret[0].([]*model.Attribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttributes indicates an expected call of ListAttributes.
+func (mr *MockAppRepoMockRecorder) ListAttributes(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttributes", reflect.TypeOf((*MockAppRepo)(nil).ListAttributes), ctx)
+}
+
+// ListAuditEntries mocks base method.
+func (m *MockAppRepo) ListAuditEntries(ctx context.Context, f *model.AuditFilter, page, size int) (*model.PaginatedAuditData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditEntries", ctx, f, page, size)
+	ret0, _ := ret[0].(*model.PaginatedAuditData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditEntries indicates an expected call of ListAuditEntries.
+func (mr *MockAppRepoMockRecorder) ListAuditEntries(ctx, f, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEntries", reflect.TypeOf((*MockAppRepo)(nil).ListAuditEntries), ctx, f, page, size)
+}
+
+// ListCategories mocks base method.
+func (m *MockAppRepo) ListCategories(ctx context.Context, page, size int) (*model.PaginatedCategoryData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCategories", ctx, page, size)
+	ret0, _ := ret[0].(*model.PaginatedCategoryData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategories indicates an expected call of ListCategories.
+func (mr *MockAppRepoMockRecorder) ListCategories(ctx, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategories", reflect.TypeOf((*MockAppRepo)(nil).ListCategories), ctx, page, size)
+}
+
+// ListCategoryAttributes mocks base method.
+func (m *MockAppRepo) ListCategoryAttributes(ctx context.Context, slugs []string) ([]*model.CategoryAttribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCategoryAttributes", ctx, slugs)
+	ret0, _ := ret[0].([]*model.CategoryAttribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategoryAttributes indicates an expected call of ListCategoryAttributes.
+func (mr *MockAppRepoMockRecorder) ListCategoryAttributes(ctx, slugs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategoryAttributes", reflect.TypeOf((*MockAppRepo)(nil).ListCategoryAttributes), ctx, slugs)
+}
+
+// ListCategoryFilters mocks base method.
+func (m *MockAppRepo) ListCategoryFilters(ctx context.Context, slug string) ([]*model.Filter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCategoryFilters", ctx, slug)
+	ret0, _ := ret[0].([]*model.Filter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategoryFilters indicates an expected call of ListCategoryFilters.
+func (mr *MockAppRepoMockRecorder) ListCategoryFilters(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategoryFilters", reflect.TypeOf((*MockAppRepo)(nil).ListCategoryFilters), ctx, slug)
+}
+
+// ListCategoryItems mocks base method.
+func (m *MockAppRepo) ListCategoryItems(ctx context.Context, slug string, page, size int, filters map[string]any, sort string) (*model.PaginatedItemsData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCategoryItems", ctx, slug, page, size, filters, sort)
+	ret0, _ := ret[0].(*model.PaginatedItemsData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategoryItems indicates an expected call of ListCategoryItems.
+func (mr *MockAppRepoMockRecorder) ListCategoryItems(ctx, slug, page, size, filters, sort any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategoryItems", reflect.TypeOf((*MockAppRepo)(nil).ListCategoryItems), ctx, slug, page, size, filters, sort)
+}
+
+// ListCustomerGroups mocks base method.
+func (m *MockAppRepo) ListCustomerGroups(ctx context.Context) ([]*model.CustomerGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomerGroups", ctx)
+	ret0, _ := ret[0].([]*model.CustomerGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomerGroups indicates an expected call of ListCustomerGroups.
+func (mr *MockAppRepoMockRecorder) ListCustomerGroups(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomerGroups", reflect.TypeOf((*MockAppRepo)(nil).ListCustomerGroups), ctx)
+}
+
+// ListDueScheduledItems mocks base method.
+func (m *MockAppRepo) ListDueScheduledItems(ctx context.Context, now time.Time) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDueScheduledItems", ctx, now)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDueScheduledItems indicates an expected call of ListDueScheduledItems.
+func (mr *MockAppRepoMockRecorder) ListDueScheduledItems(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDueScheduledItems", reflect.TypeOf((*MockAppRepo)(nil).ListDueScheduledItems), ctx, now)
+}
+
+// ListFavoriteCollections mocks base method.
+func (m *MockAppRepo) ListFavoriteCollections(ctx context.Context, uid uuid.UUID) ([]*model.FavoriteCollection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFavoriteCollections", ctx, uid)
+	ret0, _ := ret[0].([]*model.FavoriteCollection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFavoriteCollections indicates an expected call of ListFavoriteCollections.
+func (mr *MockAppRepoMockRecorder) ListFavoriteCollections(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFavoriteCollections", reflect.TypeOf((*MockAppRepo)(nil).ListFavoriteCollections), ctx, uid)
+}
+
+// ListFavoriteSubscribers mocks base method.
+func (m *MockAppRepo) ListFavoriteSubscribers(ctx context.Context, itemID uuid.UUID, t model.FavoriteEventType) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFavoriteSubscribers", ctx, itemID, t)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFavoriteSubscribers indicates an expected call of ListFavoriteSubscribers.
+func (mr *MockAppRepoMockRecorder) ListFavoriteSubscribers(ctx, itemID, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFavoriteSubscribers", reflect.TypeOf((*MockAppRepo)(nil).ListFavoriteSubscribers), ctx, itemID, t)
+}
+
+// ListFavorites mocks base method.
+func (m *MockAppRepo) ListFavorites(ctx context.Context, uid uuid.UUID, page, size int, sort string) (*model.PaginatedFavoritesData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFavorites", ctx, uid, page, size, sort)
+	ret0, _ := ret[0].(*model.PaginatedFavoritesData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFavorites indicates an expected call of ListFavorites.
+func (mr *MockAppRepoMockRecorder) ListFavorites(ctx, uid, page, size, sort any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFavorites", reflect.TypeOf((*MockAppRepo)(nil).ListFavorites), ctx, uid, page, size, sort)
+}
+
+// ListItemMedia mocks base method.
+func (m *MockAppRepo) ListItemMedia(ctx context.Context, itemID uuid.UUID) ([]*model.ItemMedia, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListItemMedia", ctx, itemID)
+	ret0, _ := ret[0].([]*model.ItemMedia)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListItemMedia indicates an expected call of ListItemMedia.
+func (mr *MockAppRepoMockRecorder) ListItemMedia(ctx, itemID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItemMedia", reflect.TypeOf((*MockAppRepo)(nil).ListItemMedia), ctx, itemID)
+}
+
+// ListItemQuestions mocks base method.
+func (m *MockAppRepo) ListItemQuestions(ctx context.Context, uid uuid.UUID, status string, page, size int) (*model.PaginatedQuestionsData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListItemQuestions", ctx, uid, status, page, size)
+	ret0, _ := ret[0].(*model.PaginatedQuestionsData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListItemQuestions indicates an expected call of ListItemQuestions.
+func (mr *MockAppRepoMockRecorder) ListItemQuestions(ctx, uid, status, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItemQuestions", reflect.TypeOf((*MockAppRepo)(nil).ListItemQuestions), ctx, uid, status, page, size)
+}
+
+// ListItemReviews mocks base method.
+func (m *MockAppRepo) ListItemReviews(ctx context.Context, uid uuid.UUID, status string, page, size int) (*model.PaginatedReviewsData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListItemReviews", ctx, uid, status, page, size)
+	ret0, _ := ret[0].(*model.PaginatedReviewsData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListItemReviews indicates an expected call of ListItemReviews.
+func (mr *MockAppRepoMockRecorder) ListItemReviews(ctx, uid, status, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItemReviews", reflect.TypeOf((*MockAppRepo)(nil).ListItemReviews), ctx, uid, status, page, size)
+}
+
+// ListItemRevisions mocks base method.
+func (m *MockAppRepo) ListItemRevisions(ctx context.Context, uid uuid.UUID, page, size int) (*model.PaginatedItemRevisionsData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListItemRevisions", ctx, uid, page, size)
+	ret0, _ := ret[0].(*model.PaginatedItemRevisionsData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListItemRevisions indicates an expected call of ListItemRevisions.
+func (mr *MockAppRepoMockRecorder) ListItemRevisions(ctx, uid, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItemRevisions", reflect.TypeOf((*MockAppRepo)(nil).ListItemRevisions), ctx, uid, page, size)
+}
+
+// ListItems mocks base method.
+func (m *MockAppRepo) ListItems(ctx context.Context, page, size int) (*model.PaginatedItemsData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListItems", ctx, page, size)
+	ret0, _ := ret[0].(*model.PaginatedItemsData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListItems indicates an expected call of ListItems.
+func (mr *MockAppRepoMockRecorder) ListItems(ctx, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItems", reflect.TypeOf((*MockAppRepo)(nil).ListItems), ctx, page, size)
+}
+
+// ListItemsByLabel mocks base method.
+func (m *MockAppRepo) ListItemsByLabel(ctx context.Context, label string, page, size int) (*model.PaginatedItemsData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListItemsByLabel", ctx, label, page, size)
+	ret0, _ := ret[0].(*model.PaginatedItemsData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListItemsByLabel indicates an expected call of ListItemsByLabel.
+func (mr *MockAppRepoMockRecorder) ListItemsByLabel(ctx, label, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItemsByLabel", reflect.TypeOf((*MockAppRepo)(nil).ListItemsByLabel), ctx, label, page, size)
+}
+
+// ListLabels mocks base method.
+func (m *MockAppRepo) ListLabels(ctx context.Context) ([]*model.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLabels", ctx)
+	ret0, _ := ret[0].([]*model.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLabels indicates an expected call of ListLabels.
+func (mr *MockAppRepoMockRecorder) ListLabels(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLabels", reflect.TypeOf((*MockAppRepo)(nil).ListLabels), ctx)
+}
+
+// ListOrders mocks base method.
+func (m *MockAppRepo) ListOrders(ctx context.Context, page, size int, filters map[string]any, sort string) (*model.PaginatedOrderData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrders", ctx, page, size, filters, sort)
+	ret0, _ := ret[0].(*model.PaginatedOrderData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrders indicates an expected call of ListOrders.
+func (mr *MockAppRepoMockRecorder) ListOrders(ctx, page, size, filters, sort any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrders", reflect.TypeOf((*MockAppRepo)(nil).ListOrders), ctx, page, size, filters, sort)
+}
+
+// ListPriceListItems mocks base method.
+func (m *MockAppRepo) ListPriceListItems(ctx context.Context, slug string, page, size int) (*model.PaginatedPriceListItemsData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPriceListItems", ctx, slug, page, size)
+	ret0, _ := ret[0].(*model.PaginatedPriceListItemsData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPriceListItems indicates an expected call of ListPriceListItems.
+func (mr *MockAppRepoMockRecorder) ListPriceListItems(ctx, slug, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPriceListItems", reflect.TypeOf((*MockAppRepo)(nil).ListPriceListItems), ctx, slug, page, size)
+}
+
+// ListPriceLists mocks base method.
+func (m *MockAppRepo) ListPriceLists(ctx context.Context) ([]*model.PriceList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPriceLists", ctx)
+	ret0, _ := ret[0].([]*model.PriceList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPriceLists indicates an expected call of ListPriceLists.
+func (mr *MockAppRepoMockRecorder) ListPriceLists(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPriceLists", reflect.TypeOf((*MockAppRepo)(nil).ListPriceLists), ctx)
+}
+
+// ListPromotionItems mocks base method.
+func (m *MockAppRepo) ListPromotionItems(ctx context.Context, slug string, page, size int) (*model.PaginatedPromoItemsData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPromotionItems", ctx, slug, page, size)
+	ret0, _ := ret[0].(*model.PaginatedPromoItemsData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPromotionItems indicates an expected call of ListPromotionItems.
+func (mr *MockAppRepoMockRecorder) ListPromotionItems(ctx, slug, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPromotionItems", reflect.TypeOf((*MockAppRepo)(nil).ListPromotionItems), ctx, slug, page, size)
+}
+
+// ListPromotions mocks base method.
+func (m *MockAppRepo) ListPromotions(ctx context.Context, page, size int) (*model.PaginatedPromosData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPromotions", ctx, page, size)
+	ret0, _ := ret[0].(*model.PaginatedPromosData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPromotions indicates an expected call of ListPromotions.
+func (mr *MockAppRepoMockRecorder) ListPromotions(ctx, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPromotions", reflect.TypeOf((*MockAppRepo)(nil).ListPromotions), ctx, page, size)
+}
+
+// ListQuantityBreaks mocks base method.
+func (m *MockAppRepo) ListQuantityBreaks(ctx context.Context, itemID uuid.UUID) ([]model.QuantityBreak, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQuantityBreaks", ctx, itemID)
+	ret0, _ := ret[0].([]model.QuantityBreak)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQuantityBreaks indicates an expected call of ListQuantityBreaks.
+func (mr *MockAppRepoMockRecorder) ListQuantityBreaks(ctx, itemID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQuantityBreaks", reflect.TypeOf((*MockAppRepo)(nil).ListQuantityBreaks), ctx, itemID)
+}
+
+// ListQuestionsByStatus mocks base method.
+func (m *MockAppRepo) ListQuestionsByStatus(ctx context.Context, status string, page, size int) (*model.PaginatedQuestionsData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQuestionsByStatus", ctx, status, page, size)
+	ret0, _ := ret[0].(*model.PaginatedQuestionsData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQuestionsByStatus indicates an expected call of ListQuestionsByStatus.
+func (mr *MockAppRepoMockRecorder) ListQuestionsByStatus(ctx, status, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQuestionsByStatus", reflect.TypeOf((*MockAppRepo)(nil).ListQuestionsByStatus), ctx, status, page, size)
+}
+
+// ListRelatedItems mocks base method.
+func (m *MockAppRepo) ListRelatedItems(ctx context.Context, uid uuid.UUID) ([]*model.RelatedProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRelatedItems", ctx, uid)
+	ret0, _ := ret[0].([]*model.RelatedProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRelatedItems indicates an expected call of ListRelatedItems.
+func (mr *MockAppRepoMockRecorder) ListRelatedItems(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRelatedItems", reflect.TypeOf((*MockAppRepo)(nil).ListRelatedItems), ctx, uid)
+}
+
+// ListReviewsByStatus mocks base method.
+func (m *MockAppRepo) ListReviewsByStatus(ctx context.Context, status string, page, size int) (*model.PaginatedReviewsData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReviewsByStatus", ctx, status, page, size)
+	ret0, _ := ret[0].(*model.PaginatedReviewsData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReviewsByStatus indicates an expected call of ListReviewsByStatus.
+func (mr *MockAppRepoMockRecorder) ListReviewsByStatus(ctx, status, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReviewsByStatus", reflect.TypeOf((*MockAppRepo)(nil).ListReviewsByStatus), ctx, status, page, size)
+}
+
+// ListStaleFilterCategories mocks base method.
+func (m *MockAppRepo) ListStaleFilterCategories(ctx context.Context, before time.Time, limit int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStaleFilterCategories", ctx, before, limit)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStaleFilterCategories indicates an expected call of ListStaleFilterCategories.
+func (mr *MockAppRepoMockRecorder) ListStaleFilterCategories(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStaleFilterCategories", reflect.TypeOf((*MockAppRepo)(nil).ListStaleFilterCategories), ctx, before, limit)
+}
+
+// ListTrash mocks base method.
+func (m *MockAppRepo) ListTrash(ctx context.Context, kind string, page, size int) (*model.PaginatedTrashData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrash", ctx, kind, page, size)
+	ret0, _ := ret[0].(*model.PaginatedTrashData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrash indicates an expected call of ListTrash.
+func (mr *MockAppRepoMockRecorder) ListTrash(ctx, kind, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrash", reflect.TypeOf((*MockAppRepo)(nil).ListTrash), ctx, kind, page, size)
+}
+
+// ListUserOrders mocks base method.
+func (m *MockAppRepo) ListUserOrders(ctx context.Context, uid uuid.UUID, page, size int) (*model.PaginatedOrderData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserOrders", ctx, uid, page, size)
+	ret0, _ := ret[0].(*model.PaginatedOrderData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserOrders indicates an expected call of ListUserOrders.
+func (mr *MockAppRepoMockRecorder) ListUserOrders(ctx, uid, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserOrders", reflect.TypeOf((*MockAppRepo)(nil).ListUserOrders), ctx, uid, page, size)
+}
+
+// ModerateAnswer mocks base method.
+func (m *MockAppRepo) ModerateAnswer(ctx context.Context, id uint64, req *model.Moderation) (*model.Answer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModerateAnswer", ctx, id, req)
+	ret0, _ := ret[0].(*model.Answer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModerateAnswer indicates an expected call of ModerateAnswer.
+func (mr *MockAppRepoMockRecorder) ModerateAnswer(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModerateAnswer", reflect.TypeOf((*MockAppRepo)(nil).ModerateAnswer), ctx, id, req)
+}
+
+// ModerateQuestion mocks base method.
+func (m *MockAppRepo) ModerateQuestion(ctx context.Context, id uint64, req *model.Moderation) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModerateQuestion", ctx, id, req)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModerateQuestion indicates an expected call of ModerateQuestion.
+func (mr *MockAppRepoMockRecorder) ModerateQuestion(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModerateQuestion", reflect.TypeOf((*MockAppRepo)(nil).ModerateQuestion), ctx, id, req)
+}
+
+// ModerateReview mocks base method.
+func (m *MockAppRepo) ModerateReview(ctx context.Context, id uint64, req *model.Moderation) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModerateReview", ctx, id, req)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModerateReview indicates an expected call of ModerateReview.
+func (mr *MockAppRepoMockRecorder) ModerateReview(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModerateReview", reflect.TypeOf((*MockAppRepo)(nil).ModerateReview), ctx, id, req)
+}
+
+// PatchCategory mocks base method.
+func (m *MockAppRepo) PatchCategory(ctx context.Context, slug string, c *model.Category, fields []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchCategory", ctx, slug, c, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchCategory indicates an expected call of PatchCategory.
+func (mr *MockAppRepoMockRecorder) PatchCategory(ctx, slug, c, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchCategory", reflect.TypeOf((*MockAppRepo)(nil).PatchCategory), ctx, slug, c, fields)
+}
+
+// PatchItem mocks base method.
+func (m *MockAppRepo) PatchItem(ctx context.Context, uid uuid.UUID, i *model.Item, fields []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchItem", ctx, uid, i, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchItem indicates an expected call of PatchItem.
+func (mr *MockAppRepoMockRecorder) PatchItem(ctx, uid, i, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchItem", reflect.TypeOf((*MockAppRepo)(nil).PatchItem), ctx, uid, i, fields)
+}
+
+// PatchOrder mocks base method.
+func (m *MockAppRepo) PatchOrder(ctx context.Context, orderID uint64, newData *model.Order, fields []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchOrder", ctx, orderID, newData, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchOrder indicates an expected call of PatchOrder.
+func (mr *MockAppRepoMockRecorder) PatchOrder(ctx, orderID, newData, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchOrder", reflect.TypeOf((*MockAppRepo)(nil).PatchOrder), ctx, orderID, newData, fields)
+}
+
+// PatchPromotion mocks base method.
+func (m *MockAppRepo) PatchPromotion(ctx context.Context, slug string, p *model.Promotion, fields []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchPromotion", ctx, slug, p, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchPromotion indicates an expected call of PatchPromotion.
+func (mr *MockAppRepoMockRecorder) PatchPromotion(ctx, slug, p, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchPromotion", reflect.TypeOf((*MockAppRepo)(nil).PatchPromotion), ctx, slug, p, fields)
+}
+
+// PromotionSearch mocks base method.
+func (m *MockAppRepo) PromotionSearch(ctx context.Context, query string, page, size int) (*model.PaginatedPromosData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromotionSearch", ctx, query, page, size)
+	ret0, _ := ret[0].(*model.PaginatedPromosData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PromotionSearch indicates an expected call of PromotionSearch.
+func (mr *MockAppRepoMockRecorder) PromotionSearch(ctx, query, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromotionSearch", reflect.TypeOf((*MockAppRepo)(nil).PromotionSearch), ctx, query, page, size)
+}
+
+// PurgeExpiredTrash mocks base method.
+func (m *MockAppRepo) PurgeExpiredTrash(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeExpiredTrash", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeExpiredTrash indicates an expected call of PurgeExpiredTrash.
+func (mr *MockAppRepoMockRecorder) PurgeExpiredTrash(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeExpiredTrash", reflect.TypeOf((*MockAppRepo)(nil).PurgeExpiredTrash), ctx, before)
+}
+
+// PurgeFromTrash mocks base method.
+func (m *MockAppRepo) PurgeFromTrash(ctx context.Context, kind, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeFromTrash", ctx, kind, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeFromTrash indicates an expected call of PurgeFromTrash.
+func (mr *MockAppRepoMockRecorder) PurgeFromTrash(ctx, kind, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeFromTrash", reflect.TypeOf((*MockAppRepo)(nil).PurgeFromTrash), ctx, kind, id)
+}
+
+// QueueOrphanMedia mocks base method.
+func (m *MockAppRepo) QueueOrphanMedia(ctx context.Context, keys []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueueOrphanMedia", ctx, keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// QueueOrphanMedia indicates an expected call of QueueOrphanMedia.
+func (mr *MockAppRepoMockRecorder) QueueOrphanMedia(ctx, keys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueOrphanMedia", reflect.TypeOf((*MockAppRepo)(nil).QueueOrphanMedia), ctx, keys)
+}
+
+// RebuildCategoryFilters mocks base method.
+func (m *MockAppRepo) RebuildCategoryFilters(ctx context.Context, slug string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildCategoryFilters", ctx, slug)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebuildCategoryFilters indicates an expected call of RebuildCategoryFilters.
+func (mr *MockAppRepoMockRecorder) RebuildCategoryFilters(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildCategoryFilters", reflect.TypeOf((*MockAppRepo)(nil).RebuildCategoryFilters), ctx, slug)
+}
+
+// RecommendItems mocks base method.
+func (m *MockAppRepo) RecommendItems(ctx context.Context, uid uuid.UUID, exclude []uuid.UUID, limit int) ([]*model.RelatedProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecommendItems", ctx, uid, exclude, limit)
+	ret0, _ := ret[0].([]*model.RelatedProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecommendItems indicates an expected call of RecommendItems.
+func (mr *MockAppRepoMockRecorder) RecommendItems(ctx, uid, exclude, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecommendItems", reflect.TypeOf((*MockAppRepo)(nil).RecommendItems), ctx, uid, exclude, limit)
+}
+
+// RecomputeLabels mocks base method.
+func (m *MockAppRepo) RecomputeLabels(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecomputeLabels", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecomputeLabels indicates an expected call of RecomputeLabels.
+func (mr *MockAppRepoMockRecorder) RecomputeLabels(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecomputeLabels", reflect.TypeOf((*MockAppRepo)(nil).RecomputeLabels), ctx)
+}
+
+// ReleaseIdempotencyKey mocks base method.
+func (m *MockAppRepo) ReleaseIdempotencyKey(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseIdempotencyKey", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseIdempotencyKey indicates an expected call of ReleaseIdempotencyKey.
+func (mr *MockAppRepoMockRecorder) ReleaseIdempotencyKey(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseIdempotencyKey", reflect.TypeOf((*MockAppRepo)(nil).ReleaseIdempotencyKey), ctx, key)
+}
+
+// RemoveFavoriteCollectionItem mocks base method.
+func (m *MockAppRepo) RemoveFavoriteCollectionItem(ctx context.Context, uid uuid.UUID, id uint64, itemID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFavoriteCollectionItem", ctx, uid, id, itemID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFavoriteCollectionItem indicates an expected call of RemoveFavoriteCollectionItem.
+func (mr *MockAppRepoMockRecorder) RemoveFavoriteCollectionItem(ctx, uid, id, itemID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFavoriteCollectionItem", reflect.TypeOf((*MockAppRepo)(nil).RemoveFavoriteCollectionItem), ctx, uid, id, itemID)
+}
+
+// RemoveFromFavorites mocks base method.
+func (m *MockAppRepo) RemoveFromFavorites(ctx context.Context, uid, itemID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFromFavorites", ctx, uid, itemID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFromFavorites indicates an expected call of RemoveFromFavorites.
+func (mr *MockAppRepoMockRecorder) RemoveFromFavorites(ctx, uid, itemID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromFavorites", reflect.TypeOf((*MockAppRepo)(nil).RemoveFromFavorites), ctx, uid, itemID)
+}
+
+// ReorderItemMedia mocks base method.
+func (m *MockAppRepo) ReorderItemMedia(ctx context.Context, itemID uuid.UUID, ids []uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderItemMedia", ctx, itemID, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderItemMedia indicates an expected call of ReorderItemMedia.
+func (mr *MockAppRepoMockRecorder) ReorderItemMedia(ctx, itemID, ids any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ItemAttrSearch", reflect.TypeOf((*MockAppRepo)(nil).ItemAttrSearch), ctx, query, size, page)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderItemMedia", reflect.TypeOf((*MockAppRepo)(nil).ReorderItemMedia), ctx, itemID, ids)
 }
 
-// ItemSearch mocks base method.
-func (m *MockAppRepo) ItemSearch(ctx context.Context, query string, size, page int) (*model.PaginatedItemsData, error) {
+// RestoreFromTrash mocks base method.
+func (m *MockAppRepo) RestoreFromTrash(ctx context.Context, kind, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ItemSearch", ctx, query, size, page)
-	ret0, _ := ret[0].(*model.PaginatedItemsData)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RestoreFromTrash", ctx, kind, id)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ItemSearch indicates an expected call of ItemSearch.
-func (mr *MockAppRepoMockRecorder) ItemSearch(ctx, query, size, page any) *gomock.Call {
+// RestoreFromTrash indicates an expected call of RestoreFromTrash.
+func (mr *MockAppRepoMockRecorder) RestoreFromTrash(ctx, kind, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ItemSearch", reflect.TypeOf((*MockAppRepo)(nil).ItemSearch), ctx, query, size, page)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreFromTrash", reflect.TypeOf((*MockAppRepo)(nil).RestoreFromTrash), ctx, kind, id)
 }
 
-// ListCategories mocks base method.
-func (m *MockAppRepo) ListCategories(ctx context.Context, page, size int) (*model.PaginatedCategoryData, error) {
+// RollbackItem mocks base method.
+func (m *MockAppRepo) RollbackItem(ctx context.Context, uid uuid.UUID, id uint64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListCategories", ctx, page, size)
-	ret0, _ := ret[0].(*model.PaginatedCategoryData)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RollbackItem", ctx, uid, id)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListCategories indicates an expected call of ListCategories.
-func (mr *MockAppRepoMockRecorder) ListCategories(ctx, page, size any) *gomock.Call {
+// RollbackItem indicates an expected call of RollbackItem.
+func (mr *MockAppRepoMockRecorder) RollbackItem(ctx, uid, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategories", reflect.TypeOf((*MockAppRepo)(nil).ListCategories), ctx, page, size)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackItem", reflect.TypeOf((*MockAppRepo)(nil).RollbackItem), ctx, uid, id)
 }
 
-// ListCategoryFilters mocks base method.
-func (m *MockAppRepo) ListCategoryFilters(ctx context.Context, slug string) ([]*model.Filter, error) {
+// SaveIdempotencyResponse mocks base method.
+func (m *MockAppRepo) SaveIdempotencyResponse(ctx context.Context, key string, status int, response []byte) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListCategoryFilters", ctx, slug)
-	ret0, _ := ret[0].([]*model.Filter)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SaveIdempotencyResponse", ctx, key, status, response)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListCategoryFilters indicates an expected call of ListCategoryFilters.
-func (mr *MockAppRepoMockRecorder) ListCategoryFilters(ctx, slug any) *gomock.Call {
+// SaveIdempotencyResponse indicates an expected call of SaveIdempotencyResponse.
+func (mr *MockAppRepoMockRecorder) SaveIdempotencyResponse(ctx, key, status, response any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategoryFilters", reflect.TypeOf((*MockAppRepo)(nil).ListCategoryFilters), ctx, slug)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveIdempotencyResponse", reflect.TypeOf((*MockAppRepo)(nil).SaveIdempotencyResponse), ctx, key, status, response)
 }
 
-// ListCategoryItems mocks base method.
-func (m *MockAppRepo) ListCategoryItems(ctx context.Context, slug string, page, size int, filters map[string]any, sort string) (*model.PaginatedItemsData, error) {
+// SetAnswerOfficial mocks base method.
+func (m *MockAppRepo) SetAnswerOfficial(ctx context.Context, id uint64, official bool) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListCategoryItems", ctx, slug, page, size, filters, sort)
-	ret0, _ := ret[0].(*model.PaginatedItemsData)
+	ret := m.ctrl.Call(m, "SetAnswerOfficial", ctx, id, official)
+	ret0, _ := ret[0].(uuid.UUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListCategoryItems indicates an expected call of ListCategoryItems.
-func (mr *MockAppRepoMockRecorder) ListCategoryItems(ctx, slug, page, size, filters, sort any) *gomock.Call {
+// SetAnswerOfficial indicates an expected call of SetAnswerOfficial.
+func (mr *MockAppRepoMockRecorder) SetAnswerOfficial(ctx, id, official any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategoryItems", reflect.TypeOf((*MockAppRepo)(nil).ListCategoryItems), ctx, slug, page, size, filters, sort)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAnswerOfficial", reflect.TypeOf((*MockAppRepo)(nil).SetAnswerOfficial), ctx, id, official)
 }
 
-// ListItems mocks base method.
-func (m *MockAppRepo) ListItems(ctx context.Context, page, size int) (*model.PaginatedItemsData, error) {
+// SetCategoryAttributes mocks base method.
+func (m *MockAppRepo) SetCategoryAttributes(ctx context.Context, slug string, attrs []*model.CategoryAttribute) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListItems", ctx, page, size)
-	ret0, _ := ret[0].(*model.PaginatedItemsData)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SetCategoryAttributes", ctx, slug, attrs)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListItems indicates an expected call of ListItems.
-func (mr *MockAppRepoMockRecorder) ListItems(ctx, page, size any) *gomock.Call {
+// SetCategoryAttributes indicates an expected call of SetCategoryAttributes.
+func (mr *MockAppRepoMockRecorder) SetCategoryAttributes(ctx, slug, attrs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItems", reflect.TypeOf((*MockAppRepo)(nil).ListItems), ctx, page, size)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCategoryAttributes", reflect.TypeOf((*MockAppRepo)(nil).SetCategoryAttributes), ctx, slug, attrs)
 }
 
-// ListItemsByLabel mocks base method.
-func (m *MockAppRepo) ListItemsByLabel(ctx context.Context, label string, page, size int) (*model.PaginatedItemsData, error) {
+// SetFavoriteCollectionItem mocks base method.
+func (m *MockAppRepo) SetFavoriteCollectionItem(ctx context.Context, uid uuid.UUID, id uint64, req *model.FavoriteCollectionItem) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListItemsByLabel", ctx, label, page, size)
-	ret0, _ := ret[0].(*model.PaginatedItemsData)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SetFavoriteCollectionItem", ctx, uid, id, req)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListItemsByLabel indicates an expected call of ListItemsByLabel.
-func (mr *MockAppRepoMockRecorder) ListItemsByLabel(ctx, label, page, size any) *gomock.Call {
+// SetFavoriteCollectionItem indicates an expected call of SetFavoriteCollectionItem.
+func (mr *MockAppRepoMockRecorder) SetFavoriteCollectionItem(ctx, uid, id, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItemsByLabel", reflect.TypeOf((*MockAppRepo)(nil).ListItemsByLabel), ctx, label, page, size)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFavoriteCollectionItem", reflect.TypeOf((*MockAppRepo)(nil).SetFavoriteCollectionItem), ctx, uid, id, req)
 }
 
-// ListOrders mocks base method.
-func (m *MockAppRepo) ListOrders(ctx context.Context, page, size int, filters map[string]any, sort string) (*model.PaginatedOrderData, error) {
+// SetFavoriteNotifications mocks base method.
+func (m *MockAppRepo) SetFavoriteNotifications(ctx context.Context, uid uuid.UUID, req *model.Favorite) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListOrders", ctx, page, size, filters, sort)
-	ret0, _ := ret[0].(*model.PaginatedOrderData)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SetFavoriteNotifications", ctx, uid, req)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListOrders indicates an expected call of ListOrders.
-func (mr *MockAppRepoMockRecorder) ListOrders(ctx, page, size, filters, sort any) *gomock.Call {
+// SetFavoriteNotifications indicates an expected call of SetFavoriteNotifications.
+func (mr *MockAppRepoMockRecorder) SetFavoriteNotifications(ctx, uid, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrders", reflect.TypeOf((*MockAppRepo)(nil).ListOrders), ctx, page, size, filters, sort)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFavoriteNotifications", reflect.TypeOf((*MockAppRepo)(nil).SetFavoriteNotifications), ctx, uid, req)
 }
 
-// ListPromotionItems mocks base method.
-func (m *MockAppRepo) ListPromotionItems(ctx context.Context, slug string, page, size int) (*model.PaginatedPromoItemsData, error) {
+// SetItemLabels mocks base method.
+func (m *MockAppRepo) SetItemLabels(ctx context.Context, uid uuid.UUID, names []string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPromotionItems", ctx, slug, page, size)
-	ret0, _ := ret[0].(*model.PaginatedPromoItemsData)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SetItemLabels", ctx, uid, names)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListPromotionItems indicates an expected call of ListPromotionItems.
-func (mr *MockAppRepoMockRecorder) ListPromotionItems(ctx, slug, page, size any) *gomock.Call {
+// SetItemLabels indicates an expected call of SetItemLabels.
+func (mr *MockAppRepoMockRecorder) SetItemLabels(ctx, uid, names any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPromotionItems", reflect.TypeOf((*MockAppRepo)(nil).ListPromotionItems), ctx, slug, page, size)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetItemLabels", reflect.TypeOf((*MockAppRepo)(nil).SetItemLabels), ctx, uid, names)
 }
 
-// ListPromotions mocks base method.
-func (m *MockAppRepo) ListPromotions(ctx context.Context, page, size int) (*model.PaginatedPromosData, error) {
+// SetPriceListItems mocks base method.
+func (m *MockAppRepo) SetPriceListItems(ctx context.Context, slug string, items []*model.PriceListItem) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPromotions", ctx, page, size)
-	ret0, _ := ret[0].(*model.PaginatedPromosData)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SetPriceListItems", ctx, slug, items)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListPromotions indicates an expected call of ListPromotions.
-func (mr *MockAppRepoMockRecorder) ListPromotions(ctx, page, size any) *gomock.Call {
+// SetPriceListItems indicates an expected call of SetPriceListItems.
+func (mr *MockAppRepoMockRecorder) SetPriceListItems(ctx, slug, items any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPromotions", reflect.TypeOf((*MockAppRepo)(nil).ListPromotions), ctx, page, size)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPriceListItems", reflect.TypeOf((*MockAppRepo)(nil).SetPriceListItems), ctx, slug, items)
 }
 
-// ListRelatedItems mocks base method.
-func (m *MockAppRepo) ListRelatedItems(ctx context.Context, uid uuid.UUID) ([]*model.RelatedProduct, error) {
+// SetQuantityBreaks mocks base method.
+func (m *MockAppRepo) SetQuantityBreaks(ctx context.Context, itemID uuid.UUID, breaks []model.QuantityBreak) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListRelatedItems", ctx, uid)
-	ret0, _ := ret[0].([]*model.RelatedProduct)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SetQuantityBreaks", ctx, itemID, breaks)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListRelatedItems indicates an expected call of ListRelatedItems.
-func (mr *MockAppRepoMockRecorder) ListRelatedItems(ctx, uid any) *gomock.Call {
+// SetQuantityBreaks indicates an expected call of SetQuantityBreaks.
+func (mr *MockAppRepoMockRecorder) SetQuantityBreaks(ctx, itemID, breaks any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRelatedItems", reflect.TypeOf((*MockAppRepo)(nil).ListRelatedItems), ctx, uid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetQuantityBreaks", reflect.TypeOf((*MockAppRepo)(nil).SetQuantityBreaks), ctx, itemID, breaks)
 }
 
-// ListUserOrders mocks base method.
-func (m *MockAppRepo) ListUserOrders(ctx context.Context, uid uuid.UUID, page, size int) (*model.PaginatedOrderData, error) {
+// ShareFavoriteCollection mocks base method.
+func (m *MockAppRepo) ShareFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64, token string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListUserOrders", ctx, uid, page, size)
-	ret0, _ := ret[0].(*model.PaginatedOrderData)
+	ret := m.ctrl.Call(m, "ShareFavoriteCollection", ctx, uid, id, token)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListUserOrders indicates an expected call of ListUserOrders.
-func (mr *MockAppRepoMockRecorder) ListUserOrders(ctx, uid, page, size any) *gomock.Call {
+// ShareFavoriteCollection indicates an expected call of ShareFavoriteCollection.
+func (mr *MockAppRepoMockRecorder) ShareFavoriteCollection(ctx, uid, id, token any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserOrders", reflect.TypeOf((*MockAppRepo)(nil).ListUserOrders), ctx, uid, page, size)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShareFavoriteCollection", reflect.TypeOf((*MockAppRepo)(nil).ShareFavoriteCollection), ctx, uid, id, token)
 }
 
-// PromotionSearch mocks base method.
-func (m *MockAppRepo) PromotionSearch(ctx context.Context, query string, page, size int) (*model.PaginatedPromosData, error) {
+// UnshareFavoriteCollection mocks base method.
+func (m *MockAppRepo) UnshareFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PromotionSearch", ctx, query, page, size)
-	ret0, _ := ret[0].(*model.PaginatedPromosData)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "UnshareFavoriteCollection", ctx, uid, id)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// PromotionSearch indicates an expected call of PromotionSearch.
-func (mr *MockAppRepoMockRecorder) PromotionSearch(ctx, query, page, size any) *gomock.Call {
+// UnshareFavoriteCollection indicates an expected call of UnshareFavoriteCollection.
+func (mr *MockAppRepoMockRecorder) UnshareFavoriteCollection(ctx, uid, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromotionSearch", reflect.TypeOf((*MockAppRepo)(nil).PromotionSearch), ctx, query, page, size)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnshareFavoriteCollection", reflect.TypeOf((*MockAppRepo)(nil).UnshareFavoriteCollection), ctx, uid, id)
 }
 
-// RemoveFromFavorites mocks base method.
-func (m *MockAppRepo) RemoveFromFavorites(ctx context.Context, uid, itemID uuid.UUID) error {
+// UpdateAttribute mocks base method.
+func (m *MockAppRepo) UpdateAttribute(ctx context.Context, slug string, a *model.Attribute) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveFromFavorites", ctx, uid, itemID)
+	ret := m.ctrl.Call(m, "UpdateAttribute", ctx, slug, a)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// RemoveFromFavorites indicates an expected call of RemoveFromFavorites.
-func (mr *MockAppRepoMockRecorder) RemoveFromFavorites(ctx, uid, itemID any) *gomock.Call {
+// UpdateAttribute indicates an expected call of UpdateAttribute.
+func (mr *MockAppRepoMockRecorder) UpdateAttribute(ctx, slug, a any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromFavorites", reflect.TypeOf((*MockAppRepo)(nil).RemoveFromFavorites), ctx, uid, itemID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttribute", reflect.TypeOf((*MockAppRepo)(nil).UpdateAttribute), ctx, slug, a)
 }
 
 // UpdateCategory mocks base method.
@@ -501,6 +1819,34 @@ func (mr *MockAppRepoMockRecorder) UpdateCategory(ctx, slug, c any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockAppRepo)(nil).UpdateCategory), ctx, slug, c)
 }
 
+// UpdateCustomerGroup mocks base method.
+func (m *MockAppRepo) UpdateCustomerGroup(ctx context.Context, slug string, g *model.CustomerGroup) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomerGroup", ctx, slug, g)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCustomerGroup indicates an expected call of UpdateCustomerGroup.
+func (mr *MockAppRepoMockRecorder) UpdateCustomerGroup(ctx, slug, g any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomerGroup", reflect.TypeOf((*MockAppRepo)(nil).UpdateCustomerGroup), ctx, slug, g)
+}
+
+// UpdateFavoriteCollection mocks base method.
+func (m *MockAppRepo) UpdateFavoriteCollection(ctx context.Context, uid uuid.UUID, id uint64, req *model.FavoriteCollection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFavoriteCollection", ctx, uid, id, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFavoriteCollection indicates an expected call of UpdateFavoriteCollection.
+func (mr *MockAppRepoMockRecorder) UpdateFavoriteCollection(ctx, uid, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFavoriteCollection", reflect.TypeOf((*MockAppRepo)(nil).UpdateFavoriteCollection), ctx, uid, id, req)
+}
+
 // UpdateItem mocks base method.
 func (m *MockAppRepo) UpdateItem(ctx context.Context, uid uuid.UUID, i *model.Item) error {
 	m.ctrl.T.Helper()
@@ -515,6 +1861,34 @@ func (mr *MockAppRepoMockRecorder) UpdateItem(ctx, uid, i any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItem", reflect.TypeOf((*MockAppRepo)(nil).UpdateItem), ctx, uid, i)
 }
 
+// UpdateItemMediaByID mocks base method.
+func (m *MockAppRepo) UpdateItemMediaByID(ctx context.Context, itemID uuid.UUID, id uint64, req *model.ItemMedia) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateItemMediaByID", ctx, itemID, id, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateItemMediaByID indicates an expected call of UpdateItemMediaByID.
+func (mr *MockAppRepoMockRecorder) UpdateItemMediaByID(ctx, itemID, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItemMediaByID", reflect.TypeOf((*MockAppRepo)(nil).UpdateItemMediaByID), ctx, itemID, id, req)
+}
+
+// UpdateLabel mocks base method.
+func (m *MockAppRepo) UpdateLabel(ctx context.Context, name string, l *model.Label) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLabel", ctx, name, l)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLabel indicates an expected call of UpdateLabel.
+func (mr *MockAppRepoMockRecorder) UpdateLabel(ctx, name, l any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLabel", reflect.TypeOf((*MockAppRepo)(nil).UpdateLabel), ctx, name, l)
+}
+
 // UpdateOrder mocks base method.
 func (m *MockAppRepo) UpdateOrder(ctx context.Context, orderID uint64, newData *model.Order) error {
 	m.ctrl.T.Helper()
@@ -529,6 +1903,20 @@ func (mr *MockAppRepoMockRecorder) UpdateOrder(ctx, orderID, newData any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrder", reflect.TypeOf((*MockAppRepo)(nil).UpdateOrder), ctx, orderID, newData)
 }
 
+// UpdatePriceList mocks base method.
+func (m *MockAppRepo) UpdatePriceList(ctx context.Context, slug string, pl *model.PriceList) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePriceList", ctx, slug, pl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePriceList indicates an expected call of UpdatePriceList.
+func (mr *MockAppRepoMockRecorder) UpdatePriceList(ctx, slug, pl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePriceList", reflect.TypeOf((*MockAppRepo)(nil).UpdatePriceList), ctx, slug, pl)
+}
+
 // UpdatePromotion mocks base method.
 func (m *MockAppRepo) UpdatePromotion(ctx context.Context, slug string, p *model.Promotion) error {
 	m.ctrl.T.Helper()
@@ -543,6 +1931,21 @@ func (mr *MockAppRepoMockRecorder) UpdatePromotion(ctx, slug, p any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePromotion", reflect.TypeOf((*MockAppRepo)(nil).UpdatePromotion), ctx, slug, p)
 }
 
+// UpdateReview mocks base method.
+func (m *MockAppRepo) UpdateReview(ctx context.Context, req *model.Review) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReview", ctx, req)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReview indicates an expected call of UpdateReview.
+func (mr *MockAppRepoMockRecorder) UpdateReview(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReview", reflect.TypeOf((*MockAppRepo)(nil).UpdateReview), ctx, req)
+}
+
 // MockDiscovery is a mock of Discovery interface.
 type MockDiscovery struct {
 	ctrl     *gomock.Controller
@@ -714,3 +2117,121 @@ func (mr *MockCacheServiceMockRecorder) Set(ctx, t, key, val any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockCacheService)(nil).Set), ctx, t, key, val)
 }
+
+// SetNX mocks base method.
+func (m *MockCacheService) SetNX(ctx context.Context, t time.Duration, key string, val any) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNX", ctx, t, key, val)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetNX indicates an expected call of SetNX.
+func (mr *MockCacheServiceMockRecorder) SetNX(ctx, t, key, val any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNX", reflect.TypeOf((*MockCacheService)(nil).SetNX), ctx, t, key, val)
+}
+
+// MockNotifier is a mock of Notifier interface.
+type MockNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifierMockRecorder
+}
+
+// MockNotifierMockRecorder is the mock recorder for MockNotifier.
+type MockNotifierMockRecorder struct {
+	mock *MockNotifier
+}
+
+// NewMockNotifier creates a new mock instance.
+func NewMockNotifier(ctrl *gomock.Controller) *MockNotifier {
+	mock := &MockNotifier{ctrl: ctrl}
+	mock.recorder = &MockNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotifier) EXPECT() *MockNotifierMockRecorder {
+	return m.recorder
+}
+
+// Notify mocks base method.
+func (m *MockNotifier) Notify(ctx context.Context, e *model.FavoriteEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", ctx, e)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockNotifierMockRecorder) Notify(ctx, e any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotifier)(nil).Notify), ctx, e)
+}
+
+// NotifyQuestion mocks base method.
+func (m *MockNotifier) NotifyQuestion(ctx context.Context, e *model.QuestionEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyQuestion", ctx, e)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyQuestion indicates an expected call of NotifyQuestion.
+func (mr *MockNotifierMockRecorder) NotifyQuestion(ctx, e any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyQuestion", reflect.TypeOf((*MockNotifier)(nil).NotifyQuestion), ctx, e)
+}
+
+// MockStorage is a mock of Storage interface.
+type MockStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageMockRecorder
+}
+
+// MockStorageMockRecorder is the mock recorder for MockStorage.
+type MockStorageMockRecorder struct {
+	mock *MockStorage
+}
+
+// NewMockStorage creates a new mock instance.
+func NewMockStorage(ctrl *gomock.Controller) *MockStorage {
+	mock := &MockStorage{ctrl: ctrl}
+	mock.recorder = &MockStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockStorage) Delete(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockStorageMockRecorder) Delete(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStorage)(nil).Delete), ctx, key)
+}
+
+// Put mocks base method.
+func (m *MockStorage) Put(ctx context.Context, key, contentType string, data []byte) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, key, contentType, data)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockStorageMockRecorder) Put(ctx, key, contentType, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockStorage)(nil).Put), ctx, key, contentType, data)
+}
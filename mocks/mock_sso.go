@@ -54,6 +54,36 @@ func (mr *MockSSOSvcMockRecorder) CreateUser(ctx, name, email, password any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockSSOSvc)(nil).CreateUser), ctx, name, email, password)
 }
 
+// GetCustomerGroups mocks base method.
+func (m *MockSSOSvc) GetCustomerGroups(ctx context.Context, token string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomerGroups", ctx, token)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomerGroups indicates an expected call of GetCustomerGroups.
+func (mr *MockSSOSvcMockRecorder) GetCustomerGroups(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerGroups", reflect.TypeOf((*MockSSOSvc)(nil).GetCustomerGroups), ctx, token)
+}
+
+// GetPermissions mocks base method.
+func (m *MockSSOSvc) GetPermissions(ctx context.Context, token string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPermissions", ctx, token)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPermissions indicates an expected call of GetPermissions.
+func (mr *MockSSOSvcMockRecorder) GetPermissions(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPermissions", reflect.TypeOf((*MockSSOSvc)(nil).GetPermissions), ctx, token)
+}
+
 // ParseClaims mocks base method.
 func (m *MockSSOSvc) ParseClaims(ctx context.Context, token string) (string, error) {
 	m.ctrl.T.Helper()
@@ -1,8 +1,10 @@
 package config
 
 import (
+	"github.com/JMURv/par-pro/products/pkg/consts"
 	"gopkg.in/yaml.v3"
 	"os"
+	"time"
 )
 
 type Config struct {
@@ -12,6 +14,11 @@ type Config struct {
 	DB           *DBConfig           `yaml:"db"`
 	Redis        *RedisConfig        `yaml:"redis"`
 	Jaeger       *JaegerConfig       `yaml:"jaeger"`
+	Jobs         *JobsConfig         `yaml:"jobs"`
+	Notifier     *NotifierConfig     `yaml:"notifier"`
+	Storage      *StorageConfig      `yaml:"storage"`
+	Auth         *AuthConfig         `yaml:"auth"`
+	Client       *ClientConfig       `yaml:"client"`
 }
 
 type SrvDiscoveryConfig struct {
@@ -51,6 +58,52 @@ type JaegerConfig struct {
 	} `yaml:"reporter"`
 }
 
+// JobsConfig holds the intervals of the background jobs and TrashRetention, how long deleted entities stay restorable.
+type JobsConfig struct {
+	ScheduledPrices    time.Duration `yaml:"scheduled_prices" env-default:"1m"`
+	MediaCleanup       time.Duration `yaml:"media_cleanup" env-default:"10m"`
+	FilterRebuild      time.Duration `yaml:"filter_rebuild" env-default:"15s"`
+	LabelRecompute     time.Duration `yaml:"label_recompute" env-default:"1h"`
+	IdempotencyCleanup time.Duration `yaml:"idempotency_cleanup" env-default:"1h"`
+	TrashPurge         time.Duration `yaml:"trash_purge" env-default:"1h"`
+	TrashRetention     time.Duration `yaml:"trash_retention" env-default:"720h"`
+}
+
+// NotifierConfig selects where favorite notifications go: "log" or "file".
+type NotifierConfig struct {
+	Type string `yaml:"type" env-default:"log"`
+	Path string `yaml:"path" env-default:"notifications.jsonl"`
+}
+
+// StorageConfig selects where uploaded media goes. "local" keeps files in Dir, which must be served under URL.
+type StorageConfig struct {
+	Type string `yaml:"type" env-default:"local"`
+	Dir  string `yaml:"dir" env-default:"media"`
+	URL  string `yaml:"url" env-default:"/media"`
+}
+
+// AuthConfig enables verifying SSO tokens locally against the keys published at JWKSURL or the PEM encoded
+// PublicKey file. Tokens that can't be verified locally are still checked by the SSO service.
+// Issuer and Audience, when set, have to match the iss and aud claims of the token.
+type AuthConfig struct {
+	JWKSURL     string        `yaml:"jwks_url"`
+	JWKSRefresh time.Duration `yaml:"jwks_refresh" env-default:"15m"`
+	PublicKey   string        `yaml:"public_key"`
+	Issuer      string        `yaml:"issuer"`
+	Audience    string        `yaml:"audience"`
+	UserClaim   string        `yaml:"user_claim" env-default:"uid"`
+}
+
+// ClientConfig tunes the calls to other services: the deadline of a single attempt, how many times an
+// unavailable service is retried by idempotent calls and after how many failed calls its breaker opens for BreakerCooldown.
+type ClientConfig struct {
+	Timeout          time.Duration `yaml:"timeout" env-default:"5s"`
+	Retries          int           `yaml:"retries" env-default:"2"`
+	Backoff          time.Duration `yaml:"backoff" env-default:"100ms"`
+	BreakerThreshold int           `yaml:"breaker_threshold" env-default:"5"`
+	BreakerCooldown  time.Duration `yaml:"breaker_cooldown" env-default:"30s"`
+}
+
 func MustLoad(configPath string) *Config {
 	var conf Config
 
@@ -63,5 +116,29 @@ func MustLoad(configPath string) *Config {
 		panic("failed to unmarshal config: " + err.Error())
 	}
 
+	if conf.Jobs == nil {
+		conf.Jobs = &JobsConfig{}
+	}
+
+	if conf.Jobs.TrashRetention <= 0 {
+		conf.Jobs.TrashRetention = consts.DefaultTrashRetention
+	}
+
+	if conf.Notifier == nil {
+		conf.Notifier = &NotifierConfig{}
+	}
+
+	if conf.Storage == nil {
+		conf.Storage = &StorageConfig{}
+	}
+
+	if conf.Auth == nil {
+		conf.Auth = &AuthConfig{}
+	}
+
+	if conf.Client == nil {
+		conf.Client = &ClientConfig{Retries: consts.DefaultClientRetries}
+	}
+
 	return &conf
 }
@@ -5,3 +5,79 @@ import "time"
 const DefaultPage = 1
 const DefaultPageSize = 40
 const DefaultCacheTime = time.Hour
+
+const LowestPriceWindow = 30 * 24 * time.Hour
+const DefaultJobInterval = time.Minute
+
+const PriceListHeader = "X-Price-List"
+const PriceListMetadataKey = "x-price-list"
+
+// CustomerGroupPermission prefixes the SSO permissions that assign a user to a customer group, e.g. "customer_group:wholesale".
+const CustomerGroupPermission = "customer_group:"
+
+// RolePermission prefixes the SSO permissions that grant a role, e.g. "role:manager".
+const RolePermission = "role:"
+
+// JWKSRefreshInterval is how long fetched signing keys are trusted, JWKSMinRefresh limits refetches on unknown key ids.
+const JWKSRefreshInterval = 15 * time.Minute
+const JWKSMinRefresh = 30 * time.Second
+
+// TokenLeeway tolerates clock skew between the SSO and this service when checking token times.
+const TokenLeeway = 30 * time.Second
+const DefaultUserClaim = "uid"
+
+// ClaimsCacheSize bounds the number of verified tokens kept in memory.
+const ClaimsCacheSize = 10000
+
+// SSOService is the name the SSO is registered under in service discovery.
+const SSOService = "sso"
+
+// Defaults for the calls to other services, see config.ClientConfig.
+const DefaultClientTimeout = 5 * time.Second
+const DefaultClientRetries = 2
+const DefaultClientBackoff = 100 * time.Millisecond
+const DefaultBreakerThreshold = 5
+const DefaultBreakerCooldown = 30 * time.Second
+
+// MaxMediaSize limits a single uploaded file.
+const MaxMediaSize = 10 << 20
+
+// MaxImagePixels limits the width times height of an uploaded image, a small file may declare huge dimensions.
+const MaxImagePixels = 40_000_000
+const MediaCleanupBatch = 100
+
+// PriceFilterName names the range filter every category gets over its item prices.
+const PriceFilterName = "price"
+
+// FilterRebuildDelay is how long a category has to stay unchanged before the worker rebuilds its filters.
+const FilterRebuildDelay = 30 * time.Second
+const FilterRebuildBatch = 50
+
+// RelatedItemsLimit is how many related items an item lists, the recommender fills the gap left by the manual ones.
+const RelatedItemsLimit = 12
+
+// Rule parameters a label gets when none is given: the age of a "new" item in days and the number of "hit" items.
+const NewLabelDays = 30
+const HitLabelSize = 20
+
+// ReviewMediaLimit is how many files a review can have attached.
+const ReviewMediaLimit = 5
+
+// IdempotencyHeader carries the client chosen key that makes a retried mutation return the first response.
+const IdempotencyHeader = "Idempotency-Key"
+const IdempotencyMetadataKey = "idempotency-key"
+const IdempotentReplayHeader = "Idempotent-Replayed"
+const IdempotentReplayMetadataKey = "idempotent-replayed"
+const MaxIdempotencyKeyLength = 255
+
+// IdempotencyTTL is how long a stored response is replayed, IdempotencyLockTimeout is when an unfinished request is considered abandoned.
+const IdempotencyTTL = 24 * time.Hour
+const IdempotencyLockTimeout = time.Minute
+
+// RequestIDHeader carries the id a request is logged and audited under, one is generated when the client sends none.
+const RequestIDHeader = "X-Request-ID"
+const RequestIDMetadataKey = "x-request-id"
+const MaxRequestIDLength = 128
+
+// DefaultTrashRetention is how long deleted entities are kept in the trash unless configured otherwise.
+const DefaultTrashRetention = 30 * 24 * time.Hour
@@ -0,0 +1,33 @@
+package model
+
+import "time"
+
+const (
+	AttributeTypeString  = "string"
+	AttributeTypeNumber  = "number"
+	AttributeTypeBoolean = "boolean"
+	AttributeTypeEnum    = "enum"
+)
+
+// Attribute defines a typed item property, items refer to it by name or slug.
+type Attribute struct {
+	ID            uint64   `json:"id"`
+	Slug          string   `json:"slug"`
+	Name          string   `json:"name"`
+	Type          string   `json:"type"`
+	Unit          string   `json:"unit"`
+	AllowedValues []string `json:"allowed_values"` // Only used by enum attributes
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CategoryAttribute binds an attribute to a category, filterable attributes get a derived filter.
+type CategoryAttribute struct {
+	CategorySlug string     `json:"category_slug"`
+	AttributeID  uint64     `json:"attribute_id"`
+	Attribute    *Attribute `json:"attribute"`
+	IsRequired   bool       `json:"is_required"`
+	IsFilterable bool       `json:"is_filterable"`
+	Position     int        `json:"position"`
+}
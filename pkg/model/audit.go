@@ -0,0 +1,74 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Actions recorded in the audit log.
+const (
+	AuditCreate   = "create"
+	AuditUpdate   = "update"
+	AuditDelete   = "delete"
+	AuditCancel   = "cancel"
+	AuditModerate = "moderate"
+	AuditRestore  = "restore"
+	AuditPurge    = "purge"
+	AuditApply    = "apply"
+	AuditRollback = "rollback"
+)
+
+// Entities recorded in the audit log.
+const (
+	AuditItem               = "item"
+	AuditItemLabels         = "item_labels"
+	AuditItemMedia          = "item_media"
+	AuditCategory           = "category"
+	AuditCategoryAttributes = "category_attributes"
+	AuditAttribute          = "attribute"
+	AuditPromotion          = "promotion"
+	AuditOrder              = "order"
+	AuditScheduledPrice     = "scheduled_price"
+	AuditPriceList          = "price_list"
+	AuditPriceListItems     = "price_list_items"
+	AuditCustomerGroup      = "customer_group"
+	AuditQuantityBreaks     = "quantity_breaks"
+	AuditLabel              = "label"
+	AuditReview             = "review"
+	AuditReviewMedia        = "review_media"
+	AuditQuestion           = "question"
+	AuditAnswer             = "answer"
+	AuditFavorite           = "favorite"
+	AuditFavoriteCollection = "favorite_collection"
+	AuditTrash              = "trash"
+)
+
+type PaginatedAuditData struct {
+	Data        []*AuditEntry `json:"data"`
+	Count       int64         `json:"count"`
+	TotalPages  int           `json:"total_pages"`
+	CurrentPage int           `json:"current_page"`
+	HasNextPage bool          `json:"has_next_page"`
+}
+
+// AuditEntry records a single mutation. ActorID is empty for background jobs and guests.
+// Diff holds the fields that changed as {"before": {...}, "after": {...}}, a side is left out when the entity did not exist.
+type AuditEntry struct {
+	ID        uint64          `json:"id"`
+	ActorID   string          `json:"actor_id"`
+	Entity    string          `json:"entity"`
+	EntityID  string          `json:"entity_id"`
+	Action    string          `json:"action"`
+	Diff      json.RawMessage `json:"diff"`
+	RequestID string          `json:"request_id"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// AuditFilter narrows the audit log, empty fields and zero times match everything.
+type AuditFilter struct {
+	Entity   string
+	EntityID string
+	ActorID  string
+	From     time.Time
+	To       time.Time
+}
@@ -27,6 +27,7 @@ type Category struct {
 	Items   []Item   `json:"items"`
 	Filters []Filter `json:"filters"`
 
+	Version   int       `json:"version,omitempty"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type CustomerGroup struct {
+	ID            uint64 `json:"id"`
+	Slug          string `json:"slug"`
+	Name          string `json:"name"`
+	PriceListID   uint64 `json:"price_list_id"`
+	PriceListSlug string `json:"price_list_slug"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type QuantityBreak struct {
+	ID              uint64    `json:"id"`
+	MinQuantity     int       `json:"min_quantity"`
+	Discount        int       `json:"discount"` // Percent off the unit price
+	Price           Money     `json:"price"`    // Unit price from MinQuantity on, filled when the item is priced
+	ItemID          uuid.UUID `json:"item_id"`
+	CustomerGroupID uint64    `json:"customer_group_id"` // 0 applies the break to every customer
+}
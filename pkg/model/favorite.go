@@ -2,12 +2,80 @@ package model
 
 import (
 	"github.com/google/uuid"
+	"time"
 )
 
+// Favorite sort keys, a leading "-" sorts in descending order.
+const (
+	FavoriteSortDateAdded = "date_added"
+	FavoriteSortPrice     = "price"
+)
+
+type PaginatedFavoritesData struct {
+	Data        []*Favorite `json:"data"`
+	Count       int64       `json:"count"`
+	TotalPages  int         `json:"total_pages"`
+	CurrentPage int         `json:"current_page"`
+	HasNextPage bool        `json:"has_next_page"`
+}
+
 type Favorite struct {
 	ID uint64 `json:"id"`
 
-	UserID uuid.UUID `json:"user_id"`
-	ItemID uuid.UUID `json:"item_id"`
-	Item   Item      `json:"item" gorm:"constraint:OnDelete:CASCADE"`
+	UserID          uuid.UUID `json:"user_id"`
+	ItemID          uuid.UUID `json:"item_id"`
+	Item            Item      `json:"item" gorm:"constraint:OnDelete:CASCADE"`
+	NotifyPriceDrop bool      `json:"notify_price_drop"`
+	NotifyInStock   bool      `json:"notify_in_stock"`
+
+	// EffectivePrice is the item price for the caller, after the best active promotion.
+	EffectivePrice Money `json:"effective_price"`
+	// Discount is the percentage of the best active promotion of the item.
+	Discount int `json:"discount"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type FavoriteCollection struct {
+	ID         uint64                   `json:"id"`
+	UserID     uuid.UUID                `json:"user_id"`
+	Name       string                   `json:"name"`
+	ShareToken string                   `json:"share_token,omitempty"`
+	Items      []FavoriteCollectionItem `json:"items"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type FavoriteCollectionItem struct {
+	CollectionID uint64    `json:"collection_id"`
+	ItemID       uuid.UUID `json:"item_id"`
+	Note         string    `json:"note"`
+	Item         Item      `json:"item"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type FavoriteEventType string
+
+const (
+	FavoriteEventPriceDrop   FavoriteEventType = "price_drop"
+	FavoriteEventBackInStock FavoriteEventType = "back_in_stock"
+)
+
+// FavoriteEvent is sent to the notifier for the users that opted in to it.
+type FavoriteEvent struct {
+	Type      FavoriteEventType `json:"type"`
+	ItemID    uuid.UUID         `json:"item_id"`
+	UserIDs   []uuid.UUID       `json:"user_ids"`
+	OldPrice  Money             `json:"old_price"`
+	NewPrice  Money             `json:"new_price"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+// ItemState is what favorite notifications watch: the effective price after active promotions and the stock flag.
+type ItemState struct {
+	Price   Money `json:"price"`
+	InStock bool  `json:"in_stock"`
 }
@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// IdempotencyRecord is the stored outcome of the first request sent with an idempotency key.
+// Status is the HTTP status of the response, zero while the request is in flight; gRPC responses are stored as 200.
+type IdempotencyRecord struct {
+	Key         string    `json:"key"`
+	RequestHash string    `json:"request_hash"`
+	Status      int       `json:"status"`
+	Response    []byte    `json:"response"`
+	CreatedAt   time.Time `json:"created_at"`
+}
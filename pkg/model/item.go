@@ -5,6 +5,14 @@ import (
 	"time"
 )
 
+// Category listing sort keys, a leading "-" sorts in descending order.
+const (
+	ItemSortCreatedAt = "created_at"
+	ItemSortPrice     = "price"
+	ItemSortTitle     = "title"
+	ItemSortRating    = "rating"
+)
+
 type PaginatedItemsData struct {
 	Data        []*Item `json:"data"`
 	Count       int64   `json:"count"`
@@ -17,7 +25,7 @@ type Item struct {
 	ID              uuid.UUID `json:"id"`
 	Title           string    `json:"title"`
 	Description     string    `json:"description"`
-	Price           float64   `json:"price"`
+	Price           Money     `json:"price"`
 	QuantityInStock int       `json:"quantity_in_stock"`
 	InStock         bool      `json:"in_stock"`
 	Src             string    `json:"src"`
@@ -25,6 +33,10 @@ type Item struct {
 	IsHit           bool      `json:"is_hit"`
 	IsRec           bool      `json:"is_rec"`
 	Article         string    `json:"article"`
+	Labels          []string  `json:"labels"`
+	// Rating and ReviewCount are aggregated over approved reviews.
+	Rating      float64 `json:"rating"`
+	ReviewCount int     `json:"review_count"`
 
 	Categories   []Category `json:"categories"`
 	ParentItemID uuid.UUID  `json:"parent_item_id"`
@@ -33,7 +45,10 @@ type Item struct {
 	Attributes      []ItemAttribute  `json:"attributes"`
 	Variants        []Item           `json:"variants"`
 	RelatedProducts []RelatedProduct `json:"related_products"`
+	QuantityBreaks  []QuantityBreak  `json:"quantity_breaks"`
 
+	// Version is bumped on every update, a non zero version on an update must match the stored one.
+	Version   int       `json:"version,omitempty"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
@@ -47,9 +62,10 @@ type PaginatedItemAttrData struct {
 }
 
 type ItemAttribute struct {
-	ID    uint64 `json:"id"`
-	Name  string `json:"name"`
-	Value string `json:"value"`
+	ID          uint64 `json:"id"`
+	Name        string `json:"name"`
+	Value       string `json:"value"`
+	AttributeID uint64 `json:"attribute_id"` // Set when the name matches a definition of the item categories
 
 	ItemID uuid.UUID `json:"item_id"`
 
@@ -62,6 +78,15 @@ type ItemMedia struct {
 	Src string `json:"src"`
 	Alt string `json:"alt"`
 
+	StorageKey  string         `json:"-"`
+	ContentType string         `json:"content_type"`
+	Size        int64          `json:"size"`
+	Width       int            `json:"width"`
+	Height      int            `json:"height"`
+	Position    int            `json:"position"`
+	IsPrimary   bool           `json:"is_primary"`
+	Variants    []MediaVariant `json:"variants"`
+
 	ItemID uuid.UUID `json:"item_id"`
 
 	CreatedAt time.Time `json:"created_at"`
@@ -73,6 +98,11 @@ type ItemCategory struct {
 	CategorySlug string    `json:"category_slug"`
 }
 
+const RelatedTypeAccessory = "accessory"
+const RelatedTypeAnalogue = "analogue"
+const RelatedTypeUpsell = "upsell"
+const RelatedTypeCrossSell = "cross_sell"
+
 type RelatedProduct struct {
 	ID uint64 `json:"id" gorm:"primaryKey"`
 
@@ -80,6 +110,21 @@ type RelatedProduct struct {
 	RelatedItemID uuid.UUID `json:"related_item_id"`
 	RelatedItem   Item      `json:"related_item"`
 
+	Type        string `json:"type"`
+	IsSymmetric bool   `json:"is_symmetric"`
+	Position    int    `json:"position"`
+	// IsRecommended marks items the recommender filled in, they have no relation row.
+	IsRecommended bool `json:"is_recommended"`
+
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
+
+// CategorySlugs returns the slugs of the item categories, the attribute schema is looked up by them.
+func (i *Item) CategorySlugs() []string {
+	res := make([]string, 0, len(i.Categories))
+	for _, v := range i.Categories {
+		res = append(res, v.Slug)
+	}
+	return res
+}
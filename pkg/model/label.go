@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+const (
+	LabelRuleManual = "manual"
+	LabelRuleNew    = "new"
+	LabelRuleHit    = "hit"
+	LabelRuleSale   = "sale"
+)
+
+// Label is a badge shown on items, items with a rule are labelled by the label worker.
+type Label struct {
+	ID       uint64 `json:"id"`
+	Name     string `json:"name"`
+	Title    string `json:"title"`
+	Color    string `json:"color"`    // "#RRGGBB"
+	Priority int    `json:"priority"` // Higher priority labels are shown first
+
+	Rule      string `json:"rule"`
+	RuleParam int    `json:"rule_param"` // Days for "new", number of items for "hit"
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,78 @@
+package mapper
+
+import (
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	md "github.com/JMURv/par-pro/products/pkg/model"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func AttributeToProto(req *md.Attribute) *pb.AttributeMsg {
+	return &pb.AttributeMsg{
+		Id:            req.ID,
+		Slug:          req.Slug,
+		Name:          req.Name,
+		Type:          req.Type,
+		Unit:          req.Unit,
+		AllowedValues: req.AllowedValues,
+		CreatedAt: &timestamppb.Timestamp{
+			Seconds: req.CreatedAt.Unix(),
+			Nanos:   int32(req.CreatedAt.Nanosecond()),
+		},
+		UpdatedAt: &timestamppb.Timestamp{
+			Seconds: req.UpdatedAt.Unix(),
+			Nanos:   int32(req.UpdatedAt.Nanosecond()),
+		},
+	}
+}
+
+func ListAttributesToProto(req []*md.Attribute) []*pb.AttributeMsg {
+	res := make([]*pb.AttributeMsg, len(req))
+	for i, v := range req {
+		res[i] = AttributeToProto(v)
+	}
+	return res
+}
+
+func AttributeFromProto(req *pb.AttributeMsg) *md.Attribute {
+	return &md.Attribute{
+		ID:            req.Id,
+		Slug:          req.Slug,
+		Name:          req.Name,
+		Type:          req.Type,
+		Unit:          req.Unit,
+		AllowedValues: req.AllowedValues,
+		CreatedAt:     req.CreatedAt.AsTime(),
+		UpdatedAt:     req.UpdatedAt.AsTime(),
+	}
+}
+
+func ListCategoryAttributesToProto(req []*md.CategoryAttribute) []*pb.CategoryAttributeMsg {
+	res := make([]*pb.CategoryAttributeMsg, len(req))
+	for i, v := range req {
+		res[i] = &pb.CategoryAttributeMsg{
+			CategorySlug: v.CategorySlug,
+			AttributeId:  v.AttributeID,
+			IsRequired:   v.IsRequired,
+			IsFilterable: v.IsFilterable,
+			Position:     int32(v.Position),
+		}
+		if v.Attribute != nil {
+			res[i].Attribute = AttributeToProto(v.Attribute)
+		}
+	}
+	return res
+}
+
+func ListCategoryAttributesFromProto(req []*pb.CategoryAttributeMsg) []*md.CategoryAttribute {
+	res := make([]*md.CategoryAttribute, len(req))
+	for i, v := range req {
+		res[i] = &md.CategoryAttribute{
+			CategorySlug: v.CategorySlug,
+			AttributeID:  v.AttributeId,
+			IsRequired:   v.IsRequired,
+			IsFilterable: v.IsFilterable,
+			Position:     int(v.Position),
+		}
+	}
+	return res
+}
@@ -0,0 +1,39 @@
+package mapper
+
+import (
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	md "github.com/JMURv/par-pro/products/pkg/model"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ListAuditToProto(req []*md.AuditEntry) []*pb.AuditEntryMsg {
+	res := make([]*pb.AuditEntryMsg, len(req))
+	for i, v := range req {
+		res[i] = &pb.AuditEntryMsg{
+			Id:        v.ID,
+			ActorId:   v.ActorID,
+			Entity:    v.Entity,
+			EntityId:  v.EntityID,
+			Action:    v.Action,
+			Diff:      string(v.Diff),
+			RequestId: v.RequestID,
+			CreatedAt: timestamppb.New(v.CreatedAt),
+		}
+	}
+	return res
+}
+
+func AuditFilterFromProto(req *pb.ListAuditLogReq) *md.AuditFilter {
+	f := &md.AuditFilter{
+		Entity:   req.Entity,
+		EntityID: req.EntityId,
+		ActorID:  req.ActorId,
+	}
+	if req.From != nil {
+		f.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		f.To = req.To.AsTime()
+	}
+	return f
+}
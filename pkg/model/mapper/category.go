@@ -22,6 +22,7 @@ func CategoryToProto(req *md.Category) *pb.CategoryMsg {
 		Src:             req.Src,
 		Alt:             req.Alt,
 		ParentSlug:      req.ParentSlug,
+		Version:         int32(req.Version),
 		CreatedAt: &timestamppb.Timestamp{
 			Seconds: req.CreatedAt.Unix(),
 			Nanos:   int32(req.CreatedAt.Nanosecond()),
@@ -83,6 +84,7 @@ func CategoryFromProto(req *pb.CategoryMsg) *md.Category {
 		Src:             req.Src,
 		Alt:             req.Alt,
 		ParentSlug:      req.ParentSlug,
+		Version:         int(req.Version),
 		CreatedAt:       req.CreatedAt.AsTime(),
 		UpdatedAt:       req.UpdatedAt.AsTime(),
 	}
@@ -0,0 +1,73 @@
+package mapper
+
+import (
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	md "github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func CustomerGroupToProto(req *md.CustomerGroup) *pb.CustomerGroupMsg {
+	return &pb.CustomerGroupMsg{
+		Id:            req.ID,
+		Slug:          req.Slug,
+		Name:          req.Name,
+		PriceListId:   req.PriceListID,
+		PriceListSlug: req.PriceListSlug,
+		CreatedAt: &timestamppb.Timestamp{
+			Seconds: req.CreatedAt.Unix(),
+			Nanos:   int32(req.CreatedAt.Nanosecond()),
+		},
+		UpdatedAt: &timestamppb.Timestamp{
+			Seconds: req.UpdatedAt.Unix(),
+			Nanos:   int32(req.UpdatedAt.Nanosecond()),
+		},
+	}
+}
+
+func ListCustomerGroupsToProto(req []*md.CustomerGroup) []*pb.CustomerGroupMsg {
+	res := make([]*pb.CustomerGroupMsg, len(req))
+	for i, v := range req {
+		res[i] = CustomerGroupToProto(v)
+	}
+	return res
+}
+
+func CustomerGroupFromProto(req *pb.CustomerGroupMsg) *md.CustomerGroup {
+	return &md.CustomerGroup{
+		ID:            req.Id,
+		Slug:          req.Slug,
+		Name:          req.Name,
+		PriceListID:   req.PriceListId,
+		PriceListSlug: req.PriceListSlug,
+	}
+}
+
+func ListQuantityBreaksToProto(req []md.QuantityBreak) []*pb.QuantityBreakMsg {
+	res := make([]*pb.QuantityBreakMsg, len(req))
+	for i, v := range req {
+		res[i] = &pb.QuantityBreakMsg{
+			Id:              v.ID,
+			MinQuantity:     uint32(v.MinQuantity),
+			Discount:        uint32(v.Discount),
+			Price:           MoneyToProto(v.Price),
+			ItemId:          v.ItemID.String(),
+			CustomerGroupId: v.CustomerGroupID,
+		}
+	}
+	return res
+}
+
+func ListQuantityBreaksFromProto(itemID uuid.UUID, req []*pb.QuantityBreakMsg) []md.QuantityBreak {
+	res := make([]md.QuantityBreak, len(req))
+	for i, v := range req {
+		res[i] = md.QuantityBreak{
+			ID:              v.Id,
+			MinQuantity:     int(v.MinQuantity),
+			Discount:        int(v.Discount),
+			ItemID:          itemID,
+			CustomerGroupID: v.CustomerGroupId,
+		}
+	}
+	return res
+}
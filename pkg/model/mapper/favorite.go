@@ -3,6 +3,8 @@ package mapper
 import (
 	pb "github.com/JMURv/par-pro/products/api/pb"
 	md "github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func ListFavoriteToProto(u []*md.Favorite) []*pb.FavoriteMsg {
@@ -19,5 +21,65 @@ func FavoriteToProto(req *md.Favorite) *pb.FavoriteMsg {
 		UserId: req.UserID.String(),
 		ItemId: req.ItemID.String(),
 		Item:   ItemToProto(&req.Item),
+
+		NotifyPriceDrop: req.NotifyPriceDrop,
+		NotifyInStock:   req.NotifyInStock,
+		EffectivePrice:  MoneyToProto(req.EffectivePrice),
+		CreatedAt: &timestamppb.Timestamp{
+			Seconds: req.CreatedAt.Unix(),
+			Nanos:   int32(req.CreatedAt.Nanosecond()),
+		},
+	}
+}
+
+func FavoriteCollectionToProto(req *md.FavoriteCollection) *pb.FavoriteCollectionMsg {
+	items := make([]*pb.FavoriteCollectionItemMsg, len(req.Items))
+	for i := range req.Items {
+		items[i] = FavoriteCollectionItemToProto(&req.Items[i])
+	}
+
+	res := &pb.FavoriteCollectionMsg{
+		Id:         req.ID,
+		Name:       req.Name,
+		ShareToken: req.ShareToken,
+		Items:      items,
+		CreatedAt: &timestamppb.Timestamp{
+			Seconds: req.CreatedAt.Unix(),
+			Nanos:   int32(req.CreatedAt.Nanosecond()),
+		},
+		UpdatedAt: &timestamppb.Timestamp{
+			Seconds: req.UpdatedAt.Unix(),
+			Nanos:   int32(req.UpdatedAt.Nanosecond()),
+		},
+	}
+
+	if req.UserID != uuid.Nil {
+		res.UserId = req.UserID.String()
+	}
+	return res
+}
+
+func ListFavoriteCollectionsToProto(req []*md.FavoriteCollection) []*pb.FavoriteCollectionMsg {
+	res := make([]*pb.FavoriteCollectionMsg, len(req))
+	for i, v := range req {
+		res[i] = FavoriteCollectionToProto(v)
+	}
+	return res
+}
+
+func FavoriteCollectionItemToProto(req *md.FavoriteCollectionItem) *pb.FavoriteCollectionItemMsg {
+	return &pb.FavoriteCollectionItemMsg{
+		CollectionId: req.CollectionID,
+		ItemId:       req.ItemID.String(),
+		Note:         req.Note,
+		Item:         ItemToProto(&req.Item),
+		CreatedAt: &timestamppb.Timestamp{
+			Seconds: req.CreatedAt.Unix(),
+			Nanos:   int32(req.CreatedAt.Nanosecond()),
+		},
+		UpdatedAt: &timestamppb.Timestamp{
+			Seconds: req.UpdatedAt.Unix(),
+			Nanos:   int32(req.UpdatedAt.Nanosecond()),
+		},
 	}
 }
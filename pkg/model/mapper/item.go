@@ -22,13 +22,17 @@ func ItemToProto(req *md.Item) *pb.ItemMsg {
 		Article:         req.Article,
 		Title:           req.Title,
 		Description:     req.Description,
-		Price:           float32(req.Price),
+		Price:           MoneyToProto(req.Price),
 		QuantityInStock: uint32(req.QuantityInStock),
 		Src:             req.Src,
 		Alt:             req.Alt,
 		InStock:         req.InStock,
 		IsHit:           req.IsHit,
 		IsRec:           req.IsRec,
+		Labels:          req.Labels,
+		Rating:          req.Rating,
+		ReviewCount:     int32(req.ReviewCount),
+		Version:         int32(req.Version),
 		Media:           ListItemMediaToProto(req.Media),
 		CreatedAt: &timestamppb.Timestamp{
 			Seconds: req.CreatedAt.Unix(),
@@ -48,10 +52,11 @@ func ItemToProto(req *md.Item) *pb.ItemMsg {
 		res := make([]*pb.ItemAttribute, len(req.Attributes))
 		for i, v := range req.Attributes {
 			res[i] = &pb.ItemAttribute{
-				Id:     v.ID,
-				Name:   v.Name,
-				Value:  v.Value,
-				ItemId: v.ItemID.String(),
+				Id:          v.ID,
+				Name:        v.Name,
+				Value:       v.Value,
+				ItemId:      v.ItemID.String(),
+				AttributeId: v.AttributeID,
 				CreatedAt: &timestamppb.Timestamp{
 					Seconds: v.CreatedAt.Unix(),
 					Nanos:   int32(v.CreatedAt.Nanosecond()),
@@ -73,6 +78,10 @@ func ItemToProto(req *md.Item) *pb.ItemMsg {
 		item.RelatedProducts = res
 	}
 
+	if len(req.QuantityBreaks) > 0 {
+		item.QuantityBreaks = ListQuantityBreaksToProto(req.QuantityBreaks)
+	}
+
 	if len(req.Categories) > 0 {
 		res := make([]*pb.CategoryMsg, len(req.Categories))
 		for i, v := range req.Categories {
@@ -114,6 +123,10 @@ func RelatedProductsToProto(req *md.RelatedProduct) *pb.RelatedProduct {
 			Seconds: req.UpdatedAt.Unix(),
 			Nanos:   int32(req.UpdatedAt.Nanosecond()),
 		},
+		Type:          req.Type,
+		IsSymmetric:   req.IsSymmetric,
+		Position:      int32(req.Position),
+		IsRecommended: req.IsRecommended,
 	}
 }
 
@@ -121,10 +134,11 @@ func ListItemAttributesToProto(req []*md.ItemAttribute) []*pb.ItemAttribute {
 	res := make([]*pb.ItemAttribute, len(req))
 	for i, v := range req {
 		res[i] = &pb.ItemAttribute{
-			Id:     v.ID,
-			Name:   v.Name,
-			Value:  v.Value,
-			ItemId: v.ItemID.String(),
+			Id:          v.ID,
+			Name:        v.Name,
+			Value:       v.Value,
+			ItemId:      v.ItemID.String(),
+			AttributeId: v.AttributeID,
 			CreatedAt: &timestamppb.Timestamp{
 				Seconds: v.CreatedAt.Unix(),
 				Nanos:   int32(v.CreatedAt.Nanosecond()),
@@ -140,21 +154,8 @@ func ListItemAttributesToProto(req []*md.ItemAttribute) []*pb.ItemAttribute {
 
 func ListItemMediaToProto(req []md.ItemMedia) []*pb.ItemMedia {
 	res := make([]*pb.ItemMedia, len(req))
-	for i, v := range req {
-		res[i] = &pb.ItemMedia{
-			Id:     v.ID,
-			Src:    v.Src,
-			Alt:    v.Alt,
-			ItemId: v.ItemID.String(),
-			CreatedAt: &timestamppb.Timestamp{
-				Seconds: v.CreatedAt.Unix(),
-				Nanos:   int32(v.CreatedAt.Nanosecond()),
-			},
-			UpdatedAt: &timestamppb.Timestamp{
-				Seconds: v.UpdatedAt.Unix(),
-				Nanos:   int32(v.UpdatedAt.Nanosecond()),
-			},
-		}
+	for i := range req {
+		res[i] = ItemMediaToProto(&req[i])
 	}
 	return res
 }
@@ -164,13 +165,14 @@ func ItemFromProto(req *pb.ItemMsg) *md.Item {
 		Article:         req.Article,
 		Title:           req.Title,
 		Description:     req.Description,
-		Price:           float64(req.Price),
+		Price:           MoneyFromProto(req.Price),
 		QuantityInStock: int(req.QuantityInStock),
 		Src:             req.Src,
 		Alt:             req.Alt,
 		InStock:         req.InStock,
 		IsHit:           req.IsHit,
 		IsRec:           req.IsRec,
+		Version:         int(req.Version),
 		CreatedAt:       req.CreatedAt.AsTime(),
 		UpdatedAt:       req.UpdatedAt.AsTime(),
 	}
@@ -200,22 +202,7 @@ func ItemFromProto(req *pb.ItemMsg) *md.Item {
 	if len(req.Media) > 0 {
 		res := make([]md.ItemMedia, len(req.Media))
 		for i, v := range req.Media {
-			iMedia := md.ItemMedia{
-				ID:        v.Id,
-				Src:       v.Src,
-				Alt:       v.Alt,
-				CreatedAt: v.CreatedAt.AsTime(),
-				UpdatedAt: v.UpdatedAt.AsTime(),
-			}
-
-			uid, err := uuid.Parse(v.ItemId)
-			if err != nil {
-				zap.L().Debug("failed to parse item id")
-			} else {
-				iMedia.ItemID = uid
-			}
-
-			res[i] = iMedia
+			res[i] = *ItemMediaFromProto(v)
 		}
 		modelItem.Media = res
 	}
@@ -224,11 +211,12 @@ func ItemFromProto(req *pb.ItemMsg) *md.Item {
 		res := make([]md.ItemAttribute, len(req.Attributes))
 		for i, v := range req.Attributes {
 			res[i] = md.ItemAttribute{
-				ID:        v.Id,
-				Name:      v.Name,
-				Value:     v.Value,
-				CreatedAt: v.CreatedAt.AsTime(),
-				UpdatedAt: v.UpdatedAt.AsTime(),
+				ID:          v.Id,
+				Name:        v.Name,
+				Value:       v.Value,
+				AttributeID: v.AttributeId,
+				CreatedAt:   v.CreatedAt.AsTime(),
+				UpdatedAt:   v.UpdatedAt.AsTime(),
 			}
 		}
 		modelItem.Attributes = res
@@ -238,10 +226,14 @@ func ItemFromProto(req *pb.ItemMsg) *md.Item {
 		res := make([]md.RelatedProduct, len(req.RelatedProducts))
 		for i, v := range req.RelatedProducts {
 			pr := md.RelatedProduct{
-				ID:          v.Id,
-				RelatedItem: *ItemFromProto(v.RelatedItem),
-				CreatedAt:   v.CreatedAt.AsTime(),
-				UpdatedAt:   v.UpdatedAt.AsTime(),
+				ID:            v.Id,
+				RelatedItem:   *ItemFromProto(v.RelatedItem),
+				Type:          v.Type,
+				IsSymmetric:   v.IsSymmetric,
+				Position:      int(v.Position),
+				IsRecommended: v.IsRecommended,
+				CreatedAt:     v.CreatedAt.AsTime(),
+				UpdatedAt:     v.UpdatedAt.AsTime(),
 			}
 
 			itemUid, err := uuid.Parse(v.ItemId)
@@ -0,0 +1,49 @@
+package mapper
+
+import (
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	md "github.com/JMURv/par-pro/products/pkg/model"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func LabelToProto(req *md.Label) *pb.LabelMsg {
+	return &pb.LabelMsg{
+		Id:        req.ID,
+		Name:      req.Name,
+		Title:     req.Title,
+		Color:     req.Color,
+		Priority:  int32(req.Priority),
+		Rule:      req.Rule,
+		RuleParam: int32(req.RuleParam),
+		CreatedAt: &timestamppb.Timestamp{
+			Seconds: req.CreatedAt.Unix(),
+			Nanos:   int32(req.CreatedAt.Nanosecond()),
+		},
+		UpdatedAt: &timestamppb.Timestamp{
+			Seconds: req.UpdatedAt.Unix(),
+			Nanos:   int32(req.UpdatedAt.Nanosecond()),
+		},
+	}
+}
+
+func ListLabelsToProto(req []*md.Label) []*pb.LabelMsg {
+	res := make([]*pb.LabelMsg, len(req))
+	for i, v := range req {
+		res[i] = LabelToProto(v)
+	}
+	return res
+}
+
+func LabelFromProto(req *pb.LabelMsg) *md.Label {
+	return &md.Label{
+		ID:        req.Id,
+		Name:      req.Name,
+		Title:     req.Title,
+		Color:     req.Color,
+		Priority:  int(req.Priority),
+		Rule:      req.Rule,
+		RuleParam: int(req.RuleParam),
+		CreatedAt: req.CreatedAt.AsTime(),
+		UpdatedAt: req.UpdatedAt.AsTime(),
+	}
+}
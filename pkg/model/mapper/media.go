@@ -0,0 +1,75 @@
+package mapper
+
+import (
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	md "github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func MediaVariantsToProto(req []md.MediaVariant) []*pb.MediaVariantMsg {
+	res := make([]*pb.MediaVariantMsg, len(req))
+	for i, v := range req {
+		res[i] = &pb.MediaVariantMsg{
+			Name:        v.Name,
+			Src:         v.Src,
+			ContentType: v.ContentType,
+			Width:       int32(v.Width),
+			Height:      int32(v.Height),
+		}
+	}
+	return res
+}
+
+func ItemMediaToProto(req *md.ItemMedia) *pb.ItemMedia {
+	return &pb.ItemMedia{
+		Id:          req.ID,
+		Src:         req.Src,
+		Alt:         req.Alt,
+		ItemId:      req.ItemID.String(),
+		ContentType: req.ContentType,
+		Size:        req.Size,
+		Width:       int32(req.Width),
+		Height:      int32(req.Height),
+		Position:    int32(req.Position),
+		IsPrimary:   req.IsPrimary,
+		Variants:    MediaVariantsToProto(req.Variants),
+		CreatedAt: &timestamppb.Timestamp{
+			Seconds: req.CreatedAt.Unix(),
+			Nanos:   int32(req.CreatedAt.Nanosecond()),
+		},
+		UpdatedAt: &timestamppb.Timestamp{
+			Seconds: req.UpdatedAt.Unix(),
+			Nanos:   int32(req.UpdatedAt.Nanosecond()),
+		},
+	}
+}
+
+func ListItemMediaPtrToProto(req []*md.ItemMedia) []*pb.ItemMedia {
+	res := make([]*pb.ItemMedia, len(req))
+	for i, v := range req {
+		res[i] = ItemMediaToProto(v)
+	}
+	return res
+}
+
+func ItemMediaFromProto(req *pb.ItemMedia) *md.ItemMedia {
+	res := &md.ItemMedia{
+		ID:        req.Id,
+		Src:       req.Src,
+		Alt:       req.Alt,
+		IsPrimary: req.IsPrimary,
+		CreatedAt: req.CreatedAt.AsTime(),
+		UpdatedAt: req.UpdatedAt.AsTime(),
+	}
+
+	uid, err := uuid.Parse(req.ItemId)
+	if err != nil {
+		zap.L().Debug("failed to parse item id")
+	} else {
+		res.ItemID = uid
+	}
+
+	return res
+}
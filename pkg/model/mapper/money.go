@@ -0,0 +1,21 @@
+package mapper
+
+import (
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	md "github.com/JMURv/par-pro/products/pkg/model"
+)
+
+func MoneyToProto(req md.Money) *pb.Money {
+	return &pb.Money{
+		Amount:   req.Amount,
+		Currency: req.Currency,
+	}
+}
+
+func MoneyFromProto(req *pb.Money) md.Money {
+	if req == nil {
+		return md.Money{}
+	}
+
+	return md.NewMoney(req.Amount, req.Currency)
+}
@@ -41,7 +41,7 @@ func OrderToProto(req *md.Order) *pb.OrderMsg {
 	order := &pb.OrderMsg{
 		Id:            req.ID,
 		Status:        req.Status,
-		Total:         float32(req.TotalAmount),
+		Total:         MoneyToProto(req.TotalAmount),
 		Fio:           req.FIO,
 		Tel:           req.Tel,
 		Email:         req.Email,
@@ -49,6 +49,7 @@ func OrderToProto(req *md.Order) *pb.OrderMsg {
 		Delivery:      req.Delivery,
 		PaymentMethod: req.PaymentMethod,
 		UserId:        req.UserID.String(),
+		Version:       int32(req.Version),
 		CreatedAt:     timestamppb.New(req.CreatedAt),
 		UpdatedAt:     timestamppb.New(req.UpdatedAt),
 	}
@@ -68,27 +69,34 @@ func ListOrderItemsFromProto(req []*pb.OrderItem) []*md.OrderItem {
 }
 
 func OrderItemFromProto(req *pb.OrderItem) *md.OrderItem {
+	itemID, _ := uuid.Parse(req.ItemId)
+	item := md.Item{}
+	if req.Item != nil {
+		item = *ItemFromProto(req.Item)
+	}
+
 	return &md.OrderItem{
 		ID:        req.Id,
 		Quantity:  int(req.Quantity),
 		OrderID:   req.OrderId,
-		Item:      *ItemFromProto(req.Item),
+		ItemID:    itemID,
+		Item:      item,
 		CreatedAt: req.CreatedAt.AsTime(),
 		UpdatedAt: req.UpdatedAt.AsTime(),
 	}
 }
 
 func OrderFromProto(req *pb.OrderMsg) *md.Order {
+	// Handlers take the owner from the caller, so a missing user id is left as uuid.Nil.
 	uid, err := uuid.Parse(req.UserId)
 	if err != nil {
 		zap.L().Debug("failed to parse user id")
-		return nil
 	}
 
 	order := &md.Order{
 		ID:            req.Id,
 		Status:        req.Status,
-		TotalAmount:   float64(req.Total),
+		TotalAmount:   MoneyFromProto(req.Total),
 		FIO:           req.Fio,
 		Tel:           req.Tel,
 		Email:         req.Email,
@@ -96,6 +104,7 @@ func OrderFromProto(req *pb.OrderMsg) *md.Order {
 		Delivery:      req.Delivery,
 		PaymentMethod: req.PaymentMethod,
 		UserID:        uid,
+		Version:       int(req.Version),
 		CreatedAt:     req.CreatedAt.AsTime(),
 		UpdatedAt:     req.UpdatedAt.AsTime(),
 	}
@@ -0,0 +1,74 @@
+package mapper
+
+import (
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	md "github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func PriceTimelineToProto(req *md.PriceTimeline) *pb.PriceTimelineMsg {
+	res := &pb.PriceTimelineMsg{
+		ItemId:          req.ItemID.String(),
+		CurrentPrice:    MoneyToProto(req.CurrentPrice),
+		LowestPrice_30D: MoneyToProto(req.LowestPrice30d),
+		History:         make([]*pb.PriceHistoryMsg, len(req.History)),
+		Scheduled:       make([]*pb.ScheduledPriceMsg, len(req.Scheduled)),
+	}
+
+	for i, v := range req.History {
+		res.History[i] = &pb.PriceHistoryMsg{
+			Id:       v.ID,
+			ItemId:   v.ItemID.String(),
+			OldPrice: MoneyToProto(v.OldPrice),
+			NewPrice: MoneyToProto(v.NewPrice),
+			Source:   v.Source,
+			CreatedAt: &timestamppb.Timestamp{
+				Seconds: v.CreatedAt.Unix(),
+				Nanos:   int32(v.CreatedAt.Nanosecond()),
+			},
+		}
+	}
+
+	for i, v := range req.Scheduled {
+		res.Scheduled[i] = ScheduledPriceToProto(v)
+	}
+
+	return res
+}
+
+func ScheduledPriceToProto(req *md.ScheduledPrice) *pb.ScheduledPriceMsg {
+	return &pb.ScheduledPriceMsg{
+		Id:     req.ID,
+		ItemId: req.ItemID.String(),
+		Price:  MoneyToProto(req.Price),
+		StartsAt: &timestamppb.Timestamp{
+			Seconds: req.StartsAt.Unix(),
+			Nanos:   int32(req.StartsAt.Nanosecond()),
+		},
+		AppliedAt: &timestamppb.Timestamp{
+			Seconds: req.AppliedAt.Unix(),
+			Nanos:   int32(req.AppliedAt.Nanosecond()),
+		},
+		CreatedAt: &timestamppb.Timestamp{
+			Seconds: req.CreatedAt.Unix(),
+			Nanos:   int32(req.CreatedAt.Nanosecond()),
+		},
+	}
+}
+
+func ScheduledPriceFromProto(req *pb.ScheduledPriceMsg) *md.ScheduledPrice {
+	itemID, err := uuid.Parse(req.ItemId)
+	if err != nil {
+		itemID = uuid.Nil
+	}
+
+	return &md.ScheduledPrice{
+		ID:        req.Id,
+		ItemID:    itemID,
+		Price:     MoneyFromProto(req.Price),
+		StartsAt:  req.StartsAt.AsTime(),
+		AppliedAt: req.AppliedAt.AsTime(),
+		CreatedAt: req.CreatedAt.AsTime(),
+	}
+}
@@ -0,0 +1,79 @@
+package mapper
+
+import (
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	md "github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func PriceListToProto(req *md.PriceList) *pb.PriceListMsg {
+	return &pb.PriceListMsg{
+		Id:           req.ID,
+		Slug:         req.Slug,
+		Name:         req.Name,
+		Currency:     req.Currency,
+		BaseCurrency: req.BaseCurrency,
+		Rate:         req.Rate,
+		Rounding:     req.Rounding,
+		RoundingStep: req.RoundingStep,
+		CreatedAt: &timestamppb.Timestamp{
+			Seconds: req.CreatedAt.Unix(),
+			Nanos:   int32(req.CreatedAt.Nanosecond()),
+		},
+		UpdatedAt: &timestamppb.Timestamp{
+			Seconds: req.UpdatedAt.Unix(),
+			Nanos:   int32(req.UpdatedAt.Nanosecond()),
+		},
+	}
+}
+
+func ListPriceListsToProto(req []*md.PriceList) []*pb.PriceListMsg {
+	res := make([]*pb.PriceListMsg, len(req))
+	for i, v := range req {
+		res[i] = PriceListToProto(v)
+	}
+	return res
+}
+
+func PriceListFromProto(req *pb.PriceListMsg) *md.PriceList {
+	return &md.PriceList{
+		ID:           req.Id,
+		Slug:         req.Slug,
+		Name:         req.Name,
+		Currency:     req.Currency,
+		BaseCurrency: req.BaseCurrency,
+		Rate:         req.Rate,
+		Rounding:     req.Rounding,
+		RoundingStep: req.RoundingStep,
+	}
+}
+
+func ListPriceListItemsToProto(req []*md.PriceListItem) []*pb.PriceListItemMsg {
+	res := make([]*pb.PriceListItemMsg, len(req))
+	for i, v := range req {
+		res[i] = &pb.PriceListItemMsg{
+			PriceListId: v.PriceListID,
+			ItemId:      v.ItemID.String(),
+			Price:       MoneyToProto(v.Price),
+		}
+	}
+	return res
+}
+
+func ListPriceListItemsFromProto(req []*pb.PriceListItemMsg) []*md.PriceListItem {
+	res := make([]*md.PriceListItem, len(req))
+	for i, v := range req {
+		itemID, err := uuid.Parse(v.ItemId)
+		if err != nil {
+			itemID = uuid.Nil
+		}
+
+		res[i] = &md.PriceListItem{
+			PriceListID: v.PriceListId,
+			ItemID:      itemID,
+			Price:       MoneyFromProto(v.Price),
+		}
+	}
+	return res
+}
@@ -21,6 +21,7 @@ func PromoToProto(req *md.Promotion) *pb.PromoMsg {
 		Description: req.Description,
 		Src:         req.Src,
 		Alt:         req.Alt,
+		Version:     int32(req.Version),
 		LastsTo: &timestamppb.Timestamp{
 			Seconds: req.LastsTo.Unix(),
 			Nanos:   int32(req.LastsTo.Nanosecond()),
@@ -72,6 +73,7 @@ func PromoFromProto(req *pb.PromoMsg) *md.Promotion {
 		Src:         req.Src,
 		Alt:         req.Alt,
 		LastsTo:     req.LastsTo.AsTime(),
+		Version:     int(req.Version),
 		CreatedAt:   req.CreatedAt.AsTime(),
 		UpdatedAt:   req.UpdatedAt.AsTime(),
 	}
@@ -0,0 +1,114 @@
+package mapper
+
+import (
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	md "github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func QuestionToProto(req *md.Question) *pb.QuestionMsg {
+	answers := make([]*pb.AnswerMsg, len(req.Answers))
+	for i := range req.Answers {
+		answers[i] = AnswerToProto(&req.Answers[i])
+	}
+
+	res := &pb.QuestionMsg{
+		Id:             req.ID,
+		ItemId:         req.ItemID.String(),
+		UserId:         req.UserID.String(),
+		Text:           req.Text,
+		Answers:        answers,
+		Status:         req.Status,
+		ModerationNote: req.ModerationNote,
+		CreatedAt: &timestamppb.Timestamp{
+			Seconds: req.CreatedAt.Unix(),
+			Nanos:   int32(req.CreatedAt.Nanosecond()),
+		},
+		UpdatedAt: &timestamppb.Timestamp{
+			Seconds: req.UpdatedAt.Unix(),
+			Nanos:   int32(req.UpdatedAt.Nanosecond()),
+		},
+	}
+
+	if req.ModeratedAt != nil {
+		res.ModeratedAt = timestamppb.New(*req.ModeratedAt)
+	}
+
+	return res
+}
+
+func ListQuestionsToProto(req []*md.Question) []*pb.QuestionMsg {
+	res := make([]*pb.QuestionMsg, len(req))
+	for i, v := range req {
+		res[i] = QuestionToProto(v)
+	}
+	return res
+}
+
+// QuestionFromProto maps the fields a user submits, the ids are left zero when they fail to parse.
+func QuestionFromProto(req *pb.QuestionMsg) *md.Question {
+	res := &md.Question{
+		ID:   req.Id,
+		Text: req.Text,
+	}
+
+	if uid, err := uuid.Parse(req.ItemId); err == nil {
+		res.ItemID = uid
+	}
+
+	if uid, err := uuid.Parse(req.UserId); err == nil {
+		res.UserID = uid
+	}
+
+	return res
+}
+
+func AnswerToProto(req *md.Answer) *pb.AnswerMsg {
+	res := &pb.AnswerMsg{
+		Id:             req.ID,
+		QuestionId:     req.QuestionID,
+		UserId:         req.UserID.String(),
+		Text:           req.Text,
+		Official:       req.Official,
+		Status:         req.Status,
+		ModerationNote: req.ModerationNote,
+		CreatedAt: &timestamppb.Timestamp{
+			Seconds: req.CreatedAt.Unix(),
+			Nanos:   int32(req.CreatedAt.Nanosecond()),
+		},
+		UpdatedAt: &timestamppb.Timestamp{
+			Seconds: req.UpdatedAt.Unix(),
+			Nanos:   int32(req.UpdatedAt.Nanosecond()),
+		},
+	}
+
+	if req.ModeratedAt != nil {
+		res.ModeratedAt = timestamppb.New(*req.ModeratedAt)
+	}
+
+	return res
+}
+
+func ListAnswersToProto(req []*md.Answer) []*pb.AnswerMsg {
+	res := make([]*pb.AnswerMsg, len(req))
+	for i, v := range req {
+		res[i] = AnswerToProto(v)
+	}
+	return res
+}
+
+// AnswerFromProto maps the fields a user submits, the user id is left zero when it fails to parse.
+func AnswerFromProto(req *pb.AnswerMsg) *md.Answer {
+	res := &md.Answer{
+		ID:         req.Id,
+		QuestionID: req.QuestionId,
+		Text:       req.Text,
+	}
+
+	if uid, err := uuid.Parse(req.UserId); err == nil {
+		res.UserID = uid
+	}
+
+	return res
+}
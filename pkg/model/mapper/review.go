@@ -0,0 +1,90 @@
+package mapper
+
+import (
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	md "github.com/JMURv/par-pro/products/pkg/model"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ReviewToProto(req *md.Review) *pb.ReviewMsg {
+	media := make([]*pb.ReviewMediaMsg, len(req.Media))
+	for i := range req.Media {
+		media[i] = ReviewMediaToProto(&req.Media[i])
+	}
+
+	res := &pb.ReviewMsg{
+		Id:             req.ID,
+		ItemId:         req.ItemID.String(),
+		UserId:         req.UserID.String(),
+		OrderId:        req.OrderID,
+		Rating:         int32(req.Rating),
+		Text:           req.Text,
+		Pros:           req.Pros,
+		Cons:           req.Cons,
+		Media:          media,
+		Status:         req.Status,
+		ModerationNote: req.ModerationNote,
+		CreatedAt: &timestamppb.Timestamp{
+			Seconds: req.CreatedAt.Unix(),
+			Nanos:   int32(req.CreatedAt.Nanosecond()),
+		},
+		UpdatedAt: &timestamppb.Timestamp{
+			Seconds: req.UpdatedAt.Unix(),
+			Nanos:   int32(req.UpdatedAt.Nanosecond()),
+		},
+	}
+
+	if req.ModeratedAt != nil {
+		res.ModeratedAt = timestamppb.New(*req.ModeratedAt)
+	}
+
+	return res
+}
+
+func ListReviewsToProto(req []*md.Review) []*pb.ReviewMsg {
+	res := make([]*pb.ReviewMsg, len(req))
+	for i, v := range req {
+		res[i] = ReviewToProto(v)
+	}
+	return res
+}
+
+// ReviewFromProto maps the fields a user submits, the ids are left zero when they fail to parse.
+func ReviewFromProto(req *pb.ReviewMsg) *md.Review {
+	res := &md.Review{
+		ID:     req.Id,
+		Rating: int(req.Rating),
+		Text:   req.Text,
+		Pros:   req.Pros,
+		Cons:   req.Cons,
+	}
+
+	if uid, err := uuid.Parse(req.ItemId); err == nil {
+		res.ItemID = uid
+	}
+
+	if uid, err := uuid.Parse(req.UserId); err == nil {
+		res.UserID = uid
+	}
+
+	return res
+}
+
+func ReviewMediaToProto(req *md.ReviewMedia) *pb.ReviewMediaMsg {
+	return &pb.ReviewMediaMsg{
+		Id:          req.ID,
+		ReviewId:    req.ReviewID,
+		Src:         req.Src,
+		ContentType: req.ContentType,
+		Size:        req.Size,
+		Width:       int32(req.Width),
+		Height:      int32(req.Height),
+		Position:    int32(req.Position),
+		Variants:    MediaVariantsToProto(req.Variants),
+		CreatedAt: &timestamppb.Timestamp{
+			Seconds: req.CreatedAt.Unix(),
+			Nanos:   int32(req.CreatedAt.Nanosecond()),
+		},
+	}
+}
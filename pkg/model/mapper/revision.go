@@ -0,0 +1,21 @@
+package mapper
+
+import (
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	md "github.com/JMURv/par-pro/products/pkg/model"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ListItemRevisionsToProto(req []*md.ItemRevision) []*pb.ItemRevisionMsg {
+	res := make([]*pb.ItemRevisionMsg, len(req))
+	for i, v := range req {
+		res[i] = &pb.ItemRevisionMsg{
+			Id:        v.ID,
+			ItemId:    v.ItemID.String(),
+			Version:   int64(v.Version),
+			Item:      ItemToProto(v.Item),
+			CreatedAt: timestamppb.New(v.CreatedAt),
+		}
+	}
+	return res
+}
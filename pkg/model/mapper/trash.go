@@ -0,0 +1,20 @@
+package mapper
+
+import (
+	pb "github.com/JMURv/par-pro/products/api/pb"
+	md "github.com/JMURv/par-pro/products/pkg/model"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ListTrashToProto(req []*md.TrashEntry) []*pb.TrashEntryMsg {
+	res := make([]*pb.TrashEntryMsg, len(req))
+	for i, v := range req {
+		res[i] = &pb.TrashEntryMsg{
+			Kind:      v.Kind,
+			Id:        v.ID,
+			Title:     v.Title,
+			DeletedAt: timestamppb.New(v.DeletedAt),
+		}
+	}
+	return res
+}
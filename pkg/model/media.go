@@ -0,0 +1,18 @@
+package model
+
+// MediaVariant is a resized copy of an uploaded image, e.g. "thumb".
+type MediaVariant struct {
+	Name        string `json:"name"`
+	Src         string `json:"src"`
+	StorageKey  string `json:"-"`
+	ContentType string `json:"content_type"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+}
+
+// MediaUpload is a file sent by a client, before it is stored.
+type MediaUpload struct {
+	Alt       string
+	IsPrimary bool
+	Data      []byte
+}
@@ -0,0 +1,117 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const DefaultCurrency = "RUB"
+
+var ErrCurrencyMismatch = errors.New("currency mismatch")
+
+// zeroDecimalCurrencies lists ISO 4217 codes without a minor unit, every other currency is assumed to use cents.
+var zeroDecimalCurrencies = map[string]struct{}{
+	"JPY": {},
+	"KRW": {},
+	"VND": {},
+}
+
+// Money is an exact amount in the currency's minor units (kopecks, cents) with its ISO 4217 code.
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+func NewMoney(amount int64, currency string) Money {
+	return Money{
+		Amount:   amount,
+		Currency: strings.ToUpper(currency),
+	}
+}
+
+// Normalize upper-cases the currency code and falls back to DefaultCurrency when it is missing.
+func (m *Money) Normalize() {
+	m.Currency = strings.ToUpper(strings.TrimSpace(m.Currency))
+	if m.Currency == "" {
+		m.Currency = DefaultCurrency
+	}
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+func (m Money) IsPositive() bool {
+	return m.Amount > 0
+}
+
+func (m Money) Add(o Money) (Money, error) {
+	if m.Currency == "" {
+		m.Currency = o.Currency
+	}
+
+	if o.Currency != "" && m.Currency != o.Currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+
+	return Money{Amount: m.Amount + o.Amount, Currency: m.Currency}, nil
+}
+
+func (m Money) Mul(n int64) Money {
+	return Money{Amount: m.Amount * n, Currency: m.Currency}
+}
+
+// Discount takes the percentage off the amount, the discounted amount is rounded half up to the minor unit.
+func (m Money) Discount(percent int) Money {
+	if percent <= 0 {
+		return m
+	}
+
+	return Money{Amount: (m.Amount*int64(100-percent) + 50) / 100, Currency: m.Currency}
+}
+
+func (m Money) Less(o Money) bool {
+	return m.Amount < o.Amount
+}
+
+func (m Money) String() string {
+	exp := CurrencyExponent(m.Currency)
+	if exp == 0 {
+		return fmt.Sprintf("%d %s", m.Amount, m.Currency)
+	}
+
+	sign, amount := "", m.Amount
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+
+	div := int64(1)
+	for i := 0; i < exp; i++ {
+		div *= 10
+	}
+	return fmt.Sprintf("%s%d.%0*d %s", sign, amount/div, exp, amount%div, m.Currency)
+}
+
+// CurrencyExponent returns the number of minor unit digits for the currency.
+func CurrencyExponent(currency string) int {
+	if _, ok := zeroDecimalCurrencies[strings.ToUpper(currency)]; ok {
+		return 0
+	}
+	return 2
+}
+
+// IsValidCurrency reports whether the code looks like an ISO 4217 alphabetic code, case-insensitively.
+func IsValidCurrency(currency string) bool {
+	currency = strings.ToUpper(currency)
+	if len(currency) != 3 {
+		return false
+	}
+
+	for _, r := range currency {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
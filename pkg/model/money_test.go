@@ -0,0 +1,120 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestMoney_Add(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        Money
+		b        Money
+		expected Money
+		err      error
+	}{
+		{
+			name:     "Same currency",
+			a:        NewMoney(1050, "RUB"),
+			b:        NewMoney(2095, "RUB"),
+			expected: NewMoney(3145, "RUB"),
+		},
+		{
+			name:     "Empty adopts currency",
+			a:        Money{},
+			b:        NewMoney(100, "USD"),
+			expected: NewMoney(100, "USD"),
+		},
+		{
+			name: "Currency mismatch",
+			a:    NewMoney(100, "RUB"),
+			b:    NewMoney(100, "USD"),
+			err:  ErrCurrencyMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				res, err := tt.a.Add(tt.b)
+				if tt.err != nil {
+					assert.ErrorIs(t, err, tt.err)
+					return
+				}
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, res)
+			},
+		)
+	}
+}
+
+func TestMoney_NoDrift(t *testing.T) {
+	total := Money{}
+	for i := 0; i < 1000; i++ {
+		var err error
+		total, err = total.Add(NewMoney(10, "RUB").Mul(3))
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int64(30000), total.Amount)
+	assert.Equal(t, "300.00 RUB", total.String())
+}
+
+func TestMoney_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		money    Money
+		expected string
+	}{
+		{name: "Cents", money: NewMoney(29905, "rub"), expected: "299.05 RUB"},
+		{name: "Negative", money: NewMoney(-5, "USD"), expected: "-0.05 USD"},
+		{name: "Zero decimal", money: NewMoney(1500, "JPY"), expected: "1500 JPY"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.expected, tt.money.String())
+			},
+		)
+	}
+}
+
+func TestMoney_Normalize(t *testing.T) {
+	m := Money{Amount: 100, Currency: " usd "}
+	m.Normalize()
+	assert.Equal(t, "USD", m.Currency)
+
+	m = Money{Amount: 100}
+	m.Normalize()
+	assert.Equal(t, DefaultCurrency, m.Currency)
+}
+
+func TestIsValidCurrency(t *testing.T) {
+	assert.True(t, IsValidCurrency("RUB"))
+	assert.True(t, IsValidCurrency("eur"))
+	assert.False(t, IsValidCurrency("RU"))
+	assert.False(t, IsValidCurrency("R1B"))
+	assert.False(t, IsValidCurrency(""))
+}
+
+func TestMoney_Discount(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       Money
+		percent  int
+		expected Money
+	}{
+		{name: "Exact", in: NewMoney(10000, "RUB"), percent: 5, expected: NewMoney(9500, "RUB")},
+		{name: "Rounds half up", in: NewMoney(1050, "RUB"), percent: 5, expected: NewMoney(998, "RUB")},
+		{name: "No discount", in: NewMoney(1050, "RUB"), percent: 0, expected: NewMoney(1050, "RUB")},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.expected, tt.in.Discount(tt.percent))
+			},
+		)
+	}
+}
@@ -34,9 +34,11 @@ type PaginatedOrderData struct {
 }
 
 type Order struct {
-	ID          uint64  `json:"id" gorm:"primaryKey;autoIncrement"`
-	Status      string  `json:"status" gorm:"not null"`
-	TotalAmount float64 `json:"total_amount" gorm:"not null"`
+	ID              uint64 `json:"id" gorm:"primaryKey;autoIncrement"`
+	Status          string `json:"status" gorm:"not null"`
+	TotalAmount     Money  `json:"total_amount" gorm:"not null"`
+	PriceListID     uint64 `json:"price_list_id,omitempty"`
+	CustomerGroupID uint64 `json:"customer_group_id,omitempty"`
 
 	FIO           string `json:"fio" gorm:"type:varchar(255);not null"`
 	Tel           string `json:"tel" gorm:"type:varchar(20);not null"`
@@ -48,6 +50,7 @@ type Order struct {
 	UserID     uuid.UUID    `json:"user_id" gorm:"not null"`
 	OrderItems []*OrderItem `json:"order_items" gorm:"foreignKey:OrderID;constraint:OnDelete:CASCADE"`
 
+	Version   int       `json:"version,omitempty" gorm:"not null;default:1"`
 	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
 	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
 }
@@ -0,0 +1,37 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const PriceSourceManual = "manual"
+const PriceSourceScheduled = "scheduled"
+
+type PriceHistory struct {
+	ID       uint64    `json:"id"`
+	ItemID   uuid.UUID `json:"item_id"`
+	OldPrice Money     `json:"old_price"`
+	NewPrice Money     `json:"new_price"`
+	Source   string    `json:"source"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ScheduledPrice struct {
+	ID        uint64    `json:"id"`
+	ItemID    uuid.UUID `json:"item_id"`
+	Price     Money     `json:"price"`
+	StartsAt  time.Time `json:"starts_at"`
+	AppliedAt time.Time `json:"applied_at"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type PriceTimeline struct {
+	ItemID         uuid.UUID         `json:"item_id"`
+	CurrentPrice   Money             `json:"current_price"`
+	LowestPrice30d Money             `json:"lowest_price_30d"`
+	History        []*PriceHistory   `json:"history"`
+	Scheduled      []*ScheduledPrice `json:"scheduled"`
+}
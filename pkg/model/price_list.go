@@ -0,0 +1,111 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"math/big"
+	"strconv"
+	"time"
+)
+
+const RoundingHalfUp = "half_up"
+const RoundingUp = "up"
+const RoundingDown = "down"
+
+type PriceList struct {
+	ID           uint64  `json:"id"`
+	Slug         string  `json:"slug"`
+	Name         string  `json:"name"`
+	Currency     string  `json:"currency"`
+	BaseCurrency string  `json:"base_currency"`
+	Rate         float64 `json:"rate"`          // Units of Currency per unit of BaseCurrency, 0 disables conversion
+	Rounding     string  `json:"rounding"`      // One of the Rounding* modes
+	RoundingStep int64   `json:"rounding_step"` // Converted prices are rounded to a multiple of this many minor units
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type PaginatedPriceListItemsData struct {
+	Data        []*PriceListItem `json:"data"`
+	Count       int64            `json:"count"`
+	TotalPages  int              `json:"total_pages"`
+	CurrentPage int              `json:"current_page"`
+	HasNextPage bool             `json:"has_next_page"`
+}
+
+type PriceListItem struct {
+	PriceListID uint64    `json:"price_list_id"`
+	ItemID      uuid.UUID `json:"item_id"`
+	Price       Money     `json:"price"`
+}
+
+// Price returns the item price in the list: the explicit list price when there is one, the converted base price otherwise.
+// When neither applies the base price is returned unchanged, so callers can tell by its currency.
+func (p *PriceList) Price(base Money, listPrice *int64) Money {
+	if listPrice != nil {
+		return Money{Amount: *listPrice, Currency: p.Currency}
+	}
+	return p.Convert(base)
+}
+
+// Convert converts a base currency amount with the list rate and rounding rules.
+func (p *PriceList) Convert(m Money) Money {
+	if m.Currency == p.Currency || m.Currency != p.BaseCurrency || p.Rate <= 0 {
+		return m
+	}
+
+	// The rate is parsed from its shortest decimal form, so 0.0345 is exactly 345/10000 and rounding is predictable
+	rate, ok := new(big.Rat).SetString(strconv.FormatFloat(p.Rate, 'f', -1, 64))
+	if !ok {
+		return m
+	}
+
+	amount := new(big.Rat).Mul(new(big.Rat).SetInt64(m.Amount), rate)
+	if diff := CurrencyExponent(p.Currency) - CurrencyExponent(m.Currency); diff != 0 {
+		scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(diff))), nil))
+		if diff > 0 {
+			amount.Mul(amount, scale)
+		} else {
+			amount.Quo(amount, scale)
+		}
+	}
+
+	step := p.RoundingStep
+	if step <= 0 {
+		step = 1
+	}
+	amount.Quo(amount, new(big.Rat).SetInt64(step))
+
+	return Money{Amount: roundRat(amount, p.Rounding) * step, Currency: p.Currency}
+}
+
+func roundRat(r *big.Rat, mode string) int64 {
+	quo, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if rem.Sign() == 0 {
+		return quo.Int64()
+	}
+
+	// QuoRem truncates towards zero, so the remainder carries the sign of r
+	away := false
+	switch mode {
+	case RoundingUp:
+		away = rem.Sign() > 0
+	case RoundingDown:
+		away = rem.Sign() < 0
+	default:
+		twice := new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2))
+		away = twice.Cmp(r.Denom()) >= 0
+	}
+
+	if away {
+		quo.Add(quo, big.NewInt(int64(rem.Sign())))
+	}
+	return quo.Int64()
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
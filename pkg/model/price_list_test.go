@@ -0,0 +1,68 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPriceList_Convert(t *testing.T) {
+	tests := []struct {
+		name     string
+		list     PriceList
+		in       Money
+		expected Money
+	}{
+		{
+			name:     "Half up",
+			list:     PriceList{Currency: "KZT", BaseCurrency: "RUB", Rate: 5.47, Rounding: RoundingHalfUp, RoundingStep: 1},
+			in:       NewMoney(1050, "RUB"),
+			expected: NewMoney(5744, "KZT"),
+		},
+		{
+			name:     "Up to step",
+			list:     PriceList{Currency: "KZT", BaseCurrency: "RUB", Rate: 5.47, Rounding: RoundingUp, RoundingStep: 100},
+			in:       NewMoney(1050, "RUB"),
+			expected: NewMoney(5800, "KZT"),
+		},
+		{
+			name:     "Down to step",
+			list:     PriceList{Currency: "KZT", BaseCurrency: "RUB", Rate: 5.47, Rounding: RoundingDown, RoundingStep: 100},
+			in:       NewMoney(1050, "RUB"),
+			expected: NewMoney(5700, "KZT"),
+		},
+		{
+			name:     "Exponent difference",
+			list:     PriceList{Currency: "JPY", BaseCurrency: "RUB", Rate: 1.65, Rounding: RoundingHalfUp, RoundingStep: 1},
+			in:       NewMoney(10000, "RUB"),
+			expected: NewMoney(165, "JPY"),
+		},
+		{
+			name:     "Already in list currency",
+			list:     PriceList{Currency: "KZT", BaseCurrency: "RUB", Rate: 5.47},
+			in:       NewMoney(100, "KZT"),
+			expected: NewMoney(100, "KZT"),
+		},
+		{
+			name:     "No rate",
+			list:     PriceList{Currency: "KZT", BaseCurrency: "RUB"},
+			in:       NewMoney(100, "RUB"),
+			expected: NewMoney(100, "RUB"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.expected, tt.list.Convert(tt.in))
+			},
+		)
+	}
+}
+
+func TestPriceList_Price(t *testing.T) {
+	list := PriceList{Currency: "KZT", BaseCurrency: "RUB", Rate: 5.47, Rounding: RoundingHalfUp, RoundingStep: 1}
+	explicit := int64(5000)
+
+	assert.Equal(t, NewMoney(5000, "KZT"), list.Price(NewMoney(1050, "RUB"), &explicit))
+	assert.Equal(t, NewMoney(5744, "KZT"), list.Price(NewMoney(1050, "RUB"), nil))
+}
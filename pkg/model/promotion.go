@@ -22,6 +22,7 @@ type Promotion struct {
 	LastsTo        time.Time        `json:"lasts_to"`
 	PromotionItems []*PromotionItem `json:"promotion_items"`
 
+	Version   int       `json:"version,omitempty"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
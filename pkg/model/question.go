@@ -0,0 +1,75 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// Questions and answers share the review statuses and are moderated the same way.
+const (
+	QuestionStatusPending  = ReviewStatusPending
+	QuestionStatusApproved = ReviewStatusApproved
+	QuestionStatusRejected = ReviewStatusRejected
+)
+
+type PaginatedQuestionsData struct {
+	Data        []*Question `json:"data"`
+	Count       int64       `json:"count"`
+	TotalPages  int         `json:"total_pages"`
+	CurrentPage int         `json:"current_page"`
+	HasNextPage bool        `json:"has_next_page"`
+}
+
+type PaginatedAnswersData struct {
+	Data        []*Answer `json:"data"`
+	Count       int64     `json:"count"`
+	TotalPages  int       `json:"total_pages"`
+	CurrentPage int       `json:"current_page"`
+	HasNextPage bool      `json:"has_next_page"`
+}
+
+// Question is asked on the item page, it carries its approved answers with the official ones first.
+type Question struct {
+	ID      uint64    `json:"id"`
+	ItemID  uuid.UUID `json:"item_id"`
+	UserID  uuid.UUID `json:"user_id"`
+	Text    string    `json:"text"`
+	Answers []Answer  `json:"answers"`
+
+	Status         string     `json:"status"`
+	ModerationNote string     `json:"moderation_note"`
+	ModeratedAt    *time.Time `json:"moderated_at"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type Answer struct {
+	ID         uint64    `json:"id"`
+	QuestionID uint64    `json:"question_id"`
+	UserID     uuid.UUID `json:"user_id"`
+	Text       string    `json:"text"`
+	Official   bool      `json:"official"`
+
+	Status         string     `json:"status"`
+	ModerationNote string     `json:"moderation_note"`
+	ModeratedAt    *time.Time `json:"moderated_at"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type QuestionEventType string
+
+const QuestionEventAnswered QuestionEventType = "question_answered"
+
+// QuestionEvent is sent to the notifier when an answer to a question gets published.
+type QuestionEvent struct {
+	Type       QuestionEventType `json:"type"`
+	ItemID     uuid.UUID         `json:"item_id"`
+	QuestionID uint64            `json:"question_id"`
+	AnswerID   uint64            `json:"answer_id"`
+	Official   bool              `json:"official"`
+	UserIDs    []uuid.UUID       `json:"user_ids"`
+	CreatedAt  time.Time         `json:"created_at"`
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	ReviewStatusPending  = "pending"
+	ReviewStatusApproved = "approved"
+	ReviewStatusRejected = "rejected"
+)
+
+type PaginatedReviewsData struct {
+	Data        []*Review `json:"data"`
+	Count       int64     `json:"count"`
+	TotalPages  int       `json:"total_pages"`
+	CurrentPage int       `json:"current_page"`
+	HasNextPage bool      `json:"has_next_page"`
+}
+
+// Review is left by a user who received the item, it is shown once a moderator approves it.
+type Review struct {
+	ID      uint64    `json:"id"`
+	ItemID  uuid.UUID `json:"item_id"`
+	UserID  uuid.UUID `json:"user_id"`
+	OrderID uint64    `json:"order_id"`
+
+	Rating int           `json:"rating"`
+	Text   string        `json:"text"`
+	Pros   string        `json:"pros"`
+	Cons   string        `json:"cons"`
+	Media  []ReviewMedia `json:"media"`
+
+	Status         string     `json:"status"`
+	ModerationNote string     `json:"moderation_note"`
+	ModeratedAt    *time.Time `json:"moderated_at"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ReviewMedia struct {
+	ID       uint64 `json:"id"`
+	ReviewID uint64 `json:"review_id"`
+	Src      string `json:"src"`
+
+	StorageKey  string         `json:"-"`
+	ContentType string         `json:"content_type"`
+	Size        int64          `json:"size"`
+	Width       int            `json:"width"`
+	Height      int            `json:"height"`
+	Position    int            `json:"position"`
+	Variants    []MediaVariant `json:"variants"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Moderation is the decision of a moderator on a pending review, question or answer.
+type Moderation struct {
+	Status string `json:"status"`
+	Note   string `json:"note"`
+}
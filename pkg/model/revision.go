@@ -0,0 +1,32 @@
+package model
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"time"
+)
+
+type PaginatedItemRevisionsData struct {
+	Data        []*ItemRevision `json:"data"`
+	Count       int64           `json:"count"`
+	TotalPages  int             `json:"total_pages"`
+	CurrentPage int             `json:"current_page"`
+	HasNextPage bool            `json:"has_next_page"`
+}
+
+// ItemRevision is the state an item had at Version, captured when an update replaced it.
+// Item holds the core fields, attributes, media, categories and related products, variants are not part of it.
+type ItemRevision struct {
+	ID        uint64    `json:"id"`
+	ItemID    uuid.UUID `json:"item_id"`
+	Version   int       `json:"version"`
+	Item      *Item     `json:"item"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ItemRevisionDiff holds the fields that differ between two revisions as {"before": {...}, "after": {...}}.
+type ItemRevisionDiff struct {
+	From uint64          `json:"from"`
+	To   uint64          `json:"to"`
+	Diff json.RawMessage `json:"diff"`
+}
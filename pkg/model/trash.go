@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+// Kinds of entities that are moved to the trash instead of being deleted.
+const (
+	TrashItem      = "item"
+	TrashCategory  = "category"
+	TrashPromotion = "promotion"
+	TrashOrder     = "order"
+)
+
+var TrashKinds = []string{TrashItem, TrashCategory, TrashPromotion, TrashOrder}
+
+type PaginatedTrashData struct {
+	Data        []*TrashEntry `json:"data"`
+	Count       int64         `json:"count"`
+	TotalPages  int           `json:"total_pages"`
+	CurrentPage int           `json:"current_page"`
+	HasNextPage bool          `json:"has_next_page"`
+}
+
+// TrashEntry is a soft deleted entity. ID is the item uuid, the category or promotion slug or the order number.
+type TrashEntry struct {
+	Kind      string    `json:"kind"`
+	ID        string    `json:"id"`
+	Title     string    `json:"title"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
@@ -11,6 +11,7 @@ import (
 	_ "github.com/lib/pq"
 	"go.uber.org/zap"
 	"path/filepath"
+	"sort"
 	"strings"
 )
 
@@ -42,7 +43,8 @@ func ApplyMigrations(db *sql.DB, conf *conf.DBConfig) error {
 func FilterItems(q *strings.Builder, args []any, filters map[string]any) []any {
 	conds := make([]string, 0, len(filters))
 
-	for key, value := range filters {
+	for _, key := range filterKeys(filters) {
+		value := filters[key]
 		switch key {
 		case "min_price":
 			conds = append(conds, "i.price >= ?")
@@ -112,3 +114,24 @@ func FilterOrders(q *strings.Builder, args []any, filters map[string]any) []any
 	}
 	return args
 }
+
+// filterKeys returns the keys in a stable order so the built query and its args line up between calls.
+// Price bounds come first, attribute filters follow alphabetically.
+func filterKeys(filters map[string]any) []string {
+	keys := make([]string, 0, len(filters))
+	for _, key := range []string{"min_price", "max_price"} {
+		if _, ok := filters[key]; ok {
+			keys = append(keys, key)
+		}
+	}
+
+	attrs := make([]string, 0, len(filters))
+	for key := range filters {
+		if key != "min_price" && key != "max_price" {
+			attrs = append(attrs, key)
+		}
+	}
+	sort.Strings(attrs)
+
+	return append(keys, attrs...)
+}
@@ -69,3 +69,17 @@ func TestFilterItems(t *testing.T) {
 		)
 	}
 }
+
+func TestFilterKeys(t *testing.T) {
+	filters := map[string]any{
+		"memory":    map[string]any{"min": "64GB"},
+		"max_price": 500,
+		"brand":     []string{"Apple"},
+		"min_price": 100,
+		"color":     "red",
+	}
+
+	for range 20 {
+		require.Equal(t, []string{"min_price", "max_price", "brand", "color", "memory"}, filterKeys(filters))
+	}
+}
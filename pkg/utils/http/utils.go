@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"errors"
 	"github.com/goccy/go-json"
 	"net/http"
 	"strings"
@@ -10,8 +11,20 @@ type Response struct {
 	Data any `json:"data"`
 }
 
+// ErrorResponse is an RFC 7807 problem, Error repeats the detail for clients of the former error body.
 type ErrorResponse struct {
-	Error string `json:"error"`
+	Type       string `json:"type"`
+	Title      string `json:"title"`
+	Status     int    `json:"status"`
+	Detail     string `json:"detail"`
+	Error      string `json:"error"`
+	Violations any    `json:"violations,omitempty"`
+}
+
+// violationsError is implemented by errors carrying field-level details of a rejected request.
+type violationsError interface {
+	error
+	Violations() any
 }
 
 func SuccessPaginatedResponse(w http.ResponseWriter, statusCode int, data any) {
@@ -31,13 +44,22 @@ func SuccessResponse(w http.ResponseWriter, statusCode int, data any) {
 }
 
 func ErrResponse(w http.ResponseWriter, statusCode int, err error) {
-	w.Header().Set("Content-Type", "application/json")
+	res := &ErrorResponse{
+		Type:   "about:blank",
+		Title:  http.StatusText(statusCode),
+		Status: statusCode,
+		Detail: err.Error(),
+		Error:  err.Error(),
+	}
+
+	var verr violationsError
+	if errors.As(err, &verr) {
+		res.Violations = verr.Violations()
+	}
+
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(statusCode)
-	json.NewEncoder(w).Encode(
-		&ErrorResponse{
-			Error: err.Error(),
-		},
-	)
+	json.NewEncoder(w).Encode(res)
 }
 
 func ParseFiltersByURL(r *http.Request) map[string]any {